@@ -575,9 +575,15 @@ func New(
 		app.AttributeKeeper, app.NameKeeper, app.TransferKeeper,
 		markerReqAttrBypassAddrs, NewGroupCheckerFunc(app.GroupKeeper),
 	)
+	app.MarkerKeeper.SetQueryTimeout(cast.ToDuration(appOpts.Get(markertypes.AppConfigKeyQueryTimeout)))
+	app.MarkerKeeper.SetRestrictionTraceEnabled(cast.ToBool(appOpts.Get(markertypes.AppConfigKeyRestrictionTrace)))
+	app.MarkerKeeper.SetHistoricalContextFn(func(height int64) (sdk.Context, error) {
+		return app.BaseApp.CreateQueryContext(height, false)
+	})
 
 	app.MetadataKeeper = metadatakeeper.NewKeeper(
 		appCodec, keys[metadatatypes.StoreKey], app.AccountKeeper, app.AuthzKeeper, app.AttributeKeeper, app.MarkerKeeper, app.BankKeeper,
+		govAuthority,
 	)
 
 	app.HoldKeeper = holdkeeper.NewKeeper(
@@ -815,6 +821,7 @@ func New(
 		stakingtypes.ModuleName,
 		feegrant.ModuleName,
 		group.ModuleName,
+		metadatatypes.ModuleName,
 		triggertypes.ModuleName,
 	)
 
@@ -772,12 +772,14 @@ func (s *UpgradeTestSuite) TestMetadataMigration() { // TODO[viridian]: Delete t
 		"INF Progress update: module=x/metadata scopes=300000 value owners=257143",
 		"INF Done moving scope value owners into bank module. module=x/metadata scopes=300005 value owners=257147",
 		"INF Done migrating x/metadata from 3 to 4. module=x/metadata",
+		"INF Starting migration of x/metadata from 4 to 5. module=x/metadata",
+		"INF Done migrating x/metadata from 4 to 5. module=x/metadata sessions=0",
 		"INF Module migrations completed.",
 	}
 
 	vm, err := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
 	s.Require().NoError(err, "GetModuleVersionMap")
-	s.Require().Equal(4, int(vm[metadatatypes.ModuleName]), "%s module version", metadatatypes.ModuleName)
+	s.Require().Equal(5, int(vm[metadatatypes.ModuleName]), "%s module version", metadatatypes.ModuleName)
 	// Drop it back to 3 so the migration runs.
 	vm[metadatatypes.ModuleName] = 3
 
@@ -788,7 +790,7 @@ func (s *UpgradeTestSuite) TestMetadataMigration() { // TODO[viridian]: Delete t
 	}
 	s.ExecuteAndAssertLogs(runner, expLogs, nil, true, "runModuleMigrations")
 	s.Assert().NoError(err, "error from runModuleMigrations")
-	s.Assert().Equal(4, int(vm[metadatatypes.ModuleName]), "vm[metadatatypes.ModuleName]")
+	s.Assert().Equal(5, int(vm[metadatatypes.ModuleName]), "vm[metadatatypes.ModuleName]")
 	s.T().Logf("runModuleMigrations took %s", t2.Sub(t1))
 
 	for _, scopeID := range expCoin {
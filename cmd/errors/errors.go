@@ -10,3 +10,28 @@ var _ error = (*ExitCodeError)(nil)
 func (e ExitCodeError) Error() string {
 	return fmt.Sprintf("exit code: %d", e)
 }
+
+// exitCodeWrapper is an error with the text of another error, that also contains an exit code.
+type exitCodeWrapper struct {
+	err  error
+	code ExitCodeError
+}
+
+// WithExitCode returns an error that has the same text as the provided error, but also contains the provided
+// exit code (so that it can be found using errors.As). If err is nil, nil is returned.
+func WithExitCode(err error, code ExitCodeError) error {
+	if err == nil {
+		return nil
+	}
+	return &exitCodeWrapper{err: err, code: code}
+}
+
+// Error returns the text of the wrapped error, satisfying the error interface.
+func (e *exitCodeWrapper) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the wrapped error and the exit code.
+func (e *exitCodeWrapper) Unwrap() []error {
+	return []error{e.err, e.code}
+}
@@ -1,16 +1,25 @@
 package cmd
 
 import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	cmderrors "github.com/provenance-io/provenance/cmd/errors"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
@@ -18,12 +27,62 @@ var (
 	cmdStart = fmt.Sprintf("%s metaaddress", version.AppName)
 )
 
+const (
+	// FlagName is the flag for providing candidate record names to the metaaddress decode command.
+	FlagName = "name"
+
+	// ExitCodeMetaAddressParse is the exit code of metaaddress commands when input cannot be parsed.
+	ExitCodeMetaAddressParse cmderrors.ExitCodeError = 2
+	// ExitCodeMetaAddressWrongType is the exit code of metaaddress commands when an address is the wrong type.
+	ExitCodeMetaAddressWrongType cmderrors.ExitCodeError = 3
+	// ExitCodeMetaAddressQuery is the exit code of metaaddress commands when a query to the node fails.
+	ExitCodeMetaAddressQuery cmderrors.ExitCodeError = 4
+)
+
+// metaAddressExitCodesHelp is the documentation of the exit codes used by the metaaddress commands.
+const metaAddressExitCodesHelp = `Exit codes:
+  0: Success.
+  2: An argument could not be parsed.
+  3: An address is not of the expected type.
+  4: A query to the node failed.`
+
+// withMetaAddressExitCode adds the appropriate exit code to the provided error without changing its text.
+// The exit code is determined using the metadata address sentinel errors, e.g. types.ErrAddressParse.
+// If the error already has an exit code, or doesn't map to one, it is returned unchanged.
+func withMetaAddressExitCode(err error) error {
+	if err == nil {
+		return nil
+	}
+	var exitCode cmderrors.ExitCodeError
+	if errors.As(err, &exitCode) {
+		return err
+	}
+	switch {
+	case errors.Is(err, types.ErrAddressWrongType):
+		return cmderrors.WithExitCode(err, ExitCodeMetaAddressWrongType)
+	case errors.Is(err, types.ErrAddressParse):
+		return cmderrors.WithExitCode(err, ExitCodeMetaAddressParse)
+	}
+	if _, isStatus := status.FromError(err); isStatus {
+		return cmderrors.WithExitCode(err, ExitCodeMetaAddressQuery)
+	}
+	return err
+}
+
+// metaAddressRunE wraps the provided RunE function so that any error it returns has the appropriate exit code.
+func metaAddressRunE(runE func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		return withMetaAddressExitCode(runE(cmd, args))
+	}
+}
+
 // GetQueryCmd is the top-level command for name CLI queries.
 func AddMetaAddressCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
 		Use:                        "metaaddress",
 		Aliases:                    []string{"ma"},
 		Short:                      "Decode/Encode Metaaddresses commands",
+		Long:                       "Decode/Encode Metaaddresses commands\n\n" + metaAddressExitCodesHelp,
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
@@ -32,6 +91,7 @@ func AddMetaAddressCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		AddMetaAddressEncoder(),
 		AddMetaAddressDecoder(),
+		AddMetaAddressDiffCmd(),
 	)
 
 	return queryCmd
@@ -43,14 +103,26 @@ func AddMetaAddressDecoder() *cobra.Command {
 		Use:     "decode [address]",
 		Aliases: []string{"d"},
 		Short:   "Decode MetadataAddress and display associate IDs and types",
-		Example: fmt.Sprintf("%[1]s decode scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel", cmdStart),
-		Args:    cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
+		Long: fmt.Sprintf(`Decode MetadataAddress and display associate IDs and types
+
+For record and record specification addresses, candidate names can be provided using --%[1]s.
+The name (if any) whose hash matches the address's name hash is then included in the output.
+
+%[2]s`, FlagName, metaAddressExitCodesHelp),
+		Example: fmt.Sprintf(`%[1]s decode scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s decode record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3 --%[2]s othername --%[2]s recordname`,
+			cmdStart, FlagName),
+		Args: cobra.ExactArgs(1),
+		RunE: metaAddressRunE(func(cmd *cobra.Command, args []string) error {
 			addr, parseErr := types.MetadataAddressFromBech32(args[0])
 			if parseErr != nil {
 				return parseErr
 			}
-			addrDetails := addr.GetDetails()
+			names, err := cmd.Flags().GetStringArray(FlagName)
+			if err != nil {
+				return err
+			}
+			addrDetails := addr.GetDetailsWithNames(names)
 			var toOut string
 			switch {
 			case addr.IsScopeAddress():
@@ -92,10 +164,18 @@ Name Hash (hex): %s
 Excess (hex): %s
 `, addrDetails.Prefix, addrDetails.PrimaryUUID, addrDetails.SecondaryUUID, addrDetails.NameHashHex, addrDetails.ExcessHex)
 			}
+			if len(names) > 0 && len(addrDetails.AddressNameHash) > 0 {
+				matchedName := addrDetails.MatchedName
+				if len(matchedName) == 0 {
+					matchedName = "<none>"
+				}
+				toOut += fmt.Sprintf("Matched Name: %s\n", matchedName)
+			}
 			_, cmdErr := fmt.Fprint(cmd.OutOrStdout(), toOut)
 			return cmdErr
-		},
+		}),
 	}
+	cmd.Flags().StringArray(FlagName, nil, "A candidate record name to check against the name hash (can be provided multiple times)")
 	return cmd
 }
 
@@ -116,7 +196,9 @@ The third [uuid|name] argument is either required or forbidden based on the type
 
 These types forbid a third argument: scope scope-specification contract-specification
 These types require a third argument: session record record-specification
-This type requires the third argument to be a UUID: session`, cmdStart),
+This type requires the third argument to be a UUID: session
+
+%[2]s`, cmdStart, metaAddressExitCodesHelp),
 		Example: fmt.Sprintf(`%[1]s encode scope 91978ba2-5f35-459a-86a7-feca1b0512e0
 %[1]s encode session 91978ba2-5f35-459a-86a7-feca1b0512e0 5803f8bc-6067-4eb5-951f-2121671c2ec0
 %[1]s encode record 91978ba2-5f35-459a-86a7-feca1b0512e0 recordname
@@ -124,11 +206,11 @@ This type requires the third argument to be a UUID: session`, cmdStart),
 %[1]s encode contract-specification def6bc0a-c9dd-4874-948f-5206e6060a84
 %[1]s encode record-specification def6bc0a-c9dd-4874-948f-5206e6060a84 recordname`, cmdStart),
 		Args: cobra.RangeArgs(2, 3),
-		RunE: func(cmd *cobra.Command, args []string) error {
+		RunE: metaAddressRunE(func(cmd *cobra.Command, args []string) error {
 			addrType := strings.ToLower(regexp.MustCompile("[^[:alpha:]]+").ReplaceAllString(args[0], ""))
 			primaryUUID, err := uuid.Parse(args[1])
 			if err != nil {
-				return err
+				return cmderrors.WithExitCode(err, ExitCodeMetaAddressParse)
 			}
 			var uuidOrNameArg string
 			argsLen := len(args)
@@ -142,44 +224,226 @@ This type requires the third argument to be a UUID: session`, cmdStart),
 			switch addrType {
 			case "scope":
 				if argsLen != 2 {
-					return fmt.Errorf("too many arguments for %s address encoder", addrType)
+					return cmderrors.WithExitCode(fmt.Errorf("too many arguments for %s address encoder", addrType), ExitCodeMetaAddressParse)
 				}
 				addr = types.ScopeMetadataAddress(primaryUUID)
 			case "session":
 				if argsLen != 3 {
-					return fmt.Errorf("not enough arguments for %s address encoder", addrType)
+					return cmderrors.WithExitCode(fmt.Errorf("not enough arguments for %s address encoder", addrType), ExitCodeMetaAddressParse)
 				}
 				secondaryUUID, err := uuid.Parse(uuidOrNameArg)
 				if err != nil {
-					return err
+					return cmderrors.WithExitCode(err, ExitCodeMetaAddressParse)
 				}
 				addr = types.SessionMetadataAddress(primaryUUID, secondaryUUID)
 			case "record":
 				if argsLen != 3 {
-					return fmt.Errorf("not enough arguments for %s address encoder", addrType)
+					return cmderrors.WithExitCode(fmt.Errorf("not enough arguments for %s address encoder", addrType), ExitCodeMetaAddressParse)
 				}
 				addr = types.RecordMetadataAddress(primaryUUID, uuidOrNameArg)
 			case "scopespecification", "scopespec":
 				if argsLen != 2 {
-					return fmt.Errorf("too many arguments for %s address encoder", "scope-specification")
+					return cmderrors.WithExitCode(fmt.Errorf("too many arguments for %s address encoder", "scope-specification"), ExitCodeMetaAddressParse)
 				}
 				addr = types.ScopeSpecMetadataAddress(primaryUUID)
 			case "contractspecification", "contractspec", "cspec":
 				if argsLen != 2 {
-					return fmt.Errorf("too many arguments for %s address encoder", "contract-specification")
+					return cmderrors.WithExitCode(fmt.Errorf("too many arguments for %s address encoder", "contract-specification"), ExitCodeMetaAddressParse)
 				}
 				addr = types.ContractSpecMetadataAddress(primaryUUID)
 			case "recordspecification", "recordspec", "recspec":
 				if argsLen != 3 {
-					return fmt.Errorf("not enough arguments for %s address encoder", "record-specification")
+					return cmderrors.WithExitCode(fmt.Errorf("not enough arguments for %s address encoder", "record-specification"), ExitCodeMetaAddressParse)
 				}
 				addr = types.RecordSpecMetadataAddress(primaryUUID, uuidOrNameArg)
 			default:
-				return fmt.Errorf("unknown type: %s, Supported types: scope session record scope-specification contract-specification record-specification", args[0])
+				return cmderrors.WithExitCode(fmt.Errorf("unknown type: %s, Supported types: scope session record scope-specification contract-specification record-specification", args[0]), ExitCodeMetaAddressParse)
 			}
 			_, cmdErr := fmt.Fprintf(cmd.OutOrStdout(), "%s\n", addr)
 			return cmdErr
-		},
+		}),
 	}
 	return cmd
 }
+
+// AddressDiffInvalidLine is a line of an address-diff input file that is not a valid metadata address.
+type AddressDiffInvalidLine struct {
+	// Line is the (1-based) line number in the file.
+	Line int `json:"line"`
+	// Value is the content of the line.
+	Value string `json:"value"`
+	// Error is the reason the line is not a valid metadata address.
+	Error string `json:"error"`
+}
+
+// AddressDiffResult is the result of the metaaddress address-diff command.
+type AddressDiffResult struct {
+	// OnlyInA are the addresses that are in the first file, but not the second.
+	OnlyInA []string `json:"only_in_a"`
+	// OnlyInB are the addresses that are in the second file, but not the first.
+	OnlyInB []string `json:"only_in_b"`
+	// InBoth are the addresses that are in both files.
+	InBoth []string `json:"in_both"`
+	// InvalidA are the lines of the first file that are not valid metadata addresses.
+	InvalidA []AddressDiffInvalidLine `json:"invalid_a"`
+	// InvalidB are the lines of the second file that are not valid metadata addresses.
+	InvalidB []AddressDiffInvalidLine `json:"invalid_b"`
+}
+
+// AddMetaAddressDiffCmd returns the metadata address set difference cobra Command.
+func AddMetaAddressDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "address-diff <fileA> <fileB>",
+		Aliases: []string{"diff"},
+		Short:   "Compare two files of metadata addresses",
+		Long: fmt.Sprintf(`Compare two files of metadata addresses.
+
+Each file should have one bech32 metadata address per line. Blank lines are ignored.
+Lines that are not valid metadata addresses are reported with their line numbers, but do not stop the comparison.
+Addresses are compared using their bytes, so the same address is matched regardless of how it's written.
+
+The output has the addresses that are only in fileA, only in fileB, and in both, with a count of each.
+
+%[1]s`, metaAddressExitCodesHelp),
+		Example: fmt.Sprintf(`%[1]s address-diff expected.txt touched.txt
+%[1]s address-diff expected.txt touched.txt --%[2]s json`, cmdStart, flags.FlagOutput),
+		Args: cobra.ExactArgs(2),
+		RunE: metaAddressRunE(func(cmd *cobra.Command, args []string) error {
+			output, err := cmd.Flags().GetString(flags.FlagOutput)
+			if err != nil {
+				return err
+			}
+			if output != OutputText && output != OutputJSON {
+				return cmderrors.WithExitCode(fmt.Errorf("invalid --%s value %q: must be either %q or %q",
+					flags.FlagOutput, output, OutputText, OutputJSON), ExitCodeMetaAddressParse)
+			}
+
+			addrsA, invalidA, err := readMetaAddressFile(args[0])
+			if err != nil {
+				return err
+			}
+			addrsB, invalidB, err := readMetaAddressFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			result := DiffMetaAddresses(addrsA, addrsB)
+			result.InvalidA = invalidA
+			result.InvalidB = invalidB
+
+			if output == OutputJSON {
+				bz, err := json.MarshalIndent(result, "", "  ")
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", bz)
+				return err
+			}
+
+			var sb strings.Builder
+			writeAddrs := func(header string, addrs []string) {
+				sb.WriteString(fmt.Sprintf("%s (%d):\n", header, len(addrs)))
+				for _, addr := range addrs {
+					sb.WriteString(fmt.Sprintf("  %s\n", addr))
+				}
+			}
+			writeInvalid := func(file string, invalid []AddressDiffInvalidLine) {
+				if len(invalid) == 0 {
+					return
+				}
+				sb.WriteString(fmt.Sprintf("Invalid lines in %s (%d):\n", file, len(invalid)))
+				for _, line := range invalid {
+					sb.WriteString(fmt.Sprintf("  line %d: %q: %s\n", line.Line, line.Value, line.Error))
+				}
+			}
+			writeAddrs("Only in "+args[0], result.OnlyInA)
+			writeAddrs("Only in "+args[1], result.OnlyInB)
+			writeAddrs("In both", result.InBoth)
+			writeInvalid(args[0], result.InvalidA)
+			writeInvalid(args[1], result.InvalidB)
+			_, err = fmt.Fprint(cmd.OutOrStdout(), sb.String())
+			return err
+		}),
+	}
+	cmd.Flags().StringP(flags.FlagOutput, "o", OutputText, fmt.Sprintf("Output format (%s|%s)", OutputText, OutputJSON))
+	return cmd
+}
+
+// readMetaAddressFile reads the newline-delimited bech32 metadata addresses from the provided file.
+// Blank lines are skipped, and lines that aren't valid metadata addresses are returned separately.
+func readMetaAddressFile(filename string) ([]types.MetadataAddress, []AddressDiffInvalidLine, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	var addrs []types.MetadataAddress
+	invalid := make([]AddressDiffInvalidLine, 0)
+	scanner := bufio.NewScanner(file)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 {
+			continue
+		}
+		addr, err := types.MetadataAddressFromBech32(line)
+		if err != nil {
+			invalid = append(invalid, AddressDiffInvalidLine{Line: lineNum, Value: line, Error: err.Error()})
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("error reading %s: %w", filename, err)
+	}
+	return addrs, invalid, nil
+}
+
+// DiffMetaAddresses compares two sets of metadata addresses using their bytes.
+// Duplicates are ignored, and each list in the result is ordered by address bytes.
+func DiffMetaAddresses(addrsA, addrsB []types.MetadataAddress) *AddressDiffResult {
+	inA := make(map[string]types.MetadataAddress, len(addrsA))
+	for _, addr := range addrsA {
+		inA[string(addr)] = addr
+	}
+	inB := make(map[string]types.MetadataAddress, len(addrsB))
+	for _, addr := range addrsB {
+		inB[string(addr)] = addr
+	}
+
+	var onlyA, onlyB, both []types.MetadataAddress
+	for key, addr := range inA {
+		if _, found := inB[key]; found {
+			both = append(both, addr)
+		} else {
+			onlyA = append(onlyA, addr)
+		}
+	}
+	for key, addr := range inB {
+		if _, found := inA[key]; !found {
+			onlyB = append(onlyB, addr)
+		}
+	}
+
+	return &AddressDiffResult{
+		OnlyInA:  sortedAddrStrings(onlyA),
+		OnlyInB:  sortedAddrStrings(onlyB),
+		InBoth:   sortedAddrStrings(both),
+		InvalidA: make([]AddressDiffInvalidLine, 0),
+		InvalidB: make([]AddressDiffInvalidLine, 0),
+	}
+}
+
+// sortedAddrStrings sorts the provided addresses by their bytes and returns their bech32 strings.
+func sortedAddrStrings(addrs []types.MetadataAddress) []string {
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i], addrs[j]) < 0
+	})
+	rv := make([]string, len(addrs))
+	for i, addr := range addrs {
+		rv[i] = addr.String()
+	}
+	return rv
+}
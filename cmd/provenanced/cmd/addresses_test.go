@@ -2,17 +2,29 @@ package cmd_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	cmderrors "github.com/provenance-io/provenance/cmd/errors"
 	"github.com/provenance-io/provenance/cmd/provenanced/cmd"
+	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
 type MetaaddressTestSuite struct {
@@ -71,13 +83,12 @@ func TestMetaaddressTestSuite(t *testing.T) {
 }
 
 func (s *MetaaddressTestSuite) TestAddMetaAddressDecoder() {
-	command := cmd.AddMetaAddressDecoder()
-
 	tests := []struct {
-		name     string
-		args     []string
-		inResult []string
-		err      string
+		name        string
+		args        []string
+		inResult    []string
+		notInResult []string
+		err         string
 	}{
 		{
 			name: "valid scope",
@@ -133,6 +144,43 @@ func (s *MetaaddressTestSuite) TestAddMetaAddressDecoder() {
 				fmt.Sprintf("Name Hash (hex): %s", s.recordNameHashedHex),
 			},
 		},
+		{
+			name: "record with matching name",
+			args: []string{s.recordIDStr, "--name", "othername", "--name", "  RecordName "},
+			inResult: []string{
+				"Type: Record",
+				fmt.Sprintf("Name Hash (hex): %s", s.recordNameHashedHex),
+				"Matched Name:   RecordName \n",
+			},
+		},
+		{
+			name: "record with no matching names",
+			args: []string{s.recordIDStr, "--name", "othername"},
+			inResult: []string{
+				"Type: Record",
+				"Matched Name: <none>",
+			},
+		},
+		{
+			name:        "record without names",
+			args:        []string{s.recordIDStr},
+			inResult:    []string{"Type: Record"},
+			notInResult: []string{"Matched Name"},
+		},
+		{
+			name: "record specification with matching name",
+			args: []string{s.recordSpecIDStr, "--name", s.recordName},
+			inResult: []string{
+				"Type: Record Specification",
+				fmt.Sprintf("Matched Name: %s", s.recordName),
+			},
+		},
+		{
+			name:        "scope with name",
+			args:        []string{s.scopeIDStr, "--name", s.recordName},
+			inResult:    []string{"Type: Scope"},
+			notInResult: []string{"Matched Name"},
+		},
 		{
 			name: "no args",
 			args: []string{},
@@ -152,6 +200,7 @@ func (s *MetaaddressTestSuite) TestAddMetaAddressDecoder() {
 
 	for _, tc := range tests {
 		s.T().Run(tc.name, func(t *testing.T) {
+			command := cmd.AddMetaAddressDecoder()
 			command.SetArgs(tc.args)
 			b := bytes.NewBufferString("")
 			command.SetOut(b)
@@ -167,6 +216,9 @@ func (s *MetaaddressTestSuite) TestAddMetaAddressDecoder() {
 				for _, str := range tc.inResult {
 					assert.Containsf(t, outStr, str, "%s - expected value to be in output", command.Name())
 				}
+				for _, str := range tc.notInResult {
+					assert.NotContainsf(t, outStr, str, "%s - unexpected value in output", command.Name())
+				}
 			}
 		})
 	}
@@ -386,3 +438,199 @@ func (s *MetaaddressTestSuite) TestAddMetaAddressEncoder() {
 		})
 	}
 }
+
+func (s *MetaaddressTestSuite) TestMetaAddressExitCodes() {
+	// A scope address that has the session bech32 prefix.
+	wrongPrefix, err := bech32.ConvertAndEncode(types.PrefixSession, types.ScopeMetadataAddress(s.scopeUUID))
+	s.Require().NoError(err, "ConvertAndEncode")
+
+	tests := []struct {
+		name    string
+		args    []string
+		expErr  string
+		expCode int
+	}{
+		{
+			name: "decode: success",
+			args: []string{"decode", s.scopeIDStr},
+		},
+		{
+			name:    "decode: bad checksum",
+			args:    []string{"decode", s.scopeIDStr[:len(s.scopeIDStr)-1] + "q"},
+			expErr:  "decoding bech32 failed: invalid checksum (expected xlkwel got xlkweq)",
+			expCode: 2,
+		},
+		{
+			name:    "decode: not bech32",
+			args:    []string{"decode", "not an address"},
+			expErr:  "decoding bech32 failed: invalid character in string: ' '",
+			expCode: 2,
+		},
+		{
+			name:    "decode: wrong type",
+			args:    []string{"decode", wrongPrefix},
+			expErr:  "invalid bech32 prefix; expected scope, got session",
+			expCode: 3,
+		},
+		{
+			name: "encode: success",
+			args: []string{"encode", "scope", s.scopeUUIDStr},
+		},
+		{
+			name:    "encode: bad uuid",
+			args:    []string{"encode", "scope", "not-a-uuid"},
+			expErr:  "invalid UUID length: 10",
+			expCode: 2,
+		},
+		{
+			name:    "encode: unknown type",
+			args:    []string{"encode", "nope", s.scopeUUIDStr},
+			expErr:  "unknown type: nope, Supported types: scope session record scope-specification contract-specification record-specification",
+			expCode: 2,
+		},
+		{
+			name:    "encode: too many args",
+			args:    []string{"encode", "scope", s.scopeUUIDStr, "extra"},
+			expErr:  "too many arguments for scope address encoder",
+			expCode: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			command := cmd.AddMetaAddressCmd()
+			command.SetArgs(tc.args)
+			command.SetOut(io.Discard)
+			command.SetErr(io.Discard)
+			err := command.ExecuteContext(context.Background())
+			if len(tc.expErr) == 0 {
+				s.Require().NoError(err, "ExecuteContext(%q)", tc.args)
+				return
+			}
+			s.Require().EqualError(err, tc.expErr, "ExecuteContext(%q) error", tc.args)
+			var exitCode cmderrors.ExitCodeError
+			s.Require().True(errors.As(err, &exitCode), "errors.As(err, ExitCodeError)")
+			s.Assert().Equal(tc.expCode, int(exitCode), "exit code")
+		})
+	}
+}
+
+func (s *MetaaddressTestSuite) TestWithMetaAddressExitCode() {
+	tests := []struct {
+		name    string
+		err     error
+		expCode int
+	}{
+		{name: "nil", err: nil},
+		{name: "unrelated error", err: errors.New("something else")},
+		{name: "parse error", err: fmt.Errorf("outer: %w", types.ErrAddressParse), expCode: 2},
+		{name: "wrong type error", err: fmt.Errorf("outer: %w", types.ErrAddressWrongType), expCode: 3},
+		{name: "query error", err: status.Error(codes.Unavailable, "node is down"), expCode: 4},
+		{name: "existing exit code", err: cmderrors.WithExitCode(types.ErrAddressParse, 7), expCode: 7},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := cmd.WithMetaAddressExitCode(tc.err)
+			if tc.err == nil {
+				s.Require().NoError(err, "withMetaAddressExitCode(nil)")
+				return
+			}
+			s.Require().EqualError(err, tc.err.Error(), "withMetaAddressExitCode error text")
+			var exitCode cmderrors.ExitCodeError
+			hasCode := errors.As(err, &exitCode)
+			s.Assert().Equal(tc.expCode != 0, hasCode, "errors.As(err, ExitCodeError)")
+			s.Assert().Equal(tc.expCode, int(exitCode), "exit code")
+		})
+	}
+}
+
+func (s *MetaaddressTestSuite) TestAddMetaAddressDiffCmd() {
+	dir := s.T().TempDir()
+	writeFile := func(name string, lines ...string) string {
+		filename := filepath.Join(dir, name)
+		s.Require().NoError(os.WriteFile(filename, []byte(strings.Join(lines, "\n")+"\n"), 0o644), "WriteFile(%q)", name)
+		return filename
+	}
+	fileA := writeFile("a.txt", s.scopeIDStr, s.sessionIDStr, "not an address", "", s.recordIDStr, s.scopeIDStr)
+	fileB := writeFile("b.txt", "  "+s.recordIDStr+"  ", s.scopeSpecIDStr, s.sessionIDStr, s.scopeIDStr+"bad")
+	missing := filepath.Join(dir, "missing.txt")
+
+	// The expected lists are ordered by address bytes, so they're ordered by type.
+	expResult := cmd.AddressDiffResult{
+		OnlyInA:  []string{s.scopeIDStr},
+		OnlyInB:  []string{s.scopeSpecIDStr},
+		InBoth:   []string{s.sessionIDStr, s.recordIDStr},
+		InvalidA: []cmd.AddressDiffInvalidLine{{Line: 3, Value: "not an address", Error: "decoding bech32 failed: invalid character in string: ' '"}},
+		InvalidB: []cmd.AddressDiffInvalidLine{{Line: 4, Value: s.scopeIDStr + "bad", Error: "decoding bech32 failed: invalid character not part of charset: 98"}},
+	}
+
+	s.Run("text output", func() {
+		command := cmd.AddMetaAddressDiffCmd()
+		command.SetArgs([]string{fileA, fileB})
+		var out bytes.Buffer
+		command.SetOut(&out)
+		command.SetErr(&out)
+		s.Require().NoError(command.Execute(), "Execute")
+		expOut := fmt.Sprintf(`Only in %[1]s (1):
+  %[3]s
+Only in %[2]s (1):
+  %[4]s
+In both (2):
+  %[5]s
+  %[6]s
+Invalid lines in %[1]s (1):
+  line 3: "not an address": decoding bech32 failed: invalid character in string: ' '
+Invalid lines in %[2]s (1):
+  line 4: "%[3]sbad": decoding bech32 failed: invalid character not part of charset: 98
+`, fileA, fileB, s.scopeIDStr, s.scopeSpecIDStr, s.sessionIDStr, s.recordIDStr)
+		s.Assert().Equal(expOut, out.String(), "output")
+	})
+
+	s.Run("json output", func() {
+		command := cmd.AddMetaAddressDiffCmd()
+		command.SetArgs([]string{fileA, fileB, "--output", "json"})
+		var out bytes.Buffer
+		command.SetOut(&out)
+		command.SetErr(&out)
+		s.Require().NoError(command.Execute(), "Execute")
+		var result cmd.AddressDiffResult
+		s.Require().NoError(json.Unmarshal(out.Bytes(), &result), "Unmarshal output:\n%s", out.String())
+		s.Assert().Equal(expResult, result, "result")
+	})
+
+	s.Run("same file", func() {
+		command := cmd.AddMetaAddressDiffCmd()
+		command.SetArgs([]string{fileA, fileA, "-o", "json"})
+		var out bytes.Buffer
+		command.SetOut(&out)
+		command.SetErr(&out)
+		s.Require().NoError(command.Execute(), "Execute")
+		var result cmd.AddressDiffResult
+		s.Require().NoError(json.Unmarshal(out.Bytes(), &result), "Unmarshal output:\n%s", out.String())
+		s.Assert().Empty(result.OnlyInA, "only in a")
+		s.Assert().Empty(result.OnlyInB, "only in b")
+		s.Assert().Equal([]string{s.scopeIDStr, s.sessionIDStr, s.recordIDStr}, result.InBoth, "in both")
+	})
+
+	s.Run("invalid output", func() {
+		command := cmd.AddMetaAddressDiffCmd()
+		command.SetArgs([]string{fileA, fileB, "--output", "yaml"})
+		command.SetOut(io.Discard)
+		command.SetErr(io.Discard)
+		err := command.Execute()
+		s.Require().EqualError(err, `invalid --output value "yaml": must be either "text" or "json"`, "Execute error")
+		var exitCode cmderrors.ExitCodeError
+		s.Require().True(errors.As(err, &exitCode), "errors.As(err, ExitCodeError)")
+		s.Assert().Equal(2, int(exitCode), "exit code")
+	})
+
+	s.Run("missing file", func() {
+		command := cmd.AddMetaAddressDiffCmd()
+		command.SetArgs([]string{fileA, missing})
+		command.SetOut(io.Discard)
+		command.SetErr(io.Discard)
+		err := command.Execute()
+		s.Require().ErrorContains(err, "missing.txt", "Execute error")
+	})
+}
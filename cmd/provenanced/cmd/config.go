@@ -1,8 +1,15 @@
 package cmd
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -13,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 
 	provconfig "github.com/provenance-io/provenance/cmd/provenanced/config"
+	"github.com/provenance-io/provenance/internal/preflight"
 )
 
 const (
@@ -23,6 +31,45 @@ const (
 	addedLeadUpdated = "Updated"
 	// addedLeadChanged is an added lead for a header to indicate that the section represents values different from their defaults.
 	addedLeadChanged = "Differences from Defaults"
+	// addedLeadDiff is an added lead for a header to indicate that the section represents values different from another config.
+	addedLeadDiff = "Differences from Other"
+	// addedLeadEnv is an added lead for a header to indicate that the section has environment variable info.
+	addedLeadEnv = "Environment Variables"
+	// addedLeadValidation is an added lead for a header to indicate that the section has validation results.
+	addedLeadValidation = "Validation"
+
+	// FlagWait is the flag for waiting for another config operation to finish instead of failing.
+	FlagWait = "wait"
+	// FlagFromFile is the flag for providing a file of key/value entries to the config set command.
+	FlagFromFile = "from-file"
+	// FlagWrite is the flag for providing a file to write the changed values to in the config changed command.
+	FlagWrite = "write"
+	// FlagForce is the flag for allowing the config changed command to overwrite an existing --write file.
+	FlagForce = "force"
+	// FlagYes is the flag for confirming that all config values should be reset by the config reset command.
+	FlagYes = "yes"
+	// FlagOverridePinned is the flag for allowing the config set and reset commands to modify pinned keys.
+	FlagOverridePinned = "override-pinned"
+	// FlagDryRun is the flag for outputting the packed config without changing any files in the config pack command.
+	FlagDryRun = "dry-run"
+	// FlagOnly is the flag for limiting the config pack and unpack commands to some of the configs.
+	FlagOnly = "only"
+	// FlagExport is the flag for outputting export lines of the non-default values in the config env command.
+	FlagExport = "export"
+	// FlagVerbose is the flag for including when a change to each value takes effect in the config get command.
+	FlagVerbose = "verbose"
+
+	// FlagNoColor is the flag for turning off colorized output in the config commands.
+	FlagNoColor = "no-color"
+	// EnvNoColor is the environment variable that, when set to anything, turns off colorized output.
+	// See https://no-color.org/
+	EnvNoColor = "NO_COLOR"
+
+	// ANSI escape codes used to colorize output.
+	ansiReset = "\x1b[0m"
+	ansiBold  = "\x1b[1m"
+	ansiRed   = "\x1b[31m"
+	ansiGreen = "\x1b[32m"
 )
 
 var configCmdStart = fmt.Sprintf("%s config", version.AppName)
@@ -39,11 +86,20 @@ func ConfigCmd() *cobra.Command {
 	cmd.AddCommand(
 		ConfigGetCmd(),
 		ConfigSetCmd(),
+		ConfigResetCmd(),
+		ConfigPinCmd(),
+		ConfigUnpinCmd(),
+		ConfigAliasCmd(),
 		ConfigChangedCmd(),
+		ConfigDiffCmd(),
+		ConfigEnvCmd(),
 		ConfigHomeCmd(),
 		ConfigPackCmd(),
 		ConfigUnpackCmd(),
+		ConfigCheckStartCmd(),
+		ConfigValidateCmd(),
 	)
+	cmd.PersistentFlags().Bool(FlagNoColor, false, fmt.Sprintf("Do not colorize output (also disabled by setting %s or when output is not a terminal)", EnvNoColor))
 	return cmd
 }
 
@@ -58,6 +114,9 @@ func ConfigGetCmd() *cobra.Command {
         e.g. %[1]s get telemetry.service-name moniker.
     Or they can be parent field names
         e.g. %[1]s get api consensus
+    Or they can be glob patterns (containing a * or ?) that are matched against the full key names.
+        A * or ? will not match a period, e.g. 'p2p.*' will not include 'p2p.foo.bar'.
+        e.g. %[1]s get 'api.*' 'p2p.*-peers'
     Or they can be a type of config file:
         "cosmos", "app" -> %[2]s configuration values.
             e.g. %[1]s get app
@@ -71,25 +130,40 @@ func ConfigGetCmd() *cobra.Command {
 
     Displayed values will reflect settings defined through environment variables.
 
-`, configCmdStart, provconfig.AppConfFilename, provconfig.CmtConfFilename, provconfig.ClientConfFilename),
+    With --%[5]s, each value is followed by when a change to it takes effect:
+        "restart" -> the node must be restarted.
+        "next-block" -> it is used starting with the next block.
+        "hot" -> it is used immediately, e.g. by the next command run.
+        e.g. %[1]s get telemetry.enabled --%[5]s
+
+`, configCmdStart, provconfig.AppConfFilename, provconfig.CmtConfFilename, provconfig.ClientConfFilename, FlagVerbose),
 		Example: fmt.Sprintf(`$ %[1]s get telemetry.service-name moniker \
 $ %[1]s get api consensus \
+$ %[1]s get 'api.*' 'p2p.*-peers' \
 $ %[1]s get app \
 $ %[1]s get cmt \
 $ %[1]s get client \
 $ %[1]s get all \
-			`, configCmdStart),
+$ %[1]s get telemetry --%[2]s \
+			`, configCmdStart, FlagVerbose),
+		ValidArgsFunction: completeConfigKeys,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := runConfigGetCmd(cmd, args)
+			out, err := newConfigOutput(cmd)
+			if err != nil {
+				return err
+			}
+			err = runConfigGetCmd(cmd, out, args)
 			// Note: If a RunE returns an error, the usage information is displayed.
 			//       That ends up being kind of annoying with this command.
 			//       So just output the error and still return nil.
 			if err != nil {
-				cmd.Printf("Error: %v\n", err)
+				out.PrintError(err)
 			}
 			return nil
 		},
 	}
+	addOutputFlag(cmd)
+	cmd.Flags().BoolP(FlagVerbose, "v", false, "Include when a change to each value takes effect")
 	return cmd
 }
 
@@ -105,16 +179,189 @@ Set a config value: %[1]s set <key> <value>
     The value must be provided as a single, separate argument.
     e.g. %[1]s set output json
 
+Set a config value using key=value: %[1]s set <key>=<value>
+    The key and value are provided as a single argument, separated by the first "=".
+    e.g. %[1]s set output=json
+
 Set multiple config values %[1]s set <key1> <value1> [<key2> <value2> ...]
     Simply provide multiple key/value pairs as alternating arguments.
     e.g. %[1]s set api.enable true api.swagger true
+    The key=value form can be mixed in with the pairs.
+    e.g. %[1]s set api.enable=true api.swagger true
+    A value that contains a "=" can be provided as the second argument of a pair.
+    But if that value starts with another config key followed by a "=", it is ambiguous and an error is returned.
+    In that case, use the key=value form for it instead, e.g. %[1]s set moniker=output=json
 
-`, configCmdStart),
+Set config values from a file: %[1]s set --%[2]s <file>
+    The file must be TOML (.toml) or JSON (.json) containing the keys and values to set.
+    The keys can either be full keys, e.g. "api.enable", or nested in their sections, e.g. "[api]" then "enable".
+    Any key/value arguments are applied after the file's entries.
+
+Pinned keys (see %[1]s pin) cannot be set unless the --%[3]s flag is provided.
+
+All values are validated before anything is saved. If there are any issues, no values are updated.
+
+`, configCmdStart, FlagFromFile, FlagOverridePinned),
 		Example: fmt.Sprintf(`$ %[1]s set output json \
-$ %[1]s set api.enable true api.swagger true
+$ %[1]s set api.enable true api.swagger true \
+$ %[1]s set api.enable=true api.swagger=true \
+$ %[1]s set --%[2]s overrides.toml
+`, configCmdStart, FlagFromFile),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out, err := newConfigOutput(cmd)
+			if err != nil {
+				return err
+			}
+			var showHelp bool
+			err = withConfigLock(cmd, func() error {
+				var runErr error
+				showHelp, runErr = runConfigSetCmd(cmd, out, args)
+				return runErr
+			})
+			// Note: If a RunE returns an error, the usage information is displayed.
+			//       That ends up being kind of annoying in most cases in here.
+			//       So only return the error when extra help is desired.
+			if err != nil {
+				if showHelp {
+					return err
+				}
+				out.PrintError(err)
+			}
+			return nil
+		},
+	}
+	addOutputFlag(cmd)
+	addWaitFlag(cmd)
+	cmd.Flags().String(FlagFromFile, "", "A TOML or JSON file of keys and values to set")
+	addOverridePinnedFlag(cmd)
+	return cmd
+}
+
+// ConfigResetCmd returns a CLI command to reset config values to their defaults.
+func ConfigResetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset <key1> [<key2> ...]",
+		Short: "Reset configuration values to their defaults",
+		Long: fmt.Sprintf(`Reset configuration values to their defaults.
+
+    The keys are identified the same way as with %[1]s get.
+    The key values can be specific.
+        e.g. %[1]s reset telemetry.service-name moniker
+    Or they can be parent field names
+        e.g. %[1]s reset api consensus
+    Or they can be glob patterns (containing a * or ?) that are matched against the full key names.
+        e.g. %[1]s reset 'p2p.*-peers'
+    Or they can be a type of config file:
+        "cosmos", "app" -> %[2]s configuration values.
+        "cometbft", "comet", "cmt", "config" -> %[3]s configuration values.
+        "client" -> %[4]s configuration values.
+    Or they can be the word "all" to reset all configuration values. This also requires the --%[5]s flag.
+        e.g. %[1]s reset all --%[5]s
+
+    Values that are already their defaults are listed, but are not changed.
+
+Pinned keys (see %[1]s pin) cannot be reset unless the --%[6]s flag is provided.
+
+All values are validated before anything is saved. If there are any issues, no values are updated.
+
+`, configCmdStart, provconfig.AppConfFilename, provconfig.CmtConfFilename, provconfig.ClientConfFilename, FlagYes, FlagOverridePinned),
+		Example: fmt.Sprintf(`$ %[1]s reset telemetry.service-name moniker \
+$ %[1]s reset api consensus \
+$ %[1]s reset 'p2p.*-peers' \
+$ %[1]s reset client \
+$ %[1]s reset all --%[2]s
+`, configCmdStart, FlagYes),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out, err := newConfigOutput(cmd)
+			if err != nil {
+				return err
+			}
+			var showHelp bool
+			err = withConfigLock(cmd, func() error {
+				var runErr error
+				showHelp, runErr = runConfigResetCmd(cmd, out, args)
+				return runErr
+			})
+			// Note: If a RunE returns an error, the usage information is displayed.
+			//       That ends up being kind of annoying in most cases in here.
+			//       So only return the error when extra help is desired.
+			if err != nil {
+				if showHelp {
+					return err
+				}
+				out.PrintError(err)
+			}
+			return nil
+		},
+	}
+	addOutputFlag(cmd)
+	addWaitFlag(cmd)
+	cmd.Flags().Bool(FlagYes, false, "Confirm that all configuration values should be reset (required for \"all\")")
+	addOverridePinnedFlag(cmd)
+	return cmd
+}
+
+// ConfigPinCmd returns a CLI command to protect config values from modification.
+func ConfigPinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pin [<key1> [<key2> ...]]",
+		Short: "Protect configuration values from being modified",
+		Long: fmt.Sprintf(`Protect configuration values from being modified.
+
+Pinned keys cannot be changed by %[1]s set or %[1]s reset unless the --%[2]s flag is provided.
+The keys must be specific, e.g. "chain-id", or "minimum-gas-prices".
+If no keys are provided, the currently pinned keys are listed.
+
+The pinned keys are stored in the %[3]s file in the config directory.
+Use %[1]s unpin to remove keys from the list.
+
+`, configCmdStart, FlagOverridePinned, provconfig.PinnedKeysFilename),
+		Example: fmt.Sprintf(`$ %[1]s pin chain-id minimum-gas-prices \
+$ %[1]s pin
+`, configCmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out, err := newConfigOutput(cmd)
+			if err != nil {
+				return err
+			}
+			err = withConfigLock(cmd, func() error {
+				return runConfigPinCmd(cmd, out, args)
+			})
+			if err != nil {
+				out.PrintError(err)
+			}
+			return nil
+		},
+	}
+	addOutputFlag(cmd)
+	addWaitFlag(cmd)
+	return cmd
+}
+
+// ConfigUnpinCmd returns a CLI command to stop protecting config values from modification.
+func ConfigUnpinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpin <key1> [<key2> ...]",
+		Short: "Stop protecting configuration values from being modified",
+		Long: fmt.Sprintf(`Stop protecting configuration values from being modified.
+
+The keys are removed from the list of pinned keys (see %[1]s pin).
+Keys that are not pinned are listed, but are otherwise ignored.
+
+`, configCmdStart),
+		Example: fmt.Sprintf(`$ %[1]s unpin chain-id minimum-gas-prices
 `, configCmdStart),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			showHelp, err := runConfigSetCmd(cmd, args)
+			out, err := newConfigOutput(cmd)
+			if err != nil {
+				return err
+			}
+			var showHelp bool
+			err = withConfigLock(cmd, func() error {
+				var runErr error
+				showHelp, runErr = runConfigUnpinCmd(cmd, out, args)
+				return runErr
+			})
 			// Note: If a RunE returns an error, the usage information is displayed.
 			//       That ends up being kind of annoying in most cases in here.
 			//       So only return the error when extra help is desired.
@@ -122,11 +369,137 @@ $ %[1]s set api.enable true api.swagger true
 				if showHelp {
 					return err
 				}
-				cmd.Printf("Error: %v\n", err)
+				out.PrintError(err)
+			}
+			return nil
+		},
+	}
+	addOutputFlag(cmd)
+	addWaitFlag(cmd)
+	return cmd
+}
+
+// ConfigAliasCmd returns a CLI command to manage aliases for groups of config keys.
+func ConfigAliasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alias",
+		Short: "Manage aliases for groups of configuration keys",
+		Long: fmt.Sprintf(`Manage aliases for groups of configuration keys.
+
+A key alias (e.g. "@perf") can be provided in place of keys to %[1]s get, %[1]s changed, and %[1]s reset.
+It is expanded to the keys it was defined with, and the expansion is included in the output.
+Key aliases cannot be used with %[1]s set.
+
+The key aliases are stored in the %[2]s file in the config directory.
+
+`, configCmdStart, provconfig.KeyAliasesFilename),
+		Example: fmt.Sprintf(`$ %[1]s alias add perf mempool.size consensus.timeout_commit 'p2p.*-peers' \
+$ %[1]s get @perf \
+$ %[1]s alias list \
+$ %[1]s alias remove perf
+`, configCmdStart),
+		RunE: client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		ConfigAliasAddCmd(),
+		ConfigAliasRemoveCmd(),
+		ConfigAliasListCmd(),
+	)
+	return cmd
+}
+
+// ConfigAliasAddCmd returns a CLI command to define (or redefine) a key alias.
+func ConfigAliasAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <name> <key1> [<key2> ...]",
+		Short: "Define an alias for a group of configuration keys",
+		Long: fmt.Sprintf(`Define an alias for a group of configuration keys.
+
+The name can be provided with or without the leading %[2]q.
+It must start with a lowercase letter and only contain lowercase letters, digits, dashes, and underscores.
+The keys can be specific keys, parent field names, or glob patterns (see %[1]s get), but not other aliases.
+If the alias already exists, it is replaced.
+
+`, configCmdStart, provconfig.KeyAliasPrefix),
+		Example: fmt.Sprintf(`$ %[1]s alias add perf mempool.size consensus.timeout_commit \
+$ %[1]s alias add @net 'p2p.*-peers' rpc.laddr
+`, configCmdStart),
+		Args:              cobra.MinimumNArgs(2),
+		ValidArgsFunction: completeConfigKeys,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out, err := newConfigOutput(cmd)
+			if err != nil {
+				return err
+			}
+			err = withConfigLock(cmd, func() error {
+				return runConfigAliasAddCmd(cmd, out, args)
+			})
+			if err != nil {
+				out.PrintError(err)
+			}
+			return nil
+		},
+	}
+	addOutputFlag(cmd)
+	addWaitFlag(cmd)
+	return cmd
+}
+
+// ConfigAliasRemoveCmd returns a CLI command to remove key aliases.
+func ConfigAliasRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove <name1> [<name2> ...]",
+		Aliases: []string{"rm"},
+		Short:   "Remove aliases for groups of configuration keys",
+		Long: `Remove aliases for groups of configuration keys.
+
+The names can be provided with or without the leading "@".
+Names that are not defined are listed, but are otherwise ignored.
+
+`,
+		Example: fmt.Sprintf(`$ %[1]s alias remove perf @net
+`, configCmdStart),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out, err := newConfigOutput(cmd)
+			if err != nil {
+				return err
+			}
+			err = withConfigLock(cmd, func() error {
+				return runConfigAliasRemoveCmd(cmd, out, args)
+			})
+			if err != nil {
+				out.PrintError(err)
+			}
+			return nil
+		},
+	}
+	addOutputFlag(cmd)
+	addWaitFlag(cmd)
+	return cmd
+}
+
+// ConfigAliasListCmd returns a CLI command to list the key aliases.
+func ConfigAliasListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the aliases for groups of configuration keys",
+		Example: fmt.Sprintf(`$ %[1]s alias list
+`, configCmdStart),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			out, err := newConfigOutput(cmd)
+			if err != nil {
+				return err
+			}
+			if err = runConfigAliasListCmd(cmd, out); err != nil {
+				out.PrintError(err)
 			}
 			return nil
 		},
 	}
+	addOutputFlag(cmd)
 	return cmd
 }
 
@@ -144,6 +517,9 @@ Get just the configuration entries that are not default values: %[1]s changed [<
             but they will be noted as such.
     Or they can be parent field names
         e.g. %[1]s get api consensus
+    Or they can be glob patterns (containing a * or ?) that are matched against the full key names.
+        A * or ? will not match a period, e.g. 'p2p.*' will not include 'p2p.foo.bar'.
+        e.g. %[1]s get 'api.*' 'p2p.*-peers'
     Or they can be a type of config file:
         "cosmos", "app" -> %[2]s configuration values.
             e.g. %[1]s get app
@@ -158,20 +534,134 @@ Get just the configuration entries that are not default values: %[1]s changed [<
 
     Displayed values will reflect settings defined through environment variables.
 
-`, configCmdStart, provconfig.AppConfFilename, provconfig.CmtConfFilename, provconfig.ClientConfFilename),
+Use --%[5]s <path> to also write the changed values to a file in the same format as %[6]s.
+    Only values that differ from their defaults are written (including those from environment variables).
+    That file can be copied to the config directory of another node as %[6]s, then unpacked there.
+    An existing file is not overwritten unless --%[7]s is also provided.
+
+`, configCmdStart, provconfig.AppConfFilename, provconfig.CmtConfFilename, provconfig.ClientConfFilename,
+			FlagWrite, provconfig.PackedConfFilename, FlagForce),
 		Example: fmt.Sprintf(`$ %[1]s changed \
-$ %[1]s changed telemetry.service-name`, configCmdStart),
+$ %[1]s changed telemetry.service-name \
+$ %[1]s changed --%[2]s /path/to/%[3]s`, configCmdStart, FlagWrite, provconfig.PackedConfFilename),
+		ValidArgsFunction: completeConfigKeys,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out, err := newConfigOutput(cmd)
+			if err != nil {
+				return err
+			}
+			err = runConfigChangedCmd(cmd, out, args)
+			// Note: If a RunE returns an error, the usage information is displayed.
+			//       That ends up being kind of annoying with this command.
+			//       So just output the error and still return nil.
+			if err != nil {
+				out.PrintError(err)
+			}
+			return nil
+		},
+	}
+	addOutputFlag(cmd)
+	cmd.Flags().String(FlagWrite, "", "A file to write the changed values to (in packed config format)")
+	cmd.Flags().Bool(FlagForce, false, "Allow the --"+FlagWrite+" file to be overwritten if it already exists")
+	return cmd
+}
+
+// ConfigDiffCmd returns a CLI command to compare the config against another config.
+func ConfigDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff <other> [<section1> [<section2> ...]]",
+		Short: "Get configuration values that are different from those of another config",
+		Long: fmt.Sprintf(`Get configuration values that are different from those of another config.
+
+The <other> config can be either another home directory or a specific config file.
+    A home directory can have either a packed or unpacked config.
+        e.g. %[1]s diff /path/to/other/home
+    A file with a .json extension is treated as a packed config file.
+        e.g. %[1]s diff /path/to/%[2]s
+    An unpacked config file is identified by the end of its name (e.g. "mainnet-%[3]s").
+    When it is an unpacked config file, only that config is compared.
+        e.g. %[1]s diff /path/to/%[3]s
+
+The comparison can be restricted using section names:
+    "cosmos", "app" -> %[3]s configuration values.
+    "cometbft", "comet", "cmt", "config" -> %[4]s configuration values.
+    "client" -> %[5]s configuration values.
+    "all" -> all configuration values.
+If no sections are provided, all values are compared.
+
+Entries that are only in one of the configs (e.g. when comparing against a config from a different version)
+are listed separately from the entries with different values.
+
+Displayed current values will reflect settings defined through environment variables.
+Environment variables are not applied to the other config.
+
+`, configCmdStart, provconfig.PackedConfFilename, provconfig.AppConfFilename, provconfig.CmtConfFilename, provconfig.ClientConfFilename),
+		Example: fmt.Sprintf(`$ %[1]s diff /path/to/other/home \
+$ %[1]s diff /path/to/other/home/config/%[2]s app \
+$ %[1]s diff /path/to/mainnet-%[3]s`, configCmdStart, provconfig.PackedConfFilename, provconfig.AppConfFilename),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out, err := newConfigOutput(cmd)
+			if err != nil {
+				return err
+			}
+			err = runConfigDiffCmd(cmd, out, args)
+			// Note: If a RunE returns an error, the usage information is displayed.
+			//       That ends up being kind of annoying with this command.
+			//       So just output the error and still return nil.
+			if err != nil {
+				out.PrintError(err)
+			}
+			return nil
+		},
+	}
+	addOutputFlag(cmd)
+	return cmd
+}
+
+// ConfigEnvCmd returns a CLI command to get the environment variables that can be used to define config values.
+func ConfigEnvCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "env [<key1> [<key2> ...]]",
+		Short: "Get the environment variables that can be used to define configuration values",
+		Long: fmt.Sprintf(`Get the environment variables that can be used to define configuration values.
+
+For each config key, this outputs the name of the environment variable that will be used for it,
+the current value, and where that value is coming from (%[2]q, %[3]q, or %[4]q).
+An environment variable takes precedence over the config files.
+
+The keys are identified the same way as with %[1]s get.
+    e.g. %[1]s env telemetry.service-name moniker
+    e.g. %[1]s env api 'p2p.*_peers'
+    e.g. %[1]s env cmt
+If no keys are provided, all configuration values are included.
+
+Use --%[5]s to output "export <var>=<value>" lines for the values that are different from their defaults.
+    Those lines can be used to define the current configuration on another node through environment variables.
+    If keys are provided, only those values are considered.
+
+`, configCmdStart, provconfig.ValueSourceEnv, provconfig.ValueSourceFile, provconfig.ValueSourceDefault, FlagExport),
+		Example: fmt.Sprintf(`$ %[1]s env telemetry.service-name moniker \
+$ %[1]s env api \
+$ %[1]s env --%[2]s`, configCmdStart, FlagExport),
+		ValidArgsFunction: completeConfigKeys,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := runConfigChangedCmd(cmd, args)
+			out, err := newConfigOutput(cmd)
+			if err != nil {
+				return err
+			}
+			err = runConfigEnvCmd(cmd, out, args)
 			// Note: If a RunE returns an error, the usage information is displayed.
 			//       That ends up being kind of annoying with this command.
 			//       So just output the error and still return nil.
 			if err != nil {
-				cmd.Printf("Error: %v\n", err)
+				out.PrintError(err)
 			}
 			return nil
 		},
 	}
+	addOutputFlag(cmd)
+	cmd.Flags().Bool(FlagExport, false, "Output export lines for the values that are different from their defaults")
 	return cmd
 }
 
@@ -204,13 +694,27 @@ Combines the %[2]s, %[3]s, and %[4]s files into %[1]s.
 Settings defined through environment variables will be included in the packed file.
 Settings that are their default value will not be included.
 
-`, provconfig.PackedConfFilename, provconfig.AppConfFilename, provconfig.CmtConfFilename, provconfig.ClientConfFilename),
-		Example: fmt.Sprintf(`$ %[1]s pack`, configCmdStart),
-		Args:    cobra.ExactArgs(0),
+To only pack some of the configs, provide their types with --%[5]s, e.g. --%[5]s app.
+The types are "app", "cmt", and "client" (or "all"). The configs that aren't packed stay in their own files.
+Any configs that are already packed stay packed.
+
+Use --%[6]s to output the contents that the packed file would have without changing any files.
+
+`, provconfig.PackedConfFilename, provconfig.AppConfFilename, provconfig.CmtConfFilename, provconfig.ClientConfFilename,
+			FlagOnly, FlagDryRun),
+		Example: fmt.Sprintf(`$ %[1]s pack
+$ %[1]s pack --%[2]s app
+$ %[1]s pack --%[2]s app,client --%[3]s`, configCmdStart, FlagOnly, FlagDryRun),
+		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return runConfigPackCmd(cmd)
+			return withConfigLock(cmd, func() error {
+				return runConfigPackCmd(cmd)
+			})
 		},
 	}
+	addWaitFlag(cmd)
+	addOnlyFlag(cmd, "pack")
+	cmd.Flags().Bool(FlagDryRun, false, "Output the packed config without changing any files")
 	return cmd
 }
 
@@ -227,28 +731,110 @@ Settings defined through environment variables will be included in the unpacked
 Default values are filled in appropriately.
 
 This can also be used to update the config files using the current template so they include all current fields.
+It will also rewrite a %[4]s file that contains JSON (as written by some older tooling) as TOML.
+
+To only unpack some of the configs, provide their types with --%[5]s, e.g. --%[5]s app.
+The types are "app", "cmt", and "client" (or "all"). The configs that aren't unpacked stay in %[1]s.
+Once none of the configs are packed, %[1]s is removed.
+
+`, provconfig.PackedConfFilename, provconfig.AppConfFilename, provconfig.CmtConfFilename, provconfig.ClientConfFilename,
+			FlagOnly),
+		Example: fmt.Sprintf(`$ %[1]s unpack
+$ %[1]s unpack --%[2]s cmt`, configCmdStart, FlagOnly),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withConfigLock(cmd, func() error {
+				return runConfigUnpackCmd(cmd)
+			})
+		},
+	}
+	addWaitFlag(cmd)
+	addOnlyFlag(cmd, "unpack")
+	return cmd
+}
+
+// ConfigCheckStartCmd returns a CLI command for running the start pre-flight checks without starting the node.
+func ConfigCheckStartCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-start",
+		Short: "Run the start command's pre-flight checks on the current configuration",
+		Long: `Run the start command's pre-flight checks on the current configuration.
 
-`, provconfig.PackedConfFilename, provconfig.AppConfFilename, provconfig.CmtConfFilename, provconfig.ClientConfFilename),
-		Example: fmt.Sprintf(`$ %[1]s unpack`, configCmdStart),
+Loads the effective configuration (including settings defined through environment variables) and runs the same
+checks that are run before starting the node, without actually starting it. The result of each check is output.
+
+The checks are:
+  - None of the enabled listen addresses use the same port.
+  - Each enabled listen address can be bound (the port is opened, then immediately closed).
+  - The db and snapshot directories either exist or can be created.
+  - The rpc TLS cert and key files (if defined) are readable.
+
+Since ports are actually opened, this should not be run while the node is running unless it is
+being checked against a different set of listen addresses.
+
+`,
+		Example: fmt.Sprintf(`$ %[1]s check-start`, configCmdStart),
 		Args:    cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return runConfigUnpackCmd(cmd)
+			return runConfigCheckStartCmd(cmd)
+		},
+	}
+	return cmd
+}
+
+// ConfigValidateCmd returns a CLI command to check the config files without changing them.
+func ConfigValidateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate [app|cmt|client]",
+		Short: "Check the configuration files for problems",
+		Long: fmt.Sprintf(`Check the configuration files for problems without changing them.
+
+Each config file is loaded and checked on its own. The result of each check is output,
+followed by a summary of which files passed and which failed.
+Only the values in the files are checked (settings defined through environment variables are ignored).
+A packed config is checked without needing to unpack it first.
+
+The checks are:
+  - The file can be read and its values have the correct types.
+  - The config passes the same basic validation that's done when setting values.
+  - App config only:
+    - The minimum-gas-prices can be parsed.
+    - The pruning options are consistent with each other.
+    - The iavl-cache-size is positive.
+
+By default, all the configs are checked. To check just some of them, provide their types:
+    "cosmos", "app" -> %[2]s configuration values.
+    "cometbft", "comet", "cmt", "config" -> %[3]s configuration values.
+    "client" -> %[4]s configuration values.
+    "all" -> all configuration values.
+
+`, configCmdStart, provconfig.AppConfFilename, provconfig.CmtConfFilename, provconfig.ClientConfFilename),
+		Example: fmt.Sprintf(`$ %[1]s validate \
+$ %[1]s validate app \
+$ %[1]s validate cmt client
+`, configCmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConfigValidateCmd(cmd, args)
 		},
 	}
 	return cmd
 }
 
 // runConfigGetCmd gets requested values and outputs them.
-func runConfigGetCmd(cmd *cobra.Command, args []string) error {
-	_, appFields, acerr := provconfig.ExtractAppConfigAndMap(cmd)
+func runConfigGetCmd(cmd *cobra.Command, out *configOutput, args []string) error {
+	verbose, err := cmd.Flags().GetBool(FlagVerbose)
+	if err != nil {
+		return err
+	}
+	appFields, acerr := provconfig.ExtractAppConfigSnapshot(cmd)
 	if acerr != nil {
 		return fmt.Errorf("could not get app config fields: %w", acerr)
 	}
-	_, cmtFields, cmtcerr := provconfig.ExtractCmtConfigAndMap(cmd)
+	cmtFields, cmtcerr := provconfig.ExtractCmtConfigSnapshot(cmd)
 	if cmtcerr != nil {
 		return fmt.Errorf("could not get cometbft config fields: %w", cmtcerr)
 	}
-	_, clientFields, ccerr := provconfig.ExtractClientConfigAndMap(cmd)
+	clientFields, ccerr := provconfig.ExtractClientConfigSnapshot(cmd)
 	if ccerr != nil {
 		return fmt.Errorf("could not get client config fields: %w", ccerr)
 	}
@@ -256,66 +842,45 @@ func runConfigGetCmd(cmd *cobra.Command, args []string) error {
 	if len(args) == 0 {
 		args = append(args, "all")
 	}
+	args, err = expandKeyAliases(cmd, out, args)
+	if err != nil {
+		return err
+	}
 
-	appToOutput := provconfig.FieldValueMap{}
-	cmtToOutput := provconfig.FieldValueMap{}
-	clientToOutput := provconfig.FieldValueMap{}
-	unknownKeyMap := provconfig.FieldValueMap{}
-	for _, key := range args {
-		switch key {
-		case "all":
-			appToOutput.AddEntriesFrom(appFields)
-			cmtToOutput.AddEntriesFrom(cmtFields)
-			clientToOutput.AddEntriesFrom(clientFields)
-		case "app", "cosmos":
-			appToOutput.AddEntriesFrom(appFields)
-		case "tendermint", "tm":
-			cmd.Printf("The %q option is deprecated and will be removed in a future version.\n", key)
-			cmd.Println("Use one of \"cometbft\", \"comet\", or \"cmt\" instead.")
-			fallthrough
-		case "config", "cometbft", "comet", "cmt":
-			cmtToOutput.AddEntriesFrom(cmtFields)
-		case "client":
-			clientToOutput.AddEntriesFrom(clientFields)
-		default:
-			appFVM, appFound, appExact := appFields.FindEntries(key)
-			cmtFVM, cmtFound, cmtExact := cmtFields.FindEntries(key)
-			clientFVM, clientFound, clientExact := clientFields.FindEntries(key)
-
-			found := appFound || cmtFound || clientFound
-			if !found {
-				unknownKeyMap.SetToNil(key)
-				continue
-			}
-
-			haveExact := appExact || cmtExact || clientExact
-			if appFound && (!haveExact || appExact) {
-				appToOutput.AddEntriesFrom(appFVM)
-			}
-			if cmtFound && (!haveExact || cmtExact) {
-				cmtToOutput.AddEntriesFrom(cmtFVM)
-			}
-			if clientFound && (!haveExact || clientExact) {
-				clientToOutput.AddEntriesFrom(clientFVM)
-			}
-		}
+	appToOutput, cmtToOutput, clientToOutput, unknownKeyMap, err := selectConfigEntries(out, appFields, cmtFields, clientFields, args)
+	if err != nil {
+		return err
 	}
 
-	isPacked := provconfig.IsPacked(cmd)
+	fieldMapString := makeFieldMapString
+	valuesJSONMap := makeValuesJSONMap
+	if verbose {
+		fieldMapString = makeReloadFieldMapString
+		valuesJSONMap = makeReloadValuesJSONMap
+	}
+	packed := provconfig.GetPackedConfigs(cmd)
 	if len(appToOutput) > 0 {
-		cmd.Println(makeAppConfigHeader(cmd, "", isPacked).String())
-		cmd.Println(makeFieldMapString(appToOutput))
+		out.Println(makeAppConfigHeader(cmd, "", packed.App).String())
+		out.Println(fieldMapString(appToOutput))
 	}
 	if len(cmtToOutput) > 0 {
-		cmd.Println(makeCmtConfigHeader(cmd, "", isPacked).String())
-		cmd.Println(makeFieldMapString(cmtToOutput))
+		out.Println(makeCmtConfigHeader(cmd, "", packed.Cmt).String())
+		out.Println(fieldMapString(cmtToOutput))
 	}
 	if len(clientToOutput) > 0 {
-		cmd.Println(makeClientConfigHeader(cmd, "", isPacked).String())
-		cmd.Println(makeFieldMapString(clientToOutput))
+		out.Println(makeClientConfigHeader(cmd, "", packed.Client).String())
+		out.Println(fieldMapString(clientToOutput))
+	}
+	if packed.Any() && (len(appToOutput) > 0 || len(cmtToOutput) > 0 || len(clientToOutput) > 0) {
+		out.Println(makeConfigIsPackedLine(cmd))
 	}
-	if isPacked && (len(appToOutput) > 0 || len(cmtToOutput) > 0 || len(clientToOutput) > 0) {
-		cmd.Println(makeConfigIsPackedLine(cmd))
+	err = out.Finish("values", configFilesJSON[interface{}]{
+		App:      valuesJSONMap(appToOutput),
+		CometBFT: valuesJSONMap(cmtToOutput),
+		Client:   valuesJSONMap(clientToOutput),
+	})
+	if err != nil {
+		return err
 	}
 	if len(unknownKeyMap) > 0 {
 		unknownKeys := unknownKeyMap.GetSortedKeys()
@@ -332,12 +897,13 @@ func runConfigGetCmd(cmd *cobra.Command, args []string) error {
 // The first return value is whether to include help with the output of an error.
 // This will only ever be true if an error is also returned.
 // The second return value is any error encountered.
-func runConfigSetCmd(cmd *cobra.Command, args []string) (bool, error) {
-	if len(args) == 0 {
-		return true, errors.New("no key/value pairs provided")
+func runConfigSetCmd(cmd *cobra.Command, out *configOutput, args []string) (bool, error) {
+	fromFile, err := cmd.Flags().GetString(FlagFromFile)
+	if err != nil {
+		return true, err
 	}
-	if len(args)%2 != 0 {
-		return true, errors.New("an even number of arguments are required when setting values")
+	if len(args) == 0 && len(fromFile) == 0 {
+		return true, errors.New("no key/value pairs provided")
 	}
 
 	// Warning: This wipes out all the viper setup stuff up to this point.
@@ -346,12 +912,12 @@ func runConfigSetCmd(cmd *cobra.Command, args []string) (bool, error) {
 	clientCtx := client.GetClientContextFromCmd(cmd)
 	clientCtx.Viper = viper.New()
 	server.GetServerContextFromCmd(cmd).Viper = clientCtx.Viper
-	if err := client.SetCmdClientContext(cmd, clientCtx); err != nil {
+	if err = client.SetCmdClientContext(cmd, clientCtx); err != nil {
 		return false, err
 	}
 
 	// Now that we have a clean viper, load the config from files again.
-	if err := provconfig.LoadConfigFromFiles(cmd); err != nil {
+	if err = provconfig.LoadConfigFromFiles(cmd); err != nil {
 		return false, err
 	}
 
@@ -368,18 +934,33 @@ func runConfigSetCmd(cmd *cobra.Command, args []string) (bool, error) {
 		return false, fmt.Errorf("couldn't get client config: %w", ccerr)
 	}
 
-	keyCount := len(args) / 2
-	keys := make([]string, keyCount)
-	vals := make([]string, keyCount)
-	for i := 0; i < keyCount; i++ {
-		keys[i] = args[i*2]
-		vals[i] = args[i*2+1]
+	var entries []configSetEntry
+	if len(fromFile) > 0 {
+		entries, err = readConfigSetFile(fromFile)
+		if err != nil {
+			return false, err
+		}
+	}
+	isKnownKey := func(key string) bool {
+		return appFields.Has(key) || cmtFields.Has(key) || clientFields.Has(key)
 	}
+	argEntries, err := parseConfigSetArgs(args, isKnownKey)
+	if err != nil {
+		return true, err
+	}
+	entries = append(entries, argEntries...)
+
 	issueFound := false
 	appUpdates := provconfig.UpdatedFieldMap{}
 	cmtUpdates := provconfig.UpdatedFieldMap{}
 	clientUpdates := provconfig.UpdatedFieldMap{}
-	for i, key := range keys {
+	for _, entry := range entries {
+		key := entry.key
+		if provconfig.IsKeyAlias(key) {
+			out.Issuef("Key alias %s cannot be used to set values.\n", key)
+			issueFound = true
+			continue
+		}
 		var confMap provconfig.FieldValueMap
 		foundIn := entryNotFound
 		for fvmi, fvm := range []provconfig.FieldValueMap{appFields, cmtFields, clientFields} {
@@ -390,14 +971,14 @@ func runConfigSetCmd(cmd *cobra.Command, args []string) (bool, error) {
 			}
 		}
 		if foundIn == entryNotFound {
-			cmd.Printf("Configuration key %s does not exist.\n", key)
+			out.Issuef("Configuration key %s does not exist.\n", key)
 			issueFound = true
 			continue
 		}
 		was := confMap.GetStringOf(key)
-		err := confMap.SetFromString(key, vals[i])
+		err = confMap.SetFromString(key, entry.value)
 		if err != nil {
-			cmd.Printf("Error setting key %s: %v\n", key, err)
+			out.Issuef("Error setting key %s: %v\n", key, err)
 			issueFound = true
 			continue
 		}
@@ -414,19 +995,19 @@ func runConfigSetCmd(cmd *cobra.Command, args []string) (bool, error) {
 	if !issueFound {
 		if len(appUpdates) > 0 {
 			if err := appConfig.ValidateBasic(); err != nil {
-				cmd.Printf("App config validation error: %v\n", err)
+				out.Issuef("App config validation error: %v\n", err)
 				issueFound = true
 			}
 		}
 		if len(cmtUpdates) > 0 {
 			if err := cmtConfig.ValidateBasic(); err != nil {
-				cmd.Printf("CometBFT config validation error: %v\n", err)
+				out.Issuef("CometBFT config validation error: %v\n", err)
 				issueFound = true
 			}
 		}
 		if len(clientUpdates) > 0 {
 			if err := clientConfig.ValidateBasic(); err != nil {
-				cmd.Printf("Client config validation error: %v\n", err)
+				out.Issuef("Client config validation error: %v\n", err)
 				issueFound = true
 			}
 		}
@@ -434,6 +1015,9 @@ func runConfigSetCmd(cmd *cobra.Command, args []string) (bool, error) {
 	if issueFound {
 		return false, errors.New("one or more issues encountered; no configuration values have been updated")
 	}
+	if err = checkPinnedKeys(cmd, appUpdates, cmtUpdates, clientUpdates); err != nil {
+		return false, err
+	}
 	// If a certain config hasn't been changed, we want to provide it as nil to the SaveConfigs func.
 	if len(appUpdates) == 0 {
 		appConfig = nil
@@ -445,36 +1029,701 @@ func runConfigSetCmd(cmd *cobra.Command, args []string) (bool, error) {
 		clientConfig = nil
 	}
 	provconfig.SaveConfigs(cmd, appConfig, cmtConfig, clientConfig, false)
-	isPacked := provconfig.IsPacked(cmd)
+	packed := provconfig.GetPackedConfigs(cmd)
 	if len(appUpdates) > 0 {
-		cmd.Println(makeAppConfigHeader(cmd, addedLeadUpdated, isPacked).WithoutEnv().String())
-		cmd.Println(makeUpdatedFieldMapString(appUpdates, provconfig.UpdatedField.StringAsUpdate))
+		out.Println(makeAppConfigHeader(cmd, addedLeadUpdated, packed.App).WithoutEnv().String())
+		out.Println(makeUpdatedFieldMapString(appUpdates, provconfig.UpdatedField.StringAsUpdate, useColor(cmd)))
 	}
 	if len(cmtUpdates) > 0 {
-		cmd.Println(makeCmtConfigHeader(cmd, addedLeadUpdated, isPacked).WithoutEnv().String())
-		cmd.Println(makeUpdatedFieldMapString(cmtUpdates, provconfig.UpdatedField.StringAsUpdate))
+		out.Println(makeCmtConfigHeader(cmd, addedLeadUpdated, packed.Cmt).WithoutEnv().String())
+		out.Println(makeUpdatedFieldMapString(cmtUpdates, provconfig.UpdatedField.StringAsUpdate, useColor(cmd)))
 	}
 	if len(clientUpdates) > 0 {
-		cmd.Println(makeClientConfigHeader(cmd, addedLeadUpdated, isPacked).WithoutEnv().String())
-		cmd.Println(makeUpdatedFieldMapString(clientUpdates, provconfig.UpdatedField.StringAsUpdate))
+		out.Println(makeClientConfigHeader(cmd, addedLeadUpdated, packed.Client).WithoutEnv().String())
+		out.Println(makeUpdatedFieldMapString(clientUpdates, provconfig.UpdatedField.StringAsUpdate, useColor(cmd)))
 	}
-	if isPacked && (len(appUpdates) > 0 || len(cmtUpdates) > 0 || len(clientUpdates) > 0) {
-		cmd.Println(makeConfigIsPackedLine(cmd))
+	if packed.Any() && (len(appUpdates) > 0 || len(cmtUpdates) > 0 || len(clientUpdates) > 0) {
+		out.Println(makeConfigIsPackedLine(cmd))
+	}
+	for _, updates := range []provconfig.UpdatedFieldMap{appUpdates, cmtUpdates, clientUpdates} {
+		for _, key := range updates.GetSortedKeys() {
+			envVar := provconfig.EnvVarName(key)
+			if _, isSet := os.LookupEnv(envVar); isSet {
+				out.Warn(WarnCodeEnvOverride, "the %s environment variable is set and will override the new %s value", envVar, key)
+			}
+		}
 	}
-	return false, nil
+	warnReloadBehaviors(out, appUpdates, cmtUpdates, clientUpdates)
+	err = out.Finish("updated", configFilesJSON[updatedFieldJSON]{
+		App:      makeUpdatesJSONMap(appUpdates),
+		CometBFT: makeUpdatesJSONMap(cmtUpdates),
+		Client:   makeUpdatesJSONMap(clientUpdates),
+	})
+	return false, err
 }
 
-// runConfigChangedCmd gets values that have changed from their defaults.
-func runConfigChangedCmd(cmd *cobra.Command, args []string) error {
-	_, appFields, acerr := provconfig.ExtractAppConfigAndMap(cmd)
-	if acerr != nil {
+// runConfigResetCmd resets the requested values to their defaults.
+// The first return value is whether to include help with the output of an error.
+// This will only ever be true if an error is also returned.
+// The second return value is any error encountered.
+func runConfigResetCmd(cmd *cobra.Command, out *configOutput, args []string) (bool, error) {
+	if len(args) == 0 {
+		return true, errors.New("no keys provided")
+	}
+	yes, err := cmd.Flags().GetBool(FlagYes)
+	if err != nil {
+		return true, err
+	}
+	args, err = expandKeyAliases(cmd, out, args)
+	if err != nil {
+		return false, err
+	}
+	for _, key := range args {
+		if key == "all" && !yes {
+			return true, fmt.Errorf("resetting all configuration values requires the --%s flag", FlagYes)
+		}
+	}
+
+	// Warning: This wipes out all the viper setup stuff up to this point.
+	// It needs to be done so that just the file values or defaults are loaded
+	// without considering environment variables.
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	clientCtx.Viper = viper.New()
+	server.GetServerContextFromCmd(cmd).Viper = clientCtx.Viper
+	if err = client.SetCmdClientContext(cmd, clientCtx); err != nil {
+		return false, err
+	}
+
+	// Now that we have a clean viper, load the config from files again.
+	if err = provconfig.LoadConfigFromFiles(cmd); err != nil {
+		return false, err
+	}
+
+	appConfig, appFields, acerr := provconfig.ExtractAppConfigAndMap(cmd)
+	if acerr != nil {
+		return false, fmt.Errorf("couldn't get app config: %w", acerr)
+	}
+	cmtConfig, cmtFields, cmtcerr := provconfig.ExtractCmtConfigAndMap(cmd)
+	if cmtcerr != nil {
+		return false, fmt.Errorf("couldn't get cometbft config: %w", cmtcerr)
+	}
+	clientConfig, clientFields, ccerr := provconfig.ExtractClientConfigAndMap(cmd)
+	if ccerr != nil {
+		return false, fmt.Errorf("couldn't get client config: %w", ccerr)
+	}
+
+	appToReset := provconfig.FieldValueMap{}
+	cmtToReset := provconfig.FieldValueMap{}
+	clientToReset := provconfig.FieldValueMap{}
+	unknownKeyMap := provconfig.FieldValueMap{}
+	for _, key := range args {
+		switch key {
+		case "all":
+			appToReset.AddEntriesFrom(appFields)
+			cmtToReset.AddEntriesFrom(cmtFields)
+			clientToReset.AddEntriesFrom(clientFields)
+		case "app", "cosmos":
+			appToReset.AddEntriesFrom(appFields)
+		case "tendermint", "tm":
+			out.WarnDeprecatedAlias(key)
+			fallthrough
+		case "config", "cometbft", "comet", "cmt":
+			cmtToReset.AddEntriesFrom(cmtFields)
+		case "client":
+			clientToReset.AddEntriesFrom(clientFields)
+		default:
+			appFVM, appFound, appExact, err := findConfigEntries(appFields, key)
+			if err != nil {
+				return false, err
+			}
+			cmtFVM, cmtFound, cmtExact, err := findConfigEntries(cmtFields, key)
+			if err != nil {
+				return false, err
+			}
+			clientFVM, clientFound, clientExact, err := findConfigEntries(clientFields, key)
+			if err != nil {
+				return false, err
+			}
+
+			found := appFound || cmtFound || clientFound
+			if !found {
+				unknownKeyMap.SetToNil(key)
+				continue
+			}
+
+			haveExact := appExact || cmtExact || clientExact
+			if appFound && (!haveExact || appExact) {
+				appToReset.AddEntriesFrom(appFVM)
+			}
+			if cmtFound && (!haveExact || cmtExact) {
+				cmtToReset.AddEntriesFrom(cmtFVM)
+			}
+			if clientFound && (!haveExact || clientExact) {
+				clientToReset.AddEntriesFrom(clientFVM)
+			}
+		}
+	}
+	if len(unknownKeyMap) > 0 {
+		unknownKeys := unknownKeyMap.GetSortedKeys()
+		s := "s"
+		if len(unknownKeys) == 1 {
+			s = ""
+		}
+		return false, fmt.Errorf("%d configuration key%s not found: %s", len(unknownKeys), s, strings.Join(unknownKeys, ", "))
+	}
+
+	allDefaults := provconfig.GetAllConfigDefaults()
+	issueFound := false
+	resetEntries := func(confMap, toReset provconfig.FieldValueMap) (provconfig.UpdatedFieldMap, []string) {
+		updates := provconfig.UpdatedFieldMap{}
+		var alreadyDefault []string
+		for _, key := range toReset.GetSortedKeys() {
+			was := confMap.GetStringOf(key)
+			if was == allDefaults.GetStringOf(key) {
+				alreadyDefault = append(alreadyDefault, key)
+				continue
+			}
+			if err := confMap.SetFromValue(key, allDefaults[key]); err != nil {
+				out.Issuef("Error resetting key %s: %v\n", key, err)
+				issueFound = true
+				continue
+			}
+			updates.AddOrUpdate(key, was, confMap.GetStringOf(key))
+		}
+		return updates, alreadyDefault
+	}
+	appUpdates, appAlreadyDefault := resetEntries(appFields, appToReset)
+	cmtUpdates, cmtAlreadyDefault := resetEntries(cmtFields, cmtToReset)
+	clientUpdates, clientAlreadyDefault := resetEntries(clientFields, clientToReset)
+
+	if !issueFound {
+		if len(appUpdates) > 0 {
+			if err := appConfig.ValidateBasic(); err != nil {
+				out.Issuef("App config validation error: %v\n", err)
+				issueFound = true
+			}
+		}
+		if len(cmtUpdates) > 0 {
+			if err := cmtConfig.ValidateBasic(); err != nil {
+				out.Issuef("CometBFT config validation error: %v\n", err)
+				issueFound = true
+			}
+		}
+		if len(clientUpdates) > 0 {
+			if err := clientConfig.ValidateBasic(); err != nil {
+				out.Issuef("Client config validation error: %v\n", err)
+				issueFound = true
+			}
+		}
+	}
+	if issueFound {
+		return false, errors.New("one or more issues encountered; no configuration values have been updated")
+	}
+	if err = checkPinnedKeys(cmd, appUpdates, cmtUpdates, clientUpdates); err != nil {
+		return false, err
+	}
+	// If a certain config hasn't been changed, we want to provide it as nil to the SaveConfigs func.
+	if len(appUpdates) == 0 {
+		appConfig = nil
+	}
+	if len(cmtUpdates) == 0 {
+		cmtConfig = nil
+	}
+	if len(clientUpdates) == 0 {
+		clientConfig = nil
+	}
+	if appConfig != nil || cmtConfig != nil || clientConfig != nil {
+		provconfig.SaveConfigs(cmd, appConfig, cmtConfig, clientConfig, false)
+	}
+	packed := provconfig.GetPackedConfigs(cmd)
+	if len(appUpdates) > 0 {
+		out.Println(makeAppConfigHeader(cmd, addedLeadUpdated, packed.App).WithoutEnv().String())
+		out.Println(makeUpdatedFieldMapString(appUpdates, provconfig.UpdatedField.StringAsUpdate, useColor(cmd)))
+	}
+	if len(cmtUpdates) > 0 {
+		out.Println(makeCmtConfigHeader(cmd, addedLeadUpdated, packed.Cmt).WithoutEnv().String())
+		out.Println(makeUpdatedFieldMapString(cmtUpdates, provconfig.UpdatedField.StringAsUpdate, useColor(cmd)))
+	}
+	if len(clientUpdates) > 0 {
+		out.Println(makeClientConfigHeader(cmd, addedLeadUpdated, packed.Client).WithoutEnv().String())
+		out.Println(makeUpdatedFieldMapString(clientUpdates, provconfig.UpdatedField.StringAsUpdate, useColor(cmd)))
+	}
+	if packed.Any() && (len(appUpdates) > 0 || len(cmtUpdates) > 0 || len(clientUpdates) > 0) {
+		out.Println(makeConfigIsPackedLine(cmd))
+	}
+	var alreadyDefault []string
+	alreadyDefault = append(alreadyDefault, appAlreadyDefault...)
+	alreadyDefault = append(alreadyDefault, cmtAlreadyDefault...)
+	alreadyDefault = append(alreadyDefault, clientAlreadyDefault...)
+	if len(alreadyDefault) > 0 {
+		out.Println(fmt.Sprintf("Already default: %s", strings.Join(alreadyDefault, ", ")))
+	}
+	for _, updates := range []provconfig.UpdatedFieldMap{appUpdates, cmtUpdates, clientUpdates} {
+		for _, key := range updates.GetSortedKeys() {
+			envVar := provconfig.EnvVarName(key)
+			if _, isSet := os.LookupEnv(envVar); isSet {
+				out.Warn(WarnCodeEnvOverride, "the %s environment variable is set and will override the reset %s value", envVar, key)
+			}
+		}
+	}
+	warnReloadBehaviors(out, appUpdates, cmtUpdates, clientUpdates)
+	if alreadyDefault == nil {
+		alreadyDefault = []string{}
+	}
+	err = out.Finish("reset", configResetJSON{
+		Updated: configFilesJSON[updatedFieldJSON]{
+			App:      makeUpdatesJSONMap(appUpdates),
+			CometBFT: makeUpdatesJSONMap(cmtUpdates),
+			Client:   makeUpdatesJSONMap(clientUpdates),
+		},
+		AlreadyDefault: alreadyDefault,
+	})
+	return false, err
+}
+
+// runConfigPinCmd adds the provided keys to the pinned keys, or lists the pinned keys if none are provided.
+func runConfigPinCmd(cmd *cobra.Command, out *configOutput, args []string) error {
+	pinned, err := provconfig.LoadPinnedKeys(cmd)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		if len(pinned) == 0 {
+			out.Println("No configuration keys are pinned.")
+		}
+		for _, key := range pinned {
+			out.Println(key)
+		}
+		return out.Finish("pinned", pinned)
+	}
+
+	allDefaults := provconfig.GetAllConfigDefaults()
+	var unknownKeys []string
+	for _, key := range args {
+		if !allDefaults.Has(key) {
+			unknownKeys = append(unknownKeys, key)
+		}
+	}
+	if len(unknownKeys) > 0 {
+		s := "s"
+		if len(unknownKeys) == 1 {
+			s = ""
+		}
+		return fmt.Errorf("%d configuration key%s not found: %s", len(unknownKeys), s, strings.Join(unknownKeys, ", "))
+	}
+
+	alreadyPinned := provconfig.FindPinnedKeys(pinned, args)
+	var newlyPinned []string
+	for _, key := range args {
+		if !slices.Contains(pinned, key) && !slices.Contains(newlyPinned, key) {
+			newlyPinned = append(newlyPinned, key)
+		}
+	}
+	sort.Strings(newlyPinned)
+	if len(newlyPinned) > 0 {
+		pinned = append(pinned, newlyPinned...)
+		if err = provconfig.SavePinnedKeys(cmd, pinned); err != nil {
+			return err
+		}
+		sort.Strings(pinned)
+		out.Println(fmt.Sprintf("Pinned: %s", strings.Join(newlyPinned, ", ")))
+	}
+	if len(alreadyPinned) > 0 {
+		out.Println(fmt.Sprintf("Already pinned: %s", strings.Join(alreadyPinned, ", ")))
+	}
+	return out.Finish("pinned", pinned)
+}
+
+// runConfigUnpinCmd removes the provided keys from the pinned keys.
+// The first return value is whether to include help with the output of an error.
+// This will only ever be true if an error is also returned.
+// The second return value is any error encountered.
+func runConfigUnpinCmd(cmd *cobra.Command, out *configOutput, args []string) (bool, error) {
+	if len(args) == 0 {
+		return true, errors.New("no keys provided")
+	}
+
+	pinned, err := provconfig.LoadPinnedKeys(cmd)
+	if err != nil {
+		return false, err
+	}
+
+	unpinned := provconfig.FindPinnedKeys(pinned, args)
+	var notPinned []string
+	for _, key := range args {
+		if !slices.Contains(pinned, key) && !slices.Contains(notPinned, key) {
+			notPinned = append(notPinned, key)
+		}
+	}
+	sort.Strings(notPinned)
+	if len(unpinned) > 0 {
+		pinned = slices.DeleteFunc(pinned, func(key string) bool {
+			return slices.Contains(unpinned, key)
+		})
+		if err = provconfig.SavePinnedKeys(cmd, pinned); err != nil {
+			return false, err
+		}
+		out.Println(fmt.Sprintf("Unpinned: %s", strings.Join(unpinned, ", ")))
+	}
+	if len(notPinned) > 0 {
+		out.Println(fmt.Sprintf("Not pinned: %s", strings.Join(notPinned, ", ")))
+	}
+	return false, out.Finish("pinned", pinned)
+}
+
+// runConfigAliasAddCmd defines (or redefines) the key alias named by the first arg as the rest of the args.
+func runConfigAliasAddCmd(cmd *cobra.Command, out *configOutput, args []string) error {
+	name, err := provconfig.NormalizeKeyAliasName(args[0])
+	if err != nil {
+		return err
+	}
+
+	allDefaults := provconfig.GetAllConfigDefaultsSnapshot()
+	var keys, unknownKeys []string
+	for _, key := range args[1:] {
+		if slices.Contains(keys, key) {
+			continue
+		}
+		_, found, _, err := findConfigEntries(allDefaults, key)
+		if err != nil {
+			return err
+		}
+		if !found {
+			unknownKeys = append(unknownKeys, key)
+			continue
+		}
+		keys = append(keys, key)
+	}
+	if len(unknownKeys) > 0 {
+		s := "s"
+		if len(unknownKeys) == 1 {
+			s = ""
+		}
+		return fmt.Errorf("%d configuration key%s not found: %s", len(unknownKeys), s, strings.Join(unknownKeys, ", "))
+	}
+
+	aliases, err := provconfig.LoadKeyAliases(cmd)
+	if err != nil {
+		return err
+	}
+	aliases[name] = keys
+	if err = provconfig.SaveKeyAliases(cmd, aliases); err != nil {
+		return err
+	}
+	out.Println(fmt.Sprintf("Alias %s%s: %s", provconfig.KeyAliasPrefix, name, strings.Join(keys, ", ")))
+	return out.Finish("aliases", makeKeyAliasesJSON(aliases))
+}
+
+// runConfigAliasRemoveCmd removes the key aliases named by the provided args.
+func runConfigAliasRemoveCmd(cmd *cobra.Command, out *configOutput, args []string) error {
+	names := make([]string, len(args))
+	for i, arg := range args {
+		name, err := provconfig.NormalizeKeyAliasName(arg)
+		if err != nil {
+			return err
+		}
+		names[i] = name
+	}
+
+	aliases, err := provconfig.LoadKeyAliases(cmd)
+	if err != nil {
+		return err
+	}
+	var removed, notFound []string
+	for _, name := range names {
+		alias := provconfig.KeyAliasPrefix + name
+		if _, ok := aliases[name]; ok {
+			delete(aliases, name)
+			removed = append(removed, alias)
+		} else if !slices.Contains(notFound, alias) && !slices.Contains(removed, alias) {
+			notFound = append(notFound, alias)
+		}
+	}
+	if len(removed) > 0 {
+		if err = provconfig.SaveKeyAliases(cmd, aliases); err != nil {
+			return err
+		}
+		sort.Strings(removed)
+		out.Println(fmt.Sprintf("Removed: %s", strings.Join(removed, ", ")))
+	}
+	if len(notFound) > 0 {
+		sort.Strings(notFound)
+		out.Println(fmt.Sprintf("Not found: %s", strings.Join(notFound, ", ")))
+	}
+	return out.Finish("aliases", makeKeyAliasesJSON(aliases))
+}
+
+// runConfigAliasListCmd lists the key aliases and the keys they expand to.
+func runConfigAliasListCmd(cmd *cobra.Command, out *configOutput) error {
+	aliases, err := provconfig.LoadKeyAliases(cmd)
+	if err != nil {
+		return err
+	}
+	if len(aliases) == 0 {
+		out.Println("No key aliases are defined.")
+	}
+	for _, name := range aliases.GetSortedNames() {
+		out.Println(fmt.Sprintf("%s%s: %s", provconfig.KeyAliasPrefix, name, strings.Join(aliases[name], ", ")))
+	}
+	return out.Finish("aliases", makeKeyAliasesJSON(aliases))
+}
+
+// makeKeyAliasesJSON converts the provided key aliases into a map of alias (with its prefix) to keys.
+func makeKeyAliasesJSON(aliases provconfig.KeyAliases) map[string][]string {
+	rv := make(map[string][]string, len(aliases))
+	for name, keys := range aliases {
+		rv[provconfig.KeyAliasPrefix+name] = keys
+	}
+	return rv
+}
+
+// expandKeyAliases replaces any key aliases in the provided args with the keys they expand to.
+// The expansions are reported in the output. The key aliases file is only read if there's a key alias in args.
+func expandKeyAliases(cmd *cobra.Command, out *configOutput, args []string) ([]string, error) {
+	if !slices.ContainsFunc(args, provconfig.IsKeyAlias) {
+		return args, nil
+	}
+	aliases, err := provconfig.LoadKeyAliases(cmd)
+	if err != nil {
+		return nil, err
+	}
+	rv, expanded, err := aliases.Expand(args)
+	if err != nil {
+		return nil, err
+	}
+	out.ReportKeyAliases(expanded)
+	return rv, nil
+}
+
+// addOverridePinnedFlag adds the --override-pinned flag to the provided config command.
+func addOverridePinnedFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagOverridePinned, false, "Allow pinned configuration values to be modified")
+}
+
+// checkPinnedKeys returns an error identifying the updated keys that are pinned.
+// If the --override-pinned flag was provided, pinned keys are allowed to be updated, and nil is returned.
+func checkPinnedKeys(cmd *cobra.Command, updates ...provconfig.UpdatedFieldMap) error {
+	override, err := cmd.Flags().GetBool(FlagOverridePinned)
+	if err != nil {
+		return err
+	}
+	if override {
+		return nil
+	}
+
+	pinned, err := provconfig.LoadPinnedKeys(cmd)
+	if err != nil {
+		return err
+	}
+	var keys []string
+	for _, u := range updates {
+		keys = append(keys, u.GetSortedKeys()...)
+	}
+	blocked := provconfig.FindPinnedKeys(pinned, keys)
+	if len(blocked) == 0 {
+		return nil
+	}
+	s := "s"
+	if len(blocked) == 1 {
+		s = ""
+	}
+	return fmt.Errorf("%d pinned configuration key%s cannot be modified without the --%s flag: %s; no configuration values have been updated",
+		len(blocked), s, FlagOverridePinned, strings.Join(blocked, ", "))
+}
+
+// configSetEntry is a key and (string) value to set.
+type configSetEntry struct {
+	key   string
+	value string
+}
+
+// parseConfigSetArgs parses the provided set command arguments into the entries to set.
+// Each entry is either a single key=value argument, or a key argument followed by a value argument.
+// Keys never contain a "=", so an argument is only treated as a value when it comes right after a key argument.
+// That value can contain a "=", unless it starts with a known key followed by a "=" (which would be ambiguous).
+func parseConfigSetArgs(args []string, isKnownKey func(key string) bool) ([]configSetEntry, error) {
+	var rv []configSetEntry
+	for i := 0; i < len(args); i++ {
+		if key, value, isKV := strings.Cut(args[i], "="); isKV {
+			if len(key) == 0 {
+				return nil, fmt.Errorf("invalid argument %q: no key provided before the =", args[i])
+			}
+			rv = append(rv, configSetEntry{key: key, value: value})
+			continue
+		}
+
+		key := args[i]
+		if i+1 >= len(args) {
+			return nil, fmt.Errorf("no value provided for key %q", key)
+		}
+		i++
+		value := args[i]
+		if valKey, _, isKV := strings.Cut(value, "="); isKV && isKnownKey(valKey) {
+			return nil, fmt.Errorf("ambiguous arguments %q %q: the second could be the value for %s or an entry for %s; "+
+				"use %s=<value> to provide a value for %s that contains a =", key, value, key, valKey, key, key)
+		}
+		rv = append(rv, configSetEntry{key: key, value: value})
+	}
+	return rv, nil
+}
+
+// readConfigSetFile reads the provided TOML or JSON file and returns the entries in it to set.
+// The entries are ordered by key.
+func readConfigSetFile(path string) ([]configSetEntry, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	if ext != ".toml" && ext != ".json" {
+		return nil, fmt.Errorf("unsupported file type %q: expected a .toml or .json file", path)
+	}
+
+	vpr := viper.New()
+	vpr.SetConfigFile(path)
+	if err := vpr.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("could not read %q: %w", path, err)
+	}
+
+	keys := vpr.AllKeys()
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no entries found in %q", path)
+	}
+	sort.Strings(keys)
+
+	rv := make([]configSetEntry, len(keys))
+	for i, key := range keys {
+		value, err := configSetValueString(vpr.Get(key))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s in %q: %w", key, path, err)
+		}
+		rv[i] = configSetEntry{key: key, value: value}
+	}
+	return rv, nil
+}
+
+// configSetValueString converts a value read from a file into the string needed to set it.
+// Lists are converted to their JSON (the same way they're provided as an argument).
+func configSetValueString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case []interface{}, []string, map[string]interface{}:
+		bz, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(bz), nil
+	default:
+		return fmt.Sprintf("%v", v), nil
+	}
+}
+
+// selectConfigEntries gets the entries identified by the provided args using the same rules as the get command.
+// The args can be specific keys, parent field names, glob patterns, types of config, or "all".
+// The entries are returned grouped by config type, followed by the args that didn't match anything.
+func selectConfigEntries(
+	out *configOutput,
+	appFields, cmtFields, clientFields *provconfig.FieldValueSnapshot,
+	args []string,
+) (provconfig.FieldValueMap, provconfig.FieldValueMap, provconfig.FieldValueMap, provconfig.FieldValueMap, error) {
+	appEntries := provconfig.FieldValueMap{}
+	cmtEntries := provconfig.FieldValueMap{}
+	clientEntries := provconfig.FieldValueMap{}
+	unknownKeyMap := provconfig.FieldValueMap{}
+	for _, key := range args {
+		switch key {
+		case "all":
+			appEntries.AddEntriesFrom(appFields.AsFieldValueMap())
+			cmtEntries.AddEntriesFrom(cmtFields.AsFieldValueMap())
+			clientEntries.AddEntriesFrom(clientFields.AsFieldValueMap())
+		case "app", "cosmos":
+			appEntries.AddEntriesFrom(appFields.AsFieldValueMap())
+		case "tendermint", "tm":
+			out.WarnDeprecatedAlias(key)
+			fallthrough
+		case "config", "cometbft", "comet", "cmt":
+			cmtEntries.AddEntriesFrom(cmtFields.AsFieldValueMap())
+		case "client":
+			clientEntries.AddEntriesFrom(clientFields.AsFieldValueMap())
+		default:
+			appFVM, appFound, appExact, err := findConfigEntries(appFields, key)
+			if err != nil {
+				return nil, nil, nil, nil, err
+			}
+			cmtFVM, cmtFound, cmtExact, err := findConfigEntries(cmtFields, key)
+			if err != nil {
+				return nil, nil, nil, nil, err
+			}
+			clientFVM, clientFound, clientExact, err := findConfigEntries(clientFields, key)
+			if err != nil {
+				return nil, nil, nil, nil, err
+			}
+
+			found := appFound || cmtFound || clientFound
+			if !found {
+				unknownKeyMap.SetToNil(key)
+				continue
+			}
+
+			haveExact := appExact || cmtExact || clientExact
+			if appFound && (!haveExact || appExact) {
+				appEntries.AddEntriesFrom(appFVM)
+			}
+			if cmtFound && (!haveExact || cmtExact) {
+				cmtEntries.AddEntriesFrom(cmtFVM)
+			}
+			if clientFound && (!haveExact || clientExact) {
+				clientEntries.AddEntriesFrom(clientFVM)
+			}
+		}
+	}
+	return appEntries, cmtEntries, clientEntries, unknownKeyMap, nil
+}
+
+// findConfigEntries looks up the entries for a key that isn't one of the special words.
+// If the key has a '*' or '?', it's treated as a glob pattern and all matching entries are returned (none are exact).
+// Otherwise, this is the same as fields.FindEntries(key).
+func findConfigEntries(fields provconfig.FieldValueReader, key string) (provconfig.FieldValueMap, bool, bool, error) {
+	if !provconfig.IsKeyPattern(key) {
+		fvm, found, exact := fields.FindEntries(key)
+		return fvm, found, exact, nil
+	}
+	fvm, err := fields.FindMatches(key)
+	if err != nil {
+		return nil, false, false, fmt.Errorf("invalid key pattern %q: %w", key, err)
+	}
+	return fvm, len(fvm) > 0, false, nil
+}
+
+// configKeyWords are the special words that can be provided in place of config keys to the get and changed commands.
+var configKeyWords = []string{"all", "app", "cosmos", "cometbft", "comet", "cmt", "config", "client"}
+
+// completeConfigKeys provides shell completion of the configuration keys (and special words) for the get and changed commands.
+// The keys come from a snapshot of the defaults, so this is safe to run alongside the command itself.
+func completeConfigKeys(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var rv []string
+	for _, word := range configKeyWords {
+		if strings.HasPrefix(word, toComplete) {
+			rv = append(rv, word)
+		}
+	}
+	rv = append(rv, provconfig.CompleteKeys(provconfig.GetAllConfigDefaultsSnapshot(), toComplete)...)
+	return rv, cobra.ShellCompDirectiveNoFileComp
+}
+
+// runConfigChangedCmd gets values that have changed from their defaults.
+func runConfigChangedCmd(cmd *cobra.Command, out *configOutput, args []string) error {
+	appFields, acerr := provconfig.ExtractAppConfigSnapshot(cmd)
+	if acerr != nil {
 		return fmt.Errorf("couldn't get app config: %w", acerr)
 	}
-	_, cmtFields, cmtcerr := provconfig.ExtractCmtConfigAndMap(cmd)
+	cmtFields, cmtcerr := provconfig.ExtractCmtConfigSnapshot(cmd)
 	if cmtcerr != nil {
 		return fmt.Errorf("couldn't get cometbft config: %w", cmtcerr)
 	}
-	_, clientFields, ccerr := provconfig.ExtractClientConfigAndMap(cmd)
+	clientFields, ccerr := provconfig.ExtractClientConfigSnapshot(cmd)
 	if ccerr != nil {
 		return fmt.Errorf("couldn't get client config: %w", ccerr)
 	}
@@ -482,8 +1731,12 @@ func runConfigChangedCmd(cmd *cobra.Command, args []string) error {
 	if len(args) == 0 {
 		args = append(args, "all")
 	}
+	args, err := expandKeyAliases(cmd, out, args)
+	if err != nil {
+		return err
+	}
 
-	allDefaults := provconfig.GetAllConfigDefaults()
+	allDefaults := provconfig.GetAllConfigDefaultsSnapshot()
 	showApp, showCmt, showClient := false, false, false
 	appDiffs := provconfig.UpdatedFieldMap{}
 	cmtDiffs := provconfig.UpdatedFieldMap{}
@@ -500,8 +1753,7 @@ func runConfigChangedCmd(cmd *cobra.Command, args []string) error {
 			showApp = true
 			appDiffs.AddOrUpdateEntriesFrom(provconfig.MakeUpdatedFieldMap(allDefaults, appFields, true))
 		case "tendermint", "tm":
-			cmd.Printf("The %q option is deprecated and will be removed in a future version.\n", key)
-			cmd.Println("Use one of \"cometbft\", \"comet\", or \"cmt\" instead.")
+			out.WarnDeprecatedAlias(key)
 			fallthrough
 		case "config", "cometbft", "comet", "cmt":
 			showCmt = true
@@ -510,9 +1762,18 @@ func runConfigChangedCmd(cmd *cobra.Command, args []string) error {
 			showClient = true
 			clientDiffs.AddOrUpdateEntriesFrom(provconfig.MakeUpdatedFieldMap(allDefaults, clientFields, true))
 		default:
-			appFVM, appFound, appExact := appFields.FindEntries(key)
-			cmtFVM, cmtFound, cmtExact := cmtFields.FindEntries(key)
-			clientFVM, clientFound, clientExact := clientFields.FindEntries(key)
+			appFVM, appFound, appExact, err := findConfigEntries(appFields, key)
+			if err != nil {
+				return err
+			}
+			cmtFVM, cmtFound, cmtExact, err := findConfigEntries(cmtFields, key)
+			if err != nil {
+				return err
+			}
+			clientFVM, clientFound, clientExact, err := findConfigEntries(clientFields, key)
+			if err != nil {
+				return err
+			}
 
 			found := appFound || cmtFound || clientFound
 			if !found {
@@ -539,42 +1800,152 @@ func runConfigChangedCmd(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	isPacked := provconfig.IsPacked(cmd)
+	writeFile, err := cmd.Flags().GetString(FlagWrite)
+	if err != nil {
+		return err
+	}
+	if len(writeFile) > 0 {
+		force, ferr := cmd.Flags().GetBool(FlagForce)
+		if ferr != nil {
+			return ferr
+		}
+		allDiffs := provconfig.UpdatedFieldMap{}
+		allDiffs.AddOrUpdateEntriesFrom(appDiffs, cmtDiffs, clientDiffs)
+		if err = provconfig.WritePackedConfigFile(writeFile, allDiffs, force); err != nil {
+			return err
+		}
+	}
+
+	packed := provconfig.GetPackedConfigs(cmd)
 
 	if showApp {
-		cmd.Println(makeAppConfigHeader(cmd, addedLeadChanged, isPacked).String())
+		out.Println(makeAppConfigHeader(cmd, addedLeadChanged, packed.App).String())
 		if len(appDiffs) > 0 {
-			cmd.Println(makeUpdatedFieldMapString(appDiffs, provconfig.UpdatedField.StringAsDefault))
+			out.Println(makeUpdatedFieldMapString(appDiffs, provconfig.UpdatedField.StringAsDefault, useColor(cmd)))
 		} else {
-			cmd.Println("All app config values equal the default config values.")
-			cmd.Println("")
+			out.Println("All app config values equal the default config values.")
+			out.Println("")
 		}
 	}
 
 	if showCmt {
-		cmd.Println(makeCmtConfigHeader(cmd, addedLeadChanged, isPacked).String())
+		out.Println(makeCmtConfigHeader(cmd, addedLeadChanged, packed.Cmt).String())
 		if len(cmtDiffs) > 0 {
-			cmd.Println(makeUpdatedFieldMapString(cmtDiffs, provconfig.UpdatedField.StringAsDefault))
+			out.Println(makeUpdatedFieldMapString(cmtDiffs, provconfig.UpdatedField.StringAsDefault, useColor(cmd)))
 		} else {
-			cmd.Println("All cometbft config values equal the default config values.")
-			cmd.Println("")
+			out.Println("All cometbft config values equal the default config values.")
+			out.Println("")
 		}
 	}
 
 	if showClient {
-		cmd.Println(makeClientConfigHeader(cmd, addedLeadChanged, isPacked).String())
+		out.Println(makeClientConfigHeader(cmd, addedLeadChanged, packed.Client).String())
 		if len(clientDiffs) > 0 {
-			cmd.Println(makeUpdatedFieldMapString(clientDiffs, provconfig.UpdatedField.StringAsDefault))
+			out.Println(makeUpdatedFieldMapString(clientDiffs, provconfig.UpdatedField.StringAsDefault, useColor(cmd)))
 		} else {
-			cmd.Println("All client config values equal the default config values.")
-			cmd.Println("")
+			out.Println("All client config values equal the default config values.")
+			out.Println("")
+		}
+	}
+
+	if packed.Any() && (showApp || showCmt || showClient) {
+		out.Println(makeConfigIsPackedLine(cmd))
+	}
+
+	if len(writeFile) > 0 {
+		out.Println("Changed values written to: " + writeFile)
+	}
+
+	err = out.Finish("changed", configFilesJSON[changedFieldJSON]{
+		App:      makeChangedJSONMap(appDiffs, appFields, allDefaults),
+		CometBFT: makeChangedJSONMap(cmtDiffs, cmtFields, allDefaults),
+		Client:   makeChangedJSONMap(clientDiffs, clientFields, allDefaults),
+	})
+	if err != nil {
+		return err
+	}
+	if len(unknownKeyMap) > 0 {
+		unknownKeys := unknownKeyMap.GetSortedKeys()
+		s := "s"
+		if len(unknownKeys) == 1 {
+			s = ""
 		}
+		return fmt.Errorf("%d configuration key%s not found: %s", len(unknownKeys), s, strings.Join(unknownKeys, ", "))
+	}
+	return nil
+}
+
+// runConfigEnvCmd outputs the environment variables of config values (or export lines for them).
+func runConfigEnvCmd(cmd *cobra.Command, out *configOutput, args []string) error {
+	appFields, acerr := provconfig.ExtractAppConfigSnapshot(cmd)
+	if acerr != nil {
+		return fmt.Errorf("could not get app config fields: %w", acerr)
+	}
+	cmtFields, cmtcerr := provconfig.ExtractCmtConfigSnapshot(cmd)
+	if cmtcerr != nil {
+		return fmt.Errorf("could not get cometbft config fields: %w", cmtcerr)
+	}
+	clientFields, ccerr := provconfig.ExtractClientConfigSnapshot(cmd)
+	if ccerr != nil {
+		return fmt.Errorf("could not get client config fields: %w", ccerr)
+	}
+	sources, err := provconfig.GetFieldSources(cmd)
+	if err != nil {
+		return fmt.Errorf("could not identify config value sources: %w", err)
+	}
+	export, err := cmd.Flags().GetBool(FlagExport)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		args = append(args, "all")
 	}
 
-	if isPacked && (showApp || showCmt || showClient) {
-		cmd.Println(makeConfigIsPackedLine(cmd))
+	appEntries, cmtEntries, clientEntries, unknownKeyMap, err := selectConfigEntries(out, appFields, cmtFields, clientFields, args)
+	if err != nil {
+		return err
 	}
 
+	if export {
+		allDefaults := provconfig.GetAllConfigDefaultsSnapshot()
+		allEntries := provconfig.FieldValueMap{}
+		allEntries.AddEntriesFrom(appEntries, cmtEntries, clientEntries)
+		changed := provconfig.MakeUpdatedFieldMap(allDefaults, allEntries, true)
+		exports := make(map[string]string, len(changed))
+		for _, key := range changed.GetSortedKeys() {
+			envVar := provconfig.EnvVarName(key)
+			value := provconfig.GetEnvStringFromValue(allEntries[key])
+			exports[envVar] = value
+			out.Println(fmt.Sprintf("export %s=%s", envVar, shellQuote(value)))
+		}
+		err = out.Finish("exports", exports)
+	} else {
+		packed := provconfig.GetPackedConfigs(cmd)
+		if len(appEntries) > 0 {
+			out.Println(makeAppConfigHeader(cmd, addedLeadEnv, packed.App).WithoutEnv().String())
+			out.Println(makeEnvFieldMapString(appEntries, sources))
+		}
+		if len(cmtEntries) > 0 {
+			out.Println(makeCmtConfigHeader(cmd, addedLeadEnv, packed.Cmt).WithoutEnv().String())
+			out.Println(makeEnvFieldMapString(cmtEntries, sources))
+		}
+		if len(clientEntries) > 0 {
+			out.Println(makeClientConfigHeader(cmd, addedLeadEnv, packed.Client).WithoutEnv().String())
+			out.Println(makeEnvFieldMapString(clientEntries, sources))
+		}
+		if packed.Any() && (len(appEntries) > 0 || len(cmtEntries) > 0 || len(clientEntries) > 0) {
+			out.Println(makeConfigIsPackedLine(cmd))
+		}
+		err = out.Finish("env", configFilesJSON[envFieldJSON]{
+			App:      makeEnvJSONMap(appEntries, sources),
+			CometBFT: makeEnvJSONMap(cmtEntries, sources),
+			Client:   makeEnvJSONMap(clientEntries, sources),
+		})
+	}
+	if err != nil {
+		return err
+	}
 	if len(unknownKeyMap) > 0 {
 		unknownKeys := unknownKeyMap.GetSortedKeys()
 		s := "s"
@@ -586,6 +1957,267 @@ func runConfigChangedCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// runConfigDiffCmd gets values that are different from those in another config.
+func runConfigDiffCmd(cmd *cobra.Command, out *configOutput, args []string) error {
+	otherPath, sections := args[0], args[1:]
+	_, appFields, acerr := provconfig.ExtractAppConfigAndMap(cmd)
+	if acerr != nil {
+		return fmt.Errorf("couldn't get app config: %w", acerr)
+	}
+	_, cmtFields, cmtcerr := provconfig.ExtractCmtConfigAndMap(cmd)
+	if cmtcerr != nil {
+		return fmt.Errorf("couldn't get cometbft config: %w", cmtcerr)
+	}
+	_, clientFields, ccerr := provconfig.ExtractClientConfigAndMap(cmd)
+	if ccerr != nil {
+		return fmt.Errorf("couldn't get client config: %w", ccerr)
+	}
+	unknown, uerr := provconfig.ExtractUnknownConfigEntries(cmd)
+	if uerr != nil {
+		return fmt.Errorf("couldn't read config files: %w", uerr)
+	}
+	other, oerr := provconfig.LoadOtherConfig(otherPath)
+	if oerr != nil {
+		return oerr
+	}
+
+	if len(sections) == 0 {
+		sections = append(sections, "all")
+	}
+
+	showApp, showCmt, showClient := false, false, false
+	for _, section := range sections {
+		switch section {
+		case "all":
+			showApp, showCmt, showClient = true, true, true
+		case "app", "cosmos":
+			showApp = true
+		case "tendermint", "tm":
+			out.WarnDeprecatedAlias(section)
+			fallthrough
+		case "config", "cometbft", "comet", "cmt":
+			showCmt = true
+		case "client":
+			showClient = true
+		default:
+			return fmt.Errorf("unknown config section %q: must be one of %q, %q, %q, or %q", section, "all", "app", "cmt", "client")
+		}
+	}
+
+	packed := provconfig.GetPackedConfigs(cmd)
+	colorize := useColor(cmd)
+	var appDiff, cmtDiff, clientDiff, packedDiff *configSectionDiff
+
+	if showApp {
+		header := makeAppConfigHeader(cmd, addedLeadDiff, packed.App)
+		if other.App == nil {
+			out.Println(header.String())
+			out.Println(fmt.Sprintf("The other config (%s) does not have an app config.\n", other.Source))
+		} else {
+			appDiff = makeConfigSectionDiff(appFields, other.App, unknown.App, other.Unknown.App)
+			out.Println(appDiff.String(header, "app", colorize))
+		}
+	}
+
+	if showCmt {
+		header := makeCmtConfigHeader(cmd, addedLeadDiff, packed.Cmt)
+		if other.Cmt == nil {
+			out.Println(header.String())
+			out.Println(fmt.Sprintf("The other config (%s) does not have a cometbft config.\n", other.Source))
+		} else {
+			cmtDiff = makeConfigSectionDiff(cmtFields, other.Cmt, unknown.Cmt, other.Unknown.Cmt)
+			out.Println(cmtDiff.String(header, "cometbft", colorize))
+		}
+	}
+
+	if showClient {
+		header := makeClientConfigHeader(cmd, addedLeadDiff, packed.Client)
+		if other.Client == nil {
+			out.Println(header.String())
+			out.Println(fmt.Sprintf("The other config (%s) does not have a client config.\n", other.Source))
+		} else {
+			clientDiff = makeConfigSectionDiff(clientFields, other.Client, unknown.Client, other.Unknown.Client)
+			out.Println(clientDiff.String(header, "client", colorize))
+		}
+	}
+
+	// Unknown packed config entries can't be attributed to a specific config, so they're only compared with everything.
+	if showApp && showCmt && showClient && (len(unknown.Packed) > 0 || len(other.Unknown.Packed) > 0) {
+		header := &sectionHeader{lead: "Unknown Packed Config Entries", color: colorize}
+		packedDiff = makeConfigSectionDiff(nil, nil, unknown.Packed, other.Unknown.Packed)
+		out.Println(packedDiff.String(header, "packed", colorize))
+	}
+
+	if packed.Any() && (showApp || showCmt || showClient) {
+		out.Println(makeConfigIsPackedLine(cmd))
+	}
+	out.Println(fmt.Sprintf("Other config: %s\n", other.Source))
+
+	return out.Finish("diff", configDiffJSON{
+		Other: other.Source,
+		Differences: configFilesJSON[diffFieldJSON]{
+			App:      appDiff.DiffsJSON(),
+			CometBFT: cmtDiff.DiffsJSON(),
+			Client:   clientDiff.DiffsJSON(),
+		},
+		OnlyInCurrent: configOnlyInJSON{
+			configFilesJSON: configFilesJSON[interface{}]{
+				App:      appDiff.OnlyInCurrentJSON(),
+				CometBFT: cmtDiff.OnlyInCurrentJSON(),
+				Client:   clientDiff.OnlyInCurrentJSON(),
+			},
+			Packed: packedDiff.OnlyInCurrentJSON(),
+		},
+		OnlyInOther: configOnlyInJSON{
+			configFilesJSON: configFilesJSON[interface{}]{
+				App:      appDiff.OnlyInOtherJSON(),
+				CometBFT: cmtDiff.OnlyInOtherJSON(),
+				Client:   clientDiff.OnlyInOtherJSON(),
+			},
+			Packed: packedDiff.OnlyInOtherJSON(),
+		},
+	})
+}
+
+// configSectionDiff is the comparison of one section (e.g. the app config) of the current config against another config.
+type configSectionDiff struct {
+	// diffs are the entries in both configs with different values. The Was is the other value; the IsNow is the current value.
+	diffs provconfig.UpdatedFieldMap
+	// onlyInCurrent are the entries that are only in the current config.
+	onlyInCurrent provconfig.FieldValueMap
+	// onlyInOther are the entries that are only in the other config.
+	onlyInOther provconfig.FieldValueMap
+	// current has all of the current entries (known and unknown).
+	current provconfig.FieldValueMap
+	// other has all of the other entries (known and unknown).
+	other provconfig.FieldValueMap
+}
+
+// makeConfigSectionDiff compares the provided current fields (and unknown entries) against the other ones.
+func makeConfigSectionDiff(current, other, currentUnknown, otherUnknown provconfig.FieldValueMap) *configSectionDiff {
+	rv := &configSectionDiff{
+		diffs:         provconfig.MakeUpdatedFieldMap(other, current, true),
+		onlyInCurrent: provconfig.FieldValueMap{},
+		onlyInOther:   provconfig.FieldValueMap{},
+		current:       provconfig.FieldValueMap{},
+		other:         provconfig.FieldValueMap{},
+	}
+	rv.diffs.AddOrUpdateEntriesFrom(provconfig.MakeUpdatedFieldMap(otherUnknown, currentUnknown, true))
+	rv.current.AddEntriesFrom(current, currentUnknown)
+	rv.other.AddEntriesFrom(other, otherUnknown)
+
+	// The known fields are the same in both, so only the unknown entries can be in just one of them.
+	for key, val := range currentUnknown {
+		if !otherUnknown.Has(key) {
+			rv.onlyInCurrent[key] = val
+		}
+	}
+	for key, val := range otherUnknown {
+		if !currentUnknown.Has(key) {
+			rv.onlyInOther[key] = val
+		}
+	}
+	return rv
+}
+
+// String makes a multi-line string of this diff with the provided header.
+// The name is used to describe the section, e.g. "app".
+func (d *configSectionDiff) String(header *sectionHeader, name string, colorize bool) string {
+	var sb strings.Builder
+	sb.WriteString(header.String())
+	sb.WriteByte('\n')
+	if len(d.diffs) == 0 && len(d.onlyInCurrent) == 0 && len(d.onlyInOther) == 0 {
+		sb.WriteString(fmt.Sprintf("All %s config values equal the other config values.\n", name))
+		return sb.String()
+	}
+	var parts []string
+	if len(d.diffs) > 0 {
+		parts = append(parts, makeUpdatedFieldMapString(d.diffs, provconfig.UpdatedField.StringAsOther, colorize))
+	}
+	if len(d.onlyInCurrent) > 0 {
+		parts = append(parts, fmt.Sprintf("Only in current %s config:\n%s", name, makeFieldMapString(d.onlyInCurrent)))
+	}
+	if len(d.onlyInOther) > 0 {
+		parts = append(parts, fmt.Sprintf("Only in other %s config:\n%s", name, makeFieldMapString(d.onlyInOther)))
+	}
+	sb.WriteString(strings.Join(parts, "\n"))
+	return sb.String()
+}
+
+// DiffsJSON converts the diffs of this section into a map of key to value/other.
+func (d *configSectionDiff) DiffsJSON() map[string]diffFieldJSON {
+	if d == nil || len(d.diffs) == 0 {
+		return nil
+	}
+	rv := make(map[string]diffFieldJSON, len(d.diffs))
+	for key := range d.diffs {
+		rv[key] = diffFieldJSON{Value: jsonValueOf(d.current[key]), Other: jsonValueOf(d.other[key])}
+	}
+	return rv
+}
+
+// OnlyInCurrentJSON converts the entries that are only in the current config into a map of key to json value.
+func (d *configSectionDiff) OnlyInCurrentJSON() map[string]interface{} {
+	if d == nil {
+		return nil
+	}
+	return makeValuesJSONMap(d.onlyInCurrent)
+}
+
+// OnlyInOtherJSON converts the entries that are only in the other config into a map of key to json value.
+func (d *configSectionDiff) OnlyInOtherJSON() map[string]interface{} {
+	if d == nil {
+		return nil
+	}
+	return makeValuesJSONMap(d.onlyInOther)
+}
+
+// warnReloadBehaviors adds a warning for each updated key that doesn't take effect immediately.
+func warnReloadBehaviors(out *configOutput, updates ...provconfig.UpdatedFieldMap) {
+	for _, updated := range updates {
+		for _, key := range updated.GetSortedKeys() {
+			switch b := provconfig.GetReloadBehavior(key); b {
+			case provconfig.ReloadHot:
+			case provconfig.ReloadNextBlock:
+				out.Warn(WarnCodeNextBlock, "%s %s", key, b.Note())
+			default:
+				out.Warn(WarnCodeRestartRequired, "%s %s", key, b.Note())
+			}
+		}
+	}
+}
+
+// addWaitFlag adds the --wait flag to the provided config command.
+func addWaitFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagWait, false, "Wait for any other config operation to finish instead of failing")
+}
+
+// withConfigLock runs the provided function while holding the config lock.
+// If another config operation holds the lock, an error is returned unless the --wait flag was provided.
+// The lock is released once the function finishes (even if it panics).
+func withConfigLock(cmd *cobra.Command, run func() error) error {
+	wait := false
+	if cmd.Flags().Lookup(FlagWait) != nil {
+		var err error
+		wait, err = cmd.Flags().GetBool(FlagWait)
+		if err != nil {
+			return err
+		}
+	}
+
+	lock, err := provconfig.AcquireConfigLock(cmd, wait)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if rerr := lock.Release(); rerr != nil {
+			cmd.PrintErrf("warning: %v\n", rerr)
+		}
+	}()
+
+	return run()
+}
+
 // runConfigHomeCmd obtains the home directory.
 func runConfigHomeCmd(cmd *cobra.Command) error {
 	clientCtx := client.GetClientContextFromCmd(cmd)
@@ -595,12 +2227,165 @@ func runConfigHomeCmd(cmd *cobra.Command) error {
 
 // runConfigPackCmd combines the toml config files into a single config json file.
 func runConfigPackCmd(cmd *cobra.Command) error {
-	return provconfig.PackConfig(cmd)
+	toPack, err := readOnlyFlag(cmd)
+	if err != nil {
+		return err
+	}
+	dryRun, err := cmd.Flags().GetBool(FlagDryRun)
+	if err != nil {
+		return err
+	}
+	return provconfig.PackSomeConfigs(cmd, toPack, dryRun)
+}
+
+// runConfigCheckStartCmd runs the start pre-flight checks and outputs the result of each.
+func runConfigCheckStartCmd(cmd *cobra.Command) error {
+	checks, err := runPreflightChecks(cmd)
+	if err != nil {
+		return err
+	}
+	for _, check := range checks {
+		cmd.Println(check.String())
+	}
+	if failed := checks.Failed(); len(failed) > 0 {
+		return fmt.Errorf("%d of %d pre-flight checks failed", len(failed), len(checks))
+	}
+	cmd.Printf("All %d pre-flight checks passed.\n", len(checks))
+	return nil
+}
+
+// runConfigValidateCmd checks the requested config files and outputs the results.
+func runConfigValidateCmd(cmd *cobra.Command, args []string) error {
+	out, err := newConfigOutput(cmd)
+	if err != nil {
+		return err
+	}
+	var app, cmt, client bool
+	if len(args) == 0 {
+		app, cmt, client = true, true, true
+	}
+	for _, arg := range args {
+		switch arg {
+		case "all":
+			app, cmt, client = true, true, true
+		case "app", "cosmos":
+			app = true
+		case "tendermint", "tm":
+			out.WarnDeprecatedAlias(arg)
+			fallthrough
+		case "config", "cometbft", "comet", "cmt":
+			cmt = true
+		case "client":
+			client = true
+		default:
+			return fmt.Errorf("unknown config type %q: must be one of %q, %q, %q, or %q", arg, "all", "app", "cmt", "client")
+		}
+	}
+	// Now that the args have been checked, there's no need to show the usage if something fails.
+	cmd.SilenceUsage = true
+
+	packed := provconfig.GetPackedConfigs(cmd)
+	results := provconfig.ValidateConfigFiles(cmd, app, cmt, client)
+	for _, result := range results {
+		cmd.Println(makeConfigValidationHeader(cmd, result.Type, packed.Has(result.Type)).String())
+		for _, check := range result.Checks {
+			cmd.Println(check.String())
+		}
+		cmd.Println("")
+	}
+
+	failed := 0
+	cmd.Println("Summary:")
+	for _, result := range results {
+		source := result.Source
+		if len(source) == 0 {
+			source = "(defaults)"
+		}
+		if result.Passed() {
+			cmd.Printf("  PASS: %s config: %s\n", result.Type, source)
+			continue
+		}
+		failed++
+		cmd.Printf("  FAIL: %s config: %s (%d of %d checks failed)\n", result.Type, source, len(result.Checks.Failed()), len(result.Checks))
+	}
+	if err = out.Finish("validation", nil); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d configs failed validation", failed, len(results))
+	}
+	return nil
+}
+
+// makeConfigValidationHeader creates the section header for the validation results of the provided type of config.
+func makeConfigValidationHeader(cmd *cobra.Command, confType string, isPacked bool) *sectionHeader {
+	var rv *sectionHeader
+	switch confType {
+	case provconfig.ConfigTypeApp:
+		rv = makeAppConfigHeader(cmd, addedLeadValidation, isPacked)
+	case provconfig.ConfigTypeCmt:
+		rv = makeCmtConfigHeader(cmd, addedLeadValidation, isPacked)
+	default:
+		rv = makeClientConfigHeader(cmd, addedLeadValidation, isPacked)
+	}
+	return rv.WithoutEnv()
+}
+
+// runPreflightChecks extracts the app and cometbft configs and runs the start pre-flight checks on them.
+// This is used by both the config check-start and start commands.
+func runPreflightChecks(cmd *cobra.Command) (preflight.Checks, error) {
+	appConfig, err := provconfig.ExtractAppConfig(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cmtConfig, err := provconfig.ExtractCmtConfig(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return preflight.Run(&appConfig.Config, cmtConfig), nil
 }
 
 // runConfigUnpackCmd converts a single config json file into the individual toml files.
 func runConfigUnpackCmd(cmd *cobra.Command) error {
-	return provconfig.UnpackConfig(cmd)
+	toUnpack, err := readOnlyFlag(cmd)
+	if err != nil {
+		return err
+	}
+	return provconfig.UnpackSomeConfigs(cmd, toUnpack)
+}
+
+// addOnlyFlag adds the --only flag to the provided config command.
+func addOnlyFlag(cmd *cobra.Command, action string) {
+	cmd.Flags().StringSlice(FlagOnly, nil, fmt.Sprintf("The types of config to %s (app, cmt, client), default is all", action))
+}
+
+// readOnlyFlag reads the --only flag and returns the configs it identifies.
+// If the flag wasn't provided, all of the configs are returned.
+func readOnlyFlag(cmd *cobra.Command) (provconfig.ConfigSet, error) {
+	vals, err := cmd.Flags().GetStringSlice(FlagOnly)
+	if err != nil {
+		return provconfig.ConfigSet{}, err
+	}
+	if len(vals) == 0 {
+		return provconfig.AllConfigs(), nil
+	}
+	var rv provconfig.ConfigSet
+	for _, val := range vals {
+		switch strings.TrimSpace(val) {
+		case "all":
+			rv = provconfig.AllConfigs()
+		case "app", "cosmos":
+			rv.App = true
+		case "config", "cometbft", "comet", "cmt":
+			rv.Cmt = true
+		case "client":
+			rv.Client = true
+		default:
+			return provconfig.ConfigSet{}, fmt.Errorf("unknown --%s config type %q: must be one of %q, %q, %q, or %q",
+				FlagOnly, val, "all", "app", "cmt", "client")
+		}
+	}
+	return rv, nil
 }
 
 // makeFieldMapString makes a multi-line string with all the keys and values in the provided map.
@@ -616,18 +2401,105 @@ func makeFieldMapString(m provconfig.FieldValueMap) string {
 	return sb.String()
 }
 
+// makeReloadFieldMapString makes a multi-line string with the key, value, and reload behavior of each entry.
+// E.g. `api.enable=true (restart)`.
+func makeReloadFieldMapString(m provconfig.FieldValueMap) string {
+	keys := m.GetSortedKeys()
+	var sb strings.Builder
+	for _, k := range keys {
+		sb.WriteString(k)
+		sb.WriteByte('=')
+		sb.WriteString(m.GetStringOf(k))
+		sb.WriteString(" (")
+		sb.WriteString(string(provconfig.GetReloadBehavior(k)))
+		sb.WriteString(")\n")
+	}
+	return sb.String()
+}
+
+// makeEnvFieldMapString makes a multi-line string with the key, environment variable, value, and source of each entry.
+// E.g. `api.enable: PIO_API_ENABLE=true (file)`.
+func makeEnvFieldMapString(m provconfig.FieldValueMap, sources provconfig.FieldSourceMap) string {
+	keys := m.GetSortedKeys()
+	var sb strings.Builder
+	for _, k := range keys {
+		sb.WriteString(k)
+		sb.WriteString(": ")
+		sb.WriteString(provconfig.EnvVarName(k))
+		sb.WriteByte('=')
+		sb.WriteString(provconfig.GetEnvStringFromValue(m[k]))
+		sb.WriteString(" (")
+		sb.WriteString(string(sources.Get(k)))
+		sb.WriteString(")\n")
+	}
+	return sb.String()
+}
+
+// shellQuote wraps the provided value in single quotes if needed so that it can be used as-is in a shell.
+func shellQuote(value string) string {
+	if len(value) > 0 && strings.IndexFunc(value, needsShellQuote) < 0 {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// needsShellQuote returns true if the provided rune requires a value to be quoted in a shell.
+func needsShellQuote(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return false
+	}
+	return !strings.ContainsRune("_-.,:/@%+=", r)
+}
+
 // makeUpdatedFieldMapString makes a multi-line string of the given updated field map.
 // The provided stringer function is used to convert each map value to a string.
-func makeUpdatedFieldMapString(m provconfig.UpdatedFieldMap, stringer func(v provconfig.UpdatedField) string) string {
+// If colorize is true, the old values are red and the new values are green (when they differ).
+func makeUpdatedFieldMapString(m provconfig.UpdatedFieldMap, stringer func(v provconfig.UpdatedField) string, colorize bool) string {
 	keys := m.GetSortedKeys()
 	var sb strings.Builder
 	for _, key := range keys {
-		sb.WriteString(stringer(*m[key]))
+		uf := *m[key]
+		if colorize && uf.HasDiff() {
+			uf.Was = colorString(ansiRed, uf.Was)
+			uf.IsNow = colorString(ansiGreen, uf.IsNow)
+		}
+		sb.WriteString(stringer(uf))
 		sb.WriteByte('\n')
 	}
 	return sb.String()
 }
 
+// colorString wraps the provided string in the provided ANSI code and a reset.
+func colorString(code, str string) string {
+	return code + str + ansiReset
+}
+
+// useColor returns true if the output of the provided command should be colorized.
+// Color is only used when the output is a terminal, the --no-color flag wasn't given, and NO_COLOR isn't set.
+func useColor(cmd *cobra.Command) bool {
+	if noColor, err := cmd.Flags().GetBool(FlagNoColor); err == nil && noColor {
+		return false
+	}
+	if len(os.Getenv(EnvNoColor)) > 0 {
+		return false
+	}
+	return isTerminal(cmd.OutOrStdout())
+}
+
+// isTerminal returns true if the provided writer is a file that is a terminal (character device).
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
 // sectionHeader is a struct holding several options for section header strings.
 type sectionHeader struct {
 	lead      string
@@ -635,6 +2507,7 @@ type sectionHeader struct {
 	filename  string
 	isPacked  bool
 	env       bool
+	color     bool
 }
 
 // WithoutEnv sets env to false returning itself.
@@ -653,6 +2526,11 @@ func (s sectionHeader) String() string {
 	}
 	sb.WriteByte(':')
 	hr := strings.Repeat("-", sb.Len())
+	if s.color {
+		lead := sb.String()
+		sb.Reset()
+		sb.WriteString(colorString(ansiBold, lead))
+	}
 	if len(s.filename) > 0 {
 		sb.WriteByte(' ')
 		switch {
@@ -681,6 +2559,7 @@ func makeAppConfigHeader(cmd *cobra.Command, addedLead string, isPacked bool) *s
 		filename:  provconfig.GetFullPathToAppConf(cmd),
 		isPacked:  isPacked,
 		env:       true,
+		color:     useColor(cmd),
 	}
 }
 
@@ -692,6 +2571,7 @@ func makeCmtConfigHeader(cmd *cobra.Command, addedLead string, isPacked bool) *s
 		filename:  provconfig.GetFullPathToCmtConf(cmd),
 		isPacked:  isPacked,
 		env:       true,
+		color:     useColor(cmd),
 	}
 }
 
@@ -703,6 +2583,7 @@ func makeClientConfigHeader(cmd *cobra.Command, addedLead string, isPacked bool)
 		filename:  provconfig.GetFullPathToClientConf(cmd),
 		isPacked:  isPacked,
 		env:       true,
+		color:     useColor(cmd),
 	}
 }
 
@@ -0,0 +1,318 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	provconfig "github.com/provenance-io/provenance/cmd/provenanced/config"
+)
+
+const (
+	// OutputText is the --output value for human-readable output from the config commands.
+	OutputText = "text"
+	// OutputJSON is the --output value for machine-readable output from the config commands.
+	OutputJSON = "json"
+)
+
+// These are the codes of the warnings that can be issued by the config commands.
+const (
+	// WarnCodeEnvOverride indicates that an environment variable will override a value being set.
+	WarnCodeEnvOverride = "env_override"
+	// WarnCodeDeprecatedAlias indicates that a deprecated alias (e.g. "tm") was used.
+	WarnCodeDeprecatedAlias = "deprecated_alias"
+	// WarnCodeRestartRequired indicates that the node must be restarted for a change to take effect.
+	WarnCodeRestartRequired = "restart_required"
+	// WarnCodeNextBlock indicates that a change will not take effect until the next block.
+	WarnCodeNextBlock = "next_block"
+)
+
+// ConfigWarning is a non-fatal problem (or important note) found while running a config command.
+type ConfigWarning struct {
+	// Code is a stable, machine-readable identifier of the type of warning, e.g. WarnCodeEnvOverride.
+	Code string `json:"code"`
+	// Message is a human-readable description of the warning.
+	Message string `json:"message"`
+}
+
+// configOutput handles the output of a config command, keeping warnings separate from the main output.
+//
+// In text mode, the main output goes to stdout, and warnings go to stderr prefixed with "warning: ".
+// In json mode, a single json object is written to stdout with the result and a "warnings" array.
+type configOutput struct {
+	cmd      *cobra.Command
+	json     bool
+	warnings []ConfigWarning
+	// aliases has the keys that each key alias used in the command was expanded to.
+	aliases map[string][]string
+}
+
+// addOutputFlag adds the --output flag to the provided config command.
+func addOutputFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP(flags.FlagOutput, "o", OutputText, fmt.Sprintf("Output format (%s|%s)", OutputText, OutputJSON))
+}
+
+// newConfigOutput creates a new configOutput for the provided command using its --output flag.
+func newConfigOutput(cmd *cobra.Command) (*configOutput, error) {
+	rv := &configOutput{cmd: cmd}
+	if cmd.Flags().Lookup(flags.FlagOutput) == nil {
+		return rv, nil
+	}
+	output, err := cmd.Flags().GetString(flags.FlagOutput)
+	if err != nil {
+		return nil, err
+	}
+	switch output {
+	case OutputText:
+	case OutputJSON:
+		rv.json = true
+	default:
+		return nil, fmt.Errorf("invalid --%s value %q: must be either %q or %q", flags.FlagOutput, output, OutputText, OutputJSON)
+	}
+	return rv, nil
+}
+
+// Warn adds a warning with the provided code and message.
+func (o *configOutput) Warn(code, format string, args ...interface{}) {
+	o.warnings = append(o.warnings, ConfigWarning{Code: code, Message: fmt.Sprintf(format, args...)})
+}
+
+// WarnDeprecatedAlias adds a deprecated alias warning about the provided alias of the cometbft config.
+func (o *configOutput) WarnDeprecatedAlias(alias string) {
+	o.Warn(WarnCodeDeprecatedAlias, "the %q option is deprecated and will be removed in a future version, use one of %q, %q, or %q instead",
+		alias, "cometbft", "comet", "cmt")
+}
+
+// ReportKeyAliases outputs the keys that each of the provided key aliases were expanded to.
+// In text mode, there's a line for each, e.g. "Alias @perf: mempool.size, consensus.timeout_commit".
+// In json mode, they're included in the result under "aliases".
+func (o *configOutput) ReportKeyAliases(expanded map[string][]string) {
+	if len(expanded) == 0 {
+		return
+	}
+	if o.aliases == nil {
+		o.aliases = make(map[string][]string, len(expanded))
+	}
+	aliases := make([]string, 0, len(expanded))
+	for alias, keys := range expanded {
+		o.aliases[alias] = keys
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	for _, alias := range aliases {
+		o.Println(fmt.Sprintf("Alias %s: %s", alias, strings.Join(expanded[alias], ", ")))
+	}
+}
+
+// Println outputs the provided line as part of the main output. It's ignored in json mode.
+func (o *configOutput) Println(i ...interface{}) {
+	if !o.json {
+		o.cmd.Println(i...)
+	}
+}
+
+// Issuef outputs a line about a problem. In text mode, it's part of the main output; in json mode, it goes to stderr.
+func (o *configOutput) Issuef(format string, args ...interface{}) {
+	if o.json {
+		o.cmd.PrintErrf(format, args...)
+		return
+	}
+	o.cmd.Printf(format, args...)
+}
+
+// Finish outputs the warnings and, in json mode, the provided result (under the provided name).
+func (o *configOutput) Finish(resultName string, result interface{}) error {
+	if !o.json {
+		for _, w := range o.warnings {
+			o.cmd.PrintErrln("warning: " + w.Message)
+		}
+		return nil
+	}
+
+	warnings := o.warnings
+	if warnings == nil {
+		warnings = []ConfigWarning{}
+	}
+	toOutput := map[string]interface{}{resultName: result, "warnings": warnings}
+	if len(o.aliases) > 0 {
+		toOutput["aliases"] = o.aliases
+	}
+	bz, err := json.MarshalIndent(toOutput, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal output to json: %w", err)
+	}
+	o.cmd.Println(string(bz))
+	return nil
+}
+
+// PrintError outputs the provided error. In text mode, it's part of the main output; in json mode, it goes to stderr.
+func (o *configOutput) PrintError(err error) {
+	o.Issuef("Error: %v\n", err)
+}
+
+// configFilesJSON is the json output of some info about config values, grouped by config file.
+type configFilesJSON[V any] struct {
+	App      map[string]V `json:"app,omitempty"`
+	CometBFT map[string]V `json:"cometbft,omitempty"`
+	Client   map[string]V `json:"client,omitempty"`
+}
+
+// makeValuesJSONMap converts the provided field value map into a map of key to json value.
+func makeValuesJSONMap(m provconfig.FieldValueMap) map[string]interface{} {
+	if len(m) == 0 {
+		return nil
+	}
+	rv := make(map[string]interface{}, len(m))
+	for key, v := range m {
+		rv[key] = jsonValueOf(v)
+	}
+	return rv
+}
+
+// jsonValueOf converts the provided config value into something that marshals as the appropriate json type.
+// Bools and numbers stay as such, strings aren't quoted again, durations become strings (e.g. "5s"),
+// and slices and arrays become json arrays. Anything else becomes a string using fmt %v.
+func jsonValueOf(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	if v.Type().String() == "time.Duration" {
+		return time.Duration(v.Int()).String()
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	case reflect.Float32:
+		// Format it using 32 bits so that it isn't output with extra float64 digits (e.g. 0.10000000149011612).
+		return json.Number(strconv.FormatFloat(v.Float(), 'g', -1, 32))
+	case reflect.Float64:
+		return v.Float()
+	case reflect.String:
+		return v.String()
+	case reflect.Slice, reflect.Array:
+		rv := make([]interface{}, v.Len())
+		for i := range rv {
+			rv[i] = jsonValueOf(v.Index(i))
+		}
+		return rv
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// reloadValueJSON is the json output of a config value along with when a change to it takes effect.
+type reloadValueJSON struct {
+	Value  interface{} `json:"value"`
+	Reload string      `json:"reload"`
+}
+
+// makeReloadValuesJSONMap converts the provided field value map into a map of key to value and reload behavior.
+func makeReloadValuesJSONMap(m provconfig.FieldValueMap) map[string]interface{} {
+	if len(m) == 0 {
+		return nil
+	}
+	rv := make(map[string]interface{}, len(m))
+	for key, v := range m {
+		rv[key] = reloadValueJSON{Value: jsonValueOf(v), Reload: string(provconfig.GetReloadBehavior(key))}
+	}
+	return rv
+}
+
+// updatedFieldJSON is the json output of a config value that has been changed.
+type updatedFieldJSON struct {
+	Was   string `json:"was"`
+	IsNow string `json:"is_now"`
+}
+
+// makeUpdatesJSONMap converts the provided updated field map into a map of key to was/is-now values.
+func makeUpdatesJSONMap(m provconfig.UpdatedFieldMap) map[string]updatedFieldJSON {
+	if len(m) == 0 {
+		return nil
+	}
+	rv := make(map[string]updatedFieldJSON, len(m))
+	for key, uf := range m {
+		rv[key] = updatedFieldJSON{Was: uf.Was, IsNow: uf.IsNow}
+	}
+	return rv
+}
+
+// configResetJSON is the json output of resetting config values to their defaults.
+type configResetJSON struct {
+	// Updated are the values that were changed back to their defaults.
+	Updated configFilesJSON[updatedFieldJSON] `json:"updated"`
+	// AlreadyDefault are the keys that were requested, but were already their default values.
+	AlreadyDefault []string `json:"already_default"`
+}
+
+// changedFieldJSON is the json output of a config value and its default.
+type changedFieldJSON struct {
+	Value   interface{} `json:"value"`
+	Default interface{} `json:"default"`
+}
+
+// makeChangedJSONMap converts the provided updated field map into a map of key to value/default.
+// The values are looked up in the provided current and default field value maps so that they have their native json types.
+func makeChangedJSONMap(m provconfig.UpdatedFieldMap, current, defaults provconfig.FieldValueReader) map[string]changedFieldJSON {
+	if len(m) == 0 {
+		return nil
+	}
+	rv := make(map[string]changedFieldJSON, len(m))
+	for key := range m {
+		value, _ := current.Get(key)
+		defValue, _ := defaults.Get(key)
+		rv[key] = changedFieldJSON{Value: jsonValueOf(value), Default: jsonValueOf(defValue)}
+	}
+	return rv
+}
+
+// envFieldJSON is the json output of a config value and the environment variable that can be used to define it.
+type envFieldJSON struct {
+	EnvVar string                 `json:"env_var"`
+	Value  interface{}            `json:"value"`
+	Source provconfig.ValueSource `json:"source"`
+}
+
+// makeEnvJSONMap converts the provided field value map into a map of key to environment variable, value, and source.
+func makeEnvJSONMap(m provconfig.FieldValueMap, sources provconfig.FieldSourceMap) map[string]envFieldJSON {
+	if len(m) == 0 {
+		return nil
+	}
+	rv := make(map[string]envFieldJSON, len(m))
+	for key, v := range m {
+		rv[key] = envFieldJSON{EnvVar: provconfig.EnvVarName(key), Value: jsonValueOf(v), Source: sources.Get(key)}
+	}
+	return rv
+}
+
+// diffFieldJSON is the json output of a config value and the value from another config.
+type diffFieldJSON struct {
+	Value interface{} `json:"value"`
+	Other interface{} `json:"other"`
+}
+
+// configOnlyInJSON is the json output of the config entries that are only in one of two configs being compared.
+type configOnlyInJSON struct {
+	configFilesJSON[interface{}]
+	// Packed are the unknown entries from a packed config (which can't be attributed to a specific config file).
+	Packed map[string]interface{} `json:"packed,omitempty"`
+}
+
+// configDiffJSON is the json output of a comparison of the current config against another.
+type configDiffJSON struct {
+	Other         string                         `json:"other"`
+	Differences   configFilesJSON[diffFieldJSON] `json:"differences"`
+	OnlyInCurrent configOnlyInJSON               `json:"only_in_current"`
+	OnlyInOther   configOnlyInJSON               `json:"only_in_other"`
+}
@@ -6,10 +6,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
+	"net"
 	"os"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -106,6 +112,7 @@ func (s *ConfigTestSuite) getConfigCmd() *cobra.Command {
 	configCmd.SetOut(io.Discard)
 	configCmd.SetErr(io.Discard)
 	configCmd.SetContext(*s.Context)
+	s.Require().NoError(configCmd.PersistentFlags().Set(cmd.FlagNoColor, "true"), "setting --%s", cmd.FlagNoColor)
 	s.Require().NoError(provconfig.LoadConfigFromFiles(configCmd), "loading config from files")
 	return configCmd
 }
@@ -188,9 +195,17 @@ func (s *ConfigTestSuite) makeClientDiffHeaderLines() string {
 	return s.makeConfigDiffHeaderLine(s.HeaderStrClient, s.baseFNClient) + "\n---------------------------------------------"
 }
 
-func (s *ConfigTestSuite) makeTmDeprecatedLines(opt string) string {
-	return "The \"" + opt + "\" option is deprecated and will be removed in a future version.\n" +
-		"Use one of \"cometbft\", \"comet\", or \"cmt\" instead.\n"
+func (s *ConfigTestSuite) makeTmDeprecatedWarningLine(opt string) string {
+	return "warning: the \"" + opt + "\" option is deprecated and will be removed in a future version, " +
+		"use one of \"cometbft\", \"comet\", or \"cmt\" instead\n"
+}
+
+func (s *ConfigTestSuite) makeRestartWarningLine(keys ...string) string {
+	var sb strings.Builder
+	for _, key := range keys {
+		sb.WriteString("warning: " + key + " requires node restart to take effect\n")
+	}
+	return sb.String()
 }
 
 func (s *ConfigTestSuite) makeMultiLine(lines ...string) string {
@@ -240,7 +255,18 @@ func (s *ConfigTestSuite) TestConfigBadArgs() {
 		{
 			name: "set with odd args",
 			args: []string{"set", "output", "text", "banana"},
-			err:  "an even number of arguments are required when setting values",
+			err:  `no value provided for key "banana"`,
+		},
+		{
+			name: "set with no key before equals",
+			args: []string{"set", "=json"},
+			err:  `invalid argument "=json": no key provided before the =`,
+		},
+		{
+			name: "set with ambiguous value",
+			args: []string{"set", "moniker", "output=json"},
+			err: `ambiguous arguments "moniker" "output=json": the second could be the value for moniker or an entry for output; ` +
+				"use moniker=<value> to provide a value for moniker that contains a =",
 		},
 	}
 
@@ -298,6 +324,8 @@ grpc.address="localhost:9090"
 grpc.enable=true
 grpc.max-recv-msg-size=10485760
 grpc.max-send-msg-size=2147483647
+marker.query-timeout="0s"
+marker.restriction-trace=false
 mempool.max-txs=-1
 state-sync.snapshot-interval=0
 state-sync.snapshot-keep-recent=2
@@ -452,7 +480,7 @@ output="text"`,
 	for _, opt := range []string{"tendermint", "tm"} {
 		args := []string{"get", opt}
 		s.Run(strings.Join(args, " "), func() {
-			expTMOut := s.makeTmDeprecatedLines(opt) + cmtOut
+			expTMOut := cmtOut + s.makeTmDeprecatedWarningLine(opt)
 			outStr := s.executeConfigCmd(args...)
 			s.Assert().Equal(expTMOut, outStr)
 		})
@@ -558,6 +586,49 @@ func (s *ConfigTestSuite) TestConfigGetMulti() {
 				"",
 			),
 		},
+		{
+			name: "pattern in app and cometbft",
+			keys: []string{"*.enable"},
+			expected: s.makeMultiLine(
+				s.makeAppConfigHeaderLines(),
+				`api.enable=false`,
+				`grpc-web.enable=true`,
+				`grpc.enable=true`,
+				"",
+				s.makeCMTConfigHeaderLines(),
+				`statesync.enable=false`,
+				"",
+			),
+		},
+		{
+			name: "pattern in cometbft and client",
+			keys: []string{"?ode*"},
+			expected: s.makeMultiLine(
+				s.makeCMTConfigHeaderLines(),
+				`node_key_file="config/node_key.json"`,
+				"",
+				s.makeClientConfigHeaderLines(),
+				`node="tcp://localhost:26657"`,
+				"",
+			),
+		},
+		{
+			name: "pattern and specific key",
+			keys: []string{"p2p.*_peers", "mempool.*-txs", "output"},
+			expected: s.makeMultiLine(
+				s.makeAppConfigHeaderLines(),
+				`mempool.max-txs=-1`,
+				"",
+				s.makeCMTConfigHeaderLines(),
+				`p2p.max_num_inbound_peers=40`,
+				`p2p.max_num_outbound_peers=10`,
+				`p2p.persistent_peers=""`,
+				"",
+				s.makeClientConfigHeaderLines(),
+				`output="text"`,
+				"",
+			),
+		},
 		{
 			name: "loose match",
 			keys: []string{"nod"},
@@ -617,6 +688,41 @@ func (s *ConfigTestSuite) TestConfigGetMulti() {
 		assert.Equal(t, expected, outStr, "%s %s - output", configCmd.Name(), args)
 	})
 
+	s.T().Run("patterns are not special words", func(t *testing.T) {
+		expectedError := "3 configuration keys not found: a?l, cl?ent, client*"
+		expected := s.makeMultiLine(
+			s.makeClientConfigHeaderLines(),
+			`output="text"`,
+			"",
+		) + "Error: " + expectedError + "\n"
+		args := []string{"get", "a?l", "output", "cl?ent", "client*"}
+
+		configCmd := s.getConfigCmd()
+		configCmd.SetArgs(args)
+		b := applyMockIOOutErr(configCmd)
+		err := configCmd.Execute()
+		require.NoError(t, err, "%s %s - expected error executing configCmd", configCmd.Name(), args)
+		out, err := io.ReadAll(b)
+		require.NoError(t, err, "%s %s - unexpected error reading configCmd output", configCmd.Name(), args)
+		outStr := string(out)
+		assert.Equal(t, expected, outStr, "%s %s - output", configCmd.Name(), args)
+	})
+
+	s.T().Run("bad pattern", func(t *testing.T) {
+		expected := `Error: invalid key pattern "p2p.[*": syntax error in pattern` + "\n"
+		args := []string{"get", "output", "p2p.[*"}
+
+		configCmd := s.getConfigCmd()
+		configCmd.SetArgs(args)
+		b := applyMockIOOutErr(configCmd)
+		err := configCmd.Execute()
+		require.NoError(t, err, "%s %s - expected error executing configCmd", configCmd.Name(), args)
+		out, err := io.ReadAll(b)
+		require.NoError(t, err, "%s %s - unexpected error reading configCmd output", configCmd.Name(), args)
+		outStr := string(out)
+		assert.Equal(t, expected, outStr, "%s %s - output", configCmd.Name(), args)
+	})
+
 	s.T().Run("two found one missing", func(t *testing.T) {
 		expectedError := "1 configuration key not found: cannot.find.me"
 		expected := s.makeMultiLine(
@@ -678,8 +784,8 @@ func (s *ConfigTestSuite) TestConfigChanged() {
 		{args: []string{"changed", "app"}, out: expectedAppOut},
 		{args: []string{"changed", "cosmos"}, out: expectedAppOut},
 		{args: []string{"changed", "config"}, out: expectedCMTOut},
-		{args: []string{"changed", "tm"}, out: s.makeTmDeprecatedLines("tm") + expectedCMTOut},
-		{args: []string{"changed", "tendermint"}, out: s.makeTmDeprecatedLines("tendermint") + expectedCMTOut},
+		{args: []string{"changed", "tm"}, out: expectedCMTOut + s.makeTmDeprecatedWarningLine("tm")},
+		{args: []string{"changed", "tendermint"}, out: expectedCMTOut + s.makeTmDeprecatedWarningLine("tendermint")},
 		{args: []string{"changed", "cometbft"}, out: expectedCMTOut},
 		{args: []string{"changed", "comet"}, out: expectedCMTOut},
 		{args: []string{"changed", "cmt"}, out: expectedCMTOut},
@@ -736,6 +842,24 @@ func (s *ConfigTestSuite) TestConfigChanged() {
 				"",
 			),
 		},
+		{
+			args: []string{"changed", "p2p.*_peers", "?ode*"},
+			out: s.makeMultiLine(
+				s.makeCMTDiffHeaderLines(),
+				`node_key_file="config/node_key.json" (same as default)`,
+				`p2p.max_num_inbound_peers=40 (same as default)`,
+				`p2p.max_num_outbound_peers=10 (same as default)`,
+				`p2p.persistent_peers="" (same as default)`,
+				"",
+				s.makeClientDiffHeaderLines(),
+				`node="tcp://localhost:26657" (same as default)`,
+				"",
+			),
+		},
+		{
+			args: []string{"changed", "all*", "nope.*"},
+			out:  "Error: 2 configuration keys not found: all*, nope.*\n",
+		},
 		{
 			args: []string{"changed", "nod"},
 			out: s.makeMultiLine(
@@ -775,6 +899,325 @@ func (s *ConfigTestSuite) TestConfigChanged() {
 	})
 }
 
+func (s *ConfigTestSuite) TestConfigChangedWrite() {
+	// getConfigCmdForHome gets a config command (with the config loaded) for the provided home directory.
+	getConfigCmdForHome := func(home string) *cobra.Command {
+		clientCtx := client.Context{}.
+			WithCodec(s.EncodingConfig.Marshaler).
+			WithHomeDir(home)
+		clientCtx.Viper = viper.New()
+		serverCtx := server.NewContext(clientCtx.Viper, provconfig.DefaultCmtConfig(), log.NewNopLogger())
+		ctx := context.Background()
+		ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+		ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+		configCmd := cmd.ConfigCmd()
+		configCmd.SetOut(io.Discard)
+		configCmd.SetErr(io.Discard)
+		configCmd.SetContext(ctx)
+		s.Require().NoError(configCmd.PersistentFlags().Set(cmd.FlagNoColor, "true"), "setting --%s", cmd.FlagNoColor)
+		s.Require().NoError(provconfig.LoadConfigFromFiles(configCmd), "loading config from files")
+		return configCmd
+	}
+
+	s.executeConfigCmd("set", "api.enable", "true", "log_format", "json", "chain-id", "roundtrip")
+	writeFile := filepath.Join(s.T().TempDir(), "overrides.json")
+
+	s.Run("write all changed", func() {
+		outStr := s.executeConfigCmd("changed", "--write", writeFile)
+		s.Assert().Contains(outStr, "Changed values written to: "+writeFile, "changed output")
+		contents, err := os.ReadFile(writeFile)
+		s.Require().NoError(err, "reading written file")
+		var written map[string]string
+		s.Require().NoError(json.Unmarshal(contents, &written), "json.Unmarshal written file")
+		expected := map[string]string{"api.enable": "true", "log_format": "json", "chain-id": "roundtrip"}
+		s.Assert().Equal(expected, written, "written file contents")
+	})
+
+	s.Run("existing file without force", func() {
+		before, err := os.ReadFile(writeFile)
+		s.Require().NoError(err, "reading written file before")
+		outStr := s.executeConfigCmd("changed", "app", "--write", writeFile)
+		s.Assert().Equal(fmt.Sprintf("Error: file %q already exists\n", writeFile), outStr, "changed output")
+		after, err := os.ReadFile(writeFile)
+		s.Require().NoError(err, "reading written file after")
+		s.Assert().Equal(string(before), string(after), "file contents")
+	})
+
+	s.Run("only selected sections with force", func() {
+		sectionFile := filepath.Join(s.T().TempDir(), "sections.json")
+		s.executeConfigCmd("changed", "client", "log_format", "api.enable", "output", "--write", sectionFile)
+		s.executeConfigCmd("changed", "client", "log_format", "--write", sectionFile, "--force")
+		contents, err := os.ReadFile(sectionFile)
+		s.Require().NoError(err, "reading written file")
+		var written map[string]string
+		s.Require().NoError(json.Unmarshal(contents, &written), "json.Unmarshal written file")
+		expected := map[string]string{"log_format": "json", "chain-id": "roundtrip"}
+		s.Assert().Equal(expected, written, "written file contents")
+	})
+
+	s.Run("round trip to fresh home", func() {
+		freshHome := s.T().TempDir()
+		freshConfigDir := filepath.Join(freshHome, "config")
+		s.Require().NoError(os.MkdirAll(freshConfigDir, 0o755), "MkdirAll(%q)", freshConfigDir)
+		contents, err := os.ReadFile(writeFile)
+		s.Require().NoError(err, "reading written file")
+		packedFile := filepath.Join(freshConfigDir, provconfig.PackedConfFilename)
+		s.Require().NoError(os.WriteFile(packedFile, contents, 0o644), "WriteFile(%q)", packedFile)
+
+		s.executeCmd(getConfigCmdForHome(freshHome), "unpack")
+		s.Assert().False(provconfig.FileExists(packedFile), "file exists: packed")
+
+		freshCmd := getConfigCmdForHome(freshHome)
+		_, freshApp, err := provconfig.ExtractAppConfigAndMap(freshCmd)
+		s.Require().NoError(err, "fresh ExtractAppConfigAndMap")
+		_, freshCmt, err := provconfig.ExtractCmtConfigAndMap(freshCmd)
+		s.Require().NoError(err, "fresh ExtractCmtConfigAndMap")
+		_, freshClient, err := provconfig.ExtractClientConfigAndMap(freshCmd)
+		s.Require().NoError(err, "fresh ExtractClientConfigAndMap")
+
+		origCmd := s.getConfigCmd()
+		_, origApp, err := provconfig.ExtractAppConfigAndMap(origCmd)
+		s.Require().NoError(err, "orig ExtractAppConfigAndMap")
+		_, origCmt, err := provconfig.ExtractCmtConfigAndMap(origCmd)
+		s.Require().NoError(err, "orig ExtractCmtConfigAndMap")
+		_, origClient, err := provconfig.ExtractClientConfigAndMap(origCmd)
+		s.Require().NoError(err, "orig ExtractClientConfigAndMap")
+
+		s.Assert().Empty(provconfig.MakeUpdatedFieldMap(origApp, freshApp, true), "app config differences")
+		s.Assert().Empty(provconfig.MakeUpdatedFieldMap(origCmt, freshCmt, true), "cometbft config differences")
+		s.Assert().Empty(provconfig.MakeUpdatedFieldMap(origClient, freshClient, true), "client config differences")
+	})
+}
+
+func (s *ConfigTestSuite) TestConfigDiff() {
+	diffHeader := func(t, fn string) string {
+		lead := t + " Config Differences from Other:"
+		return fmt.Sprintf("%s %s/config/%s (or env)\n%s", lead, s.Home, fn, strings.Repeat("-", len(lead)+5))
+	}
+	appHeader := diffHeader(s.HeaderStrApp, s.BaseFNApp)
+	cmtHeader := diffHeader(s.HeaderStrCMT, s.BaseFNCMT)
+	clientHeader := diffHeader(s.HeaderStrClient, s.baseFNClient)
+	allEqual := func(t string) string {
+		return fmt.Sprintf("All %s config values equal the other config values.", t)
+	}
+
+	// The other home has a packed config with a few changes (and an entry that isn't known).
+	otherHome := s.T().TempDir()
+	otherConfigDir := filepath.Join(otherHome, "config")
+	s.Require().NoError(os.MkdirAll(otherConfigDir, 0o755), "MkdirAll(%q)", otherConfigDir)
+	packedFile := filepath.Join(otherConfigDir, provconfig.PackedConfFilename)
+	packedContents := `{"api.enable": "true", "log_format": "json", "output": "json", "from-the-future": "yes"}`
+	s.Require().NoError(os.WriteFile(packedFile, []byte(packedContents), 0o644), "WriteFile(%q)", packedFile)
+
+	// This app config file only has a change and an entry that isn't known.
+	appFile := filepath.Join(s.T().TempDir(), "mainnet-app.toml")
+	appContents := "[api]\nenable = true\n\n[brand-new]\nthing = \"x\"\n"
+	s.Require().NoError(os.WriteFile(appFile, []byte(appContents), 0o644), "WriteFile(%q)", appFile)
+
+	s.Run("same home", func() {
+		outStr := s.executeConfigCmd("diff", s.Home)
+		expected := s.makeMultiLine(
+			appHeader, allEqual("app"), "",
+			cmtHeader, allEqual("cometbft"), "",
+			clientHeader, allEqual("client"), "",
+			"Other config: "+s.Home, "",
+		)
+		s.Assert().Equal(expected, outStr, "diff output")
+	})
+
+	s.Run("packed other home", func() {
+		outStr := s.executeConfigCmd("diff", otherHome)
+		expected := s.makeMultiLine(
+			appHeader, "api.enable=false (other=true)", "",
+			cmtHeader, `log_format="plain" (other="json")`, "",
+			clientHeader, `output="text" (other="json")`, "",
+			"Unknown Packed Config Entries:",
+			"------------------------------",
+			"Only in other packed config:",
+			`from-the-future="yes"`, "",
+			"Other config: "+otherHome, "",
+		)
+		s.Assert().Equal(expected, outStr, "diff output")
+	})
+
+	s.Run("packed file restricted to client", func() {
+		outStr := s.executeConfigCmd("diff", packedFile, "client")
+		expected := s.makeMultiLine(
+			clientHeader, `output="text" (other="json")`, "",
+			"Other config: "+packedFile, "",
+		)
+		s.Assert().Equal(expected, outStr, "diff output")
+	})
+
+	s.Run("unpacked app file", func() {
+		outStr := s.executeConfigCmd("diff", appFile)
+		expected := s.makeMultiLine(
+			appHeader, "api.enable=false (other=true)", "",
+			"Only in other app config:",
+			`brand-new.thing="x"`, "",
+			cmtHeader, fmt.Sprintf("The other config (%s) does not have a cometbft config.", appFile), "",
+			clientHeader, fmt.Sprintf("The other config (%s) does not have a client config.", appFile), "",
+			"Other config: "+appFile, "",
+		)
+		s.Assert().Equal(expected, outStr, "diff output")
+	})
+
+	s.Run("entry only in current", func() {
+		clientFile := filepath.Join(s.Home, "config", s.baseFNClient)
+		orig, err := os.ReadFile(clientFile)
+		s.Require().NoError(err, "ReadFile(%q)", clientFile)
+		defer func() {
+			s.Require().NoError(os.WriteFile(clientFile, orig, 0o644), "restoring %q", clientFile)
+		}()
+		s.Require().NoError(os.WriteFile(clientFile, append(orig, []byte("\nold-thing = 5\n")...), 0o644), "WriteFile(%q)", clientFile)
+
+		outStr := s.executeConfigCmd("diff", s.Home, "client")
+		expected := s.makeMultiLine(
+			clientHeader, allEqual("client"), "",
+			"Other config: "+s.Home, "",
+		)
+		s.Assert().Equal(expected, outStr, "diff output against same home")
+
+		outStr = s.executeConfigCmd("diff", otherHome, "client")
+		expected = s.makeMultiLine(
+			clientHeader, `output="text" (other="json")`, "",
+			"Only in current client config:",
+			"old-thing=5", "",
+			"Other config: "+otherHome, "",
+		)
+		s.Assert().Equal(expected, outStr, "diff output against other home")
+	})
+
+	s.Run("json", func() {
+		configCmd := s.getConfigCmd()
+		configCmd.SetArgs([]string{"diff", otherHome, "-o", "json"})
+		var stdout, stderr bytes.Buffer
+		configCmd.SetOut(&stdout)
+		configCmd.SetErr(&stderr)
+		s.Require().NoError(configCmd.Execute(), "Execute")
+		s.Assert().Empty(stderr.String(), "stderr")
+
+		var out struct {
+			Diff struct {
+				Other       string                                       `json:"other"`
+				Differences map[string]map[string]map[string]interface{} `json:"differences"`
+				OnlyInOther map[string]map[string]interface{}            `json:"only_in_other"`
+			} `json:"diff"`
+		}
+		s.Require().NoError(json.Unmarshal(stdout.Bytes(), &out), "json.Unmarshal(stdout):\n%s", stdout.String())
+		s.Assert().Equal(otherHome, out.Diff.Other, "other")
+		s.Assert().Equal(map[string]interface{}{"value": false, "other": true}, out.Diff.Differences["app"]["api.enable"], "api.enable")
+		s.Assert().Equal(map[string]interface{}{"value": "plain", "other": "json"}, out.Diff.Differences["cometbft"]["log_format"], "log_format")
+		s.Assert().Equal(map[string]interface{}{"value": "text", "other": "json"}, out.Diff.Differences["client"]["output"], "output")
+		s.Assert().Equal(map[string]map[string]interface{}{"packed": {"from-the-future": "yes"}}, out.Diff.OnlyInOther, "only_in_other")
+	})
+
+	unknownFile := filepath.Join(otherHome, "notes.txt")
+	s.Require().NoError(os.WriteFile(unknownFile, []byte("hello"), 0o644), "WriteFile(%q)", unknownFile)
+
+	errorTests := []struct {
+		name string
+		args []string
+		exp  string
+	}{
+		{
+			name: "unknown section",
+			args: []string{"diff", otherHome, "bananas"},
+			exp:  `Error: unknown config section "bananas": must be one of "all", "app", "cmt", or "client"` + "\n",
+		},
+		{
+			name: "path does not exist",
+			args: []string{"diff", filepath.Join(otherHome, "nope")},
+			exp:  fmt.Sprintf("Error: could not load config from %q: stat %s: no such file or directory\n", filepath.Join(otherHome, "nope"), filepath.Join(otherHome, "nope")),
+		},
+		{
+			name: "no config files",
+			args: []string{"diff", otherConfigDir},
+			exp:  fmt.Sprintf("Error: no config files found in %q\n", filepath.Join(otherConfigDir, "config")),
+		},
+		{
+			name: "unknown file type",
+			args: []string{"diff", unknownFile},
+			exp:  fmt.Sprintf(`Error: unknown config file type %q: expected a name ending with ".json", "app.toml", "config.toml", or "client.toml"`+"\n", unknownFile),
+		},
+	}
+
+	for _, tc := range errorTests {
+		s.Run(tc.name, func() {
+			outStr := s.executeConfigCmd(tc.args...)
+			s.Assert().Equal(tc.exp, outStr, "diff output")
+		})
+	}
+}
+
+func (s *ConfigTestSuite) TestConfigEnv() {
+	s.executeConfigCmd("set", "api.enable", "true", "chain-id", "envtest", "moniker", "it's a node")
+
+	s.Run("values from files", func() {
+		outStr := s.executeConfigCmd("env", "api.enable", "moniker", "chain-id")
+		s.Assert().Contains(outStr, "api.enable: PIO_API_ENABLE=true (file)\n", "env output")
+		s.Assert().Contains(outStr, "moniker: PIO_MONIKER=it's a node (file)\n", "env output")
+		s.Assert().Contains(outStr, "chain-id: PIO_CHAIN_ID=envtest (file)\n", "env output")
+	})
+
+	s.Run("value from env", func() {
+		s.T().Setenv("PIO_API_SWAGGER", "true")
+		outStr := s.executeConfigCmd("env", "api.swagger")
+		s.Assert().Contains(outStr, "api.swagger: PIO_API_SWAGGER=", "env output")
+		s.Assert().Contains(outStr, "(env)\n", "env output")
+	})
+
+	s.Run("parent field and pattern", func() {
+		outStr := s.executeConfigCmd("env", "telemetry", "p2p.*_peers")
+		s.Assert().Contains(outStr, "telemetry.service-name: PIO_TELEMETRY_SERVICE_NAME=", "env output")
+		s.Assert().Contains(outStr, "p2p.persistent_peers: PIO_P2P_PERSISTENT_PEERS=", "env output")
+		s.Assert().NotContains(outStr, "api.enable", "env output")
+	})
+
+	s.Run("unknown key", func() {
+		outStr := s.executeConfigCmd("env", "bananas")
+		s.Assert().Equal("Error: 1 configuration key not found: bananas\n", outStr, "env output")
+	})
+
+	s.Run("json", func() {
+		configCmd := s.getConfigCmd()
+		configCmd.SetArgs([]string{"env", "api.enable", "-o", "json"})
+		var stdout, stderr bytes.Buffer
+		configCmd.SetOut(&stdout)
+		configCmd.SetErr(&stderr)
+		s.Require().NoError(configCmd.Execute(), "Execute")
+		s.Assert().Empty(stderr.String(), "stderr")
+
+		var out struct {
+			Env map[string]map[string]map[string]interface{} `json:"env"`
+		}
+		s.Require().NoError(json.Unmarshal(stdout.Bytes(), &out), "json.Unmarshal(stdout):\n%s", stdout.String())
+		exp := map[string]interface{}{"env_var": "PIO_API_ENABLE", "value": true, "source": "file"}
+		s.Assert().Equal(exp, out.Env["app"]["api.enable"], "api.enable")
+	})
+
+	s.Run("export", func() {
+		outStr := s.executeConfigCmd("env", "--"+cmd.FlagExport)
+		s.Assert().Contains(outStr, "export PIO_API_ENABLE=true\n", "export output")
+		s.Assert().Contains(outStr, "export PIO_CHAIN_ID=envtest\n", "export output")
+		s.Assert().Contains(outStr, `export PIO_MONIKER='it'\''s a node'`+"\n", "export output")
+		s.Assert().NotContains(outStr, "PIO_API_SWAGGER", "export output")
+	})
+
+	s.Run("export limited to keys", func() {
+		outStr := s.executeConfigCmd("env", "--"+cmd.FlagExport, "api")
+		s.Assert().Equal("export PIO_API_ENABLE=true\n", outStr, "export output")
+	})
+
+	s.Run("packed", func() {
+		s.executeConfigCmd("pack")
+		outStr := s.executeConfigCmd("env", "api.enable", "api.swagger")
+		s.Assert().Contains(outStr, "api.enable: PIO_API_ENABLE=true (file)\n", "env output")
+		s.Assert().Contains(outStr, "api.swagger: PIO_API_SWAGGER=false (default)\n", "env output")
+	})
+}
+
 func (s *ConfigTestSuite) TestConfigSetValidation() {
 	tests := []struct {
 		name string
@@ -786,6 +1229,11 @@ func (s *ConfigTestSuite) TestConfigSetValidation() {
 			args: []string{"set", "minimum-gas-prices", ""},
 			out:  `App config validation error: set min gas price in app.toml or flag or env variable: error in app.toml [cosmos/cosmos-sdk@v0.43.0/types/errors/errors.go:269]`,
 		},
+		{
+			name: "set app marker section fails validation",
+			args: []string{"set", "--", "marker.query-timeout", "-5s"},
+			out:  `App config validation error: marker.query-timeout cannot be negative`,
+		},
 		{
 			name: "set cometbft fails validation",
 			args: []string{"set", "log_format", "crazy"},
@@ -856,6 +1304,12 @@ func (s *ConfigTestSuite) TestConfigCmdSet() {
 			newVal:  `"banana"`,
 			toMatch: []*regexp.Regexp{reAppConfigUpdated},
 		},
+		{
+			name:    "marker.query-timeout",
+			oldVal:  `"0s"`,
+			newVal:  `"5s"`,
+			toMatch: []*regexp.Regexp{reAppConfigUpdated},
+		},
 
 		// cometbft fields
 		{
@@ -956,7 +1410,7 @@ func (s *ConfigTestSuite) TestConfigSetMulti() {
 				s.makeAppConfigUpdateLines(),
 				s.makeKeyUpdatedLine("api.enable", "false", "true"),
 				s.makeKeyUpdatedLine("telemetry.service-name", `""`, `"blocky"`),
-				""),
+				"") + s.makeRestartWarningLine("api.enable", "telemetry.service-name"),
 		},
 		{
 			name: "two cometbft entries",
@@ -965,7 +1419,7 @@ func (s *ConfigTestSuite) TestConfigSetMulti() {
 				s.makeCMTConfigUpdateLines(),
 				s.makeKeyUpdatedLine("log_format", `"plain"`, `"json"`),
 				s.makeKeyUpdatedLine("consensus.timeout_commit", fmt.Sprintf("%q", provconfig.DefaultConsensusTimeoutCommit), `"950ms"`),
-				""),
+				"") + s.makeRestartWarningLine("log_format", "consensus.timeout_commit"),
 		},
 		{
 			name: "two client entries",
@@ -997,7 +1451,7 @@ func (s *ConfigTestSuite) TestConfigSetMulti() {
 				s.makeClientConfigUpdateLines(),
 				s.makeKeyUpdatedLine("node", `"tcp://127.0.0.1:26657"`, `"tcp://localhost:26657"`),
 				s.makeKeyUpdatedLine("output", `"json"`, `"text"`),
-				""),
+				"") + s.makeRestartWarningLine("api.swagger", "telemetry.service-name", "log_format", "consensus.timeout_commit"),
 		},
 	}
 
@@ -1016,6 +1470,444 @@ func (s *ConfigTestSuite) TestConfigSetMulti() {
 	}
 }
 
+func (s *ConfigTestSuite) TestConfigSetInputStyles() {
+	configDir := filepath.Join(s.Home, "config")
+	writeFile := func(name, contents string) string {
+		path := filepath.Join(s.T().TempDir(), name)
+		s.Require().NoError(os.WriteFile(path, []byte(contents), 0o644), "writing %s", name)
+		return path
+	}
+	tomlFile := writeFile("overrides.toml", `log_format = "json"
+output = "json"
+
+[api]
+enable = true
+
+[telemetry]
+service-name = "a=b"
+`)
+	jsonFile := writeFile("overrides.json", `{"api.enable": true, "telemetry": {"service-name": "a=b"}, "log_format": "json", "output": "json"}`)
+	partialFile := writeFile("partial.json", `{"api": {"enable": true}, "telemetry.service-name": "a=b"}`)
+	otherFile := writeFile("other.json", `{"api": {"enable": true}, "telemetry.service-name": "other", "log_format": "json", "output": "text"}`)
+
+	resetArgs := []string{"set", "api.enable", "false", "telemetry.service-name", "", "log_format", "plain", "output", "text"}
+	expOut := s.makeMultiLine(
+		s.makeAppConfigUpdateLines(),
+		s.makeKeyUpdatedLine("api.enable", "false", "true"),
+		s.makeKeyUpdatedLine("telemetry.service-name", `""`, `"a=b"`),
+		"",
+		s.makeCMTConfigUpdateLines(),
+		s.makeKeyUpdatedLine("log_format", `"plain"`, `"json"`),
+		"",
+		s.makeClientConfigUpdateLines(),
+		s.makeKeyUpdatedLine("output", `"text"`, `"json"`),
+		"") + s.makeRestartWarningLine("api.enable", "telemetry.service-name", "log_format")
+
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{
+			name: "pairs",
+			args: []string{"set", "api.enable", "true", "telemetry.service-name", "a=b", "log_format", "json", "output", "json"},
+		},
+		{
+			name: "key=value",
+			args: []string{"set", "api.enable=true", "telemetry.service-name=a=b", "log_format=json", "output=json"},
+		},
+		{
+			name: "mixed",
+			args: []string{"set", "api.enable=true", "telemetry.service-name", "a=b", "log_format", "json", "output=json"},
+		},
+		{
+			name: "toml file",
+			args: []string{"set", "--" + cmd.FlagFromFile, tomlFile},
+		},
+		{
+			name: "json file",
+			args: []string{"set", "--" + cmd.FlagFromFile, jsonFile},
+		},
+		{
+			name: "file and args",
+			args: []string{"set", "--" + cmd.FlagFromFile, partialFile, "log_format", "json", "output=json"},
+		},
+		{
+			name: "args override file",
+			args: []string{"set", "--" + cmd.FlagFromFile, otherFile, "telemetry.service-name=a=b", "output", "json"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.executeConfigCmd(resetArgs...)
+			out := s.executeConfigCmd(tc.args...)
+			s.Assert().Equal(expOut, out, "%s output", tc.args)
+		})
+	}
+
+	s.Run("errors update nothing", func() {
+		s.executeConfigCmd(resetArgs...)
+		origApp, err := os.ReadFile(filepath.Join(configDir, s.BaseFNApp))
+		s.Require().NoError(err, "reading app config file")
+
+		badFile := writeFile("bad.toml", `log_format = "json"
+
+[api]
+enable = true
+not-a-key = 5
+`)
+		out := s.executeConfigCmd("set", "--"+cmd.FlagFromFile, badFile)
+		s.Assert().Contains(out, "Configuration key api.not-a-key does not exist.", "output")
+		s.Assert().Contains(out, "Error: one or more issues encountered; no configuration values have been updated", "output")
+
+		newApp, err := os.ReadFile(filepath.Join(configDir, s.BaseFNApp))
+		s.Require().NoError(err, "reading app config file after failed set")
+		s.Assert().Equal(string(origApp), string(newApp), "app config file after failed set")
+	})
+
+	s.Run("file errors", func() {
+		tests := []struct {
+			name string
+			file string
+			exp  string
+		}{
+			{name: "unknown type", file: writeFile("overrides.yaml", "output: json\n"), exp: "unsupported file type"},
+			{name: "missing file", file: filepath.Join(s.Home, "nope.toml"), exp: "could not read"},
+			{name: "empty file", file: writeFile("empty.json", "{}"), exp: "no entries found in"},
+			{name: "invalid json", file: writeFile("invalid.json", "{not json"), exp: "could not read"},
+		}
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				out := s.executeConfigCmd("set", "--"+cmd.FlagFromFile, tc.file)
+				s.Assert().Contains(out, "Error: ", "output")
+				s.Assert().Contains(out, tc.exp, "output")
+			})
+		}
+	})
+}
+
+func (s *ConfigTestSuite) TestConfigReset() {
+	s.Run("no keys", func() {
+		configCmd := s.getConfigCmd()
+		configCmd.SetArgs([]string{"reset"})
+		applyMockIOOutErr(configCmd)
+		err := configCmd.Execute()
+		s.Assert().EqualError(err, "no keys provided", "config reset error")
+	})
+
+	s.Run("all without yes", func() {
+		configCmd := s.getConfigCmd()
+		configCmd.SetArgs([]string{"reset", "all"})
+		applyMockIOOutErr(configCmd)
+		err := configCmd.Execute()
+		s.Assert().EqualError(err, "resetting all configuration values requires the --yes flag", "config reset all error")
+	})
+
+	s.Run("unknown keys", func() {
+		outStr := s.executeConfigCmd("reset", "output", "bananas", "nope.*")
+		s.Assert().Equal("Error: 2 configuration keys not found: bananas, nope.*\n", outStr, "config reset output")
+	})
+
+	s.Run("already default", func() {
+		outStr := s.executeConfigCmd("reset", "output", "api.enable")
+		s.Assert().Equal("Already default: api.enable, output\n", outStr, "config reset output")
+	})
+
+	s.executeConfigCmd("set", "api.enable", "true", "log_format", "json", "p2p.max_num_inbound_peers", "41", "output", "json")
+
+	s.Run("keys across all files", func() {
+		expected := s.makeMultiLine(
+			s.makeAppConfigUpdateLines(),
+			s.makeKeyUpdatedLine("api.enable", "true", "false"),
+			"",
+			s.makeCMTConfigUpdateLines(),
+			s.makeKeyUpdatedLine("log_format", `"json"`, `"plain"`),
+			s.makeKeyUpdatedLine("p2p.max_num_inbound_peers", "41", "40"),
+			"",
+			s.makeClientConfigUpdateLines(),
+			s.makeKeyUpdatedLine("output", `"json"`, `"text"`),
+			"",
+			"Already default: p2p.max_num_outbound_peers, p2p.persistent_peers",
+		) + s.makeRestartWarningLine("api.enable", "log_format", "p2p.max_num_inbound_peers")
+		outStr := s.executeConfigCmd("reset", "api.enable", "log_format", "p2p.*_peers", "output")
+		s.Assert().Equal(expected, outStr, "config reset output")
+
+		changed := s.executeConfigCmd("changed", "-o", "json")
+		s.Assert().JSONEq(`{"changed":{},"warnings":[]}`, changed, "config changed output after reset")
+	})
+
+	s.executeConfigCmd("set", "moniker", "resetme", "telemetry.service-name", "resetme", "broadcast-mode", "async")
+
+	s.Run("all with yes", func() {
+		outStr := s.executeConfigCmd("reset", "all", "--yes")
+		s.Assert().Contains(outStr, s.makeKeyUpdatedLine("telemetry.service-name", `"resetme"`, `""`), "config reset all output")
+		s.Assert().Contains(outStr, s.makeKeyUpdatedLine("broadcast-mode", `"async"`, `"sync"`), "config reset all output")
+		s.Assert().Contains(outStr, "moniker Was: \"resetme\", Is Now: ", "config reset all output")
+
+		changed := s.executeConfigCmd("changed", "-o", "json")
+		s.Assert().JSONEq(`{"changed":{},"warnings":[]}`, changed, "config changed output after reset all")
+	})
+
+	s.Run("json output", func() {
+		s.executeConfigCmd("set", "output", "json")
+		expected := `{
+  "reset": {
+    "updated": {
+      "client": {
+        "output": {
+          "was": "\"json\"",
+          "is_now": "\"text\""
+        }
+      }
+    },
+    "already_default": [
+      "node"
+    ]
+  },
+  "warnings": []
+}
+`
+		outStr := s.executeConfigCmd("reset", "output", "node", "-o", "json")
+		s.Assert().Equal(expected, outStr, "config reset json output")
+	})
+
+	s.Run("invalid result", func() {
+		s.executeConfigCmd("set",
+			"statesync.enable", "true",
+			"statesync.rpc_servers", `["tcp://a:1", "tcp://b:2"]`,
+			"statesync.trust_height", "5",
+			"statesync.trust_hash", "ABCDEF0123456789ABCDEF0123456789ABCDEF0123456789ABCDEF0123456789",
+			"output", "json",
+		)
+		expected := s.makeMultiLine(
+			"CometBFT config validation error: error in [statesync] section: rpc_servers is required",
+			"Error: one or more issues encountered; no configuration values have been updated",
+		)
+		outStr := s.executeConfigCmd("reset", "statesync.rpc_servers", "output")
+		s.Assert().Equal(expected, outStr, "config reset output")
+
+		values := s.executeConfigCmd("get", "statesync.rpc_servers", "output")
+		s.Assert().Contains(values, `statesync.rpc_servers=["tcp://a:1", "tcp://b:2"]`, "config get output after failed reset")
+		s.Assert().Contains(values, `output="json"`, "config get output after failed reset")
+	})
+}
+
+func (s *ConfigTestSuite) TestConfigPin() {
+	pinnedFile := filepath.Join(s.Home, "config", provconfig.PinnedKeysFilename)
+
+	s.Run("nothing pinned", func() {
+		outStr := s.executeConfigCmd("pin")
+		s.Assert().Equal("No configuration keys are pinned.\n", outStr, "config pin output")
+	})
+
+	s.Run("unknown keys", func() {
+		outStr := s.executeConfigCmd("pin", "chain-id", "bananas")
+		s.Assert().Equal("Error: 1 configuration key not found: bananas\n", outStr, "config pin output")
+		_, err := os.Stat(pinnedFile)
+		s.Assert().ErrorIs(err, os.ErrNotExist, "pinned keys file after failed pin")
+	})
+
+	s.Run("pin keys", func() {
+		outStr := s.executeConfigCmd("pin", "moniker", "chain-id")
+		s.Assert().Equal("Pinned: chain-id, moniker\n", outStr, "config pin output")
+		s.Assert().FileExists(pinnedFile, "pinned keys file")
+		outStr = s.executeConfigCmd("pin", "minimum-gas-prices", "moniker")
+		s.Assert().Equal("Pinned: minimum-gas-prices\nAlready pinned: moniker\n", outStr, "config pin output")
+	})
+
+	s.Run("list pinned", func() {
+		outStr := s.executeConfigCmd("pin")
+		s.Assert().Equal("chain-id\nminimum-gas-prices\nmoniker\n", outStr, "config pin output")
+		outStr = s.executeConfigCmd("pin", "-o", "json")
+		s.Assert().JSONEq(`{"pinned":["chain-id","minimum-gas-prices","moniker"],"warnings":[]}`, outStr, "config pin json output")
+	})
+
+	s.Run("set pinned key", func() {
+		outStr := s.executeConfigCmd("set", "moniker", "changed", "output", "json")
+		s.Assert().Equal("Error: 1 pinned configuration key cannot be modified without the --override-pinned flag: "+
+			"moniker; no configuration values have been updated\n", outStr, "config set output")
+		values := s.executeConfigCmd("get", "moniker", "output")
+		s.Assert().NotContains(values, `moniker="changed"`, "config get output after blocked set")
+		s.Assert().Contains(values, `output="text"`, "config get output after blocked set")
+	})
+
+	s.Run("set pinned key with override", func() {
+		outStr := s.executeConfigCmd("set", "moniker", "changed", "--override-pinned")
+		s.Assert().Contains(outStr, `Is Now: "changed"`, "config set output")
+		values := s.executeConfigCmd("get", "moniker")
+		s.Assert().Contains(values, `moniker="changed"`, "config get output after override set")
+	})
+
+	s.Run("reset pinned keys", func() {
+		outStr := s.executeConfigCmd("reset", "all", "--yes")
+		s.Assert().Equal("Error: 1 pinned configuration key cannot be modified without the --override-pinned flag: "+
+			"moniker; no configuration values have been updated\n", outStr, "config reset output")
+		outStr = s.executeConfigCmd("reset", "moniker", "--override-pinned")
+		s.Assert().Contains(outStr, `moniker Was: "changed", Is Now: `, "config reset output")
+	})
+
+	s.Run("unpin", func() {
+		configCmd := s.getConfigCmd()
+		configCmd.SetArgs([]string{"unpin"})
+		applyMockIOOutErr(configCmd)
+		err := configCmd.Execute()
+		s.Assert().EqualError(err, "no keys provided", "config unpin error")
+
+		outStr := s.executeConfigCmd("unpin", "moniker", "output")
+		s.Assert().Equal("Unpinned: moniker\nNot pinned: output\n", outStr, "config unpin output")
+		outStr = s.executeConfigCmd("set", "moniker", "unpinned")
+		s.Assert().Contains(outStr, `Is Now: "unpinned"`, "config set output after unpin")
+
+		outStr = s.executeConfigCmd("unpin", "chain-id", "minimum-gas-prices", "-o", "json")
+		s.Assert().JSONEq(`{"pinned":[],"warnings":[]}`, outStr, "config unpin json output")
+		_, err = os.Stat(pinnedFile)
+		s.Assert().ErrorIs(err, os.ErrNotExist, "pinned keys file after unpinning everything")
+	})
+}
+
+func (s *ConfigTestSuite) TestConfigAlias() {
+	aliasFile := filepath.Join(s.Home, "config", provconfig.KeyAliasesFilename)
+
+	s.Run("nothing defined", func() {
+		outStr := s.executeConfigCmd("alias", "list")
+		s.Assert().Equal("No key aliases are defined.\n", outStr, "config alias list output")
+	})
+
+	s.Run("add with unknown keys", func() {
+		outStr := s.executeConfigCmd("alias", "add", "perf", "mempool.size", "bananas")
+		s.Assert().Equal("Error: 1 configuration key not found: bananas\n", outStr, "config alias add output")
+		_, err := os.Stat(aliasFile)
+		s.Assert().ErrorIs(err, os.ErrNotExist, "key aliases file after failed add")
+	})
+
+	s.Run("add with invalid name", func() {
+		outStr := s.executeConfigCmd("alias", "add", "Perf", "mempool.size")
+		s.Assert().Contains(outStr, `Error: invalid key alias name "Perf"`, "config alias add output")
+	})
+
+	s.Run("add", func() {
+		outStr := s.executeConfigCmd("alias", "add", "perf", "mempool.size", "consensus.timeout_commit")
+		s.Assert().Equal("Alias @perf: mempool.size, consensus.timeout_commit\n", outStr, "config alias add output")
+		outStr = s.executeConfigCmd("alias", "add", "@cli", "output", "chain-id")
+		s.Assert().Equal("Alias @cli: output, chain-id\n", outStr, "config alias add output")
+		s.Assert().FileExists(aliasFile, "key aliases file")
+	})
+
+	s.Run("list", func() {
+		outStr := s.executeConfigCmd("alias", "list")
+		s.Assert().Equal("@cli: output, chain-id\n@perf: mempool.size, consensus.timeout_commit\n", outStr, "config alias list output")
+		outStr = s.executeConfigCmd("alias", "list", "-o", "json")
+		s.Assert().JSONEq(`{"aliases":{"@cli":["output","chain-id"],"@perf":["mempool.size","consensus.timeout_commit"]},"warnings":[]}`,
+			outStr, "config alias list json output")
+	})
+
+	s.Run("get", func() {
+		outStr := s.executeConfigCmd("get", "@perf")
+		s.Assert().True(strings.HasPrefix(outStr, "Alias @perf: mempool.size, consensus.timeout_commit\n"), "config get output:\n%s", outStr)
+		s.Assert().Contains(outStr, "mempool.size=5000\n", "config get output")
+		s.Assert().Contains(outStr, "consensus.timeout_commit=", "config get output")
+		s.Assert().NotContains(outStr, "chain-id", "config get output")
+	})
+
+	s.Run("get json", func() {
+		outStr := s.executeConfigCmd("get", "@cli", "moniker", "-o", "json")
+		var out struct {
+			Values  map[string]map[string]interface{} `json:"values"`
+			Aliases map[string][]string               `json:"aliases"`
+		}
+		s.Require().NoError(json.Unmarshal([]byte(outStr), &out), "json.Unmarshal(output):\n%s", outStr)
+		s.Assert().Equal(map[string][]string{"@cli": {"output", "chain-id"}}, out.Aliases, "aliases")
+		s.Assert().Contains(out.Values["client"], "output", "client values")
+		s.Assert().Contains(out.Values["client"], "chain-id", "client values")
+		s.Assert().Contains(out.Values["cometbft"], "moniker", "cometbft values")
+	})
+
+	s.Run("changed", func() {
+		s.executeConfigCmd("set", "output", "json")
+		outStr := s.executeConfigCmd("changed", "@cli")
+		s.Assert().True(strings.HasPrefix(outStr, "Alias @cli: output, chain-id\n"), "config changed output:\n%s", outStr)
+		s.Assert().Contains(outStr, `output="json" (default="text")`, "config changed output")
+		s.Assert().NotContains(outStr, "mempool.size", "config changed output")
+	})
+
+	s.Run("reset", func() {
+		outStr := s.executeConfigCmd("reset", "@cli")
+		s.Assert().Contains(outStr, "Alias @cli: output, chain-id\n", "config reset output")
+		s.Assert().Contains(outStr, `output Was: "json", Is Now: "text"`, "config reset output")
+	})
+
+	s.Run("set not allowed", func() {
+		outStr := s.executeConfigCmd("set", "@cli", "json")
+		s.Assert().Contains(outStr, "Key alias @cli cannot be used to set values.", "config set output")
+	})
+
+	s.Run("unknown alias", func() {
+		outStr := s.executeConfigCmd("get", "@nope", "moniker")
+		s.Assert().Equal("Error: 1 key alias not found: @nope\n", outStr, "config get output")
+		outStr = s.executeConfigCmd("changed", "@perf", "@nope", "@other")
+		s.Assert().Equal("Error: 2 key aliases not found: @nope, @other\n", outStr, "config changed output")
+		outStr = s.executeConfigCmd("reset", "@nope")
+		s.Assert().Equal("Error: 1 key alias not found: @nope\n", outStr, "config reset output")
+	})
+
+	s.Run("remove", func() {
+		outStr := s.executeConfigCmd("alias", "remove", "perf", "@nope")
+		s.Assert().Equal("Removed: @perf\nNot found: @nope\n", outStr, "config alias remove output")
+		outStr = s.executeConfigCmd("alias", "rm", "@cli", "-o", "json")
+		s.Assert().JSONEq(`{"aliases":{},"warnings":[]}`, outStr, "config alias remove json output")
+		_, err := os.Stat(aliasFile)
+		s.Assert().ErrorIs(err, os.ErrNotExist, "key aliases file after removing everything")
+	})
+}
+
+func (s *ConfigTestSuite) TestConfigCompletion() {
+	s.Run("get", func() {
+		outStr := s.executeConfigCmd("__complete", "get", "rpc.laddr")
+		s.Assert().Contains(outStr, "rpc.laddr\n", "completion output")
+		s.Assert().NotContains(outStr, "moniker", "completion output")
+	})
+
+	s.Run("changed with special word", func() {
+		outStr := s.executeConfigCmd("__complete", "changed", "cli")
+		s.Assert().Contains(outStr, "client\n", "completion output")
+	})
+
+	// This one is mostly useful when run with -race.
+	s.Run("concurrent get and completion", func() {
+		type run struct {
+			cmd  *cobra.Command
+			args []string
+		}
+		var runs []run
+		for i := 0; i < 4; i++ {
+			runs = append(runs,
+				run{cmd: s.getConfigCmd(), args: []string{"get", "moniker", "rpc.*"}},
+				run{cmd: s.getConfigCmd(), args: []string{"__complete", "get", "rpc."}},
+				run{cmd: s.getConfigCmd(), args: []string{"changed", "all"}},
+			)
+		}
+
+		errs := make(chan error, len(runs))
+		var wg sync.WaitGroup
+		for _, r := range runs {
+			r.cmd.SetArgs(r.args)
+			applyMockIOOutErr(r.cmd)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := r.cmd.Execute(); err != nil {
+					errs <- fmt.Errorf("%q: %w", r.args, err)
+				}
+			}()
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			s.Assert().NoError(err, "executing config command")
+		}
+	})
+}
+
 func (s *ConfigTestSuite) TestPackUnpack() {
 	s.Run("pack", func() {
 		expectedPacked := map[string]string{}
@@ -1073,6 +1965,140 @@ func (s *ConfigTestSuite) TestPackUnpack() {
 	})
 }
 
+func (s *ConfigTestSuite) TestPackUnpackOnly() {
+	s.executeConfigCmd("set", "api.enable", "true", "chain-id", "onlytest")
+
+	configCmd := s.getConfigCmd()
+	packedFile := provconfig.GetFullPathToPackedConf(configCmd)
+	appFile := provconfig.GetFullPathToAppConf(configCmd)
+	cmtFile := provconfig.GetFullPathToCmtConf(configCmd)
+	clientFile := provconfig.GetFullPathToClientConf(configCmd)
+
+	var dryRunOut string
+	s.Run("pack only app dry run", func() {
+		dryRunOut = s.executeConfigCmd("pack", "--"+cmd.FlagOnly, "app", "--"+cmd.FlagDryRun)
+		s.Assert().Contains(dryRunOut, `"api.enable": "true"`, "dry run output")
+		s.Assert().Contains(dryRunOut, `"packed-configs": "app"`, "dry run output")
+		s.Assert().NotContains(dryRunOut, "onlytest", "dry run output")
+		s.Assert().False(provconfig.FileExists(packedFile), "file exists: packed")
+		s.Assert().True(provconfig.FileExists(appFile), "file exists: app")
+	})
+
+	s.Run("pack only app", func() {
+		s.executeConfigCmd("pack", "--"+cmd.FlagOnly, "app")
+		s.Require().True(provconfig.FileExists(packedFile), "file exists: packed")
+		packedContents, err := os.ReadFile(packedFile)
+		s.Require().NoError(err, "ReadFile(%q)", packedFile)
+		s.Assert().Equal(dryRunOut, string(packedContents), "packed file contents vs dry run output")
+		s.Assert().False(provconfig.FileExists(appFile), "file exists: app")
+		s.Assert().True(provconfig.FileExists(cmtFile), "file exists: cometbft")
+		s.Assert().True(provconfig.FileExists(clientFile), "file exists: client")
+	})
+
+	s.Run("get from partially packed", func() {
+		outStr := s.executeConfigCmd("get", "api.enable", "chain-id")
+		s.Assert().Contains(outStr, "api.enable=true", "get output")
+		s.Assert().Contains(outStr, `chain-id="onlytest"`, "get output")
+	})
+
+	s.Run("unknown only type", func() {
+		cmd2 := s.getConfigCmd()
+		cmd2.SetArgs([]string{"pack", "--" + cmd.FlagOnly, "bananas"})
+		applyMockIOOutErr(cmd2)
+		err := cmd2.Execute()
+		s.Assert().EqualError(err, `unknown --only config type "bananas": must be one of "all", "app", "cmt", or "client"`, "pack error")
+	})
+
+	s.Run("unpack only app", func() {
+		s.executeConfigCmd("unpack", "--"+cmd.FlagOnly, "app")
+		s.Assert().False(provconfig.FileExists(packedFile), "file exists: packed")
+		s.Assert().True(provconfig.FileExists(appFile), "file exists: app")
+		s.Assert().True(provconfig.FileExists(cmtFile), "file exists: cometbft")
+		s.Assert().True(provconfig.FileExists(clientFile), "file exists: client")
+		outStr := s.executeConfigCmd("get", "api.enable")
+		s.Assert().Contains(outStr, "api.enable=true", "get output")
+	})
+}
+
+func (s *ConfigTestSuite) TestConfigLock() {
+	configCmd := s.getConfigCmd()
+	lockFile := provconfig.GetFullPathToConfigLock(configCmd)
+	packedFile := provconfig.GetFullPathToPackedConf(configCmd)
+	heldLock := fmt.Sprintf("%d %d\n", os.Getpid(), time.Now().UnixNano())
+	// This pid is way bigger than any pid that will actually be in use.
+	staleLock := fmt.Sprintf("%d %d\n", math.MaxInt32, time.Now().UnixNano())
+	expErr := "another config operation is in progress: held by pid " + strconv.Itoa(os.Getpid())
+
+	writeLock := func(contents string) {
+		s.Require().NoError(os.WriteFile(lockFile, []byte(contents), 0o600), "writing lock file")
+	}
+
+	s.Run("pack with held lock", func() {
+		writeLock(heldLock)
+		defer os.Remove(lockFile)
+
+		cmd := s.getConfigCmd()
+		cmd.SetArgs([]string{"pack"})
+		applyMockIOOutErr(cmd)
+		err := cmd.Execute()
+		s.Assert().ErrorContains(err, expErr, "pack error")
+		s.Assert().NoFileExists(packedFile, "packed file")
+		s.Assert().FileExists(lockFile, "lock file")
+	})
+
+	s.Run("set with held lock", func() {
+		writeLock(heldLock)
+		defer os.Remove(lockFile)
+
+		outStr := s.executeConfigCmd("set", "output", "json")
+		s.Assert().Contains(outStr, "Error: "+expErr, "set output")
+		s.Assert().NotContains(outStr, "output Was", "set output")
+		s.Assert().FileExists(lockFile, "lock file")
+	})
+
+	s.Run("unpack with held lock", func() {
+		writeLock(heldLock)
+		defer os.Remove(lockFile)
+
+		cmd := s.getConfigCmd()
+		cmd.SetArgs([]string{"unpack"})
+		applyMockIOOutErr(cmd)
+		err := cmd.Execute()
+		s.Assert().ErrorContains(err, expErr, "unpack error")
+		s.Assert().FileExists(lockFile, "lock file")
+	})
+
+	s.Run("pack with stale lock", func() {
+		writeLock(staleLock)
+		defer os.Remove(packedFile)
+
+		s.executeConfigCmd("pack")
+		s.Assert().FileExists(packedFile, "packed file")
+		s.Assert().NoFileExists(lockFile, "lock file")
+	})
+
+	s.Run("unpack with stale lock", func() {
+		writeLock(staleLock)
+
+		s.executeConfigCmd("unpack")
+		s.Assert().NoFileExists(packedFile, "packed file")
+		s.Assert().NoFileExists(lockFile, "lock file")
+	})
+
+	s.Run("set with held lock and wait", func() {
+		writeLock(heldLock)
+		go func() {
+			time.Sleep(200 * time.Millisecond)
+			s.Assert().NoError(os.Remove(lockFile), "removing held lock file")
+		}()
+
+		outStr := s.executeConfigCmd("set", "--wait", "output", "json")
+		s.Assert().NotContains(outStr, "Error", "set output")
+		s.Assert().Contains(outStr, s.makeKeyUpdatedLine("output", `"text"`, `"json"`), "set output")
+		s.Assert().NoFileExists(lockFile, "lock file")
+	})
+}
+
 func (s *ConfigTestSuite) TestEmptyPackedConfigHasDefaultMinGas() {
 	expected := provconfig.DefaultAppConfig().MinGasPrices
 	s.Require().NotEqual("", expected, "default MinGasPrices")
@@ -1192,3 +2218,414 @@ func (s *ConfigTestSuite) TestUpdate() {
 		s.Assert().Equal(uFileValue, actual, "unmanaged config entry")
 	})
 }
+
+func TestColorizedOutput(t *testing.T) {
+	ansiEsc := "\x1b["
+	updates := provconfig.UpdatedFieldMap{
+		"changed":   &provconfig.UpdatedField{Key: "changed", Was: "old", IsNow: "new"},
+		"unchanged": &provconfig.UpdatedField{Key: "unchanged", Was: "same", IsNow: "same"},
+	}
+
+	t.Run("updated fields without color", func(t *testing.T) {
+		actual := cmd.MakeUpdatedFieldMapString(updates, provconfig.UpdatedField.StringAsUpdate, false)
+		expected := "changed Was: old, Is Now: new\nunchanged Was: same, Is Now: same\n"
+		assert.Equal(t, expected, actual, "makeUpdatedFieldMapString result")
+	})
+
+	t.Run("updated fields with color", func(t *testing.T) {
+		actual := cmd.MakeUpdatedFieldMapString(updates, provconfig.UpdatedField.StringAsDefault, true)
+		expected := "changed=\x1b[32mnew\x1b[0m (default=\x1b[31mold\x1b[0m)\nunchanged=same (same as default)\n"
+		assert.Equal(t, expected, actual, "makeUpdatedFieldMapString result")
+	})
+
+	t.Run("header without color", func(t *testing.T) {
+		actual := cmd.MakeSectionHeaderString("App Config", "Updated", false)
+		assert.Equal(t, "App Config Updated:\n-------------------", actual, "section header")
+	})
+
+	t.Run("header with color", func(t *testing.T) {
+		actual := cmd.MakeSectionHeaderString("App Config", "Updated", true)
+		assert.Equal(t, "\x1b[1mApp Config Updated:\x1b[0m\n-------------------", actual, "section header")
+	})
+
+	t.Run("color not used when output is not a terminal", func(t *testing.T) {
+		configCmd := cmd.ConfigCmd()
+		configCmd.SetOut(&bytes.Buffer{})
+		assert.False(t, cmd.UseColor(configCmd), "useColor with buffer output")
+	})
+
+	t.Run("color not used with no-color flag", func(t *testing.T) {
+		configCmd := cmd.ConfigCmd()
+		configCmd.SetOut(os.Stdout)
+		require.NoError(t, configCmd.PersistentFlags().Set(cmd.FlagNoColor, "true"), "setting --%s", cmd.FlagNoColor)
+		assert.False(t, cmd.UseColor(configCmd), "useColor with --%s", cmd.FlagNoColor)
+	})
+
+	t.Run("color not used with NO_COLOR env", func(t *testing.T) {
+		t.Setenv(cmd.EnvNoColor, "1")
+		configCmd := cmd.ConfigCmd()
+		configCmd.SetOut(os.Stdout)
+		assert.False(t, cmd.UseColor(configCmd), "useColor with %s set", cmd.EnvNoColor)
+	})
+
+	t.Run("no ansi codes unless forced on", func(t *testing.T) {
+		for _, colorize := range []bool{false, true} {
+			fields := cmd.MakeUpdatedFieldMapString(updates, provconfig.UpdatedField.StringAsUpdate, colorize)
+			header := cmd.MakeSectionHeaderString("Client Config", "", colorize)
+			assert.Equal(t, colorize, strings.Contains(fields, ansiEsc), "fields contain ansi codes with colorize=%t", colorize)
+			assert.Equal(t, colorize, strings.Contains(header, ansiEsc), "header contains ansi codes with colorize=%t", colorize)
+		}
+	})
+}
+
+func (s *ConfigTestSuite) TestConfigCheckStart() {
+	freeAddr := func() string {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		s.Require().NoError(err, "net.Listen")
+		addr := ln.Addr().String()
+		s.Require().NoError(ln.Close(), "ln.Close()")
+		return addr
+	}
+	grpcAddr := freeAddr()
+	s.executeConfigCmd("set",
+		"rpc.laddr", "tcp://"+freeAddr(),
+		"p2p.laddr", "tcp://"+freeAddr(),
+		"grpc.address", grpcAddr,
+	)
+
+	s.Run("all checks pass", func() {
+		outStr := s.executeConfigCmd("check-start")
+		s.Assert().Contains(outStr, "PASS: grpc.address "+grpcAddr+" is available", "output")
+		s.Assert().NotContains(outStr, "FAIL", "output")
+		s.Assert().Contains(outStr, "pre-flight checks passed.", "output")
+	})
+
+	s.Run("port conflict", func() {
+		ln, err := net.Listen("tcp", grpcAddr)
+		s.Require().NoError(err, "net.Listen(%q)", grpcAddr)
+		defer ln.Close()
+
+		configCmd := s.getConfigCmd()
+		configCmd.SetArgs([]string{"check-start"})
+		b := applyMockIOOutErr(configCmd)
+		err = configCmd.Execute()
+		s.Assert().EqualError(err, "1 of 6 pre-flight checks failed", "check-start error")
+		s.Assert().Contains(b.String(), "FAIL: grpc.address "+grpcAddr+" is available", "output")
+	})
+
+	s.Run("missing tls file", func() {
+		s.executeConfigCmd("set", "rpc.tls_cert_file", "missing-cert.pem", "rpc.tls_key_file", "missing-key.pem")
+		defer s.executeConfigCmd("set", "rpc.tls_cert_file", "", "rpc.tls_key_file", "")
+
+		configCmd := s.getConfigCmd()
+		configCmd.SetArgs([]string{"check-start"})
+		b := applyMockIOOutErr(configCmd)
+		err := configCmd.Execute()
+		s.Assert().EqualError(err, "2 of 8 pre-flight checks failed", "check-start error")
+		s.Assert().Contains(b.String(), "FAIL: rpc.tls_cert_file "+s.Home+"/config/missing-cert.pem is readable", "output")
+		s.Assert().Contains(b.String(), "FAIL: rpc.tls_key_file "+s.Home+"/config/missing-key.pem is readable", "output")
+	})
+}
+
+func (s *ConfigTestSuite) TestConfigValidate() {
+	// executeValidate runs the validate command with the provided args, returning the output and error.
+	executeValidate := func(args ...string) (string, error) {
+		configCmd := s.getConfigCmd()
+		configCmd.SetArgs(append([]string{"validate"}, args...))
+		b := applyMockIOOutErr(configCmd)
+		err := configCmd.Execute()
+		return b.String(), err
+	}
+	appFile := filepath.Join(s.Home, "config", s.BaseFNApp)
+	cmtFile := filepath.Join(s.Home, "config", s.BaseFNCMT)
+	clientFile := filepath.Join(s.Home, "config", s.baseFNClient)
+	// updateAppFile replaces the provided line in the app config file, returning a func that will undo the change.
+	updateAppFile := func(oldLine, newLine string) func() {
+		orig, err := os.ReadFile(appFile)
+		s.Require().NoError(err, "reading app config file")
+		s.Require().Contains(string(orig), oldLine, "app config file contents")
+		updated := strings.Replace(string(orig), oldLine, newLine, 1)
+		s.Require().NoError(os.WriteFile(appFile, []byte(updated), 0o644), "writing updated app config file")
+		return func() {
+			s.Require().NoError(os.WriteFile(appFile, orig, 0o644), "restoring app config file")
+		}
+	}
+
+	s.Run("all pass", func() {
+		out, err := executeValidate()
+		s.Require().NoError(err, "validate error")
+		s.Assert().NotContains(out, "FAIL", "output")
+		s.Assert().Contains(out, "App Config Validation: "+appFile, "output")
+		s.Assert().Contains(out, "PASS: iavl-cache-size is positive", "output")
+		s.Assert().Contains(out, "Summary:\n"+
+			"  PASS: app config: "+appFile+"\n"+
+			"  PASS: cometbft config: "+cmtFile+"\n"+
+			"  PASS: client config: "+clientFile+"\n", "output")
+	})
+
+	s.Run("just client", func() {
+		out, err := executeValidate("client")
+		s.Require().NoError(err, "validate error")
+		s.Assert().Contains(out, "Client Config Validation: "+clientFile, "output")
+		s.Assert().NotContains(out, "App Config", "output")
+		s.Assert().NotContains(out, "CometBFT Config", "output")
+	})
+
+	s.Run("unknown type", func() {
+		_, err := executeValidate("cmt", "other")
+		s.Assert().EqualError(err, `unknown config type "other": must be one of "all", "app", "cmt", or "client"`, "validate error")
+	})
+
+	s.Run("app fails sanity checks", func() {
+		defer updateAppFile("iavl-cache-size = 781250", "iavl-cache-size = 0")()
+		defer updateAppFile(`pruning-interval = "0"`, `pruning-interval = "10"`)()
+
+		out, err := executeValidate()
+		s.Assert().EqualError(err, "1 of 3 configs failed validation", "validate error")
+		s.Assert().Contains(out, "FAIL: iavl-cache-size is positive: iavl-cache-size must be positive", "output")
+		s.Assert().Contains(out, "FAIL: pruning options are consistent: ", "output")
+		s.Assert().Contains(out, "  FAIL: app config: "+appFile+" (2 of 6 checks failed)\n", "output")
+		s.Assert().Contains(out, "  PASS: cometbft config: "+cmtFile+"\n", "output")
+
+		out, err = executeValidate("cmt")
+		s.Assert().NoError(err, "validate cmt error")
+		s.Assert().NotContains(out, "FAIL", "validate cmt output")
+	})
+
+	s.Run("app has wrong type", func() {
+		defer updateAppFile("iavl-cache-size = 781250", `iavl-cache-size = "big"`)()
+
+		out, err := executeValidate("app")
+		s.Assert().EqualError(err, "1 of 1 configs failed validation", "validate error")
+		s.Assert().Contains(out, "PASS: file can be read", "output")
+		s.Assert().Contains(out, "FAIL: values have the correct types: ", "output")
+		s.Assert().NotContains(out, "basic validation", "output")
+	})
+
+	s.Run("packed", func() {
+		s.executeConfigCmd("pack")
+		defer s.executeConfigCmd("unpack")
+		packedFile := filepath.Join(s.Home, "config", "packed-conf.json")
+		s.Require().NoError(os.WriteFile(packedFile, []byte(`{"output":"yaml","iavl-cache-size":"0"}`), 0o644), "writing packed config")
+
+		out, err := executeValidate()
+		s.Assert().EqualError(err, "2 of 3 configs failed validation", "validate error")
+		s.Assert().Contains(out, "App Config Validation: (packed)", "output")
+		s.Assert().Contains(out, "  FAIL: app config: "+packedFile+" (1 of 6 checks failed)\n", "output")
+		s.Assert().Contains(out, "  PASS: cometbft config: "+packedFile+"\n", "output")
+		s.Assert().Contains(out, "  FAIL: client config: "+packedFile+" (1 of 3 checks failed)\n", "output")
+		s.Assert().NoFileExists(appFile, "app config file after validating packed config")
+		s.Assert().NoFileExists(clientFile, "client config file after validating packed config")
+		s.Require().NoError(os.WriteFile(packedFile, []byte(`{}`), 0o644), "resetting packed config")
+	})
+}
+
+func (s *ConfigTestSuite) TestConfigWarnings() {
+	// executeWithSeparateOutput executes the config command, returning stdout and stderr separately.
+	executeWithSeparateOutput := func(args ...string) (string, string) {
+		configCmd := s.getConfigCmd()
+		configCmd.SetArgs(args)
+		var stdout, stderr bytes.Buffer
+		configCmd.SetOut(&stdout)
+		configCmd.SetErr(&stderr)
+		err := configCmd.Execute()
+		s.Require().NoError(err, "unexpected error executing %s %q", configCmd.Name(), args)
+		return stdout.String(), stderr.String()
+	}
+	// getWarningCodes unmarshals the json output and returns the codes of its warnings.
+	getWarningCodes := func(stdout string) []string {
+		var out struct {
+			Warnings []cmd.ConfigWarning `json:"warnings"`
+		}
+		s.Require().NoError(json.Unmarshal([]byte(stdout), &out), "json.Unmarshal(stdout):\n%s", stdout)
+		s.Require().NotNil(out.Warnings, "warnings")
+		codes := make([]string, len(out.Warnings))
+		for i, w := range out.Warnings {
+			s.Assert().NotEmpty(w.Message, "warnings[%d].Message", i)
+			codes[i] = w.Code
+		}
+		return codes
+	}
+
+	s.Run("deprecated alias text", func() {
+		stdout, stderr := executeWithSeparateOutput("get", "tm")
+		s.Assert().NotContains(stdout, "warning:", "stdout")
+		s.Assert().Contains(stdout, s.makeCMTConfigHeaderLines(), "stdout")
+		s.Assert().Equal(s.makeTmDeprecatedWarningLine("tm"), stderr, "stderr")
+	})
+
+	s.Run("deprecated alias json", func() {
+		stdout, stderr := executeWithSeparateOutput("get", "tendermint", "--output", "json")
+		s.Assert().Empty(stderr, "stderr")
+		s.Assert().Equal([]string{cmd.WarnCodeDeprecatedAlias}, getWarningCodes(stdout), "warning codes")
+		s.Assert().Contains(stdout, `"log_format": "plain"`, "stdout")
+	})
+
+	s.Run("changed deprecated alias json", func() {
+		stdout, stderr := executeWithSeparateOutput("changed", "tm", "-o", "json")
+		s.Assert().Empty(stderr, "stderr")
+		s.Assert().Equal([]string{cmd.WarnCodeDeprecatedAlias}, getWarningCodes(stdout), "warning codes")
+	})
+
+	s.Run("no warnings json", func() {
+		stdout, stderr := executeWithSeparateOutput("get", "api.enable", "--output", "json")
+		s.Assert().Empty(stderr, "stderr")
+		s.Assert().Empty(getWarningCodes(stdout), "warning codes")
+		s.Assert().Contains(stdout, `"app": {`, "stdout")
+	})
+
+	s.Run("get json native types", func() {
+		stdout, stderr := executeWithSeparateOutput("get", "api.enable", "api.max-open-connections", "log_format", "consensus.timeout_commit", "-o", "json")
+		s.Assert().Empty(stderr, "stderr")
+		var out struct {
+			Values map[string]map[string]interface{} `json:"values"`
+		}
+		s.Require().NoError(json.Unmarshal([]byte(stdout), &out), "json.Unmarshal(stdout):\n%s", stdout)
+		s.Assert().Equal(false, out.Values["app"]["api.enable"], "api.enable")
+		s.Assert().Equal(float64(1000), out.Values["app"]["api.max-open-connections"], "api.max-open-connections")
+		s.Assert().Equal("plain", out.Values["cometbft"]["log_format"], "log_format")
+		s.Assert().Equal("1.5s", out.Values["cometbft"]["consensus.timeout_commit"], "consensus.timeout_commit")
+		s.Assert().NotContains(out.Values, "client", "values")
+	})
+
+	s.Run("get json unknown key", func() {
+		stdout, stderr := executeWithSeparateOutput("get", "api.enable", "not-a-key", "--output", "json")
+		s.Assert().Equal("Error: 1 configuration key not found: not-a-key\n", stderr, "stderr")
+		var out struct {
+			Values map[string]map[string]interface{} `json:"values"`
+		}
+		s.Require().NoError(json.Unmarshal([]byte(stdout), &out), "json.Unmarshal(stdout):\n%s", stdout)
+		s.Assert().Equal(map[string]interface{}{"api.enable": false}, out.Values["app"], "app values")
+	})
+
+	s.Run("changed json native types", func() {
+		stdout, stderr := executeWithSeparateOutput("changed", "api.enable", "output", "-o", "json")
+		s.Assert().Empty(stderr, "stderr")
+		var out struct {
+			Changed map[string]map[string]map[string]interface{} `json:"changed"`
+		}
+		s.Require().NoError(json.Unmarshal([]byte(stdout), &out), "json.Unmarshal(stdout):\n%s", stdout)
+		s.Assert().Equal(map[string]interface{}{"value": false, "default": false}, out.Changed["app"]["api.enable"], "api.enable")
+		s.Assert().Equal(map[string]interface{}{"value": "text", "default": "text"}, out.Changed["client"]["output"], "output")
+	})
+
+	s.Run("set client value text", func() {
+		stdout, stderr := executeWithSeparateOutput("set", "chain-id", "warnchain")
+		s.Assert().Contains(stdout, s.makeKeyUpdatedLine("chain-id", `""`, `"warnchain"`), "stdout")
+		s.Assert().Empty(stderr, "stderr")
+	})
+
+	s.Run("set app value text", func() {
+		stdout, stderr := executeWithSeparateOutput("set", "api.enable", "true")
+		s.Assert().Contains(stdout, s.makeKeyUpdatedLine("api.enable", "false", "true"), "stdout")
+		s.Assert().NotContains(stdout, "warning:", "stdout")
+		s.Assert().Equal(s.makeRestartWarningLine("api.enable"), stderr, "stderr")
+	})
+
+	s.Run("set multiple values restart warnings", func() {
+		stdout, stderr := executeWithSeparateOutput("set", "telemetry.enabled", "true", "log_level", "debug", "output", "json")
+		s.Assert().Contains(stdout, s.makeKeyUpdatedLine("telemetry.enabled", "false", "true"), "stdout")
+		s.Assert().NotContains(stdout, "warning:", "stdout")
+		expStderr := "warning: telemetry.enabled requires node restart to take effect\n" +
+			"warning: log_level requires node restart to take effect\n"
+		s.Assert().Equal(expStderr, stderr, "stderr")
+	})
+
+	s.Run("get verbose text", func() {
+		stdout, stderr := executeWithSeparateOutput("get", "telemetry.enabled", "chain-id", "--verbose")
+		s.Assert().Contains(stdout, "telemetry.enabled=true (restart)\n", "stdout")
+		s.Assert().Contains(stdout, "chain-id=\"warnchain\" (hot)\n", "stdout")
+		s.Assert().Empty(stderr, "stderr")
+	})
+
+	s.Run("get verbose json", func() {
+		stdout, stderr := executeWithSeparateOutput("get", "api.enable", "output", "-v", "-o", "json")
+		s.Assert().Empty(stderr, "stderr")
+		var out struct {
+			Values map[string]map[string]map[string]interface{} `json:"values"`
+		}
+		s.Require().NoError(json.Unmarshal([]byte(stdout), &out), "json.Unmarshal(stdout):\n%s", stdout)
+		s.Assert().Equal(map[string]interface{}{"value": true, "reload": "restart"}, out.Values["app"]["api.enable"], "api.enable")
+		s.Assert().Equal(map[string]interface{}{"value": "json", "reload": "hot"}, out.Values["client"]["output"], "output")
+	})
+
+	s.Run("set with env override json", func() {
+		s.T().Setenv("PIO_API_SWAGGER", "false")
+		stdout, stderr := executeWithSeparateOutput("set", "api.swagger", "true", "-o", "json")
+		s.Assert().Empty(stderr, "stderr")
+		expCodes := []string{cmd.WarnCodeEnvOverride, cmd.WarnCodeRestartRequired}
+		s.Assert().Equal(expCodes, getWarningCodes(stdout), "warning codes")
+		s.Assert().Contains(stdout, "PIO_API_SWAGGER", "stdout")
+		s.Assert().Contains(stdout, `"is_now": "true"`, "stdout")
+	})
+
+	s.Run("set with env override text", func() {
+		s.T().Setenv("PIO_CHAIN_ID", "otherchain")
+		stdout, stderr := executeWithSeparateOutput("set", "chain-id", "warnchain2")
+		s.Assert().NotContains(stdout, "warning:", "stdout")
+		s.Assert().Equal("warning: the PIO_CHAIN_ID environment variable is set and will override the new chain-id value\n", stderr, "stderr")
+	})
+
+	s.Run("invalid output", func() {
+		configCmd := s.getConfigCmd()
+		configCmd.SetArgs([]string{"get", "--output", "yaml"})
+		applyMockIOOutErr(configCmd)
+		err := configCmd.Execute()
+		s.Assert().EqualError(err, `invalid --output value "yaml": must be either "text" or "json"`, "get error")
+	})
+}
+
+func (s *ConfigTestSuite) TestConfigJSONClientConfig() {
+	clientFile := filepath.Join(s.Home, "config", s.baseFNClient)
+	clientJSON := `{
+  "chain-id": "jsonchain",
+  "keyring-backend": "test",
+  "output": "text",
+  "node": "tcp://localhost:26657",
+  "broadcast-mode": "async"
+}
+`
+	s.Require().NoError(os.WriteFile(clientFile, []byte(clientJSON), 0o644), "writing JSON client config")
+	expWarning := "warning: the client config file " + clientFile + " contains JSON instead of TOML, " +
+		"use the config unpack (or update) command to rewrite it as TOML\n"
+
+	// getCmdWithLoadOutput gets the config command, returning it along with the output from loading the config.
+	getCmdWithLoadOutput := func() (*cobra.Command, string) {
+		configCmd := cmd.ConfigCmd()
+		configCmd.SetContext(*s.Context)
+		b := applyMockIOOutErr(configCmd)
+		s.Require().NoError(configCmd.PersistentFlags().Set(cmd.FlagNoColor, "true"), "setting --%s", cmd.FlagNoColor)
+		s.Require().NoError(provconfig.LoadConfigFromFiles(configCmd), "loading config from files")
+		return configCmd, b.String()
+	}
+
+	s.Run("get from JSON client config", func() {
+		configCmd, loadOut := getCmdWithLoadOutput()
+		s.Assert().Equal(expWarning, loadOut, "output from loading config")
+		outStr := s.executeCmd(configCmd, "get", "broadcast-mode", "chain-id", "keyring-backend")
+		expected := s.makeMultiLine(
+			s.makeClientConfigHeaderLines(),
+			`broadcast-mode="async"`,
+			`chain-id="jsonchain"`,
+			`keyring-backend="test"`,
+			"")
+		s.Assert().Equal(expected, outStr, "get output")
+	})
+
+	s.Run("unpack rewrites JSON client config as TOML", func() {
+		configCmd, _ := getCmdWithLoadOutput()
+		s.executeCmd(configCmd, "unpack")
+		contents, err := os.ReadFile(clientFile)
+		s.Require().NoError(err, "reading client config file")
+		s.Assert().False(provconfig.IsJSONConfigFile(clientFile), "IsJSONConfigFile after unpack")
+		s.Assert().Contains(string(contents), `chain-id = "jsonchain"`, "client config file contents")
+		s.Assert().Contains(string(contents), `keyring-backend = "test"`, "client config file contents")
+	})
+
+	s.Run("get after unpack", func() {
+		configCmd, loadOut := getCmdWithLoadOutput()
+		s.Assert().Empty(loadOut, "output from loading config")
+		outStr := s.executeCmd(configCmd, "get", "chain-id")
+		s.Assert().Contains(outStr, `chain-id="jsonchain"`, "get output")
+	})
+}
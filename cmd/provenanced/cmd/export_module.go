@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/app"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+const (
+	// FlagChunkSize is the flag for the maximum number of entries to put in each exported chunk.
+	FlagChunkSize = "chunk-size"
+	// FlagStartChunk is the flag for the first chunk index to export.
+	FlagStartChunk = "start-chunk"
+	// FlagOut is the flag for the directory to write the exported chunks to.
+	FlagOut = "out"
+	// FlagHeight is the flag for the height to export state from.
+	FlagHeight = "height"
+
+	// DefaultChunkSize is the default number of entries to put in each exported chunk.
+	DefaultChunkSize = 1000
+)
+
+// ExportModuleCmd returns a command for exporting the state of individual modules.
+func ExportModuleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "export-module",
+		Short:                      "Export the state of a single module to chunked JSON files",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		ExportMarkerModuleCmd(),
+	)
+	return cmd
+}
+
+// ExportMarkerModuleCmd returns a command for exporting the marker module state in chunks.
+func ExportMarkerModuleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "marker --out <dir> [--chunk-size <n>] [--start-chunk <i>] [--height <h>]",
+		Short: "Export the marker module state to chunked JSON files",
+		Long: fmt.Sprintf(`Export the marker module state to chunked JSON files.
+
+Only one chunk is held in memory at a time, unlike the genesis export command, which builds the
+whole marker module state in memory before writing it.
+
+Entries are exported with at most --chunk-size entries per file: first the markers (with their
+net asset values) in address order, then the deny-send entries, holding thresholds, and net asset
+value bounds, each in store order. Each file is a complete marker module genesis state containing
+the params and the chunk's entries.
+The files are named %[1]s-<chunk index>.json, e.g. %[1]s-000000.json.
+
+The chunk contents are deterministic for a given height, so an interrupted export can be resumed
+by providing the index of the first missing chunk with --start-chunk (and the same --height).
+
+The full marker genesis state is the concatenation of the markers, net_asset_values,
+deny_send_addresses, holding_thresholds, and net_asset_value_bounds lists of all the chunks
+(in chunk index order).
+`, markertypes.ModuleName),
+		Example: fmt.Sprintf(`$ %[1]s export-module marker --out ./marker-export --chunk-size 500
+$ %[1]s export-module marker --out ./marker-export --chunk-size 500 --height 1000 --start-chunk 12`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			chunkSize, err := cmd.Flags().GetInt(FlagChunkSize)
+			if err != nil {
+				return err
+			}
+			startChunk, err := cmd.Flags().GetInt(FlagStartChunk)
+			if err != nil {
+				return err
+			}
+			outDir, err := cmd.Flags().GetString(FlagOut)
+			if err != nil {
+				return err
+			}
+			if len(outDir) == 0 {
+				return errors.New("an output directory must be provided with --" + FlagOut)
+			}
+			height, err := cmd.Flags().GetInt64(FlagHeight)
+			if err != nil {
+				return err
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			if len(homeDir) > 0 {
+				serverCtx.Config.SetRoot(homeDir)
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(serverCtx.Config.RootDir, "data"))
+			if err != nil {
+				return fmt.Errorf("could not open application database: %w", err)
+			}
+			defer db.Close()
+
+			pioApp := app.New(serverCtx.Logger, db, nil, height == -1, serverCtx.Viper)
+			if height != -1 {
+				if err = pioApp.LoadHeight(height); err != nil {
+					return fmt.Errorf("could not load height %d: %w", height, err)
+				}
+			}
+
+			if err = os.MkdirAll(outDir, 0o755); err != nil {
+				return fmt.Errorf("could not create output directory: %w", err)
+			}
+
+			ctx := pioApp.NewContextLegacy(true, cmtproto.Header{Height: pioApp.LastBlockHeight()})
+			cdc := pioApp.AppCodec()
+			return pioApp.MarkerKeeper.ExportGenesisChunks(ctx, chunkSize, startChunk, func(chunk int, data *markertypes.GenesisState) error {
+				bz, err := cdc.MarshalJSON(data)
+				if err != nil {
+					return fmt.Errorf("could not marshal chunk %d: %w", chunk, err)
+				}
+				filename := filepath.Join(outDir, fmt.Sprintf("%s-%06d.json", markertypes.ModuleName, chunk))
+				if err = os.WriteFile(filename, bz, 0o644); err != nil {
+					return fmt.Errorf("could not write chunk %d: %w", chunk, err)
+				}
+				cmd.Printf("Chunk %d (%d markers) written to %s\n", chunk, len(data.Markers), filename)
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().Int(FlagChunkSize, DefaultChunkSize, "The maximum number of entries to include in each chunk")
+	cmd.Flags().Int(FlagStartChunk, 0, "The index of the first chunk to export (used to resume an export)")
+	cmd.Flags().String(FlagOut, "", "The directory to write the chunk files to")
+	cmd.Flags().Int64(FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
+
+	return cmd
+}
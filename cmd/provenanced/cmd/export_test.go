@@ -10,4 +10,15 @@ var (
 	AddMarketsToAppState = addMarketsToAppState
 	// GetNextAvailableMarketID is a test-only exposure of getNextAvailableMarketID.
 	GetNextAvailableMarketID = getNextAvailableMarketID
+	// MakeUpdatedFieldMapString is a test-only exposure of makeUpdatedFieldMapString.
+	MakeUpdatedFieldMapString = makeUpdatedFieldMapString
+	// UseColor is a test-only exposure of useColor.
+	UseColor = useColor
+	// WithMetaAddressExitCode is a test-only exposure of withMetaAddressExitCode.
+	WithMetaAddressExitCode = withMetaAddressExitCode
 )
+
+// MakeSectionHeaderString is a test-only way to create a section header string.
+func MakeSectionHeaderString(lead, addedLead string, color bool) string {
+	return sectionHeader{lead: lead, addedLead: addedLead, color: color}.String()
+}
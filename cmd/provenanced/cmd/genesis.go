@@ -64,6 +64,7 @@ func GenesisCmd(txConfig client.TxConfig, moduleBasics module.BasicManager, defa
 		genutilcli.GenTxCmd(moduleBasics, txConfig, banktypes.GenesisBalancesIterator{}, defaultNodeHome, txConfig.SigningContext().ValidatorAddressCodec()),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, defaultNodeHome, gentxModule.GenTxValidator, txConfig.SigningContext().ValidatorAddressCodec()),
 		genutilcli.ValidateGenesisCmd(moduleBasics),
+		ValidateMetadataGenesisCmd(),
 		AddGenesisAccountCmd(txConfig, defaultNodeHome),
 		AddRootDomainAccountCmd(defaultNodeHome),
 		AddGenesisMarkerCmd(defaultNodeHome),
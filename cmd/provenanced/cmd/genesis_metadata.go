@@ -0,0 +1,465 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// ValidateMetadataGenesisCmd returns a command that validates the metadata section of a genesis file.
+func ValidateMetadataGenesisCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-metadata <genesis.json>",
+		Short: "Validate the metadata module's section of a genesis file",
+		Long: `Validate the metadata module's section of a genesis file.
+
+The metadata genesis state is fully validated offline, and every problem found is reported
+along with the JSON path of the problematic entry. The checks include:
+  - The format and type of each metadata address.
+  - Duplicate ids.
+  - The existence of each session's scope and each record's session.
+  - The existence of referenced scope, contract, and record specifications.
+  - The owner, party, value owner, and data access addresses.
+
+Only the provided file is read; a running node is not needed.`,
+		Example: fmt.Sprintf(`$ %[1]s validate-metadata genesis.json`, genCmdStart),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			problems, err := FindMetadataGenesisProblems(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+			for _, problem := range problems {
+				cmd.Println(problem.String())
+			}
+			if len(problems) > 0 {
+				// The problems have already been printed, so the usage wouldn't help anything.
+				cmd.SilenceUsage = true
+				return fmt.Errorf("found %d problem(s) in the %s genesis state", len(problems), metadatatypes.ModuleName)
+			}
+			cmd.Printf("No problems found in the %s genesis state.\n", metadatatypes.ModuleName)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// MetadataGenesisProblem is a problem found in the metadata section of a genesis file.
+type MetadataGenesisProblem struct {
+	// Path is the JSON path to the problematic entry or field, e.g. "$.app_state.metadata.scopes[3].scope_id".
+	Path string
+	// Err is what's wrong with it.
+	Err error
+}
+
+// String returns a string of this problem in the format "<path>: <error>".
+func (p MetadataGenesisProblem) String() string {
+	return p.Path + ": " + p.Err.Error()
+}
+
+// FindMetadataGenesisProblems reads the provided genesis file and returns all the problems found in its metadata section.
+// An error is only returned if the file cannot be read or parsed enough to look for problems.
+func FindMetadataGenesisProblems(cdc codec.JSONCodec, genesisFile string) ([]MetadataGenesisProblem, error) {
+	if cdc == nil {
+		return nil, errors.New("no codec available")
+	}
+	bz, err := os.ReadFile(genesisFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read genesis file: %w", err)
+	}
+
+	var genDoc struct {
+		AppState map[string]json.RawMessage `json:"app_state"`
+	}
+	if err = json.Unmarshal(bz, &genDoc); err != nil {
+		return nil, fmt.Errorf("could not parse genesis file %q: %w", genesisFile, err)
+	}
+	mdGenState, ok := genDoc.AppState[metadatatypes.ModuleName]
+	if !ok {
+		return nil, fmt.Errorf("genesis file %q does not have a %s section", genesisFile, metadatatypes.ModuleName)
+	}
+	var sections map[string]json.RawMessage
+	if err = json.Unmarshal(mdGenState, &sections); err != nil {
+		return nil, fmt.Errorf("could not parse %s section of genesis file %q: %w", metadatatypes.ModuleName, genesisFile, err)
+	}
+
+	c := &metadataGenesisChecker{
+		cdc:       cdc,
+		base:      "$.app_state." + metadatatypes.ModuleName,
+		undecoded: make(map[string]bool),
+	}
+	c.check(sections)
+	return c.problems, nil
+}
+
+// metadataGenesisChecker looks for problems in a metadata genesis state, keeping track of all of them.
+type metadataGenesisChecker struct {
+	cdc      codec.JSONCodec
+	base     string
+	problems []MetadataGenesisProblem
+
+	// These are the paths of the entries with each id (as a string of the bytes).
+	scopes        map[string]string
+	sessions      map[string]string
+	records       map[string]string
+	scopeSpecs    map[string]string
+	contractSpecs map[string]string
+	recordSpecs   map[string]string
+
+	// undecoded has the ids of the entries that could not be decoded.
+	// They are problems themselves, so they aren't also reported as missing when referenced.
+	undecoded map[string]bool
+}
+
+// addProblem records a problem at the provided path.
+func (c *metadataGenesisChecker) addProblem(path string, err error) {
+	c.problems = append(c.problems, MetadataGenesisProblem{Path: path, Err: err})
+}
+
+// addProblemf records a problem at the provided path with an error created from the provided format and args.
+func (c *metadataGenesisChecker) addProblemf(path string, format string, args ...interface{}) {
+	c.addProblem(path, fmt.Errorf(format, args...))
+}
+
+// check looks for problems in the provided sections of a metadata genesis state.
+func (c *metadataGenesisChecker) check(sections map[string]json.RawMessage) {
+	scopes := decodeMetadataGenesisEntries[metadatatypes.Scope](c, sections, "scopes",
+		"scope_id", "specification_id")
+	sessions := decodeMetadataGenesisEntries[metadatatypes.Session](c, sections, "sessions",
+		"session_id", "specification_id")
+	records := decodeMetadataGenesisEntries[metadatatypes.Record](c, sections, "records",
+		"session_id", "specification_id")
+	scopeSpecs := decodeMetadataGenesisEntries[metadatatypes.ScopeSpecification](c, sections, "scope_specifications",
+		"specification_id", "contract_spec_ids")
+	contractSpecs := decodeMetadataGenesisEntries[metadatatypes.ContractSpecification](c, sections, "contract_specifications",
+		"specification_id")
+	recordSpecs := decodeMetadataGenesisEntries[metadatatypes.RecordSpecification](c, sections, "record_specifications",
+		"specification_id")
+	locators := decodeMetadataGenesisEntries[metadatatypes.ObjectStoreLocator](c, sections, "object_store_locators")
+
+	// Specifications are checked first, since the other entries reference them.
+	c.contractSpecs = make(map[string]string)
+	for i, spec := range contractSpecs {
+		if spec != nil {
+			c.checkContractSpec(c.entryPath("contract_specifications", i), spec)
+		}
+	}
+	c.recordSpecs = make(map[string]string)
+	for i, spec := range recordSpecs {
+		if spec != nil {
+			c.checkRecordSpec(c.entryPath("record_specifications", i), spec)
+		}
+	}
+	c.scopeSpecs = make(map[string]string)
+	for i, spec := range scopeSpecs {
+		if spec != nil {
+			c.checkScopeSpec(c.entryPath("scope_specifications", i), spec)
+		}
+	}
+	c.scopes = make(map[string]string)
+	for i, scope := range scopes {
+		if scope != nil {
+			c.checkScope(c.entryPath("scopes", i), scope)
+		}
+	}
+	c.sessions = make(map[string]string)
+	for i, session := range sessions {
+		if session != nil {
+			c.checkSession(c.entryPath("sessions", i), session)
+		}
+	}
+	c.records = make(map[string]string)
+	for i, record := range records {
+		if record != nil {
+			c.checkRecord(c.entryPath("records", i), record)
+		}
+	}
+	for i, locator := range locators {
+		if locator != nil {
+			c.checkObjectStoreLocator(c.entryPath("object_store_locators", i), locator)
+		}
+	}
+}
+
+// entryPath returns the JSON path to an entry in one of the metadata genesis sections.
+func (c *metadataGenesisChecker) entryPath(section string, i int) string {
+	return fmt.Sprintf("%s.%s[%d]", c.base, section, i)
+}
+
+// decodeMetadataGenesisEntries decodes each entry in a list section of the metadata genesis state.
+// Each of the provided address fields (a string or list of strings) is checked before decoding the entry so
+// that a bad address is reported with the field's path (instead of just a decoding error for the whole entry).
+// The first address field should be the entry's own id.
+// Entries that have a bad address or cannot otherwise be decoded are reported as problems and are nil in the result.
+func decodeMetadataGenesisEntries[T any, PT interface {
+	*T
+	proto.Message
+}](c *metadataGenesisChecker, sections map[string]json.RawMessage, section string, addrFields ...string) []PT {
+	raw, ok := sections[section]
+	if !ok || string(raw) == "null" {
+		return nil
+	}
+	sectionPath := c.base + "." + section
+	var entries []json.RawMessage
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		c.addProblemf(sectionPath, "could not parse as a list: %w", err)
+		return nil
+	}
+
+	rv := make([]PT, len(entries))
+	for i, entry := range entries {
+		path := c.entryPath(section, i)
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(entry, &fields); err != nil {
+			c.addProblemf(path, "could not parse as an object: %w", err)
+			continue
+		}
+
+		addrOK := true
+		for _, field := range addrFields {
+			if !c.checkAddrField(path+"."+field, fields[field]) {
+				addrOK = false
+			}
+		}
+		if !addrOK {
+			continue
+		}
+
+		val := PT(new(T))
+		if err := c.cdc.UnmarshalJSON(entry, val); err != nil {
+			c.addProblemf(path, "could not decode: %w", err)
+			if len(addrFields) > 0 {
+				var idStr string
+				if json.Unmarshal(fields[addrFields[0]], &idStr) == nil && len(idStr) > 0 {
+					id, _ := metadatatypes.MetadataAddressFromBech32(idStr)
+					c.undecoded[string(id)] = true
+				}
+			}
+			continue
+		}
+		rv[i] = val
+	}
+	return rv
+}
+
+// checkAddrField makes sure that, if provided, the raw field value is a metadata address (or list of them).
+// Returns true if no problems were found.
+func (c *metadataGenesisChecker) checkAddrField(path string, raw json.RawMessage) bool {
+	if len(raw) == 0 || string(raw) == "null" {
+		return true
+	}
+	var addrs []string
+	var str string
+	if err := json.Unmarshal(raw, &str); err == nil {
+		return c.checkAddrString(path, str)
+	}
+	if err := json.Unmarshal(raw, &addrs); err != nil {
+		c.addProblemf(path, "must be a string or list of strings")
+		return false
+	}
+	rv := true
+	for i, addr := range addrs {
+		if !c.checkAddrString(fmt.Sprintf("%s[%d]", path, i), addr) {
+			rv = false
+		}
+	}
+	return rv
+}
+
+// checkAddrString makes sure that, if not empty, the provided string is a bech32 metadata address.
+// Returns true if no problems were found.
+func (c *metadataGenesisChecker) checkAddrString(path, str string) bool {
+	if len(str) == 0 {
+		// Required addresses are checked once the entry is decoded.
+		return true
+	}
+	if _, err := metadatatypes.MetadataAddressFromBech32(str); err != nil {
+		c.addProblemf(path, "invalid metadata address %q: %w", str, err)
+		return false
+	}
+	return true
+}
+
+// checkID makes sure the provided id has the expected type and hasn't been seen yet, then records it as seen.
+// Returns true if no problems were found.
+func (c *metadataGenesisChecker) checkID(path string, id metadatatypes.MetadataAddress, expHRP string, seen map[string]string) bool {
+	if err := metadatatypes.VerifyMetadataAddressHasType(id, expHRP); err != nil {
+		c.addProblem(path, err)
+		return false
+	}
+	if other, found := seen[string(id)]; found {
+		c.addProblemf(path, "duplicate id %s (also at %s)", id, other)
+		return false
+	}
+	seen[string(id)] = path
+	return true
+}
+
+// checkRef makes sure the provided id has the expected type and was defined in the genesis state.
+// Returns true if no problems were found.
+func (c *metadataGenesisChecker) checkRef(path string, id metadatatypes.MetadataAddress, expHRP string, defined map[string]string) bool {
+	if err := metadatatypes.VerifyMetadataAddressHasType(id, expHRP); err != nil {
+		c.addProblem(path, err)
+		return false
+	}
+	return c.checkExists(path, id, hrpDisplayName(expHRP), defined)
+}
+
+// checkExists makes sure the provided id was defined in the genesis state (or was in an entry that couldn't be decoded).
+// Returns true if no problems were found.
+func (c *metadataGenesisChecker) checkExists(path string, id metadatatypes.MetadataAddress, name string, defined map[string]string) bool {
+	if _, found := defined[string(id)]; !found && !c.undecoded[string(id)] {
+		c.addProblemf(path, "%s %s does not exist", name, id)
+		return false
+	}
+	return true
+}
+
+// checkAccAddr makes sure that the provided string is a bech32 account address.
+// Returns true if no problems were found.
+func (c *metadataGenesisChecker) checkAccAddr(path, addr string) bool {
+	if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+		c.addProblemf(path, "invalid account address %q: %w", addr, err)
+		return false
+	}
+	return true
+}
+
+// checkValidateBasic records the error from validateBasic (if there is one).
+// It should only be used once the more specific checks have passed since it will likely repeat their problems.
+func (c *metadataGenesisChecker) checkValidateBasic(path string, validateBasic func() error) {
+	if err := validateBasic(); err != nil {
+		c.addProblem(path, err)
+	}
+}
+
+// checkScope looks for problems with a scope.
+func (c *metadataGenesisChecker) checkScope(path string, scope *metadatatypes.Scope) {
+	ok := c.checkID(path+".scope_id", scope.ScopeId, metadatatypes.PrefixScope, c.scopes)
+	ok = c.checkRef(path+".specification_id", scope.SpecificationId, metadatatypes.PrefixScopeSpecification, c.scopeSpecs) && ok
+	ownersOK := true
+	for i, owner := range scope.Owners {
+		ownersOK = c.checkAccAddr(fmt.Sprintf("%s.owners[%d].address", path, i), owner.Address) && ownersOK
+	}
+	if ownersOK {
+		if err := scope.ValidateOwnersBasic(); err != nil {
+			c.addProblem(path+".owners", err)
+			ownersOK = false
+		}
+	}
+	ok = ownersOK && ok
+	for i, addr := range scope.DataAccess {
+		ok = c.checkAccAddr(fmt.Sprintf("%s.data_access[%d]", path, i), addr) && ok
+	}
+	if len(scope.ValueOwnerAddress) > 0 {
+		ok = c.checkAccAddr(path+".value_owner_address", scope.ValueOwnerAddress) && ok
+	}
+	if ok {
+		c.checkValidateBasic(path, scope.ValidateBasic)
+	}
+}
+
+// checkSession looks for problems with a session.
+func (c *metadataGenesisChecker) checkSession(path string, session *metadatatypes.Session) {
+	ok := c.checkID(path+".session_id", session.SessionId, metadatatypes.PrefixSession, c.sessions)
+	if ok {
+		ok = c.checkExists(path+".session_id", session.SessionId.MustGetAsScopeAddress(), "scope", c.scopes)
+	}
+	ok = c.checkRef(path+".specification_id", session.SpecificationId, metadatatypes.PrefixContractSpecification, c.contractSpecs) && ok
+	for i, party := range session.Parties {
+		ok = c.checkAccAddr(fmt.Sprintf("%s.parties[%d].address", path, i), party.Address) && ok
+	}
+	if ok {
+		c.checkValidateBasic(path, session.ValidateBasic)
+	}
+}
+
+// checkRecord looks for problems with a record.
+func (c *metadataGenesisChecker) checkRecord(path string, record *metadatatypes.Record) {
+	ok := c.checkRef(path+".session_id", record.SessionId, metadatatypes.PrefixSession, c.sessions)
+	if len(record.Name) == 0 {
+		c.addProblemf(path+".name", "record name cannot be empty")
+		ok = false
+	}
+	if ok {
+		ok = c.checkID(path+".name", record.GetRecordAddress(), metadatatypes.PrefixRecord, c.records)
+	}
+	if !record.SpecificationId.Empty() {
+		ok = c.checkRef(path+".specification_id", record.SpecificationId, metadatatypes.PrefixRecordSpecification, c.recordSpecs) && ok
+	}
+	if ok {
+		c.checkValidateBasic(path, record.ValidateBasic)
+	}
+}
+
+// checkScopeSpec looks for problems with a scope specification.
+func (c *metadataGenesisChecker) checkScopeSpec(path string, spec *metadatatypes.ScopeSpecification) {
+	ok := c.checkID(path+".specification_id", spec.SpecificationId, metadatatypes.PrefixScopeSpecification, c.scopeSpecs)
+	for i, id := range spec.ContractSpecIds {
+		ok = c.checkRef(fmt.Sprintf("%s.contract_spec_ids[%d]", path, i), id, metadatatypes.PrefixContractSpecification, c.contractSpecs) && ok
+	}
+	for i, owner := range spec.OwnerAddresses {
+		ok = c.checkAccAddr(fmt.Sprintf("%s.owner_addresses[%d]", path, i), owner) && ok
+	}
+	if ok {
+		c.checkValidateBasic(path, spec.ValidateBasic)
+	}
+}
+
+// checkContractSpec looks for problems with a contract specification.
+func (c *metadataGenesisChecker) checkContractSpec(path string, spec *metadatatypes.ContractSpecification) {
+	ok := c.checkID(path+".specification_id", spec.SpecificationId, metadatatypes.PrefixContractSpecification, c.contractSpecs)
+	for i, owner := range spec.OwnerAddresses {
+		ok = c.checkAccAddr(fmt.Sprintf("%s.owner_addresses[%d]", path, i), owner) && ok
+	}
+	if ok {
+		c.checkValidateBasic(path, spec.ValidateBasic)
+	}
+}
+
+// checkRecordSpec looks for problems with a record specification.
+func (c *metadataGenesisChecker) checkRecordSpec(path string, spec *metadatatypes.RecordSpecification) {
+	ok := c.checkID(path+".specification_id", spec.SpecificationId, metadatatypes.PrefixRecordSpecification, c.recordSpecs)
+	if ok {
+		ok = c.checkExists(path+".specification_id", spec.SpecificationId.MustGetAsContractSpecAddress(), "contract specification", c.contractSpecs)
+	}
+	if ok {
+		c.checkValidateBasic(path, spec.ValidateBasic)
+	}
+}
+
+// checkObjectStoreLocator looks for problems with an object store locator.
+func (c *metadataGenesisChecker) checkObjectStoreLocator(path string, locator *metadatatypes.ObjectStoreLocator) {
+	c.checkAccAddr(path+".owner", locator.Owner)
+	if len(locator.EncryptionKey) > 0 {
+		c.checkAccAddr(path+".encryption_key", locator.EncryptionKey)
+	}
+	if len(locator.LocatorUri) == 0 {
+		c.addProblemf(path+".locator_uri", "locator uri cannot be empty")
+	}
+}
+
+// hrpDisplayName returns a human-friendly name for the type of metadata address with the provided hrp.
+func hrpDisplayName(hrp string) string {
+	switch hrp {
+	case metadatatypes.PrefixScopeSpecification:
+		return "scope specification"
+	case metadatatypes.PrefixContractSpecification:
+		return "contract specification"
+	case metadatatypes.PrefixRecordSpecification:
+		return "record specification"
+	}
+	return hrp
+}
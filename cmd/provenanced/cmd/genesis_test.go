@@ -1,13 +1,17 @@
 package cmd_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,6 +37,7 @@ import (
 	"github.com/provenance-io/provenance/testutil/assertions"
 	"github.com/provenance-io/provenance/testutil/mocks"
 	"github.com/provenance-io/provenance/x/exchange"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
 
 var testMbm = module.NewBasicManager(genutil.AppModuleBasic{})
@@ -919,3 +924,157 @@ func TestGetNextAvailableMarketID(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMetadataGenesisCmd(t *testing.T) {
+	origCache := sdk.IsAddrCacheEnabled()
+	defer sdk.SetAddrCacheEnabled(origCache)
+	sdk.SetAddrCacheEnabled(false)
+
+	pioconfig.SetProvenanceConfig("", 0)
+	appCodec := app.MakeTestEncodingConfig(t).Marshaler
+	owner := sdk.AccAddress("owner_______________").String()
+	owners := []metadatatypes.Party{{Address: owner, Role: metadatatypes.PartyType_PARTY_TYPE_OWNER}}
+	ownerTypes := []metadatatypes.PartyType{metadatatypes.PartyType_PARTY_TYPE_OWNER}
+
+	cSpecUUID := uuid.MustParse("c0000000-0000-0000-0000-000000000000")
+	cSpecID := metadatatypes.ContractSpecMetadataAddress(cSpecUUID)
+	rSpecID := cSpecID.MustGetAsRecordSpecAddress("record0")
+	sSpecID := metadatatypes.ScopeSpecMetadataAddress(uuid.MustParse("50000000-0000-0000-0000-000000000000"))
+	scopeUUIDs := make([]uuid.UUID, 4)
+	scopeIDs := make([]metadatatypes.MetadataAddress, len(scopeUUIDs))
+	for i := range scopeIDs {
+		scopeUUIDs[i] = uuid.MustParse(fmt.Sprintf("a000000%d-0000-0000-0000-000000000000", i))
+		scopeIDs[i] = metadatatypes.ScopeMetadataAddress(scopeUUIDs[i])
+	}
+	sessionID := metadatatypes.SessionMetadataAddress(scopeUUIDs[0], uuid.MustParse("b0000000-0000-0000-0000-000000000000"))
+	missingScopeUUID := uuid.MustParse("e0000000-0000-0000-0000-000000000000")
+	missingScopeID := metadatatypes.ScopeMetadataAddress(missingScopeUUID)
+	orphanSessionID := metadatatypes.SessionMetadataAddress(missingScopeUUID, uuid.MustParse("b0000001-0000-0000-0000-000000000000"))
+	missingSSpecID := metadatatypes.ScopeSpecMetadataAddress(uuid.MustParse("e0000001-0000-0000-0000-000000000000"))
+	missingRSpecID := cSpecID.MustGetAsRecordSpecAddress("missing")
+	process := *metadatatypes.NewProcess("proc", &metadatatypes.Process_Hash{Hash: "HASH"}, "method")
+
+	// newGenState creates a metadata genesis state without any problems.
+	// The entries at the end of each list are where the defects get injected.
+	newGenState := func() *metadatatypes.GenesisState {
+		return &metadatatypes.GenesisState{
+			Params:          metadatatypes.DefaultParams(),
+			OSLocatorParams: metadatatypes.DefaultOSLocatorParams(),
+			ContractSpecifications: []metadatatypes.ContractSpecification{
+				*metadatatypes.NewContractSpecification(cSpecID, nil, []string{owner}, ownerTypes,
+					metadatatypes.NewContractSpecificationSourceHash("HASH"), "com.example.Contract"),
+			},
+			RecordSpecifications: []metadatatypes.RecordSpecification{
+				*metadatatypes.NewRecordSpecification(rSpecID, "record0", nil, "string",
+					metadatatypes.DefinitionType_DEFINITION_TYPE_PROPOSED, ownerTypes),
+			},
+			ScopeSpecifications: []metadatatypes.ScopeSpecification{
+				*metadatatypes.NewScopeSpecification(sSpecID, nil, []string{owner}, ownerTypes, []metadatatypes.MetadataAddress{cSpecID}),
+			},
+			Scopes: []metadatatypes.Scope{
+				*metadatatypes.NewScope(scopeIDs[0], sSpecID, owners, nil, owner, false),
+				*metadatatypes.NewScope(scopeIDs[1], sSpecID, owners, nil, owner, false),
+				*metadatatypes.NewScope(scopeIDs[2], sSpecID, owners, nil, owner, false),
+				*metadatatypes.NewScope(scopeIDs[3], sSpecID, owners, nil, owner, false),
+			},
+			Sessions: []metadatatypes.Session{
+				*metadatatypes.NewSession("session0", sessionID, cSpecID, owners, nil),
+			},
+			Records: []metadatatypes.Record{
+				*metadatatypes.NewRecord("record0", sessionID, process, nil, nil, rSpecID),
+			},
+		}
+	}
+
+	// writeGenFile writes a genesis file with the provided metadata genesis state, applying the provided
+	// tweak to its json first (if there is one). Returns the path to the new file.
+	writeGenFile := func(t *testing.T, genState *metadatatypes.GenesisState, tweak func(md map[string]interface{})) string {
+		bz, err := appCodec.MarshalJSON(genState)
+		require.NoError(t, err, "MarshalJSON(metadata genesis state)")
+		var md map[string]interface{}
+		require.NoError(t, json.Unmarshal(bz, &md), "Unmarshal(metadata genesis state)")
+		if tweak != nil {
+			tweak(md)
+		}
+		bz, err = json.MarshalIndent(map[string]interface{}{
+			"app_state": map[string]interface{}{metadatatypes.ModuleName: md},
+		}, "", "  ")
+		require.NoError(t, err, "MarshalIndent(genesis)")
+		genFile := filepath.Join(t.TempDir(), "genesis.json")
+		require.NoError(t, os.WriteFile(genFile, bz, 0o644), "WriteFile(genesis)")
+		return genFile
+	}
+
+	runCmd := func(t *testing.T, genFile string) (string, error) {
+		cmd := provenancecmd.ValidateMetadataGenesisCmd()
+		cmd.SetArgs([]string{genFile})
+		var outBuf bytes.Buffer
+		cmd.SetOut(&outBuf)
+		cmd.SetErr(&outBuf)
+		clientCtx := client.Context{}.WithCodec(appCodec)
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+		err := cmd.ExecuteContext(ctx)
+		return outBuf.String(), err
+	}
+
+	t.Run("no problems", func(t *testing.T) {
+		genFile := writeGenFile(t, newGenState(), nil)
+		problems, err := provenancecmd.FindMetadataGenesisProblems(appCodec, genFile)
+		require.NoError(t, err, "FindMetadataGenesisProblems")
+		assert.Empty(t, problems, "FindMetadataGenesisProblems")
+
+		out, err := runCmd(t, genFile)
+		require.NoError(t, err, "ValidateMetadataGenesisCmd")
+		assert.Equal(t, "No problems found in the metadata genesis state.\n", out, "ValidateMetadataGenesisCmd output")
+	})
+
+	t.Run("five defects", func(t *testing.T) {
+		genState := newGenState()
+		// Defect 2: The value owner isn't a valid address.
+		genState.Scopes[2].ValueOwnerAddress = "notanaddress"
+		// Defect 3: The scope specification doesn't exist.
+		genState.Scopes[3].SpecificationId = missingSSpecID
+		// Defect 4: The session's scope doesn't exist.
+		genState.Sessions = append(genState.Sessions, *metadatatypes.NewSession("session1", orphanSessionID, cSpecID, owners, nil))
+		// Defect 5: The record specification doesn't exist.
+		genState.Records = append(genState.Records, *metadatatypes.NewRecord("record1", sessionID, process, nil, nil, missingRSpecID))
+		genFile := writeGenFile(t, genState, func(md map[string]interface{}) {
+			// Defect 1: The scope id isn't a valid metadata address.
+			md["scopes"].([]interface{})[1].(map[string]interface{})["scope_id"] = "scope1notvalid"
+		})
+
+		expPaths := []string{
+			"$.app_state.metadata.scopes[1].scope_id",
+			"$.app_state.metadata.scopes[2].value_owner_address",
+			"$.app_state.metadata.scopes[3].specification_id",
+			"$.app_state.metadata.sessions[1].session_id",
+			"$.app_state.metadata.records[1].specification_id",
+		}
+
+		problems, err := provenancecmd.FindMetadataGenesisProblems(appCodec, genFile)
+		require.NoError(t, err, "FindMetadataGenesisProblems")
+		paths := make([]string, len(problems))
+		for i, problem := range problems {
+			paths[i] = problem.Path
+		}
+		assert.ElementsMatch(t, expPaths, paths, "paths of problems found")
+		for _, problem := range problems {
+			assert.Error(t, problem.Err, "problem at %s", problem.Path)
+		}
+
+		out, err := runCmd(t, genFile)
+		assert.EqualError(t, err, "found 5 problem(s) in the metadata genesis state", "ValidateMetadataGenesisCmd")
+		for _, problem := range problems {
+			assert.Contains(t, out, problem.String()+"\n", "ValidateMetadataGenesisCmd output")
+		}
+		assert.Contains(t, out, missingSSpecID.String()+" does not exist", "ValidateMetadataGenesisCmd output")
+		assert.Contains(t, out, missingScopeID.String()+" does not exist", "ValidateMetadataGenesisCmd output")
+		assert.Contains(t, out, missingRSpecID.String()+" does not exist", "ValidateMetadataGenesisCmd output")
+		assert.NotContains(t, out, "Usage:", "ValidateMetadataGenesisCmd output")
+	})
+
+	t.Run("file does not exist", func(t *testing.T) {
+		_, err := provenancecmd.FindMetadataGenesisProblems(appCodec, filepath.Join(t.TempDir(), "nope.json"))
+		assert.ErrorContains(t, err, "could not read genesis file", "FindMetadataGenesisProblems")
+	})
+}
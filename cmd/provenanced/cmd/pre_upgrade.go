@@ -8,8 +8,6 @@ import (
 
 	cmtconfig "github.com/cometbft/cometbft/config"
 
-	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
-
 	cmderrors "github.com/provenance-io/provenance/cmd/errors"
 	"github.com/provenance-io/provenance/cmd/provenanced/config"
 )
@@ -91,7 +89,7 @@ func UpdateConfig(cmd *cobra.Command) error {
 
 // SafeSaveConfigs calls config.SaveConfigs but returns an error instead of panicking.
 func SafeSaveConfigs(cmd *cobra.Command,
-	appConfig *serverconfig.Config,
+	appConfig *config.AppConfig,
 	cmtConfig *cmtconfig.Config,
 	clientConfig *config.ClientConfig,
 	verbose bool,
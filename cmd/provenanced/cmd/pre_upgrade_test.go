@@ -23,7 +23,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
-	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/app"
@@ -226,7 +225,7 @@ func TestPreUpgradeCmd(t *testing.T) {
 
 	seenNames := make(map[string]bool)
 	// newHome creates a new home directory and saves the configs. Returns full path to home and success.
-	newHome := func(t *testing.T, name string, appCfg *serverconfig.Config, cmtCfg *cmtconfig.Config, clientCfg *config.ClientConfig) (string, bool) {
+	newHome := func(t *testing.T, name string, appCfg *config.AppConfig, cmtCfg *cmtconfig.Config, clientCfg *config.ClientConfig) (string, bool) {
 		require.False(t, seenNames[name], "dir name %q created in previous test", name)
 		seenNames[name] = true
 		home := filepath.Join(tmpDir, name)
@@ -239,7 +238,7 @@ func TestPreUpgradeCmd(t *testing.T) {
 		return home, success
 	}
 	// newHomePacked creates a new home directory, saves the configs, and packs them. Returns full path to home and success.
-	newHomePacked := func(t *testing.T, name string, appCfg *serverconfig.Config, cmtCfg *cmtconfig.Config, clientCfg *config.ClientConfig) (string, bool) {
+	newHomePacked := func(t *testing.T, name string, appCfg *config.AppConfig, cmtCfg *cmtconfig.Config, clientCfg *config.ClientConfig) (string, bool) {
 		home, success := newHome(t, name, appCfg, cmtCfg, clientCfg)
 		if !success {
 			return home, success
@@ -277,7 +276,7 @@ func TestPreUpgradeCmd(t *testing.T) {
 		expInStdout  []string
 		expInStderr  []string
 		expNot       []string
-		expAppCfg    *serverconfig.Config
+		expAppCfg    *config.AppConfig
 		expCmtCfg    *cmtconfig.Config
 		expClientCfg *config.ClientConfig
 	}{
@@ -80,7 +80,7 @@ func NewRootCmd(sealConfig bool) (*cobra.Command, params.EncodingConfig) {
 		WithAccountRetriever(types.AccountRetriever{}).
 		WithBroadcastMode(flags.BroadcastSync).
 		WithHomeDir(app.DefaultNodeHome).
-		WithViper("PIO")
+		WithViper(config.EnvPrefix)
 	sdk.SetCoinDenomRegex(app.SdkCoinDenomRegex)
 
 	rootCmd := &cobra.Command{
@@ -169,6 +169,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, b
 		testnetCmd(basicManager, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
 		ConfigCmd(),
+		ExportModuleCmd(),
 		AddMetaAddressCmd(),
 		snapshot.Cmd(newApp),
 		GetPreUpgradeCmd(),
@@ -195,6 +196,21 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, b
 		panic(fmt.Errorf("start command not found: %w", err))
 	}
 	startCmd.SilenceUsage = true
+
+	// Run the pre-flight checks before starting. These are the same checks run by config check-start.
+	origPreRunE := startCmd.PreRunE
+	startCmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if origPreRunE != nil {
+			if err := origPreRunE(cmd, args); err != nil {
+				return err
+			}
+		}
+		checks, err := runPreflightChecks(cmd)
+		if err != nil {
+			return err
+		}
+		return checks.Err()
+	}
 }
 
 func addModuleInitFlags(startCmd *cobra.Command) {
@@ -0,0 +1,148 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// KeyAliasPrefix is the prefix that identifies a key alias when used in place of config keys, e.g. "@perf".
+const KeyAliasPrefix = "@"
+
+// keyAliasNameRx is the pattern that key alias names (without the prefix) must match.
+var keyAliasNameRx = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// KeyAliases associates key alias names (without the prefix) with the config keys they expand to.
+type KeyAliases map[string][]string
+
+// IsKeyAlias returns true if the provided argument is a key alias (i.e. it starts with KeyAliasPrefix).
+func IsKeyAlias(arg string) bool {
+	return strings.HasPrefix(arg, KeyAliasPrefix)
+}
+
+// NormalizeKeyAliasName removes the prefix (if there is one) from the provided key alias name and validates it.
+func NormalizeKeyAliasName(name string) (string, error) {
+	rv := strings.TrimPrefix(name, KeyAliasPrefix)
+	if !keyAliasNameRx.MatchString(rv) {
+		return "", fmt.Errorf("invalid key alias name %q: must start with a lowercase letter and "+
+			"only contain lowercase letters, digits, dashes, and underscores", name)
+	}
+	return rv, nil
+}
+
+// GetSortedNames returns the names of all the key aliases (without the prefix), sorted.
+func (a KeyAliases) GetSortedNames() []string {
+	rv := make([]string, 0, len(a))
+	for name := range a {
+		rv = append(rv, name)
+	}
+	sort.Strings(rv)
+	return rv
+}
+
+// Expand replaces each key alias in args with the keys it expands to. Other args are kept as they are.
+// The second return value has the keys of each key alias that was expanded (keyed by the alias with its prefix).
+// An error is returned if any of the key aliases aren't defined.
+func (a KeyAliases) Expand(args []string) ([]string, map[string][]string, error) {
+	var rv []string
+	var expanded map[string][]string
+	var unknown []string
+	for _, arg := range args {
+		if !IsKeyAlias(arg) {
+			rv = append(rv, arg)
+			continue
+		}
+		keys, ok := a[strings.TrimPrefix(arg, KeyAliasPrefix)]
+		if !ok {
+			unknown = append(unknown, arg)
+			continue
+		}
+		rv = append(rv, keys...)
+		if expanded == nil {
+			expanded = make(map[string][]string)
+		}
+		expanded[arg] = keys
+	}
+	if len(unknown) > 0 {
+		s := "es"
+		if len(unknown) == 1 {
+			s = ""
+		}
+		return nil, nil, fmt.Errorf("%d key alias%s not found: %s", len(unknown), s, strings.Join(unknown, ", "))
+	}
+	return rv, expanded, nil
+}
+
+// LoadKeyAliases reads the key aliases of the home directory of the provided command.
+// If there is no key aliases file, an empty map is returned.
+func LoadKeyAliases(cmd *cobra.Command) (KeyAliases, error) {
+	return readKeyAliasesFile(GetFullPathToKeyAliases(cmd))
+}
+
+// SaveKeyAliases writes the provided key aliases to the home directory of the provided command.
+// If there aren't any key aliases, the key aliases file is deleted.
+func SaveKeyAliases(cmd *cobra.Command, aliases KeyAliases) error {
+	if err := EnsureConfigDir(cmd); err != nil {
+		return err
+	}
+	return writeKeyAliasesFile(GetFullPathToKeyAliases(cmd), aliases)
+}
+
+// readKeyAliasesFile reads the provided key aliases file.
+func readKeyAliasesFile(path string) (KeyAliases, error) {
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return KeyAliases{}, nil
+		}
+		return nil, fmt.Errorf("could not read key aliases file %q: %w", path, err)
+	}
+
+	vpr := viper.New()
+	vpr.SetConfigFile(path)
+	vpr.SetConfigType("toml")
+	if err := vpr.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("could not read key aliases file %q: %w", path, err)
+	}
+
+	rv := make(KeyAliases)
+	for _, name := range vpr.AllKeys() {
+		if _, err := NormalizeKeyAliasName(name); err != nil {
+			return nil, fmt.Errorf("invalid key aliases file %q: %w", path, err)
+		}
+		rv[name] = vpr.GetStringSlice(name)
+	}
+	return rv, nil
+}
+
+// writeKeyAliasesFile writes the provided key aliases (sorted by name) to the provided key aliases file.
+// If there aren't any key aliases, the file is deleted instead.
+func writeKeyAliasesFile(path string, aliases KeyAliases) error {
+	if len(aliases) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("could not remove key aliases file %q: %w", path, err)
+		}
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("# Aliases for groups of config keys, e.g. @perf.\n")
+	sb.WriteString("# Manage these using the config alias commands.\n")
+	for _, name := range aliases.GetSortedNames() {
+		quoted := make([]string, len(aliases[name]))
+		for i, key := range aliases[name] {
+			quoted[i] = strconv.Quote(key)
+		}
+		sb.WriteString(name + " = [" + strings.Join(quoted, ", ") + "]\n")
+	}
+	if err := os.WriteFile(path, []byte(sb.String()), 0o644); err != nil {
+		return fmt.Errorf("could not write key aliases file %q: %w", path, err)
+	}
+	return nil
+}
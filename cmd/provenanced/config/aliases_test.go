@@ -0,0 +1,119 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeKeyAliasName(t *testing.T) {
+	tests := []struct {
+		name   string
+		exp    string
+		expErr string
+	}{
+		{name: "perf", exp: "perf"},
+		{name: "@perf", exp: "perf"},
+		{name: "my-set_2", exp: "my-set_2"},
+		{name: "", expErr: `invalid key alias name ""`},
+		{name: "@", expErr: `invalid key alias name "@"`},
+		{name: "Perf", expErr: `invalid key alias name "Perf"`},
+		{name: "2fast", expErr: `invalid key alias name "2fast"`},
+		{name: "api.perf", expErr: `invalid key alias name "api.perf"`},
+		{name: "@@perf", expErr: `invalid key alias name "@@perf"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act, err := NormalizeKeyAliasName(tc.name)
+			if len(tc.expErr) > 0 {
+				assert.ErrorContains(t, err, tc.expErr, "NormalizeKeyAliasName error")
+			} else {
+				assert.NoError(t, err, "NormalizeKeyAliasName error")
+			}
+			assert.Equal(t, tc.exp, act, "NormalizeKeyAliasName result")
+		})
+	}
+}
+
+func TestKeyAliasesExpand(t *testing.T) {
+	aliases := KeyAliases{
+		"perf": {"mempool.size", "consensus.timeout_commit"},
+		"cli":  {"output"},
+	}
+
+	t.Run("no aliases", func(t *testing.T) {
+		args, expanded, err := aliases.Expand([]string{"moniker", "api"})
+		require.NoError(t, err, "Expand")
+		assert.Equal(t, []string{"moniker", "api"}, args, "args")
+		assert.Nil(t, expanded, "expanded")
+	})
+
+	t.Run("mixed", func(t *testing.T) {
+		args, expanded, err := aliases.Expand([]string{"moniker", "@perf", "@cli"})
+		require.NoError(t, err, "Expand")
+		assert.Equal(t, []string{"moniker", "mempool.size", "consensus.timeout_commit", "output"}, args, "args")
+		exp := map[string][]string{
+			"@perf": {"mempool.size", "consensus.timeout_commit"},
+			"@cli":  {"output"},
+		}
+		assert.Equal(t, exp, expanded, "expanded")
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		args, expanded, err := aliases.Expand([]string{"@perf", "@nope", "@other"})
+		assert.EqualError(t, err, "2 key aliases not found: @nope, @other", "Expand error")
+		assert.Nil(t, args, "args")
+		assert.Nil(t, expanded, "expanded")
+	})
+}
+
+func TestKeyAliasesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), KeyAliasesFilename)
+
+	t.Run("no file", func(t *testing.T) {
+		aliases, err := readKeyAliasesFile(path)
+		require.NoError(t, err, "readKeyAliasesFile")
+		assert.Equal(t, KeyAliases{}, aliases, "aliases")
+	})
+
+	t.Run("write then read", func(t *testing.T) {
+		aliases := KeyAliases{
+			"perf": {"mempool.size", "consensus.timeout_commit"},
+			"cli":  {"output"},
+		}
+		require.NoError(t, writeKeyAliasesFile(path, aliases), "writeKeyAliasesFile")
+		bz, err := os.ReadFile(path)
+		require.NoError(t, err, "ReadFile")
+		expContents := "# Aliases for groups of config keys, e.g. @perf.\n" +
+			"# Manage these using the config alias commands.\n" +
+			"cli = [\"output\"]\n" +
+			"perf = [\"mempool.size\", \"consensus.timeout_commit\"]\n"
+		assert.Equal(t, expContents, string(bz), "file contents")
+
+		read, err := readKeyAliasesFile(path)
+		require.NoError(t, err, "readKeyAliasesFile")
+		assert.Equal(t, aliases, read, "aliases")
+	})
+
+	t.Run("write nothing deletes file", func(t *testing.T) {
+		require.NoError(t, writeKeyAliasesFile(path, nil), "writeKeyAliasesFile")
+		_, err := os.Stat(path)
+		assert.ErrorIs(t, err, os.ErrNotExist, "Stat error after writing no aliases")
+	})
+
+	t.Run("invalid name in file", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte("[api]\nperf = [\"api.enable\"]\n"), 0o644), "WriteFile")
+		_, err := readKeyAliasesFile(path)
+		assert.ErrorContains(t, err, `invalid key alias name "api.perf"`, "readKeyAliasesFile error")
+	})
+
+	t.Run("invalid toml", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte("perf = "), 0o644), "WriteFile")
+		_, err := readKeyAliasesFile(path)
+		assert.ErrorContains(t, err, "could not read key aliases file", "readKeyAliasesFile error")
+	})
+}
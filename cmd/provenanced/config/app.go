@@ -0,0 +1,51 @@
+package config
+
+import (
+	"errors"
+	"time"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// AppConfig is the app/cosmos config (from the SDK) along with the provenance-specific sections.
+// It's all written to the same app.toml file.
+type AppConfig struct {
+	serverconfig.Config `mapstructure:",squash"`
+
+	// Marker contains the config for the marker module.
+	Marker MarkerConfig `mapstructure:"marker"`
+}
+
+// MarkerConfig defines the node-level config for the marker module.
+type MarkerConfig struct {
+	// QueryTimeout is the maximum amount of time that an expensive marker query is allowed to run.
+	// Zero means there's no limit.
+	QueryTimeout time.Duration `mapstructure:"query-timeout"`
+	// RestrictionTrace is whether to record the checks made by the marker send restriction when it rejects a
+	// transfer, so they can be looked up using the RestrictionTrace query.
+	RestrictionTrace bool `mapstructure:"restriction-trace"`
+}
+
+// DefaultMarkerConfig returns the default marker config.
+func DefaultMarkerConfig() MarkerConfig {
+	return MarkerConfig{
+		QueryTimeout:     0,
+		RestrictionTrace: false,
+	}
+}
+
+// ValidateBasic returns an error if this app config is invalid.
+func (c AppConfig) ValidateBasic() error {
+	if err := c.Config.ValidateBasic(); err != nil {
+		return err
+	}
+	return c.Marker.ValidateBasic()
+}
+
+// ValidateBasic returns an error if this marker config is invalid.
+func (c MarkerConfig) ValidateBasic() error {
+	if c.QueryTimeout < 0 {
+		return errors.New("marker.query-timeout cannot be negative")
+	}
+	return nil
+}
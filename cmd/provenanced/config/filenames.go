@@ -28,6 +28,12 @@ const (
 
 	// PackedConfFilename is the filename of the packed (non-defaults) file.
 	PackedConfFilename = "packed-conf.json"
+	// ConfigLockFilename is the filename of the lock file used to keep config operations from running concurrently.
+	ConfigLockFilename = "config.lock"
+	// PinnedKeysFilename is the filename of the file with the config keys that are protected from modification.
+	PinnedKeysFilename = "pinned-keys.json"
+	// KeyAliasesFilename is the filename of the file with the user-defined aliases for groups of config keys.
+	KeyAliasesFilename = "key-aliases.toml"
 )
 
 // GetHomeDir gets the home directory from the provided cobra command.
@@ -64,3 +70,18 @@ func GetFullPathToUnmanagedConf(cmd *cobra.Command) string {
 func GetFullPathToPackedConf(cmd *cobra.Command) string {
 	return filepath.Join(GetHomeDir(cmd), ConfigSubDir, PackedConfFilename)
 }
+
+// GetFullPathToConfigLock gets the full path to the config lock file.
+func GetFullPathToConfigLock(cmd *cobra.Command) string {
+	return filepath.Join(GetHomeDir(cmd), ConfigSubDir, ConfigLockFilename)
+}
+
+// GetFullPathToPinnedKeys gets the full path to the pinned keys file.
+func GetFullPathToPinnedKeys(cmd *cobra.Command) string {
+	return filepath.Join(GetHomeDir(cmd), ConfigSubDir, PinnedKeysFilename)
+}
+
+// GetFullPathToKeyAliases gets the full path to the key aliases file.
+func GetFullPathToKeyAliases(cmd *cobra.Command) string {
+	return filepath.Join(GetHomeDir(cmd), ConfigSubDir, KeyAliasesFilename)
+}
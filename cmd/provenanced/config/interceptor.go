@@ -25,6 +25,18 @@ const (
 	CoinTypeFlag = "coin-type"
 )
 
+// EnvPrefix is the prefix of the environment variables that can be used to define config values.
+const EnvPrefix = "PIO"
+
+// envKeyReplacer converts a config key or flag name into the form used in an environment variable name.
+var envKeyReplacer = strings.NewReplacer(".", "_", "-", "_")
+
+// EnvVarName returns the name of the environment variable that can be used to define the provided config key.
+// E.g. "api.enable" => "PIO_API_ENABLE".
+func EnvVarName(key string) string {
+	return EnvPrefix + "_" + strings.ToUpper(envKeyReplacer.Replace(key))
+}
+
 // InterceptConfigsPreRunHandler performs a pre-run function for all commands.
 // It will finish setting up the client context and create the server context.
 // It will create a Viper literal and the configs will be read and parsed or created from defaults.
@@ -76,9 +88,7 @@ func bindFlagsAndEnv(cmd *cobra.Command, v *viper.Viper) (err error) {
 		recover() //nolint:errcheck // err already set to needed return value.
 	}()
 
-	replacer := strings.NewReplacer(".", "_", "-", "_")
-
-	v.SetEnvKeyReplacer(replacer)
+	v.SetEnvKeyReplacer(envKeyReplacer)
 	v.AutomaticEnv()
 
 	if err = v.BindPFlags(cmd.Flags()); err != nil {
@@ -91,7 +101,7 @@ func bindFlagsAndEnv(cmd *cobra.Command, v *viper.Viper) (err error) {
 	cmd.Flags().VisitAll(func(f *pflag.Flag) {
 		// Environment variables can't have dashes in them, so bind them to their equivalent
 		// keys with underscores, e.g. --favorite-color to PIO_FAVORITE_COLOR
-		err = v.BindEnv(f.Name, "PIO_"+strings.ToUpper(replacer.Replace(f.Name)))
+		err = v.BindEnv(f.Name, EnvVarName(f.Name))
 		if err != nil {
 			panic(err)
 		}
@@ -0,0 +1,173 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// ErrConfigLocked is returned when the config lock is held by another config operation.
+var ErrConfigLocked = errors.New("another config operation is in progress")
+
+var (
+	// ConfigLockStaleAge is how old a config lock can be before it's considered stale (regardless of its pid).
+	ConfigLockStaleAge = 10 * time.Minute
+	// configLockPollInterval is how long to wait between attempts to get the config lock when waiting for it.
+	configLockPollInterval = 100 * time.Millisecond
+)
+
+// ConfigLock is an advisory lock on the config directory, used so that concurrent
+// config operations (e.g. pack, unpack, and set) don't interleave their writes.
+//
+// The lock is a file in the config directory that contains the pid of the holder
+// and when it was acquired. A lock is stale (and will be taken over) if the holder
+// process no longer exists or if the lock is older than ConfigLockStaleAge.
+type ConfigLock struct {
+	path string
+}
+
+// AcquireConfigLock gets the config lock for the home directory of the provided command.
+// If the lock is held by another (live) process, either ErrConfigLocked is returned, or if wait = true,
+// this blocks until the lock is released (or becomes stale). The returned lock must be released.
+func AcquireConfigLock(cmd *cobra.Command, wait bool) (*ConfigLock, error) {
+	if err := EnsureConfigDir(cmd); err != nil {
+		return nil, err
+	}
+	return acquireConfigLock(GetFullPathToConfigLock(cmd), wait)
+}
+
+// acquireConfigLock gets the config lock using the provided lock file.
+func acquireConfigLock(path string, wait bool) (*ConfigLock, error) {
+	for {
+		err := createConfigLockFile(path)
+		if err == nil {
+			return &ConfigLock{path: path}, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("could not create config lock file %q: %w", path, err)
+		}
+
+		holder, stale := readConfigLockHolder(path)
+		if stale {
+			if err = removeStaleConfigLock(path, holder); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if !wait {
+			return nil, fmt.Errorf("%w: %s (lock file: %s)", ErrConfigLocked, holder, path)
+		}
+		time.Sleep(configLockPollInterval)
+	}
+}
+
+// Release releases this config lock. It is safe to call more than once.
+func (l *ConfigLock) Release() error {
+	if l == nil || len(l.path) == 0 {
+		return nil
+	}
+	err := os.Remove(l.path)
+	l.path = ""
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("could not remove config lock file: %w", err)
+	}
+	return nil
+}
+
+// configLockHolder is the info stored in a config lock file.
+type configLockHolder struct {
+	// contents is the raw contents of the lock file.
+	contents string
+	// pid is the process id of the holder. It is 0 if it could not be determined.
+	pid int
+	// since is when the lock was acquired.
+	since time.Time
+}
+
+// String returns a description of who holds the lock and since when.
+func (h configLockHolder) String() string {
+	if h.pid == 0 {
+		return fmt.Sprintf("held since %s", h.since.Format(time.RFC3339))
+	}
+	return fmt.Sprintf("held by pid %d since %s", h.pid, h.since.Format(time.RFC3339))
+}
+
+// createConfigLockFile creates the lock file, failing with an os.ErrExist error if it already exists.
+func createConfigLockFile(path string) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(f, "%d %d\n", os.Getpid(), time.Now().UnixNano())
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return err
+	}
+	return nil
+}
+
+// readConfigLockHolder reads the provided lock file and determines whether it's stale.
+// If the contents cannot be parsed (e.g. the holder is still writing it), only the age of the file is considered.
+// If the file no longer exists, it's treated as stale so that another attempt is made to create it.
+func readConfigLockHolder(path string) (configLockHolder, bool) {
+	var rv configLockHolder
+	info, err := os.Stat(path)
+	if err != nil {
+		return rv, errors.Is(err, os.ErrNotExist)
+	}
+	rv.since = info.ModTime()
+
+	bz, err := os.ReadFile(path)
+	if err == nil {
+		rv.contents = string(bz)
+		var pid int
+		var nanos int64
+		if _, err = fmt.Sscanf(strings.TrimSpace(rv.contents), "%d %d", &pid, &nanos); err == nil && pid > 0 {
+			rv.pid = pid
+			rv.since = time.Unix(0, nanos)
+		}
+	}
+
+	if time.Since(rv.since) > ConfigLockStaleAge {
+		return rv, true
+	}
+	return rv, rv.pid != 0 && !processExists(rv.pid)
+}
+
+// removeStaleConfigLock deletes the provided lock file as long as it still has the same contents as when it was read.
+// This lessens the chance of deleting a fresh lock that another process just created after removing the same stale lock.
+func removeStaleConfigLock(path string, holder configLockHolder) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("could not read stale config lock file %q: %w", path, err)
+	}
+	if string(bz) != holder.contents {
+		return nil
+	}
+	if err = os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("could not remove stale config lock file %q: %w", path, err)
+	}
+	return nil
+}
+
+// processExists returns true if there appears to be a running process with the provided pid.
+// If that can't be determined, it's assumed that the process exists.
+func processExists(pid int) bool {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	err = proc.Signal(syscall.Signal(0))
+	return !errors.Is(err, os.ErrProcessDone) && !errors.Is(err, syscall.ESRCH)
+}
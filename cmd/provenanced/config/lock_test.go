@@ -0,0 +1,158 @@
+package config
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeLockFile writes a lock file with the provided contents and sets its modification time.
+func writeLockFile(t *testing.T, path, contents string, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600), "WriteFile(%q)", path)
+	require.NoError(t, os.Chtimes(path, modTime, modTime), "Chtimes(%q)", path)
+}
+
+func TestAcquireConfigLock(t *testing.T) {
+	origPollInterval := configLockPollInterval
+	configLockPollInterval = 10 * time.Millisecond
+	defer func() {
+		configLockPollInterval = origPollInterval
+	}()
+
+	now := time.Now()
+	old := now.Add(-1 * ConfigLockStaleAge).Add(-1 * time.Minute)
+	pid := os.Getpid()
+	// This pid is way bigger than any pid that will actually be in use.
+	deadPID := math.MaxInt32
+
+	tests := []struct {
+		name     string
+		setup    func(t *testing.T, path string)
+		wait     bool
+		expErr   string
+		expTaken bool
+	}{
+		{
+			name:  "no lock",
+			setup: func(t *testing.T, path string) {},
+		},
+		{
+			name: "held lock",
+			setup: func(t *testing.T, path string) {
+				writeLockFile(t, path, fmt.Sprintf("%d %d\n", pid, now.UnixNano()), now)
+			},
+			expErr: fmt.Sprintf("another config operation is in progress: held by pid %d since %s", pid, now.Format(time.RFC3339)),
+		},
+		{
+			name: "held lock unreadable contents",
+			setup: func(t *testing.T, path string) {
+				writeLockFile(t, path, "not a lock", now)
+			},
+			expErr: "another config operation is in progress: held since " + now.Format(time.RFC3339),
+		},
+		{
+			name: "held lock with wait",
+			setup: func(t *testing.T, path string) {
+				writeLockFile(t, path, fmt.Sprintf("%d %d\n", pid, now.UnixNano()), now)
+				go func() {
+					time.Sleep(50 * time.Millisecond)
+					assert.NoError(t, os.Remove(path), "removing held lock file")
+				}()
+			},
+			wait: true,
+		},
+		{
+			name: "stale lock: process gone",
+			setup: func(t *testing.T, path string) {
+				writeLockFile(t, path, fmt.Sprintf("%d %d\n", deadPID, now.UnixNano()), now)
+			},
+			expTaken: true,
+		},
+		{
+			name: "stale lock: too old",
+			setup: func(t *testing.T, path string) {
+				writeLockFile(t, path, fmt.Sprintf("%d %d\n", pid, old.UnixNano()), now)
+			},
+			expTaken: true,
+		},
+		{
+			name: "stale lock: unreadable contents and too old",
+			setup: func(t *testing.T, path string) {
+				writeLockFile(t, path, "not a lock", old)
+			},
+			expTaken: true,
+		},
+		{
+			name: "stale lock: process gone with wait",
+			setup: func(t *testing.T, path string) {
+				writeLockFile(t, path, fmt.Sprintf("%d %d\n", deadPID, now.UnixNano()), now)
+			},
+			wait:     true,
+			expTaken: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), ConfigLockFilename)
+			tc.setup(t, path)
+
+			var origContents []byte
+			if tc.expTaken {
+				var err error
+				origContents, err = os.ReadFile(path)
+				require.NoError(t, err, "ReadFile(%q) before acquiring lock", path)
+			}
+
+			lock, err := acquireConfigLock(path, tc.wait)
+			if len(tc.expErr) > 0 {
+				assert.ErrorIs(t, err, ErrConfigLocked, "acquireConfigLock error")
+				assert.ErrorContains(t, err, tc.expErr, "acquireConfigLock error")
+				assert.Nil(t, lock, "acquireConfigLock lock")
+				assert.FileExists(t, path, "lock file after failing to acquire it")
+				return
+			}
+			require.NoError(t, err, "acquireConfigLock error")
+			require.NotNil(t, lock, "acquireConfigLock lock")
+
+			contents, err := os.ReadFile(path)
+			require.NoError(t, err, "ReadFile(%q) after acquiring lock", path)
+			assert.Regexp(t, fmt.Sprintf(`^%d [0-9]+\n$`, pid), string(contents), "lock file contents")
+			if tc.expTaken {
+				assert.NotEqual(t, string(origContents), string(contents), "lock file contents compared to stale lock")
+			}
+
+			err = lock.Release()
+			assert.NoError(t, err, "Release()")
+			assert.NoFileExists(t, path, "lock file after Release()")
+			err = lock.Release()
+			assert.NoError(t, err, "second Release()")
+		})
+	}
+}
+
+func TestConfigLockReleasedOnPanic(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ConfigLockFilename)
+
+	doPanic := func() {
+		lock, err := acquireConfigLock(path, false)
+		require.NoError(t, err, "acquireConfigLock")
+		defer func() {
+			_ = lock.Release()
+		}()
+		panic("oops")
+	}
+	require.PanicsWithValue(t, "oops", doPanic, "doPanic")
+	assert.NoFileExists(t, path, "lock file after panic")
+
+	lock, err := acquireConfigLock(path, false)
+	require.NoError(t, err, "acquireConfigLock after panic")
+	assert.NoError(t, lock.Release(), "Release()")
+}
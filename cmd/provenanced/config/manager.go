@@ -1,9 +1,11 @@
 package config
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
+	"reflect"
 	"strings"
 	"time"
 
@@ -25,37 +27,176 @@ var DefaultConsensusTimeoutCommit = 1500 * time.Millisecond
 
 // PackConfig generates and saves the packed config file then removes the individual config files.
 func PackConfig(cmd *cobra.Command) error {
-	generateAndWritePackedConfig(cmd, nil, nil, nil, true)
-	err := deleteUnpackedConfig(cmd, true)
-	return err
+	return PackSomeConfigs(cmd, AllConfigs(), false)
+}
+
+// PackSomeConfigs adds the provided configs to the packed config file then removes their individual config files.
+// Any configs that are already packed stay packed.
+// If dryRun is true, the contents of the packed config file are written to the command's output
+// (exactly as they would be saved), and no files are changed.
+func PackSomeConfigs(cmd *cobra.Command, toPack ConfigSet, dryRun bool) error {
+	packed := GetPackedConfigs(cmd).Union(toPack)
+	if dryRun {
+		packedJSON, err := makePackedConfigFileContents(cmd, packed, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		_, err = cmd.OutOrStdout().Write(packedJSON)
+		return err
+	}
+	generateAndWritePackedConfig(cmd, packed, nil, nil, nil, true)
+	return deleteUnpackedConfig(cmd, toPack, true)
 }
 
 // UnpackConfig generates the saves the individual config files and removes the packed config file.
 func UnpackConfig(cmd *cobra.Command) error {
-	appConfig, appConfErr := ExtractAppConfig(cmd)
-	if appConfErr != nil {
-		return fmt.Errorf("could not get app config values: %w", appConfErr)
+	return UnpackSomeConfigs(cmd, AllConfigs())
+}
+
+// UnpackSomeConfigs saves the individual config files of the provided configs and removes them from the packed config.
+// The packed config file is removed once there aren't any configs left in it.
+func UnpackSomeConfigs(cmd *cobra.Command, toUnpack ConfigSet) error {
+	var appConfig *AppConfig
+	var cmtConfig *cmtconfig.Config
+	var clientConfig *ClientConfig
+	var err error
+	if toUnpack.App {
+		if appConfig, err = ExtractAppConfig(cmd); err != nil {
+			return fmt.Errorf("could not get app config values: %w", err)
+		}
 	}
-	cmtConfig, cmtConfErr := ExtractCmtConfig(cmd)
-	if cmtConfErr != nil {
-		return fmt.Errorf("could not get cometbft config values: %w", cmtConfErr)
+	if toUnpack.Cmt {
+		if cmtConfig, err = ExtractCmtConfig(cmd); err != nil {
+			return fmt.Errorf("could not get cometbft config values: %w", err)
+		}
 	}
-	clientConfig, clientConfErr := ExtractClientConfig(cmd)
-	if clientConfErr != nil {
-		return fmt.Errorf("could not get client config values: %w", clientConfErr)
+	if toUnpack.Client {
+		if clientConfig, err = ExtractClientConfig(cmd); err != nil {
+			return fmt.Errorf("could not get client config values: %w", err)
+		}
 	}
+	stillPacked := GetPackedConfigs(cmd).Without(toUnpack)
 	writeUnpackedConfig(cmd, appConfig, cmtConfig, clientConfig, true)
-	err := deletePackedConfig(cmd, true)
-	return err
+	if stillPacked.Any() {
+		generateAndWritePackedConfig(cmd, stillPacked, nil, nil, nil, true)
+		return nil
+	}
+	return deletePackedConfig(cmd, true)
 }
 
 // IsPacked checks to see if we're using a packed config or not.
-// returns true if using the packed config.
+// returns true if using the packed config (for at least some of the configs).
 // returns false if using the unpacked multiple config files.
+// Use GetPackedConfigs to identify which configs are packed.
 func IsPacked(cmd *cobra.Command) bool {
 	return FileExists(GetFullPathToPackedConf(cmd))
 }
 
+// PackedConfigsKey is the packed config file entry that identifies which configs are in it.
+// It's only included when some of the configs are not packed (i.e. they're in their individual files).
+// Its value is a comma-separated list of config types, e.g. "app,client".
+const PackedConfigsKey = "packed-configs"
+
+// GetPackedConfigs identifies which configs are packed. If the packed config file doesn't exist, none of them are.
+func GetPackedConfigs(cmd *cobra.Command) ConfigSet {
+	if !IsPacked(cmd) {
+		return ConfigSet{}
+	}
+	return getPackedConfigsInFile(GetFullPathToPackedConf(cmd))
+}
+
+// getPackedConfigsInFile identifies which configs are in the provided packed config file.
+// If the file can't be read, all of them are considered packed so that the problem is reported when it's loaded.
+func getPackedConfigsInFile(packedConfFile string) ConfigSet {
+	_, packed, err := readPackedConfigFile(packedConfFile)
+	if err != nil {
+		return AllConfigs()
+	}
+	return packed
+}
+
+// ConfigSet identifies some of the configs, e.g. the ones that are packed.
+type ConfigSet struct {
+	// App is whether the app config is in this set.
+	App bool
+	// Cmt is whether the cometbft config is in this set.
+	Cmt bool
+	// Client is whether the client config is in this set.
+	Client bool
+}
+
+// AllConfigs returns a ConfigSet with all of the configs in it.
+func AllConfigs() ConfigSet {
+	return ConfigSet{App: true, Cmt: true, Client: true}
+}
+
+// parseConfigSet parses a comma-separated list of config types (e.g. "app,client") into a ConfigSet.
+func parseConfigSet(str string) (ConfigSet, error) {
+	var rv ConfigSet
+	for _, confType := range strings.Split(str, ",") {
+		switch strings.TrimSpace(confType) {
+		case ConfigTypeApp:
+			rv.App = true
+		case ConfigTypeCmt:
+			rv.Cmt = true
+		case ConfigTypeClient:
+			rv.Client = true
+		default:
+			return ConfigSet{}, fmt.Errorf("unknown config type %q: expected one of %q, %q, or %q",
+				confType, ConfigTypeApp, ConfigTypeCmt, ConfigTypeClient)
+		}
+	}
+	return rv, nil
+}
+
+// String returns a comma-separated list of the types of config in this set, e.g. "app,client".
+func (s ConfigSet) String() string {
+	var confTypes []string
+	if s.App {
+		confTypes = append(confTypes, ConfigTypeApp)
+	}
+	if s.Cmt {
+		confTypes = append(confTypes, ConfigTypeCmt)
+	}
+	if s.Client {
+		confTypes = append(confTypes, ConfigTypeClient)
+	}
+	return strings.Join(confTypes, ",")
+}
+
+// Any returns true if at least one of the configs is in this set.
+func (s ConfigSet) Any() bool {
+	return s.App || s.Cmt || s.Client
+}
+
+// All returns true if all of the configs are in this set.
+func (s ConfigSet) All() bool {
+	return s.App && s.Cmt && s.Client
+}
+
+// Has returns true if the provided type of config (e.g. ConfigTypeApp) is in this set.
+func (s ConfigSet) Has(confType string) bool {
+	switch confType {
+	case ConfigTypeApp:
+		return s.App
+	case ConfigTypeCmt:
+		return s.Cmt
+	case ConfigTypeClient:
+		return s.Client
+	}
+	return false
+}
+
+// Union returns a ConfigSet with the configs that are in either this set or the other one.
+func (s ConfigSet) Union(other ConfigSet) ConfigSet {
+	return ConfigSet{App: s.App || other.App, Cmt: s.Cmt || other.Cmt, Client: s.Client || other.Client}
+}
+
+// Without returns a ConfigSet with the configs that are in this set but not the other one.
+func (s ConfigSet) Without(other ConfigSet) ConfigSet {
+	return ConfigSet{App: s.App && !other.App, Cmt: s.Cmt && !other.Cmt, Client: s.Client && !other.Client}
+}
+
 // FileExists returns true if there is no error getting stat info of the file.
 // Even returns false if os.IsNotExist(err) is false.
 // I.e. if the file exists but isn't readable, this will still return false.
@@ -65,7 +206,7 @@ func FileExists(fullFilePath string) bool {
 }
 
 // ExtractAppConfig creates an app/cosmos config from the command context.
-func ExtractAppConfig(cmd *cobra.Command) (*serverconfig.Config, error) {
+func ExtractAppConfig(cmd *cobra.Command) (*AppConfig, error) {
 	v := server.GetServerContextFromCmd(cmd).Viper
 	conf := DefaultAppConfig()
 	if err := v.Unmarshal(conf); err != nil {
@@ -75,7 +216,7 @@ func ExtractAppConfig(cmd *cobra.Command) (*serverconfig.Config, error) {
 }
 
 // ExtractAppConfigAndMap from the command context, creates an app/cosmos config and related string->value map.
-func ExtractAppConfigAndMap(cmd *cobra.Command) (*serverconfig.Config, FieldValueMap, error) {
+func ExtractAppConfigAndMap(cmd *cobra.Command) (*AppConfig, FieldValueMap, error) {
 	conf, err := ExtractAppConfig(cmd)
 	if err != nil {
 		return nil, nil, err
@@ -85,8 +226,11 @@ func ExtractAppConfigAndMap(cmd *cobra.Command) (*serverconfig.Config, FieldValu
 }
 
 // DefaultAppConfig gets our default app config.
-func DefaultAppConfig() *serverconfig.Config {
-	rv := serverconfig.DefaultConfig()
+func DefaultAppConfig() *AppConfig {
+	rv := &AppConfig{
+		Config: *serverconfig.DefaultConfig(),
+		Marker: DefaultMarkerConfig(),
+	}
 	rv.MinGasPrices = pioconfig.GetProvenanceConfig().ProvenanceMinGasPrices
 	rv.IAVLDisableFastNode = true
 	return rv
@@ -178,22 +322,69 @@ func GetAllConfigDefaults() FieldValueMap {
 	return rv
 }
 
+// GetAllConfigDefaultsSnapshot gets an immutable snapshot of the defaults of all the configs.
+func GetAllConfigDefaultsSnapshot() *FieldValueSnapshot {
+	return GetAllConfigDefaults().Snapshot()
+}
+
+// ExtractAppConfigSnapshot gets an immutable snapshot of the app/cosmos config field values from the command context.
+// Use ExtractAppConfigAndMap instead if the values need to be changed.
+func ExtractAppConfigSnapshot(cmd *cobra.Command) (*FieldValueSnapshot, error) {
+	_, fields, err := ExtractAppConfigAndMap(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return fields.Snapshot(), nil
+}
+
+// ExtractCmtConfigSnapshot gets an immutable snapshot of the cometbft config field values from the command context.
+// Use ExtractCmtConfigAndMap instead if the values need to be changed.
+func ExtractCmtConfigSnapshot(cmd *cobra.Command) (*FieldValueSnapshot, error) {
+	_, fields, err := ExtractCmtConfigAndMap(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return fields.Snapshot(), nil
+}
+
+// ExtractClientConfigSnapshot gets an immutable snapshot of the client config field values from the command context.
+// Use ExtractClientConfigAndMap instead if the values need to be changed.
+func ExtractClientConfigSnapshot(cmd *cobra.Command) (*FieldValueSnapshot, error) {
+	_, fields, err := ExtractClientConfigAndMap(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return fields.Snapshot(), nil
+}
+
 // SaveConfigs saves the configs to files.
-// If the config is packed, any nil configs provided will extracted from the cmd.
-// If the config is unpacked, only the configs provided will be written.
+// If any configs are packed, the packed config file is rewritten, and any nil packed configs will be extracted from the cmd.
+// For the configs that are unpacked, only the ones provided will be written.
 // Any errors encountered will result in a panic.
 func SaveConfigs(
 	cmd *cobra.Command,
-	appConfig *serverconfig.Config,
+	appConfig *AppConfig,
 	cmtConfig *cmtconfig.Config,
 	clientConfig *ClientConfig,
 	verbose bool,
 ) {
-	if IsPacked(cmd) {
-		generateAndWritePackedConfig(cmd, appConfig, cmtConfig, clientConfig, verbose)
-	} else {
-		writeUnpackedConfig(cmd, appConfig, cmtConfig, clientConfig, verbose)
+	packed := GetPackedConfigs(cmd)
+	if packed.Any() {
+		generateAndWritePackedConfig(cmd, packed, appConfig, cmtConfig, clientConfig, verbose)
+	}
+	if packed.All() {
+		return
 	}
+	if packed.App {
+		appConfig = nil
+	}
+	if packed.Cmt {
+		cmtConfig = nil
+	}
+	if packed.Client {
+		clientConfig = nil
+	}
+	writeUnpackedConfig(cmd, appConfig, cmtConfig, clientConfig, verbose)
 }
 
 // writeUnpackedConfig writes the provided configs to their files.
@@ -201,7 +392,7 @@ func SaveConfigs(
 // Any errors encountered will result in a panic or exit.
 func writeUnpackedConfig(
 	cmd *cobra.Command,
-	appConfig *serverconfig.Config,
+	appConfig *AppConfig,
 	cmtConfig *cmtconfig.Config,
 	clientConfig *ClientConfig,
 	verbose bool,
@@ -212,7 +403,7 @@ func writeUnpackedConfig(
 		if verbose {
 			cmd.Printf("Writing app config to: %s ... ", confFile)
 		}
-		serverconfig.WriteConfigFile(confFile, appConfig)
+		WriteAppConfigToFile(confFile, appConfig)
 		if verbose {
 			cmd.Printf("Done.\n")
 		}
@@ -239,15 +430,20 @@ func writeUnpackedConfig(
 	}
 }
 
-// deleteUnpackedConfig deletes all the unpacked config files.
+// deleteUnpackedConfig deletes the unpacked config files of the provided configs.
 // An attempt will be made to remove each file before returning.
 // The error returned might reflect multiple failures to delete.
 // Any files that don't exist, are ignored.
-func deleteUnpackedConfig(cmd *cobra.Command, verbose bool) error {
-	configFiles := []string{
-		GetFullPathToAppConf(cmd),
-		GetFullPathToCmtConf(cmd),
-		GetFullPathToClientConf(cmd),
+func deleteUnpackedConfig(cmd *cobra.Command, toDelete ConfigSet, verbose bool) error {
+	var configFiles []string
+	if toDelete.App {
+		configFiles = append(configFiles, GetFullPathToAppConf(cmd))
+	}
+	if toDelete.Cmt {
+		configFiles = append(configFiles, GetFullPathToCmtConf(cmd))
+	}
+	if toDelete.Client {
+		configFiles = append(configFiles, GetFullPathToClientConf(cmd))
 	}
 	var rvErr error
 	for _, f := range configFiles {
@@ -263,52 +459,19 @@ func deleteUnpackedConfig(cmd *cobra.Command, verbose bool) error {
 }
 
 // generateAndWritePackedConfig generates the contents of the packed config file and saves it.
+// Only the configs in the provided packed set are included.
 // Any config parameter provided as nil will be retrieved from the cmd.
 // Any errors encountered will result in a panic.
 func generateAndWritePackedConfig(
 	cmd *cobra.Command,
-	appConfig *serverconfig.Config,
+	packed ConfigSet,
+	appConfig *AppConfig,
 	cmtConfig *cmtconfig.Config,
 	clientConfig *ClientConfig,
 	verbose bool,
 ) {
 	mustEnsureConfigDir(cmd)
-	var appConfMap, cmtConfMap, clientConfMap FieldValueMap
-	if appConfig == nil {
-		var err error
-		_, appConfMap, err = ExtractAppConfigAndMap(cmd)
-		if err != nil {
-			panic(fmt.Errorf("could not extract app config values: %w", err))
-		}
-	} else {
-		appConfMap = MakeFieldValueMap(appConfig, false)
-	}
-	if cmtConfig == nil {
-		var err error
-		_, cmtConfMap, err = ExtractCmtConfigAndMap(cmd)
-		if err != nil {
-			panic(fmt.Errorf("could not extract cometbft config values: %w", err))
-		}
-	} else {
-		cmtConfMap = MakeFieldValueMap(cmtConfig, false)
-	}
-	if clientConfig == nil {
-		var err error
-		_, clientConfMap, err = ExtractClientConfigAndMap(cmd)
-		if err != nil {
-			panic(fmt.Errorf("could not extract client config values: %w", err))
-		}
-	} else {
-		clientConfMap = MakeFieldValueMap(clientConfig, false)
-	}
-	allConf := FieldValueMap{}
-	allConf.AddEntriesFrom(appConfMap, cmtConfMap, clientConfMap)
-	defaultConf := GetAllConfigDefaults()
-	packed := map[string]string{}
-	for key, info := range MakeUpdatedFieldMap(defaultConf, allConf, true) {
-		packed[key] = unquote(info.IsNow)
-	}
-	packedJSON, err := json.MarshalIndent(packed, "", "  ")
+	packedJSON, err := makePackedConfigFileContents(cmd, packed, appConfig, cmtConfig, clientConfig)
 	if err != nil {
 		panic(err)
 	}
@@ -327,6 +490,96 @@ func generateAndWritePackedConfig(
 	}
 }
 
+// makePackedConfigFileContents generates the contents of the packed config file with the configs in the provided packed set.
+// Any config parameter provided as nil will be retrieved from the cmd.
+func makePackedConfigFileContents(
+	cmd *cobra.Command,
+	packed ConfigSet,
+	appConfig *AppConfig,
+	cmtConfig *cmtconfig.Config,
+	clientConfig *ClientConfig,
+) ([]byte, error) {
+	allConf := FieldValueMap{}
+	defaultConf := FieldValueMap{}
+	if packed.App {
+		var appConfMap FieldValueMap
+		if appConfig == nil {
+			var err error
+			if _, appConfMap, err = ExtractAppConfigAndMap(cmd); err != nil {
+				return nil, fmt.Errorf("could not extract app config values: %w", err)
+			}
+		} else {
+			appConfMap = MakeFieldValueMap(appConfig, false)
+		}
+		allConf.AddEntriesFrom(appConfMap)
+		defaultConf.AddEntriesFrom(MakeFieldValueMap(DefaultAppConfig(), false))
+	}
+	if packed.Cmt {
+		var cmtConfMap FieldValueMap
+		if cmtConfig == nil {
+			var err error
+			if _, cmtConfMap, err = ExtractCmtConfigAndMap(cmd); err != nil {
+				return nil, fmt.Errorf("could not extract cometbft config values: %w", err)
+			}
+		} else {
+			cmtConfMap = MakeFieldValueMap(cmtConfig, false)
+		}
+		allConf.AddEntriesFrom(cmtConfMap)
+		defaultConf.AddEntriesFrom(removeUndesirableCmtConfigEntries(MakeFieldValueMap(DefaultCmtConfig(), false)))
+	}
+	if packed.Client {
+		var clientConfMap FieldValueMap
+		if clientConfig == nil {
+			var err error
+			if _, clientConfMap, err = ExtractClientConfigAndMap(cmd); err != nil {
+				return nil, fmt.Errorf("could not extract client config values: %w", err)
+			}
+		} else {
+			clientConfMap = MakeFieldValueMap(clientConfig, false)
+		}
+		allConf.AddEntriesFrom(clientConfMap)
+		defaultConf.AddEntriesFrom(MakeFieldValueMap(DefaultClientConfig(), false))
+	}
+	changes := MakeUpdatedFieldMap(defaultConf, allConf, true)
+	if !packed.All() {
+		changes[PackedConfigsKey] = &UpdatedField{Key: PackedConfigsKey, IsNow: packed.String()}
+	}
+	return makePackedConfigJSON(changes)
+}
+
+// makePackedConfigJSON creates the contents of a packed config file containing the current values of the provided changes.
+func makePackedConfigJSON(changes UpdatedFieldMap) ([]byte, error) {
+	packed := map[string]string{}
+	for key, info := range changes {
+		packed[key] = unquote(info.IsNow)
+	}
+	return json.MarshalIndent(packed, "", "  ")
+}
+
+// WritePackedConfigFile writes the provided changes to a file in the same format as the packed config file.
+// Only entries that differ from what they were are included, so the result is a minimal set of overrides.
+// An error is returned if the file already exists, unless force is true.
+func WritePackedConfigFile(filePath string, changes UpdatedFieldMap, force bool) error {
+	if !force && FileExists(filePath) {
+		return fmt.Errorf("file %q already exists", filePath)
+	}
+	toWrite := UpdatedFieldMap{}
+	for key, info := range changes {
+		if info.HasDiff() {
+			toWrite[key] = info
+		}
+	}
+	packedJSON, err := makePackedConfigJSON(toWrite)
+	if err != nil {
+		return fmt.Errorf("could not create packed config: %w", err)
+	}
+	//nolint:gosec // These are the correct permissions
+	if err = os.WriteFile(filePath, packedJSON, 0644); err != nil {
+		return fmt.Errorf("could not write packed config file: %w", err)
+	}
+	return nil
+}
+
 // deletePackedConfig deletes the packed config file.
 func deletePackedConfig(cmd *cobra.Command, verbose bool) error {
 	return deleteConfigFile(cmd, GetFullPathToPackedConf(cmd), verbose)
@@ -421,20 +674,30 @@ func loadUnmanagedConfig(cmd *cobra.Command) error {
 
 // loadUnpackedConfig attempts to read the unpacked config files and apply them to the appropriate contexts.
 func loadUnpackedConfig(cmd *cobra.Command) error {
-	appConfFile := GetFullPathToAppConf(cmd)
-	cmtConfFile := GetFullPathToCmtConf(cmd)
-	clientConfFile := GetFullPathToClientConf(cmd)
-
 	// Both the server context and client context should be using the same Viper, so this is good for both.
 	vpr := server.GetServerContextFromCmd(cmd).Viper
+	clientConfFile := GetFullPathToClientConf(cmd)
+	err := readUnpackedConfig(vpr, GetFullPathToAppConf(cmd), GetFullPathToCmtConf(cmd), clientConfFile)
+	if err != nil {
+		return err
+	}
+	if IsJSONConfigFile(clientConfFile) {
+		cmd.PrintErrf("warning: the client config file %s contains JSON instead of TOML, "+
+			"use the config unpack (or update) command to rewrite it as TOML\n", clientConfFile)
+	}
+	return applyConfigsToContexts(cmd)
+}
 
+// readUnpackedConfig loads the defaults of each config into the provided viper, then merges in each of
+// the provided config files. A config file that doesn't exist (or is "") is skipped (leaving just the defaults).
+func readUnpackedConfig(vpr *viper.Viper, appConfFile, cmtConfFile, clientConfFile string) error {
 	// Load the cometbft config defaults, then file if it exists.
 	tdErr := addFieldMapToViper(vpr, MakeFieldValueMap(DefaultCmtConfig(), false))
 	if tdErr != nil {
 		return fmt.Errorf("cometbft config defaults load error: %w", tdErr)
 	}
 	switch _, err := os.Stat(cmtConfFile); {
-	case os.IsNotExist(err):
+	case len(cmtConfFile) == 0, os.IsNotExist(err):
 		// Do nothing.
 	case err != nil:
 		return fmt.Errorf("cometbft config file stat error: %w", err)
@@ -452,7 +715,7 @@ func loadUnpackedConfig(cmd *cobra.Command) error {
 		return fmt.Errorf("app config defaults load error: %w", adErr)
 	}
 	switch _, err := os.Stat(appConfFile); {
-	case os.IsNotExist(err):
+	case len(appConfFile) == 0, os.IsNotExist(err):
 		// Do nothing.
 	case err != nil:
 		return fmt.Errorf("app config file stat error: %w", err)
@@ -470,94 +733,183 @@ func loadUnpackedConfig(cmd *cobra.Command) error {
 		return fmt.Errorf("client config defaults load error: %w", cdErr)
 	}
 	switch _, err := os.Stat(clientConfFile); {
-	case os.IsNotExist(err):
+	case len(clientConfFile) == 0, os.IsNotExist(err):
 		// Do nothing.
 	case err != nil:
 		return fmt.Errorf("client config file stat error: %w", err)
 	default:
-		vpr.SetConfigFile(clientConfFile)
-		rerr := vpr.MergeInConfig()
+		rerr := mergeInTOMLOrJSONConfig(vpr, clientConfFile)
 		if rerr != nil {
 			return fmt.Errorf("client config file read error: %w", rerr)
 		}
 	}
+	return nil
+}
 
-	return applyConfigsToContexts(cmd)
+// mergeInTOMLOrJSONConfig merges the provided config file into the provided viper.
+// Some older tooling wrote the client config as JSON even though the file is named client.toml.
+// So if the file can't be read as TOML, but its contents start with a '{', it's read as JSON instead.
+// If it can't be read as JSON either, the TOML error is returned.
+func mergeInTOMLOrJSONConfig(vpr *viper.Viper, confFile string) error {
+	vpr.SetConfigFile(confFile)
+	tomlErr := vpr.MergeInConfig()
+	if tomlErr == nil {
+		return nil
+	}
+	contents, err := os.ReadFile(confFile)
+	if err != nil || !isJSONPayload(contents) {
+		return tomlErr
+	}
+	var confMap map[string]interface{}
+	if err = json.Unmarshal(contents, &confMap); err != nil {
+		return tomlErr
+	}
+	return vpr.MergeConfigMap(confMap)
+}
+
+// isJSONPayload returns true if the provided contents look like a JSON object, i.e. start with a '{'.
+func isJSONPayload(contents []byte) bool {
+	contents = bytes.TrimSpace(contents)
+	return len(contents) > 0 && contents[0] == '{'
+}
+
+// IsJSONConfigFile returns true if the provided config file exists and contains JSON instead of TOML.
+func IsJSONConfigFile(confFile string) bool {
+	contents, err := os.ReadFile(confFile)
+	return err == nil && isJSONPayload(contents)
 }
 
 // loadPackedConfig attempts to read the packed config and applies it to the appropriate contexts.
+// Any configs that aren't packed are read from their individual files.
 func loadPackedConfig(cmd *cobra.Command) error {
-	packedConfFile := GetFullPathToPackedConf(cmd)
+	// The server and client should both have the same viper, so we only need the one.
+	vpr := server.GetServerContextFromCmd(cmd).Viper
+	unknown, err := readPackedConfig(vpr, GetFullPathToPackedConf(cmd),
+		GetFullPathToAppConf(cmd), GetFullPathToCmtConf(cmd), GetFullPathToClientConf(cmd))
+	for _, k := range unknown.GetSortedKeys() {
+		cmd.PrintErrf("unknown packed config key: %s", k)
+	}
+	if err != nil {
+		return err
+	}
+	return applyConfigsToContexts(cmd)
+}
 
-	// Read in the packed config if it exists.
+// readPackedConfigFile reads the entries in the provided packed config file and identifies which configs are in it.
+// If the file doesn't exist, there aren't any entries, but all the configs are considered packed.
+// The PackedConfigsKey entry is not included in the returned entries.
+func readPackedConfigFile(packedConfFile string) (map[string]string, ConfigSet, error) {
 	packedConf := map[string]string{}
-
 	switch packedJSON, rerr := os.ReadFile(packedConfFile); {
 	case os.IsNotExist(rerr):
 		// Packed config file doesn't exist. Do nothing. Just let it use the defaults.
 	case rerr != nil:
-		return fmt.Errorf("packed config file read error: %w", rerr)
+		return nil, ConfigSet{}, fmt.Errorf("packed config file read error: %w", rerr)
 	default:
 		jerr := json.Unmarshal(packedJSON, &packedConf)
 		if jerr != nil {
-			return fmt.Errorf("packed config file parse error: %w", jerr)
+			return nil, ConfigSet{}, fmt.Errorf("packed config file parse error: %w", jerr)
 		}
 	}
 
+	packedConfigs, ok := packedConf[PackedConfigsKey]
+	if !ok {
+		return packedConf, AllConfigs(), nil
+	}
+	delete(packedConf, PackedConfigsKey)
+	packed, err := parseConfigSet(packedConfigs)
+	if err != nil {
+		return nil, ConfigSet{}, fmt.Errorf("packed config file %s entry error: %w", PackedConfigsKey, err)
+	}
+	return packedConf, packed, nil
+}
+
+// readPackedConfig reads the provided packed config file and loads it (and the defaults) into the provided viper.
+// If the file doesn't exist, just the defaults are loaded.
+// The configs that aren't in the packed config file are loaded from the provided individual config files
+// (the same way readUnpackedConfig does), the individual files of the packed configs are ignored.
+// Any entries in the file that don't correspond to a known field are returned.
+func readPackedConfig(vpr *viper.Viper, packedConfFile, appConfFile, cmtConfFile, clientConfFile string) (FieldValueMap, error) {
+	packedConf, packed, err := readPackedConfigFile(packedConfFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load the configs that aren't packed. The defaults are loaded for the packed ones, but those get replaced below.
+	if packed.App {
+		appConfFile = ""
+	}
+	if packed.Cmt {
+		cmtConfFile = ""
+	}
+	if packed.Client {
+		clientConfFile = ""
+	}
+	if err = readUnpackedConfig(vpr, appConfFile, cmtConfFile, clientConfFile); err != nil {
+		return nil, err
+	}
+
 	// Start with the defaults
 	appConfigMap := MakeFieldValueMap(DefaultAppConfig(), false)
 	cmtConfigMap := MakeFieldValueMap(DefaultCmtConfig(), false)
 	clientConfigMap := MakeFieldValueMap(DefaultClientConfig(), false)
 
 	// Apply the packed config entries to the defaults.
+	unknown := FieldValueMap{}
 	var rvErr error
 	for k, v := range packedConf {
 		found := false
 		if appConfigMap.Has(k) {
 			found = true
-			err := appConfigMap.SetFromString(k, v)
-			if err != nil {
-				rvErr = appendError(rvErr, fmt.Errorf("app config key: %s, value: %s, err: %w", k, v, err))
+			if packed.App {
+				if err := appConfigMap.SetFromString(k, v); err != nil {
+					rvErr = appendError(rvErr, fmt.Errorf("app config key: %s, value: %s, err: %w", k, v, err))
+				}
 			}
 		}
 		if cmtConfigMap.Has(k) {
 			found = true
-			err := cmtConfigMap.SetFromString(k, v)
-			if err != nil {
-				rvErr = appendError(rvErr, fmt.Errorf("cometbft config key: %s, value: %s, err: %w", k, v, err))
+			if packed.Cmt {
+				if err := cmtConfigMap.SetFromString(k, v); err != nil {
+					rvErr = appendError(rvErr, fmt.Errorf("cometbft config key: %s, value: %s, err: %w", k, v, err))
+				}
 			}
 		}
 		if clientConfigMap.Has(k) {
 			found = true
-			err := clientConfigMap.SetFromString(k, v)
-			if err != nil {
-				rvErr = appendError(rvErr, fmt.Errorf("client config key: %s, value: %s, err: %w", k, v, err))
+			if packed.Client {
+				if err := clientConfigMap.SetFromString(k, v); err != nil {
+					rvErr = appendError(rvErr, fmt.Errorf("client config key: %s, value: %s, err: %w", k, v, err))
+				}
 			}
 		}
 		if !found {
-			cmd.PrintErrf("unknown packed config key: %s", k)
+			unknown[k] = reflect.ValueOf(v)
 		}
 	}
 	if rvErr != nil {
-		return fmt.Errorf("one or more fields in the packed config could not be set\n%w", rvErr)
+		return unknown, fmt.Errorf("one or more fields in the packed config could not be set\n%w", rvErr)
 	}
 
 	// Set the config values as defaults in viper.
 	// Viper doesn't really have a way to directly set a config value,
 	// and a set value takes precedence over flags. So I guess defaults are what we go with.
-	// The server and client should both have the same viper, so we only need the one.
-	vpr := server.GetServerContextFromCmd(cmd).Viper
-	if lerr := addFieldMapToViper(vpr, cmtConfigMap); lerr != nil {
-		return fmt.Errorf("cometbft packed config load error: %w", lerr)
+	if packed.Cmt {
+		if lerr := addFieldMapToViper(vpr, cmtConfigMap); lerr != nil {
+			return unknown, fmt.Errorf("cometbft packed config load error: %w", lerr)
+		}
 	}
-	if lerr := addFieldMapToViper(vpr, appConfigMap); lerr != nil {
-		return fmt.Errorf("app packed config load error: %w", lerr)
+	if packed.App {
+		if lerr := addFieldMapToViper(vpr, appConfigMap); lerr != nil {
+			return unknown, fmt.Errorf("app packed config load error: %w", lerr)
+		}
 	}
-	if lerr := addFieldMapToViper(vpr, clientConfigMap); lerr != nil {
-		return fmt.Errorf("client packed config load error: %w", lerr)
+	if packed.Client {
+		if lerr := addFieldMapToViper(vpr, clientConfigMap); lerr != nil {
+			return unknown, fmt.Errorf("client packed config load error: %w", lerr)
+		}
 	}
-
-	return applyConfigsToContexts(cmd)
+	return unknown, nil
 }
 
 func addFieldMapToViper(vpr *viper.Viper, fvmap FieldValueMap) error {
@@ -586,7 +938,7 @@ func applyConfigsToContexts(cmd *cobra.Command) error {
 	}
 	if clientCtx, err = ApplyClientConfigToContext(clientCtx, clientConfig); err != nil {
 		f := GetFullPathToClientConf(cmd)
-		if IsPacked(cmd) {
+		if GetPackedConfigs(cmd).Client {
 			f = GetFullPathToPackedConf(cmd)
 		}
 		return fmt.Errorf("could not apply client config %s to client context - it may need to be updated manually: %w", f, err)
@@ -106,7 +106,7 @@ func (s *ConfigManagerTestSuite) TestManagerWriteAppConfigWithIndexEventsThenRea
 	// This test is just making sure that writing/reading index events works in our stuff.
 	dCmd := s.makeDummyCmd()
 
-	appConfig := serverconfig.DefaultConfig()
+	appConfig := DefaultAppConfig()
 	appConfig.IndexEvents = []string{"key1", "key2"}
 	SaveConfigs(dCmd, appConfig, nil, nil, false)
 
@@ -118,13 +118,50 @@ func (s *ConfigManagerTestSuite) TestManagerWriteAppConfigWithIndexEventsThenRea
 	s.Require().Equal(appConfig.IndexEvents, appConfig2.IndexEvents, "index events before/after")
 }
 
+func (s *ConfigManagerTestSuite) TestMarkerQueryTimeoutWriteRead() {
+	s.Run("default", func() {
+		s.Assert().Equal(time.Duration(0), DefaultAppConfig().Marker.QueryTimeout, "default Marker.QueryTimeout")
+	})
+
+	s.Run("unpacked", func() {
+		dCmd := s.makeDummyCmd()
+		appConfig := DefaultAppConfig()
+		appConfig.Marker.QueryTimeout = 3 * time.Second
+		SaveConfigs(dCmd, appConfig, nil, nil, false)
+
+		dCmd2 := s.makeDummyCmd()
+		s.Require().NoError(LoadConfigFromFiles(dCmd2), "LoadConfigFromFiles")
+		appConfig2, err := ExtractAppConfig(dCmd2)
+		s.Require().NoError(err, "ExtractAppConfig")
+		s.Assert().Equal(3*time.Second, appConfig2.Marker.QueryTimeout, "Marker.QueryTimeout")
+	})
+
+	s.Run("packed", func() {
+		dCmd := s.makeDummyCmd()
+		appConfig := DefaultAppConfig()
+		appConfig.Marker.QueryTimeout = 250 * time.Millisecond
+		generateAndWritePackedConfig(dCmd, AllConfigs(), appConfig, DefaultCmtConfig(), DefaultClientConfig(), false)
+		s.Require().NoError(loadPackedConfig(dCmd), "loadPackedConfig")
+
+		appConfig2, err := ExtractAppConfig(dCmd)
+		s.Require().NoError(err, "ExtractAppConfig")
+		s.Assert().Equal(250*time.Millisecond, appConfig2.Marker.QueryTimeout, "Marker.QueryTimeout")
+	})
+
+	s.Run("negative is invalid", func() {
+		appConfig := DefaultAppConfig()
+		appConfig.Marker.QueryTimeout = -1 * time.Second
+		s.Assert().EqualError(appConfig.ValidateBasic(), "marker.query-timeout cannot be negative", "ValidateBasic")
+	})
+}
+
 func (s *ConfigManagerTestSuite) TestPackedConfigCosmosLoadDefaults() {
 	dCmd := s.makeDummyCmd()
 
 	appConfig := DefaultAppConfig()
 	cmtConfig := DefaultCmtConfig()
 	clientConfig := DefaultClientConfig()
-	generateAndWritePackedConfig(dCmd, appConfig, cmtConfig, clientConfig, false)
+	generateAndWritePackedConfig(dCmd, AllConfigs(), appConfig, cmtConfig, clientConfig, false)
 	s.Require().NoError(loadPackedConfig(dCmd))
 
 	ctx := client.GetClientContextFromCmd(dCmd)
@@ -132,19 +169,19 @@ func (s *ConfigManagerTestSuite) TestPackedConfigCosmosLoadDefaults() {
 	s.Require().NotPanics(func() {
 		appConfig2, err := serverconfig.GetConfig(vpr)
 		s.Require().NoError(err, "GetConfig")
-		s.Assert().Equal(*appConfig, appConfig2)
+		s.Assert().Equal(appConfig.Config, appConfig2)
 	})
 }
 
 func (s *ConfigManagerTestSuite) TestPackedConfigCosmosLoadGlobalLabels() {
 	dCmd := s.makeDummyCmd()
 
-	appConfig := serverconfig.DefaultConfig()
+	appConfig := DefaultAppConfig()
 	appConfig.Telemetry.GlobalLabels = append(appConfig.Telemetry.GlobalLabels, []string{"key1", "value1"})
 	appConfig.Telemetry.GlobalLabels = append(appConfig.Telemetry.GlobalLabels, []string{"key2", "value2"})
 	cmtConfig := DefaultCmtConfig()
 	clientConfig := DefaultClientConfig()
-	generateAndWritePackedConfig(dCmd, appConfig, cmtConfig, clientConfig, false)
+	generateAndWritePackedConfig(dCmd, AllConfigs(), appConfig, cmtConfig, clientConfig, false)
 	s.Require().NoError(loadPackedConfig(dCmd))
 
 	ctx := client.GetClientContextFromCmd(dCmd)
@@ -389,7 +426,7 @@ func (s *ConfigManagerTestSuite) TestPackedConfigCmtLoadDefaults() {
 	cmtConfig := DefaultCmtConfig()
 	cmtConfig.SetRoot(s.Home)
 	clientConfig := DefaultClientConfig()
-	generateAndWritePackedConfig(dCmd, appConfig, cmtConfig, clientConfig, false)
+	generateAndWritePackedConfig(dCmd, AllConfigs(), appConfig, cmtConfig, clientConfig, false)
 	s.logFile(GetFullPathToPackedConf(dCmd))
 	s.Require().NoError(loadPackedConfig(dCmd), "loadPackedConfig")
 
@@ -414,6 +451,84 @@ func (s *ConfigManagerTestSuite) TestPackedConfigCmtLoadDefaults() {
 	})
 }
 
+func (s *ConfigManagerTestSuite) TestPartiallyPackedConfig() {
+	dCmd := s.makeDummyCmd()
+	appConfig := DefaultAppConfig()
+	appConfig.MinGasPrices = "5nhash"
+	cmtConfig := DefaultCmtConfig()
+	cmtConfig.Moniker = "partial-moniker"
+	clientConfig := DefaultClientConfig()
+	clientConfig.ChainID = "partial-chain"
+	SaveConfigs(dCmd, appConfig, cmtConfig, clientConfig, false)
+	s.Require().NoError(LoadConfigFromFiles(dCmd), "LoadConfigFromFiles before packing")
+
+	appFile := GetFullPathToAppConf(dCmd)
+	cmtFile := GetFullPathToCmtConf(dCmd)
+	clientFile := GetFullPathToClientConf(dCmd)
+	packedFile := GetFullPathToPackedConf(dCmd)
+
+	s.Run("pack app", func() {
+		s.Require().NoError(PackSomeConfigs(dCmd, ConfigSet{App: true}, false), "PackSomeConfigs")
+		s.logFile(packedFile)
+		s.Assert().True(FileExists(packedFile), "file exists: packed")
+		s.Assert().False(FileExists(appFile), "file exists: app")
+		s.Assert().True(FileExists(cmtFile), "file exists: cometbft")
+		s.Assert().True(FileExists(clientFile), "file exists: client")
+		s.Assert().Equal(ConfigSet{App: true}, GetPackedConfigs(dCmd), "GetPackedConfigs")
+
+		packedConf, packed, err := readPackedConfigFile(packedFile)
+		s.Require().NoError(err, "readPackedConfigFile")
+		s.Assert().Equal(ConfigSet{App: true}, packed, "packed configs in file")
+		s.Assert().Equal(map[string]string{"minimum-gas-prices": "5nhash"}, packedConf, "packed config entries")
+	})
+
+	s.Run("load partially packed", func() {
+		dCmd2 := s.makeDummyCmd()
+		s.Require().NoError(LoadConfigFromFiles(dCmd2), "LoadConfigFromFiles")
+		appConfig2, err := ExtractAppConfig(dCmd2)
+		s.Require().NoError(err, "ExtractAppConfig")
+		s.Assert().Equal("5nhash", appConfig2.MinGasPrices, "MinGasPrices")
+		cmtConfig2, err := ExtractCmtConfig(dCmd2)
+		s.Require().NoError(err, "ExtractCmtConfig")
+		s.Assert().Equal("partial-moniker", cmtConfig2.Moniker, "Moniker")
+		clientConfig2, err := ExtractClientConfig(dCmd2)
+		s.Require().NoError(err, "ExtractClientConfig")
+		s.Assert().Equal("partial-chain", clientConfig2.ChainID, "ChainID")
+	})
+
+	s.Run("pack client too", func() {
+		s.Require().NoError(PackSomeConfigs(dCmd, ConfigSet{Client: true}, false), "PackSomeConfigs")
+		s.logFile(packedFile)
+		s.Assert().False(FileExists(appFile), "file exists: app")
+		s.Assert().True(FileExists(cmtFile), "file exists: cometbft")
+		s.Assert().False(FileExists(clientFile), "file exists: client")
+		s.Assert().Equal(ConfigSet{App: true, Client: true}, GetPackedConfigs(dCmd), "GetPackedConfigs")
+	})
+
+	s.Run("unpack app", func() {
+		s.Require().NoError(UnpackSomeConfigs(dCmd, ConfigSet{App: true}), "UnpackSomeConfigs")
+		s.Assert().True(FileExists(packedFile), "file exists: packed")
+		s.Assert().True(FileExists(appFile), "file exists: app")
+		s.Assert().False(FileExists(clientFile), "file exists: client")
+		s.Assert().Equal(ConfigSet{Client: true}, GetPackedConfigs(dCmd), "GetPackedConfigs")
+	})
+
+	s.Run("unpack client", func() {
+		s.Require().NoError(UnpackSomeConfigs(dCmd, ConfigSet{Client: true}), "UnpackSomeConfigs")
+		s.Assert().False(FileExists(packedFile), "file exists: packed")
+		s.Assert().True(FileExists(appFile), "file exists: app")
+		s.Assert().True(FileExists(cmtFile), "file exists: cometbft")
+		s.Assert().True(FileExists(clientFile), "file exists: client")
+		s.Assert().Equal(ConfigSet{}, GetPackedConfigs(dCmd), "GetPackedConfigs")
+
+		dCmd2 := s.makeDummyCmd()
+		s.Require().NoError(LoadConfigFromFiles(dCmd2), "LoadConfigFromFiles")
+		clientConfig2, err := ExtractClientConfig(dCmd2)
+		s.Require().NoError(err, "ExtractClientConfig")
+		s.Assert().Equal("partial-chain", clientConfig2.ChainID, "ChainID")
+	})
+}
+
 func (s *ConfigManagerTestSuite) TestEntryUniqueness() {
 	// This test is basically a canary.
 	// In the config commands, we've taken advantage of the fact that no two config files have a field with the same name.
@@ -444,3 +559,34 @@ func (s *ConfigManagerTestSuite) TestEntryUniqueness() {
 		s.Assert().Len(configs, 1, "configs with field name = %q", field)
 	}
 }
+
+func (s *ConfigManagerTestSuite) TestMergeInTOMLOrJSONConfig() {
+	dir := s.T().TempDir()
+	tests := []struct {
+		name     string
+		contents string
+		expErr   string
+		expChain string
+	}{
+		{name: "toml", contents: "chain-id = \"tomlchain\"\n", expChain: "tomlchain"},
+		{name: "json", contents: `{"chain-id": "jsonchain"}`, expChain: "jsonchain"},
+		{name: "json with leading whitespace", contents: "\n  {\"chain-id\": \"spacechain\"}\n", expChain: "spacechain"},
+		{name: "invalid json", contents: `{"chain-id": `, expErr: "While parsing config"},
+		{name: "invalid toml", contents: `chain-id = `, expErr: "While parsing config"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			confFile := filepath.Join(dir, tc.name+".toml")
+			s.Require().NoError(os.WriteFile(confFile, []byte(tc.contents), 0o644), "writing config file")
+			vpr := viper.New()
+			err := mergeInTOMLOrJSONConfig(vpr, confFile)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "mergeInTOMLOrJSONConfig error")
+				return
+			}
+			s.Require().NoError(err, "mergeInTOMLOrJSONConfig error")
+			s.Assert().Equal(tc.expChain, vpr.GetString("chain-id"), "chain-id")
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// OtherConfig is a config loaded from somewhere other than the home directory of the command being run.
+type OtherConfig struct {
+	// Source is the path that the config was loaded from.
+	Source string
+	// IsPacked is true if the config was loaded (at least partially) from a packed config file.
+	IsPacked bool
+	// App has the app config field values. It is nil if the source doesn't have an app config.
+	App FieldValueMap
+	// Cmt has the cometbft config field values. It is nil if the source doesn't have a cometbft config.
+	Cmt FieldValueMap
+	// Client has the client config field values. It is nil if the source doesn't have a client config.
+	Client FieldValueMap
+	// Unknown has the entries in the source that don't correspond to any known field.
+	Unknown UnknownConfigEntries
+}
+
+// UnknownConfigEntries are entries found in config files that don't correspond to any known field,
+// e.g. entries from a config used with a different version.
+type UnknownConfigEntries struct {
+	// App has the unknown entries from an app config file.
+	App FieldValueMap
+	// Cmt has the unknown entries from a cometbft config file.
+	Cmt FieldValueMap
+	// Client has the unknown entries from a client config file.
+	Client FieldValueMap
+	// Packed has the unknown entries from a packed config file. These cannot be attributed to a specific config.
+	Packed FieldValueMap
+}
+
+// LoadOtherConfig loads the config at the provided path without using or altering the contexts of any command.
+// The path can be a home directory, a packed config file, or one of the unpacked config files.
+// A file with a .json extension is treated as a packed config file. The type of an unpacked config file is
+// identified by the end of its name, e.g. both "app.toml" and "mainnet-app.toml" are treated as an app config file.
+// When the path is an unpacked config file, only that config is loaded (the others are left nil).
+// When the path is a home directory with a partially packed config, the configs that aren't packed
+// are loaded from their individual files.
+// Environment variables are not applied to the loaded values.
+func LoadOtherConfig(path string) (*OtherConfig, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not load config from %q: %w", path, err)
+	}
+
+	rv := &OtherConfig{Source: path}
+	var home, appConfFile, cmtConfFile, clientConfFile, packedConfFile string
+	var packed ConfigSet
+	if info.IsDir() {
+		home = path
+		dCmd := newDetachedCmd(home)
+		packed = GetPackedConfigs(dCmd)
+		if IsPacked(dCmd) {
+			packedConfFile = GetFullPathToPackedConf(dCmd)
+		}
+		if !packed.App {
+			appConfFile = GetFullPathToAppConf(dCmd)
+		}
+		if !packed.Cmt {
+			cmtConfFile = GetFullPathToCmtConf(dCmd)
+		}
+		if !packed.Client {
+			clientConfFile = GetFullPathToClientConf(dCmd)
+		}
+		if len(packedConfFile) == 0 && !FileExists(appConfFile) && !FileExists(cmtConfFile) && !FileExists(clientConfFile) {
+			return nil, fmt.Errorf("no config files found in %q", GetFullPathToConfigDir(dCmd))
+		}
+	} else {
+		// The file is usually in the config dir of a home dir, but it doesn't really matter since the
+		// home dir is only used for the cometbft config root (which isn't one of the field values).
+		home = filepath.Dir(filepath.Dir(path))
+		base := filepath.Base(path)
+		switch {
+		case strings.HasSuffix(base, ".json"):
+			packedConfFile = path
+			packed = getPackedConfigsInFile(path)
+		case strings.HasSuffix(base, AppConfFilename):
+			appConfFile = path
+		case strings.HasSuffix(base, CmtConfFilename):
+			cmtConfFile = path
+		case strings.HasSuffix(base, ClientConfFilename):
+			clientConfFile = path
+		default:
+			return nil, fmt.Errorf("unknown config file type %q: expected a name ending with %q, %q, %q, or %q",
+				path, ".json", AppConfFilename, CmtConfFilename, ClientConfFilename)
+		}
+	}
+
+	dCmd := newDetachedCmd(home)
+	vpr := server.GetServerContextFromCmd(dCmd).Viper
+	if len(packedConfFile) > 0 {
+		rv.IsPacked = true
+		rv.Unknown.Packed, err = readPackedConfig(vpr, packedConfFile, appConfFile, cmtConfFile, clientConfFile)
+	} else {
+		err = readUnpackedConfig(vpr, appConfFile, cmtConfFile, clientConfFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not load config from %q: %w", path, err)
+	}
+
+	if packed.App || len(appConfFile) > 0 {
+		if _, rv.App, err = ExtractAppConfigAndMap(dCmd); err != nil {
+			return nil, err
+		}
+	}
+	if packed.Cmt || len(cmtConfFile) > 0 {
+		if _, rv.Cmt, err = ExtractCmtConfigAndMap(dCmd); err != nil {
+			return nil, err
+		}
+	}
+	if packed.Client || len(clientConfFile) > 0 {
+		if _, rv.Client, err = ExtractClientConfigAndMap(dCmd); err != nil {
+			return nil, err
+		}
+	}
+
+	// The individual files of any packed configs were left as "", so only the unpacked ones are checked.
+	if err = rv.Unknown.findUnpacked(appConfFile, cmtConfFile, clientConfFile); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// ExtractUnknownConfigEntries reads the config files in the home directory of the provided command
+// and identifies the entries in them that don't correspond to any known field.
+func ExtractUnknownConfigEntries(cmd *cobra.Command) (*UnknownConfigEntries, error) {
+	rv := &UnknownConfigEntries{}
+	packed := GetPackedConfigs(cmd)
+	if IsPacked(cmd) {
+		var err error
+		rv.Packed, err = readPackedConfig(viper.New(), GetFullPathToPackedConf(cmd), "", "", "")
+		if err != nil {
+			return nil, err
+		}
+	}
+	// The individual files of any packed configs are left as "", so only the unpacked ones are checked.
+	var appConfFile, cmtConfFile, clientConfFile string
+	if !packed.App {
+		appConfFile = GetFullPathToAppConf(cmd)
+	}
+	if !packed.Cmt {
+		cmtConfFile = GetFullPathToCmtConf(cmd)
+	}
+	if !packed.Client {
+		clientConfFile = GetFullPathToClientConf(cmd)
+	}
+	if err := rv.findUnpacked(appConfFile, cmtConfFile, clientConfFile); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// findUnpacked reads each of the provided unpacked config files (if they exist), identifying the
+// entries in each that don't correspond to a field of that type of config.
+func (u *UnknownConfigEntries) findUnpacked(appConfFile, cmtConfFile, clientConfFile string) error {
+	var err error
+	if u.App, err = findUnknownEntries(appConfFile, MakeFieldValueMap(DefaultAppConfig(), false)); err != nil {
+		return fmt.Errorf("app config file read error: %w", err)
+	}
+	if u.Cmt, err = findUnknownEntries(cmtConfFile, MakeFieldValueMap(DefaultCmtConfig(), false)); err != nil {
+		return fmt.Errorf("cometbft config file read error: %w", err)
+	}
+	if u.Client, err = findUnknownEntries(clientConfFile, MakeFieldValueMap(DefaultClientConfig(), false)); err != nil {
+		return fmt.Errorf("client config file read error: %w", err)
+	}
+	return nil
+}
+
+// findUnknownEntries reads the provided config file and returns the entries in it that aren't in the known map.
+// Entries that are inside a known entry (e.g. in a map field) are not considered unknown.
+// If the file is "" or doesn't exist, nil is returned.
+func findUnknownEntries(confFile string, known FieldValueMap) (FieldValueMap, error) {
+	if len(confFile) == 0 || !FileExists(confFile) {
+		return nil, nil
+	}
+	vpr := viper.New()
+	if err := mergeInTOMLOrJSONConfig(vpr, confFile); err != nil {
+		return nil, err
+	}
+
+	var rv FieldValueMap
+	for _, key := range vpr.AllKeys() {
+		if known.Has(key) || isInKnownEntry(key, known) {
+			continue
+		}
+		if rv == nil {
+			rv = FieldValueMap{}
+		}
+		rv[key] = reflect.ValueOf(vpr.Get(key))
+	}
+	return rv, nil
+}
+
+// isInKnownEntry returns true if the provided key is a sub-key of one of the keys in the known map.
+func isInKnownEntry(key string, known FieldValueMap) bool {
+	for k := range known {
+		if strings.HasPrefix(key, k+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// newDetachedCmd creates a command with its own client and server contexts (and viper) for the provided home directory.
+// It allows using the config loading and extraction funcs without affecting the command actually being run.
+func newDetachedCmd(home string) *cobra.Command {
+	clientCtx := client.Context{}.WithHomeDir(home)
+	clientCtx.Viper = viper.New()
+	serverCtx := server.NewContext(clientCtx.Viper, DefaultCmtConfig(), log.NewNopLogger())
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+	rv := &cobra.Command{}
+	rv.SetOut(io.Discard)
+	rv.SetErr(io.Discard)
+	rv.SetContext(ctx)
+	return rv
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindUnknownEntries(t *testing.T) {
+	known := FieldValueMap{
+		"name":       reflect.ValueOf("a"),
+		"api.enable": reflect.ValueOf(true),
+		"labels":     reflect.ValueOf(map[string]string{}),
+	}
+
+	confFile := filepath.Join(t.TempDir(), "test.toml")
+	contents := `name = "b"
+old-name = "c"
+
+[api]
+enable = false
+timeout = 5
+
+[labels]
+foo = "bar"
+`
+	require.NoError(t, os.WriteFile(confFile, []byte(contents), 0o644), "WriteFile(%q)", confFile)
+
+	unknown, err := findUnknownEntries(confFile, known)
+	require.NoError(t, err, "findUnknownEntries")
+	assert.Equal(t, []string{"old-name", "api.timeout"}, unknown.GetSortedKeys(), "unknown keys")
+	assert.Equal(t, `"c"`, unknown.GetStringOf("old-name"), "old-name value")
+	assert.Equal(t, "5", unknown.GetStringOf("api.timeout"), "api.timeout value")
+
+	unknown, err = findUnknownEntries(filepath.Join(t.TempDir(), "missing.toml"), known)
+	require.NoError(t, err, "findUnknownEntries missing file")
+	assert.Nil(t, unknown, "findUnknownEntries missing file")
+}
+
+func TestLoadOtherConfigUnpackedHome(t *testing.T) {
+	home := t.TempDir()
+	configDir := filepath.Join(home, ConfigSubDir)
+	require.NoError(t, os.MkdirAll(configDir, 0o755), "MkdirAll(%q)", configDir)
+	clientFile := filepath.Join(configDir, ClientConfFilename)
+	require.NoError(t, os.WriteFile(clientFile, []byte("output = \"json\"\nextra = true\n"), 0o644), "WriteFile(%q)", clientFile)
+
+	other, err := LoadOtherConfig(home)
+	require.NoError(t, err, "LoadOtherConfig")
+	assert.Equal(t, home, other.Source, "Source")
+	assert.False(t, other.IsPacked, "IsPacked")
+	assert.Equal(t, `"json"`, other.Client.GetStringOf("output"), "client output value")
+	// The other config files don't exist, so they should have just the defaults.
+	assert.Equal(t, "false", other.App.GetStringOf("api.enable"), "app api.enable value")
+	assert.Equal(t, `"plain"`, other.Cmt.GetStringOf("log_format"), "cometbft log_format value")
+	assert.Equal(t, []string{"extra"}, other.Unknown.Client.GetSortedKeys(), "unknown client keys")
+	assert.Nil(t, other.Unknown.App, "unknown app entries")
+	assert.Nil(t, other.Unknown.Packed, "unknown packed entries")
+}
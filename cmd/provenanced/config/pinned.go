@@ -0,0 +1,94 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+)
+
+// LoadPinnedKeys reads the pinned keys of the home directory of the provided command.
+// Pinned keys are config keys that should not be modified without an explicit override.
+// If there is no pinned keys file, an empty list is returned. The returned keys are sorted.
+func LoadPinnedKeys(cmd *cobra.Command) ([]string, error) {
+	return readPinnedKeysFile(GetFullPathToPinnedKeys(cmd))
+}
+
+// SavePinnedKeys writes the provided keys as the pinned keys of the home directory of the provided command.
+// If no keys are provided, the pinned keys file is deleted.
+func SavePinnedKeys(cmd *cobra.Command, keys []string) error {
+	if err := EnsureConfigDir(cmd); err != nil {
+		return err
+	}
+	return writePinnedKeysFile(GetFullPathToPinnedKeys(cmd), keys)
+}
+
+// FindPinnedKeys returns the sorted entries in keys that are also in pinned.
+func FindPinnedKeys(pinned, keys []string) []string {
+	isPinned := make(map[string]bool, len(pinned))
+	for _, key := range pinned {
+		isPinned[key] = true
+	}
+	var rv []string
+	for _, key := range keys {
+		if isPinned[key] {
+			rv = append(rv, key)
+			isPinned[key] = false
+		}
+	}
+	sort.Strings(rv)
+	return rv
+}
+
+// readPinnedKeysFile reads the provided pinned keys file, returning the sorted keys in it.
+func readPinnedKeysFile(path string) ([]string, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []string{}, nil
+		}
+		return nil, fmt.Errorf("could not read pinned keys file %q: %w", path, err)
+	}
+	var keys []string
+	if err = json.Unmarshal(bz, &keys); err != nil {
+		return nil, fmt.Errorf("invalid pinned keys file %q: %w", path, err)
+	}
+	if keys == nil {
+		keys = []string{}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// writePinnedKeysFile writes the provided keys (sorted and without duplicates) to the provided pinned keys file.
+// If there aren't any keys, the file is deleted instead.
+func writePinnedKeysFile(path string, keys []string) error {
+	if len(keys) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("could not remove pinned keys file %q: %w", path, err)
+		}
+		return nil
+	}
+
+	toWrite := make([]string, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if !seen[key] {
+			seen[key] = true
+			toWrite = append(toWrite, key)
+		}
+	}
+	sort.Strings(toWrite)
+
+	bz, err := json.MarshalIndent(toWrite, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal pinned keys: %w", err)
+	}
+	if err = os.WriteFile(path, append(bz, '\n'), 0o644); err != nil {
+		return fmt.Errorf("could not write pinned keys file %q: %w", path, err)
+	}
+	return nil
+}
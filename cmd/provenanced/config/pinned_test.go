@@ -0,0 +1,71 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPinnedKeysFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), PinnedKeysFilename)
+
+	t.Run("no file", func(t *testing.T) {
+		keys, err := readPinnedKeysFile(path)
+		require.NoError(t, err, "readPinnedKeysFile")
+		assert.Equal(t, []string{}, keys, "pinned keys")
+	})
+
+	t.Run("write then read", func(t *testing.T) {
+		err := writePinnedKeysFile(path, []string{"moniker", "chain-id", "moniker", "api.enable"})
+		require.NoError(t, err, "writePinnedKeysFile")
+		bz, err := os.ReadFile(path)
+		require.NoError(t, err, "ReadFile")
+		assert.Equal(t, "[\n  \"api.enable\",\n  \"chain-id\",\n  \"moniker\"\n]\n", string(bz), "file contents")
+
+		keys, err := readPinnedKeysFile(path)
+		require.NoError(t, err, "readPinnedKeysFile")
+		assert.Equal(t, []string{"api.enable", "chain-id", "moniker"}, keys, "pinned keys")
+	})
+
+	t.Run("write nothing deletes file", func(t *testing.T) {
+		require.NoError(t, writePinnedKeysFile(path, nil), "writePinnedKeysFile")
+		_, err := os.Stat(path)
+		assert.ErrorIs(t, err, os.ErrNotExist, "Stat error after writing no keys")
+		require.NoError(t, writePinnedKeysFile(path, nil), "writePinnedKeysFile without a file")
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte(`{"moniker":true}`), 0o644), "WriteFile")
+		_, err := readPinnedKeysFile(path)
+		assert.ErrorContains(t, err, "invalid pinned keys file", "readPinnedKeysFile error")
+	})
+}
+
+func TestFindPinnedKeys(t *testing.T) {
+	tests := []struct {
+		name   string
+		pinned []string
+		keys   []string
+		exp    []string
+	}{
+		{name: "nothing pinned", pinned: nil, keys: []string{"moniker"}, exp: nil},
+		{name: "no keys", pinned: []string{"moniker"}, keys: nil, exp: nil},
+		{name: "none pinned", pinned: []string{"moniker"}, keys: []string{"chain-id", "output"}, exp: nil},
+		{
+			name:   "some pinned",
+			pinned: []string{"chain-id", "minimum-gas-prices", "moniker"},
+			keys:   []string{"output", "moniker", "chain-id", "moniker"},
+			exp:    []string{"chain-id", "moniker"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := FindPinnedKeys(tc.pinned, tc.keys)
+			assert.Equal(t, tc.exp, actual, "FindPinnedKeys")
+		})
+	}
+}
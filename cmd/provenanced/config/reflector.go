@@ -3,6 +3,7 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"path"
 	"reflect"
 	"sort"
 	"strconv"
@@ -130,6 +131,17 @@ func (m FieldValueMap) Has(key string) bool {
 	return ok
 }
 
+// Len gets the number of entries in this FieldValueMap.
+func (m FieldValueMap) Len() int {
+	return len(m)
+}
+
+// Get gets the value with the given key and whether it exists.
+func (m FieldValueMap) Get(key string) (reflect.Value, bool) {
+	v, ok := m[key]
+	return v, ok
+}
+
 // GetSortedKeys gets the keys of this FieldValueMap and sorts them using sortKeys.
 func (m FieldValueMap) GetSortedKeys() []string {
 	rv := make([]string, 0, len(m))
@@ -232,6 +244,30 @@ func (m FieldValueMap) FindEntries(key string) (FieldValueMap, bool, bool) {
 	return rv, len(rv) > 0, false
 }
 
+// IsKeyPattern returns true if the provided key has any glob wildcard characters ('*' or '?').
+func IsKeyPattern(key string) bool {
+	return strings.ContainsAny(key, "*?")
+}
+
+// FindMatches gets all entries with keys that match the provided glob pattern.
+// Matching uses path.Match semantics with the periods treated as separators.
+// So a '*' or '?' will not match a period, e.g. "rpc.*" matches "rpc.laddr" but not "rpc.foo.bar" or "grpc.address".
+// An error is returned if the pattern is malformed.
+func (m FieldValueMap) FindMatches(pattern string) (FieldValueMap, error) {
+	rv := FieldValueMap{}
+	pathPattern := strings.ReplaceAll(pattern, ".", "/")
+	if _, err := path.Match(pathPattern, ""); err != nil {
+		return rv, err
+	}
+	for k, v := range m {
+		// The pattern was already validated, so there won't be an error here.
+		if isMatch, _ := path.Match(pathPattern, strings.ReplaceAll(k, ".", "/")); isMatch {
+			rv[k] = v
+		}
+	}
+	return rv, nil
+}
+
 // GetStringOf gets a string representation of the value with the given key.
 // If the key doesn't exist in this FieldValueMap, an empty string is returned.
 func (m FieldValueMap) GetStringOf(key string) string {
@@ -285,6 +321,32 @@ func (m FieldValueMap) SetFromString(key, valueStr string) error {
 	return fmt.Errorf("no field found for key: %s", key)
 }
 
+// SetFromValue sets a value to the provided value, which must have the same type.
+// Assuming the value came from MakeFieldValueMap, this will actually be updating the
+// value in the config object provided to that function.
+func (m FieldValueMap) SetFromValue(key string, value reflect.Value) error {
+	v, ok := m[key]
+	if !ok {
+		return fmt.Errorf("no field found for key: %s", key)
+	}
+	if !v.CanSet() {
+		return fmt.Errorf("field %s cannot be set", key)
+	}
+	if !value.IsValid() || value.Type() != v.Type() {
+		return fmt.Errorf("cannot set field %s of type %s to a value of type %s", key, v.Type(), valueTypeString(value))
+	}
+	v.Set(value)
+	return nil
+}
+
+// valueTypeString gets a string of the type of the provided value, even if the value isn't valid.
+func valueTypeString(value reflect.Value) string {
+	if !value.IsValid() {
+		return "<invalid>"
+	}
+	return value.Type().String()
+}
+
 // AsConfigMap converts this into a map[string]interface{} adding sub-sections as single entries
 // (as opposed to full keys containing a . (period)).
 func (m FieldValueMap) AsConfigMap() (map[string]interface{}, error) {
@@ -491,17 +553,17 @@ type UpdatedField struct {
 
 // MakeUpdatedField creates an UpdateField with the given key getting the values from each provided map.
 // If either one of the maps doesn't have the key, the second returned value will be false.
-func MakeUpdatedField(key string, wasMap, isNowMap FieldValueMap) (UpdatedField, bool) {
+func MakeUpdatedField(key string, wasMap, isNowMap FieldValueReader) (UpdatedField, bool) {
 	rv := UpdatedField{
 		Key: key,
 	}
-	wasVal, wasFound := wasMap[key]
+	wasFound := wasMap.Has(key)
 	if wasFound {
-		rv.Was = GetStringFromValue(wasVal)
+		rv.Was = wasMap.GetStringOf(key)
 	}
-	isNowVal, isNowFound := isNowMap[key]
+	isNowFound := isNowMap.Has(key)
 	if isNowFound {
-		rv.IsNow = GetStringFromValue(isNowVal)
+		rv.IsNow = isNowMap.GetStringOf(key)
 	}
 	return rv, isNowFound && wasFound
 }
@@ -529,6 +591,11 @@ func (u UpdatedField) StringAsDefault() string {
 	return fmt.Sprintf("%s=%s (default=%s)", u.Key, u.IsNow, u.Was)
 }
 
+// StringAsOther creates a string from this UpdatedField identifying the Was as the value from another config.
+func (u UpdatedField) StringAsOther() string {
+	return fmt.Sprintf("%s=%s (other=%s)", u.Key, u.IsNow, u.Was)
+}
+
 // HasDiff returns true if IsNow and Was have different values.
 func (u UpdatedField) HasDiff() bool {
 	return u.IsNow != u.Was
@@ -537,11 +604,11 @@ func (u UpdatedField) HasDiff() bool {
 // UpdatedFieldMap maps field names to UpdatedField references.
 type UpdatedFieldMap map[string]*UpdatedField
 
-// MakeUpdatedFieldMap creates an UpdatedFieldMap with fields that exist in both provided FieldValueMap objects.
+// MakeUpdatedFieldMap creates an UpdatedFieldMap with fields that exist in both provided field values.
 // Set onlyChanged to true to further limit fields to only those with differences.
-func MakeUpdatedFieldMap(wasMap, isNowMap FieldValueMap, onlyChanged bool) UpdatedFieldMap {
+func MakeUpdatedFieldMap(wasMap, isNowMap FieldValueReader, onlyChanged bool) UpdatedFieldMap {
 	rv := UpdatedFieldMap{}
-	for key := range isNowMap {
+	for _, key := range isNowMap.GetSortedKeys() {
 		uf, ok := MakeUpdatedField(key, wasMap, isNowMap)
 		if ok && (!onlyChanged || uf.HasDiff()) {
 			rv[key] = &uf
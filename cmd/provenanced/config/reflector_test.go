@@ -574,3 +574,161 @@ func (s *ReflectorTestSuit) TestFieldValueMap_FindEntries() {
 		})
 	}
 }
+
+func (s *ReflectorTestSuit) TestFieldValueMap_FindMatches() {
+	thing := DefaultMainThing()
+	thingMap := MakeFieldValueMap(&thing, true)
+
+	tests := []struct {
+		name    string
+		pattern string
+		expKeys []string
+		expErr  string
+	}{
+		{
+			name:    "no wildcards exact key",
+			pattern: "main-int",
+			expKeys: []string{"main-int"},
+		},
+		{
+			name:    "no wildcards section name",
+			pattern: "deepthing",
+			expKeys: []string{},
+		},
+		{
+			name:    "all fields in a section",
+			pattern: "deepthing.*",
+			expKeys: []string{"deepthing.a-string", "deepthing.some-strings"},
+		},
+		{
+			name:    "star does not cross periods",
+			pattern: "st3.*",
+			expKeys: []string{"st3.thing"},
+		},
+		{
+			name:    "star in each segment",
+			pattern: "*.*.*",
+			expKeys: []string{"st3.subsubthing1.anint", "st3.subsubthing1.auint"},
+		},
+		{
+			name:    "top level only",
+			pattern: "*",
+			expKeys: []string{"auint", "exported-field", "main-int"},
+		},
+		{
+			name:    "field in any section",
+			pattern: "*.anint",
+			expKeys: []string{"main-sub-thing.anint", "squashed-sub-thing-1.anint"},
+		},
+		{
+			name:    "question mark",
+			pattern: "*.a?int",
+			expKeys: []string{
+				"main-sub-thing.anint",
+				"main-sub-thing.auint",
+				"squashed-sub-thing-1.anint",
+				"squashed-sub-thing-1.auint",
+			},
+		},
+		{
+			name:    "partial segment",
+			pattern: "*thing*.a-*",
+			expKeys: []string{"deepthing.a-string", "psthing2.a-string"},
+		},
+		{
+			name:    "character class",
+			pattern: "*.[st]*",
+			expKeys: []string{"deepthing.some-strings", "psthing2.some-strings", "st3.thing"},
+		},
+		{
+			name:    "nothing matches",
+			pattern: "nope.*",
+			expKeys: []string{},
+		},
+		{
+			name:    "bad pattern",
+			pattern: "st3.[*",
+			expKeys: []string{},
+			expErr:  "syntax error in pattern",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var fvm FieldValueMap
+			var err error
+			testFunc := func() {
+				fvm, err = thingMap.FindMatches(tc.pattern)
+			}
+			s.Require().NotPanics(testFunc, "FindMatches(%q)", tc.pattern)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "FindMatches(%q) error", tc.pattern)
+			} else {
+				s.Assert().NoError(err, "FindMatches(%q) error", tc.pattern)
+			}
+			actKeys := fvm.GetSortedKeys()
+			s.Assert().Equal(tc.expKeys, actKeys, "FindMatches(%q) result keys", tc.pattern)
+		})
+	}
+}
+
+func (s *ReflectorTestSuit) TestIsKeyPattern() {
+	tests := []struct {
+		key string
+		exp bool
+	}{
+		{key: "", exp: false},
+		{key: "all", exp: false},
+		{key: "rpc.laddr", exp: false},
+		{key: "rpc.[lx]addr", exp: false},
+		{key: "rpc.*", exp: true},
+		{key: "rpc.?addr", exp: true},
+		{key: "*", exp: true},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.key, func() {
+			s.Assert().Equal(tc.exp, IsKeyPattern(tc.key), "IsKeyPattern(%q)", tc.key)
+		})
+	}
+}
+
+func (s *ReflectorTestSuit) TestFieldValueMap_SetFromValue() {
+	thing := DefaultMainThing()
+	thing.PSThing2 = &SubThing2{}
+	thingMap := MakeFieldValueMap(&thing, true)
+
+	s.Run("same type", func() {
+		err := thingMap.SetFromValue("main-int", reflect.ValueOf(42))
+		s.Require().NoError(err, "SetFromValue(main-int, 42)")
+		s.Assert().Equal(42, thing.MainInt, "thing.MainInt")
+	})
+
+	s.Run("slice", func() {
+		err := thingMap.SetFromValue("psthing2.some-strings", reflect.ValueOf([]string{"x", "y"}))
+		s.Require().NoError(err, "SetFromValue(psthing2.some-strings, [x, y])")
+		s.Assert().Equal([]string{"x", "y"}, thing.PSThing2.SomeStrings, "thing.PSThing2.SomeStrings")
+	})
+
+	s.Run("unknown key", func() {
+		err := thingMap.SetFromValue("not-a-key", reflect.ValueOf(42))
+		s.Assert().EqualError(err, "no field found for key: not-a-key", "SetFromValue(not-a-key, 42)")
+	})
+
+	s.Run("wrong type", func() {
+		err := thingMap.SetFromValue("main-int", reflect.ValueOf("42"))
+		s.Assert().EqualError(err, "cannot set field main-int of type int to a value of type string", "SetFromValue(main-int, \"42\")")
+		s.Assert().Equal(42, thing.MainInt, "thing.MainInt")
+	})
+
+	s.Run("invalid value", func() {
+		err := thingMap.SetFromValue("main-int", reflect.Value{})
+		s.Assert().EqualError(err, "cannot set field main-int of type int to a value of type <invalid>", "SetFromValue(main-int, invalid)")
+	})
+
+	s.Run("not settable", func() {
+		noSetMap := MakeFieldValueMap(DefaultMainThing(), false)
+		err := noSetMap.SetFromValue("main-int", reflect.ValueOf(42))
+		s.Assert().EqualError(err, "field main-int cannot be set", "SetFromValue(main-int, 42) on a non-pointer map")
+	})
+}
@@ -0,0 +1,47 @@
+package config
+
+// ReloadBehavior identifies when a change to a config value takes effect.
+type ReloadBehavior string
+
+const (
+	// ReloadRestart indicates that a change to a value only takes effect after the node is restarted.
+	ReloadRestart ReloadBehavior = "restart"
+	// ReloadNextBlock indicates that a change to a value takes effect on the next block without a restart.
+	ReloadNextBlock ReloadBehavior = "next-block"
+	// ReloadHot indicates that a change to a value takes effect immediately, e.g. on the next command run.
+	ReloadHot ReloadBehavior = "hot"
+)
+
+// Note returns a short description of when a change to a value with this behavior takes effect.
+func (b ReloadBehavior) Note() string {
+	switch b {
+	case ReloadNextBlock:
+		return "takes effect on the next block"
+	case ReloadHot:
+		return "takes effect immediately"
+	default:
+		return "requires node restart to take effect"
+	}
+}
+
+// keyReloadBehaviors has the reload behavior of each config key that can change without a node restart.
+// Keys that aren't in here are ReloadRestart.
+//
+// The node only reads the app and cometbft configs when it starts, so none of their keys are in here.
+// The client config is read each time a command is run, so all of its keys are ReloadHot.
+var keyReloadBehaviors = map[string]ReloadBehavior{
+	"chain-id":        ReloadHot,
+	"keyring-backend": ReloadHot,
+	"output":          ReloadHot,
+	"node":            ReloadHot,
+	"broadcast-mode":  ReloadHot,
+}
+
+// GetReloadBehavior returns when a change to the config value with the provided key takes effect.
+// Unknown keys are ReloadRestart.
+func GetReloadBehavior(key string) ReloadBehavior {
+	if b, ok := keyReloadBehaviors[key]; ok {
+		return b
+	}
+	return ReloadRestart
+}
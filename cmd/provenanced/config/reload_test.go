@@ -0,0 +1,49 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetReloadBehavior(t *testing.T) {
+	tests := []struct {
+		key string
+		exp ReloadBehavior
+	}{
+		{key: "chain-id", exp: ReloadHot},
+		{key: "output", exp: ReloadHot},
+		{key: "telemetry.enabled", exp: ReloadRestart},
+		{key: "log_level", exp: ReloadRestart},
+		{key: "marker.query-timeout", exp: ReloadRestart},
+		{key: "consensus.timeout_commit", exp: ReloadRestart},
+		{key: "not.a.key", exp: ReloadRestart},
+		{key: "", exp: ReloadRestart},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.key, func(t *testing.T) {
+			assert.Equal(t, tc.exp, GetReloadBehavior(tc.key), "GetReloadBehavior(%q)", tc.key)
+		})
+	}
+}
+
+func TestKeyReloadBehaviors(t *testing.T) {
+	defaults := GetAllConfigDefaults()
+	for key, b := range keyReloadBehaviors {
+		assert.True(t, defaults.Has(key), "%q is in keyReloadBehaviors but is not a config key", key)
+		assert.Contains(t, []ReloadBehavior{ReloadRestart, ReloadNextBlock, ReloadHot}, b, "reload behavior of %q", key)
+	}
+
+	// The client config is read by each command, so all of it should be hot-reloadable.
+	for key := range MakeFieldValueMap(DefaultClientConfig(), false) {
+		assert.Equal(t, ReloadHot, GetReloadBehavior(key), "GetReloadBehavior(%q)", key)
+	}
+}
+
+func TestReloadBehaviorNote(t *testing.T) {
+	assert.Equal(t, "requires node restart to take effect", ReloadRestart.Note(), "ReloadRestart.Note()")
+	assert.Equal(t, "takes effect on the next block", ReloadNextBlock.Note(), "ReloadNextBlock.Note()")
+	assert.Equal(t, "takes effect immediately", ReloadHot.Note(), "ReloadHot.Note()")
+	assert.Equal(t, "requires node restart to take effect", ReloadBehavior("unknown").Note(), "unknown.Note()")
+}
@@ -0,0 +1,171 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+)
+
+// FieldValueReader provides read-only access to config field values.
+// It is implemented by both FieldValueMap and FieldValueSnapshot.
+type FieldValueReader interface {
+	// Has checks if the provided key exists.
+	Has(key string) bool
+	// Len gets the number of entries.
+	Len() int
+	// Get gets the value with the given key and whether it exists.
+	Get(key string) (reflect.Value, bool)
+	// GetStringOf gets a string representation of the value with the given key (see GetStringFromValue).
+	GetStringOf(key string) string
+	// GetSortedKeys gets all the keys sorted using sortKeys.
+	GetSortedKeys() []string
+	// FindEntries looks for entries that match the provided key (see FieldValueMap.FindEntries).
+	FindEntries(key string) (FieldValueMap, bool, bool)
+	// FindMatches gets all entries with keys that match the provided glob pattern (see FieldValueMap.FindMatches).
+	FindMatches(pattern string) (FieldValueMap, error)
+}
+
+var (
+	_ FieldValueReader = (FieldValueMap)(nil)
+	_ FieldValueReader = (*FieldValueSnapshot)(nil)
+)
+
+// FieldValueSnapshot is an immutable copy of a FieldValueMap.
+// The values are copied out of the objects they came from, so a snapshot does not change when the
+// original objects do, and it is safe to read from multiple goroutines at once.
+// Any values (or maps of values) returned from a snapshot are new copies; altering them will not alter the snapshot.
+//
+// A snapshot cannot be used to set values. Use the FieldValueMap it came from for that.
+type FieldValueSnapshot struct {
+	fields FieldValueMap
+	keys   []string
+}
+
+// Snapshot creates an immutable copy of this FieldValueMap.
+func (m FieldValueMap) Snapshot() *FieldValueSnapshot {
+	rv := &FieldValueSnapshot{fields: make(FieldValueMap, len(m))}
+	for k, v := range m {
+		rv.fields[k] = copyValue(v)
+	}
+	rv.keys = rv.fields.GetSortedKeys()
+	return rv
+}
+
+// MakeFieldValueSnapshot creates an immutable snapshot of the field values of the provided obj.
+// See also: MakeFieldValueMap.
+func MakeFieldValueSnapshot(obj interface{}, fillNilsWithZero bool) *FieldValueSnapshot {
+	return MakeFieldValueMap(obj, fillNilsWithZero).Snapshot()
+}
+
+// Has checks if the provided key exists in this FieldValueSnapshot.
+func (s *FieldValueSnapshot) Has(key string) bool {
+	return s.fields.Has(key)
+}
+
+// Len gets the number of entries in this FieldValueSnapshot.
+func (s *FieldValueSnapshot) Len() int {
+	return len(s.fields)
+}
+
+// Get gets a copy of the value with the given key and whether it exists.
+func (s *FieldValueSnapshot) Get(key string) (reflect.Value, bool) {
+	v, ok := s.fields[key]
+	if !ok {
+		return reflect.Value{}, false
+	}
+	return copyValue(v), true
+}
+
+// GetStringOf gets a string representation of the value with the given key.
+// If the key doesn't exist in this FieldValueSnapshot, an empty string is returned.
+func (s *FieldValueSnapshot) GetStringOf(key string) string {
+	return s.fields.GetStringOf(key)
+}
+
+// GetSortedKeys gets the keys of this FieldValueSnapshot sorted using sortKeys.
+func (s *FieldValueSnapshot) GetSortedKeys() []string {
+	rv := make([]string, len(s.keys))
+	copy(rv, s.keys)
+	return rv
+}
+
+// FindEntries looks for entries in this snapshot that match the provided key (see FieldValueMap.FindEntries).
+// The returned map has copies of the values.
+func (s *FieldValueSnapshot) FindEntries(key string) (FieldValueMap, bool, bool) {
+	rv, found, exact := s.fields.FindEntries(key)
+	return copyFieldValues(rv), found, exact
+}
+
+// FindMatches gets all entries with keys that match the provided glob pattern (see FieldValueMap.FindMatches).
+// The returned map has copies of the values.
+func (s *FieldValueSnapshot) FindMatches(pattern string) (FieldValueMap, error) {
+	rv, err := s.fields.FindMatches(pattern)
+	return copyFieldValues(rv), err
+}
+
+// AsFieldValueMap gets a FieldValueMap with copies of all the values in this snapshot.
+func (s *FieldValueSnapshot) AsFieldValueMap() FieldValueMap {
+	return copyFieldValues(s.fields)
+}
+
+// CompleteKeys gets the sorted keys from the provided reader that start with the provided prefix.
+// It is intended for use in shell completion.
+func CompleteKeys(r FieldValueReader, prefix string) []string {
+	keys := r.GetSortedKeys()
+	rv := keys[:0]
+	for _, k := range keys {
+		if strings.HasPrefix(k, prefix) {
+			rv = append(rv, k)
+		}
+	}
+	return rv
+}
+
+// copyFieldValues returns a new FieldValueMap with copies of each value in the provided map.
+func copyFieldValues(m FieldValueMap) FieldValueMap {
+	rv := make(FieldValueMap, len(m))
+	for k, v := range m {
+		rv[k] = copyValue(v)
+	}
+	return rv
+}
+
+// copyValue creates a deep copy of the provided value that does not reference anything in the original.
+// The returned value is not tied to whatever object the provided value came from.
+// Invalid values are returned as-is.
+func copyValue(v reflect.Value) reflect.Value {
+	if !v.IsValid() {
+		return v
+	}
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		rv := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			rv.Index(i).Set(copyValue(v.Index(i)))
+		}
+		return rv
+	case reflect.Map:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		rv := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			rv.SetMapIndex(iter.Key(), copyValue(iter.Value()))
+		}
+		return rv
+	case reflect.Ptr:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		rv := reflect.New(v.Type().Elem())
+		rv.Elem().Set(copyValue(v.Elem()))
+		return rv
+	default:
+		rv := reflect.New(v.Type()).Elem()
+		rv.Set(v)
+		return rv
+	}
+}
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldValueSnapshot(t *testing.T) {
+	thing := DefaultMainThing()
+	thing.PSThing2 = &SubThing2{AString: "a", SomeStrings: []string{"one", "two"}}
+	live := MakeFieldValueMap(&thing, false)
+	snap := live.Snapshot()
+
+	require.Equal(t, live.Len(), snap.Len(), "snapshot Len()")
+	require.Equal(t, live.GetSortedKeys(), snap.GetSortedKeys(), "snapshot GetSortedKeys()")
+	for _, key := range live.GetSortedKeys() {
+		assert.Equal(t, live.GetStringOf(key), snap.GetStringOf(key), "GetStringOf(%q)", key)
+		assert.True(t, snap.Has(key), "Has(%q)", key)
+	}
+	assert.False(t, snap.Has("not-a-key"), "Has(%q)", "not-a-key")
+
+	origStrings := snap.GetStringOf("psthing2.some-strings")
+	origInt := snap.GetStringOf("main-int")
+
+	t.Run("changes to the original do not change the snapshot", func(t *testing.T) {
+		require.NoError(t, live.SetFromString("main-int", "5555"), "SetFromString main-int")
+		thing.PSThing2.SomeStrings[0] = "changed"
+		assert.Equal(t, origInt, snap.GetStringOf("main-int"), "snapshot main-int")
+		assert.Equal(t, origStrings, snap.GetStringOf("psthing2.some-strings"), "snapshot psthing2.some-strings")
+	})
+
+	t.Run("changes to returned values do not change the snapshot", func(t *testing.T) {
+		v, ok := snap.Get("psthing2.some-strings")
+		require.True(t, ok, "Get ok")
+		v.Index(0).SetString("also changed")
+
+		entries, found, _ := snap.FindEntries("psthing2")
+		require.True(t, found, "FindEntries found")
+		entries["psthing2.some-strings"].Index(1).SetString("changed again")
+		entries.SetToNil("main-int")
+
+		all := snap.AsFieldValueMap()
+		all["psthing2.some-strings"].Index(0).SetString("yet another change")
+
+		keys := snap.GetSortedKeys()
+		keys[0] = "not-a-key"
+
+		assert.Equal(t, origStrings, snap.GetStringOf("psthing2.some-strings"), "snapshot psthing2.some-strings")
+		assert.Equal(t, origInt, snap.GetStringOf("main-int"), "snapshot main-int")
+		assert.Equal(t, live.GetSortedKeys(), snap.GetSortedKeys(), "snapshot GetSortedKeys()")
+	})
+
+	t.Run("nil pointer entries are kept", func(t *testing.T) {
+		v, ok := snap.Get("st3.subsubthing1")
+		require.True(t, ok, "Get ok")
+		assert.Equal(t, reflect.Ptr, v.Kind(), "Kind()")
+		assert.True(t, v.IsNil(), "IsNil()")
+	})
+}
+
+func TestCompleteKeys(t *testing.T) {
+	snap := MakeFieldValueSnapshot(DefaultMainThing(), true)
+
+	tests := []struct {
+		prefix string
+		exp    []string
+	}{
+		{prefix: "main-", exp: []string{"main-int", "main-sub-thing.anint", "main-sub-thing.auint"}},
+		{prefix: "psthing2.a", exp: []string{"psthing2.a-string"}},
+		{prefix: "nope", exp: []string{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.prefix, func(t *testing.T) {
+			act := CompleteKeys(snap, tc.prefix)
+			assert.Equal(t, tc.exp, act, "CompleteKeys(%q)", tc.prefix)
+		})
+	}
+}
+
+// TestFieldValueSnapshotConcurrentReads is mostly useful when run with -race.
+// It reads from a snapshot in several goroutines while the live object it came from is being changed.
+func TestFieldValueSnapshotConcurrentReads(t *testing.T) {
+	thing := DefaultMainThing()
+	thing.PSThing2 = &SubThing2{AString: "a", SomeStrings: []string{"one", "two"}}
+	live := MakeFieldValueMap(&thing, false)
+	snap := live.Snapshot()
+	expInt := snap.GetStringOf("main-int")
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			_ = live.SetFromString("main-int", fmt.Sprintf("%d", i))
+			_ = live.SetFromString("psthing2.some-strings", fmt.Sprintf(`["%d"]`, i))
+		}
+	}()
+
+	errs := make(chan error, 8)
+	for r := 0; r < 4; r++ {
+		wg.Add(2)
+		// Imitate the get command.
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				if act := snap.GetStringOf("main-int"); act != expInt {
+					errs <- fmt.Errorf("main-int = %s, expected %s", act, expInt)
+					return
+				}
+				entries, _, _ := snap.FindEntries("psthing2")
+				_ = entries.GetStringOf("psthing2.some-strings")
+				_, _ = snap.FindMatches("*")
+			}
+		}()
+		// Imitate completion.
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				_ = CompleteKeys(snap, "main")
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err, "concurrent read")
+	}
+}
+
+// The snapshot benchmarks show the cost of copying the values compared to just making the live map. Run them using:
+//   go test ./cmd/provenanced/config -run '^$' -bench . -benchmem
+
+func BenchmarkMakeFieldValueMap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = MakeFieldValueMap(DefaultCmtConfig(), true)
+	}
+}
+
+func BenchmarkMakeFieldValueSnapshot(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = MakeFieldValueSnapshot(DefaultCmtConfig(), true)
+	}
+}
+
+func BenchmarkGetAllConfigDefaultsSnapshot(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = GetAllConfigDefaultsSnapshot()
+	}
+}
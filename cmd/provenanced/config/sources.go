@@ -0,0 +1,122 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// ValueSource identifies where the current value of a config field came from.
+type ValueSource string
+
+const (
+	// ValueSourceDefault indicates that a value is the default because nothing else defines it.
+	ValueSourceDefault ValueSource = "default"
+	// ValueSourceFile indicates that a value is defined in one of the config files.
+	ValueSourceFile ValueSource = "file"
+	// ValueSourceEnv indicates that a value is defined by an environment variable.
+	ValueSourceEnv ValueSource = "env"
+)
+
+// FieldSourceMap associates config field keys with the source of their current values.
+type FieldSourceMap map[string]ValueSource
+
+// Get returns the source of the value with the provided key. Keys not in this map are ValueSourceDefault.
+func (m FieldSourceMap) Get(key string) ValueSource {
+	if src, ok := m[key]; ok {
+		return src
+	}
+	return ValueSourceDefault
+}
+
+// GetFieldSources identifies where the current value of each known config field came from
+// for the home directory of the provided command.
+//
+// Environment variables take precedence over the config files, which take precedence over the defaults.
+// A field is considered to come from a file if it is in the file, even if the value there is the default.
+func GetFieldSources(cmd *cobra.Command) (FieldSourceMap, error) {
+	fileKeys, err := getConfigFileKeys(cmd)
+	if err != nil {
+		return nil, err
+	}
+	defaults := GetAllConfigDefaultsSnapshot()
+	rv := make(FieldSourceMap, defaults.Len())
+	for _, key := range defaults.GetSortedKeys() {
+		switch {
+		case isEnvVarSet(key):
+			rv[key] = ValueSourceEnv
+		case fileKeys[key]:
+			rv[key] = ValueSourceFile
+		default:
+			rv[key] = ValueSourceDefault
+		}
+	}
+	return rv, nil
+}
+
+// isEnvVarSet returns true if the environment variable for the provided config key is set.
+func isEnvVarSet(key string) bool {
+	_, isSet := os.LookupEnv(EnvVarName(key))
+	return isSet
+}
+
+// getConfigFileKeys gets the keys that are defined in the config files (packed, unpacked, and unmanaged)
+// of the home directory of the provided command.
+func getConfigFileKeys(cmd *cobra.Command) (map[string]bool, error) {
+	rv := make(map[string]bool)
+	packed := GetPackedConfigs(cmd)
+	if packed.Any() {
+		packedConf, _, err := readPackedConfigFile(GetFullPathToPackedConf(cmd))
+		if err != nil {
+			return nil, err
+		}
+		for key := range packedConf {
+			rv[key] = true
+		}
+	}
+
+	var confFiles []string
+	if !packed.App {
+		confFiles = append(confFiles, GetFullPathToAppConf(cmd))
+	}
+	if !packed.Cmt {
+		confFiles = append(confFiles, GetFullPathToCmtConf(cmd))
+	}
+	if !packed.Client {
+		confFiles = append(confFiles, GetFullPathToClientConf(cmd))
+	}
+	confFiles = append(confFiles, GetFullPathToUnmanagedConf(cmd))
+	for _, confFile := range confFiles {
+		if !FileExists(confFile) {
+			continue
+		}
+		vpr := viper.New()
+		if err := mergeInTOMLOrJSONConfig(vpr, confFile); err != nil {
+			return nil, fmt.Errorf("could not read config file %s: %w", confFile, err)
+		}
+		for _, key := range vpr.AllKeys() {
+			rv[key] = true
+		}
+	}
+	return rv, nil
+}
+
+// GetEnvStringFromValue gets a string of the given value in the form it would have in an environment variable.
+// Strings and durations are not quoted, and the entries of slices and arrays are separated by spaces.
+// For anything else, it just uses fmt %v.
+func GetEnvStringFromValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		entries := make([]string, v.Len())
+		for i := range entries {
+			entries[i] = GetEnvStringFromValue(v.Index(i))
+		}
+		return strings.Join(entries, " ")
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
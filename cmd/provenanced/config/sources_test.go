@@ -0,0 +1,70 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFieldSources(t *testing.T) {
+	home := t.TempDir()
+	dCmd := newDetachedCmd(home)
+
+	t.Run("no config files", func(t *testing.T) {
+		sources, err := GetFieldSources(dCmd)
+		require.NoError(t, err, "GetFieldSources")
+		assert.Equal(t, ValueSourceDefault, sources.Get("api.enable"), "api.enable")
+		assert.Equal(t, ValueSourceDefault, sources.Get("moniker"), "moniker")
+		assert.Equal(t, ValueSourceDefault, sources.Get("not-a-key"), "not-a-key")
+	})
+
+	t.Run("packed app only", func(t *testing.T) {
+		appConfig := DefaultAppConfig()
+		appConfig.API.Enable = true
+		generateAndWritePackedConfig(dCmd, ConfigSet{App: true}, appConfig, nil, nil, false)
+		writeUnpackedConfig(dCmd, nil, DefaultCmtConfig(), nil, false)
+
+		sources, err := GetFieldSources(dCmd)
+		require.NoError(t, err, "GetFieldSources")
+		assert.Equal(t, ValueSourceFile, sources.Get("api.enable"), "api.enable")
+		assert.Equal(t, ValueSourceDefault, sources.Get("api.swagger"), "api.swagger")
+		assert.Equal(t, ValueSourceFile, sources.Get("moniker"), "moniker")
+		assert.Equal(t, ValueSourceDefault, sources.Get("chain-id"), "chain-id")
+	})
+
+	t.Run("env overrides file", func(t *testing.T) {
+		t.Setenv("PIO_API_ENABLE", "false")
+		t.Setenv("PIO_CHAIN_ID", "testchain")
+		sources, err := GetFieldSources(dCmd)
+		require.NoError(t, err, "GetFieldSources")
+		assert.Equal(t, ValueSourceEnv, sources.Get("api.enable"), "api.enable")
+		assert.Equal(t, ValueSourceEnv, sources.Get("chain-id"), "chain-id")
+		assert.Equal(t, ValueSourceFile, sources.Get("moniker"), "moniker")
+	})
+}
+
+func TestGetEnvStringFromValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		exp   string
+	}{
+		{name: "string", value: "some value", exp: "some value"},
+		{name: "empty string", value: "", exp: ""},
+		{name: "bool", value: true, exp: "true"},
+		{name: "int", value: 42, exp: "42"},
+		{name: "duration", value: 1500 * time.Millisecond, exp: "1.5s"},
+		{name: "string slice", value: []string{"a", "b", "c"}, exp: "a b c"},
+		{name: "empty slice", value: []string{}, exp: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act := GetEnvStringFromValue(reflect.ValueOf(tc.value))
+			assert.Equal(t, tc.exp, act, "GetEnvStringFromValue")
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"os"
 	"text/template"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 )
 
 // This is similar to the content in the SDK's client/config/toml.go file.
@@ -32,14 +34,39 @@ node = "{{ .Node }}"
 broadcast-mode = "{{ .BroadcastMode }}"
 `
 
+// provenanceAppConfigTemplate is the part of the app.toml file with our own sections.
+// It gets appended to the SDK's template for the app.toml file.
+const provenanceAppConfigTemplate = `
+###############################################################################
+###                           Marker Configuration                          ###
+###############################################################################
+
+[marker]
+
+# The maximum amount of time that an expensive marker query (e.g. AllMarkers or Holding) is allowed to run.
+# Queries that take longer are aborted with a DeadlineExceeded error. Use 0 to disable this limit.
+query-timeout = "{{ .Marker.QueryTimeout }}"
+
+# Whether to record the checks made by the marker send restriction when it rejects a transfer.
+# The most recent records are kept in memory (they are not saved) and can be looked up by tx hash using the
+# marker RestrictionTrace query. Recording does not affect state or gas.
+restriction-trace = {{ .Marker.RestrictionTrace }}
+`
+
 var configTemplate *template.Template
 
+var appConfigTemplate *template.Template
+
 func init() {
 	var err error
 	tmpl := template.New("clientConfigFileTemplate")
 	if configTemplate, err = tmpl.Parse(defaultConfigTemplate); err != nil {
 		panic(err)
 	}
+	appTmpl := template.New("appConfigFileTemplate")
+	if appConfigTemplate, err = appTmpl.Parse(serverconfig.DefaultConfigTemplate + provenanceAppConfigTemplate); err != nil {
+		panic(err)
+	}
 }
 
 // WriteConfigToFile creates the file contents using a template and the provided config
@@ -56,3 +83,18 @@ func WriteConfigToFile(configFilePath string, config *ClientConfig) {
 		panic(err)
 	}
 }
+
+// WriteAppConfigToFile creates the app.toml file contents using a template and the provided config
+// then writes the contents to the provided configFilePath.
+func WriteAppConfigToFile(configFilePath string, config *AppConfig) {
+	var buffer bytes.Buffer
+
+	if err := appConfigTemplate.Execute(&buffer, config); err != nil {
+		panic(err)
+	}
+
+	//nolint:gosec // The config file should be readable by anyone.
+	if err := os.WriteFile(configFilePath, buffer.Bytes(), 0o644); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,196 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/preflight"
+)
+
+const (
+	// ConfigTypeApp is the config type name of the app config.
+	ConfigTypeApp = "app"
+	// ConfigTypeCmt is the config type name of the cometbft config.
+	ConfigTypeCmt = "cometbft"
+	// ConfigTypeClient is the config type name of the client config.
+	ConfigTypeClient = "client"
+)
+
+// ConfigValidation is the result of validating one of the configs.
+type ConfigValidation struct {
+	// Type is the type of config that was validated, e.g. ConfigTypeApp.
+	Type string
+	// Source is the file the config was loaded from.
+	// It's the packed config file if the config is packed, or "" if the file doesn't exist (so the defaults were used).
+	Source string
+	// Checks are the results of each check done on the config.
+	Checks preflight.Checks
+}
+
+// Passed returns true if all the checks on this config passed.
+func (v ConfigValidation) Passed() bool {
+	return len(v.Checks.Failed()) == 0
+}
+
+// ValidateConfigFiles loads each of the requested configs from the home directory of the provided command and checks them.
+// Only the files are considered (e.g. environment variables are not), and nothing is changed in the command's contexts.
+// A packed config is read in memory; it does not need to be unpacked first.
+// When only some of the configs are packed, the rest are read from their individual files.
+// The results are in the order: app, cometbft, client (skipping any that weren't requested).
+func ValidateConfigFiles(cmd *cobra.Command, app, cmt, client bool) []*ConfigValidation {
+	var rv []*ConfigValidation
+	packed := GetPackedConfigs(cmd)
+	if app {
+		file := GetFullPathToAppConf(cmd)
+		if packed.App {
+			file = GetFullPathToPackedConf(cmd)
+		}
+		rv = append(rv, validateConfigFile(cmd, ConfigTypeApp, file, packed.App, checkAppConfig))
+	}
+	if cmt {
+		file := GetFullPathToCmtConf(cmd)
+		if packed.Cmt {
+			file = GetFullPathToPackedConf(cmd)
+		}
+		rv = append(rv, validateConfigFile(cmd, ConfigTypeCmt, file, packed.Cmt, checkCmtConfig))
+	}
+	if client {
+		file := GetFullPathToClientConf(cmd)
+		if packed.Client {
+			file = GetFullPathToPackedConf(cmd)
+		}
+		rv = append(rv, validateConfigFile(cmd, ConfigTypeClient, file, packed.Client, checkClientConfig))
+	}
+	return rv
+}
+
+// configChecker runs the checks on a loaded config.
+// The provided command has the config (and nothing else) loaded into its viper.
+type configChecker func(dCmd *cobra.Command) preflight.Checks
+
+// validateConfigFile loads the provided config file into a detached command and runs the provided checks on it.
+func validateConfigFile(cmd *cobra.Command, confType, file string, isPacked bool, checker configChecker) *ConfigValidation {
+	rv := &ConfigValidation{Type: confType}
+	if FileExists(file) {
+		rv.Source = file
+	}
+
+	dCmd := newDetachedCmd(GetHomeDir(cmd))
+	vpr := server.GetServerContextFromCmd(dCmd).Viper
+	var err error
+	switch {
+	case isPacked:
+		_, err = readPackedConfig(vpr, file, "", "", "")
+	case confType == ConfigTypeApp:
+		err = readUnpackedConfig(vpr, file, "", "")
+	case confType == ConfigTypeCmt:
+		err = readUnpackedConfig(vpr, "", file, "")
+	case confType == ConfigTypeClient:
+		err = readUnpackedConfig(vpr, "", "", file)
+	default:
+		// This should only happen if there's a programming error, so this doesn't need to be pretty.
+		err = fmt.Errorf("unknown config type %q", confType)
+	}
+	rv.Checks = append(rv.Checks, preflight.Check{Name: "file can be read", Err: err})
+	if err != nil {
+		return rv
+	}
+
+	rv.Checks = append(rv.Checks, checker(dCmd)...)
+	return rv
+}
+
+// checkAppConfig runs the app config checks.
+func checkAppConfig(dCmd *cobra.Command) preflight.Checks {
+	conf, err := ExtractAppConfig(dCmd)
+	rv := preflight.Checks{{Name: "values have the correct types", Err: err}}
+	if err != nil {
+		return rv
+	}
+	return append(rv,
+		preflight.Check{Name: "basic validation", Err: conf.ValidateBasic()},
+		preflight.Check{Name: "minimum-gas-prices can be parsed", Err: checkMinGasPrices(conf.MinGasPrices)},
+		preflight.Check{Name: "pruning options are consistent", Err: checkPruning(conf.Pruning, conf.PruningKeepRecent, conf.PruningInterval)},
+		preflight.Check{Name: "iavl-cache-size is positive", Err: checkIAVLCacheSize(conf.IAVLCacheSize)},
+	)
+}
+
+// checkCmtConfig runs the cometbft config checks.
+func checkCmtConfig(dCmd *cobra.Command) preflight.Checks {
+	conf, err := ExtractCmtConfig(dCmd)
+	rv := preflight.Checks{{Name: "values have the correct types", Err: err}}
+	if err != nil {
+		return rv
+	}
+	return append(rv, preflight.Check{Name: "basic validation", Err: conf.ValidateBasic()})
+}
+
+// checkClientConfig runs the client config checks.
+func checkClientConfig(dCmd *cobra.Command) preflight.Checks {
+	conf, err := ExtractClientConfig(dCmd)
+	rv := preflight.Checks{{Name: "values have the correct types", Err: err}}
+	if err != nil {
+		return rv
+	}
+	return append(rv, preflight.Check{Name: "basic validation", Err: conf.ValidateBasic()})
+}
+
+// checkMinGasPrices returns an error if the provided minimum-gas-prices cannot be parsed.
+// An empty value is allowed here since that's checked by the basic validation.
+func checkMinGasPrices(minGasPrices string) error {
+	if len(minGasPrices) == 0 {
+		return nil
+	}
+	if _, err := sdk.ParseDecCoins(minGasPrices); err != nil {
+		return fmt.Errorf("invalid minimum-gas-prices %q: %w", minGasPrices, err)
+	}
+	return nil
+}
+
+// checkPruning returns an error if the provided pruning options don't make sense together.
+// The keep-recent and interval values are only used with the custom strategy, so they must be "0" for the others.
+func checkPruning(strategy, keepRecent, interval string) error {
+	switch strategy {
+	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing, pruningtypes.PruningOptionEverything:
+		if !isZeroOrEmpty(keepRecent) || !isZeroOrEmpty(interval) {
+			return fmt.Errorf("pruning-keep-recent (%q) and pruning-interval (%q) are only used with %q pruning, but pruning is %q",
+				keepRecent, interval, pruningtypes.PruningOptionCustom, strategy)
+		}
+		return nil
+	case pruningtypes.PruningOptionCustom:
+		keepRecentVal, err := strconv.ParseUint(keepRecent, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid pruning-keep-recent %q: %w", keepRecent, err)
+		}
+		intervalVal, err := strconv.ParseUint(interval, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid pruning-interval %q: %w", interval, err)
+		}
+		return pruningtypes.NewCustomPruningOptions(keepRecentVal, intervalVal).Validate()
+	default:
+		return fmt.Errorf("unknown pruning %q (must be one of %q, %q, %q, %q)", strategy,
+			pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing,
+			pruningtypes.PruningOptionEverything, pruningtypes.PruningOptionCustom)
+	}
+}
+
+// isZeroOrEmpty returns true if the provided string is "" or "0".
+func isZeroOrEmpty(str string) bool {
+	return len(str) == 0 || str == "0"
+}
+
+// checkIAVLCacheSize returns an error if the provided iavl-cache-size is zero.
+func checkIAVLCacheSize(size uint64) error {
+	if size == 0 {
+		return errors.New("iavl-cache-size must be positive")
+	}
+	return nil
+}
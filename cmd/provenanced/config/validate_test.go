@@ -0,0 +1,118 @@
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPruning(t *testing.T) {
+	tests := []struct {
+		name       string
+		strategy   string
+		keepRecent string
+		interval   string
+		expErr     string
+	}{
+		{name: "default", strategy: "default", keepRecent: "0", interval: "0"},
+		{name: "nothing", strategy: "nothing", keepRecent: "0", interval: "0"},
+		{name: "everything", strategy: "everything", keepRecent: "", interval: ""},
+		{
+			name:       "default with interval",
+			strategy:   "default",
+			keepRecent: "0",
+			interval:   "10",
+			expErr:     `pruning-keep-recent ("0") and pruning-interval ("10") are only used with "custom" pruning, but pruning is "default"`,
+		},
+		{
+			name:       "nothing with keep recent",
+			strategy:   "nothing",
+			keepRecent: "100",
+			interval:   "0",
+			expErr:     `pruning-keep-recent ("100") and pruning-interval ("0") are only used with "custom" pruning, but pruning is "nothing"`,
+		},
+		{name: "custom", strategy: "custom", keepRecent: "100", interval: "10"},
+		{name: "custom bad keep recent", strategy: "custom", keepRecent: "x", interval: "10", expErr: `invalid pruning-keep-recent "x"`},
+		{name: "custom bad interval", strategy: "custom", keepRecent: "100", interval: "-1", expErr: `invalid pruning-interval "-1"`},
+		{name: "custom zero interval", strategy: "custom", keepRecent: "100", interval: "0", expErr: "'pruning-interval' must not be 0"},
+		{name: "custom interval too small", strategy: "custom", keepRecent: "100", interval: "9", expErr: "'pruning-interval' must not be less than 10"},
+		{name: "custom keep recent too small", strategy: "custom", keepRecent: "1", interval: "10", expErr: "'pruning-keep-recent' must not be less than 2"},
+		{name: "unknown", strategy: "sometimes", keepRecent: "0", interval: "0", expErr: `unknown pruning "sometimes"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkPruning(tc.strategy, tc.keepRecent, tc.interval)
+			if len(tc.expErr) > 0 {
+				assert.ErrorContains(t, err, tc.expErr, "checkPruning error")
+			} else {
+				assert.NoError(t, err, "checkPruning error")
+			}
+		})
+	}
+}
+
+func TestCheckMinGasPrices(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		expErr string
+	}{
+		{name: "empty", value: ""},
+		{name: "one coin", value: "1905nhash"},
+		{name: "two coins", value: "1905nhash,0.5stake"},
+		{name: "bad denom", value: "1905:nhash", expErr: `invalid minimum-gas-prices "1905:nhash"`},
+		{name: "no amount", value: "nhash", expErr: `invalid minimum-gas-prices "nhash"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkMinGasPrices(tc.value)
+			if len(tc.expErr) > 0 {
+				assert.ErrorContains(t, err, tc.expErr, "checkMinGasPrices error")
+			} else {
+				assert.NoError(t, err, "checkMinGasPrices error")
+			}
+		})
+	}
+}
+
+func TestCheckIAVLCacheSize(t *testing.T) {
+	assert.EqualError(t, checkIAVLCacheSize(0), "iavl-cache-size must be positive", "checkIAVLCacheSize(0)")
+	assert.NoError(t, checkIAVLCacheSize(1), "checkIAVLCacheSize(1)")
+	assert.NoError(t, checkIAVLCacheSize(781250), "checkIAVLCacheSize(781250)")
+}
+
+func TestValidateConfigFiles(t *testing.T) {
+	home := t.TempDir()
+	dCmd := newDetachedCmd(home)
+	require.NoError(t, EnsureConfigDir(dCmd), "EnsureConfigDir")
+	appFile := GetFullPathToAppConf(dCmd)
+	clientFile := GetFullPathToClientConf(dCmd)
+	require.NoError(t, os.WriteFile(appFile, []byte("iavl-cache-size = = 5\n"), 0o644), "writing app config file")
+	require.NoError(t, os.WriteFile(clientFile, []byte("output = \"text\"\n"), 0o644), "writing client config file")
+
+	results := ValidateConfigFiles(dCmd, true, true, true)
+	require.Len(t, results, 3, "results")
+
+	app, cmt, client := results[0], results[1], results[2]
+	assert.Equal(t, ConfigTypeApp, app.Type, "app result type")
+	assert.Equal(t, appFile, app.Source, "app result source")
+	assert.False(t, app.Passed(), "app result passed")
+	if assert.Len(t, app.Checks, 1, "app result checks") {
+		assert.Equal(t, "file can be read", app.Checks[0].Name, "app check name")
+		assert.ErrorContains(t, app.Checks[0].Err, "app config file merge error", "app check error")
+	}
+
+	assert.Equal(t, ConfigTypeCmt, cmt.Type, "cmt result type")
+	assert.Equal(t, "", cmt.Source, "cmt result source")
+	assert.True(t, cmt.Passed(), "cmt result passed")
+
+	assert.Equal(t, ConfigTypeClient, client.Type, "client result type")
+	assert.Equal(t, clientFile, client.Source, "client result source")
+	assert.True(t, client.Passed(), "client result passed")
+
+	assert.NoFileExists(t, GetFullPathToCmtConf(dCmd), "cmt config file after validation")
+}
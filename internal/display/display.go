@@ -0,0 +1,274 @@
+package display
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// FlagDisplay is the flag that switches a query command's output from proto JSON to the display form.
+const FlagDisplay = "display"
+
+// AddDisplayFlagToCmd adds the --display flag to a command.
+func AddDisplayFlagToCmd(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagDisplay, false,
+		"Render the response for people: unpack Any values, show coins using denom metadata, and show enums as names")
+}
+
+// PrintProto prints the provided message using the client context.
+// If the command's --display flag is set, the message is rendered into its display form first,
+// otherwise it is printed as proto JSON (i.e. the same as clientCtx.PrintProto).
+func PrintProto(cmd *cobra.Command, clientCtx client.Context, msg proto.Message, opts ...Option) error {
+	show, err := cmd.Flags().GetBool(FlagDisplay)
+	if err != nil || !show {
+		return clientCtx.PrintProto(msg)
+	}
+	out, err := NewClientRenderer(clientCtx, opts...).Render(msg)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintRaw(out)
+}
+
+// MetadataGetter looks up the bank denom metadata of a denom.
+// It should return nil, nil if the denom does not have any metadata.
+type MetadataGetter func(denom string) (*banktypes.Metadata, error)
+
+// Option is a configuration option for a Renderer.
+type Option func(r *Renderer)
+
+// WithEnum causes the provided enum's values to be rendered as lower-case names without the provided prefix.
+// E.g. WithEnum("ACCESS_", types.Access_value) causes "ACCESS_ADMIN" to be rendered as "admin".
+// The values map is the one generated for the enum (e.g. types.Access_value).
+func WithEnum(prefix string, values map[string]int32) Option {
+	return func(r *Renderer) {
+		for name, value := range values {
+			if value == 0 {
+				// Zero values are always unspecified/unknown, so leave them alone to make that obvious.
+				continue
+			}
+			r.enums[name] = strings.ToLower(strings.TrimPrefix(name, prefix))
+		}
+	}
+}
+
+// Renderer converts proto messages into a display form that is easier for people to read.
+//
+//   - An Any is replaced with its unpacked value (without the "@type"), and the fields of
+//     that value's embedded base_account (if it has one) are moved up into it.
+//   - A coin (an object with only a denom and amount) is rendered as a string using the
+//     display unit of the denom's metadata (e.g. "1.5hash"). If the denom has no metadata,
+//     the coin is rendered using its base denom (e.g. "1500000000nhash").
+//   - Values of enums registered using WithEnum are rendered as their short lower-case names.
+type Renderer struct {
+	cdc         codec.JSONCodec
+	getMetadata MetadataGetter
+	enums       map[string]string
+	metadata    map[string]*banktypes.Metadata
+}
+
+// NewRenderer creates a new Renderer that marshals messages with the provided codec and looks up denom metadata
+// using the provided getter. If getMetadata is nil, coins are always rendered with their base denom.
+func NewRenderer(cdc codec.JSONCodec, getMetadata MetadataGetter, opts ...Option) *Renderer {
+	rv := &Renderer{
+		cdc:         cdc,
+		getMetadata: getMetadata,
+		enums:       make(map[string]string),
+		metadata:    make(map[string]*banktypes.Metadata),
+	}
+	for _, opt := range opts {
+		opt(rv)
+	}
+	return rv
+}
+
+// NewClientRenderer creates a new Renderer that uses the client context's codec
+// and looks up denom metadata by querying the bank module.
+func NewClientRenderer(clientCtx client.Context, opts ...Option) *Renderer {
+	queryClient := banktypes.NewQueryClient(clientCtx)
+	getMetadata := func(denom string) (*banktypes.Metadata, error) {
+		resp, err := queryClient.DenomMetadata(context.Background(), &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("could not get denom metadata for %q: %w", denom, err)
+		}
+		return &resp.Metadata, nil
+	}
+	return NewRenderer(clientCtx.Codec, getMetadata, opts...)
+}
+
+// Render converts the provided message into its display form, as JSON.
+func (r *Renderer) Render(msg proto.Message) (json.RawMessage, error) {
+	bz, err := r.cdc.MarshalJSON(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	var val interface{}
+	if err = dec.Decode(&val); err != nil {
+		return nil, err
+	}
+
+	val, err = r.renderValue(val)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err = enc.Encode(val); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte{'\n'}), nil
+}
+
+// renderValue converts a decoded JSON value into its display form.
+func (r *Renderer) renderValue(val interface{}) (interface{}, error) {
+	switch v := val.(type) {
+	case map[string]interface{}:
+		if denom, amount, ok := asCoin(v); ok {
+			return r.renderCoin(denom, amount)
+		}
+		if _, isAny := v["@type"]; isAny {
+			unpackAny(v)
+		}
+		for key, entry := range v {
+			rendered, err := r.renderValue(entry)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = rendered
+		}
+		return v, nil
+	case []interface{}:
+		for i, entry := range v {
+			rendered, err := r.renderValue(entry)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = rendered
+		}
+		return v, nil
+	case string:
+		if name, known := r.enums[v]; known {
+			return name, nil
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
+
+// unpackAny removes the "@type" entry from an unpacked Any, and moves the fields of its base_account up into it.
+func unpackAny(v map[string]interface{}) {
+	delete(v, "@type")
+	base, ok := v["base_account"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	delete(v, "base_account")
+	for key, entry := range base {
+		if _, exists := v[key]; !exists {
+			v[key] = entry
+		}
+	}
+}
+
+// asCoin returns the denom and amount of the provided object if it is a coin (or dec coin).
+func asCoin(v map[string]interface{}) (denom string, amount string, ok bool) {
+	if len(v) != 2 {
+		return "", "", false
+	}
+	denom, ok = v["denom"].(string)
+	if !ok {
+		return "", "", false
+	}
+	amount, ok = v["amount"].(string)
+	return denom, amount, ok
+}
+
+// renderCoin returns a string of the provided amount and denom using the denom's display unit (if it has one).
+func (r *Renderer) renderCoin(denom, amount string) (string, error) {
+	md, err := r.getDenomMetadata(denom)
+	if err != nil {
+		return "", err
+	}
+	if md == nil || len(md.Display) == 0 || md.Display == denom {
+		return amount + denom, nil
+	}
+	for _, unit := range md.DenomUnits {
+		if unit != nil && unit.Denom == md.Display && unit.Exponent > 0 {
+			return ShiftDecimal(amount, unit.Exponent) + md.Display, nil
+		}
+	}
+	return amount + denom, nil
+}
+
+// getDenomMetadata gets (and caches) the metadata for a denom. Returns nil if there isn't any.
+func (r *Renderer) getDenomMetadata(denom string) (*banktypes.Metadata, error) {
+	if r.getMetadata == nil || len(denom) == 0 {
+		return nil, nil
+	}
+	if md, known := r.metadata[denom]; known {
+		return md, nil
+	}
+	md, err := r.getMetadata(denom)
+	if err != nil {
+		return nil, err
+	}
+	r.metadata[denom] = md
+	return md, nil
+}
+
+// ShiftDecimal moves the decimal point of the provided (non-negative) decimal string to the left by the
+// provided number of places. Trailing zeros after the decimal point are removed, e.g. ShiftDecimal("1500", 3) = "1.5".
+// If the amount is not a decimal number, it is returned unchanged.
+func ShiftDecimal(amount string, places uint32) string {
+	whole, frac, _ := strings.Cut(amount, ".")
+	if len(whole) == 0 || !isDigits(whole) || !isDigits(frac) {
+		return amount
+	}
+
+	digits := whole + frac
+	point := len(whole) - int(places)
+	if point <= 0 {
+		digits = strings.Repeat("0", 1-point) + digits
+		point = 1
+	}
+
+	whole = strings.TrimLeft(digits[:point], "0")
+	if len(whole) == 0 {
+		whole = "0"
+	}
+	frac = strings.TrimRight(digits[point:], "0")
+	if len(frac) == 0 {
+		return whole
+	}
+	return whole + "." + frac
+}
+
+// isDigits returns true if the provided string only contains the characters 0 through 9.
+func isDigits(str string) bool {
+	for _, c := range str {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,127 @@
+package display_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/display"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestRenderer(t *testing.T) {
+	cdc := app.MakeTestEncodingConfig(t).Marshaler
+	admin := sdk.AccAddress("admin_______________")
+	markerAddr := markertypes.MustGetMarkerAddress("hotdog")
+
+	marker := markertypes.NewMarkerAccount(
+		authtypes.NewBaseAccount(markerAddr, nil, 8, 0),
+		sdk.NewInt64Coin("hotdog", 1000),
+		nil,
+		[]markertypes.AccessGrant{*markertypes.NewAccessGrant(admin, []markertypes.Access{markertypes.Access_Admin, markertypes.Access_ForceTransfer})},
+		markertypes.StatusActive,
+		markertypes.MarkerType_RestrictedCoin,
+		true, false, false, nil,
+	)
+	markerAny, err := codectypes.NewAnyWithValue(marker)
+	require.NoError(t, err, "NewAnyWithValue(marker)")
+
+	metadata := map[string]*banktypes.Metadata{
+		"nhash": {
+			Base:    "nhash",
+			Display: "hash",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "nhash", Exponent: 0},
+				{Denom: "hash", Exponent: 9},
+			},
+		},
+	}
+	lookups := 0
+	getMetadata := func(denom string) (*banktypes.Metadata, error) {
+		lookups++
+		if denom == "baddenom" {
+			return nil, errors.New("injected error")
+		}
+		return metadata[denom], nil
+	}
+	opts := []display.Option{
+		display.WithEnum("ACCESS_", markertypes.Access_value),
+		display.WithEnum("MARKER_STATUS_", markertypes.MarkerStatus_value),
+		display.WithEnum("MARKER_TYPE_", markertypes.MarkerType_value),
+	}
+
+	t.Run("marker", func(t *testing.T) {
+		renderer := display.NewRenderer(cdc, getMetadata, opts...)
+		out, err := renderer.Render(&markertypes.QueryMarkerResponse{Marker: markerAny})
+		require.NoError(t, err, "Render")
+		exp := `{"marker":{"access_control":[{"address":"` + admin.String() + `","permissions":["admin","force_transfer"]}],` +
+			`"account_number":"8","address":"` + markerAddr.String() + `","allow_forced_transfer":false,"allow_governance_control":false,` +
+			`"denom":"hotdog","manager":"","marker_type":"restricted","pub_key":null,"required_attributes":[],"sequence":"0",` +
+			`"status":"active","supply":"1000","supply_fixed":true},"owned_scopes":[],"owned_scopes_pagination":null,"resolved_id":null}`
+		assert.Equal(t, exp, string(out), "Render output")
+	})
+
+	t.Run("coins", func(t *testing.T) {
+		lookups = 0
+		renderer := display.NewRenderer(cdc, getMetadata, opts...)
+		out, err := renderer.Render(&markertypes.QueryEscrowResponse{
+			Escrow: sdk.NewCoins(sdk.NewInt64Coin("nhash", 1_500_000_000), sdk.NewInt64Coin("hotdog", 3)),
+		})
+		require.NoError(t, err, "Render")
+		assert.Equal(t, `{"escrow":["3hotdog","1.5hash"],"metadata":[],"resolved_id":null}`, string(out), "Render output")
+
+		out, err = renderer.Render(&markertypes.QuerySupplyResponse{Amount: sdk.NewCoin("nhash", sdkmath.NewInt(7))})
+		require.NoError(t, err, "Render")
+		assert.Equal(t, `{"amount":"0.000000007hash","resolved_id":null}`, string(out), "Render output")
+		assert.Equal(t, 2, lookups, "number of metadata lookups")
+	})
+
+	t.Run("metadata error", func(t *testing.T) {
+		renderer := display.NewRenderer(cdc, getMetadata, opts...)
+		_, err := renderer.Render(&markertypes.QuerySupplyResponse{Amount: sdk.NewInt64Coin("baddenom", 1)})
+		assert.EqualError(t, err, "injected error", "Render")
+	})
+
+	t.Run("no options", func(t *testing.T) {
+		renderer := display.NewRenderer(cdc, nil)
+		out, err := renderer.Render(&markertypes.QueryAccessResponse{Accounts: marker.AccessControl})
+		require.NoError(t, err, "Render")
+		assert.Equal(t, `{"accounts":[{"address":"`+admin.String()+`","permissions":["ACCESS_ADMIN","ACCESS_FORCE_TRANSFER"]}]}`, string(out), "Render output")
+	})
+}
+
+func TestShiftDecimal(t *testing.T) {
+	tests := []struct {
+		amount string
+		places uint32
+		exp    string
+	}{
+		{amount: "1500", places: 0, exp: "1500"},
+		{amount: "1500", places: 3, exp: "1.5"},
+		{amount: "1000", places: 3, exp: "1"},
+		{amount: "1500", places: 4, exp: "0.15"},
+		{amount: "15", places: 5, exp: "0.00015"},
+		{amount: "0", places: 6, exp: "0"},
+		{amount: "12.5", places: 2, exp: "0.125"},
+		{amount: "1250.000", places: 1, exp: "125"},
+		{amount: "-5", places: 1, exp: "-5"},
+		{amount: "abc", places: 1, exp: "abc"},
+		{amount: "", places: 1, exp: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.amount, func(t *testing.T) {
+			assert.Equal(t, tc.exp, display.ShiftDecimal(tc.amount, tc.places), "ShiftDecimal(%q, %d)", tc.amount, tc.places)
+		})
+	}
+}
@@ -0,0 +1,234 @@
+// Package preflight contains the checks that are run on a node's config before starting it.
+//
+// These are used by both the start command and the config check-start command so that they can't diverge.
+package preflight
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	cmtconfig "github.com/cometbft/cometbft/config"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// Check is the result of a single pre-flight check.
+type Check struct {
+	// Name is a short description of what was checked.
+	Name string
+	// Err is the problem found by this check, or nil if it passed.
+	Err error
+}
+
+// Passed returns true if this check did not find a problem.
+func (c Check) Passed() bool {
+	return c.Err == nil
+}
+
+// String returns a single line describing this check and its result.
+func (c Check) String() string {
+	if c.Err != nil {
+		return fmt.Sprintf("FAIL: %s: %v", c.Name, c.Err)
+	}
+	return "PASS: " + c.Name
+}
+
+// Checks is the results of several pre-flight checks.
+type Checks []Check
+
+// Failed returns just the checks that found a problem.
+func (c Checks) Failed() Checks {
+	var rv Checks
+	for _, check := range c {
+		if !check.Passed() {
+			rv = append(rv, check)
+		}
+	}
+	return rv
+}
+
+// Err returns an error containing all the failed checks, or nil if they all passed.
+func (c Checks) Err() error {
+	failed := c.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	errs := make([]error, len(failed))
+	for i, check := range failed {
+		errs[i] = fmt.Errorf("%s: %w", check.Name, check.Err)
+	}
+	return fmt.Errorf("%d pre-flight check(s) failed: %w", len(failed), errors.Join(errs...))
+}
+
+// listener is an address that the node will listen on, and the config field it came from.
+type listener struct {
+	field string
+	addr  string
+}
+
+// Run runs all the pre-flight checks on the provided configs and returns the results.
+// Nothing is changed by these checks, and any ports opened are closed before returning.
+func Run(appCfg *serverconfig.Config, cmtCfg *cmtconfig.Config) Checks {
+	listeners := getListeners(appCfg, cmtCfg)
+	rv := make(Checks, 0, len(listeners)*2+4)
+	rv = append(rv, checkCollisions(listeners)...)
+	for _, l := range listeners {
+		rv = append(rv, Check{Name: fmt.Sprintf("%s %s is available", l.field, l.addr), Err: CheckListen(l.addr)})
+	}
+	rv = append(rv,
+		Check{Name: "db dir " + cmtCfg.DBDir() + " is usable", Err: CheckDir(cmtCfg.DBDir())},
+		Check{Name: "snapshot dir " + SnapshotDir(cmtCfg) + " is usable", Err: CheckDir(SnapshotDir(cmtCfg))},
+	)
+	if len(cmtCfg.RPC.TLSCertFile) > 0 {
+		rv = append(rv, Check{Name: "rpc.tls_cert_file " + cmtCfg.RPC.CertFile() + " is readable", Err: CheckReadable(cmtCfg.RPC.CertFile())})
+	}
+	if len(cmtCfg.RPC.TLSKeyFile) > 0 {
+		rv = append(rv, Check{Name: "rpc.tls_key_file " + cmtCfg.RPC.KeyFile() + " is readable", Err: CheckReadable(cmtCfg.RPC.KeyFile())})
+	}
+	return rv
+}
+
+// SnapshotDir returns the directory that state sync snapshots are stored in.
+// This is the same directory the SDK uses when it creates the snapshot store.
+func SnapshotDir(cmtCfg *cmtconfig.Config) string {
+	return filepath.Join(cmtCfg.RootDir, "data", "snapshots")
+}
+
+// getListeners gets all the enabled tcp addresses that the node will listen on.
+func getListeners(appCfg *serverconfig.Config, cmtCfg *cmtconfig.Config) []listener {
+	var rv []listener
+	add := func(field, addr string) {
+		addr, ok := tcpHostPort(addr)
+		if ok {
+			rv = append(rv, listener{field: field, addr: addr})
+		}
+	}
+
+	add("rpc.laddr", cmtCfg.RPC.ListenAddress)
+	add("p2p.laddr", cmtCfg.P2P.ListenAddress)
+	if cmtCfg.RPC.IsPprofEnabled() {
+		add("rpc.pprof_laddr", cmtCfg.RPC.PprofListenAddress)
+	}
+	if cmtCfg.Instrumentation.Prometheus {
+		add("instrumentation.prometheus_listen_addr", cmtCfg.Instrumentation.PrometheusListenAddr)
+	}
+	if appCfg.GRPC.Enable {
+		add("grpc.address", appCfg.GRPC.Address)
+	}
+	if appCfg.API.Enable {
+		add("api.address", appCfg.API.Address)
+	}
+	return rv
+}
+
+// tcpHostPort converts the provided address into a host:port string.
+// Returns false if the address is empty or not a tcp address (e.g. a unix socket).
+func tcpHostPort(addr string) (string, bool) {
+	addr = strings.TrimSpace(addr)
+	if len(addr) == 0 {
+		return "", false
+	}
+	if proto, rest, found := strings.Cut(addr, "://"); found {
+		if proto != "tcp" {
+			return "", false
+		}
+		addr = rest
+	}
+	return addr, true
+}
+
+// checkCollisions returns a failed check for each pair of listeners that would be using the same port.
+func checkCollisions(listeners []listener) Checks {
+	var rv Checks
+	for i, l1 := range listeners {
+		for _, l2 := range listeners[i+1:] {
+			if addrsOverlap(l1.addr, l2.addr) {
+				rv = append(rv, Check{
+					Name: fmt.Sprintf("%s and %s use different ports", l1.field, l2.field),
+					Err:  fmt.Errorf("both use %s and %s", l1.addr, l2.addr),
+				})
+			}
+		}
+	}
+	if len(rv) == 0 && len(listeners) > 1 {
+		rv = append(rv, Check{Name: "listen addresses use different ports"})
+	}
+	return rv
+}
+
+// addrsOverlap returns true if the two host:port addresses would conflict with each other.
+// Addresses that can't be parsed are ignored here since those problems are reported by CheckListen.
+func addrsOverlap(addr1, addr2 string) bool {
+	host1, port1, err1 := net.SplitHostPort(addr1)
+	host2, port2, err2 := net.SplitHostPort(addr2)
+	if err1 != nil || err2 != nil || port1 != port2 || port1 == "0" {
+		return false
+	}
+	host1, host2 = normalizeHost(host1), normalizeHost(host2)
+	return host1 == host2 || isAnyHost(host1) || isAnyHost(host2)
+}
+
+// normalizeHost converts the provided host into a standard form for comparison.
+func normalizeHost(host string) string {
+	if strings.EqualFold(host, "localhost") {
+		return "127.0.0.1"
+	}
+	return host
+}
+
+// isAnyHost returns true if the provided host means that all interfaces will be listened on.
+func isAnyHost(host string) bool {
+	return len(host) == 0 || host == "0.0.0.0" || host == "::"
+}
+
+// CheckListen makes sure that the provided tcp address can be listened on.
+// The listener is closed immediately after being opened.
+func CheckListen(addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return ln.Close()
+}
+
+// CheckDir makes sure that the provided path is a directory, or can be created as one.
+func CheckDir(path string) error {
+	info, err := os.Stat(path)
+	if err == nil {
+		if !info.IsDir() {
+			return fmt.Errorf("%s is not a directory", path)
+		}
+		return nil
+	}
+	if !os.IsNotExist(err) {
+		return err
+	}
+
+	// It doesn't exist yet, so make sure it can be created by finding the closest existing parent.
+	for parent := filepath.Dir(path); ; parent = filepath.Dir(parent) {
+		info, err = os.Stat(parent)
+		switch {
+		case err == nil && !info.IsDir():
+			return fmt.Errorf("cannot create %s: %s is not a directory", path, parent)
+		case err == nil:
+			return nil
+		case !os.IsNotExist(err):
+			return err
+		case parent == filepath.Dir(parent):
+			return fmt.Errorf("cannot create %s: no parent directory exists", path)
+		}
+	}
+}
+
+// CheckReadable makes sure that the provided file exists and can be read.
+func CheckReadable(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
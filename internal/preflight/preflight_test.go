@@ -0,0 +1,155 @@
+package preflight
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtconfig "github.com/cometbft/cometbft/config"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// newTestConfigs creates app and cometbft configs rooted in a temp dir that only listen on free local ports.
+func newTestConfigs(t *testing.T) (*serverconfig.Config, *cmtconfig.Config) {
+	appCfg := serverconfig.DefaultConfig()
+	appCfg.GRPC.Enable = true
+	appCfg.GRPC.Address = freeAddr(t)
+	appCfg.API.Enable = true
+	appCfg.API.Address = "tcp://" + freeAddr(t)
+
+	cmtCfg := cmtconfig.DefaultConfig()
+	cmtCfg.SetRoot(t.TempDir())
+	cmtCfg.RPC.ListenAddress = "tcp://" + freeAddr(t)
+	cmtCfg.P2P.ListenAddress = "tcp://" + freeAddr(t)
+	return appCfg, cmtCfg
+}
+
+// freeAddr gets a local address with a port that isn't currently in use.
+func freeAddr(t *testing.T) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err, "net.Listen")
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close(), "ln.Close()")
+	return addr
+}
+
+// failedNames gets the names of all the failed checks.
+func failedNames(checks Checks) []string {
+	var rv []string
+	for _, check := range checks.Failed() {
+		rv = append(rv, check.Name)
+	}
+	return rv
+}
+
+func TestRun(t *testing.T) {
+	t.Run("all good", func(t *testing.T) {
+		appCfg, cmtCfg := newTestConfigs(t)
+		checks := Run(appCfg, cmtCfg)
+		assert.Empty(t, failedNames(checks), "failed checks")
+		assert.NoError(t, checks.Err(), "checks.Err()")
+		// 1 collision check + 4 listeners + 2 dirs.
+		assert.Len(t, checks, 7, "checks")
+	})
+
+	t.Run("port in use", func(t *testing.T) {
+		appCfg, cmtCfg := newTestConfigs(t)
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err, "net.Listen")
+		defer ln.Close()
+		appCfg.GRPC.Address = ln.Addr().String()
+
+		checks := Run(appCfg, cmtCfg)
+		exp := []string{"grpc.address " + appCfg.GRPC.Address + " is available"}
+		assert.Equal(t, exp, failedNames(checks), "failed checks")
+		assert.ErrorContains(t, checks.Err(), "1 pre-flight check(s) failed", "checks.Err()")
+		assert.ErrorContains(t, checks.Err(), "address already in use", "checks.Err()")
+	})
+
+	t.Run("grpc and api collide", func(t *testing.T) {
+		appCfg, cmtCfg := newTestConfigs(t)
+		_, port, err := net.SplitHostPort(appCfg.GRPC.Address)
+		require.NoError(t, err, "SplitHostPort(%q)", appCfg.GRPC.Address)
+		appCfg.API.Address = "tcp://0.0.0.0:" + port
+
+		checks := Run(appCfg, cmtCfg)
+		exp := []string{"grpc.address and api.address use different ports"}
+		assert.Equal(t, exp, failedNames(checks), "failed checks")
+	})
+
+	t.Run("disabled listeners are not checked", func(t *testing.T) {
+		appCfg, cmtCfg := newTestConfigs(t)
+		appCfg.GRPC.Enable = false
+		appCfg.API.Enable = false
+		ln, err := net.Listen("tcp", appCfg.GRPC.Address)
+		require.NoError(t, err, "net.Listen")
+		defer ln.Close()
+
+		checks := Run(appCfg, cmtCfg)
+		assert.Empty(t, failedNames(checks), "failed checks")
+		for _, check := range checks {
+			assert.NotContains(t, check.Name, "grpc", "check name")
+		}
+	})
+
+	t.Run("missing tls file", func(t *testing.T) {
+		appCfg, cmtCfg := newTestConfigs(t)
+		keyFile := filepath.Join(cmtCfg.RootDir, "key.pem")
+		require.NoError(t, os.WriteFile(keyFile, []byte("not really a key"), 0o600), "WriteFile(key.pem)")
+		cmtCfg.RPC.TLSCertFile = "cert.pem"
+		cmtCfg.RPC.TLSKeyFile = keyFile
+
+		checks := Run(appCfg, cmtCfg)
+		exp := []string{"rpc.tls_cert_file " + filepath.Join(cmtCfg.RootDir, "config", "cert.pem") + " is readable"}
+		assert.Equal(t, exp, failedNames(checks), "failed checks")
+		assert.ErrorContains(t, checks.Err(), "no such file or directory", "checks.Err()")
+	})
+
+	t.Run("db dir is a file", func(t *testing.T) {
+		appCfg, cmtCfg := newTestConfigs(t)
+		require.NoError(t, os.WriteFile(filepath.Join(cmtCfg.RootDir, "data"), nil, 0o600), "WriteFile(data)")
+
+		checks := Run(appCfg, cmtCfg)
+		exp := []string{
+			"db dir " + cmtCfg.DBDir() + " is usable",
+			"snapshot dir " + SnapshotDir(cmtCfg) + " is usable",
+		}
+		assert.Equal(t, exp, failedNames(checks), "failed checks")
+	})
+}
+
+func TestCheckString(t *testing.T) {
+	assert.Equal(t, "PASS: thing", Check{Name: "thing"}.String(), "passed check")
+	assert.Equal(t, "FAIL: thing: oops", Check{Name: "thing", Err: errors.New("oops")}.String(), "failed check")
+}
+
+func TestAddrsOverlap(t *testing.T) {
+	tests := []struct {
+		addr1, addr2 string
+		exp          bool
+	}{
+		{addr1: "127.0.0.1:1317", addr2: "127.0.0.1:1317", exp: true},
+		{addr1: "localhost:1317", addr2: "127.0.0.1:1317", exp: true},
+		{addr1: "0.0.0.0:1317", addr2: "127.0.0.1:1317", exp: true},
+		{addr1: "127.0.0.1:1317", addr2: ":1317", exp: true},
+		{addr1: "[::]:1317", addr2: "10.0.0.1:1317", exp: true},
+		{addr1: "127.0.0.1:1317", addr2: "10.0.0.1:1317", exp: false},
+		{addr1: "127.0.0.1:1317", addr2: "127.0.0.1:9090", exp: false},
+		{addr1: "127.0.0.1:0", addr2: "127.0.0.1:0", exp: false},
+		{addr1: "bad", addr2: "bad", exp: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(strings.Join([]string{tc.addr1, tc.addr2}, " "), func(t *testing.T) {
+			act := addrsOverlap(tc.addr1, tc.addr2)
+			assert.Equal(t, tc.exp, act, "addrsOverlap(%q, %q)", tc.addr1, tc.addr2)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package provcli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+const (
+	FlagGenesis = "genesis"
+)
+
+// ErrGenesisModuleMissing indicates that a genesis file does not have a section for a module.
+var ErrGenesisModuleMissing = errors.New("module section not found in genesis")
+
+// AddGenesisFlagToCmd adds the genesis flag to a command.
+func AddGenesisFlagToCmd(cmd *cobra.Command) {
+	cmd.Flags().String(FlagGenesis, "", "Read the params from this genesis file instead of querying a node")
+}
+
+// ReadModuleGenesis reads a genesis file and unmarshals the section for the provided module into genState.
+// If the genesis file does not have a section for the module, the returned error is an ErrGenesisModuleMissing.
+func ReadModuleGenesis(cdc codec.JSONCodec, genFile, moduleName string, genState proto.Message) error {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genFile)
+	if err != nil {
+		return err
+	}
+
+	var appState map[string]json.RawMessage
+	if err = json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+		return fmt.Errorf("invalid app state in genesis file %s: %w", genFile, err)
+	}
+
+	modState, found := appState[moduleName]
+	if !found || len(modState) == 0 || string(modState) == "null" {
+		return fmt.Errorf("%w: genesis file %s does not have a %s section", ErrGenesisModuleMissing, genFile, moduleName)
+	}
+
+	if err = cdc.UnmarshalJSON(modState, genState); err != nil {
+		return fmt.Errorf("invalid %s section in genesis file %s: %w", moduleName, genFile, err)
+	}
+	return nil
+}
+
+// PrintGenesisParams outputs a module's params and the number of each type of entry in its genesis state.
+func PrintGenesisParams(clientCtx client.Context, params proto.Message, counts map[string]int) error {
+	paramsJSON, err := clientCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	output, err := json.Marshal(struct {
+		Params json.RawMessage `json:"params"`
+		Counts map[string]int  `json:"counts"`
+	}{
+		Params: paramsJSON,
+		Counts: counts,
+	})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintRaw(output)
+}
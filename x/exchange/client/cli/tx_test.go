@@ -367,6 +367,7 @@ func (s *CmdTestSuite) TestCmdTxFillAsks() {
 			},
 			args: []string{"fill-asks", "--from", s.addr4.String(), "--market", "5",
 				"--price", "2500peach", "--settlement-fee", "75peach", "--creation-fee", "10peach"},
+			gas:          300_000,
 			expectedCode: 0,
 		},
 	}
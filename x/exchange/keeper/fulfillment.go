@@ -3,7 +3,6 @@ package keeper
 import (
 	"errors"
 	"fmt"
-	"strings"
 
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -337,14 +336,15 @@ func (k Keeper) recordNAVs(ctx sdk.Context, marketID uint32, navs []exchange.Net
 	markerNAVs := make(map[string][]markertypes.NetAssetValue)
 	metadataNAVs := make(map[string][]metadatatypes.NetAssetValue)
 	for _, nav := range navs {
-		isMetadataDenom := strings.HasPrefix(nav.Assets.Denom, metadatatypes.DenomPrefix)
+		isMetadataDenom := metadatatypes.IsMetadataDenom(nav.Assets.Denom)
 
 		if !nav.Assets.Amount.IsUint64() {
 			k.logErrorf(ctx, "could not record net-asset-value of %q at a price of %q: asset volume greater than max uint64",
 				nav.Assets, nav.Price)
 			if isMetadataDenom {
+				scopeID, _ := metadatatypes.TrimMetadataDenomPrefix(nav.Assets.Denom)
 				k.emitEvent(ctx, &metadatatypes.EventSetNetAssetValue{
-					ScopeId: strings.TrimPrefix(nav.Assets.Denom, metadatatypes.DenomPrefix),
+					ScopeId: scopeID,
 					Price:   nav.Price.String(),
 					Volume:  nav.Assets.Amount.String(),
 					Source:  source,
@@ -444,7 +444,7 @@ func (k Keeper) emitMetadataNAVEvents(ctx sdk.Context, scopeID metadatatypes.Met
 
 // GetNav looks up a NAV from the marker or metadata module and returns it as a NetAssetPrice.
 func (k Keeper) GetNav(ctx sdk.Context, assetsDenom, priceDenom string) *exchange.NetAssetPrice {
-	if strings.HasPrefix(assetsDenom, metadatatypes.DenomPrefix) {
+	if metadatatypes.IsMetadataDenom(assetsDenom) {
 		// Get the nav from the metadata module.
 		nav, _ := k.metadataKeeper.GetNetAssetValue(ctx, assetsDenom, priceDenom)
 		if nav == nil {
@@ -1,8 +1,13 @@
 package cli_test
 
 import (
+	"bytes"
 	"encoding/base64"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"testing"
@@ -33,7 +38,9 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/provenance-io/provenance/internal/antewrapper"
+	"github.com/provenance-io/provenance/internal/display"
 	"github.com/provenance-io/provenance/internal/pioconfig"
+	"github.com/provenance-io/provenance/internal/provcli"
 	"github.com/provenance-io/provenance/testutil"
 	"github.com/provenance-io/provenance/testutil/assertions"
 	testcli "github.com/provenance-io/provenance/testutil/cli"
@@ -127,6 +134,17 @@ func (s *IntegrationTestSuite) SetupSuite() {
 			bal(markertypes.MustGetMarkerAddress("authzhotdog"), coin(800, "authzhotdog")),
 			bal(markertypes.MustGetMarkerAddress("grantcoin"), coin(5000, s.cfg.BondDenom)),
 		)
+		bankGenState.DenomMetadata = append(bankGenState.DenomMetadata, banktypes.Metadata{
+			Description: "A hotdog for the display tests.",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "authzhotdog", Exponent: 0},
+				{Denom: "hotdog", Exponent: 2},
+			},
+			Base:    "authzhotdog",
+			Display: "hotdog",
+			Name:    "Hotdog",
+			Symbol:  "HOTDOG",
+		})
 
 		return bankGenState
 	})
@@ -446,6 +464,10 @@ func appendMarkers(a []markertypes.MarkerAccount, toAdd ...*sdktypes.Any) []mark
 }
 
 func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
+	// Access lists are sorted by address bytes.
+	hotdogAdmins := slices.Clone(s.accountAddresses[:3])
+	slices.SortFunc(hotdogAdmins, func(a, b sdk.AccAddress) int { return bytes.Compare(a, b) })
+
 	testCases := []struct {
 		name           string
 		cmd            *cobra.Command
@@ -467,7 +489,19 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"testcoin",
 				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
 			},
-			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"8","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[]}}`,
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"8","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[]},"resolved_id":{"denom":"testcoin","address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq"},"owned_scopes":[],"owned_scopes_pagination":null}`,
+		},
+		{
+			name:           "get testcoin marker with owned scopes",
+			cmd:            markercli.MarkerCmd(),
+			args:           []string{"testcoin", "--" + markercli.FlagOwnedScopes, fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
+			expectedOutput: `{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"8","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[]},"resolved_id":{"denom":"testcoin","address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq"},"owned_scopes":[],"owned_scopes_pagination":{"next_key":null,"total":"0"}}`,
+		},
+		{
+			name:           "get markers by denom",
+			cmd:            markercli.MarkersByDenomCmd(),
+			args:           []string{"nosuchcoin,testcoin", fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
+			expectedOutput: `{"markers":[{"denom":"testcoin","marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"8","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[]}}],"not_found":["nosuchcoin"]}`,
 		},
 		{
 			"get testcoin marker test",
@@ -492,7 +526,12 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
   required_attributes: []
   status: MARKER_STATUS_ACTIVE
   supply: "1000"
-  supply_fixed: true`,
+  supply_fixed: true
+owned_scopes: []
+owned_scopes_pagination: null
+resolved_id:
+  address: cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq
+  denom: testcoin`,
 		},
 		{
 			"query non existent marker",
@@ -509,7 +548,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"lockedcoin",
 				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
 			},
-			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2","pub_key":null,"account_number":"9","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"lockedcoin","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[]}}`,
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2","pub_key":null,"account_number":"9","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"lockedcoin","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[]},"resolved_id":{"denom":"lockedcoin","address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2"},"owned_scopes":[],"owned_scopes_pagination":null}`,
 		},
 		{
 			"get restricted coin marker with forced transfer",
@@ -534,7 +573,12 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
   required_attributes: []
   status: MARKER_STATUS_ACTIVE
   supply: "3000"
-  supply_fixed: false`,
+  supply_fixed: false
+owned_scopes: []
+owned_scopes_pagination: null
+resolved_id:
+  address: cosmos1ae2206l700zfkxyqvd6cwn3gddas3rjy6z6g4u
+  denom: ` + s.holderDenom,
 		},
 		{
 			"query access",
@@ -544,13 +588,117 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			},
 			"accounts: []",
 		},
+		{
+			name:           "get authzhotdog marker json",
+			cmd:            markercli.MarkerCmd(),
+			args:           []string{"authzhotdog", fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
+			expectedOutput: `{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1avvqh2lfu8j9uhaq65ktqmy7dxv9epxc0a3tc0","pub_key":null,"account_number":"12","sequence":"0"},"manager":"","access_control":[{"address":"` + hotdogAdmins[0].String() + `","permissions":["ACCESS_TRANSFER","ACCESS_ADMIN"]},{"address":"` + hotdogAdmins[1].String() + `","permissions":["ACCESS_TRANSFER","ACCESS_ADMIN"]},{"address":"` + hotdogAdmins[2].String() + `","permissions":["ACCESS_TRANSFER","ACCESS_ADMIN"]}],"status":"MARKER_STATUS_ACTIVE","denom":"authzhotdog","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[]},"resolved_id":{"denom":"authzhotdog","address":"cosmos1avvqh2lfu8j9uhaq65ktqmy7dxv9epxc0a3tc0"},"owned_scopes":[],"owned_scopes_pagination":null}`,
+		},
+		{
+			name:           "get authzhotdog marker display json",
+			cmd:            markercli.MarkerCmd(),
+			args:           []string{"authzhotdog", "--" + display.FlagDisplay, fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
+			expectedOutput: `{"marker":{"access_control":[{"address":"` + hotdogAdmins[0].String() + `","permissions":["transfer","admin"]},{"address":"` + hotdogAdmins[1].String() + `","permissions":["transfer","admin"]},{"address":"` + hotdogAdmins[2].String() + `","permissions":["transfer","admin"]}],"account_number":"12","address":"cosmos1avvqh2lfu8j9uhaq65ktqmy7dxv9epxc0a3tc0","allow_forced_transfer":false,"allow_governance_control":false,"denom":"authzhotdog","manager":"","marker_type":"restricted","pub_key":null,"required_attributes":[],"sequence":"0","status":"active","supply":"1000","supply_fixed":true},"owned_scopes":[],"owned_scopes_pagination":null,"resolved_id":{"address":"cosmos1avvqh2lfu8j9uhaq65ktqmy7dxv9epxc0a3tc0","denom":"authzhotdog"}}`,
+		},
+		{
+			name: "get authzhotdog marker display text",
+			cmd:  markercli.MarkerCmd(),
+			args: []string{"authzhotdog", "--" + display.FlagDisplay},
+			expectedOutput: `marker:
+  access_control:
+  - address: ` + hotdogAdmins[0].String() + `
+    permissions:
+    - transfer
+    - admin
+  - address: ` + hotdogAdmins[1].String() + `
+    permissions:
+    - transfer
+    - admin
+  - address: ` + hotdogAdmins[2].String() + `
+    permissions:
+    - transfer
+    - admin
+  account_number: "12"
+  address: cosmos1avvqh2lfu8j9uhaq65ktqmy7dxv9epxc0a3tc0
+  allow_forced_transfer: false
+  allow_governance_control: false
+  denom: authzhotdog
+  manager: ""
+  marker_type: restricted
+  pub_key: null
+  required_attributes: []
+  sequence: "0"
+  status: active
+  supply: "1000"
+  supply_fixed: true
+owned_scopes: []
+owned_scopes_pagination: null
+resolved_id:
+  address: cosmos1avvqh2lfu8j9uhaq65ktqmy7dxv9epxc0a3tc0
+  denom: authzhotdog`,
+		},
+		{
+			name: "query authzhotdog access",
+			cmd:  markercli.MarkerAccessCmd(),
+			args: []string{"authzhotdog"},
+			expectedOutput: `accounts:
+- address: ` + hotdogAdmins[0].String() + `
+  permissions:
+  - ACCESS_TRANSFER
+  - ACCESS_ADMIN
+- address: ` + hotdogAdmins[1].String() + `
+  permissions:
+  - ACCESS_TRANSFER
+  - ACCESS_ADMIN
+- address: ` + hotdogAdmins[2].String() + `
+  permissions:
+  - ACCESS_TRANSFER
+  - ACCESS_ADMIN`,
+		},
+		{
+			name: "query authzhotdog access display",
+			cmd:  markercli.MarkerAccessCmd(),
+			args: []string{"authzhotdog", "--" + display.FlagDisplay},
+			expectedOutput: `accounts:
+- address: ` + hotdogAdmins[0].String() + `
+  permissions:
+  - transfer
+  - admin
+- address: ` + hotdogAdmins[1].String() + `
+  permissions:
+  - transfer
+  - admin
+- address: ` + hotdogAdmins[2].String() + `
+  permissions:
+  - transfer
+  - admin`,
+		},
+		{
+			name: "query authzhotdog escrow display",
+			cmd:  markercli.MarkerEscrowCmd(),
+			args: []string{"authzhotdog", "--" + display.FlagDisplay},
+			expectedOutput: `escrow:
+- 8hotdog
+metadata: []
+resolved_id:
+  address: cosmos1avvqh2lfu8j9uhaq65ktqmy7dxv9epxc0a3tc0
+  denom: authzhotdog`,
+		},
 		{
 			"query escrow",
 			markercli.MarkerEscrowCmd(),
 			[]string{
 				s.cfg.BondDenom,
 			},
-			"escrow: []",
+			fmt.Sprintf("escrow: []\nmetadata: []\nresolved_id:\n  address: %s\n  denom: %s",
+				markertypes.MustGetMarkerAddress(s.cfg.BondDenom), s.cfg.BondDenom),
+		},
+		{
+			name: "query escrow with resolve metadata",
+			cmd:  markercli.MarkerEscrowCmd(),
+			args: []string{s.cfg.BondDenom, "--" + markercli.FlagResolveMetadata},
+			expectedOutput: fmt.Sprintf("escrow: []\nmetadata: []\nresolved_id:\n  address: %s\n  denom: %s",
+				markertypes.MustGetMarkerAddress(s.cfg.BondDenom), s.cfg.BondDenom),
 		},
 		{
 			"query supply",
@@ -558,7 +706,9 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			[]string{
 				s.cfg.BondDenom,
 			},
-			fmt.Sprintf("amount:\n  amount: \"%s\"\n  denom: %s", s.cfg.BondedTokens.MulRaw(int64(s.cfg.NumValidators)), s.cfg.BondDenom),
+			fmt.Sprintf("amount:\n  amount: \"%s\"\n  denom: %s\nresolved_id:\n  address: %s\n  denom: %s",
+				s.cfg.BondedTokens.MulRaw(int64(s.cfg.NumValidators)), s.cfg.BondDenom,
+				markertypes.MustGetMarkerAddress(s.cfg.BondDenom), s.cfg.BondDenom),
 		},
 		{
 			name:           "account data",
@@ -566,11 +716,36 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			args:           []string{s.holderDenom},
 			expectedOutput: "value: Do not sell this coin.",
 		},
+		{
+			name: "account data by addresses",
+			cmd:  markercli.AccountDataByAddressesCmd(),
+			args: []string{
+				"--" + markercli.FlagAddress, markertypes.MustGetMarkerAddress(s.holderDenom).String(),
+				"--" + markercli.FlagAddress, s.accountAddresses[0].String(),
+				"--" + markercli.FlagAddress, "bad",
+			},
+			expectedOutput: "entries:\n" +
+				"- address: " + markertypes.MustGetMarkerAddress(s.holderDenom).String() + "\n  denom: " + s.holderDenom + "\n  error: \"\"\n  value: Do not sell this coin.\n" +
+				"- address: " + s.accountAddresses[0].String() + "\n  denom: \"\"\n  error: not a marker account\n  value: \"\"\n" +
+				"- address: bad\n  denom: \"\"\n  error: 'invalid address: decoding bech32 failed: invalid bech32 string length 3'\n  value: \"\"",
+		},
 		{
 			name:           "marker net asset value query",
 			cmd:            markercli.NetAssetValuesCmd(),
 			args:           []string{"testcoin"},
-			expectedOutput: "net_asset_values:\n- price:\n    amount: \"100\"\n    denom: usd\n  updated_block_height: \"0\"\n  volume: \"100\"",
+			expectedOutput: "net_asset_values:\n- price:\n    amount: \"100\"\n    denom: usd\n  updated_block_height: \"0\"\n  volume: \"100\"\npagination:\n  next_key: null\n  total: \"0\"",
+		},
+		{
+			name:           "marker net asset value query with matching price denom",
+			cmd:            markercli.NetAssetValuesCmd(),
+			args:           []string{"testcoin", "--" + markercli.FlagPriceDenoms, "eur,usd"},
+			expectedOutput: "net_asset_values:\n- price:\n    amount: \"100\"\n    denom: usd\n  updated_block_height: \"0\"\n  volume: \"100\"\npagination:\n  next_key: null\n  total: \"0\"",
+		},
+		{
+			name:           "marker net asset value query without matching price denom",
+			cmd:            markercli.NetAssetValuesCmd(),
+			args:           []string{"testcoin", "--" + markercli.FlagPriceDenoms, "eur"},
+			expectedOutput: "net_asset_values: []\npagination:\n  next_key: null\n  total: \"0\"",
 		},
 	}
 	for _, tc := range testCases {
@@ -584,6 +759,169 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestAllMarkersFilters() {
+	asJson := fmt.Sprintf("--%s=json", cmtcli.OutputFlag)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expErr    string
+		expStatus markertypes.MarkerStatus
+		expType   markertypes.MarkerType
+		expDenoms []string
+		notDenoms []string
+		expCost   bool
+	}{
+		{
+			name:      "status argument",
+			args:      []string{"active"},
+			expStatus: markertypes.StatusActive,
+			expDenoms: []string{"testcoin", "lockedcoin"},
+		},
+		{
+			name:      "type restricted",
+			args:      []string{"--" + markercli.FlagType, "restricted"},
+			expType:   markertypes.MarkerType_RestrictedCoin,
+			expDenoms: []string{"lockedcoin"},
+			notDenoms: []string{"testcoin"},
+		},
+		{
+			name:      "status and type full names",
+			args:      []string{"--" + markercli.FlagStatus, "marker_status_active", "--" + markercli.FlagType, "MARKER_TYPE_COIN"},
+			expStatus: markertypes.StatusActive,
+			expType:   markertypes.MarkerType_Coin,
+			expDenoms: []string{"testcoin"},
+			notDenoms: []string{"lockedcoin"},
+		},
+		{
+			name:      "status number",
+			args:      []string{"--" + markercli.FlagStatus, "5"},
+			expStatus: markertypes.StatusDestroyed,
+			notDenoms: []string{"testcoin", "lockedcoin"},
+		},
+		{
+			name:   "unknown status",
+			args:   []string{"--" + markercli.FlagStatus, "burnt"},
+			expErr: `invalid marker status "burnt": expected one of proposed, finalized, active, cancelled, destroyed`,
+		},
+		{
+			name:   "unknown type",
+			args:   []string{"--" + markercli.FlagType, "9"},
+			expErr: `invalid marker type "9": expected one of coin, restricted`,
+		},
+		{
+			name:   "status argument and flag",
+			args:   []string{"active", "--" + markercli.FlagStatus, "active"},
+			expErr: "the status cannot be provided both as an argument and with --status",
+		},
+		{
+			name:      "include cost",
+			args:      []string{"active", "--" + markercli.FlagIncludeCost},
+			expStatus: markertypes.StatusActive,
+			expDenoms: []string{"testcoin", "lockedcoin"},
+			expCost:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			clientCtx := s.testnet.Validators[0].ClientCtx
+			args := append(tc.args, asJson)
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.AllMarkersCmd(), args)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "AllMarkersCmd error")
+				return
+			}
+			s.Require().NoError(err, "AllMarkersCmd error")
+
+			var result markertypes.QueryAllMarkersResponse
+			s.Require().NoError(s.cfg.Codec.UnmarshalJSON(out.Bytes(), &result), "unmarshal AllMarkersCmd output")
+			markers := appendMarkers(nil, result.Markers...)
+			denoms := make([]string, len(markers))
+			for i, marker := range markers {
+				denoms[i] = marker.Denom
+				if tc.expStatus != markertypes.StatusUndefined {
+					s.Assert().Equal(tc.expStatus.String(), marker.Status.String(), "%s status", marker.Denom)
+				}
+				if tc.expType != markertypes.MarkerType_Unknown {
+					s.Assert().Equal(tc.expType.String(), marker.MarkerType.String(), "%s marker type", marker.Denom)
+				}
+			}
+			for _, denom := range tc.expDenoms {
+				s.Assert().Contains(denoms, denom, "listed markers")
+			}
+			for _, denom := range tc.notDenoms {
+				s.Assert().NotContains(denoms, denom, "listed markers")
+			}
+			if tc.expCost {
+				if s.Assert().NotNil(result.Cost, "cost") {
+					s.Assert().GreaterOrEqual(int(result.Cost.KeysVisited), len(markers), "cost keys visited")
+				}
+			} else {
+				s.Assert().Nil(result.Cost, "cost")
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestQueryParamsFromGenesis() {
+	writeGenesis := func(name, appState string) string {
+		genFile := filepath.Join(s.T().TempDir(), name)
+		contents := `{"chain_id":"genesis-params-test","app_state":` + appState + `}`
+		s.Require().NoError(os.WriteFile(genFile, []byte(contents), 0o600), "WriteFile(%q)", name)
+		return genFile
+	}
+
+	testCases := []struct {
+		name     string
+		genFile  string
+		expOut   []string
+		expInErr []string
+	}{
+		{
+			name:    "testnet genesis",
+			genFile: s.testnet.Validators[0].Ctx.Config.GenesisFile(),
+			expOut: []string{
+				"counts:\n",
+				"  markers: 20\n",
+				"  net_asset_value_bounds: 0\n",
+				"params:\n",
+				"  enable_governance: true\n",
+				"  max_supply: \"1000000\"\n",
+			},
+		},
+		{
+			name:     "no marker section",
+			genFile:  writeGenesis("no-marker.json", `{"bank":{}}`),
+			expInErr: []string{"module section not found in genesis", "does not have a marker section"},
+		},
+		{
+			name:     "malformed marker section",
+			genFile:  writeGenesis("bad-marker.json", `{"marker":{"params":"bad"}}`),
+			expInErr: []string{"invalid marker section in genesis file"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := markercli.QueryParamsCmd()
+			args := []string{"--" + provcli.FlagGenesis, tc.genFile, "--output", "text"}
+			out, err := clitestutil.ExecTestCLICmd(s.testnet.Validators[0].ClientCtx, cmd, args)
+			if len(tc.expInErr) > 0 {
+				s.Require().Error(err, "ExecTestCLICmd error")
+				for _, exp := range tc.expInErr {
+					s.Assert().ErrorContains(err, exp, "ExecTestCLICmd error")
+				}
+				return
+			}
+			s.Require().NoError(err, "ExecTestCLICmd error")
+			for _, exp := range tc.expOut {
+				s.Assert().Contains(out.String(), exp, "ExecTestCLICmd output")
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestMarkerTxCommands() {
 	testCases := []struct {
 		name         string
@@ -2136,6 +2474,66 @@ func TestParseBoolStrict(t *testing.T) {
 	}
 }
 
+func TestParseMarkerID(t *testing.T) {
+	tests := []struct {
+		name   string
+		arg    string
+		exp    string
+		expErr string
+	}{
+		{name: "empty", arg: "", expErr: "marker id cannot be empty: provide a marker address or denom"},
+		{name: "whitespace only", arg: " \t  ", expErr: "marker id cannot be empty: provide a marker address or denom"},
+		{name: "just nft prefix", arg: "nft/", expErr: "marker id cannot be empty: provide a marker address or denom"},
+		{name: "denom", arg: "nhash", exp: "nhash"},
+		{name: "denom with whitespace", arg: "  nhash ", exp: "nhash"},
+		{name: "denom case is kept", arg: "MyCoin", exp: "MyCoin"},
+		{name: "nft prefix", arg: "nft/mycoin", exp: "mycoin"},
+		{name: "nft prefix with whitespace", arg: " nft/ mycoin ", exp: "mycoin"},
+		{name: "address", arg: "cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq", exp: "cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq"},
+		{name: "other slash denom", arg: "ibc/ABCDEF", exp: "ibc/ABCDEF"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := markercli.ParseMarkerID(tc.arg)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ParseMarkerID(%q) error", tc.arg)
+			} else {
+				assert.NoError(t, err, "ParseMarkerID(%q) error", tc.arg)
+			}
+			assert.Equal(t, tc.exp, actual, "ParseMarkerID(%q) result", tc.arg)
+		})
+	}
+}
+
+func TestMarkerQueryCmdsEmptyID(t *testing.T) {
+	cmdMakers := []func() *cobra.Command{
+		markercli.AllHoldersCmd,
+		markercli.MarkerCmd,
+		markercli.MarkerAccessCmd,
+		markercli.MarkerEscrowCmd,
+		markercli.MarkerSupplyCmd,
+		markercli.AccountDataCmd,
+		markercli.NetAssetValuesCmd,
+		markercli.RecommendedGrantsCmd,
+	}
+	args := []string{"", "   "}
+	expErr := "marker id cannot be empty: provide a marker address or denom"
+
+	for _, cmdMaker := range cmdMakers {
+		for _, arg := range args {
+			cmd := cmdMaker()
+			t.Run(fmt.Sprintf("%s %q", cmd.Name(), arg), func(t *testing.T) {
+				cmd.SetArgs([]string{arg})
+				cmd.SetOut(io.Discard)
+				cmd.SetErr(io.Discard)
+				err := cmd.Execute()
+				assert.EqualError(t, err, expErr, "%s %q error", cmd.Name(), arg)
+			})
+		}
+	}
+}
+
 func (s *IntegrationTestSuite) TestSupplyDecreaseProposal() {
 	testCases := []struct {
 		name         string
@@ -2,15 +2,22 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
 
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	"github.com/provenance-io/provenance/internal/display"
+	"github.com/provenance-io/provenance/internal/provcli"
 	"github.com/provenance-io/provenance/x/marker/types"
 )
 
@@ -27,12 +34,29 @@ func GetQueryCmd() *cobra.Command {
 		QueryParamsCmd(),
 		AllMarkersCmd(),
 		AllHoldersCmd(),
+		HoldingDiffCmd(),
+		HolderCountCmd(),
 		MarkerCmd(),
+		MarkersByDenomCmd(),
 		MarkerAccessCmd(),
+		AccessGrantsByAddressCmd(),
+		MarkersByRequiredAttributeCmd(),
+		HoldingByAddressCmd(),
 		MarkerEscrowCmd(),
 		MarkerSupplyCmd(),
 		AccountDataCmd(),
+		AccountDataByAddressesCmd(),
+		AccountDataHistoryAvailableCmd(),
 		NetAssetValuesCmd(),
+		CanSetNetAssetValueCmd(),
+		LastAdminCheckCmd(),
+		RecommendedGrantsCmd(),
+		TransferRulesCmd(),
+		RestrictionTraceCmd(),
+		DenomMetadataProblemsCmd(),
+		ConvertValueCmd(),
+		MarkerValueCmd(),
+		AllMarkersValueCmd(),
 	)
 	return queryCmd
 }
@@ -42,37 +66,81 @@ func QueryParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "params",
 		Short: "Query the current marker parameters",
-		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the current marker parameters.
+If --genesis is provided, the params (and the number of each type of entry) are read from that genesis file instead.`),
+		Args: cobra.NoArgs,
 		Example: strings.TrimSpace(
-			fmt.Sprintf(`$ %s query marker params`, version.AppName)),
+			fmt.Sprintf(`$ %[1]s query marker params
+$ %[1]s query marker params --genesis exported-genesis.json`, version.AppName)),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			if genFile, _ := cmd.Flags().GetString(provcli.FlagGenesis); len(genFile) > 0 {
+				return outputGenesisParams(clientCtx, genFile)
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(&res.Params)
+			return printQueryResponse(cmd, clientCtx, &res.Params)
 		},
 	}
 
+	provcli.AddGenesisFlagToCmd(cmd)
+	display.AddDisplayFlagToCmd(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// outputGenesisParams reads the marker genesis state from the genesis file and outputs its params and entry counts.
+func outputGenesisParams(clientCtx client.Context, genFile string) error {
+	var genState types.GenesisState
+	if err := provcli.ReadModuleGenesis(clientCtx.Codec, genFile, types.ModuleName, &genState); err != nil {
+		return err
+	}
+	if err := genState.Validate(); err != nil {
+		return fmt.Errorf("invalid %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return provcli.PrintGenesisParams(clientCtx, &genState.Params, map[string]int{
+		"markers":                len(genState.Markers),
+		"net_asset_values":       len(genState.NetAssetValues),
+		"deny_send_addresses":    len(genState.DenySendAddresses),
+		"holding_thresholds":     len(genState.HoldingThresholds),
+		"net_asset_value_bounds": len(genState.NetAssetValueBounds),
+	})
+}
+
 // AllMarkersCmd is the CLI command for listing all marker module registrations.
 func AllMarkersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list [status, optional]",
 		Short: "List all marker registrations on the Provenance Blockchain",
+		Long: fmt.Sprintf(`List all marker registrations on the Provenance Blockchain.
+
+The markers can be limited to those with a specific status, provided either as an argument or with --%[1]s.
+The status can be one of: %[3]s.
+
+The markers can be limited to those of a specific type using --%[2]s.
+The type can be one of: %[4]s.
+
+The status and type can also be provided as the full enum name (e.g. MARKER_STATUS_ACTIVE) or its number.
+Names are case-insensitive.
+
+Use --%[5]s to include the number of store keys visited and the time spent handling the query.`,
+			FlagStatus, FlagType, strings.Join(markerStatusFilterNames(), ", "), strings.Join(markerTypeFilterNames(), ", "), FlagIncludeCost),
 		Example: strings.TrimSpace(
-			fmt.Sprintf(`$ %s query marker list`, version.AppName)),
+			fmt.Sprintf(`$ %[1]s query marker list
+$ %[1]s query marker list active
+$ %[1]s query marker list --%[2]s active --%[3]s coin
+$ %[1]s query marker list --%[2]s proposed --%[3]s restricted`, version.AppName, FlagStatus, FlagType)),
 		Args: cobra.RangeArgs(0, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -86,28 +154,58 @@ func AllMarkersCmd() *cobra.Command {
 				return err
 			}
 
-			var status types.MarkerStatus
+			statusStr, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
 			if len(args) > 0 {
-				status, err = types.MarkerStatusFromString(args[0])
+				if len(statusStr) > 0 {
+					return fmt.Errorf("the status cannot be provided both as an argument and with --%s", FlagStatus)
+				}
+				statusStr = args[0]
+			}
+			var status types.MarkerStatus
+			if len(statusStr) > 0 {
+				status, err = parseMarkerStatusFilter(statusStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			typeStr, err := cmd.Flags().GetString(FlagType)
+			if err != nil {
+				return err
+			}
+			var markerType types.MarkerType
+			if len(typeStr) > 0 {
+				markerType, err = parseMarkerTypeFilter(typeStr)
 				if err != nil {
-					fmt.Printf("expected one of 'proposed,finalized,active,cancelled,destroyed\n")
 					return err
 				}
 			}
 
+			includeCost, err := cmd.Flags().GetBool(FlagIncludeCost)
+			if err != nil {
+				return err
+			}
+
 			var response *types.QueryAllMarkersResponse
 			if response, err = queryClient.AllMarkers(
 				context.Background(),
-				&types.QueryAllMarkersRequest{Status: status, Pagination: pageReq},
+				&types.QueryAllMarkersRequest{Status: status, MarkerType: markerType, Pagination: pageReq, IncludeCost: includeCost},
 			); err != nil {
 				fmt.Printf("failed to query markers: %s\n", err.Error())
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return printQueryResponse(cmd, clientCtx, response)
 		},
 	}
 
+	cmd.Flags().String(FlagStatus, "", "Only list markers with this status")
+	cmd.Flags().String(FlagType, "", "Only list markers of this type")
+	cmd.Flags().Bool(FlagIncludeCost, false, "Include the number of store keys visited and the time spent handling the query")
 	flags.AddPaginationFlagsToCmd(cmd, "markers")
+	display.AddDisplayFlagToCmd(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -118,36 +216,190 @@ func AllHoldersCmd() *cobra.Command {
 		Use:     "holding [denom]",
 		Aliases: []string{"hold", "holder"},
 		Short:   "List all accounts holding the given marker on the Provenance Blockchain",
+		Long: strings.TrimSpace(`List all accounts holding the given marker on the Provenance Blockchain.
+
+Use --` + FlagExcludeModuleAccounts + ` to omit module and marker accounts (e.g. the marker's escrow),
+--` + FlagExcludeAddresses + ` to omit specific accounts (e.g. ibc transfer escrow accounts),
+and --` + FlagMinAmount + ` to omit accounts with small balances.
+
+Use --` + FlagHasAttribute + ` or --` + FlagMissingAttribute + ` to only list accounts that have (or are missing) an attribute.
+The attribute can start with "*." to match any attribute ending with the rest of it, e.g. "*.kyc.pb".
+When filtering by attribute, at most 50 accounts are listed per page.
+
+Use --` + FlagResolveMetadata + ` to include details about the metadata address of a metadata denom (e.g. nft/scope1...).
+
+Use --` + flags.FlagCountTotal + ` to include the total number of (matching) holders, regardless of the page limit.
+
+Use --` + FlagIncludeCost + ` to include the number of store keys visited and the time spent handling the query.`),
 		Example: strings.TrimSpace(
-			fmt.Sprintf(`$ %s query marker holding nhash`, version.AppName)),
+			fmt.Sprintf(`$ %[1]s query marker holding nhash
+$ %[1]s query marker holding nhash --%[2]s --%[3]s 1000
+$ %[1]s query marker holding nhash --%[4]s --limit 10
+$ %[1]s query marker holding restrictedcoin --%[5]s kyc.provenance.io`,
+				version.AppName, FlagExcludeModuleAccounts, FlagMinAmount, flags.FlagCountTotal, FlagMissingAttribute)),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := ParseMarkerID(args[0])
+			if err != nil {
+				return err
+			}
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
-			id := strings.ToLower(strings.TrimSpace(args[0]))
 			queryClient := types.NewQueryClient(clientCtx)
 			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
 			if err != nil {
 				return err
 			}
+			req := &types.QueryHoldingRequest{
+				Id:         id,
+				Pagination: pageReq,
+			}
+			if req.ExcludeModuleAccounts, err = cmd.Flags().GetBool(FlagExcludeModuleAccounts); err != nil {
+				return err
+			}
+			if req.ExcludedAddresses, err = cmd.Flags().GetStringSlice(FlagExcludeAddresses); err != nil {
+				return err
+			}
+			if req.MinAmount, err = cmd.Flags().GetString(FlagMinAmount); err != nil {
+				return err
+			}
+			if req.ResolveMetadata, err = cmd.Flags().GetBool(FlagResolveMetadata); err != nil {
+				return err
+			}
+			if req.IncludeCost, err = cmd.Flags().GetBool(FlagIncludeCost); err != nil {
+				return err
+			}
+			if req.Attribute, err = cmd.Flags().GetString(FlagHasAttribute); err != nil {
+				return err
+			}
+			if len(req.Attribute) > 0 {
+				req.AttributeMode = types.AttributeFilterMode_Has
+			}
+			missingAttr, err := cmd.Flags().GetString(FlagMissingAttribute)
+			if err != nil {
+				return err
+			}
+			if len(missingAttr) > 0 {
+				req.Attribute = missingAttr
+				req.AttributeMode = types.AttributeFilterMode_Missing
+			}
 			var response *types.QueryHoldingResponse
-			if response, err = queryClient.Holding(
+			if response, err = queryClient.Holding(context.Background(), req); err != nil {
+				fmt.Printf("failed to query blockchain balances for \"%s\": %v\n", id, err)
+				return nil
+			}
+			return printQueryResponse(cmd, clientCtx, response)
+		},
+	}
+
+	cmd.Flags().Bool(FlagExcludeModuleAccounts, false, "Omit module and marker accounts from the results")
+	cmd.Flags().StringSlice(FlagExcludeAddresses, nil, "Addresses to omit from the results (comma-separated)")
+	cmd.Flags().String(FlagMinAmount, "", "Omit accounts holding less than this amount")
+	cmd.Flags().Bool(FlagResolveMetadata, false, "Include details about the metadata address of a metadata denom")
+	cmd.Flags().Bool(FlagIncludeCost, false, "Include the number of store keys visited and the time spent handling the query")
+	cmd.Flags().String(FlagHasAttribute, "", "Only list accounts that have this attribute")
+	cmd.Flags().String(FlagMissingAttribute, "", "Only list accounts that do not have this attribute")
+	cmd.MarkFlagsMutuallyExclusive(FlagHasAttribute, FlagMissingAttribute)
+	flags.AddPaginationFlagsToCmd(cmd, "markers")
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// HolderCountCmd is the CLI command for getting the number of accounts holding a marker.
+func HolderCountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "holder-count [address|denom]",
+		Aliases: []string{"holdercount", "hc"},
+		Short:   "Get the number of accounts holding a marker",
+		Long: `Get the number of accounts holding a marker on the Provenance Blockchain.
+
+The response also includes the total amount held by accounts other than the marker's escrow account.`,
+		Example: fmt.Sprintf(`$ %s query marker holder-count nhash`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := ParseMarkerID(args[0])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			var response *types.QueryHolderCountResponse
+			if response, err = queryClient.HolderCount(
+				context.Background(),
+				&types.QueryHolderCountRequest{Id: id},
+			); err != nil {
+				fmt.Printf("failed to query holder count of \"%s\": %v\n", id, err)
+				return nil
+			}
+			return printQueryResponse(cmd, clientCtx, response)
+		},
+	}
+
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// HoldingDiffCmd is the CLI command for listing the accounts whose holdings of a marker differ between two heights.
+func HoldingDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "holding-diff [address|denom] [height-a] [height-b]",
+		Aliases: []string{"holdingdiff", "hd"},
+		Short:   "List the accounts whose holdings of a marker differ between two heights",
+		Long: `List the accounts whose holdings of a marker differ between two heights.
+
+Accounts are listed as added (only holding at height-b), removed (only holding at height-a),
+or changed (holding at both heights, but different amounts), with the amounts held at each height.
+The node being queried must still have the state for both heights (i.e. they must not be pruned).`,
+		Example: fmt.Sprintf(`$ %s query marker holding-diff nhash 1000 2000`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := ParseMarkerID(args[0])
+			if err != nil {
+				return err
+			}
+			heightA, err := strconv.ParseInt(strings.TrimSpace(args[1]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height-a %q: %w", args[1], err)
+			}
+			heightB, err := strconv.ParseInt(strings.TrimSpace(args[2]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height-b %q: %w", args[2], err)
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			var response *types.QueryHoldingDiffResponse
+			if response, err = queryClient.HoldingDiff(
 				context.Background(),
-				&types.QueryHoldingRequest{
+				&types.QueryHoldingDiffRequest{
 					Id:         id,
+					HeightA:    heightA,
+					HeightB:    heightB,
 					Pagination: pageReq,
 				},
 			); err != nil {
-				fmt.Printf("failed to query blockchain balances for \"%s\": %v\n", id, err)
+				fmt.Printf("failed to query holding diff of \"%s\" between heights %d and %d: %v\n", id, heightA, heightB, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return printQueryResponse(cmd, clientCtx, response)
 		},
 	}
 
-	flags.AddPaginationFlagsToCmd(cmd, "markers")
+	flags.AddPaginationFlagsToCmd(cmd, "holding changes")
+	display.AddDisplayFlagToCmd(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -155,30 +407,95 @@ func AllHoldersCmd() *cobra.Command {
 // MarkerCmd is the CLI command for querying marker module registrations.
 func MarkerCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "get [address|denom]",
-		Short:   "Get marker details",
-		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
-		Example: fmt.Sprintf(`$ %s query marker get "nhash"`, version.AppName),
-		Args:    cobra.ExactArgs(1),
+		Use:   "get [address|denom]",
+		Short: "Get marker details",
+		Long: strings.TrimSpace(`Get marker details.
+
+Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name.
+
+Use --` + FlagOwnedScopes + ` to include the scopes that the marker account is the value owner of.
+The pagination flags apply to those scopes, and at most 100 are returned at a time.`),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %[1]s query marker get "nhash"
+$ %[1]s query marker get "mymarker" --%[2]s`, version.AppName, FlagOwnedScopes)),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := ParseMarkerID(args[0])
+			if err != nil {
+				return err
+			}
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 			queryClient := types.NewQueryClient(clientCtx)
-			id := strings.TrimSpace(args[0])
+
+			req := &types.QueryMarkerRequest{Id: id}
+			if req.IncludeOwnedScopes, err = cmd.Flags().GetBool(FlagOwnedScopes); err != nil {
+				return err
+			}
+			if req.IncludeOwnedScopes {
+				if req.OwnedScopesPagination, err = client.ReadPageRequestWithPageKeyDecoded(cmd.Flags()); err != nil {
+					return err
+				}
+			}
 
 			var response *types.QueryMarkerResponse
-			if response, err = queryClient.Marker(
-				context.Background(),
-				&types.QueryMarkerRequest{Id: id},
-			); err != nil {
+			if response, err = queryClient.Marker(context.Background(), req); err != nil {
 				fmt.Printf("failed to query marker \"%s\" details: %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return printQueryResponse(cmd, clientCtx, response)
+		},
+	}
+	cmd.Flags().Bool(FlagOwnedScopes, false, "Include the scopes that the marker account is the value owner of")
+	flags.AddPaginationFlagsToCmd(cmd, "owned scopes")
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkersByDenomCmd is the CLI command for querying several markers by denom.
+func MarkersByDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "markers-by-denom <denom1>[,<denom2>,...]",
+		Short:   "Get several markers by denom",
+		Aliases: []string{"markersbydenom", "mbd"},
+		Long: fmt.Sprintf(`Get several markers by denom.
+
+The denoms are provided as a single comma-separated argument. At most %d denoms can be provided.
+The markers are listed in the order requested. A denom without a marker is listed in not_found instead of failing the whole query.`,
+			types.MaxMarkersByDenom),
+		Example: fmt.Sprintf(`$ %s query marker markers-by-denom nhash,bananas,apples`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var denoms []string
+			for _, denom := range strings.Split(args[0], ",") {
+				denom = strings.TrimSpace(denom)
+				if len(denom) > 0 {
+					denoms = append(denoms, denom)
+				}
+			}
+			if len(denoms) == 0 {
+				return errors.New("at least one denom must be provided")
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryMarkersByDenomRequest{Denoms: denoms}
+			resp, err := queryClient.MarkersByDenom(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query markers by denom: %w", err)
+			}
+
+			return printQueryResponse(cmd, clientCtx, resp)
 		},
 	}
+
+	display.AddDisplayFlagToCmd(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -191,12 +508,15 @@ func MarkerAccessCmd() *cobra.Command {
 		Example: fmt.Sprintf(`$ %s query marker grants "nhash"`, version.AppName),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := ParseMarkerID(args[0])
+			if err != nil {
+				return err
+			}
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 			queryClient := types.NewQueryClient(clientCtx)
-			id := strings.ToLower(strings.TrimSpace(args[0]))
 
 			var response *types.QueryAccessResponse
 			if response, err = queryClient.Access(
@@ -206,9 +526,157 @@ func MarkerAccessCmd() *cobra.Command {
 				fmt.Printf("failed to query marker \"%s\" for access control list: %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return printQueryResponse(cmd, clientCtx, response)
+		},
+	}
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// AccessGrantsByAddressCmd is the CLI command for querying the markers that an address has access on.
+func AccessGrantsByAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "grants-by-address <address> [permission]",
+		Aliases: []string{"grantsbyaddress", "gba"},
+		Short:   "List the markers that an address has access grants on",
+		Long: `List the markers that an address has access grants on, with the permissions it has on each.
+
+If a permission is provided (e.g. withdraw), only markers where the address has that permission are listed.`,
+		Example: strings.TrimSpace(fmt.Sprintf(`$ %[1]s query marker grants-by-address pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %[1]s query marker grants-by-address pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk withdraw`, version.AppName)),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(args[0]))
+			if err != nil {
+				return fmt.Errorf("invalid address %q: %w", args[0], err)
+			}
+			permission := types.Access_Unknown
+			if len(args) > 1 {
+				permission = types.AccessByName(args[1])
+				if permission == types.Access_Unknown {
+					return fmt.Errorf("invalid permission %q", args[1])
+				}
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			var response *types.QueryAccessGrantsByAddressResponse
+			if response, err = queryClient.AccessGrantsByAddress(
+				context.Background(),
+				&types.QueryAccessGrantsByAddressRequest{
+					Address:    addr.String(),
+					Permission: permission,
+					Pagination: pageReq,
+				},
+			); err != nil {
+				fmt.Printf("failed to query access grants of \"%s\": %v\n", addr, err)
+				return nil
+			}
+			return printQueryResponse(cmd, clientCtx, response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "grants")
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkersByRequiredAttributeCmd is the CLI command for querying the restricted markers that require an attribute.
+func MarkersByRequiredAttributeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "by-required-attribute <attribute>",
+		Aliases: []string{"byrequiredattribute", "bra"},
+		Short:   "List the restricted markers with a required attribute satisfied by an attribute",
+		Long: `List the restricted markers with a required attribute satisfied by an attribute, with the required attribute that matched.
+
+Wildcard required attributes are matched too, e.g. an attribute named kyc.provenance.io satisfies a required attribute of *.provenance.io.`,
+		Example: fmt.Sprintf(`$ %s query marker by-required-attribute kyc.provenance.io`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			var response *types.QueryMarkersByRequiredAttributeResponse
+			if response, err = queryClient.MarkersByRequiredAttribute(
+				context.Background(),
+				&types.QueryMarkersByRequiredAttributeRequest{
+					Attribute:  strings.TrimSpace(args[0]),
+					Pagination: pageReq,
+				},
+			); err != nil {
+				fmt.Printf("failed to query markers requiring \"%s\": %v\n", args[0], err)
+				return nil
+			}
+			return printQueryResponse(cmd, clientCtx, response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "markers")
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// HoldingByAddressCmd is the CLI command for listing the markers that an address holds coins of.
+func HoldingByAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "holding-by-address <address>",
+		Aliases: []string{"holdingbyaddress", "hba"},
+		Short:   "List the markers that an address holds coins of",
+		Long: strings.TrimSpace(`List the markers that an address holds coins of, with the balance, marker status, marker type,
+and the permissions the address has on each.
+
+Use --` + FlagIncludeAccess + ` to also include each marker's full access control list.`),
+		Example: strings.TrimSpace(fmt.Sprintf(`$ %[1]s query marker holding-by-address pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %[1]s query marker holding-by-address pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --%[2]s`, version.AppName, FlagIncludeAccess)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(args[0]))
+			if err != nil {
+				return fmt.Errorf("invalid address %q: %w", args[0], err)
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryHoldingByAddressRequest{
+				Address:    addr.String(),
+				Pagination: pageReq,
+			}
+			if req.IncludeAccess, err = cmd.Flags().GetBool(FlagIncludeAccess); err != nil {
+				return err
+			}
+			var response *types.QueryHoldingByAddressResponse
+			if response, err = queryClient.HoldingByAddress(context.Background(), req); err != nil {
+				fmt.Printf("failed to query marker holdings of \"%s\": %v\n", addr, err)
+				return nil
+			}
+			return printQueryResponse(cmd, clientCtx, response)
 		},
 	}
+
+	cmd.Flags().Bool(FlagIncludeAccess, false, "Include each marker's full access control list")
+	flags.AddPaginationFlagsToCmd(cmd, "holdings")
+	display.AddDisplayFlagToCmd(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -216,29 +684,42 @@ func MarkerAccessCmd() *cobra.Command {
 // MarkerEscrowCmd is the CLI command for querying marker module registrations.
 func MarkerEscrowCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "escrow [address|denom]",
-		Short:   "Get coins in escrow by marker",
-		Example: fmt.Sprintf(`$ %s query marker escrow "nhash"`, version.AppName),
-		Args:    cobra.ExactArgs(1),
+		Use:   "escrow [address|denom]",
+		Short: "Get coins in escrow by marker",
+		Long: strings.TrimSpace(`Get coins in escrow by marker.
+
+Use --` + FlagResolveMetadata + ` to include details about the metadata address of each escrowed metadata denom (e.g. nft/scope1...).`),
+		Example: fmt.Sprintf(`$ %[1]s query marker escrow "nhash"
+$ %[1]s query marker escrow "nhash" --%[2]s`, version.AppName, FlagResolveMetadata),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := ParseMarkerID(args[0])
+			if err != nil {
+				return err
+			}
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 			queryClient := types.NewQueryClient(clientCtx)
-			id := strings.ToLower(strings.TrimSpace(args[0]))
+			resolveMetadata, err := cmd.Flags().GetBool(FlagResolveMetadata)
+			if err != nil {
+				return err
+			}
 
 			var response *types.QueryEscrowResponse
 			if response, err = queryClient.Escrow(
 				context.Background(),
-				&types.QueryEscrowRequest{Id: id},
+				&types.QueryEscrowRequest{Id: id, ResolveMetadata: resolveMetadata},
 			); err != nil {
 				fmt.Printf("failed to query marker \"%s\" for escrow balances: %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return printQueryResponse(cmd, clientCtx, response)
 		},
 	}
+	cmd.Flags().Bool(FlagResolveMetadata, false, "Include details about the metadata address of each escrowed metadata denom")
+	display.AddDisplayFlagToCmd(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -251,12 +732,15 @@ func MarkerSupplyCmd() *cobra.Command {
 		Example: fmt.Sprintf(`$ %s query marker supply "nhash"`, version.AppName),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := ParseMarkerID(args[0])
+			if err != nil {
+				return err
+			}
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 			queryClient := types.NewQueryClient(clientCtx)
-			id := strings.ToLower(strings.TrimSpace(args[0]))
 
 			var response *types.QuerySupplyResponse
 			if response, err = queryClient.Supply(
@@ -266,9 +750,10 @@ func MarkerSupplyCmd() *cobra.Command {
 				fmt.Printf("failed to query marker \"%s\" for total supply configuration: %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return printQueryResponse(cmd, clientCtx, response)
 		},
 	}
+	display.AddDisplayFlagToCmd(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -282,12 +767,15 @@ func AccountDataCmd() *cobra.Command {
 		Example: fmt.Sprintf(`$ %s query marker account-data nhash`, version.AppName),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			denom, err := ParseMarkerID(args[0])
+			if err != nil {
+				return err
+			}
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 			queryClient := types.NewQueryClient(clientCtx)
-			denom := strings.TrimSpace(args[0])
 
 			req := &types.QueryAccountDataRequest{Denom: denom}
 			resp, err := queryClient.AccountData(context.Background(), req)
@@ -295,42 +783,596 @@ func AccountDataCmd() *cobra.Command {
 				return fmt.Errorf("failed to query account data for marker %q: %w", denom, err)
 			}
 
-			return clientCtx.PrintProto(resp)
+			return printQueryResponse(cmd, clientCtx, resp)
 		},
 	}
 
+	display.AddDisplayFlagToCmd(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
-// NetAssetValuesCmd is the CLI command for querying a marker's net asset values.
-func NetAssetValuesCmd() *cobra.Command {
+// AccountDataByAddressesCmd is the CLI command for querying the account data of several marker addresses.
+func AccountDataByAddressesCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "net-asset-values [address|denom]",
-		Aliases: []string{"nav", "navs"},
-		Short:   "Get marker's net asset values'",
-		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
-		Example: fmt.Sprintf(`$ %s query marker net-asset-values "nhash"`, version.AppName),
+		Use:     "account-data-by-addresses --" + FlagAddress + " <address> [--" + FlagAddress + " <address> ...]",
+		Short:   "Get the account data of several markers by address",
+		Aliases: []string{"accountdatabyaddresses", "adba"},
+		Long: fmt.Sprintf(`Get the account data of several markers by address.
+
+Each address is provided using its own --%[1]s flag. At most %[2]d addresses can be provided.
+An address that is not for a marker account has an error in its entry instead of failing the whole query.`,
+			FlagAddress, types.MaxAccountDataAddresses),
+		Example: fmt.Sprintf(`$ %[1]s query marker account-data-by-addresses --%[2]s pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --%[2]s pb1tg6gf8hmxrf7s0mz6ph6h2f2jh4dtqv8ktcluh`,
+			version.AppName, FlagAddress),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			addresses, err := cmd.Flags().GetStringArray(FlagAddress)
+			if err != nil {
+				return err
+			}
+			if len(addresses) == 0 {
+				return fmt.Errorf("at least one --%s must be provided", FlagAddress)
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAccountDataByAddressesRequest{Addresses: addresses}
+			resp, err := queryClient.AccountDataByAddresses(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query account data by addresses: %w", err)
+			}
+
+			return printQueryResponse(cmd, clientCtx, resp)
+		},
+	}
+
+	cmd.Flags().StringArray(FlagAddress, nil, "A marker address to get the account data of (can be repeated)")
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// AccountDataHistoryAvailableCmd is the CLI command for querying whether previous account data values of a marker are retained.
+func AccountDataHistoryAvailableCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account-data-history-available <denom>",
+		Short:   "Get whether previous values of a marker's account data are retained",
+		Aliases: []string{"accountdatahistoryavailable", "adha"},
+		Example: fmt.Sprintf(`$ %s query marker account-data-history-available nhash`, version.AppName),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			denom, err := ParseMarkerID(args[0])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAccountDataHistoryAvailableRequest{Denom: denom}
+			resp, err := queryClient.AccountDataHistoryAvailable(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query account data history availability for marker %q: %w", denom, err)
+			}
+
+			return printQueryResponse(cmd, clientCtx, resp)
+		},
+	}
+
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// NetAssetValuesCmd is the CLI command for querying a marker's net asset values.
+func NetAssetValuesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "net-asset-values [address|denom]",
+		Aliases: []string{"nav", "navs"},
+		Short:   "Get marker's net asset values'",
+		Long: `Get marker's net asset values, ordered by price denom.
+
+Use --` + FlagPriceDenoms + ` to only get the net asset values with a price in specific denoms.
+Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: strings.TrimSpace(fmt.Sprintf(`$ %[1]s query marker net-asset-values "nhash"
+$ %[1]s query marker net-asset-values "nhash" --%[2]s usd`, version.AppName, FlagPriceDenoms)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := ParseMarkerID(args[0])
+			if err != nil {
+				return err
+			}
+			priceDenoms, err := cmd.Flags().GetStringSlice(FlagPriceDenoms)
+			if err != nil {
+				return err
+			}
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 			queryClient := types.NewQueryClient(clientCtx)
-			id := strings.TrimSpace(args[0])
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			var response *types.QueryNetAssetValuesResponse
 			if response, err = queryClient.NetAssetValues(
 				context.Background(),
-				&types.QueryNetAssetValuesRequest{Id: id},
+				&types.QueryNetAssetValuesRequest{Id: id, PriceDenoms: priceDenoms, Pagination: pageReq},
 			); err != nil {
 				fmt.Printf("failed to query marker %q net asset values details: %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return printQueryResponse(cmd, clientCtx, response)
+		},
+	}
+	cmd.Flags().StringSlice(FlagPriceDenoms, nil, "Only get the net asset values with a price in these denoms (comma-separated)")
+	flags.AddPaginationFlagsToCmd(cmd, "net asset values")
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CanSetNetAssetValueCmd is the CLI command for checking a net asset value against a marker's net asset value bounds.
+func CanSetNetAssetValueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "can-set-net-asset-value [address|denom] <price>,<volume>",
+		Aliases: []string{"can-set-nav", "csnav"},
+		Short:   "Check whether a net asset value would be allowed by a marker's net asset value bounds",
+		Long: `Check whether a net asset value would be allowed by a marker's net asset value bounds.
+
+The response includes the change in the per-unit value from the marker's current net asset value
+with the same price denom (as a percent), and the marker's max allowed change (in basis points).`,
+		Example: fmt.Sprintf(`$ %s query marker can-set-net-asset-value "hotdogcoin" 1000usd,1`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := ParseMarkerID(args[0])
+			if err != nil {
+				return err
+			}
+			navs, err := ParseNetAssetValueString(args[1])
+			if err != nil {
+				return err
+			}
+			if len(navs) != 1 {
+				return fmt.Errorf("expected exactly one net asset value, got %d", len(navs))
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryCanSetNetAssetValueResponse
+			if response, err = queryClient.CanSetNetAssetValue(
+				context.Background(),
+				&types.QueryCanSetNetAssetValueRequest{Id: id, NetAssetValue: navs[0]},
+			); err != nil {
+				fmt.Printf("failed to check marker %q net asset value: %v\n", id, err)
+				return nil
+			}
+			return printQueryResponse(cmd, clientCtx, response)
+		},
+	}
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// LastAdminCheckCmd is the CLI command for checking whether revoking an address's access would remove a marker's last admin.
+func LastAdminCheckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "last-admin-check [address|denom] <address>",
+		Aliases: []string{"lac"},
+		Short:   "Check whether revoking an address's access would remove a marker's last admin",
+		Long: `Check whether revoking an address's access would remove the last admin access grant from a marker.
+
+Such a revocation is rejected for active markers that are not controlled by governance unless the
+revoke transaction is made with --` + FlagAllowLastAdminRemoval + `.`,
+		Example: fmt.Sprintf(`$ %s query marker last-admin-check "hotdogcoin" pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := ParseMarkerID(args[0])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryLastAdminCheckResponse
+			if response, err = queryClient.LastAdminCheck(
+				context.Background(),
+				&types.QueryLastAdminCheckRequest{Id: id, Address: args[1]},
+			); err != nil {
+				fmt.Printf("failed to check marker %q last admin: %v\n", id, err)
+				return nil
+			}
+			return printQueryResponse(cmd, clientCtx, response)
+		},
+	}
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// RecommendedGrantsCmd is the CLI command for querying the permissions a marker is likely missing.
+func RecommendedGrantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "recommended-grants [address|denom]",
+		Aliases: []string{"recommendedgrants", "rg"},
+		Short:   "Get the access permissions typically needed to operate a marker that no one has",
+		Long: `Get the access permissions typically needed to operate a marker that are not granted to any address.
+
+The recommendations are based on the marker's type, status, and flags. They are advisory only,
+a marker can be valid and usable without any of them.`,
+		Example: fmt.Sprintf(`$ %s query marker recommended-grants "nhash"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := ParseMarkerID(args[0])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryRecommendedGrantsResponse
+			if response, err = queryClient.RecommendedGrants(
+				context.Background(),
+				&types.QueryRecommendedGrantsRequest{Id: id},
+			); err != nil {
+				fmt.Printf("failed to query marker %q recommended grants: %v\n", id, err)
+				return nil
+			}
+			return printQueryResponse(cmd, clientCtx, response)
+		},
+	}
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// TransferRulesCmd is the CLI command for querying the settings that control how a marker's funds can be transferred.
+func TransferRulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-rules [address|denom]",
+		Aliases: []string{"transferrules", "tr"},
+		Short:   "Get the settings that control how a marker's funds can be transferred",
+		Long: fmt.Sprintf(`Get the settings that control how a marker's funds can be transferred.
+
+The result has the marker's type, whether it allows forced transfers, its required attributes,
+and whether the bank module allows sends of its denom.
+If the --%[1]s flag is provided, the result also has whether that address has transfer access on the marker.`,
+			FlagForAddress),
+		Example: fmt.Sprintf(`$ %[1]s query marker transfer-rules "nhash"
+$ %[1]s query marker transfer-rules "nhash" --%[2]s pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`,
+			version.AppName, FlagForAddress),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := ParseMarkerID(args[0])
+			if err != nil {
+				return err
+			}
+			addr, err := cmd.Flags().GetString(FlagForAddress)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryMarkerTransferRulesResponse
+			if response, err = queryClient.MarkerTransferRules(
+				context.Background(),
+				&types.QueryMarkerTransferRulesRequest{Id: id, Address: addr},
+			); err != nil {
+				fmt.Printf("failed to query marker %q transfer rules: %v\n", id, err)
+				return nil
+			}
+			return printQueryResponse(cmd, clientCtx, response)
+		},
+	}
+	cmd.Flags().String(FlagForAddress, "", "An address to check for transfer access on the marker")
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// RestrictionTraceCmd is the CLI command for querying the send restriction decisions recorded for a recent transaction.
+func RestrictionTraceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "restriction-trace <tx-hash>",
+		Aliases: []string{"restrictiontrace", "rtrace"},
+		Short:   "Get the send restriction decisions recorded for a recent transaction",
+		Long: `Get the send restriction decisions recorded for a recent transaction.
+
+Traces are only recorded for transfers rejected by the marker send restriction, and only when the node
+being queried has marker.restriction-trace enabled in its app config. They are kept in memory on that node,
+and only the most recent ones are kept.`,
+		Example: fmt.Sprintf(`$ %[1]s query marker restriction-trace 0A0AF2BAE9E44D7D4F3C9DBE5B1C3B2C1F3E4D5C6B7A8F9E0D1C2B3A4F5E6D7C`,
+			version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryRestrictionTraceResponse
+			if response, err = queryClient.RestrictionTrace(
+				context.Background(),
+				&types.QueryRestrictionTraceRequest{TxHash: args[0]},
+			); err != nil {
+				fmt.Printf("failed to query restriction trace for tx %q: %v\n", args[0], err)
+				return nil
+			}
+			return printQueryResponse(cmd, clientCtx, response)
+		},
+	}
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DenomMetadataProblemsCmd is the CLI command for listing markers with missing or inconsistent denom metadata.
+func DenomMetadataProblemsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-metadata-problems",
+		Aliases: []string{"denommetadataproblems", "dmp"},
+		Short:   "List markers whose denom metadata is missing or inconsistent",
+		Long: `List markers whose denom metadata is missing or inconsistent.
+
+A problem is reported when a marker has no denom metadata, the metadata's base isn't the marker's denom,
+the metadata's display denom is empty, or the denom units don't lead from the base to the display denom.
+Pagination is applied to the markers that are checked, so a page can have any number of problems.`,
+		Example: fmt.Sprintf(`$ %s query marker denom-metadata-problems`, version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryDenomMetadataProblemsResponse
+			if response, err = queryClient.DenomMetadataProblems(
+				context.Background(),
+				&types.QueryDenomMetadataProblemsRequest{Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query denom metadata problems: %v\n", err)
+				return nil
+			}
+			return printQueryResponse(cmd, clientCtx, response)
 		},
 	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "markers")
+	display.AddDisplayFlagToCmd(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// ConvertValueCmd is the CLI command for converting an amount into another denom using net asset values.
+func ConvertValueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "convert-value [amount] [target-denom]",
+		Aliases: []string{"convertvalue", "cv"},
+		Short:   "Convert an amount into another denom using the recorded net asset values",
+		Long: fmt.Sprintf(`Convert an amount into another denom using the recorded net asset values.
+
+Net asset values are chained together as needed (e.g. from a marker's nhash nav, then nhash's usd nav).
+The path with the fewest hops is used, and the value is only truncated once, at the end of the calculation.
+If --%[1]s is not provided, %[2]d is used. It cannot be more than %[3]d.`,
+			FlagMaxHops, types.DefaultConvertValueMaxHops, types.MaxConvertValueHops),
+		Example: fmt.Sprintf(`$ %s query marker convert-value 1000mycoin usd --%s 2`, version.AppName, FlagMaxHops),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[0], err)
+			}
+			maxHops, err := cmd.Flags().GetUint32(FlagMaxHops)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryConvertValueResponse
+			if response, err = queryClient.ConvertValue(
+				context.Background(),
+				&types.QueryConvertValueRequest{Amount: amount, TargetDenom: strings.TrimSpace(args[1]), MaxHops: maxHops},
+			); err != nil {
+				fmt.Printf("failed to convert %s to %s: %v\n", amount, args[1], err)
+				return nil
+			}
+			return printQueryResponse(cmd, clientCtx, response)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagMaxHops, 0, "The maximum number of net asset values to chain together")
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkerValueCmd is the CLI command for querying the value of a marker's supply in a price denom.
+func MarkerValueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "value [address|denom] [price-denom]",
+		Aliases: []string{"marker-value", "mv"},
+		Short:   "Get the value of a marker's full supply in a price denom",
+		Long: `Get the value of a marker's full supply in a price denom.
+
+The value is the marker's current supply times the price of its net asset value in the price denom,
+divided by that net asset value's volume (truncated).`,
+		Example: fmt.Sprintf(`$ %s query marker value mycoin usd`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := ParseMarkerID(args[0])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryMarkerValueResponse
+			if response, err = queryClient.MarkerValue(
+				context.Background(),
+				&types.QueryMarkerValueRequest{Id: id, PriceDenom: strings.TrimSpace(args[1])},
+			); err != nil {
+				fmt.Printf("failed to query marker %q value in %s: %v\n", id, args[1], err)
+				return nil
+			}
+			return printQueryResponse(cmd, clientCtx, response)
+		},
+	}
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// AllMarkersValueCmd is the CLI command for querying the total value of all markers in a price denom.
+func AllMarkersValueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "total-value [price-denom]",
+		Aliases: []string{"totalvalue", "tv"},
+		Short:   "Get the total value of all markers' full supplies in a price denom",
+		Long: `Get the total value of all markers' full supplies in a price denom.
+
+Only markers with a net asset value in the price denom are included. The others are listed as skipped.`,
+		Example: fmt.Sprintf(`$ %s query marker total-value usd`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryAllMarkersValueResponse
+			if response, err = queryClient.AllMarkersValue(
+				context.Background(),
+				&types.QueryAllMarkersValueRequest{PriceDenom: strings.TrimSpace(args[0])},
+			); err != nil {
+				fmt.Printf("failed to query total marker value in %s: %v\n", args[0], err)
+				return nil
+			}
+			return printQueryResponse(cmd, clientCtx, response)
+		},
+	}
+	display.AddDisplayFlagToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// displayOptions are the display renderer options used for marker query responses.
+var displayOptions = []display.Option{
+	display.WithEnum("ACCESS_", types.Access_value),
+	display.WithEnum("MARKER_STATUS_", types.MarkerStatus_value),
+	display.WithEnum("MARKER_TYPE_", types.MarkerType_value),
+}
+
+// parseMarkerStatusFilter converts the provided string into a MarkerStatus for filtering a query.
+// It can be the short name (e.g. "active"), the full name (e.g. "MARKER_STATUS_ACTIVE"), or the number (e.g. "3").
+// Names are case-insensitive. The unspecified status is not allowed.
+func parseMarkerStatusFilter(str string) (types.MarkerStatus, error) {
+	val, ok := parseEnumFilter(str, "MARKER_STATUS_", types.MarkerStatus_value, types.MarkerStatus_name)
+	if !ok || types.MarkerStatus(val) == types.StatusUndefined {
+		return types.StatusUndefined, fmt.Errorf("invalid marker status %q: expected one of %s",
+			str, strings.Join(markerStatusFilterNames(), ", "))
+	}
+	return types.MarkerStatus(val), nil
+}
+
+// parseMarkerTypeFilter converts the provided string into a MarkerType for filtering a query.
+// It can be the short name (e.g. "coin"), the full name (e.g. "MARKER_TYPE_COIN"), or the number (e.g. "1").
+// Names are case-insensitive. The unspecified type is not allowed.
+func parseMarkerTypeFilter(str string) (types.MarkerType, error) {
+	val, ok := parseEnumFilter(str, "MARKER_TYPE_", types.MarkerType_value, types.MarkerType_name)
+	if !ok {
+		if markerType, err := types.MarkerTypeFromString(strings.TrimSpace(str)); err == nil {
+			val, ok = int32(markerType), true
+		}
+	}
+	if !ok || types.MarkerType(val) == types.MarkerType_Unknown {
+		return types.MarkerType_Unknown, fmt.Errorf("invalid marker type %q: expected one of %s",
+			str, strings.Join(markerTypeFilterNames(), ", "))
+	}
+	return types.MarkerType(val), nil
+}
+
+// parseEnumFilter converts the provided string into the value of an enum with the provided prefix, values, and names.
+// The string can be the name with or without the prefix (case-insensitive), or the number.
+// The second return value is false if the string does not identify a known value.
+func parseEnumFilter(str, prefix string, values map[string]int32, names map[int32]string) (int32, bool) {
+	str = strings.TrimSpace(str)
+	if num, err := strconv.ParseInt(str, 10, 32); err == nil {
+		_, ok := names[int32(num)]
+		return int32(num), ok
+	}
+	upper := strings.ToUpper(str)
+	if val, ok := values[upper]; ok {
+		return val, true
+	}
+	val, ok := values[prefix+upper]
+	return val, ok
+}
+
+// markerStatusFilterNames gets the short names of the marker statuses that can be used to filter a query.
+func markerStatusFilterNames() []string {
+	return []string{"proposed", "finalized", "active", "cancelled", "destroyed"}
+}
+
+// markerTypeFilterNames gets the short names of the marker types that can be used to filter a query.
+func markerTypeFilterNames() []string {
+	return []string{"coin", "restricted"}
+}
+
+// printQueryResponse prints a query response as proto JSON, or in its display form if the --display flag was provided.
+func printQueryResponse(cmd *cobra.Command, clientCtx client.Context, resp proto.Message) error {
+	return display.PrintProto(cmd, clientCtx, resp, displayOptions...)
+}
+
+// ParseMarkerID cleans up the provided marker id (address or denom) argument so that it can be given to a query.
+// Leading and trailing whitespace is removed, and an accidental "nft/" prefix is removed (with a warning).
+// Otherwise, the id is left as-is so that the server can decide how to resolve it.
+func ParseMarkerID(arg string) (string, error) {
+	id := strings.TrimSpace(arg)
+	if trimmed, found := strings.CutPrefix(id, "nft/"); found {
+		id = strings.TrimSpace(trimmed)
+		if len(id) > 0 {
+			fmt.Fprintf(os.Stderr, "Warning: ignoring \"nft/\" prefix, using marker id %q\n", id)
+		}
+	}
+	if len(id) == 0 {
+		return "", errors.New("marker id cannot be empty: provide a marker address or denom")
+	}
+	return id, nil
+}
@@ -56,6 +56,22 @@ const (
 	FlagUsdMills               = "usd-mills"
 	FlagVolume                 = "volume"
 	FlagTargetAddress          = "target-address"
+	FlagMaxHops                = "max-hops"
+	FlagExcludeModuleAccounts  = "exclude-module-accounts"
+	FlagExcludeAddresses       = "exclude-addresses"
+	FlagMinAmount              = "min-amount"
+	FlagPriceDenoms            = "price-denoms"
+	FlagIncludeAccess          = "include-access"
+	FlagBypassBounds           = "bypass-bounds"
+	FlagAddress                = "address"
+	FlagStatus                 = "status"
+	FlagResolveMetadata        = "resolve-metadata"
+	FlagOwnedScopes            = "owned-scopes"
+	FlagIncludeCost            = "include-cost"
+	FlagAllowLastAdminRemoval  = "allow-last-admin-removal"
+	FlagHasAttribute           = "has-attribute"
+	FlagMissingAttribute       = "missing-attribute"
+	FlagForAddress             = "for-address"
 )
 
 // NewTxCmd returns the top-level command for marker CLI transactions.
@@ -90,6 +106,8 @@ func NewTxCmd() *cobra.Command {
 		GetCmdSetAccountData(),
 		GetCmdUpdateSendDenyListRequest(),
 		GetCmdAddNetAssetValues(),
+		GetCmdSetHoldingThresholds(),
+		GetCmdSetNetAssetValueBounds(),
 		GetCmdSupplyDecreaseProposal(),
 		GetCmdSupplyIncreaseProposal(),
 		GetCmdSetAdministratorProposal(),
@@ -375,7 +393,9 @@ func GetCmdDeleteAccess() *cobra.Command {
 		Args:    cobra.ExactArgs(2),
 		Short:   "Revoke all access to a marker for the address",
 		Long: strings.TrimSpace(`Revoke all administrative access to a marker for given access.
-From Address must have appropriate existing access.`),
+From Address must have appropriate existing access.
+Revoking the last admin access grant from an active marker that is not controlled by governance
+is rejected unless --` + FlagAllowLastAdminRemoval + ` is provided.`),
 		Example: fmt.Sprintf(`$ %s tx marker revoke pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom --from mykey`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -389,9 +409,14 @@ From Address must have appropriate existing access.`),
 			}
 			callerAddr := clientCtx.GetFromAddress()
 			msg := types.NewDeleteAccessRequest(args[1], callerAddr, targetAddr)
+			msg.AllowLastAdminRemoval, err = cmd.Flags().GetBool(FlagAllowLastAdminRemoval)
+			if err != nil {
+				return err
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().Bool(FlagAllowLastAdminRemoval, false, "allow revoking the last admin access grant from the marker")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1050,6 +1075,49 @@ $ %[1]s tx marker account-data hotdogcoin --%[4]s`,
 	return cmd
 }
 
+// GetCmdSetHoldingThresholds returns a CLI command for setting a marker's holding thresholds.
+func GetCmdSetHoldingThresholds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-holding-thresholds <denom> [<basis points> ...]",
+		Aliases: []string{"holding-thresholds", "sht"},
+		Short:   "Set the holding concentration thresholds of a marker",
+		Long: fmt.Sprintf(`Set the holding concentration thresholds of a marker.
+
+Each threshold is a share of the marker's supply in basis points (e.g. 2500 = 25%%).
+Whenever a send, mint, or burn causes an account's share to cross one of the thresholds,
+an EventMarkerHoldingThresholdCrossed event is emitted.
+
+Thresholds must be between 1 and %[1]d and are provided in ascending order.
+At most %[2]d thresholds can be provided. Providing none removes all of the marker's thresholds.
+The signer must have admin access on the marker.
+`, types.BasisPointsPerWhole-1, types.MaxHoldingThresholds),
+		Example: fmt.Sprintf(`$ %[1]s tx %[2]s set-holding-thresholds hotdogcoin 2500 5000
+$ %[1]s tx %[2]s set-holding-thresholds hotdogcoin`, version.AppName, types.ModuleName),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom := strings.TrimSpace(args[0])
+			var basisPoints []uint32
+			for _, arg := range args[1:] {
+				bp, err := strconv.ParseUint(arg, 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid holding threshold %q: %w", arg, err)
+				}
+				basisPoints = append(basisPoints, uint32(bp))
+			}
+
+			msg := types.NewMsgSetHoldingThresholdsRequest(denom, basisPoints, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdAddNetAssetValues returns a CLI command for adding/updating marker net asset values.
 func GetCmdAddNetAssetValues() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1101,13 +1169,63 @@ desired precision.
 				return err
 			}
 
+			flagSet := cmd.Flags()
 			denom := strings.TrimSpace(args[0])
 			netAssetValues, err := ParseNetAssetValueString(args[1])
 			if err != nil {
 				return err
 			}
 
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), types.NewMsgAddNetAssetValuesRequest(denom, clientCtx.GetFromAddress().String(), netAssetValues))
+			msg := types.NewMsgAddNetAssetValuesRequest(denom, clientCtx.GetFromAddress().String(), netAssetValues)
+			msg.BypassBounds, err = flagSet.GetBool(FlagBypassBounds)
+			if err != nil {
+				return err
+			}
+
+			setAdmin := func(admin string) {
+				msg.Administrator = admin
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, setAdmin, msg)
+		},
+	}
+	cmd.Flags().Bool(FlagBypassBounds, false, "skip the marker's net asset value bounds (only allowed with --"+FlagGovProposal+")")
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetNetAssetValueBounds returns a CLI command for setting a marker's net asset value bounds.
+func GetCmdSetNetAssetValueBounds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-net-asset-value-bounds <denom> <max change basis points>",
+		Aliases: []string{"set-nav-bounds", "snavb"},
+		Short:   "Set the net asset value bounds of a marker",
+		Long: `Set the net asset value bounds of a marker.
+
+The max change is the largest change (in basis points, e.g. 2500 = 25%) allowed in the per-unit
+value of a net asset value when it is updated, compared to the marker's current net asset value
+with the same price denom. Updates outside of the bounds are rejected unless submitted by governance
+with --` + FlagBypassBounds + `. A max change of 0 removes the marker's net asset value bounds.
+The signer must have admin access on the marker.
+`,
+		Example: fmt.Sprintf(`$ %[1]s tx %[2]s set-net-asset-value-bounds hotdogcoin 2500
+$ %[1]s tx %[2]s set-net-asset-value-bounds hotdogcoin 0`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom := strings.TrimSpace(args[0])
+			maxChange, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid max change %q: %w", args[1], err)
+			}
+
+			msg := types.NewMsgSetNetAssetValueBoundsRequest(denom, uint32(maxChange), clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
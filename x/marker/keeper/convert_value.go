@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// navPathStep is a denom reached while looking for a conversion path, and how it was reached.
+type navPathStep struct {
+	// denom is the denom reached by this step.
+	denom string
+	// prev is the index of the step that this one came from, or -1 for the starting denom.
+	prev int
+	// nav is the net asset value (of the prev step's denom) that leads to this denom.
+	nav types.NetAssetValue
+}
+
+// ConvertValueThroughNAVs converts the provided amount into the target denom by chaining together recorded net asset values.
+// Returns the converted value and the net asset values that were used (in the order they were applied).
+//
+// The path used is the one with the fewest hops (no more than maxHops). If there are several such paths, the one
+// with the lexically smallest sequence of denoms is used. A net asset value that leads back to a denom that has
+// already been reached (i.e. a cycle) is never followed.
+//
+// To avoid compounding rounding errors, the value is calculated as amount * (product of prices) / (product of volumes),
+// and only truncated (toward zero) once at the end.
+func (k Keeper) ConvertValueThroughNAVs(ctx sdk.Context, amount sdk.Coin, targetDenom string, maxHops int) (sdk.Coin, []types.NetAssetValue, error) {
+	if err := amount.Validate(); err != nil {
+		return sdk.Coin{}, nil, fmt.Errorf("invalid amount: %w", err)
+	}
+	if err := sdk.ValidateDenom(targetDenom); err != nil {
+		return sdk.Coin{}, nil, fmt.Errorf("invalid target denom: %w", err)
+	}
+	if maxHops < 1 {
+		return sdk.Coin{}, nil, fmt.Errorf("invalid max hops %d: must be at least 1", maxHops)
+	}
+	if amount.Denom == targetDenom {
+		return amount, nil, nil
+	}
+
+	navs, err := k.findNAVPath(ctx, amount.Denom, targetDenom, maxHops)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	num := new(big.Int).Set(amount.Amount.BigInt())
+	den := big.NewInt(1)
+	for _, nav := range navs {
+		num.Mul(num, nav.Price.Amount.BigInt())
+		den.Mul(den, new(big.Int).SetUint64(nav.Volume))
+	}
+	num.Quo(num, den)
+	if num.BitLen() > sdkmath.MaxBitLen {
+		return sdk.Coin{}, nil, fmt.Errorf("converted value of %s in %s is too large", amount, targetDenom)
+	}
+
+	return sdk.NewCoin(targetDenom, sdkmath.NewIntFromBigInt(num)), navs, nil
+}
+
+// findNAVPath does a breadth-first search through the recorded net asset values for a way to get from one denom to another.
+// Net asset values are read in price denom order, so the first path found is the shortest, with ties going to the lexically first.
+func (k Keeper) findNAVPath(ctx sdk.Context, fromDenom, toDenom string, maxHops int) ([]types.NetAssetValue, error) {
+	steps := []navPathStep{{denom: fromDenom, prev: -1}}
+	reached := map[string]bool{fromDenom: true}
+	cycles := 0
+
+	levelStart := 0
+	for hop := 1; hop <= maxHops && levelStart < len(steps); hop++ {
+		levelEnd := len(steps)
+		for i := levelStart; i < levelEnd; i++ {
+			if err := checkQueryDeadline(ctx); err != nil {
+				return nil, err
+			}
+
+			markerAddr, err := types.MarkerAddress(steps[i].denom)
+			if err != nil {
+				// Not a valid marker denom (e.g. a price-only denom), so it can't have any navs.
+				continue
+			}
+
+			found := -1
+			err = k.IterateNetAssetValues(ctx, markerAddr, func(nav types.NetAssetValue) bool {
+				if nav.Volume == 0 {
+					return false
+				}
+				if reached[nav.Price.Denom] {
+					if leadsBackTo(steps, i, nav.Price.Denom) {
+						cycles++
+					}
+					return false
+				}
+				reached[nav.Price.Denom] = true
+				steps = append(steps, navPathStep{denom: nav.Price.Denom, prev: i, nav: nav})
+				if nav.Price.Denom == toDenom {
+					found = len(steps) - 1
+					return true
+				}
+				return false
+			})
+			if err != nil {
+				return nil, fmt.Errorf("could not read net asset values of %q: %w", steps[i].denom, err)
+			}
+			if found >= 0 {
+				return navPathTo(steps, found), nil
+			}
+		}
+		levelStart = levelEnd
+	}
+
+	if cycles > 0 {
+		return nil, fmt.Errorf("no conversion path from %q to %q found within %d hop(s) (ignored %d cyclic net asset value(s))",
+			fromDenom, toDenom, maxHops, cycles)
+	}
+	return nil, fmt.Errorf("no conversion path from %q to %q found within %d hop(s)", fromDenom, toDenom, maxHops)
+}
+
+// leadsBackTo returns true if the provided denom was reached on the way to the step at the provided index.
+func leadsBackTo(steps []navPathStep, i int, denom string) bool {
+	for ; i >= 0; i = steps[i].prev {
+		if steps[i].denom == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// navPathTo gets the net asset values needed to get from the starting denom to the denom of the step at the provided index.
+func navPathTo(steps []navPathStep, i int) []types.NetAssetValue {
+	var rv []types.NetAssetValue
+	for ; steps[i].prev >= 0; i = steps[i].prev {
+		rv = append(rv, steps[i].nav)
+	}
+	for l, r := 0, len(rv)-1; l < r; l, r = l+1, r-1 {
+		rv[l], rv[r] = rv[r], rv[l]
+	}
+	return rv
+}
@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestConvertValueThroughNAVs(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	newNAV := func(price string, volume uint64) types.NetAssetValue {
+		coin, err := sdk.ParseCoinNormalized(price)
+		require.NoError(t, err, "ParseCoinNormalized(%q)", price)
+		return types.NewNetAssetValue(coin, volume)
+	}
+	addMarker := func(denom string, navs ...types.NetAssetValue) {
+		marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+			*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint}),
+		})
+		marker.Supply = sdkmath.NewInt(1000)
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker(%q)", denom)
+		for _, nav := range navs {
+			require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, marker, nav, "test"), "SetNetAssetValue(%q, %s)", denom, nav.Price)
+		}
+	}
+
+	// acoin -> hashcoin -> usd
+	addMarker("hashcoin", newNAV("7usd", 2))
+	addMarker("acoin", newNAV("10hashcoin", 3))
+	// bcoin -> ccoin -> usd and bcoin -> hashcoin -> usd are both two hops.
+	addMarker("ccoin", newNAV("3usd", 1))
+	addMarker("bcoin", newNAV("2ccoin", 1), newNAV("4hashcoin", 1))
+	// dcoin -> usd is direct, even though dcoin -> hashcoin is lexically first.
+	addMarker("dcoin", newNAV("1hashcoin", 1), newNAV("5usd", 1))
+	// xcoin -> ycoin -> xcoin is a cycle without any way to usd.
+	addMarker("xcoin", newNAV("1ycoin", 1))
+	addMarker("ycoin", newNAV("1xcoin", 1))
+
+	tests := []struct {
+		name     string
+		amount   string
+		target   string
+		maxHops  int
+		expValue string
+		expNAVs  []types.NetAssetValue
+		expErr   string
+	}{
+		{
+			name:     "direct",
+			amount:   "9acoin",
+			target:   "hashcoin",
+			maxHops:  1,
+			expValue: "30hashcoin",
+			expNAVs:  []types.NetAssetValue{newNAV("10hashcoin", 3)},
+		},
+		{
+			name:     "two hops",
+			amount:   "9acoin",
+			target:   "usd",
+			maxHops:  3,
+			expValue: "105usd",
+			expNAVs:  []types.NetAssetValue{newNAV("10hashcoin", 3), newNAV("7usd", 2)},
+		},
+		{
+			// Truncating after each hop would give 1 * 10 / 3 = 3, then 3 * 7 / 2 = 10.
+			name:     "two hops only truncated at the end",
+			amount:   "1acoin",
+			target:   "usd",
+			maxHops:  2,
+			expValue: "11usd",
+			expNAVs:  []types.NetAssetValue{newNAV("10hashcoin", 3), newNAV("7usd", 2)},
+		},
+		{
+			name:     "same number of hops: lexically first path",
+			amount:   "1bcoin",
+			target:   "usd",
+			maxHops:  2,
+			expValue: "6usd",
+			expNAVs:  []types.NetAssetValue{newNAV("2ccoin", 1), newNAV("3usd", 1)},
+		},
+		{
+			name:     "fewer hops preferred",
+			amount:   "2dcoin",
+			target:   "usd",
+			maxHops:  2,
+			expValue: "10usd",
+			expNAVs:  []types.NetAssetValue{newNAV("5usd", 1)},
+		},
+		{
+			name:     "same denom",
+			amount:   "5acoin",
+			target:   "acoin",
+			maxHops:  1,
+			expValue: "5acoin",
+		},
+		{
+			name:    "path longer than max hops",
+			amount:  "9acoin",
+			target:  "usd",
+			maxHops: 1,
+			expErr:  `no conversion path from "acoin" to "usd" found within 1 hop(s)`,
+		},
+		{
+			name:    "no path",
+			amount:  "9usd",
+			target:  "acoin",
+			maxHops: 3,
+			expErr:  `no conversion path from "usd" to "acoin" found within 3 hop(s)`,
+		},
+		{
+			name:    "cycle",
+			amount:  "1xcoin",
+			target:  "usd",
+			maxHops: 5,
+			expErr:  `no conversion path from "xcoin" to "usd" found within 5 hop(s) (ignored 1 cyclic net asset value(s))`,
+		},
+		{
+			name:    "invalid max hops",
+			amount:  "1acoin",
+			target:  "usd",
+			maxHops: 0,
+			expErr:  "invalid max hops 0: must be at least 1",
+		},
+		{
+			name:    "invalid target denom",
+			amount:  "1acoin",
+			target:  "x",
+			maxHops: 1,
+			expErr:  "invalid target denom: invalid denom: x",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			amount, err := sdk.ParseCoinNormalized(tc.amount)
+			require.NoError(t, err, "ParseCoinNormalized(%q)", tc.amount)
+
+			value, navs, err := app.MarkerKeeper.ConvertValueThroughNAVs(ctx, amount, tc.target, tc.maxHops)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ConvertValueThroughNAVs error")
+				return
+			}
+			require.NoError(t, err, "ConvertValueThroughNAVs error")
+			assert.Equal(t, tc.expValue, value.String(), "ConvertValueThroughNAVs value")
+			require.Len(t, navs, len(tc.expNAVs), "ConvertValueThroughNAVs navs")
+			for i := range tc.expNAVs {
+				assert.Equal(t, tc.expNAVs[i].Price, navs[i].Price, "navs[%d].Price", i)
+				assert.Equal(t, tc.expNAVs[i].Volume, navs[i].Volume, "navs[%d].Volume", i)
+			}
+		})
+	}
+
+	t.Run("query", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.ConvertValue(ctx, &types.QueryConvertValueRequest{
+			Amount:      sdk.NewInt64Coin("acoin", 9),
+			TargetDenom: "usd",
+		})
+		require.NoError(t, err, "ConvertValue")
+		assert.Equal(t, "105usd", resp.Value.String(), "ConvertValue value")
+		assert.Len(t, resp.NetAssetValues, 2, "ConvertValue net asset values")
+	})
+
+	t.Run("query max hops too large", func(t *testing.T) {
+		_, err := app.MarkerKeeper.ConvertValue(ctx, &types.QueryConvertValueRequest{
+			Amount:      sdk.NewInt64Coin("acoin", 9),
+			TargetDenom: "usd",
+			MaxHops:     types.MaxConvertValueHops + 1,
+		})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = max hops 6 cannot be more than 5", "ConvertValue")
+	})
+
+	t.Run("query no path", func(t *testing.T) {
+		_, err := app.MarkerKeeper.ConvertValue(ctx, &types.QueryConvertValueRequest{
+			Amount:      sdk.NewInt64Coin("usd", 9),
+			TargetDenom: "acoin",
+		})
+		assert.EqualError(t, err, `rpc error: code = InvalidArgument desc = no conversion path from "usd" to "acoin" found within 3 hop(s)`, "ConvertValue")
+	})
+}
@@ -53,7 +53,32 @@ func (k Keeper) WithAttrKeeper(attrKeeper types.AttrKeeper) Keeper {
 	return k
 }
 
+// HasHoldingThresholds is a TEST ONLY exposure of hasHoldingThresholds.
+func (k Keeper) HasHoldingThresholds(ctx sdk.Context, markerAddr sdk.AccAddress) bool {
+	return k.hasHoldingThresholds(ctx, markerAddr)
+}
+
 // SetNewMarker is a TEST ONLY function that calls NewMarker, then SetMarker.
 func (k Keeper) SetNewMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	k.SetMarker(ctx, k.NewMarker(ctx, marker))
 }
+
+// SetHolderStreamPageSize is a TEST ONLY func that sets the number of denom owners that the HoldingDiff query
+// gets at a time. It returns a func that restores the original value.
+func SetHolderStreamPageSize(size uint64) func() {
+	orig := holderStreamPageSize
+	holderStreamPageSize = size
+	return func() {
+		holderStreamPageSize = orig
+	}
+}
+
+// SetOwnedScopesPageSize is a TEST ONLY func that sets the number of balances that are read at a time when
+// getting the scopes a marker owns. It returns a func that restores the original value.
+func SetOwnedScopesPageSize(size uint64) func() {
+	orig := ownedScopesPageSize
+	ownedScopesPageSize = size
+	return func() {
+		ownedScopesPageSize = orig
+	}
+}
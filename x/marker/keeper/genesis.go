@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -60,59 +64,203 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			store.Set(types.NetAssetValueKey(address, navCopy.Price.Denom), bz)
 		}
 	}
+	for _, mht := range data.HoldingThresholds {
+		if err := k.SetHoldingThresholds(ctx, sdk.MustAccAddressFromBech32(mht.Address), mht.BasisPoints); err != nil {
+			panic(err)
+		}
+	}
+	for _, mnb := range data.NetAssetValueBounds {
+		if err := k.SetNetAssetValueBounds(ctx, sdk.MustAccAddressFromBech32(mnb.Address), mnb.MaxChangeBasisPoints); err != nil {
+			panic(err)
+		}
+	}
 }
 
-// ExportGenesis exports the current keeper state of the marker module.ExportGenesis
-// We do not export anything because our marker accounts will be exported/imported by the Account Module.
+// exportGenesisChunkSize is the number of entries in each of the chunks that ExportGenesis is built from.
+const exportGenesisChunkSize = 1000
+
+// ExportGenesis exports the current keeper state of the marker module.
+// The whole state has to be returned, so it's built by appending each of the chunks from ExportGenesisChunks,
+// and the entire marker state ends up in memory. Only ExportGenesisChunks (e.g. via the export-module marker
+// command) has bounded memory use.
 func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
-	params := k.GetParams(ctx)
-
-	var markers []types.MarkerAccount
-	appendToMarkers := func(marker types.MarkerAccountI) bool {
-		markers = append(markers, types.MarkerAccount{
-			BaseAccount: &authtypes.BaseAccount{
-				Address:       marker.GetAddress().String(),
-				AccountNumber: marker.GetAccountNumber(),
-				Sequence:      0,
-			},
-			Manager:                marker.GetManager().String(),
-			AccessControl:          marker.GetAccessList(),
-			Status:                 marker.GetStatus(),
-			Denom:                  marker.GetDenom(),
-			Supply:                 marker.GetSupply().Amount,
-			MarkerType:             marker.GetMarkerType(),
-			SupplyFixed:            marker.HasFixedSupply(),
-			AllowGovernanceControl: marker.HasGovernanceEnabled(),
-			AllowForcedTransfer:    marker.AllowsForcedTransfer(),
-			RequiredAttributes:     marker.GetRequiredAttributes(),
-		})
-		return false
+	rv := types.NewGenesisState(k.GetParams(ctx), nil, nil, make([]types.MarkerNetAssetValues, 0))
+	err := k.ExportGenesisChunks(ctx, exportGenesisChunkSize, 0, func(_ int, chunk *types.GenesisState) error {
+		rv.AppendEntries(chunk)
+		return nil
+	})
+	if err != nil {
+		panic(err)
 	}
-	k.IterateMarkers(ctx, appendToMarkers)
+	return rv
+}
 
-	var denyAddresses []types.DenySendAddress
-	handleDenyList := func(key []byte) bool {
-		markerAddr, denyAddr := types.GetDenySendAddresses(key)
-		denyAddresses = append(denyAddresses, types.DenySendAddress{MarkerAddress: markerAddr.String(), DenyAddress: denyAddr.String()})
-		return false
+// ExportGenesisChunks exports the state of the marker module in chunks of (at most) chunkSize entries.
+//
+// The entries are, in order: each marker (with its net asset values), ordered by marker address;
+// then each deny-send entry, holding thresholds entry, and net asset value bounds entry, each in store order.
+// So, for a given state, a chunk index always contains the same entries.
+// Chunks before startChunk are skipped without loading their markers, which allows an interrupted export to be resumed.
+// Each chunk also contains the params.
+// Only one chunk is held in memory at a time; it is provided to the callback, then discarded.
+//
+// Concatenating all of the chunks (see types.ConcatGenesisStates) yields the same state as ExportGenesis.
+func (k Keeper) ExportGenesisChunks(ctx sdk.Context, chunkSize, startChunk int, cb func(chunk int, data *types.GenesisState) error) error {
+	if chunkSize < 1 {
+		return fmt.Errorf("invalid chunk size %d: must be at least 1", chunkSize)
 	}
-	k.IterateSendDeny(ctx, handleDenyList)
-
-	markerNetAssetValues := make([]types.MarkerNetAssetValues, len(markers))
-	for i := range markers {
-		var markerNavs types.MarkerNetAssetValues
-		var navs []types.NetAssetValue
-		err := k.IterateNetAssetValues(ctx, markers[i].GetAddress(), func(nav types.NetAssetValue) (stop bool) {
-			navs = append(navs, nav)
-			return false
-		})
-		if err != nil {
-			panic(err)
+	if startChunk < 0 {
+		return fmt.Errorf("invalid start chunk %d: cannot be negative", startChunk)
+	}
+
+	c := &genesisChunker{params: k.GetParams(ctx), chunkSize: chunkSize, startChunk: startChunk, cb: cb}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.MarkerStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cur := c.current(); cur != nil {
+			markerAddr := sdk.AccAddress(iterator.Value())
+			marker, err := k.GetMarker(ctx, markerAddr)
+			if err != nil {
+				return err
+			}
+			if marker == nil {
+				return fmt.Errorf("marker %s not found in account store", markerAddr)
+			}
+			navs, err := k.exportNetAssetValues(ctx, markerAddr)
+			if err != nil {
+				return err
+			}
+			cur.Markers = append(cur.Markers, exportMarker(marker))
+			cur.NetAssetValues = append(cur.NetAssetValues, navs)
+		}
+		if err := c.next(); err != nil {
+			return err
 		}
-		markerNavs.Address = markers[i].GetAddress().String()
-		markerNavs.NetAssetValues = navs
-		markerNetAssetValues[i] = markerNavs
 	}
 
-	return types.NewGenesisState(params, markers, denyAddresses, markerNetAssetValues)
+	// The rest of the entries are exported using the same full-store iterations that have always been used
+	// for them, so that entries are included even if their marker address isn't (or is no longer) a marker.
+	var err error
+	k.IterateSendDeny(ctx, func(key []byte) bool {
+		if cur := c.current(); cur != nil {
+			markerAddr, denyAddr := types.GetDenySendAddresses(key)
+			cur.DenySendAddresses = append(cur.DenySendAddresses, types.DenySendAddress{MarkerAddress: markerAddr.String(), DenyAddress: denyAddr.String()})
+		}
+		err = c.next()
+		return err != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	iterErr := k.IterateHoldingThresholds(ctx, func(markerAddr sdk.AccAddress, basisPoints []uint32) bool {
+		if cur := c.current(); cur != nil {
+			cur.HoldingThresholds = append(cur.HoldingThresholds, types.MarkerHoldingThresholds{Address: markerAddr.String(), BasisPoints: basisPoints})
+		}
+		err = c.next()
+		return err != nil
+	})
+	if iterErr != nil {
+		return iterErr
+	}
+	if err != nil {
+		return err
+	}
+
+	iterErr = k.IterateNetAssetValueBounds(ctx, func(markerAddr sdk.AccAddress, maxChangeBasisPoints uint32) bool {
+		if cur := c.current(); cur != nil {
+			cur.NetAssetValueBounds = append(cur.NetAssetValueBounds, types.MarkerNetAssetValueBounds{Address: markerAddr.String(), MaxChangeBasisPoints: maxChangeBasisPoints})
+		}
+		err = c.next()
+		return err != nil
+	})
+	if iterErr != nil {
+		return iterErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return c.finish()
+}
+
+// genesisChunker keeps track of the chunk being built during a chunked genesis export.
+type genesisChunker struct {
+	params     types.Params
+	chunkSize  int
+	startChunk int
+	cb         func(chunk int, data *types.GenesisState) error
+
+	chunk   int
+	inChunk int
+	cur     *types.GenesisState
+}
+
+// current returns the chunk that the current entry should be added to, or nil if the entry is in a skipped chunk.
+func (c *genesisChunker) current() *types.GenesisState {
+	if c.chunk < c.startChunk {
+		return nil
+	}
+	if c.cur == nil {
+		c.cur = types.NewGenesisState(c.params, nil, nil, nil)
+	}
+	return c.cur
+}
+
+// next moves on to the next entry, providing the current chunk to the callback if it's now full.
+func (c *genesisChunker) next() error {
+	c.inChunk++
+	if c.inChunk < c.chunkSize {
+		return nil
+	}
+	err := c.finish()
+	c.chunk++
+	c.inChunk = 0
+	return err
+}
+
+// finish provides the current chunk (if there is one) to the callback.
+func (c *genesisChunker) finish() error {
+	if c.cur == nil {
+		return nil
+	}
+	cur := c.cur
+	c.cur = nil
+	return c.cb(c.chunk, cur)
+}
+
+// exportMarker converts the provided marker into the form used in genesis.
+func exportMarker(marker types.MarkerAccountI) types.MarkerAccount {
+	return types.MarkerAccount{
+		BaseAccount: &authtypes.BaseAccount{
+			Address:       marker.GetAddress().String(),
+			AccountNumber: marker.GetAccountNumber(),
+			Sequence:      0,
+		},
+		Manager:                marker.GetManager().String(),
+		AccessControl:          marker.GetAccessList(),
+		Status:                 marker.GetStatus(),
+		Denom:                  marker.GetDenom(),
+		Supply:                 marker.GetSupply().Amount,
+		MarkerType:             marker.GetMarkerType(),
+		SupplyFixed:            marker.HasFixedSupply(),
+		AllowGovernanceControl: marker.HasGovernanceEnabled(),
+		AllowForcedTransfer:    marker.AllowsForcedTransfer(),
+		RequiredAttributes:     marker.GetRequiredAttributes(),
+	}
+}
+
+// exportNetAssetValues gets the net asset values of a marker in the form used in genesis.
+func (k Keeper) exportNetAssetValues(ctx sdk.Context, markerAddr sdk.AccAddress) (types.MarkerNetAssetValues, error) {
+	var navs []types.NetAssetValue
+	err := k.IterateNetAssetValues(ctx, markerAddr, func(nav types.NetAssetValue) (stop bool) {
+		navs = append(navs, nav)
+		return false
+	})
+	if err != nil {
+		return types.MarkerNetAssetValues{}, err
+	}
+	return types.MarkerNetAssetValues{Address: markerAddr.String(), NetAssetValues: navs}, nil
 }
@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestExportGenesisChunks(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	for i := 0; i < 7; i++ {
+		denom := fmt.Sprintf("chunkcoin%d", i)
+		marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+			*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Transfer}),
+		})
+		marker.Supply = sdkmath.NewInt(100)
+		marker.MarkerType = types.MarkerType_RestrictedCoin
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker(%q)", denom)
+		nav := types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, int64(i+1)), 1)
+		require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, marker, nav, "test"), "SetNetAssetValue(%q)", denom)
+		if i%2 == 0 {
+			app.MarkerKeeper.AddSendDeny(ctx, marker.GetAddress(), sdk.AccAddress(fmt.Sprintf("denied%d_____________", i)))
+		}
+		if i%3 == 0 {
+			require.NoError(t, app.MarkerKeeper.SetHoldingThresholds(ctx, marker.GetAddress(), []uint32{2500}), "SetHoldingThresholds(%q)", denom)
+		}
+		if i%4 == 0 {
+			require.NoError(t, app.MarkerKeeper.SetNetAssetValueBounds(ctx, marker.GetAddress(), 1000), "SetNetAssetValueBounds(%q)", denom)
+		}
+	}
+
+	// A deny-send entry whose marker address isn't a marker should still be exported.
+	orphanMarkerAddr := sdk.AccAddress("not_a_marker________")
+	orphanDenyAddr := sdk.AccAddress("orphan_denied_______")
+	app.MarkerKeeper.AddSendDeny(ctx, orphanMarkerAddr, orphanDenyAddr)
+	orphan := types.DenySendAddress{MarkerAddress: orphanMarkerAddr.String(), DenyAddress: orphanDenyAddr.String()}
+
+	expected := app.MarkerKeeper.ExportGenesis(ctx)
+	require.GreaterOrEqual(t, len(expected.Markers), 7, "number of markers in monolithic export")
+	require.Contains(t, expected.DenySendAddresses, orphan, "deny send addresses in monolithic export")
+	entryCount := func(state *types.GenesisState) int {
+		return len(state.Markers) + len(state.DenySendAddresses) + len(state.HoldingThresholds) + len(state.NetAssetValueBounds)
+	}
+
+	t.Run("all chunks reassemble to monolithic export", func(t *testing.T) {
+		var chunks []*types.GenesisState
+		var indexes []int
+		err := app.MarkerKeeper.ExportGenesisChunks(ctx, 3, 0, func(chunk int, data *types.GenesisState) error {
+			indexes = append(indexes, chunk)
+			chunks = append(chunks, data)
+			return nil
+		})
+		require.NoError(t, err, "ExportGenesisChunks")
+
+		expChunks := (entryCount(expected) + 2) / 3
+		require.Len(t, chunks, expChunks, "number of chunks")
+		for i, chunk := range chunks {
+			assert.Equal(t, i, indexes[i], "chunk index %d", i)
+			assert.LessOrEqual(t, entryCount(chunk), 3, "number of entries in chunk %d", i)
+			assert.Equal(t, len(chunk.Markers), len(chunk.NetAssetValues), "number of nav entries in chunk %d", i)
+			assert.Equal(t, expected.Params, chunk.Params, "params in chunk %d", i)
+		}
+
+		actual := types.ConcatGenesisStates(chunks...)
+		assert.Equal(t, expected.Params, actual.Params, "params")
+		assert.Equal(t, expected.Markers, actual.Markers, "markers")
+		assert.Equal(t, expected.NetAssetValues, actual.NetAssetValues, "net asset values")
+		assert.Equal(t, expected.DenySendAddresses, actual.DenySendAddresses, "deny send addresses")
+		assert.Contains(t, actual.DenySendAddresses, orphan, "deny send addresses")
+		assert.Equal(t, expected.HoldingThresholds, actual.HoldingThresholds, "holding thresholds")
+		assert.Equal(t, expected.NetAssetValueBounds, actual.NetAssetValueBounds, "net asset value bounds")
+	})
+
+	t.Run("resuming from a chunk index yields the same chunks", func(t *testing.T) {
+		full := make(map[int]*types.GenesisState)
+		err := app.MarkerKeeper.ExportGenesisChunks(ctx, 2, 0, func(chunk int, data *types.GenesisState) error {
+			full[chunk] = data
+			return nil
+		})
+		require.NoError(t, err, "ExportGenesisChunks from 0")
+
+		var resumed []int
+		err = app.MarkerKeeper.ExportGenesisChunks(ctx, 2, 2, func(chunk int, data *types.GenesisState) error {
+			resumed = append(resumed, chunk)
+			assert.Equal(t, full[chunk], data, "chunk %d", chunk)
+			return nil
+		})
+		require.NoError(t, err, "ExportGenesisChunks from 2")
+		require.NotEmpty(t, resumed, "resumed chunks")
+		assert.Equal(t, 2, resumed[0], "first resumed chunk index")
+		assert.Len(t, resumed, len(full)-2, "number of resumed chunks")
+	})
+
+	t.Run("resumed export imports", func(t *testing.T) {
+		// Mimic an export-module marker run that was interrupted after chunk 1 and resumed with --start-chunk 2.
+		cdc := app.AppCodec()
+		chunkJSON := make(map[int][]byte)
+		exportChunks := func(startChunk, stopAfter int) {
+			err := app.MarkerKeeper.ExportGenesisChunks(ctx, 2, startChunk, func(chunk int, data *types.GenesisState) error {
+				bz, err := cdc.MarshalJSON(data)
+				require.NoError(t, err, "MarshalJSON chunk %d", chunk)
+				chunkJSON[chunk] = bz
+				if stopAfter >= 0 && chunk >= stopAfter {
+					return fmt.Errorf("interrupted after chunk %d", chunk)
+				}
+				return nil
+			})
+			if stopAfter >= 0 {
+				require.EqualError(t, err, fmt.Sprintf("interrupted after chunk %d", stopAfter), "ExportGenesisChunks from %d", startChunk)
+			} else {
+				require.NoError(t, err, "ExportGenesisChunks from %d", startChunk)
+			}
+		}
+		exportChunks(0, 1)
+		require.Len(t, chunkJSON, 2, "number of chunks before resuming")
+		exportChunks(2, -1)
+
+		chunks := make([]*types.GenesisState, len(chunkJSON))
+		for i := range chunks {
+			bz, ok := chunkJSON[i]
+			require.True(t, ok, "chunk %d was not exported", i)
+			chunks[i] = &types.GenesisState{}
+			require.NoError(t, cdc.UnmarshalJSON(bz, chunks[i]), "UnmarshalJSON chunk %d", i)
+		}
+		imported := types.ConcatGenesisStates(chunks...)
+		require.NoError(t, imported.Validate(), "Validate reassembled state")
+
+		newApp := simapp.Setup(t)
+		newCtx := newApp.BaseApp.NewContext(false)
+		require.NotPanics(t, func() { newApp.MarkerKeeper.InitGenesis(newCtx, imported) }, "InitGenesis")
+		actual := newApp.MarkerKeeper.ExportGenesis(newCtx)
+
+		assert.Equal(t, expected.Params, actual.Params, "params")
+		// The new app assigns its own account numbers to the imported markers, so only the denoms are compared.
+		assert.Equal(t, markerDenoms(expected.Markers), markerDenoms(actual.Markers), "marker denoms")
+		assert.Equal(t, expected.NetAssetValues, actual.NetAssetValues, "net asset values")
+		assert.Equal(t, expected.DenySendAddresses, actual.DenySendAddresses, "deny send addresses")
+		assert.Equal(t, expected.HoldingThresholds, actual.HoldingThresholds, "holding thresholds")
+		assert.Equal(t, expected.NetAssetValueBounds, actual.NetAssetValueBounds, "net asset value bounds")
+	})
+
+	t.Run("callback error stops export", func(t *testing.T) {
+		calls := 0
+		err := app.MarkerKeeper.ExportGenesisChunks(ctx, 1, 0, func(chunk int, _ *types.GenesisState) error {
+			calls++
+			return fmt.Errorf("injected error on chunk %d", chunk)
+		})
+		require.EqualError(t, err, "injected error on chunk 0", "ExportGenesisChunks error")
+		assert.Equal(t, 1, calls, "number of callback calls")
+	})
+
+	t.Run("invalid args", func(t *testing.T) {
+		noop := func(int, *types.GenesisState) error { return nil }
+		err := app.MarkerKeeper.ExportGenesisChunks(ctx, 0, 0, noop)
+		assert.EqualError(t, err, "invalid chunk size 0: must be at least 1", "zero chunk size")
+		err = app.MarkerKeeper.ExportGenesisChunks(ctx, 1, -1, noop)
+		assert.EqualError(t, err, "invalid start chunk -1: cannot be negative", "negative start chunk")
+	})
+}
+
+// markerDenoms returns the denoms of the provided markers, in order.
+func markerDenoms(markers []types.MarkerAccount) []string {
+	rv := make([]string, len(markers))
+	for i, marker := range markers {
+		rv[i] = marker.Denom
+	}
+	return rv
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// HealthCheckLimit is the maximum number of store entries read by any single health check.
+const HealthCheckLimit = 10
+
+// ModuleHealth runs a few shallow, bounded, read-only checks of the marker module state.
+func (k Keeper) ModuleHealth(c context.Context, _ *types.QueryModuleHealthRequest) (*types.QueryModuleHealthResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	checks := k.RunHealthChecks(ctx)
+	rv := &types.QueryModuleHealthResponse{Healthy: true, Checks: checks}
+	for _, check := range checks {
+		if !check.Passed {
+			rv.Healthy = false
+		}
+	}
+	return rv, nil
+}
+
+// RunHealthChecks runs each of the marker module health checks and returns their results.
+// Each check reads at most HealthCheckLimit entries, so the cost does not grow with the size of state.
+func (k Keeper) RunHealthChecks(ctx sdk.Context) []types.HealthCheck {
+	return []types.HealthCheck{
+		runHealthCheck("params", func() error { return k.checkParamsHealth(ctx) }),
+		runHealthCheck("markers", func() error { return k.checkMarkersHealth(ctx) }),
+		runHealthCheck("marker_index", func() error { return k.checkMarkerIndexHealth(ctx) }),
+		runHealthCheck("net_asset_values", func() error { return k.checkNetAssetValuesHealth(ctx) }),
+	}
+}
+
+// runHealthCheck runs the provided check, timing it, and converting any error (or panic) into a failed result.
+func runHealthCheck(name string, check func() error) (rv types.HealthCheck) {
+	rv.Name = name
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			rv.Passed = false
+			rv.Error = fmt.Sprintf("panic: %v", r)
+		}
+		rv.DurationMicros = uint64(time.Since(start).Microseconds())
+	}()
+	if err := check(); err != nil {
+		rv.Error = err.Error()
+		return rv
+	}
+	rv.Passed = true
+	return rv
+}
+
+// checkParamsHealth makes sure the params can be read and are valid.
+func (k Keeper) checkParamsHealth(ctx sdk.Context) error {
+	return k.GetParams(ctx).Validate()
+}
+
+// checkMarkersHealth makes sure the first few marker entries each point to a valid marker account.
+func (k Keeper) checkMarkersHealth(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.MarkerStoreKeyPrefix)
+	defer it.Close()
+	for i := 0; it.Valid() && i < HealthCheckLimit; it.Next() {
+		i++
+		addr := types.SplitMarkerStoreKey(it.Key())
+		marker, err := k.GetMarker(ctx, addr)
+		if err != nil {
+			return fmt.Errorf("could not read marker %s: %w", addr, err)
+		}
+		if marker == nil {
+			return fmt.Errorf("no marker account found for marker entry %s", addr)
+		}
+		if err = marker.Validate(); err != nil {
+			return fmt.Errorf("invalid marker %s: %w", addr, err)
+		}
+	}
+	return nil
+}
+
+// checkMarkerIndexHealth makes sure the first marker entry round-trips: the marker account it points to
+// has a denom whose marker address is the same as the entry's address.
+func (k Keeper) checkMarkerIndexHealth(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.MarkerStoreKeyPrefix)
+	defer it.Close()
+	if !it.Valid() {
+		return nil
+	}
+
+	addr := types.SplitMarkerStoreKey(it.Key())
+	marker, err := k.GetMarker(ctx, addr)
+	if err != nil {
+		return fmt.Errorf("could not read marker %s: %w", addr, err)
+	}
+	if marker == nil {
+		return fmt.Errorf("no marker account found for marker entry %s", addr)
+	}
+	denomAddr, err := types.MarkerAddress(marker.GetDenom())
+	if err != nil {
+		return fmt.Errorf("invalid denom %q in marker %s: %w", marker.GetDenom(), addr, err)
+	}
+	if !denomAddr.Equals(addr) {
+		return fmt.Errorf("marker entry %s has denom %q with address %s", addr, marker.GetDenom(), denomAddr)
+	}
+	return nil
+}
+
+// checkNetAssetValuesHealth makes sure the first few net asset value entries can be read and belong to a known marker.
+func (k Keeper) checkNetAssetValuesHealth(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.NetAssetValuePrefix)
+	defer it.Close()
+	for i := 0; it.Valid() && i < HealthCheckLimit; it.Next() {
+		i++
+		var nav types.NetAssetValue
+		if err := k.cdc.Unmarshal(it.Value(), &nav); err != nil {
+			return fmt.Errorf("could not read net asset value entry %X: %w", it.Key(), err)
+		}
+		markerAddr := types.GetMarkerFromNetAssetValueKey(it.Key())
+		if !store.Has(types.MarkerStoreKey(markerAddr)) {
+			return fmt.Errorf("net asset value entry %q exists for unknown marker %s", nav.Price, markerAddr)
+		}
+	}
+	return nil
+}
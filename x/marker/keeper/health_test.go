@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestModuleHealth(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	marker := types.NewEmptyMarkerAccount("healthcoin", admin.String(), []types.AccessGrant{
+		*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint}),
+	})
+	marker.Supply = sdkmath.NewInt(100)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	nav := types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 5), 1)
+	require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, marker, nav, "test"), "SetNetAssetValue")
+
+	checkNames := func(checks []types.HealthCheck) []string {
+		rv := make([]string, len(checks))
+		for i, check := range checks {
+			rv[i] = check.Name
+		}
+		return rv
+	}
+	expNames := []string{"params", "markers", "marker_index", "net_asset_values"}
+
+	resp, err := app.MarkerKeeper.ModuleHealth(ctx, &types.QueryModuleHealthRequest{})
+	require.NoError(t, err, "ModuleHealth before corruption")
+	assert.True(t, resp.Healthy, "Healthy before corruption")
+	assert.Equal(t, expNames, checkNames(resp.Checks), "check names before corruption")
+	for _, check := range resp.Checks {
+		assert.True(t, check.Passed, "%s passed before corruption", check.Name)
+		assert.Empty(t, check.Error, "%s error before corruption", check.Name)
+	}
+
+	// Add a marker entry (that sorts before all others) for an address that doesn't have an account.
+	missingAddr := sdk.AccAddress(make([]byte, 20))
+	store := app.MarkerKeeper.GetStore(ctx)
+	store.Set(types.MarkerStoreKey(missingAddr), missingAddr)
+
+	resp, err = app.MarkerKeeper.ModuleHealth(ctx, &types.QueryModuleHealthRequest{})
+	require.NoError(t, err, "ModuleHealth after corruption")
+	assert.False(t, resp.Healthy, "Healthy after corruption")
+	require.Equal(t, expNames, checkNames(resp.Checks), "check names after corruption")
+	expErr := "no marker account found for marker entry " + missingAddr.String()
+	assert.True(t, resp.Checks[0].Passed, "params passed after corruption")
+	assert.False(t, resp.Checks[1].Passed, "markers passed after corruption")
+	assert.Equal(t, expErr, resp.Checks[1].Error, "markers error after corruption")
+	assert.False(t, resp.Checks[2].Passed, "marker_index passed after corruption")
+	assert.Equal(t, expErr, resp.Checks[2].Error, "marker_index error after corruption")
+	assert.True(t, resp.Checks[3].Passed, "net_asset_values passed after corruption")
+}
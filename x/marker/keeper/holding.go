@@ -0,0 +1,375 @@
+package keeper
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// holdingAttributeFilterMaxLimit is the largest page limit allowed in a Holding query that filters by attribute.
+// It's smaller than usual because an attribute lookup is needed for each holder.
+const holdingAttributeFilterMaxLimit = 50
+
+// holdingFilter defines the holders to omit from the results of a Holding query.
+type holdingFilter struct {
+	excludeModuleAccounts bool
+	excludedAddrs         map[string]bool
+	minAmount             sdkmath.Int
+	attribute             string
+	attributeMissing      bool
+}
+
+// newHoldingFilter creates the holding filter defined in the provided request.
+// Returns nil if the request doesn't define any filtering.
+func newHoldingFilter(req *types.QueryHoldingRequest) (*holdingFilter, error) {
+	if !req.ExcludeModuleAccounts && len(req.ExcludedAddresses) == 0 && len(req.MinAmount) == 0 && len(req.Attribute) == 0 {
+		return nil, nil
+	}
+
+	rv := &holdingFilter{excludeModuleAccounts: req.ExcludeModuleAccounts}
+	if len(req.ExcludedAddresses) > 0 {
+		rv.excludedAddrs = make(map[string]bool, len(req.ExcludedAddresses))
+		for _, addrStr := range req.ExcludedAddresses {
+			addr, err := sdk.AccAddressFromBech32(addrStr)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid excluded address %q: %v", addrStr, err)
+			}
+			rv.excludedAddrs[string(addr)] = true
+		}
+	}
+	if len(req.MinAmount) > 0 {
+		var ok bool
+		rv.minAmount, ok = sdkmath.NewIntFromString(req.MinAmount)
+		if !ok || rv.minAmount.IsNegative() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid min amount %q: must be a non-negative integer", req.MinAmount)
+		}
+	}
+	if len(req.Attribute) > 0 {
+		var err error
+		rv.attribute, err = types.NormalizeRequiredAttribute(req.Attribute)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid attribute %q: %v", req.Attribute, err)
+		}
+		switch req.AttributeMode {
+		case types.AttributeFilterMode_Unspecified, types.AttributeFilterMode_Has:
+		case types.AttributeFilterMode_Missing:
+			rv.attributeMissing = true
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid attribute mode %d", req.AttributeMode)
+		}
+	}
+	return rv, nil
+}
+
+// includes returns true if the provided denom owner should be included in the results.
+func (f *holdingFilter) includes(ctx sdk.Context, k Keeper, owner *banktypes.DenomOwner) (bool, error) {
+	if owner.Balance.IsZero() {
+		return false, nil
+	}
+	if !f.minAmount.IsNil() && owner.Balance.Amount.LT(f.minAmount) {
+		return false, nil
+	}
+	if len(f.excludedAddrs) == 0 && !f.excludeModuleAccounts && len(f.attribute) == 0 {
+		return true, nil
+	}
+	addr, err := sdk.AccAddressFromBech32(owner.Address)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "invalid holder address %q: %v", owner.Address, err)
+	}
+	if f.excludedAddrs[string(addr)] {
+		return false, nil
+	}
+	if f.excludeModuleAccounts {
+		switch k.authKeeper.GetAccount(ctx, addr).(type) {
+		case sdk.ModuleAccountI, types.MarkerAccountI:
+			return false, nil
+		}
+	}
+	if len(f.attribute) > 0 {
+		missing, err := k.getMissingAttributes(ctx, addr, []string{f.attribute})
+		if err != nil {
+			return false, status.Error(codes.Internal, err.Error())
+		}
+		if f.attributeMissing != (len(missing) > 0) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// getFilteredHoldings gets a page of the holders of a denom, omitting the ones excluded by the filter.
+//
+// The denom owners are filtered as they're iterated (instead of filtering a page of results), so a page is
+// only short if there aren't any more holders. The page keys are the same as those of the bank DenomOwners query.
+// Each denom owner read is recorded in the provided cost (which can be nil).
+func (k Keeper) getFilteredHoldings(ctx sdk.Context, denom string, filter *holdingFilter, pageReq *query.PageRequest, cost *queryCost) (*types.QueryHoldingResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && len(pageReq.Key) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "paginate: invalid request, either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	if len(filter.attribute) > 0 && limit > holdingAttributeFilterMaxLimit {
+		limit = holdingAttributeFilterMaxLimit
+	}
+	toSkip := pageReq.Offset
+
+	rv := &types.QueryHoldingResponse{Pagination: &query.PageResponse{}}
+	var total uint64
+	haveNextKey := false
+	key := pageReq.Key
+	for {
+		resp, err := k.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{
+			Denom:      denom,
+			Pagination: &query.PageRequest{Key: key, Limit: holdersPageSize, Reverse: pageReq.Reverse},
+		})
+		if err != nil {
+			return nil, err
+		}
+		cost.addKeys(len(resp.DenomOwners))
+		// The bank module doesn't check for an expired context while iterating, so we check after each page.
+		if err = checkQueryDeadline(ctx); err != nil {
+			return nil, err
+		}
+
+		for i, owner := range resp.DenomOwners {
+			include, err := filter.includes(ctx, k, owner)
+			if err != nil {
+				return nil, err
+			}
+			if !include {
+				continue
+			}
+			total++
+			if toSkip > 0 {
+				toSkip--
+				continue
+			}
+			if uint64(len(rv.Balances)) < limit {
+				rv.Balances = append(rv.Balances, types.Balance{Address: owner.Address, Coins: sdk.NewCoins(owner.Balance)})
+				continue
+			}
+			if !haveNextKey {
+				// This is the first entry that didn't fit in the page, so it's where the next page should start.
+				haveNextKey = true
+				rv.Pagination.NextKey, err = k.getDenomOwnersKeyAt(ctx, denom, key, i, pageReq.Reverse, cost)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if !pageReq.CountTotal {
+				break
+			}
+		}
+
+		if (haveNextKey && !pageReq.CountTotal) || resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		key = resp.Pagination.NextKey
+	}
+
+	if pageReq.CountTotal {
+		rv.Pagination.Total = total
+	}
+	return rv, nil
+}
+
+// countHolders counts the accounts with a positive balance of a denom, and totals the amount held by accounts other
+// than the provided escrow address. The denom owners are streamed a page at a time, so the balances are never all
+// held in memory. Each denom owner read is recorded in the provided cost (which can be nil).
+func (k Keeper) countHolders(ctx sdk.Context, denom string, escrowAddr sdk.AccAddress, cost *queryCost) (uint64, sdkmath.Int, error) {
+	escrow := escrowAddr.String()
+	var count uint64
+	held := sdkmath.ZeroInt()
+	var key []byte
+	for {
+		resp, err := k.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{
+			Denom:      denom,
+			Pagination: &query.PageRequest{Key: key, Limit: holdersPageSize},
+		})
+		if err != nil {
+			return 0, sdkmath.Int{}, err
+		}
+		cost.addKeys(len(resp.DenomOwners))
+		// The bank module doesn't check for an expired context while iterating, so we check after each page.
+		if err = checkQueryDeadline(ctx); err != nil {
+			return 0, sdkmath.Int{}, err
+		}
+
+		for _, owner := range resp.DenomOwners {
+			if owner.Balance.IsZero() {
+				continue
+			}
+			count++
+			if owner.Address != escrow {
+				held = held.Add(owner.Balance.Amount)
+			}
+		}
+
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		key = resp.Pagination.NextKey
+	}
+	return count, held, nil
+}
+
+// getDenomOwnersKeyAt gets the DenomOwners page key of the entry with the provided index in the page that starts at the provided key.
+// Each denom owner read is recorded in the provided cost (which can be nil).
+func (k Keeper) getDenomOwnersKeyAt(ctx sdk.Context, denom string, key []byte, index int, reverse bool, cost *queryCost) ([]byte, error) {
+	if index == 0 {
+		return key, nil
+	}
+	resp, err := k.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{
+		Denom:      denom,
+		Pagination: &query.PageRequest{Key: key, Limit: uint64(index), Reverse: reverse}, //nolint:gosec // G115: An index is never negative.
+	})
+	if err != nil {
+		return nil, err
+	}
+	cost.addKeys(len(resp.DenomOwners))
+	if resp.Pagination == nil {
+		return nil, nil
+	}
+	return resp.Pagination.NextKey, nil
+}
+
+// balancesPageSize is the number of balances to get at a time when getting the marker holdings of an account.
+const balancesPageSize = 1000
+
+// getHoldingsByAddress gets a page of the marker coins held by an account.
+//
+// The account's balances are checked as they're iterated (instead of filtering a page of balances), so a page
+// is only short if there aren't any more holdings. The page keys are the same as those of the bank AllBalances query.
+func (k Keeper) getHoldingsByAddress(ctx sdk.Context, addr sdk.AccAddress, includeAccess bool, pageReq *query.PageRequest) (*types.QueryHoldingByAddressResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && len(pageReq.Key) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "paginate: invalid request, either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	toSkip := pageReq.Offset
+
+	rv := &types.QueryHoldingByAddressResponse{Holdings: []types.MarkerHolding{}, Pagination: &query.PageResponse{}}
+	var total uint64
+	haveNextKey := false
+	key := pageReq.Key
+	for {
+		resp, err := k.bankKeeper.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+			Address:    addr.String(),
+			Pagination: &query.PageRequest{Key: key, Limit: balancesPageSize, Reverse: pageReq.Reverse},
+		})
+		if err != nil {
+			return nil, err
+		}
+		// The bank module doesn't check for an expired context while iterating, so we check after each page.
+		if err = checkQueryDeadline(ctx); err != nil {
+			return nil, err
+		}
+
+		for i, balance := range resp.Balances {
+			marker, err := k.getMarkerForHolding(ctx, balance.Denom)
+			if err != nil {
+				return nil, err
+			}
+			if marker == nil {
+				continue
+			}
+			total++
+			if toSkip > 0 {
+				toSkip--
+				continue
+			}
+			if uint64(len(rv.Holdings)) < limit {
+				rv.Holdings = append(rv.Holdings, newMarkerHolding(addr, balance, marker, includeAccess))
+				continue
+			}
+			if !haveNextKey {
+				// This is the first entry that didn't fit in the page, so it's where the next page should start.
+				haveNextKey = true
+				rv.Pagination.NextKey, err = k.getAllBalancesKeyAt(ctx, addr, key, i, pageReq.Reverse)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if !pageReq.CountTotal {
+				break
+			}
+		}
+
+		if (haveNextKey && !pageReq.CountTotal) || resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		key = resp.Pagination.NextKey
+	}
+
+	if pageReq.CountTotal {
+		rv.Pagination.Total = total
+	}
+	return rv, nil
+}
+
+// getMarkerForHolding gets the marker with the provided denom. Returns nil if there isn't one.
+func (k Keeper) getMarkerForHolding(ctx sdk.Context, denom string) (types.MarkerAccountI, error) {
+	markerAddr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return nil, nil //nolint:nilerr // A denom that can't be a marker denom just means there isn't a marker.
+	}
+	if !ctx.KVStore(k.storeKey).Has(types.MarkerStoreKey(markerAddr)) {
+		return nil, nil
+	}
+	marker, err := k.GetMarker(ctx, markerAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get marker %q: %v", denom, err)
+	}
+	return marker, nil
+}
+
+// newMarkerHolding creates the MarkerHolding entry for an account's balance of a marker's coin.
+func newMarkerHolding(addr sdk.AccAddress, balance sdk.Coin, marker types.MarkerAccountI, includeAccess bool) types.MarkerHolding {
+	accessList := marker.GetAccessList()
+	rv := types.MarkerHolding{
+		Balance:       balance,
+		MarkerAddress: marker.GetAddress().String(),
+		Status:        marker.GetStatus(),
+		MarkerType:    marker.GetMarkerType(),
+		Permissions:   types.GrantsForAddress(addr, accessList...).Permissions,
+	}
+	if includeAccess {
+		rv.AccessControl = accessList
+	}
+	return rv
+}
+
+// getAllBalancesKeyAt gets the AllBalances page key of the entry with the provided index in the page that starts at the provided key.
+func (k Keeper) getAllBalancesKeyAt(ctx sdk.Context, addr sdk.AccAddress, key []byte, index int, reverse bool) ([]byte, error) {
+	if index == 0 {
+		return key, nil
+	}
+	resp, err := k.bankKeeper.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Key: key, Limit: uint64(index), Reverse: reverse}, //nolint:gosec // G115: An index is never negative.
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Pagination == nil {
+		return nil, nil
+	}
+	return resp.Pagination.NextKey, nil
+}
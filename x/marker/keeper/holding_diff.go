@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// holdersPageSize is the number of denom owners to get at a time when reading the holders of a denom.
+const holdersPageSize = 1000
+
+// holderStreamPageSize is the number of denom owners that a holderStream gets at a time.
+// It's only a variable so that unit tests can use smaller pages.
+var holderStreamPageSize uint64 = holdersPageSize
+
+// GetHoldingDiff identifies the accounts whose holdings of the provided denom are different at heightB than at heightA.
+// The entries are ordered by address (the same way the bank module orders denom owners), and pagination is applied
+// to all of them together. The holders at each height are read one page at a time and merged as they're read,
+// so only a page of holders from each height is in memory at once, and a page key lets reading start there.
+func (k Keeper) GetHoldingDiff(ctx sdk.Context, denom string, heightA, heightB int64, pageReq *query.PageRequest) (*types.QueryHoldingDiffResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+	// The page keys that we provide are addresses, but the bank module's denom owner keys are length-prefixed addresses.
+	var startKey []byte
+	if len(pageReq.Key) > 0 {
+		var err error
+		startKey, err = address.LengthPrefix(pageReq.Key)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page key: %v", err)
+		}
+	}
+
+	before, err := k.newHolderStream(ctx, denom, heightA, startKey, pageReq.Reverse)
+	if err != nil {
+		return nil, err
+	}
+	after, err := k.newHolderStream(ctx, denom, heightB, startKey, pageReq.Reverse)
+	if err != nil {
+		return nil, err
+	}
+
+	zero := sdk.NewInt64Coin(denom, 0)
+	rv := &types.QueryHoldingDiffResponse{Pagination: &query.PageResponse{}}
+	var count uint64
+	for {
+		b, err := before.peek()
+		if err != nil {
+			return nil, err
+		}
+		a, err := after.peek()
+		if err != nil {
+			return nil, err
+		}
+		if b == nil && a == nil {
+			break
+		}
+
+		// cmp < 0 means the before entry comes first, cmp > 0 means the after entry comes first.
+		cmp := 0
+		switch {
+		case b == nil:
+			cmp = 1
+		case a == nil:
+			cmp = -1
+		default:
+			cmp = bytes.Compare(b.key, a.key)
+			if pageReq.Reverse {
+				cmp = -cmp
+			}
+		}
+
+		var entry *types.HoldingChange
+		var entries *[]types.HoldingChange
+		var addr sdk.AccAddress
+		switch {
+		case cmp < 0:
+			before.pop()
+			entry, entries, addr = &types.HoldingChange{Address: b.Address, Before: b.Balance, After: zero}, &rv.Removed, b.addr
+		case cmp > 0:
+			after.pop()
+			entry, entries, addr = &types.HoldingChange{Address: a.Address, Before: zero, After: a.Balance}, &rv.Added, a.addr
+		default:
+			before.pop()
+			after.pop()
+			if !b.Balance.IsEqual(a.Balance) {
+				entry, entries, addr = &types.HoldingChange{Address: a.Address, Before: b.Balance, After: a.Balance}, &rv.Changed, a.addr
+			}
+		}
+		if entry == nil {
+			continue
+		}
+
+		switch {
+		case count < pageReq.Offset:
+			// Still skipping entries to get to the requested offset.
+		case count < pageReq.Offset+limit:
+			*entries = append(*entries, *entry)
+		case count == pageReq.Offset+limit:
+			rv.Pagination.NextKey = addr
+		}
+		count++
+		if len(rv.Pagination.NextKey) > 0 && (!countTotal || len(pageReq.Key) > 0) {
+			break
+		}
+	}
+
+	if countTotal && len(pageReq.Key) == 0 {
+		rv.Pagination.Total = count
+	}
+	return rv, nil
+}
+
+// holder is an account that holds a denom, as read by a holderStream.
+type holder struct {
+	banktypes.DenomOwner
+	// addr is the account's address.
+	addr sdk.AccAddress
+	// key is the account's key in the bank module's denom owner index, i.e. its length-prefixed address.
+	key []byte
+}
+
+// holderStream reads the holders of a denom at a height, one page at a time, in the order the bank module stores them.
+type holderStream struct {
+	k        Keeper
+	queryCtx sdk.Context
+	hCtx     sdk.Context
+	denom    string
+	reverse  bool
+
+	page    []holder
+	pageReq *query.PageRequest
+}
+
+// newHolderStream creates a holderStream for the holders of the provided denom at the provided height.
+// If a startKey is provided, the first holder will be the one with that key (or the one after it).
+func (k Keeper) newHolderStream(ctx sdk.Context, denom string, height int64, startKey []byte, reverse bool) (*holderStream, error) {
+	if k.historicalContextFn == nil {
+		return nil, status.Error(codes.Unimplemented, "the state at previous heights is not available")
+	}
+	hCtx, err := k.historicalContextFn(height)
+	if err != nil {
+		if errors.Is(err, sdkerrors.ErrInvalidHeight) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid height %d: %v", height, err)
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "state at height %d is not available (it may have been pruned): %v", height, err)
+	}
+	// Use the query's go context so that the query timeout is still applied.
+	hCtx = hCtx.WithContext(ctx.Context())
+
+	return &holderStream{
+		k:        k,
+		queryCtx: ctx,
+		hCtx:     hCtx,
+		denom:    denom,
+		reverse:  reverse,
+		pageReq:  &query.PageRequest{Key: startKey, Limit: holderStreamPageSize, Reverse: reverse},
+	}, nil
+}
+
+// peek returns the next holder without moving past it, getting the next page of holders if needed.
+// It returns nil once there aren't any more holders.
+func (s *holderStream) peek() (*holder, error) {
+	if len(s.page) == 0 && s.pageReq != nil {
+		if err := s.loadPage(); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.page) == 0 {
+		return nil, nil
+	}
+	return &s.page[0], nil
+}
+
+// pop moves past the next holder.
+func (s *holderStream) pop() {
+	if len(s.page) > 0 {
+		s.page = s.page[1:]
+	}
+}
+
+// loadPage gets the next page of holders.
+func (s *holderStream) loadPage() error {
+	resp, err := s.k.bankKeeper.DenomOwners(s.hCtx, &banktypes.QueryDenomOwnersRequest{Denom: s.denom, Pagination: s.pageReq})
+	if err != nil {
+		return err
+	}
+	// The bank module doesn't check for an expired context while iterating, so we check after each page.
+	if err = checkQueryDeadline(s.queryCtx); err != nil {
+		return err
+	}
+
+	s.page = make([]holder, len(resp.DenomOwners))
+	for i, owner := range resp.DenomOwners {
+		addr, aErr := sdk.AccAddressFromBech32(owner.Address)
+		if aErr != nil {
+			return status.Errorf(codes.Internal, "invalid holder address %q: %v", owner.Address, aErr)
+		}
+		s.page[i] = holder{DenomOwner: *owner, addr: addr, key: address.MustLengthPrefix(addr)}
+	}
+
+	s.pageReq = nil
+	if resp.Pagination != nil && len(resp.Pagination.NextKey) > 0 {
+		s.pageReq = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: holderStreamPageSize, Reverse: s.reverse}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetHoldingThresholds gets the holding thresholds (in basis points) of a marker.
+// Returns nil if the marker doesn't have any.
+func (k Keeper) GetHoldingThresholds(ctx sdk.Context, markerAddr sdk.AccAddress) ([]uint32, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.HoldingThresholdsKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var thresholds types.HoldingThresholds
+	if err := k.cdc.Unmarshal(bz, &thresholds); err != nil {
+		return nil, fmt.Errorf("could not read holding thresholds for marker %s: %w", markerAddr, err)
+	}
+	return thresholds.BasisPoints, nil
+}
+
+// SetHoldingThresholds sets the holding thresholds (in basis points) of a marker.
+// If no thresholds are provided, the marker's holding thresholds are removed.
+func (k Keeper) SetHoldingThresholds(ctx sdk.Context, markerAddr sdk.AccAddress, basisPoints []uint32) error {
+	if err := types.ValidateHoldingThresholds(basisPoints); err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := types.HoldingThresholdsKey(markerAddr)
+	if len(basisPoints) == 0 {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&types.HoldingThresholds{BasisPoints: basisPoints})
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// IterateHoldingThresholds iterates over the holding thresholds of all markers.
+func (k Keeper) IterateHoldingThresholds(ctx sdk.Context, handler func(markerAddr sdk.AccAddress, basisPoints []uint32) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.HoldingThresholdsPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		markerAddr := types.GetMarkerFromHoldingThresholdsKey(it.Key())
+		var thresholds types.HoldingThresholds
+		if err := k.cdc.Unmarshal(it.Value(), &thresholds); err != nil {
+			return fmt.Errorf("could not read holding thresholds for marker %s: %w", markerAddr, err)
+		}
+		if handler(markerAddr, thresholds.BasisPoints) {
+			break
+		}
+	}
+	return nil
+}
+
+// hasHoldingThresholds returns true if the marker has holding thresholds.
+// No gas is used so that sends of denoms without any holding thresholds don't cost more than they would without them.
+func (k Keeper) hasHoldingThresholds(ctx sdk.Context, markerAddr sdk.AccAddress) bool {
+	store := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).KVStore(k.storeKey)
+	return store.Has(types.HoldingThresholdsKey(markerAddr))
+}
+
+// emitSendHoldingThresholdEvents emits an EventMarkerHoldingThresholdCrossed for each holding threshold
+// that the sender's or receiver's share crosses because of a send. This is called from the send restriction,
+// i.e. after the funds have been removed from the sender, but before they have been added to the receiver.
+// Sends to or from the marker module account are part of a mint or burn and are handled by emitSupplyHoldingThresholdEvents.
+// Problems are logged instead of returned so that they can't cause a send to fail.
+func (k Keeper) emitSendHoldingThresholdEvents(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) {
+	if fromAddr.Equals(toAddr) || fromAddr.Equals(k.markerModuleAddr) || toAddr.Equals(k.markerModuleAddr) {
+		return
+	}
+	for _, coin := range amt {
+		markerAddr, err := types.MarkerAddress(coin.Denom)
+		if err != nil || !k.hasHoldingThresholds(ctx, markerAddr) {
+			continue
+		}
+		thresholds, err := k.GetHoldingThresholds(ctx, markerAddr)
+		if err != nil {
+			ctx.Logger().Error("unable to get holding thresholds", "error", err, "denom", coin.Denom)
+			continue
+		}
+
+		supply := k.bankKeeper.GetSupply(ctx, coin.Denom).Amount
+		if spent, reported := lastSpentAmount(ctx, fromAddr, coin); !reported {
+			fromBal := k.bankKeeper.GetBalance(ctx, fromAddr, coin.Denom).Amount
+			k.emitHoldingThresholdEvents(ctx, coin.Denom, fromAddr, thresholds, fromBal.Add(spent), supply, fromBal, supply)
+		}
+		toBal := k.bankKeeper.GetBalance(ctx, toAddr, coin.Denom).Amount
+		k.emitHoldingThresholdEvents(ctx, coin.Denom, toAddr, thresholds, toBal, supply, toBal.Add(coin.Amount), supply)
+	}
+}
+
+// lastSpentAmount returns how much of the coin's denom the bank module most recently removed from the sender,
+// and whether the sender's crossings for that have already been reported.
+// A multi-send removes the whole input from the sender before applying the send restriction for each output,
+// so the amount of any one output isn't what the sender's balance went down by, and the sender's crossings
+// are reported with the first output that causes one. If the removal can't be found, the coin's amount is used.
+func lastSpentAmount(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) (sdkmath.Int, bool) {
+	senderStr := sender.String()
+	events := ctx.EventManager().Events()
+	for i := len(events) - 1; i >= 0; i-- {
+		switch events[i].Type {
+		case banktypes.EventTypeCoinSpent:
+			if attr, ok := events[i].GetAttribute(banktypes.AttributeKeySpender); !ok || attr.Value != senderStr {
+				continue
+			}
+			attr, _ := events[i].GetAttribute(sdk.AttributeKeyAmount)
+			spent, err := sdk.ParseCoinsNormalized(attr.Value)
+			if err != nil {
+				return coin.Amount, false
+			}
+			return spent.AmountOf(coin.Denom), false
+		case holdingThresholdCrossedEventType:
+			tev, err := sdk.ParseTypedEvent(abci.Event(events[i]))
+			if err != nil {
+				continue
+			}
+			if event, ok := tev.(*types.EventMarkerHoldingThresholdCrossed); ok && event.Address == senderStr &&
+				event.Denom == coin.Denom && event.Direction == types.HoldingThresholdDirectionDown {
+				return coin.Amount, true
+			}
+		}
+	}
+	return coin.Amount, false
+}
+
+// holdingThresholdCrossedEventType is the type of event emitted when a holding threshold is crossed.
+var holdingThresholdCrossedEventType = proto.MessageName(&types.EventMarkerHoldingThresholdCrossed{})
+
+// emitSupplyHoldingThresholdEvents emits an EventMarkerHoldingThresholdCrossed for each holding threshold that
+// the marker account's share crossed because of a change in supply. This is called after the supply has changed.
+// A supply change affects the share of every holder, but only the marker account's is checked so that the cost is fixed.
+// Problems are logged instead of returned so that they can't cause the supply change to fail.
+func (k Keeper) emitSupplyHoldingThresholdEvents(ctx sdk.Context, marker types.MarkerAccountI, oldSupply, newSupply sdkmath.Int) {
+	if !k.hasHoldingThresholds(ctx, marker.GetAddress()) {
+		return
+	}
+	thresholds, err := k.GetHoldingThresholds(ctx, marker.GetAddress())
+	if err != nil {
+		ctx.Logger().Error("unable to get holding thresholds", "error", err, "denom", marker.GetDenom())
+		return
+	}
+	denom := marker.GetDenom()
+	newBal := k.bankKeeper.GetBalance(ctx, marker.GetAddress(), denom).Amount
+	oldBal := newBal.Sub(newSupply).Add(oldSupply)
+	k.emitHoldingThresholdEvents(ctx, denom, marker.GetAddress(), thresholds, oldBal, oldSupply, newBal, newSupply)
+}
+
+// emitHoldingThresholdEvents emits an EventMarkerHoldingThresholdCrossed for each of the thresholds crossed by the provided change.
+func (k Keeper) emitHoldingThresholdEvents(ctx sdk.Context, denom string, addr sdk.AccAddress, thresholds []uint32, preBal, preSupply, postBal, postSupply sdkmath.Int) {
+	for _, crossing := range types.CrossedHoldingThresholds(thresholds, preBal, preSupply, postBal, postSupply) {
+		event := types.NewEventMarkerHoldingThresholdCrossed(denom, addr.String(), crossing, postBal, postSupply)
+		if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+			ctx.Logger().Error("unable to emit event", "error", err, "event", event)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// holdingThresholdEvents gets all the EventMarkerHoldingThresholdCrossed events emitted so far.
+func holdingThresholdEvents(t *testing.T, ctx sdk.Context) []*types.EventMarkerHoldingThresholdCrossed {
+	t.Helper()
+	var rv []*types.EventMarkerHoldingThresholdCrossed
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != "provenance.marker.v1.EventMarkerHoldingThresholdCrossed" {
+			continue
+		}
+		tev, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err, "ParseTypedEvent(%v)", event)
+		rv = append(rv, tev.(*types.EventMarkerHoldingThresholdCrossed))
+	}
+	return rv
+}
+
+func TestHoldingThresholdsGetSet(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	markerAddr := types.MustGetMarkerAddress("threshcoin")
+
+	thresholds, err := app.MarkerKeeper.GetHoldingThresholds(ctx, markerAddr)
+	require.NoError(t, err, "GetHoldingThresholds before set")
+	assert.Nil(t, thresholds, "thresholds before set")
+
+	err = app.MarkerKeeper.SetHoldingThresholds(ctx, markerAddr, []uint32{5000, 2500})
+	require.EqualError(t, err, "holding thresholds must be in ascending order without duplicates", "SetHoldingThresholds out of order")
+
+	err = app.MarkerKeeper.SetHoldingThresholds(ctx, markerAddr, []uint32{2500, 5000})
+	require.NoError(t, err, "SetHoldingThresholds")
+	thresholds, err = app.MarkerKeeper.GetHoldingThresholds(ctx, markerAddr)
+	require.NoError(t, err, "GetHoldingThresholds after set")
+	assert.Equal(t, []uint32{2500, 5000}, thresholds, "thresholds after set")
+
+	gasCtx := ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+	assert.True(t, app.MarkerKeeper.HasHoldingThresholds(gasCtx, markerAddr), "HasHoldingThresholds after set")
+	assert.False(t, app.MarkerKeeper.HasHoldingThresholds(gasCtx, types.MustGetMarkerAddress("otherthreshcoin")), "HasHoldingThresholds other marker")
+	assert.Zero(t, gasCtx.GasMeter().GasConsumed(), "gas consumed by HasHoldingThresholds")
+
+	err = app.MarkerKeeper.SetHoldingThresholds(ctx, markerAddr, nil)
+	require.NoError(t, err, "SetHoldingThresholds(nil)")
+	thresholds, err = app.MarkerKeeper.GetHoldingThresholds(ctx, markerAddr)
+	require.NoError(t, err, "GetHoldingThresholds after removal")
+	assert.Nil(t, thresholds, "thresholds after removal")
+}
+
+func TestHoldingThresholdCrossedEvents(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	denom := "threshcoin"
+	admin := sdk.AccAddress("admin_______________")
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+		*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint, types.Access_Burn, types.Access_Withdraw}),
+	})
+	marker.Supply = sdkmath.NewInt(100)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+
+	msgServer := keeper.NewMsgServerImpl(app.MarkerKeeper)
+	_, err := msgServer.SetHoldingThresholds(ctx, types.NewMsgSetHoldingThresholdsRequest(denom, []uint32{2500}, admin.String()))
+	require.NoError(t, err, "SetHoldingThresholds")
+
+	coins := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amt))
+	}
+	expEvent := func(addr sdk.AccAddress, direction string, balance, supply int64) *types.EventMarkerHoldingThresholdCrossed {
+		return &types.EventMarkerHoldingThresholdCrossed{
+			Denom:     denom,
+			Address:   addr.String(),
+			Threshold: "2500",
+			Direction: direction,
+			Balance:   sdkmath.NewInt(balance).String(),
+			Supply:    sdkmath.NewInt(supply).String(),
+		}
+	}
+
+	t.Run("cross up then back down", func(t *testing.T) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		// addr1: 0 -> 30 (crosses 25% up). The marker goes from 100 -> 70 (no crossing).
+		require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, addr1, denom, coins(30)), "WithdrawCoins")
+		// addr1: 30 -> 20 (crosses 25% down). addr2: 0 -> 10 (no crossing).
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, addr2, coins(10)), "SendCoins")
+		// addr2: 10 -> 15 (no crossing). addr1: 20 -> 15 (no crossing).
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, addr2, coins(5)), "SendCoins")
+
+		exp := []*types.EventMarkerHoldingThresholdCrossed{
+			expEvent(addr1, types.HoldingThresholdDirectionUp, 30, 100),
+			expEvent(addr1, types.HoldingThresholdDirectionDown, 20, 100),
+		}
+		assert.Equal(t, exp, holdingThresholdEvents(t, ctx), "threshold crossed events")
+	})
+
+	t.Run("mint and burn", func(t *testing.T) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		// The marker holds 70 of 100. Withdraw 50 so that it holds 20 of 100 (crosses 25% down).
+		// addr2: 15 -> 65 (crosses 25% up).
+		require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, addr2, denom, coins(50)), "WithdrawCoins")
+		// The marker: 20/100 -> 120/200 (crosses 25% up).
+		require.NoError(t, app.MarkerKeeper.MintCoin(ctx, admin, sdk.NewInt64Coin(denom, 100)), "MintCoin")
+		// The marker: 120/200 -> 20/100 (crosses 25% down).
+		require.NoError(t, app.MarkerKeeper.BurnCoin(ctx, admin, sdk.NewInt64Coin(denom, 100)), "BurnCoin")
+
+		exp := []*types.EventMarkerHoldingThresholdCrossed{
+			expEvent(marker.GetAddress(), types.HoldingThresholdDirectionDown, 20, 100),
+			expEvent(addr2, types.HoldingThresholdDirectionUp, 65, 100),
+			expEvent(marker.GetAddress(), types.HoldingThresholdDirectionUp, 120, 200),
+			expEvent(marker.GetAddress(), types.HoldingThresholdDirectionDown, 20, 100),
+		}
+		assert.Equal(t, exp, holdingThresholdEvents(t, ctx), "threshold crossed events")
+	})
+
+	t.Run("multi-send", func(t *testing.T) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		// addr2: 65 -> 20 (crosses 25% down, but neither output does on its own).
+		// addr1: 15 -> 40 (crosses 25% up). addr3: 0 -> 20 (no crossing).
+		input := banktypes.NewInput(addr2, coins(45))
+		outputs := []banktypes.Output{banktypes.NewOutput(addr1, coins(25)), banktypes.NewOutput(addr3, coins(20))}
+		require.NoError(t, app.BankKeeper.InputOutputCoins(ctx, input, outputs), "InputOutputCoins")
+
+		exp := []*types.EventMarkerHoldingThresholdCrossed{
+			expEvent(addr2, types.HoldingThresholdDirectionDown, 20, 100),
+			expEvent(addr1, types.HoldingThresholdDirectionUp, 40, 100),
+		}
+		assert.Equal(t, exp, holdingThresholdEvents(t, ctx), "threshold crossed events")
+	})
+
+	t.Run("unreadable thresholds", func(t *testing.T) {
+		store := ctx.KVStore(app.GetKey(types.StoreKey))
+		key := types.HoldingThresholdsKey(marker.GetAddress())
+		orig := store.Get(key)
+		store.Set(key, []byte("not holding thresholds"))
+		defer store.Set(key, orig)
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, addr3, coins(20)), "SendCoins")
+		assert.Empty(t, holdingThresholdEvents(t, ctx), "threshold crossed events")
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, addr3, addr1, coins(20)), "SendCoins back")
+	})
+
+	t.Run("no thresholds", func(t *testing.T) {
+		_, err = msgServer.SetHoldingThresholds(ctx, types.NewMsgSetHoldingThresholdsRequest(denom, nil, admin.String()))
+		require.NoError(t, err, "SetHoldingThresholds(nil)")
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, addr2, coins(40)), "SendCoins")
+		assert.Empty(t, holdingThresholdEvents(t, ctx), "threshold crossed events")
+	})
+}
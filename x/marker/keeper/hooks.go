@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetHooks sets the marker hooks. It panics if hooks have already been set.
+// Since the keeper is passed around by value, this must be called before the
+// keeper is provided to anything else (e.g. other keepers or the module).
+func (k *Keeper) SetHooks(hooks types.MarkerHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set marker hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// GetHooks gets the marker hooks. If none have been set, a NoOpMarkerHooks is returned.
+func (k Keeper) GetHooks() types.MarkerHooks {
+	if k.hooks == nil {
+		return types.NoOpMarkerHooks{}
+	}
+	return k.hooks
+}
+
+// callHooks calls the provided function with the marker hooks (if there are any).
+//
+// The hooks are given a cache context whose changes are only written if they finish successfully.
+// If the hooks panic, the panic is recovered and logged, and their changes are discarded. That way, a
+// misbehaving hook can't cause a marker action to fail or halt the chain. Out-of-gas panics are not
+// recovered though, since those need to be handled by the tx processing.
+func (k Keeper) callHooks(ctx sdk.Context, name string, call func(ctx sdk.Context, hooks types.MarkerHooks)) {
+	if k.hooks == nil {
+		return
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, isOOG := r.(storetypes.ErrorOutOfGas); isOOG {
+				panic(r)
+			}
+			k.Logger(ctx).Error("marker hook panicked (recovered)", "hook", name, "panic", fmt.Sprintf("%v", r))
+		}
+	}()
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	call(cacheCtx, k.hooks)
+	writeCache()
+}
+
+// afterMarkerActivated calls the AfterMarkerActivated hook.
+func (k Keeper) afterMarkerActivated(ctx sdk.Context, marker types.MarkerAccountI, caller sdk.Address) {
+	k.callHooks(ctx, "AfterMarkerActivated", func(ctx sdk.Context, hooks types.MarkerHooks) {
+		hooks.AfterMarkerActivated(ctx, marker, caller)
+	})
+}
+
+// afterMarkerMinted calls the AfterMarkerMinted hook.
+func (k Keeper) afterMarkerMinted(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin) {
+	k.callHooks(ctx, "AfterMarkerMinted", func(ctx sdk.Context, hooks types.MarkerHooks) {
+		hooks.AfterMarkerMinted(ctx, caller, coin)
+	})
+}
+
+// afterMarkerBurned calls the AfterMarkerBurned hook.
+func (k Keeper) afterMarkerBurned(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin) {
+	k.callHooks(ctx, "AfterMarkerBurned", func(ctx sdk.Context, hooks types.MarkerHooks) {
+		hooks.AfterMarkerBurned(ctx, caller, coin)
+	})
+}
+
+// afterMarkerAccessChanged calls the AfterMarkerAccessChanged hook.
+func (k Keeper) afterMarkerAccessChanged(ctx sdk.Context, marker types.MarkerAccountI, caller, addr sdk.AccAddress) {
+	k.callHooks(ctx, "AfterMarkerAccessChanged", func(ctx sdk.Context, hooks types.MarkerHooks) {
+		hooks.AfterMarkerAccessChanged(ctx, marker, caller, addr)
+	})
+}
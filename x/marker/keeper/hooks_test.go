@@ -0,0 +1,302 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// recordingMarkerHooks is a MarkerHooks that records each call made to it.
+type recordingMarkerHooks struct {
+	// name is included in each recorded call and in the events emitted.
+	name string
+	// calls are the recorded calls.
+	calls []string
+	// panicOn is the name of a hook (e.g. "AfterMarkerMinted") that should panic (after recording the call).
+	panicOn string
+	// panicWith is what's provided to panic.
+	panicWith interface{}
+}
+
+var _ types.MarkerHooks = (*recordingMarkerHooks)(nil)
+
+func newRecordingMarkerHooks(name string) *recordingMarkerHooks {
+	return &recordingMarkerHooks{name: name}
+}
+
+// WithPanic sets up this recorder to panic with the provided value during the provided hook.
+func (h *recordingMarkerHooks) WithPanic(hook string, value interface{}) *recordingMarkerHooks {
+	h.panicOn = hook
+	h.panicWith = value
+	return h
+}
+
+// record records the call and emits an event about it (to make sure hook changes are kept or discarded as expected).
+func (h *recordingMarkerHooks) record(ctx sdk.Context, hook string, args ...interface{}) {
+	call := fmt.Sprintf("%s.%s%v", h.name, hook, args)
+	h.calls = append(h.calls, call)
+	ctx.EventManager().EmitEvent(sdk.NewEvent("marker_hook_called", sdk.NewAttribute("call", call)))
+	if h.panicOn == hook {
+		panic(h.panicWith)
+	}
+}
+
+func (h *recordingMarkerHooks) AfterMarkerActivated(ctx sdk.Context, marker types.MarkerAccountI, caller sdk.Address) {
+	h.record(ctx, "AfterMarkerActivated", marker.GetDenom(), marker.GetStatus(), caller.String())
+}
+
+func (h *recordingMarkerHooks) AfterMarkerMinted(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin) {
+	h.record(ctx, "AfterMarkerMinted", caller.String(), coin.String())
+}
+
+func (h *recordingMarkerHooks) AfterMarkerBurned(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin) {
+	h.record(ctx, "AfterMarkerBurned", caller.String(), coin.String())
+}
+
+func (h *recordingMarkerHooks) AfterMarkerAccessChanged(ctx sdk.Context, marker types.MarkerAccountI, caller, addr sdk.AccAddress) {
+	h.record(ctx, "AfterMarkerAccessChanged", marker.GetDenom(), caller.String(), addr.String(), types.GrantsForAddress(addr, marker.GetAccessList()...).GetAccessList())
+}
+
+// hookCallsInEvents gets the calls recorded in the marker_hook_called events of the provided context.
+func hookCallsInEvents(ctx sdk.Context) []string {
+	var rv []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "marker_hook_called" {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			rv = append(rv, attr.Value)
+		}
+	}
+	return rv
+}
+
+func TestMarkerHooks(t *testing.T) {
+	app := simapp.Setup(t)
+	admin := sdk.AccAddress("admin_______________")
+	other := sdk.AccAddress("other_______________")
+
+	// newKeeper returns a copy of the app's marker keeper with the provided hooks set.
+	newKeeper := func(hooks types.MarkerHooks) keeper.Keeper {
+		k := app.MarkerKeeper
+		k.SetHooks(hooks)
+		return k
+	}
+	// newMarker adds a proposed marker that the admin has full access to.
+	newMarker := func(ctx sdk.Context, denom string) {
+		marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+			*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint, types.Access_Burn, types.Access_Withdraw}),
+		})
+		marker.Supply = sdkmath.NewInt(1000)
+		require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, marker), "AddMarkerAccount(%q)", denom)
+	}
+	// newActiveMarker adds an active marker that the admin has full access to (without any hooks set).
+	newActiveMarker := func(ctx sdk.Context, denom string) {
+		newMarker(ctx, denom)
+		require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, denom), "FinalizeMarker(%q)", denom)
+		require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, denom), "ActivateMarker(%q)", denom)
+	}
+	call := func(hook string, args ...interface{}) string {
+		return fmt.Sprintf("rec.%s%v", hook, args)
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(ctx sdk.Context)
+		action   func(ctx sdk.Context, k keeper.Keeper) error
+		expErr   string
+		expCalls []string
+	}{
+		{
+			name:  "activate",
+			setup: func(ctx sdk.Context) { newMarker(ctx, "activatecoin") },
+			action: func(ctx sdk.Context, k keeper.Keeper) error {
+				if err := k.FinalizeMarker(ctx, admin, "activatecoin"); err != nil {
+					return err
+				}
+				return k.ActivateMarker(ctx, admin, "activatecoin")
+			},
+			expCalls: []string{call("AfterMarkerActivated", "activatecoin", types.StatusActive, admin.String())},
+		},
+		{
+			name:  "activate: fails",
+			setup: func(ctx sdk.Context) { newMarker(ctx, "activatefailcoin") },
+			action: func(ctx sdk.Context, k keeper.Keeper) error {
+				return k.ActivateMarker(ctx, admin, "activatefailcoin")
+			},
+			expErr: "can only activate markeraccounts in the Finalized status",
+		},
+		{
+			name: "add, finalize, and activate",
+			action: func(ctx sdk.Context, k keeper.Keeper) error {
+				marker := types.NewEmptyMarkerAccount("addfinactcoin", admin.String(), []types.AccessGrant{
+					*types.NewAccessGrant(admin, []types.Access{types.Access_Admin}),
+				})
+				marker.Supply = sdkmath.NewInt(100)
+				return k.AddFinalizeAndActivateMarker(ctx, marker)
+			},
+			expCalls: []string{call("AfterMarkerActivated", "addfinactcoin", types.StatusActive, admin.String())},
+		},
+		{
+			name:  "mint",
+			setup: func(ctx sdk.Context) { newActiveMarker(ctx, "mintcoin") },
+			action: func(ctx sdk.Context, k keeper.Keeper) error {
+				return k.MintCoin(ctx, admin, sdk.NewInt64Coin("mintcoin", 5))
+			},
+			expCalls: []string{call("AfterMarkerMinted", admin.String(), "5mintcoin")},
+		},
+		{
+			name:  "mint: proposed marker",
+			setup: func(ctx sdk.Context) { newMarker(ctx, "mintpropcoin") },
+			action: func(ctx sdk.Context, k keeper.Keeper) error {
+				return k.MintCoin(ctx, admin, sdk.NewInt64Coin("mintpropcoin", 6))
+			},
+			expCalls: []string{call("AfterMarkerMinted", admin.String(), "6mintpropcoin")},
+		},
+		{
+			name:  "mint: fails",
+			setup: func(ctx sdk.Context) { newActiveMarker(ctx, "mintfailcoin") },
+			action: func(ctx sdk.Context, k keeper.Keeper) error {
+				return k.MintCoin(ctx, other, sdk.NewInt64Coin("mintfailcoin", 5))
+			},
+			expErr: fmt.Sprintf("%s does not have ACCESS_MINT on mintfailcoin marker (%s)", other, types.MustGetMarkerAddress("mintfailcoin")),
+		},
+		{
+			name:  "burn",
+			setup: func(ctx sdk.Context) { newActiveMarker(ctx, "burncoin") },
+			action: func(ctx sdk.Context, k keeper.Keeper) error {
+				return k.BurnCoin(ctx, admin, sdk.NewInt64Coin("burncoin", 7))
+			},
+			expCalls: []string{call("AfterMarkerBurned", admin.String(), "7burncoin")},
+		},
+		{
+			name:  "burn: fails",
+			setup: func(ctx sdk.Context) { newActiveMarker(ctx, "burnfailcoin") },
+			action: func(ctx sdk.Context, k keeper.Keeper) error {
+				return k.BurnCoin(ctx, other, sdk.NewInt64Coin("burnfailcoin", 7))
+			},
+			expErr: fmt.Sprintf("%s does not have ACCESS_BURN on burnfailcoin marker (%s)", other, types.MustGetMarkerAddress("burnfailcoin")),
+		},
+		{
+			name:  "add access",
+			setup: func(ctx sdk.Context) { newActiveMarker(ctx, "addaccesscoin") },
+			action: func(ctx sdk.Context, k keeper.Keeper) error {
+				return k.AddAccess(ctx, admin, "addaccesscoin", types.NewAccessGrant(other, []types.Access{types.Access_Deposit}))
+			},
+			expCalls: []string{call("AfterMarkerAccessChanged", "addaccesscoin", admin.String(), other.String(), []types.Access{types.Access_Deposit})},
+		},
+		{
+			name:  "remove access",
+			setup: func(ctx sdk.Context) { newActiveMarker(ctx, "removeaccesscoin") },
+			action: func(ctx sdk.Context, k keeper.Keeper) error {
+				if err := app.MarkerKeeper.AddAccess(ctx, admin, "removeaccesscoin", types.NewAccessGrant(other, []types.Access{types.Access_Deposit})); err != nil {
+					return err
+				}
+				return k.RemoveAccess(ctx, admin, "removeaccesscoin", other, false)
+			},
+			expCalls: []string{call("AfterMarkerAccessChanged", "removeaccesscoin", admin.String(), other.String(), []types.Access{})},
+		},
+		{
+			name:  "access change: fails",
+			setup: func(ctx sdk.Context) { newActiveMarker(ctx, "accessfailcoin") },
+			action: func(ctx sdk.Context, k keeper.Keeper) error {
+				return k.RemoveAccess(ctx, other, "accessfailcoin", admin, false)
+			},
+			expErr: other.String() + " is not authorized to make access list changes against finalized/active accessfailcoin marker",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := app.BaseApp.NewContext(false).CacheContext()
+			if tc.setup != nil {
+				tc.setup(ctx)
+			}
+			hooks := newRecordingMarkerHooks("rec")
+			k := newKeeper(hooks)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			err := tc.action(ctx, k)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "action error")
+			} else {
+				assert.NoError(t, err, "action error")
+			}
+			assert.Equal(t, tc.expCalls, hooks.calls, "calls made to hooks")
+			assert.Equal(t, tc.expCalls, hookCallsInEvents(ctx), "calls found in events")
+		})
+	}
+
+	t.Run("no hooks", func(t *testing.T) {
+		ctx, _ := app.BaseApp.NewContext(false).CacheContext()
+		newActiveMarker(ctx, "nohookcoin")
+		assert.Equal(t, types.NoOpMarkerHooks{}, app.MarkerKeeper.GetHooks(), "GetHooks")
+		assert.NoError(t, app.MarkerKeeper.MintCoin(ctx, admin, sdk.NewInt64Coin("nohookcoin", 5)), "MintCoin")
+	})
+
+	t.Run("set hooks twice", func(t *testing.T) {
+		k := newKeeper(newRecordingMarkerHooks("first"))
+		assert.PanicsWithValue(t, "cannot set marker hooks twice", func() {
+			k.SetHooks(newRecordingMarkerHooks("second"))
+		}, "SetHooks")
+	})
+
+	t.Run("multiple hooks", func(t *testing.T) {
+		ctx, _ := app.BaseApp.NewContext(false).CacheContext()
+		newActiveMarker(ctx, "multicoin")
+		hooks1 := newRecordingMarkerHooks("one")
+		hooks2 := newRecordingMarkerHooks("two")
+		k := newKeeper(types.NewMultiMarkerHooks(hooks1, hooks2))
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		require.NoError(t, k.BurnCoin(ctx, admin, sdk.NewInt64Coin("multicoin", 3)), "BurnCoin")
+		expCalls := []string{
+			fmt.Sprintf("one.AfterMarkerBurned[%s 3multicoin]", admin),
+			fmt.Sprintf("two.AfterMarkerBurned[%s 3multicoin]", admin),
+		}
+		assert.Equal(t, expCalls[:1], hooks1.calls, "calls made to hooks1")
+		assert.Equal(t, expCalls[1:], hooks2.calls, "calls made to hooks2")
+		assert.Equal(t, expCalls, hookCallsInEvents(ctx), "calls found in events")
+	})
+
+	t.Run("hook panics", func(t *testing.T) {
+		ctx, _ := app.BaseApp.NewContext(false).CacheContext()
+		newActiveMarker(ctx, "paniccoin")
+		hooks := newRecordingMarkerHooks("rec").WithPanic("AfterMarkerMinted", "oops")
+		k := newKeeper(hooks)
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		// The panic should be recovered and the action should still succeed, but the hook's changes are discarded.
+		require.NotPanics(t, func() {
+			require.NoError(t, k.MintCoin(ctx, admin, sdk.NewInt64Coin("paniccoin", 5)), "MintCoin")
+		}, "MintCoin")
+		assert.Equal(t, []string{call("AfterMarkerMinted", admin.String(), "5paniccoin")}, hooks.calls, "calls made to hooks")
+		assert.Empty(t, hookCallsInEvents(ctx), "calls found in events")
+		marker, err := k.GetMarkerByDenom(ctx, "paniccoin")
+		require.NoError(t, err, "GetMarkerByDenom")
+		assert.Equal(t, sdkmath.NewInt(1005), marker.GetSupply().Amount, "marker supply")
+	})
+
+	t.Run("hook runs out of gas", func(t *testing.T) {
+		ctx, _ := app.BaseApp.NewContext(false).CacheContext()
+		newActiveMarker(ctx, "oogcoin")
+		oog := storetypes.ErrorOutOfGas{Descriptor: "test"}
+		k := newKeeper(newRecordingMarkerHooks("rec").WithPanic("AfterMarkerBurned", oog))
+
+		// Out-of-gas panics need to get back to the tx processing, so they shouldn't be recovered.
+		assert.PanicsWithValue(t, oog, func() {
+			_ = k.BurnCoin(ctx, admin, sdk.NewInt64Coin("oogcoin", 5))
+		}, "BurnCoin")
+	})
+}
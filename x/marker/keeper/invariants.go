@@ -12,15 +12,23 @@ import (
 // The name of the marker supply invariant
 const invariantName = "required-marker-supply"
 
+// The name of the net asset value volume invariant
+const navVolumeInvariantName = "net-asset-value-volume"
+
 // RegisterInvariants registers module invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, mk Keeper, bk bankkeeper.Keeper) {
 	ir.RegisterRoute(types.ModuleName, invariantName, supplyInvariant(mk, bk))
+	ir.RegisterRoute(types.ModuleName, navVolumeInvariantName, navVolumeInvariant(mk))
 }
 
 // AllInvariants runs all invariants of the marker module.
 func AllInvariants(k Keeper, bk bankkeeper.Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return supplyInvariant(k, bk)(ctx)
+		res, stop := supplyInvariant(k, bk)(ctx)
+		if stop {
+			return res, stop
+		}
+		return navVolumeInvariant(k)(ctx)
 	}
 }
 
@@ -58,3 +66,24 @@ func supplyInvariant(mk Keeper, bk bankkeeper.Keeper) sdk.Invariant {
 		return statusMessage, isBroken
 	}
 }
+
+// Checks that every net asset value with a positive price also has a positive volume.
+func navVolumeInvariant(mk Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := 0
+		err := mk.IterateAllNetAssetValues(ctx, func(markerAddr sdk.AccAddress, nav types.NetAssetValue) bool {
+			if nav.Price.Amount.IsPositive() && nav.Volume == 0 {
+				broken++
+				msg += fmt.Sprintf("\t%s net asset value %s has zero volume\n", markerAddr, nav.Price)
+			}
+			return false
+		})
+		if err != nil {
+			broken++
+			msg += fmt.Sprintf("\terror iterating net asset values: %v\n", err)
+		}
+		return sdk.FormatInvariant(types.ModuleName, navVolumeInvariantName,
+			fmt.Sprintf("found %d invalid net asset values\n%s", broken, msg)), broken != 0
+	}
+}
@@ -70,3 +70,36 @@ func TestMarkerInvariant(t *testing.T) {
 	_, isBroken = invariantChecks(ctx)
 	require.False(t, isBroken)
 }
+
+func TestNetAssetValueVolumeInvariant(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	user := testUserAddress("test")
+
+	invariantChecks := markerkeeper.AllInvariants(app.MarkerKeeper, app.BankKeeper)
+
+	mac := markertypes.NewEmptyMarkerAccount("navcoin", user.String(),
+		[]markertypes.AccessGrant{*markertypes.NewAccessGrant(user, []markertypes.Access{markertypes.Access_Admin})})
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+
+	// A zero price with zero volume is allowed.
+	require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, mac, types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 0), 0), "test"))
+	msg, isBroken := invariantChecks(ctx)
+	require.False(t, isBroken, "invariant broken with zero price and zero volume: %s", msg)
+
+	require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, mac, types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 5), 3), "test"))
+	msg, isBroken = invariantChecks(ctx)
+	require.False(t, isBroken, "invariant broken with positive price and volume: %s", msg)
+
+	// SetNetAssetValue won't store a positive price with zero volume, so write it to state directly.
+	badNav := types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 5), 0)
+	bz, err := app.AppCodec().Marshal(&badNav)
+	require.NoError(t, err, "Marshal(badNav)")
+	app.MarkerKeeper.GetStore(ctx).Set(types.NetAssetValueKey(mac.GetAddress(), types.UsdDenom), bz)
+
+	msg, isBroken = invariantChecks(ctx)
+	require.True(t, isBroken, "invariant broken with positive price and zero volume")
+	require.Contains(t, msg, "net-asset-value-volume", "invariant message")
+	require.Contains(t, msg, "found 1 invalid net asset values", "invariant message")
+	require.Contains(t, msg, mac.GetAddress().String()+" net asset value 5usd has zero volume", "invariant message")
+}
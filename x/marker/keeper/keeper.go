@@ -3,7 +3,9 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
+	"time"
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -87,8 +89,25 @@ type Keeper struct {
 
 	// groupChecker provides a way to check if an account is in a group.
 	groupChecker types.GroupChecker
+
+	// queryTimeout is the maximum amount of time that an expensive query is allowed to run.
+	// Zero means there's no limit.
+	queryTimeout time.Duration
+
+	// hooks are called after marker lifecycle actions. It's nil if no hooks have been set.
+	hooks types.MarkerHooks
+
+	// historicalContextFn provides contexts for previous heights. It's nil if it hasn't been set.
+	historicalContextFn HistoricalContextFn
+
+	// restrictionTraces holds the most recent send restriction decision traces (when enabled).
+	// It's a pointer so that it's shared with the copy of this keeper used for the send restriction.
+	restrictionTraces *restrictionTraceRing
 }
 
+// HistoricalContextFn returns a read-only context with the state as it was at the provided height.
+type HistoricalContextFn func(height int64) (sdk.Context, error)
+
 // NewKeeper returns a marker keeper. It handles:
 // - managing MarkerAccounts
 // - enforcing permissions for marker creation/deletion/management
@@ -123,11 +142,29 @@ func NewKeeper(
 		ibcTransferServer:     ibcTransferServer,
 		reqAttrBypassAddrs:    types.NewImmutableAccAddresses(reqAttrBypassAddrs),
 		groupChecker:          checker,
+		restrictionTraces:     newRestrictionTraceRing(),
 	}
 	bankKeeper.AppendSendRestriction(rv.SendRestrictionFn)
 	return rv
 }
 
+// SetQueryTimeout sets the maximum amount of time that an expensive query is allowed to run.
+// A timeout of zero (or less) means there's no limit.
+func (k *Keeper) SetQueryTimeout(timeout time.Duration) {
+	k.queryTimeout = timeout
+}
+
+// SetHistoricalContextFn sets the function used to get the state at previous heights (e.g. for the HoldingDiff query).
+func (k *Keeper) SetHistoricalContextFn(fn HistoricalContextFn) {
+	k.historicalContextFn = fn
+}
+
+// SetRestrictionTraceEnabled sets whether to record the checks made by the send restriction when it rejects a transfer.
+// The traces are only kept in memory and can be looked up using the RestrictionTrace query.
+func (k Keeper) SetRestrictionTraceEnabled(enabled bool) {
+	k.restrictionTraces.setEnabled(enabled)
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -155,9 +192,11 @@ func (k Keeper) GetMarker(ctx sdk.Context, address sdk.AccAddress) (types.Marker
 
 // SetMarker sets a marker in the auth account store will panic if the marker account is not valid or
 // if the auth module account keeper fails to marshall the account.
+// The marker's access list is normalized (see NormalizeAccessControl) before it is written.
 func (k Keeper) SetMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	store := ctx.KVStore(k.storeKey)
 
+	marker.NormalizeAccessControl()
 	if err := marker.Validate(); err != nil {
 		panic(err)
 	}
@@ -273,8 +312,14 @@ func (k Keeper) GetSendDenyList(ctx sdk.Context, markerAddr sdk.AccAddress) []sd
 
 // AddSetNetAssetValues adds a set of net asset values to a marker
 func (k Keeper) AddSetNetAssetValues(ctx sdk.Context, marker types.MarkerAccountI, netAssetValues []types.NetAssetValue, source string) error {
+	return k.addSetNetAssetValues(ctx, marker, netAssetValues, nil, source)
+}
+
+// addSetNetAssetValues adds a set of net asset values to a marker. If changes are provided, there must be one for
+// each net asset value, and they are the changes in per-unit value included in the set net asset value events.
+func (k Keeper) addSetNetAssetValues(ctx sdk.Context, marker types.MarkerAccountI, netAssetValues []types.NetAssetValue, changes []*big.Rat, source string) error {
 	var errs []error
-	for _, nav := range netAssetValues {
+	for i, nav := range netAssetValues {
 		if nav.Price.Denom == marker.GetDenom() {
 			errs = append(errs, fmt.Errorf("net asset value denom cannot match marker denom %q", marker.GetDenom()))
 			continue
@@ -292,7 +337,11 @@ func (k Keeper) AddSetNetAssetValues(ctx sdk.Context, marker types.MarkerAccount
 			}
 		}
 
-		if err := k.SetNetAssetValue(ctx, marker, nav, source); err != nil {
+		var change *big.Rat
+		if changes != nil {
+			change = changes[i]
+		}
+		if err := k.setNetAssetValue(ctx, marker, nav, change, source); err != nil {
 			errs = append(errs, fmt.Errorf("cannot set net asset value: %w", err))
 		}
 	}
@@ -301,12 +350,19 @@ func (k Keeper) AddSetNetAssetValues(ctx sdk.Context, marker types.MarkerAccount
 
 // SetNetAssetValue adds/updates a net asset value to marker
 func (k Keeper) SetNetAssetValue(ctx sdk.Context, marker types.MarkerAccountI, netAssetValue types.NetAssetValue, source string) error {
+	return k.setNetAssetValue(ctx, marker, netAssetValue, nil, source)
+}
+
+// setNetAssetValue adds/updates a net asset value to marker. The change in per-unit value is only used in the event,
+// and is only known when setting the value using Msg/AddNetAssetValues (nil otherwise).
+func (k Keeper) setNetAssetValue(ctx sdk.Context, marker types.MarkerAccountI, netAssetValue types.NetAssetValue, change *big.Rat, source string) error {
 	netAssetValue.UpdatedBlockHeight = uint64(ctx.BlockHeight())
 	if err := netAssetValue.Validate(); err != nil {
 		return err
 	}
 
 	setNetAssetValueEvent := types.NewEventSetNetAssetValue(marker.GetDenom(), netAssetValue.Price, netAssetValue.Volume, source)
+	setNetAssetValueEvent.PercentChange = types.FormatPercentChange(change)
 	if err := ctx.EventManager().EmitTypedEvent(setNetAssetValueEvent); err != nil {
 		return err
 	}
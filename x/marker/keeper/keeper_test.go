@@ -1,8 +1,10 @@
 package keeper_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"testing"
@@ -245,7 +247,7 @@ func TestManageAccess(t *testing.T) {
 	// Grant access fails for caller that is not the manager of a proposed marker
 	require.Error(t, app.MarkerKeeper.AddAccess(
 		ctx, user2, "testcoin", types.NewAccessGrant(user2, []types.Access{types.Access_Burn})))
-	require.Error(t, app.MarkerKeeper.RemoveAccess(ctx, user2, "testcoin", user1))
+	require.Error(t, app.MarkerKeeper.RemoveAccess(ctx, user2, "testcoin", user1, false))
 
 	m, err = app.MarkerKeeper.GetMarker(ctx, addr)
 	require.NoError(t, err)
@@ -257,7 +259,7 @@ func TestManageAccess(t *testing.T) {
 	require.False(t, m.AddressHasAccess(user2, types.Access_Withdraw))
 
 	// Remove access and check
-	require.NoError(t, app.MarkerKeeper.RemoveAccess(ctx, user1, "testcoin", user2))
+	require.NoError(t, app.MarkerKeeper.RemoveAccess(ctx, user1, "testcoin", user2, false))
 
 	m, err = app.MarkerKeeper.GetMarker(ctx, addr)
 	require.NoError(t, err)
@@ -274,12 +276,12 @@ func TestManageAccess(t *testing.T) {
 	require.NoError(t, err)
 
 	// Manager can make changes to grants for finalized markers
-	require.NoError(t, app.MarkerKeeper.RemoveAccess(ctx, user1, "testcoin", user1))
+	require.NoError(t, app.MarkerKeeper.RemoveAccess(ctx, user1, "testcoin", user1, false))
 	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, user1, "testcoin",
 		types.NewAccessGrant(user1, []types.Access{types.Access_Burn})))
 
 	// Unauthorized user can not manipulate finalized marker grants
-	require.Error(t, app.MarkerKeeper.RemoveAccess(ctx, user2, "testcoin", user1))
+	require.Error(t, app.MarkerKeeper.RemoveAccess(ctx, user2, "testcoin", user1, false))
 
 	// Admin can make changes to grants for finalized markers
 	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, admin, "testcoin",
@@ -297,7 +299,7 @@ func TestManageAccess(t *testing.T) {
 	// Admin cannot make changes to grants for cancelled markers
 	require.Error(t, app.MarkerKeeper.AddAccess(ctx, admin, "testcoin",
 		types.NewAccessGrant(user2, []types.Access{types.Access_Burn})))
-	require.Error(t, app.MarkerKeeper.RemoveAccess(ctx, admin, "testcoin", user2))
+	require.Error(t, app.MarkerKeeper.RemoveAccess(ctx, admin, "testcoin", user2, false))
 
 	// Load the marker one last time and verify our permission records are consistent and correct
 	m, err = app.MarkerKeeper.GetMarker(ctx, addr)
@@ -316,6 +318,366 @@ func TestManageAccess(t *testing.T) {
 	require.EqualValues(t, 0, len(m.AddressListForPermission(types.Access_Withdraw)))
 }
 
+// sortedByAddress returns the provided access grants sorted by address bytes, which is the order used by the bulk access events.
+func sortedByAddress(grants []types.AccessGrant) []types.AccessGrant {
+	rv := slices.Clone(grants)
+	slices.SortFunc(rv, func(a, b types.AccessGrant) int {
+		return bytes.Compare(sdk.MustAccAddressFromBech32(a.Address), sdk.MustAccAddressFromBech32(b.Address))
+	})
+	return rv
+}
+
+// newBulkAccessMarker creates, finalizes, and activates a marker (without governance control) for the bulk access tests.
+func newBulkAccessMarker(t testing.TB, app *simapp.App, ctx sdk.Context, denom string, markerType types.MarkerType, grants ...types.AccessGrant) sdk.AccAddress {
+	marker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+		sdk.NewInt64Coin(denom, 100), nil, grants,
+		types.StatusProposed, markerType, true, false, false, nil,
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker(%q)", denom)
+	return marker.GetAddress()
+}
+
+func TestAddAccessBulk(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := testUserAddress("bulkadmin")
+	user1 := testUserAddress("bulkuser1")
+	user2 := testUserAddress("bulkuser2")
+	other := testUserAddress("bulkother")
+	grant := func(addr sdk.AccAddress, perms ...types.Access) types.AccessGrant {
+		return types.AccessGrant{Address: addr.String(), Permissions: perms}
+	}
+	restrictedAddr := newBulkAccessMarker(t, app, ctx, "bulkaddcoin", types.MarkerType_RestrictedCoin,
+		grant(admin, types.Access_Admin), grant(user1, types.Access_Mint))
+	coinAddr := newBulkAccessMarker(t, app, ctx, "bulkaddplaincoin", types.MarkerType_Coin,
+		grant(admin, types.Access_Admin))
+
+	tests := []struct {
+		name       string
+		markerAddr sdk.AccAddress
+		grants     []types.AccessGrant
+		granter    sdk.AccAddress
+		expErr     string
+		expAccess  []types.AccessGrant
+		expEvent   []types.AccessGrant
+	}{
+		{
+			name:       "no grants",
+			markerAddr: restrictedAddr,
+			granter:    admin,
+			expErr:     "no access grants provided",
+		},
+		{
+			name:       "unknown marker",
+			markerAddr: other,
+			grants:     []types.AccessGrant{grant(user2, types.Access_Mint)},
+			granter:    admin,
+			expErr:     "marker not found for " + other.String(),
+		},
+		{
+			name:       "granter not allowed",
+			markerAddr: restrictedAddr,
+			grants:     []types.AccessGrant{grant(user2, types.Access_Mint)},
+			granter:    user1,
+			expErr:     user1.String() + " is not authorized to make access list changes against finalized/active bulkaddcoin marker",
+		},
+		{
+			name:       "invalid address in a later grant",
+			markerAddr: restrictedAddr,
+			grants:     []types.AccessGrant{grant(user2, types.Access_Mint), {Address: "notanaddress", Permissions: []types.Access{types.Access_Burn}}},
+			granter:    admin,
+			expErr:     `invalid access grant [1] for "notanaddress": invalid address: decoding bech32 failed: invalid separator index -1`,
+		},
+		{
+			name:       "permission not applicable to marker type",
+			markerAddr: coinAddr,
+			grants:     []types.AccessGrant{grant(user2, types.Access_Mint), grant(user1, types.Access_Transfer)},
+			granter:    admin,
+			expErr:     `invalid access grant [1] for "` + user1.String() + `": ACCESS_TRANSFER is not supported for marker type MARKER_TYPE_COIN`,
+		},
+		{
+			name:       "duplicate permission in a grant",
+			markerAddr: restrictedAddr,
+			grants:     []types.AccessGrant{grant(user2, types.Access_Mint, types.Access_Mint)},
+			granter:    admin,
+			expErr:     `invalid access grant [0] for "` + user2.String() + `": ` + types.ErrDuplicateAccessEntry.Error(),
+		},
+		{
+			name:       "grant to the marker itself",
+			markerAddr: restrictedAddr,
+			grants:     []types.AccessGrant{grant(user2, types.Access_Mint), grant(restrictedAddr, types.Access_Burn)},
+			granter:    admin,
+			expErr:     "permissions cannot be granted to 'bulkaddcoin' marker account: [ACCESS_BURN]",
+		},
+		{
+			name:       "new addresses",
+			markerAddr: restrictedAddr,
+			grants:     []types.AccessGrant{grant(user2, types.Access_Deposit, types.Access_Withdraw), grant(other, types.Access_Transfer)},
+			granter:    admin,
+			expAccess: []types.AccessGrant{
+				grant(admin, types.Access_Admin), grant(user1, types.Access_Mint),
+				grant(user2, types.Access_Deposit, types.Access_Withdraw), grant(other, types.Access_Transfer),
+			},
+			expEvent: []types.AccessGrant{grant(user2, types.Access_Deposit, types.Access_Withdraw), grant(other, types.Access_Transfer)},
+		},
+		{
+			name:       "merged with each other and existing grants",
+			markerAddr: restrictedAddr,
+			grants: []types.AccessGrant{
+				grant(user1, types.Access_Burn), grant(user2, types.Access_Deposit),
+				grant(user2, types.Access_Withdraw, types.Access_Deposit), grant(user1, types.Access_Mint),
+			},
+			granter: admin,
+			expAccess: []types.AccessGrant{
+				grant(admin, types.Access_Admin), grant(user1, types.Access_Mint, types.Access_Burn),
+				grant(user2, types.Access_Deposit, types.Access_Withdraw),
+			},
+			expEvent: []types.AccessGrant{grant(user1, types.Access_Burn, types.Access_Mint), grant(user2, types.Access_Deposit, types.Access_Withdraw)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var origAccess []types.AccessGrant
+			if orig, _ := app.MarkerKeeper.GetMarker(ctx, tc.markerAddr); orig != nil {
+				origAccess = orig.GetAccessList()
+			}
+			cacheCtx, _ := ctx.CacheContext()
+			cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+			err := app.MarkerKeeper.AddAccessBulk(cacheCtx, tc.markerAddr, tc.grants, tc.granter)
+			assertions.AssertErrorValue(t, err, tc.expErr, "AddAccessBulk error")
+
+			expAccess := tc.expAccess
+			expEvents := sdk.Events{}
+			if len(tc.expErr) > 0 {
+				// Nothing should have changed.
+				expAccess = origAccess
+			} else {
+				marker, err := app.MarkerKeeper.GetMarker(ctx, tc.markerAddr)
+				require.NoError(t, err, "GetMarker for denom")
+				event, err := sdk.TypedEventToEvent(types.NewEventMarkerAddAccessBulk(sortedByAddress(tc.expEvent), marker.GetDenom(), tc.granter.String()))
+				require.NoError(t, err, "TypedEventToEvent")
+				expEvents = sdk.Events{event}
+			}
+			if len(expAccess) > 0 {
+				marker, err := app.MarkerKeeper.GetMarker(cacheCtx, tc.markerAddr)
+				require.NoError(t, err, "GetMarker after AddAccessBulk")
+				assert.ElementsMatch(t, expAccess, marker.GetAccessList(), "access list after AddAccessBulk")
+			}
+			assert.Equal(t, expEvents, cacheCtx.EventManager().Events(), "events emitted by AddAccessBulk")
+		})
+	}
+}
+
+func TestRemoveAccessBulk(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := testUserAddress("bulkadmin")
+	user1 := testUserAddress("bulkuser1")
+	user2 := testUserAddress("bulkuser2")
+	other := testUserAddress("bulkother")
+	grant := func(addr sdk.AccAddress, perms ...types.Access) types.AccessGrant {
+		return types.AccessGrant{Address: addr.String(), Permissions: perms}
+	}
+	markerAddr := newBulkAccessMarker(t, app, ctx, "bulkremovecoin", types.MarkerType_RestrictedCoin,
+		grant(admin, types.Access_Admin, types.Access_Mint),
+		grant(user1, types.Access_Mint, types.Access_Burn, types.Access_Withdraw),
+		grant(user2, types.Access_Deposit, types.Access_Transfer),
+	)
+
+	tests := []struct {
+		name           string
+		revokes        []types.AccessGrant
+		granter        sdk.AccAddress
+		allowLastAdmin bool
+		expErr         string
+		expAccess      []types.AccessGrant
+		expEvent       []types.AccessGrant
+	}{
+		{
+			name:    "no grants",
+			granter: admin,
+			expErr:  "no access grants provided",
+		},
+		{
+			name:    "granter not allowed",
+			revokes: []types.AccessGrant{grant(user2, types.Access_Deposit)},
+			granter: user1,
+			expErr:  user1.String() + " is not authorized to make access list changes against finalized/active bulkremovecoin marker",
+		},
+		{
+			name:    "address without any access",
+			revokes: []types.AccessGrant{grant(user2, types.Access_Deposit), grant(other, types.Access_Mint)},
+			granter: admin,
+			expErr:  "access revoke failed: " + other.String() + " does not have any access on bulkremovecoin marker (" + markerAddr.String() + ")",
+		},
+		{
+			name:    "permission not held",
+			revokes: []types.AccessGrant{grant(user1, types.Access_Mint), grant(user2, types.Access_Mint)},
+			granter: admin,
+			expErr:  "access revoke failed: " + user2.String() + " does not have ACCESS_MINT on bulkremovecoin marker (" + markerAddr.String() + ")",
+		},
+		{
+			name:    "last admin",
+			revokes: []types.AccessGrant{grant(user1, types.Access_Burn), grant(admin, types.Access_Admin)},
+			granter: admin,
+			expErr:  "cannot remove the last ACCESS_ADMIN access grant from active marker bulkremovecoin that is not controlled by governance",
+		},
+		{
+			name:           "last admin allowed",
+			revokes:        []types.AccessGrant{grant(admin, types.Access_Admin)},
+			granter:        admin,
+			allowLastAdmin: true,
+			expAccess: []types.AccessGrant{
+				grant(admin, types.Access_Mint),
+				grant(user1, types.Access_Mint, types.Access_Burn, types.Access_Withdraw),
+				grant(user2, types.Access_Deposit, types.Access_Transfer),
+			},
+			expEvent: []types.AccessGrant{grant(admin, types.Access_Admin)},
+		},
+		{
+			name: "some permissions, merged by address",
+			revokes: []types.AccessGrant{
+				grant(user1, types.Access_Burn), grant(user2, types.Access_Transfer), grant(user1, types.Access_Mint),
+			},
+			granter: admin,
+			expAccess: []types.AccessGrant{
+				grant(admin, types.Access_Admin, types.Access_Mint),
+				grant(user1, types.Access_Withdraw),
+				grant(user2, types.Access_Deposit),
+			},
+			expEvent: []types.AccessGrant{grant(user1, types.Access_Burn, types.Access_Mint), grant(user2, types.Access_Transfer)},
+		},
+		{
+			name:    "all permissions of an address",
+			revokes: []types.AccessGrant{grant(user1), grant(user2, types.Access_Deposit, types.Access_Transfer)},
+			granter: admin,
+			expAccess: []types.AccessGrant{
+				grant(admin, types.Access_Admin, types.Access_Mint),
+			},
+			expEvent: []types.AccessGrant{
+				grant(user1, types.Access_Mint, types.Access_Burn, types.Access_Withdraw),
+				grant(user2, types.Access_Deposit, types.Access_Transfer),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			orig, err := app.MarkerKeeper.GetMarker(ctx, markerAddr)
+			require.NoError(t, err, "GetMarker before RemoveAccessBulk")
+			cacheCtx, _ := ctx.CacheContext()
+			cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+			err = app.MarkerKeeper.RemoveAccessBulk(cacheCtx, markerAddr, tc.revokes, tc.granter, tc.allowLastAdmin)
+			assertions.AssertErrorValue(t, err, tc.expErr, "RemoveAccessBulk error")
+
+			expAccess := tc.expAccess
+			expEvents := sdk.Events{}
+			if len(tc.expErr) > 0 {
+				// Nothing should have changed.
+				expAccess = orig.GetAccessList()
+			} else {
+				event, err := sdk.TypedEventToEvent(types.NewEventMarkerDeleteAccessBulk(sortedByAddress(tc.expEvent), "bulkremovecoin", tc.granter.String()))
+				require.NoError(t, err, "TypedEventToEvent")
+				expEvents = sdk.Events{event}
+			}
+			marker, err := app.MarkerKeeper.GetMarker(cacheCtx, markerAddr)
+			require.NoError(t, err, "GetMarker after RemoveAccessBulk")
+			assert.ElementsMatch(t, expAccess, marker.GetAccessList(), "access list after RemoveAccessBulk")
+			assert.Equal(t, expEvents, cacheCtx.EventManager().Events(), "events emitted by RemoveAccessBulk")
+		})
+	}
+}
+
+// BenchmarkAddAccess500 compares adding 500 access grants to a marker all at once using AddAccessBulk
+// with adding them one at a time using AddAccess.
+func BenchmarkAddAccess500(b *testing.B) {
+	app := simapp.Setup(b)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := testUserAddress("benchadmin")
+	markerAddr := newBulkAccessMarker(b, app, ctx, "benchaccesscoin", types.MarkerType_RestrictedCoin,
+		types.AccessGrant{Address: admin.String(), Permissions: []types.Access{types.Access_Admin}})
+	grants := make([]types.AccessGrant, 500)
+	for i := range grants {
+		grants[i] = types.AccessGrant{
+			Address:     sdk.AccAddress(fmt.Sprintf("bench_grantee_%06d", i)).String(),
+			Permissions: []types.Access{types.Access_Deposit, types.Access_Transfer},
+		}
+	}
+
+	b.Run("bulk", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			cacheCtx, _ := ctx.CacheContext()
+			if err := app.MarkerKeeper.AddAccessBulk(cacheCtx, markerAddr, grants, admin); err != nil {
+				b.Fatalf("AddAccessBulk error: %v", err)
+			}
+		}
+	})
+
+	b.Run("loop", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			cacheCtx, _ := ctx.CacheContext()
+			for j := range grants {
+				if err := app.MarkerKeeper.AddAccess(cacheCtx, admin, "benchaccesscoin", &grants[j]); err != nil {
+					b.Fatalf("AddAccess[%d] error: %v", j, err)
+				}
+			}
+		}
+	})
+}
+
+// BenchmarkRemoveAccess500 compares removing 500 access grants from a marker all at once using RemoveAccessBulk
+// with removing them one at a time using RemoveAccess.
+func BenchmarkRemoveAccess500(b *testing.B) {
+	app := simapp.Setup(b)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := testUserAddress("benchadmin")
+	grants := make([]types.AccessGrant, 500)
+	revokes := make([]types.AccessGrant, len(grants))
+	for i := range grants {
+		addr := sdk.AccAddress(fmt.Sprintf("bench_grantee_%06d", i)).String()
+		grants[i] = types.AccessGrant{Address: addr, Permissions: []types.Access{types.Access_Deposit, types.Access_Transfer}}
+		revokes[i] = types.AccessGrant{Address: addr}
+	}
+	markerAddr := newBulkAccessMarker(b, app, ctx, "benchaccesscoin", types.MarkerType_RestrictedCoin,
+		append([]types.AccessGrant{{Address: admin.String(), Permissions: []types.Access{types.Access_Admin}}}, grants...)...)
+
+	b.Run("bulk", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			cacheCtx, _ := ctx.CacheContext()
+			if err := app.MarkerKeeper.RemoveAccessBulk(cacheCtx, markerAddr, revokes, admin, false); err != nil {
+				b.Fatalf("RemoveAccessBulk error: %v", err)
+			}
+		}
+	})
+
+	b.Run("loop", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			cacheCtx, _ := ctx.CacheContext()
+			for j := range revokes {
+				removed, err := sdk.AccAddressFromBech32(revokes[j].Address)
+				if err != nil {
+					b.Fatalf("revokes[%d] address error: %v", j, err)
+				}
+				if err = app.MarkerKeeper.RemoveAccess(cacheCtx, admin, "benchaccesscoin", removed, false); err != nil {
+					b.Fatalf("RemoveAccess[%d] error: %v", j, err)
+				}
+			}
+		}
+	})
+}
+
 func TestCancelProposedByManager(t *testing.T) {
 	app := simapp.Setup(t)
 	ctx := app.BaseApp.NewContext(false)
@@ -2163,12 +2525,12 @@ func TestMsgUpdateRequiredAttributesRequest(t *testing.T) {
 		{
 			name:             "should fail, can not normalize remove list entry",
 			updateMsgRequest: *types.NewMsgUpdateRequiredAttributesRequest(rMarkerDenom, transferAuthUser, []string{"?$#"}, []string{}),
-			expectedError:    "value provided for name is invalid",
+			expectedError:    `invalid required attribute "?$#": illegal character "?" in name segment "?$#"`,
 		},
 		{
 			name:             "should fail, can not normalize add list entry",
 			updateMsgRequest: *types.NewMsgUpdateRequiredAttributesRequest(rMarkerDenom, transferAuthUser, []string{}, []string{"?$#"}),
-			expectedError:    "value provided for name is invalid",
+			expectedError:    `invalid required attribute "?$#": illegal character "?" in name segment "?$#"`,
 		},
 		{
 			name:             "should fail, remove value does not exist",
@@ -2190,6 +2552,11 @@ func TestMsgUpdateRequiredAttributesRequest(t *testing.T) {
 			updateMsgRequest: *types.NewMsgUpdateRequiredAttributesRequest(rMarkerDenom, transferAuthUser, []string{}, []string{"foo2.provenance.io", "*.jackthecat.io"}),
 			expectedReqAttr:  []string{"*.provenance.io", "bar.provenance.io", "foo2.provenance.io", "*.jackthecat.io"},
 		},
+		{
+			name:             "should succeed, added elements are normalized",
+			updateMsgRequest: *types.NewMsgUpdateRequiredAttributesRequest(rMarkerDenom, transferAuthUser, []string{"*.JackTheCat.io"}, []string{" KYC.Provenance.IO "}),
+			expectedReqAttr:  []string{"*.provenance.io", "bar.provenance.io", "foo2.provenance.io", "kyc.provenance.io"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -3085,6 +3452,10 @@ func (d dummyBankKeeper) DenomOwners(_ context.Context, _ *banktypes.QueryDenomO
 	return nil, nil
 }
 
+func (d dummyBankKeeper) AllBalances(_ context.Context, _ *banktypes.QueryAllBalancesRequest) (*banktypes.QueryAllBalancesResponse, error) {
+	return nil, nil
+}
+
 func (d dummyBankKeeper) SendCoins(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
 	return nil
 }
@@ -3105,6 +3476,8 @@ func (d dummyBankKeeper) AppendSendRestriction(_ banktypes.SendRestrictionFn) {}
 
 func (d dummyBankKeeper) BlockedAddr(_ sdk.AccAddress) bool { return false }
 
+func (d dummyBankKeeper) IsSendEnabledDenom(_ context.Context, _ string) bool { return true }
+
 func (d dummyBankKeeper) GetDenomMetaData(_ context.Context, _ string) (banktypes.Metadata, bool) {
 	return banktypes.Metadata{}, false
 }
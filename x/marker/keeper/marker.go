@@ -90,41 +90,64 @@ func (k Keeper) AddAccess(
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %w", denom, err)
 	}
-	switch m.GetStatus() {
-	// marker is fixed/active, assert permission to make changes by checking for Grant Permission
-	case types.StatusFinalized, types.StatusActive:
-		if !(caller.Equals(m.GetManager()) && m.GetStatus() == types.StatusFinalized) &&
-			!m.AddressHasAccess(caller, types.Access_Admin) &&
-			!k.accountControlsAllSupply(ctx, caller, m) {
-			return fmt.Errorf("%s is not authorized to make access list changes against finalized/active %s marker",
-				caller, m.GetDenom())
-		}
-		fallthrough
-	case types.StatusProposed:
-		mgr := m.GetManager()
-		// Check to see if fromAddr is the creator (and status is proposed against fallthrough case)
-		if !mgr.Equals(caller) && m.GetStatus() == types.StatusProposed {
-			return fmt.Errorf("updates to pending marker %s can only be made by %s", m.GetDenom(), mgr)
-		}
-		if err = m.GrantAccess(grant); err != nil {
-			return fmt.Errorf("access grant failed: %w", err)
-		}
-		if err := m.Validate(); err != nil {
-			return err
-		}
-		k.SetMarker(ctx, m)
-	// Undefined, Cancelled, Destroyed -- no modifications are supported in these states
-	default:
-		return fmt.Errorf("marker in %s state can not be modified", m.GetStatus())
+	if err = k.validateCanChangeAccess(ctx, caller, m); err != nil {
+		return err
+	}
+	if err = m.GrantAccess(grant); err != nil {
+		return fmt.Errorf("access grant failed: %w", err)
+	}
+	if err = m.Validate(); err != nil {
+		return err
 	}
+	k.SetMarker(ctx, m)
+
+	k.afterMarkerAccessChanged(ctx, m, caller, grant.GetAddress())
 
 	markerAddAccessEvent := types.NewEventMarkerAddAccess(grant, denom, caller.String())
 
 	return ctx.EventManager().EmitTypedEvent(markerAddAccessEvent)
 }
 
-// RemoveAccess delete the AccessGrant for the specified user from the marker if the caller is allowed to make changes
-func (k Keeper) RemoveAccess(ctx sdk.Context, caller sdk.AccAddress, denom string, remove sdk.AccAddress) error {
+// AddAccessBulk adds all of the provided access grants to a marker if the granter is allowed to make changes.
+// The marker is only loaded, validated, and written once, no matter how many grants there are.
+// Grants for the same address (including one already on the marker) are combined.
+// If any grant is invalid or not applicable to the marker's type, an error is returned and nothing is changed.
+// A single EventMarkerAddAccessBulk is emitted with all of the provided grants.
+func (k Keeper) AddAccessBulk(ctx sdk.Context, markerAddr sdk.AccAddress, grants []types.AccessGrant, granter sdk.AccAddress) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "add_access_bulk")
+
+	if len(grants) == 0 {
+		return fmt.Errorf("no access grants provided")
+	}
+	m, err := k.getMarkerForAccessChange(ctx, markerAddr, granter)
+	if err != nil {
+		return err
+	}
+	for i := range grants {
+		if err = types.ValidateGrantsForMarkerType(m.GetMarkerType(), grants[i]); err != nil {
+			return fmt.Errorf("invalid access grant [%d] for %q: %w", i, grants[i].Address, err)
+		}
+	}
+	added, err := m.GrantAccessBulk(grants)
+	if err != nil {
+		return fmt.Errorf("access grant failed: %w", err)
+	}
+	if err = m.Validate(); err != nil {
+		return err
+	}
+	k.SetMarker(ctx, m)
+
+	for _, grant := range added {
+		k.afterMarkerAccessChanged(ctx, m, granter, grant.GetAddress())
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerAddAccessBulk(added, m.GetDenom(), granter.String()))
+}
+
+// RemoveAccess delete the AccessGrant for the specified user from the marker if the caller is allowed to make changes.
+// Removing the last ADMIN access grant from an active marker that is not controlled by governance is rejected
+// unless allowLastAdmin is true.
+func (k Keeper) RemoveAccess(ctx sdk.Context, caller sdk.AccAddress, denom string, remove sdk.AccAddress, allowLastAdmin bool) error {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "remove_access")
 
 	// (if marker does not exist then fail)
@@ -132,6 +155,85 @@ func (k Keeper) RemoveAccess(ctx sdk.Context, caller sdk.AccAddress, denom strin
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %w", denom, err)
 	}
+	if err = k.validateCanChangeAccess(ctx, caller, m); err != nil {
+		return err
+	}
+	if !allowLastAdmin {
+		if err = types.CheckLastAdminRemoval(m, remove); err != nil {
+			return err
+		}
+	}
+	if err = m.RevokeAccess(remove); err != nil {
+		return fmt.Errorf("access revoke failed: %w", err)
+	}
+	if err = m.Validate(); err != nil {
+		return err
+	}
+	k.SetMarker(ctx, m)
+
+	k.afterMarkerAccessChanged(ctx, m, caller, remove)
+
+	markerDeleteAccessEvent := types.NewEventMarkerDeleteAccess(remove.String(), denom, caller.String())
+
+	return ctx.EventManager().EmitTypedEvent(markerDeleteAccessEvent)
+}
+
+// RemoveAccessBulk removes the permissions in each of the provided access grants from a marker if the granter
+// is allowed to make changes. A grant without any permissions removes all of that address's permissions.
+// The marker is only loaded, validated, and written once, no matter how many grants there are.
+// If an address does not have a permission being removed, an error is returned and nothing is changed.
+// Removing the last ADMIN access from an active marker that is not controlled by governance is rejected
+// unless allowLastAdmin is true.
+// A single EventMarkerDeleteAccessBulk is emitted with all of the permissions that were removed.
+func (k Keeper) RemoveAccessBulk(ctx sdk.Context, markerAddr sdk.AccAddress, revokes []types.AccessGrant, granter sdk.AccAddress, allowLastAdmin bool) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "remove_access_bulk")
+
+	if len(revokes) == 0 {
+		return fmt.Errorf("no access grants provided")
+	}
+	m, err := k.getMarkerForAccessChange(ctx, markerAddr, granter)
+	if err != nil {
+		return err
+	}
+	hadAdmin := len(m.AddressListForPermission(types.Access_Admin)) > 0
+	removed, err := m.RevokeAccessBulk(revokes)
+	if err != nil {
+		return fmt.Errorf("access revoke failed: %w", err)
+	}
+	if !allowLastAdmin && hadAdmin && m.GetStatus() == types.StatusActive && !m.HasGovernanceEnabled() &&
+		len(m.AddressListForPermission(types.Access_Admin)) == 0 {
+		return fmt.Errorf("cannot remove the last %s access grant from active marker %s that is not controlled by governance",
+			types.Access_Admin, m.GetDenom())
+	}
+	if err = m.Validate(); err != nil {
+		return err
+	}
+	k.SetMarker(ctx, m)
+
+	for _, grant := range removed {
+		k.afterMarkerAccessChanged(ctx, m, granter, grant.GetAddress())
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDeleteAccessBulk(removed, m.GetDenom(), granter.String()))
+}
+
+// getMarkerForAccessChange gets the marker with the provided address, and makes sure the caller can change its access list.
+func (k Keeper) getMarkerForAccessChange(ctx sdk.Context, markerAddr, caller sdk.AccAddress) (types.MarkerAccountI, error) {
+	m, err := k.GetMarker(ctx, markerAddr)
+	if err != nil {
+		return nil, fmt.Errorf("could not get marker %s: %w", markerAddr, err)
+	}
+	if m == nil {
+		return nil, fmt.Errorf("marker not found for %s", markerAddr)
+	}
+	if err = k.validateCanChangeAccess(ctx, caller, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// validateCanChangeAccess returns an error if the caller is not allowed to change the access list of the provided marker.
+func (k Keeper) validateCanChangeAccess(ctx sdk.Context, caller sdk.AccAddress, m types.MarkerAccountI) error {
 	switch m.GetStatus() {
 	// marker is fixed/active, assert permission to make changes by checking for Grant Permission
 	case types.StatusFinalized, types.StatusActive:
@@ -141,28 +243,18 @@ func (k Keeper) RemoveAccess(ctx sdk.Context, caller sdk.AccAddress, denom strin
 			return fmt.Errorf("%s is not authorized to make access list changes against finalized/active %s marker",
 				caller, m.GetDenom())
 		}
-		fallthrough
+		return nil
 	case types.StatusProposed:
+		// Pending markers can only be changed by their creator.
 		mgr := m.GetManager()
-		// Check to see if fromAddr is the creator (and status is proposed against fallthrough case)
-		if !mgr.Equals(caller) && m.GetStatus() == types.StatusProposed {
-			return fmt.Errorf("updates to pending marker %s can only be made by %s", m.GetDenom(), mgr.String())
-		}
-		if err = m.RevokeAccess(remove); err != nil {
-			return fmt.Errorf("access revoke failed: %w", err)
-		}
-		if err := m.Validate(); err != nil {
-			return err
+		if !mgr.Equals(caller) {
+			return fmt.Errorf("updates to pending marker %s can only be made by %s", m.GetDenom(), mgr)
 		}
-		k.SetMarker(ctx, m)
+		return nil
 	// Undefined, Cancelled, Destroyed -- no modifications are supported in these states
 	default:
 		return fmt.Errorf("marker in %s state can not be modified", m.GetStatus())
 	}
-
-	markerDeleteAccessEvent := types.NewEventMarkerDeleteAccess(remove.String(), denom, caller.String())
-
-	return ctx.EventManager().EmitTypedEvent(markerDeleteAccessEvent)
 }
 
 // WithdrawCoins removes the specified coins from the MarkerAccount (both marker denominated coins and coins as assets
@@ -246,6 +338,8 @@ func (k Keeper) MintCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 		}
 	}
 
+	k.afterMarkerMinted(ctx, caller, coin)
+
 	markerMintEvent := types.NewEventMarkerMint(coin.Amount.String(), coin.Denom, caller.String())
 
 	return ctx.EventManager().EmitTypedEvent(markerMintEvent)
@@ -285,6 +379,8 @@ func (k Keeper) BurnCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 		}
 	}
 
+	k.afterMarkerBurned(ctx, caller, coin)
+
 	markerBurnEvent := types.NewEventMarkerBurn(coin.Amount.String(), coin.Denom, caller.String())
 
 	return ctx.EventManager().EmitTypedEvent(markerBurnEvent)
@@ -336,7 +432,10 @@ func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI,
 		if err := k.bankKeeper.BurnCoins(ctx, types.CoinPoolName, sdk.NewCoins(offset)); err != nil {
 			return fmt.Errorf("could not burn coin %v %w", offset, err)
 		}
+	} else {
+		return nil
 	}
+	k.emitSupplyHoldingThresholdEvents(ctx, marker, currentSupply, desiredSupply.Amount)
 	return nil
 }
 
@@ -510,6 +609,8 @@ func (k Keeper) ActivateMarker(ctx sdk.Context, caller sdk.Address, denom string
 	// record status as active
 	k.SetMarker(ctx, m)
 
+	k.afterMarkerActivated(ctx, m, caller)
+
 	markerActivateEvent := types.NewEventMarkerActivate(denom, caller.String())
 
 	return ctx.EventManager().EmitTypedEvent(markerActivateEvent)
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetMarkerValue gets the value of the full supply of the provided marker, in the provided price denom.
+// The value is calculated as supply * price / volume (truncated) using the marker's net asset value in the price denom.
+// If the marker does not have a net asset value in the price denom, nil is returned without an error.
+// An error is returned if the value cannot be calculated without overflowing.
+func (k Keeper) GetMarkerValue(ctx sdk.Context, marker types.MarkerAccountI, priceDenom string) (*types.MarkerValue, error) {
+	nav, err := k.getLatestNetAssetValue(ctx, marker.GetAddress(), priceDenom)
+	if err != nil {
+		return nil, fmt.Errorf("could not read net asset values of %q: %w", marker.GetDenom(), err)
+	}
+	if nav == nil {
+		return nil, nil
+	}
+
+	supply := k.CurrentCirculation(ctx, marker)
+	value, err := calculateMarkerValue(supply, *nav)
+	if err != nil {
+		return nil, fmt.Errorf("could not calculate value of %q in %q: %w", marker.GetDenom(), priceDenom, err)
+	}
+
+	return &types.MarkerValue{
+		Denom:         marker.GetDenom(),
+		Supply:        supply,
+		Value:         sdk.NewCoin(priceDenom, value),
+		NetAssetValue: *nav,
+	}, nil
+}
+
+// GetAllMarkersValue gets the value of the full supply of every marker that has a net asset value in the provided price denom.
+// Returns the sum of those values, each of the marker values, and the denoms of the markers that were skipped
+// because they do not have a net asset value in the price denom.
+// An error is returned if any value (or the sum) cannot be calculated without overflowing.
+func (k Keeper) GetAllMarkersValue(ctx sdk.Context, priceDenom string) (sdk.Coin, []types.MarkerValue, []string, error) {
+	total := sdkmath.ZeroInt()
+	var values []types.MarkerValue
+	var skipped []string
+	var err error
+	k.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		if err = checkQueryDeadline(ctx); err != nil {
+			return true
+		}
+
+		var mv *types.MarkerValue
+		mv, err = k.GetMarkerValue(ctx, marker, priceDenom)
+		if err != nil {
+			return true
+		}
+		if mv == nil {
+			skipped = append(skipped, marker.GetDenom())
+			return false
+		}
+
+		total, err = total.SafeAdd(mv.Value.Amount)
+		if err != nil {
+			err = fmt.Errorf("total value in %q is too large: %w", priceDenom, err)
+			return true
+		}
+		values = append(values, *mv)
+		return false
+	})
+	if err != nil {
+		return sdk.Coin{}, nil, nil, err
+	}
+
+	return sdk.NewCoin(priceDenom, total), values, skipped, nil
+}
+
+// getLatestNetAssetValue gets the net asset value of a marker that has the provided price denom.
+// If there are several, the one updated most recently is returned. Returns nil if there aren't any.
+func (k Keeper) getLatestNetAssetValue(ctx sdk.Context, markerAddr sdk.AccAddress, priceDenom string) (*types.NetAssetValue, error) {
+	var rv *types.NetAssetValue
+	err := k.IterateNetAssetValues(ctx, markerAddr, func(nav types.NetAssetValue) bool {
+		if nav.Price.Denom == priceDenom && (rv == nil || nav.UpdatedBlockHeight >= rv.UpdatedBlockHeight) {
+			rv = &nav
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// calculateMarkerValue returns supply * price / volume (truncated), or an error if that would overflow.
+func calculateMarkerValue(supply sdkmath.Int, nav types.NetAssetValue) (sdkmath.Int, error) {
+	if nav.Volume == 0 {
+		if !nav.Price.Amount.IsZero() {
+			return sdkmath.Int{}, fmt.Errorf("net asset value %s has a volume of zero", nav.Price)
+		}
+		return sdkmath.ZeroInt(), nil
+	}
+
+	product, err := supply.SafeMul(nav.Price.Amount)
+	if err != nil {
+		return sdkmath.Int{}, fmt.Errorf("supply %s times price %s is too large: %w", supply, nav.Price, err)
+	}
+	return product.SafeQuo(sdkmath.NewIntFromUint64(nav.Volume))
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestMarkerValue(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	addMarker := func(ctx sdk.Context, denom string, supply int64, navs ...types.NetAssetValue) types.MarkerAccountI {
+		marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+			*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint}),
+		})
+		marker.Supply = sdkmath.NewInt(supply)
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker(%q)", denom)
+		for _, nav := range navs {
+			require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, marker, nav, "test"), "SetNetAssetValue(%q, %s)", denom, nav.Price)
+		}
+		return marker
+	}
+	hugePrice := sdk.NewCoin("usd", sdkmath.NewIntWithDecimal(1, 74))
+
+	var existing []string
+	app.MarkerKeeper.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		existing = append(existing, marker.GetDenom())
+		return false
+	})
+	require.Empty(t, existing, "markers that already exist")
+
+	acoin := addMarker(ctx, "acoin", 1000, types.NewNetAssetValue(sdk.NewInt64Coin("usd", 7), 3))
+	addMarker(ctx, "bcoin", 50, types.NewNetAssetValue(sdk.NewInt64Coin("nhash", 2), 1), types.NewNetAssetValue(sdk.NewInt64Coin("usd", 4), 10))
+	addMarker(ctx, "ccoin", 20, types.NewNetAssetValue(sdk.NewInt64Coin("nhash", 5), 1))
+
+	t.Run("truncated value", func(t *testing.T) {
+		mv, err := app.MarkerKeeper.GetMarkerValue(ctx, acoin, "usd")
+		require.NoError(t, err, "GetMarkerValue")
+		require.NotNil(t, mv, "GetMarkerValue")
+		assert.Equal(t, "acoin", mv.Denom, "Denom")
+		assert.Equal(t, "1000", mv.Supply.String(), "Supply")
+		assert.Equal(t, "2333usd", mv.Value.String(), "Value")
+		assert.Equal(t, "7usd", mv.NetAssetValue.Price.String(), "NetAssetValue.Price")
+		assert.Equal(t, uint64(3), mv.NetAssetValue.Volume, "NetAssetValue.Volume")
+		assert.Equal(t, uint64(ctx.BlockHeight()), mv.NetAssetValue.UpdatedBlockHeight, "NetAssetValue.UpdatedBlockHeight")
+	})
+
+	t.Run("no nav in price denom", func(t *testing.T) {
+		mv, err := app.MarkerKeeper.GetMarkerValue(ctx, acoin, "nhash")
+		assert.NoError(t, err, "GetMarkerValue")
+		assert.Nil(t, mv, "GetMarkerValue")
+	})
+
+	t.Run("query by address", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.MarkerValue(ctx, &types.QueryMarkerValueRequest{Id: acoin.GetAddress().String(), PriceDenom: "usd"})
+		require.NoError(t, err, "MarkerValue")
+		assert.Equal(t, "acoin", resp.MarkerValue.Denom, "MarkerValue denom")
+		assert.Equal(t, "2333usd", resp.MarkerValue.Value.String(), "MarkerValue value")
+	})
+
+	t.Run("query no nav", func(t *testing.T) {
+		_, err := app.MarkerKeeper.MarkerValue(ctx, &types.QueryMarkerValueRequest{Id: "ccoin", PriceDenom: "usd"})
+		assert.EqualError(t, err, `rpc error: code = NotFound desc = marker "ccoin" does not have a net asset value in "usd"`, "MarkerValue")
+	})
+
+	t.Run("query invalid price denom", func(t *testing.T) {
+		_, err := app.MarkerKeeper.MarkerValue(ctx, &types.QueryMarkerValueRequest{Id: "acoin", PriceDenom: "x"})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid price denom: invalid denom: x", "MarkerValue")
+		_, err = app.MarkerKeeper.AllMarkersValue(ctx, &types.QueryAllMarkersValueRequest{PriceDenom: ""})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid price denom: invalid denom: ", "AllMarkersValue")
+	})
+
+	t.Run("query all", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.AllMarkersValue(ctx, &types.QueryAllMarkersValueRequest{PriceDenom: "usd"})
+		require.NoError(t, err, "AllMarkersValue")
+		assert.Equal(t, "2353usd", resp.Total.String(), "AllMarkersValue total")
+		require.Len(t, resp.MarkerValues, 2, "AllMarkersValue marker values")
+		denoms := []string{resp.MarkerValues[0].Denom, resp.MarkerValues[1].Denom}
+		assert.ElementsMatch(t, []string{"acoin", "bcoin"}, denoms, "AllMarkersValue marker value denoms")
+		assert.Equal(t, []string{"ccoin"}, resp.SkippedDenoms, "AllMarkersValue skipped denoms")
+	})
+
+	t.Run("query all nothing priced", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.AllMarkersValue(ctx, &types.QueryAllMarkersValueRequest{PriceDenom: "eur"})
+		require.NoError(t, err, "AllMarkersValue")
+		assert.Equal(t, "0eur", resp.Total.String(), "AllMarkersValue total")
+		assert.Empty(t, resp.MarkerValues, "AllMarkersValue marker values")
+		assert.ElementsMatch(t, []string{"acoin", "bcoin", "ccoin"}, resp.SkippedDenoms, "AllMarkersValue skipped denoms")
+	})
+
+	t.Run("value overflow", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		big := addMarker(cacheCtx, "bigcoin", 1_000_000, types.NewNetAssetValue(hugePrice, 1))
+		_, err := app.MarkerKeeper.GetMarkerValue(cacheCtx, big, "usd")
+		assert.ErrorContains(t, err, `could not calculate value of "bigcoin" in "usd": supply 1000000 times price `, "GetMarkerValue")
+		assert.ErrorContains(t, err, "is too large: integer overflow", "GetMarkerValue")
+	})
+
+	t.Run("total overflow", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		addMarker(cacheCtx, "bigcoin1", 1000, types.NewNetAssetValue(hugePrice, 1))
+		addMarker(cacheCtx, "bigcoin2", 1000, types.NewNetAssetValue(hugePrice, 1))
+		_, err := app.MarkerKeeper.AllMarkersValue(cacheCtx, &types.QueryAllMarkersValueRequest{PriceDenom: "usd"})
+		assert.EqualError(t, err, `rpc error: code = InvalidArgument desc = total value in "usd" is too large: integer overflow`, "AllMarkersValue")
+	})
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// Migrate2To3 will update the marker store from version 2 to version 3.
+// It combines duplicate access grants and sorts the access list of all existing markers.
+// An EventMarkerAccessListsNormalized is emitted with the number of markers changed and grants merged.
+func (m Migrator) Migrate2To3(ctx sdk.Context) error {
+	logger := m.keeper.Logger(ctx)
+	logger.Info("Starting migration of x/marker from 2 to 3.")
+
+	var toUpdate []types.MarkerAccountI
+	merged := 0
+	m.keeper.IterateMarkers(ctx, func(marker types.MarkerAccountI) (stop bool) {
+		orig := marker.GetAccessList()
+		normalized, count := types.NormalizeAccessGrants(orig)
+		if !slices.EqualFunc(orig, normalized, accessGrantsEqual) {
+			toUpdate = append(toUpdate, marker)
+			merged += count
+		}
+		return false
+	})
+
+	for _, marker := range toUpdate {
+		m.keeper.SetMarker(ctx, marker)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerAccessListsNormalized(len(toUpdate), merged)); err != nil {
+		return err
+	}
+
+	logger.Info("Done migrating x/marker from 2 to 3.", "markers", len(toUpdate), "merged_grants", merged)
+	return nil
+}
+
+// accessGrantsEqual returns true if the two access grants have the same address and permissions (in the same order).
+func accessGrantsEqual(a, b types.AccessGrant) bool {
+	return a.Address == b.Address && slices.Equal(a.Permissions, b.Permissions)
+}
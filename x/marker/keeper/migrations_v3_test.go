@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestMigrate2To3(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	grant := func(addr sdk.AccAddress, perms ...types.Access) types.AccessGrant {
+		return types.AccessGrant{Address: addr.String(), Permissions: perms}
+	}
+
+	newMarker := func(denom string, grants ...types.AccessGrant) *types.MarkerAccount {
+		marker := types.NewEmptyMarkerAccount(denom, addr1.String(), []types.AccessGrant{grant(addr1, types.Access_Admin)})
+		marker.Supply = sdkmath.NewInt(100)
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker(%q)", denom)
+		// Write the access list directly to the account store so it isn't normalized (like it could be in v2).
+		acct, err := app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+		require.NoError(t, err, "GetMarkerByDenom(%q)", denom)
+		rv := acct.(*types.MarkerAccount)
+		rv.AccessControl = grants
+		app.AccountKeeper.SetAccount(ctx, rv)
+		return rv
+	}
+
+	dupCoin := newMarker("dupcoin",
+		grant(addr3, types.Access_Admin),
+		grant(addr1, types.Access_Mint),
+		grant(addr3, types.Access_Burn),
+		grant(addr2, types.Access_Withdraw),
+		grant(addr1, types.Access_Mint, types.Access_Deposit),
+	)
+	sortedCoin := newMarker("sortedcoin",
+		grant(addr1, types.Access_Admin),
+		grant(addr2, types.Access_Mint),
+	)
+
+	getAccessList := func(denom string) []types.AccessGrant {
+		marker, err := app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+		require.NoError(t, err, "GetMarkerByDenom(%q)", denom)
+		return marker.GetAccessList()
+	}
+	require.Equal(t, dupCoin.AccessControl, getAccessList("dupcoin"), "dupcoin access list before migration")
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err := keeper.NewMigrator(app.MarkerKeeper).Migrate2To3(ctx)
+	require.NoError(t, err, "Migrate2To3")
+
+	// Only dupcoin changed, and two of its grants were merged into others.
+	expEvent, err := sdk.TypedEventToEvent(types.NewEventMarkerAccessListsNormalized(1, 2))
+	require.NoError(t, err, "TypedEventToEvent EventMarkerAccessListsNormalized")
+	assert.Equal(t, sdk.Events{expEvent}, ctx.EventManager().Events(), "Migrate2To3 events")
+
+	assert.Equal(t, []types.AccessGrant{
+		grant(addr1, types.Access_Mint, types.Access_Deposit),
+		grant(addr2, types.Access_Withdraw),
+		grant(addr3, types.Access_Admin, types.Access_Burn),
+	}, getAccessList("dupcoin"), "dupcoin access list after migration")
+	assert.Equal(t, sortedCoin.AccessControl, getAccessList("sortedcoin"), "sortedcoin access list after migration")
+}
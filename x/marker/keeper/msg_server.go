@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/hashicorp/go-metrics"
 
@@ -147,6 +148,19 @@ func (k msgServer) AddAccess(goCtx context.Context, msg *types.MsgAddAccessReque
 
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
+	// Several grants are added together so that the marker is only validated and written once.
+	if len(msg.Access) > 1 {
+		markerAddr, err := types.MarkerAddress(msg.Denom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		if err = k.Keeper.AddAccessBulk(ctx, markerAddr, msg.Access, admin); err != nil {
+			ctx.Logger().Error("unable to add access grants to marker", "err", err)
+			return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+		}
+		return &types.MsgAddAccessResponse{}, nil
+	}
+
 	for i := range msg.Access {
 		access := msg.Access[i]
 		if err := k.Keeper.AddAccess(ctx, admin, msg.Denom, &access); err != nil {
@@ -168,9 +182,23 @@ func (k msgServer) DeleteAccess(goCtx context.Context, msg *types.MsgDeleteAcces
 	}
 
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+
+	// Several grants are revoked together so that the marker is only validated and written once.
+	if len(msg.RevokeAccess) > 0 {
+		markerAddr, err := types.MarkerAddress(msg.Denom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		if err = k.Keeper.RemoveAccessBulk(ctx, markerAddr, msg.RevokeAccess, admin, msg.AllowLastAdminRemoval); err != nil {
+			ctx.Logger().Error("unable to remove access grants from marker", "err", err)
+			return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+		}
+		return &types.MsgDeleteAccessResponse{}, nil
+	}
+
 	addr := sdk.MustAccAddressFromBech32(msg.RemovedAddress)
 
-	if err := k.Keeper.RemoveAccess(ctx, admin, msg.Denom, addr); err != nil {
+	if err := k.Keeper.RemoveAccess(ctx, admin, msg.Denom, addr, msg.AllowLastAdminRemoval); err != nil {
 		ctx.Logger().Error("unable to remove access grant from marker", "err", err)
 		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
 	}
@@ -671,11 +699,20 @@ func (k msgServer) SetAccountData(goCtx context.Context, msg *types.MsgSetAccoun
 		}
 	}
 
+	oldValue, err := k.attrKeeper.GetAccountData(ctx, marker.GetAddress().String())
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s account data: %w", msg.Denom, err)
+	}
+
 	err = k.attrKeeper.SetAccountData(ctx, marker.GetAddress().String(), msg.Value)
 	if err != nil {
 		return nil, fmt.Errorf("error setting %s account data: %w", msg.Denom, err)
 	}
 
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerAccountDataUpdated(msg.Denom, len(oldValue), len(msg.Value), msg.Signer)); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgSetAccountDataResponse{}, nil
 }
 
@@ -735,6 +772,10 @@ func (k msgServer) AddNetAssetValues(goCtx context.Context, msg *types.MsgAddNet
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	if msg.BypassBounds && msg.Administrator != k.GetAuthority() {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "only %s can bypass net asset value bounds, got %s", k.GetAuthority(), msg.Administrator)
+	}
+
 	isGovProp := marker.HasGovernanceEnabled() && msg.Administrator == k.GetAuthority()
 
 	if !isGovProp {
@@ -745,7 +786,21 @@ func (k msgServer) AddNetAssetValues(goCtx context.Context, msg *types.MsgAddNet
 		}
 	}
 
-	err = k.AddSetNetAssetValues(ctx, marker, msg.NetAssetValues, msg.Administrator)
+	// The bounds (and the changes in per-unit value for the events) are only checked here; the
+	// other ways that a net asset value can be set (e.g. genesis or trade settlement) don't have any.
+	changes := make([]*big.Rat, len(msg.NetAssetValues))
+	for i, nav := range msg.NetAssetValues {
+		if msg.BypassBounds {
+			changes[i], err = k.getNetAssetValueChange(ctx, marker, nav)
+		} else {
+			changes[i], _, err = k.CheckNetAssetValueBounds(ctx, marker, nav)
+		}
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s net asset value: %v", nav.Price.Denom, err)
+		}
+	}
+
+	err = k.addSetNetAssetValues(ctx, marker, msg.NetAssetValues, changes, msg.Administrator)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
@@ -848,3 +903,124 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetHoldingThresholds sets the holding concentration thresholds of a marker.
+func (k msgServer) SetHoldingThresholds(goCtx context.Context, msg *types.MsgSetHoldingThresholdsRequest) (*types.MsgSetHoldingThresholdsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+	if err = marker.ValidateAddressHasAccess(admin, types.Access_Admin); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+
+	if err = k.Keeper.SetHoldingThresholds(ctx, marker.GetAddress(), msg.BasisPoints); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgSetHoldingThresholdsResponse{}, nil
+}
+
+// SetNetAssetValueBounds sets the limits on the net asset value updates of a marker.
+func (k msgServer) SetNetAssetValueBounds(goCtx context.Context, msg *types.MsgSetNetAssetValueBoundsRequest) (*types.MsgSetNetAssetValueBoundsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+	if err = marker.ValidateAddressHasAccess(admin, types.Access_Admin); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+
+	if err = k.Keeper.SetNetAssetValueBounds(ctx, marker.GetAddress(), msg.MaxChangeBasisPoints); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgSetNetAssetValueBoundsResponse{}, nil
+}
+
+// UpdateMarkersBulk updates settings on several markers at once via governance proposal.
+// Either all of the updates are applied, or none of them are.
+func (k msgServer) UpdateMarkersBulk(goCtx context.Context, msg *types.MsgUpdateMarkersBulkRequest) (*types.MsgUpdateMarkersBulkResponse, error) {
+	if msg.Authority != k.GetAuthority() {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Apply everything in a cache context so that nothing is written unless all the updates succeed.
+	cacheCtx, writeCache := ctx.CacheContext()
+	denoms := make([]string, 0, len(msg.Updates))
+	for i, update := range msg.Updates {
+		if err := k.applyMarkerBulkUpdate(cacheCtx, update); err != nil {
+			return nil, fmt.Errorf("could not apply update[%d]: %w", i, err)
+		}
+		denoms = append(denoms, update.Denom)
+	}
+	writeCache()
+
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkersBulkUpdated(denoms, msg.Authority)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateMarkersBulkResponse{}, nil
+}
+
+// applyMarkerBulkUpdate makes the changes of a single MarkerBulkUpdate to its marker.
+// The marker must allow governance control, and must be restricted if the
+// required attributes or forced transfer flag are being changed.
+func (k msgServer) applyMarkerBulkUpdate(ctx sdk.Context, update types.MarkerBulkUpdate) error {
+	marker, err := k.GetMarkerByDenom(ctx, update.Denom)
+	if err != nil {
+		return fmt.Errorf("could not get marker for %s: %w", update.Denom, err)
+	}
+	if !marker.HasGovernanceEnabled() {
+		return fmt.Errorf("%s marker does not allow governance control", update.Denom)
+	}
+
+	changesReqAttrs := len(update.AddRequiredAttributes) > 0 || len(update.RemoveRequiredAttributes) > 0
+	if (changesReqAttrs || update.SetAllowForcedTransfer) && marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return fmt.Errorf("marker %s is not a restricted marker", update.Denom)
+	}
+
+	if changesReqAttrs {
+		removeList, err := k.NormalizeRequiredAttributes(ctx, update.RemoveRequiredAttributes)
+		if err != nil {
+			return err
+		}
+		addList, err := k.NormalizeRequiredAttributes(ctx, update.AddRequiredAttributes)
+		if err != nil {
+			return err
+		}
+		reqAttrs, err := types.RemoveFromRequiredAttributes(marker.GetRequiredAttributes(), removeList)
+		if err != nil {
+			return err
+		}
+		reqAttrs, err = types.AddToRequiredAttributes(reqAttrs, addList)
+		if err != nil {
+			return err
+		}
+		marker.SetRequiredAttributes(reqAttrs)
+	}
+	if update.SetAllowForcedTransfer {
+		marker.SetAllowForcedTransfer(update.AllowForcedTransfer)
+	}
+	if update.SetAllowGovernanceControl {
+		marker.SetAllowGovernanceControl(update.AllowGovernanceControl)
+	}
+
+	k.SetMarker(ctx, marker)
+	return nil
+}
@@ -718,6 +718,16 @@ func (s *MsgServerTestSuite) TestMsgAddAccessRequest() {
 		Permissions: types.AccessListByNames("Invalid"),
 	}
 
+	accessBurnGrant := types.AccessGrant{
+		Address:     s.owner1,
+		Permissions: types.AccessListByNames("BURN"),
+	}
+
+	accessOwner2Grant := types.AccessGrant{
+		Address:     s.owner2,
+		Permissions: types.AccessListByNames("DEPOSIT,WITHDRAW"),
+	}
+
 	addMarkerMsg := types.NewMsgAddMarkerRequest("hotdog", sdkmath.NewInt(100), s.owner1Addr, s.owner1Addr, types.MarkerType_Coin, true, true, false, []string{}, 0, 0)
 	_, err := s.msgServer.AddMarker(s.ctx, addMarkerMsg)
 	s.Assert().NoError(err, "should successfully add marker")
@@ -733,6 +743,15 @@ func (s *MsgServerTestSuite) TestMsgAddAccessRequest() {
 			msg:           types.NewMsgAddAccessRequest("hotdog", s.owner1Addr, accessMintGrant),
 			expectedEvent: types.NewEventMarkerAddAccess(&accessMintGrant, "hotdog", s.owner1),
 		},
+		{
+			name: "should successfully grant several accesses to marker at once",
+			msg: &types.MsgAddAccessRequest{
+				Denom:         "hotdog",
+				Administrator: s.owner1,
+				Access:        []types.AccessGrant{accessBurnGrant, accessOwner2Grant},
+			},
+			expectedEvent: types.NewEventMarkerAddAccessBulk(sortedByAddress([]types.AccessGrant{accessBurnGrant, accessOwner2Grant}), "hotdog", s.owner1),
+		},
 		{
 			name:     "should fail to ADD access to marker, validate basic fails",
 			msg:      types.NewMsgAddAccessRequest("hotdog", s.owner1Addr, accessInvalidGrant),
@@ -757,6 +776,7 @@ func (s *MsgServerTestSuite) TestMsgAddAccessRequest() {
 				if tc.expectedEvent != nil {
 					result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), tc.expectedEvent)
 					s.Assert().True(result, "Expected typed event was not found in response.\n    Expected: %+v\n    Response: %+v", tc.expectedEvent, response)
+					s.Assert().Len(s.ctx.EventManager().Events(), 1, "events emitted by AddAccess")
 				}
 			}
 		})
@@ -803,6 +823,153 @@ func (s *MsgServerTestSuite) TestMsgDeleteAccessMarkerRequest() {
 	}
 }
 
+func (s *MsgServerTestSuite) TestMsgDeleteAccessLastAdmin() {
+	newMarker := func(denom string, govControl bool) {
+		marker := types.NewEmptyMarkerAccount(denom, s.owner1, []types.AccessGrant{
+			*types.NewAccessGrant(s.owner1Addr, []types.Access{types.Access_Admin, types.Access_Mint}),
+			*types.NewAccessGrant(s.owner2Addr, []types.Access{types.Access_Burn}),
+		})
+		marker.AllowGovernanceControl = govControl
+		marker.Supply = sdkmath.NewInt(100)
+		s.Require().NoError(s.app.MarkerKeeper.AddFinalizeAndActivateMarker(s.ctx, marker), "AddFinalizeAndActivateMarker(%q)", denom)
+	}
+	newMarker("lastadmincoin", false)
+	newMarker("forcedadmincoin", false)
+	newMarker("govadmincoin", true)
+
+	forced := types.NewDeleteAccessRequest("forcedadmincoin", s.owner1Addr, s.owner1Addr)
+	forced.AllowLastAdminRemoval = true
+
+	tests := []struct {
+		name   string
+		msg    *types.MsgDeleteAccessRequest
+		expErr string
+	}{
+		{
+			name: "last admin rejected",
+			msg:  types.NewDeleteAccessRequest("lastadmincoin", s.owner1Addr, s.owner1Addr),
+			expErr: "cannot remove the last ACCESS_ADMIN access grant from active marker lastadmincoin " +
+				"that is not controlled by governance: unauthorized",
+		},
+		{
+			name: "non-admin grant removed",
+			msg:  types.NewDeleteAccessRequest("lastadmincoin", s.owner1Addr, s.owner2Addr),
+		},
+		{
+			name: "last admin removal forced",
+			msg:  forced,
+		},
+		{
+			name: "last admin of governance controlled marker",
+			msg:  types.NewDeleteAccessRequest("govadmincoin", s.owner1Addr, s.owner1Addr),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.DeleteAccess(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "DeleteAccess error")
+				return
+			}
+			s.Require().NoError(err, "DeleteAccess error")
+			marker, err := s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, tc.msg.Denom)
+			s.Require().NoError(err, "GetMarkerByDenom(%q)", tc.msg.Denom)
+			removed := sdk.MustAccAddressFromBech32(tc.msg.RemovedAddress)
+			for _, grant := range marker.GetAccessList() {
+				s.Assert().NotEqual(removed.String(), grant.Address, "access grant address after DeleteAccess")
+			}
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestMsgDeleteAccessRevokeAccess() {
+	denom := "revokebulkcoin"
+	owner3Addr := sdk.AccAddress("owner3______________")
+	marker := types.NewEmptyMarkerAccount(denom, s.owner1, []types.AccessGrant{
+		*types.NewAccessGrant(s.owner1Addr, []types.Access{types.Access_Admin, types.Access_Mint}),
+		*types.NewAccessGrant(s.owner2Addr, []types.Access{types.Access_Burn, types.Access_Deposit}),
+		*types.NewAccessGrant(owner3Addr, []types.Access{types.Access_Withdraw}),
+	})
+	marker.AllowGovernanceControl = false
+	marker.Supply = sdkmath.NewInt(100)
+	s.Require().NoError(s.app.MarkerKeeper.AddFinalizeAndActivateMarker(s.ctx, marker), "AddFinalizeAndActivateMarker(%q)", denom)
+
+	newMsg := func(revokes ...types.AccessGrant) *types.MsgDeleteAccessRequest {
+		return &types.MsgDeleteAccessRequest{Denom: denom, Administrator: s.owner1, RevokeAccess: revokes}
+	}
+
+	tests := []struct {
+		name      string
+		msg       *types.MsgDeleteAccessRequest
+		expErr    string
+		expAccess []types.AccessGrant
+		expEvent  []types.AccessGrant
+	}{
+		{
+			name: "one permission not held",
+			msg: newMsg(
+				types.AccessGrant{Address: s.owner2, Permissions: []types.Access{types.Access_Burn}},
+				types.AccessGrant{Address: owner3Addr.String(), Permissions: []types.Access{types.Access_Mint}},
+			),
+			expErr: "access revoke failed: " + owner3Addr.String() + " does not have ACCESS_MINT on " + denom +
+				" marker (" + types.MustGetMarkerAddress(denom).String() + "): unauthorized",
+		},
+		{
+			name: "last admin rejected",
+			msg:  newMsg(types.AccessGrant{Address: s.owner1, Permissions: []types.Access{types.Access_Admin}}),
+			expErr: "cannot remove the last ACCESS_ADMIN access grant from active marker " + denom +
+				" that is not controlled by governance: unauthorized",
+		},
+		{
+			name: "several grants revoked",
+			msg: newMsg(
+				types.AccessGrant{Address: s.owner2, Permissions: []types.Access{types.Access_Burn}},
+				types.AccessGrant{Address: owner3Addr.String()},
+			),
+			expAccess: []types.AccessGrant{
+				*types.NewAccessGrant(s.owner1Addr, []types.Access{types.Access_Admin, types.Access_Mint}),
+				*types.NewAccessGrant(s.owner2Addr, []types.Access{types.Access_Deposit}),
+			},
+			expEvent: []types.AccessGrant{
+				*types.NewAccessGrant(s.owner2Addr, []types.Access{types.Access_Burn}),
+				*types.NewAccessGrant(owner3Addr, []types.Access{types.Access_Withdraw}),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			before, err := s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, denom)
+			s.Require().NoError(err, "GetMarkerByDenom(%q) before DeleteAccess", denom)
+			expAccess := tc.expAccess
+			if len(tc.expErr) > 0 {
+				expAccess = before.GetAccessList()
+			}
+
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			_, err = s.msgServer.DeleteAccess(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "DeleteAccess error")
+			} else {
+				s.Require().NoError(err, "DeleteAccess error")
+			}
+
+			expEvents := sdk.Events{}
+			if tc.expEvent != nil {
+				event, err := sdk.TypedEventToEvent(types.NewEventMarkerDeleteAccessBulk(sortedByAddress(tc.expEvent), denom, s.owner1))
+				s.Require().NoError(err, "TypedEventToEvent(EventMarkerDeleteAccessBulk)")
+				expEvents = sdk.Events{event}
+			}
+			s.Assert().Equal(expEvents, s.ctx.EventManager().Events(), "events emitted by DeleteAccess")
+
+			after, err := s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, denom)
+			s.Require().NoError(err, "GetMarkerByDenom(%q) after DeleteAccess", denom)
+			s.Assert().ElementsMatch(expAccess, after.GetAccessList(), "access list after DeleteAccess")
+		})
+	}
+}
+
 func (s *MsgServerTestSuite) TestMsgActivateMarkerRequest() {
 	hotdogDenom := "hotdog"
 
@@ -1297,29 +1464,40 @@ func (s *MsgServerTestSuite) TestMsgSetAccountDataRequest() {
 	_, err = s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
 	s.Assert().NoError(err, "should successfully add/finalize/active restricted marker")
 
+	valueU1 := "This is some unrestricted coin data."
+	valueU2 := "This is some different unrestricted coin data."
+	valueR1 := "This is some restricted coin data."
+	valueR2 := "This is some different restricted coin data."
+
 	testcases := []struct {
-		name          string
-		msg           *types.MsgSetAccountDataRequest
-		expectedEvent proto.Message
-		errorMsg      string
+		name           string
+		msg            *types.MsgSetAccountDataRequest
+		expectedEvents []proto.Message
+		errorMsg       string
 	}{
 		{
 			name: "should successfully set account data on unrestricted marker via gov prop",
 			msg: &types.MsgSetAccountDataRequest{
 				Denom:  denomU,
-				Value:  "This is some unrestricted coin data.",
+				Value:  valueU1,
 				Signer: authority,
 			},
-			expectedEvent: &attrtypes.EventAccountDataUpdated{Account: denomUAddr},
+			expectedEvents: []proto.Message{
+				&attrtypes.EventAccountDataUpdated{Account: denomUAddr},
+				types.NewEventMarkerAccountDataUpdated(denomU, 0, len(valueU1), authority),
+			},
 		},
 		{
 			name: "should successfully set account data on unrestricted marker by signer with deposit",
 			msg: &types.MsgSetAccountDataRequest{
 				Denom:  denomU,
-				Value:  "This is some different unrestricted coin data.",
+				Value:  valueU2,
 				Signer: s.owner2,
 			},
-			expectedEvent: &attrtypes.EventAccountDataUpdated{Account: denomUAddr},
+			expectedEvents: []proto.Message{
+				&attrtypes.EventAccountDataUpdated{Account: denomUAddr},
+				types.NewEventMarkerAccountDataUpdated(denomU, len(valueU1), len(valueU2), s.owner2),
+			},
 		},
 		{
 			name: "should fail to set account data on unrestricted marker because signer does not have deposit",
@@ -1330,23 +1508,41 @@ func (s *MsgServerTestSuite) TestMsgSetAccountDataRequest() {
 			},
 			errorMsg: s.noAccessErr(s.owner1, types.Access_Deposit, denomU),
 		},
+		{
+			name: "should successfully clear account data on unrestricted marker by signer with deposit",
+			msg: &types.MsgSetAccountDataRequest{
+				Denom:  denomU,
+				Value:  "",
+				Signer: s.owner2,
+			},
+			expectedEvents: []proto.Message{
+				&attrtypes.EventAccountDataUpdated{Account: denomUAddr},
+				types.NewEventMarkerAccountDataUpdated(denomU, len(valueU2), 0, s.owner2),
+			},
+		},
 		{
 			name: "should successfully set account data on restricted marker via gov prop",
 			msg: &types.MsgSetAccountDataRequest{
 				Denom:  denomR,
-				Value:  "This is some restricted coin data.",
+				Value:  valueR1,
 				Signer: authority,
 			},
-			expectedEvent: &attrtypes.EventAccountDataUpdated{Account: denomRAddr},
+			expectedEvents: []proto.Message{
+				&attrtypes.EventAccountDataUpdated{Account: denomRAddr},
+				types.NewEventMarkerAccountDataUpdated(denomR, 0, len(valueR1), authority),
+			},
 		},
 		{
 			name: "should successfully set account data on restricted marker by signer with deposit",
 			msg: &types.MsgSetAccountDataRequest{
 				Denom:  denomR,
-				Value:  "This is some different restricted coin data.",
+				Value:  valueR2,
 				Signer: s.owner2,
 			},
-			expectedEvent: &attrtypes.EventAccountDataUpdated{Account: denomRAddr},
+			expectedEvents: []proto.Message{
+				&attrtypes.EventAccountDataUpdated{Account: denomRAddr},
+				types.NewEventMarkerAccountDataUpdated(denomR, len(valueR1), len(valueR2), s.owner2),
+			},
 		},
 		{
 			name: "should fail to set account data on restricted marker because signer does not have deposit",
@@ -1357,6 +1553,18 @@ func (s *MsgServerTestSuite) TestMsgSetAccountDataRequest() {
 			},
 			errorMsg: s.noAccessErr(s.owner1, types.Access_Deposit, denomR),
 		},
+		{
+			name: "should successfully clear account data on restricted marker via gov prop",
+			msg: &types.MsgSetAccountDataRequest{
+				Denom:  denomR,
+				Value:  "",
+				Signer: authority,
+			},
+			expectedEvents: []proto.Message{
+				&attrtypes.EventAccountDataUpdated{Account: denomRAddr},
+				types.NewEventMarkerAccountDataUpdated(denomR, len(valueR2), 0, authority),
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -1365,11 +1573,12 @@ func (s *MsgServerTestSuite) TestMsgSetAccountDataRequest() {
 			response, err := s.msgServer.SetAccountData(s.ctx, tc.msg)
 			if len(tc.errorMsg) > 0 {
 				s.Require().EqualError(err, tc.errorMsg, "handler(%T) error", tc.msg)
+				s.Assert().Empty(s.ctx.EventManager().ABCIEvents(), "events emitted on failure")
 			} else {
 				s.Require().NoError(err, "handler(%T) error", tc.msg)
-				if tc.expectedEvent != nil {
-					result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), tc.expectedEvent)
-					s.Assert().True(result, "Expected typed event was not found in response.\n    Expected: %+v\n    Response: %+v", tc.expectedEvent, response)
+				for _, expectedEvent := range tc.expectedEvents {
+					result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), expectedEvent)
+					s.Assert().True(result, "Expected typed event was not found in response.\n    Expected: %+v\n    Response: %+v", expectedEvent, response)
 				}
 			}
 		})
@@ -1870,3 +2079,301 @@ func (s *MsgServerTestSuite) TestMsgUpdateParamsRequest() {
 		})
 	}
 }
+
+func (s *MsgServerTestSuite) TestSetHoldingThresholds() {
+	adminUser := testUserAddress("admin")
+	otherUser := testUserAddress("other")
+
+	markerDenom := "thresholdcoin"
+	markerAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(markerDenom), nil, 0, 0)
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(markerAcct, sdk.NewInt64Coin(markerDenom, 1000), adminUser,
+		[]types.AccessGrant{
+			{Address: adminUser.String(), Permissions: []types.Access{types.Access_Admin}},
+			{Address: otherUser.String(), Permissions: []types.Access{types.Access_Mint}},
+		},
+		types.StatusActive, types.MarkerType_Coin, true, false, false, []string{}))
+
+	testCases := []struct {
+		name          string
+		msg           types.MsgSetHoldingThresholdsRequest
+		expErr        string
+		expThresholds []uint32
+	}{
+		{
+			name:   "marker not found",
+			msg:    types.MsgSetHoldingThresholdsRequest{Denom: "cantfindme", BasisPoints: []uint32{2500}, Administrator: adminUser.String()},
+			expErr: "marker cantfindme not found for address: " + types.MustGetMarkerAddress("cantfindme").String() + ": invalid request",
+		},
+		{
+			name: "signer does not have admin access",
+			msg:  types.MsgSetHoldingThresholdsRequest{Denom: markerDenom, BasisPoints: []uint32{2500}, Administrator: otherUser.String()},
+			expErr: fmt.Sprintf("%s does not have %s on %s marker (%s): unauthorized",
+				otherUser, types.Access_Admin, markerDenom, markerAcct.Address),
+		},
+		{
+			name:   "invalid thresholds",
+			msg:    types.MsgSetHoldingThresholdsRequest{Denom: markerDenom, BasisPoints: []uint32{5000, 2500}, Administrator: adminUser.String()},
+			expErr: "holding thresholds must be in ascending order without duplicates: invalid request",
+		},
+		{
+			name:          "set thresholds",
+			msg:           types.MsgSetHoldingThresholdsRequest{Denom: markerDenom, BasisPoints: []uint32{2500, 5000}, Administrator: adminUser.String()},
+			expThresholds: []uint32{2500, 5000},
+		},
+		{
+			name:          "remove thresholds",
+			msg:           types.MsgSetHoldingThresholdsRequest{Denom: markerDenom, Administrator: adminUser.String()},
+			expThresholds: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.msgServer.SetHoldingThresholds(s.ctx, &tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().Nil(res, "SetHoldingThresholds response")
+				s.Assert().EqualError(err, tc.expErr, "SetHoldingThresholds error")
+				return
+			}
+			s.Require().NoError(err, "SetHoldingThresholds error")
+			s.Assert().Equal(&types.MsgSetHoldingThresholdsResponse{}, res, "SetHoldingThresholds response")
+			thresholds, err := s.app.MarkerKeeper.GetHoldingThresholds(s.ctx, markerAcct.GetAddress())
+			s.Require().NoError(err, "GetHoldingThresholds")
+			s.Assert().Equal(tc.expThresholds, thresholds, "holding thresholds")
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestSetNetAssetValueBounds() {
+	adminUser := testUserAddress("admin")
+	otherUser := testUserAddress("other")
+
+	markerDenom := "boundscoin"
+	markerAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(markerDenom), nil, 0, 0)
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(markerAcct, sdk.NewInt64Coin(markerDenom, 1000), adminUser,
+		[]types.AccessGrant{
+			{Address: adminUser.String(), Permissions: []types.Access{types.Access_Admin}},
+			{Address: otherUser.String(), Permissions: []types.Access{types.Access_Mint}},
+		},
+		types.StatusActive, types.MarkerType_Coin, true, false, false, []string{}))
+
+	testCases := []struct {
+		name         string
+		msg          types.MsgSetNetAssetValueBoundsRequest
+		expErr       string
+		expMaxChange uint32
+	}{
+		{
+			name:   "marker not found",
+			msg:    types.MsgSetNetAssetValueBoundsRequest{Denom: "cantfindme", MaxChangeBasisPoints: 2500, Administrator: adminUser.String()},
+			expErr: "marker cantfindme not found for address: " + types.MustGetMarkerAddress("cantfindme").String() + ": invalid request",
+		},
+		{
+			name: "signer does not have admin access",
+			msg:  types.MsgSetNetAssetValueBoundsRequest{Denom: markerDenom, MaxChangeBasisPoints: 2500, Administrator: otherUser.String()},
+			expErr: fmt.Sprintf("%s does not have %s on %s marker (%s): unauthorized",
+				otherUser, types.Access_Admin, markerDenom, markerAcct.Address),
+		},
+		{
+			name:         "set bounds",
+			msg:          types.MsgSetNetAssetValueBoundsRequest{Denom: markerDenom, MaxChangeBasisPoints: 2500, Administrator: adminUser.String()},
+			expMaxChange: 2500,
+		},
+		{
+			name:         "remove bounds",
+			msg:          types.MsgSetNetAssetValueBoundsRequest{Denom: markerDenom, Administrator: adminUser.String()},
+			expMaxChange: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.msgServer.SetNetAssetValueBounds(s.ctx, &tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().Nil(res, "SetNetAssetValueBounds response")
+				s.Assert().EqualError(err, tc.expErr, "SetNetAssetValueBounds error")
+				return
+			}
+			s.Require().NoError(err, "SetNetAssetValueBounds error")
+			s.Assert().Equal(&types.MsgSetNetAssetValueBoundsResponse{}, res, "SetNetAssetValueBounds response")
+			maxChange, err := s.app.MarkerKeeper.GetNetAssetValueBounds(s.ctx, markerAcct.GetAddress())
+			s.Require().NoError(err, "GetNetAssetValueBounds")
+			s.Assert().Equal(tc.expMaxChange, maxChange, "net asset value bounds")
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestAddNetAssetValueWithBounds() {
+	adminUser := testUserAddress("admin")
+	authority := s.app.MarkerKeeper.GetAuthority()
+
+	markerDenom := "boundednavcoin"
+	markerAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(markerDenom), nil, 0, 0)
+	marker := types.NewMarkerAccount(markerAcct, sdk.NewInt64Coin(markerDenom, 1000), adminUser,
+		[]types.AccessGrant{{Address: adminUser.String(), Permissions: []types.Access{types.Access_Admin}}},
+		types.StatusActive, types.MarkerType_Coin, true, true, false, []string{})
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, marker)
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValue(s.ctx, marker, types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 100), 1), "test"), "SetNetAssetValue")
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValueBounds(s.ctx, marker.GetAddress(), 2500), "SetNetAssetValueBounds")
+
+	noGovDenom := "boundednogovcoin"
+	noGovMarker := types.NewMarkerAccount(authtypes.NewBaseAccount(types.MustGetMarkerAddress(noGovDenom), nil, 0, 0),
+		sdk.NewInt64Coin(noGovDenom, 1000), adminUser,
+		[]types.AccessGrant{{Address: adminUser.String(), Permissions: []types.Access{types.Access_Admin}}},
+		types.StatusActive, types.MarkerType_Coin, true, false, false, []string{})
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, noGovMarker)
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValue(s.ctx, noGovMarker, types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 100), 1), "test"), "SetNetAssetValue no gov")
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValueBounds(s.ctx, noGovMarker.GetAddress(), 2500), "SetNetAssetValueBounds no gov")
+
+	usd := func(amount int64) []types.NetAssetValue {
+		return []types.NetAssetValue{types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, amount), 1)}
+	}
+
+	testCases := []struct {
+		name      string
+		msg       types.MsgAddNetAssetValuesRequest
+		expErr    string
+		expPrice  int64
+		expChange string
+	}{
+		{
+			name:      "in bounds",
+			msg:       types.MsgAddNetAssetValuesRequest{Denom: markerDenom, NetAssetValues: usd(120), Administrator: adminUser.String()},
+			expPrice:  120,
+			expChange: "20.00",
+		},
+		{
+			name:     "out of bounds",
+			msg:      types.MsgAddNetAssetValuesRequest{Denom: markerDenom, NetAssetValues: usd(120_000_000), Administrator: adminUser.String()},
+			expErr:   "usd net asset value: net asset value change of 99999900.00% exceeds the max change of 25.00%: invalid request",
+			expPrice: 120,
+		},
+		{
+			name:     "bypass by non-authority",
+			msg:      types.MsgAddNetAssetValuesRequest{Denom: markerDenom, NetAssetValues: usd(120_000_000), Administrator: adminUser.String(), BypassBounds: true},
+			expErr:   "only " + authority + " can bypass net asset value bounds, got " + adminUser.String() + ": expected gov account as only signer for proposal message",
+			expPrice: 120,
+		},
+		{
+			name:      "bypass by authority",
+			msg:       types.MsgAddNetAssetValuesRequest{Denom: markerDenom, NetAssetValues: usd(120_000_000), Administrator: authority, BypassBounds: true},
+			expPrice:  120_000_000,
+			expChange: "99999900.00",
+		},
+		{
+			name:     "authority without bypass on marker without governance control",
+			msg:      types.MsgAddNetAssetValuesRequest{Denom: noGovDenom, NetAssetValues: usd(110), Administrator: authority},
+			expErr:   "signer " + authority + " does not have permission to add net asset value for \"" + noGovDenom + "\"",
+			expPrice: 100,
+		},
+		{
+			name:     "bypass by authority on marker without governance control",
+			msg:      types.MsgAddNetAssetValuesRequest{Denom: noGovDenom, NetAssetValues: usd(500), Administrator: authority, BypassBounds: true},
+			expErr:   "signer " + authority + " does not have permission to add net asset value for \"" + noGovDenom + "\"",
+			expPrice: 100,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+			res, err := s.msgServer.AddNetAssetValues(ctx, &tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().Nil(res, "AddNetAssetValues response")
+				s.Assert().EqualError(err, tc.expErr, "AddNetAssetValues error")
+			} else {
+				s.Require().NoError(err, "AddNetAssetValues error")
+				s.Assert().Equal(&types.MsgAddNetAssetValuesResponse{}, res, "AddNetAssetValues response")
+				expEvent := types.NewEventSetNetAssetValue(tc.msg.Denom, sdk.NewInt64Coin(types.UsdDenom, tc.expPrice), 1, tc.msg.Administrator)
+				expEvent.PercentChange = tc.expChange
+				s.Assert().Equal([]*types.EventSetNetAssetValue{expEvent}, setNetAssetValueEvents(s.T(), ctx), "AddNetAssetValues events")
+			}
+			nav, err := s.app.MarkerKeeper.GetNetAssetValue(s.ctx, tc.msg.Denom, types.UsdDenom)
+			s.Require().NoError(err, "GetNetAssetValue")
+			s.Require().NotNil(nav, "GetNetAssetValue")
+			s.Assert().Equal(sdk.NewInt64Coin(types.UsdDenom, tc.expPrice), nav.Price, "net asset value price")
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestUpdateMarkersBulk() {
+	authority := s.app.MarkerKeeper.GetAuthority()
+	otherAddr := sdk.AccAddress("otherAccAddr________").String()
+
+	newMarker := func(denom string, reqAttrs ...string) {
+		acct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(denom), nil, 0, 0)
+		s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(acct, sdk.NewInt64Coin(denom, 1000), s.owner1Addr,
+			[]types.AccessGrant{{Address: s.owner1, Permissions: []types.Access{types.Access_Admin, types.Access_Transfer}}},
+			types.StatusActive, types.MarkerType_RestrictedCoin, true, true, false, reqAttrs))
+	}
+	getMarker := func(denom string) types.MarkerAccountI {
+		marker, err := s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, denom)
+		s.Require().NoError(err, "GetMarkerByDenom(%q)", denom)
+		return marker
+	}
+
+	newMarker("bulkcoina")
+	newMarker("bulkcoinb")
+	newMarker("bulkcoinc", "kyc.bulk.io")
+	newMarker("bulkcoind", "kyc.bulk.io")
+
+	s.Run("wrong authority", func() {
+		msg := &types.MsgUpdateMarkersBulkRequest{
+			Updates:   []types.MarkerBulkUpdate{{Denom: "bulkcoina", SetAllowForcedTransfer: true, AllowForcedTransfer: true}},
+			Authority: otherAddr,
+		}
+		_, err := s.msgServer.UpdateMarkersBulk(s.ctx, msg)
+		s.Assert().EqualError(err, "expected "+authority+" got "+otherAddr+": expected gov account as only signer for proposal message", "UpdateMarkersBulk error")
+	})
+
+	s.Run("three markers", func() {
+		msg := &types.MsgUpdateMarkersBulkRequest{
+			Updates: []types.MarkerBulkUpdate{
+				{Denom: "bulkcoina", AddRequiredAttributes: []string{"kyc.bulk.io"}},
+				{Denom: "bulkcoinb", SetAllowForcedTransfer: true, AllowForcedTransfer: true},
+				{Denom: "bulkcoinc", RemoveRequiredAttributes: []string{"kyc.bulk.io"}, SetAllowGovernanceControl: true, AllowGovernanceControl: false},
+			},
+			Authority: authority,
+		}
+		em := sdk.NewEventManager()
+		res, err := s.msgServer.UpdateMarkersBulk(s.ctx.WithEventManager(em), msg)
+		s.Require().NoError(err, "UpdateMarkersBulk error")
+		s.Assert().Equal(&types.MsgUpdateMarkersBulkResponse{}, res, "UpdateMarkersBulk response")
+
+		s.Assert().Equal([]string{"kyc.bulk.io"}, getMarker("bulkcoina").GetRequiredAttributes(), "bulkcoina required attributes")
+		s.Assert().True(getMarker("bulkcoinb").AllowsForcedTransfer(), "bulkcoinb AllowsForcedTransfer")
+		markerC := getMarker("bulkcoinc")
+		s.Assert().Empty(markerC.GetRequiredAttributes(), "bulkcoinc required attributes")
+		s.Assert().False(markerC.HasGovernanceEnabled(), "bulkcoinc HasGovernanceEnabled")
+
+		expEvent := types.NewEventMarkersBulkUpdated([]string{"bulkcoina", "bulkcoinb", "bulkcoinc"}, authority)
+		s.Assert().True(s.containsMessage(em.ABCIEvents(), expEvent), "events contain %#v", expEvent)
+	})
+
+	s.Run("one invalid denom", func() {
+		msg := &types.MsgUpdateMarkersBulkRequest{
+			Updates: []types.MarkerBulkUpdate{
+				{Denom: "bulkcoind", RemoveRequiredAttributes: []string{"kyc.bulk.io"}},
+				{Denom: "bulkcoinnope", SetAllowForcedTransfer: true, AllowForcedTransfer: true},
+			},
+			Authority: authority,
+		}
+		em := sdk.NewEventManager()
+		res, err := s.msgServer.UpdateMarkersBulk(s.ctx.WithEventManager(em), msg)
+		s.Assert().EqualError(err, "could not apply update[1]: could not get marker for bulkcoinnope: "+
+			"marker bulkcoinnope not found for address: "+types.MustGetMarkerAddress("bulkcoinnope").String(), "UpdateMarkersBulk error")
+		s.Assert().Nil(res, "UpdateMarkersBulk response")
+		s.Assert().Empty(em.Events(), "events emitted during failed UpdateMarkersBulk")
+		s.Assert().Equal([]string{"kyc.bulk.io"}, getMarker("bulkcoind").GetRequiredAttributes(), "bulkcoind required attributes")
+	})
+
+	s.Run("gov control no longer allowed", func() {
+		msg := &types.MsgUpdateMarkersBulkRequest{
+			Updates:   []types.MarkerBulkUpdate{{Denom: "bulkcoinc", SetAllowForcedTransfer: true, AllowForcedTransfer: true}},
+			Authority: authority,
+		}
+		_, err := s.msgServer.UpdateMarkersBulk(s.ctx, msg)
+		s.Assert().EqualError(err, "could not apply update[0]: bulkcoinc marker does not allow governance control", "UpdateMarkersBulk error")
+		s.Assert().False(getMarker("bulkcoinc").AllowsForcedTransfer(), "bulkcoinc AllowsForcedTransfer")
+	})
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetNetAssetValueBounds gets the largest change (in basis points) allowed in a net asset value update of a marker.
+// Returns zero if the marker doesn't have any net asset value bounds.
+func (k Keeper) GetNetAssetValueBounds(ctx sdk.Context, markerAddr sdk.AccAddress) (uint32, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NetAssetValueBoundsKey(markerAddr))
+	if len(bz) == 0 {
+		return 0, nil
+	}
+	var bounds types.NetAssetValueBounds
+	if err := k.cdc.Unmarshal(bz, &bounds); err != nil {
+		return 0, fmt.Errorf("could not read net asset value bounds for marker %s: %w", markerAddr, err)
+	}
+	return bounds.MaxChangeBasisPoints, nil
+}
+
+// SetNetAssetValueBounds sets the largest change (in basis points) allowed in a net asset value update of a marker.
+// If the max change is zero, the marker's net asset value bounds are removed.
+func (k Keeper) SetNetAssetValueBounds(ctx sdk.Context, markerAddr sdk.AccAddress, maxChangeBasisPoints uint32) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.NetAssetValueBoundsKey(markerAddr)
+	if maxChangeBasisPoints == 0 {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&types.NetAssetValueBounds{MaxChangeBasisPoints: maxChangeBasisPoints})
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// IterateNetAssetValueBounds iterates over the net asset value bounds of all markers.
+func (k Keeper) IterateNetAssetValueBounds(ctx sdk.Context, handler func(markerAddr sdk.AccAddress, maxChangeBasisPoints uint32) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.NetAssetValueBoundsPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		markerAddr := types.GetMarkerFromNetAssetValueBoundsKey(it.Key())
+		var bounds types.NetAssetValueBounds
+		if err := k.cdc.Unmarshal(it.Value(), &bounds); err != nil {
+			return fmt.Errorf("could not read net asset value bounds for marker %s: %w", markerAddr, err)
+		}
+		if handler(markerAddr, bounds.MaxChangeBasisPoints) {
+			break
+		}
+	}
+	return nil
+}
+
+// CheckNetAssetValueBounds compares a net asset value with the marker's current one (with the same price denom).
+// It returns the change in the per-unit value (nil if there isn't a current value to compare with), the marker's
+// max change in basis points (zero if it doesn't have any bounds), and an error if the change is out of bounds.
+func (k Keeper) CheckNetAssetValueBounds(ctx sdk.Context, marker types.MarkerAccountI, nav types.NetAssetValue) (*big.Rat, uint32, error) {
+	change, err := k.getNetAssetValueChange(ctx, marker, nav)
+	if err != nil {
+		return nil, 0, err
+	}
+	maxChange, err := k.GetNetAssetValueBounds(ctx, marker.GetAddress())
+	if err != nil {
+		return change, 0, err
+	}
+	return change, maxChange, types.CheckNetAssetValueChange(change, maxChange)
+}
+
+// getNetAssetValueChange returns the change in the per-unit value from the marker's current net asset value
+// (with the same price denom) to the provided one. Returns nil if there isn't a current value to compare with.
+func (k Keeper) getNetAssetValueChange(ctx sdk.Context, marker types.MarkerAccountI, nav types.NetAssetValue) (*big.Rat, error) {
+	oldNAV, err := k.GetNetAssetValue(ctx, marker.GetDenom(), nav.Price.Denom)
+	if err != nil || oldNAV == nil {
+		return nil, err
+	}
+	change, ok := types.NetAssetValueChange(*oldNAV, nav)
+	if !ok {
+		return nil, nil
+	}
+	return change, nil
+}
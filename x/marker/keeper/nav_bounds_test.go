@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// setNetAssetValueEvents gets all the EventSetNetAssetValue events emitted so far.
+func setNetAssetValueEvents(t *testing.T, ctx sdk.Context) []*types.EventSetNetAssetValue {
+	t.Helper()
+	var rv []*types.EventSetNetAssetValue
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != "provenance.marker.v1.EventSetNetAssetValue" {
+			continue
+		}
+		tev, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err, "ParseTypedEvent(%v)", event)
+		rv = append(rv, tev.(*types.EventSetNetAssetValue))
+	}
+	return rv
+}
+
+func TestNetAssetValueBoundsGetSet(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	markerAddr := types.MustGetMarkerAddress("boundscoin")
+
+	maxChange, err := app.MarkerKeeper.GetNetAssetValueBounds(ctx, markerAddr)
+	require.NoError(t, err, "GetNetAssetValueBounds before set")
+	assert.Zero(t, maxChange, "max change before set")
+
+	require.NoError(t, app.MarkerKeeper.SetNetAssetValueBounds(ctx, markerAddr, 2500), "SetNetAssetValueBounds")
+	maxChange, err = app.MarkerKeeper.GetNetAssetValueBounds(ctx, markerAddr)
+	require.NoError(t, err, "GetNetAssetValueBounds after set")
+	assert.Equal(t, uint32(2500), maxChange, "max change after set")
+
+	require.NoError(t, app.MarkerKeeper.SetNetAssetValueBounds(ctx, markerAddr, 0), "SetNetAssetValueBounds(0)")
+	maxChange, err = app.MarkerKeeper.GetNetAssetValueBounds(ctx, markerAddr)
+	require.NoError(t, err, "GetNetAssetValueBounds after removal")
+	assert.Zero(t, maxChange, "max change after removal")
+}
+
+func TestSetNetAssetValuePercentChangeEvent(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	marker := types.NewEmptyMarkerAccount("percentcoin", admin.String(), []types.AccessGrant{
+		*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint}),
+	})
+	marker.Supply = sdkmath.NewInt(100)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	var percents []string
+	for _, nav := range []types.NetAssetValue{
+		types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 800), 10),
+		types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 70), 1),
+		types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 140), 1),
+	} {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		msg := &types.MsgAddNetAssetValuesRequest{Denom: marker.GetDenom(), Administrator: admin.String(), NetAssetValues: []types.NetAssetValue{nav}}
+		_, err := msgServer.AddNetAssetValues(ctx, msg)
+		require.NoError(t, err, "AddNetAssetValues(%s)", nav.Price)
+		events := setNetAssetValueEvents(t, ctx)
+		require.Len(t, events, 1, "EventSetNetAssetValue events for %s", nav.Price)
+		percents = append(percents, events[0].PercentChange)
+	}
+	assert.Equal(t, []string{"", "-12.50", "100.00"}, percents, "event percent changes")
+
+	// The change is only looked up for Msg/AddNetAssetValues, so it's not in the event when set some other way.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	nav := types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 70), 1)
+	require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, marker, nav, "test"), "SetNetAssetValue(%s)", nav.Price)
+	events := setNetAssetValueEvents(t, ctx)
+	require.Len(t, events, 1, "EventSetNetAssetValue events for SetNetAssetValue(%s)", nav.Price)
+	assert.Empty(t, events[0].PercentChange, "SetNetAssetValue event percent change")
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// queryCost keeps track of how much work a query has done.
+// A nil *queryCost is allowed and does nothing, so handlers don't need to check if costs were requested.
+type queryCost struct {
+	start       time.Time
+	keysVisited uint64
+}
+
+// newQueryCost returns a new queryCost that starts timing now, or nil if not enabled.
+func newQueryCost(enabled bool) *queryCost {
+	if !enabled {
+		return nil
+	}
+	return &queryCost{start: time.Now()}
+}
+
+// addKeys records that the provided number of keys were visited.
+func (c *queryCost) addKeys(count int) {
+	if c != nil && count > 0 {
+		c.keysVisited += uint64(count)
+	}
+}
+
+// wrapStore returns a store that counts the keys visited by its iterators.
+// If this queryCost is nil, the provided store is returned unchanged.
+func (c *queryCost) wrapStore(store storetypes.KVStore) storetypes.KVStore {
+	if c == nil {
+		return store
+	}
+	return &costStore{KVStore: store, cost: c}
+}
+
+// toResult converts this queryCost into the QueryCost for a response. A nil queryCost gives nil.
+func (c *queryCost) toResult() *types.QueryCost {
+	if c == nil {
+		return nil
+	}
+	return &types.QueryCost{
+		KeysVisited:    c.keysVisited,
+		WallTimeMicros: uint64(time.Since(c.start).Microseconds()), //nolint:gosec // G115: Time since a past time is never negative.
+	}
+}
+
+// costStore is a KVStore whose iterators count the keys they visit.
+type costStore struct {
+	storetypes.KVStore
+	cost *queryCost
+}
+
+var _ storetypes.KVStore = (*costStore)(nil)
+
+// Iterator returns an iterator over the provided domain that counts the keys it visits.
+func (s *costStore) Iterator(start, end []byte) storetypes.Iterator {
+	return newCostIterator(s.KVStore.Iterator(start, end), s.cost)
+}
+
+// ReverseIterator returns a reverse iterator over the provided domain that counts the keys it visits.
+func (s *costStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return newCostIterator(s.KVStore.ReverseIterator(start, end), s.cost)
+}
+
+// costIterator is an iterator that counts each key it lands on.
+type costIterator struct {
+	storetypes.Iterator
+	cost *queryCost
+}
+
+// newCostIterator wraps the provided iterator so that it counts each key it lands on, including the first one.
+func newCostIterator(it storetypes.Iterator, cost *queryCost) storetypes.Iterator {
+	if it.Valid() {
+		cost.addKeys(1)
+	}
+	return &costIterator{Iterator: it, cost: cost}
+}
+
+// Next moves to the next key, counting it if there is one.
+func (i *costIterator) Next() {
+	i.Iterator.Next()
+	if i.Iterator.Valid() {
+		i.cost.addKeys(1)
+	}
+}
@@ -2,18 +2,27 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/store/prefix"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -24,30 +33,62 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
 
-// AllMarkers returns a list of all markers on the blockchain
+// AllMarkers returns a list of all markers on the blockchain.
+// Marker entries that can't be read are skipped and described in the response's errors.
 func (k Keeper) AllMarkers(c context.Context, req *types.QueryAllMarkersRequest) (*types.QueryAllMarkersResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
-	ctx := sdk.UnwrapSDKContext(c)
+	if _, known := types.MarkerStatus_name[int32(req.Status)]; !known {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown marker status %d", req.Status)
+	}
+	if _, known := types.MarkerType_name[int32(req.MarkerType)]; !known {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown marker type %d", req.MarkerType)
+	}
+	cost := newQueryCost(req.IncludeCost)
+	ctx, cancel := k.queryContext(c)
+	defer cancel()
 	markers := make([]*codectypes.Any, 0)
+	var badEntries []string
 	store := ctx.KVStore(k.storeKey)
-	markerStore := prefix.NewStore(store, types.MarkerStoreKeyPrefix)
-	pageRes, err := query.Paginate(markerStore, req.Pagination, func(_ []byte, value []byte) error {
-		result, err := k.GetMarker(ctx, sdk.AccAddress(value))
-		if err == nil {
+	markerStore := cost.wrapStore(prefix.NewStore(store, types.MarkerStoreKeyPrefix))
+	pageRes, err := query.FilteredPaginate(markerStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		if err := checkQueryDeadline(ctx); err != nil {
+			return false, err
+		}
+		addr := sdk.AccAddress(value)
+		result, err := k.GetMarker(ctx, addr)
+		if err == nil && result == nil {
+			err = errors.New("no marker account found")
+		}
+		if err != nil {
+			// A bad entry shouldn't stop the query, so it's skipped and reported instead.
+			// Only doing this when accumulating makes sure each bad entry is only reported in one page.
+			if accumulate {
+				k.Logger(ctx).Error("skipping bad marker entry in AllMarkers query", "address", addr.String(), "error", err)
+				badEntries = append(badEntries, fmt.Sprintf("%s: %v", addr, err))
+			}
+			return false, nil
+		}
+		if req.Status != types.StatusUndefined && result.GetStatus() != req.Status {
+			return false, nil
+		}
+		if req.MarkerType != types.MarkerType_Unknown && result.GetMarkerType() != req.MarkerType {
+			return false, nil
+		}
+		if accumulate {
 			anyMsg, anyErr := codectypes.NewAnyWithValue(result)
 			if anyErr != nil {
-				return status.Error(codes.Internal, anyErr.Error())
+				return false, status.Error(codes.Internal, anyErr.Error())
 			}
 			markers = append(markers, anyMsg)
 		}
-		return err
+		return true, nil
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &types.QueryAllMarkersResponse{Markers: markers, Pagination: pageRes}, nil
+	return &types.QueryAllMarkersResponse{Markers: markers, Pagination: pageRes, Cost: cost.toResult(), Errors: badEntries}, nil
 }
 
 // Marker query for a single marker by denom or address
@@ -64,7 +105,162 @@ func (k Keeper) Marker(c context.Context, req *types.QueryMarkerRequest) (*types
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &types.QueryMarkerResponse{Marker: anyMsg}, nil
+	resp := &types.QueryMarkerResponse{Marker: anyMsg, ResolvedId: types.NewResolvedMarkerID(marker)}
+	if req.IncludeOwnedScopes {
+		resp.OwnedScopes, resp.OwnedScopesPagination, err = k.getOwnedScopes(ctx, marker.GetAddress(), req.OwnedScopesPagination)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// MarkersByDenom returns the markers with each of several denoms, in the order requested.
+// A denom without a marker is listed in the response's not_found, and does not cause the whole query to fail.
+func (k Keeper) MarkersByDenom(c context.Context, req *types.QueryMarkersByDenomRequest) (*types.QueryMarkersByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.Denoms) > types.MaxMarkersByDenom {
+		return nil, status.Errorf(codes.InvalidArgument, "too many denoms %d: cannot have more than %d", len(req.Denoms), types.MaxMarkersByDenom)
+	}
+	ctx, cancel := k.queryContext(c)
+	defer cancel()
+
+	resp := &types.QueryMarkersByDenomResponse{Markers: make([]types.MarkerByDenomEntry, 0, len(req.Denoms))}
+	for _, denom := range req.Denoms {
+		if err := checkQueryDeadline(ctx); err != nil {
+			return nil, err
+		}
+		marker, err := k.GetMarkerByDenom(ctx, denom)
+		if err != nil {
+			resp.NotFound = append(resp.NotFound, denom)
+			continue
+		}
+		anyMsg, err := codectypes.NewAnyWithValue(marker)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Markers = append(resp.Markers, types.MarkerByDenomEntry{Denom: denom, Marker: anyMsg})
+	}
+
+	return resp, nil
+}
+
+// ownedScopesPageSize is the number of balances to get at a time when reading the scope denoms an account holds.
+// It's only a variable so that unit tests can use smaller pages.
+var ownedScopesPageSize uint64 = types.MaxOwnedScopesLimit
+
+// getOwnedScopes gets a page of the scopes that the provided account is the value owner of (i.e. has the scope denom of).
+// The page limit is capped at MaxOwnedScopesLimit. Only the account's balances with a scope denom prefix are read
+// (one page at a time), and a page key (a scope denom) lets reading start there.
+func (k Keeper) getOwnedScopes(ctx sdk.Context, addr sdk.AccAddress, pageReq *query.PageRequest) ([]types.ResolvedMetadataDenom, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+	if limit > types.MaxOwnedScopesLimit {
+		limit = types.MaxOwnedScopesLimit
+	}
+
+	rv := []types.ResolvedMetadataDenom{}
+	pageResp := &query.PageResponse{}
+	key := string(pageReq.Key)
+	var count uint64
+	done := false
+	for _, denomPrefix := range scopeDenomPrefixes(pageReq.Reverse) {
+		// If there's a page key, skip the prefixes that come before it, and start at it when it has this prefix.
+		start, skipAfter := denomPrefix, ""
+		switch {
+		case len(key) == 0:
+		case strings.HasPrefix(key, denomPrefix):
+			start, skipAfter = key, key
+		case (key < denomPrefix) == pageReq.Reverse:
+			continue
+		}
+
+		err := k.iterateBalanceDenoms(ctx, addr, start, pageReq.Reverse, func(denom string) bool {
+			if !strings.HasPrefix(denom, denomPrefix) {
+				return true
+			}
+			// In reverse, the bank module also provides the denoms that the key is a prefix of; they're on a previous page.
+			if pageReq.Reverse && len(skipAfter) > 0 && denom > skipAfter {
+				return false
+			}
+
+			switch {
+			case count < pageReq.Offset:
+				// Still skipping entries to get to the requested offset.
+			case count < pageReq.Offset+limit:
+				rv = append(rv, types.NewResolvedMetadataDenom(denom))
+			case count == pageReq.Offset+limit:
+				pageResp.NextKey = []byte(denom)
+			}
+			count++
+			done = len(pageResp.NextKey) > 0 && (!countTotal || len(key) > 0)
+			return done
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if done {
+			break
+		}
+	}
+
+	if countTotal && len(key) == 0 {
+		pageResp.Total = count
+	}
+	return rv, pageResp, nil
+}
+
+// scopeDenomPrefixes gets the string that each scope denom starts with, one for each metadata denom prefix.
+// They're sorted in the order that the bank module will provide them (i.e. descending if reverse is true).
+func scopeDenomPrefixes(reverse bool) []string {
+	rv := metadatatypes.MetadataDenomPrefixes()
+	for i := range rv {
+		rv[i] += metadatatypes.PrefixScope + "1"
+	}
+	slices.Sort(rv)
+	if reverse {
+		slices.Reverse(rv)
+	}
+	return rv
+}
+
+// iterateBalanceDenoms calls cb with the denom of each of an account's balances (in the order the bank module stores
+// them) until cb returns true. The balances are read from the bank module one page at a time, starting at the provided
+// denom. In reverse, that starts at the last denom that the start denom is a prefix of.
+func (k Keeper) iterateBalanceDenoms(ctx sdk.Context, addr sdk.AccAddress, start string, reverse bool, cb func(denom string) bool) error {
+	pageReq := &query.PageRequest{Key: []byte(start), Limit: ownedScopesPageSize, Reverse: reverse}
+	for pageReq != nil {
+		resp, err := k.bankKeeper.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: addr.String(), Pagination: pageReq})
+		if err != nil {
+			return err
+		}
+		// The bank module doesn't check for an expired context while iterating, so we check after each page.
+		if err = checkQueryDeadline(ctx); err != nil {
+			return err
+		}
+		for _, coin := range resp.Balances {
+			if cb(coin.Denom) {
+				return nil
+			}
+		}
+
+		pageReq = nil
+		if resp.Pagination != nil && len(resp.Pagination.NextKey) > 0 {
+			pageReq = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: ownedScopesPageSize, Reverse: reverse}
+		}
+	}
+	return nil
 }
 
 // Holding query for all accounts holding the given marker coins
@@ -72,20 +268,92 @@ func (k Keeper) Holding(c context.Context, req *types.QueryHoldingRequest) (*typ
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
-	ctx := sdk.UnwrapSDKContext(c)
+	cost := newQueryCost(req.IncludeCost)
+	ctx, cancel := k.queryContext(c)
+	defer cancel()
 	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
 	if err != nil {
 		return nil, err
 	}
 
 	denom := marker.GetDenom()
-	denomOwners, err := k.bankKeeper.DenomOwners(c, &banktypes.QueryDenomOwnersRequest{
+	filter, err := newHoldingFilter(req)
+	if err != nil {
+		return nil, err
+	}
+	countTotal := req.CountTotal || (req.Pagination != nil && req.Pagination.CountTotal)
+	var resp *types.QueryHoldingResponse
+	if filter != nil {
+		pageReq := req.Pagination
+		if countTotal {
+			pageReq = withCountTotal(pageReq)
+		}
+		resp, err = k.getFilteredHoldings(ctx, denom, filter, pageReq, cost)
+	} else {
+		resp, err = k.getHoldings(ctx, denom, req.Pagination, cost)
+		if err == nil && countTotal {
+			// The bank module only counts the total when paging by offset, so we always count them ourselves.
+			if resp.Pagination == nil {
+				resp.Pagination = &query.PageResponse{}
+			}
+			resp.Pagination.Total, _, err = k.countHolders(ctx, denom, marker.GetAddress(), cost)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if req.ResolveMetadata && metadatatypes.IsMetadataDenom(denom) {
+		resp.Metadata = []types.ResolvedMetadataDenom{types.NewResolvedMetadataDenom(denom)}
+	}
+	resp.Cost = cost.toResult()
+	return resp, nil
+}
+
+// withCountTotal returns a copy of the provided page request with CountTotal set.
+func withCountTotal(pageReq *query.PageRequest) *query.PageRequest {
+	rv := &query.PageRequest{}
+	if pageReq != nil {
+		*rv = *pageReq
+	}
+	rv.CountTotal = true
+	return rv
+}
+
+// HolderCount query for the number of accounts holding a marker's coins.
+func (k Keeper) HolderCount(c context.Context, req *types.QueryHolderCountRequest) (*types.QueryHolderCountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx, cancel := k.queryContext(c)
+	defer cancel()
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := marker.GetDenom()
+	count, held, err := k.countHolders(ctx, denom, marker.GetAddress(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryHolderCountResponse{HolderCount: count, HeldOutsideEscrow: sdk.NewCoin(denom, held)}, nil
+}
+
+// getHoldings gets a page of the accounts that hold the provided denom (using the bank module's DenomOwners).
+// Each denom owner read is recorded in the provided cost (which can be nil).
+func (k Keeper) getHoldings(ctx sdk.Context, denom string, pageReq *query.PageRequest, cost *queryCost) (*types.QueryHoldingResponse, error) {
+	denomOwners, err := k.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{
 		Denom:      denom,
-		Pagination: req.Pagination,
+		Pagination: pageReq,
 	})
 	if err != nil {
 		return nil, err
 	}
+	cost.addKeys(len(denomOwners.DenomOwners))
+	// The bank module doesn't check for an expired context while iterating, so we check once it's done.
+	if err = checkQueryDeadline(ctx); err != nil {
+		return nil, err
+	}
 
 	balances := make([]types.Balance, len(denomOwners.DenomOwners))
 	for i, bal := range denomOwners.DenomOwners {
@@ -101,6 +369,39 @@ func (k Keeper) Holding(c context.Context, req *types.QueryHoldingRequest) (*typ
 	}, nil
 }
 
+// HoldingDiff query for the accounts whose holdings of a marker's coins are different at two heights.
+func (k Keeper) HoldingDiff(c context.Context, req *types.QueryHoldingDiffRequest) (*types.QueryHoldingDiffResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.HeightA <= 0 || req.HeightB <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "heights must be positive, got height_a=%d and height_b=%d", req.HeightA, req.HeightB)
+	}
+	ctx, cancel := k.queryContext(c)
+	defer cancel()
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.GetHoldingDiff(ctx, marker.GetDenom(), req.HeightA, req.HeightB, req.Pagination)
+}
+
+// HoldingByAddress query for the marker coins held by an account.
+func (k Keeper) HoldingByAddress(c context.Context, req *types.QueryHoldingByAddressRequest) (*types.QueryHoldingByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %q: %v", req.Address, err)
+	}
+
+	ctx, cancel := k.queryContext(c)
+	defer cancel()
+	return k.getHoldingsByAddress(ctx, addr, req.IncludeAccess, req.Pagination)
+}
+
 // Supply query for supply of coin on a marker account
 func (k Keeper) Supply(c context.Context, req *types.QuerySupplyRequest) (*types.QuerySupplyResponse, error) {
 	if req == nil {
@@ -111,7 +412,7 @@ func (k Keeper) Supply(c context.Context, req *types.QuerySupplyRequest) (*types
 	if err != nil {
 		return nil, err
 	}
-	return &types.QuerySupplyResponse{Amount: marker.GetSupply()}, nil
+	return &types.QuerySupplyResponse{Amount: marker.GetSupply(), ResolvedId: types.NewResolvedMarkerID(marker)}, nil
 }
 
 // Escrow query for coins on a marker account
@@ -126,7 +427,11 @@ func (k Keeper) Escrow(c context.Context, req *types.QueryEscrowRequest) (*types
 	}
 	escrow := k.bankKeeper.GetAllBalances(ctx, marker.GetAddress())
 
-	return &types.QueryEscrowResponse{Escrow: escrow}, nil
+	resp := &types.QueryEscrowResponse{Escrow: escrow, ResolvedId: types.NewResolvedMarkerID(marker)}
+	if req.ResolveMetadata {
+		resp.Metadata = types.ResolveMetadataDenoms(escrow)
+	}
+	return resp, nil
 }
 
 // Access query for access records on an account
@@ -142,6 +447,54 @@ func (k Keeper) Access(c context.Context, req *types.QueryAccessRequest) (*types
 	return &types.QueryAccessResponse{Accounts: marker.GetAccessList()}, nil
 }
 
+// AccessGrantsByAddress query for all markers that an address has access on (optionally, a specific permission).
+func (k Keeper) AccessGrantsByAddress(c context.Context, req *types.QueryAccessGrantsByAddressRequest) (*types.QueryAccessGrantsByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %q: %v", req.Address, err)
+	}
+	if _, known := types.Access_name[int32(req.Permission)]; !known {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown permission %d", req.Permission)
+	}
+
+	ctx, cancel := k.queryContext(c)
+	defer cancel()
+	grants := make([]types.MarkerAccessGrant, 0)
+	store := ctx.KVStore(k.storeKey)
+	markerStore := prefix.NewStore(store, types.MarkerStoreKeyPrefix)
+	pageRes, err := query.FilteredPaginate(markerStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		if err := checkQueryDeadline(ctx); err != nil {
+			return false, err
+		}
+		marker, err := k.GetMarker(ctx, sdk.AccAddress(value))
+		if err != nil || marker == nil {
+			return false, err
+		}
+		grant := types.GrantsForAddress(addr, marker.GetAccessList()...)
+		if len(grant.Permissions) == 0 {
+			return false, nil
+		}
+		if req.Permission != types.Access_Unknown && !grant.HasAccess(req.Permission) {
+			return false, nil
+		}
+		if accumulate {
+			grants = append(grants, types.MarkerAccessGrant{
+				Denom:         marker.GetDenom(),
+				MarkerAddress: marker.GetAddress().String(),
+				Permissions:   grant.Permissions,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAccessGrantsByAddressResponse{Grants: grants, Pagination: pageRes}, nil
+}
+
 // DenomMetadata query for metadata on denom
 func (k Keeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
 	if req == nil {
@@ -179,11 +532,311 @@ func (k Keeper) AccountData(c context.Context, req *types.QueryAccountDataReques
 	return &types.QueryAccountDataResponse{Value: value}, nil
 }
 
+// AccountDataByAddresses returns the account data of each of several marker addresses.
+// A problem with one address is reported in its entry, and does not cause the whole query to fail.
+func (k Keeper) AccountDataByAddresses(c context.Context, req *types.QueryAccountDataByAddressesRequest) (*types.QueryAccountDataByAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.Addresses) > types.MaxAccountDataAddresses {
+		return nil, status.Errorf(codes.InvalidArgument, "too many addresses %d: cannot have more than %d", len(req.Addresses), types.MaxAccountDataAddresses)
+	}
+	ctx, cancel := k.queryContext(c)
+	defer cancel()
+
+	entries := make([]types.AccountDataEntry, len(req.Addresses))
+	for i, address := range req.Addresses {
+		if err := checkQueryDeadline(ctx); err != nil {
+			return nil, err
+		}
+		entries[i] = k.getAccountDataEntry(ctx, address)
+	}
+
+	return &types.QueryAccountDataByAddressesResponse{Entries: entries}, nil
+}
+
+// getAccountDataEntry looks up the account data of the marker with the provided address.
+// Any problem is recorded in the entry's error.
+func (k Keeper) getAccountDataEntry(ctx sdk.Context, address string) types.AccountDataEntry {
+	rv := types.AccountDataEntry{Address: address}
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		rv.Error = fmt.Sprintf("invalid address: %v", err)
+		return rv
+	}
+	if !k.IsMarkerAccount(ctx, addr) {
+		rv.Error = "not a marker account"
+		return rv
+	}
+	marker, err := k.GetMarker(ctx, addr)
+	if err != nil || marker == nil {
+		rv.Error = fmt.Sprintf("could not get marker: %v", err)
+		return rv
+	}
+	rv.Denom = marker.GetDenom()
+	rv.Value, err = k.attrKeeper.GetAccountData(ctx, address)
+	if err != nil {
+		rv.Error = fmt.Sprintf("could not get account data: %v", err)
+	}
+	return rv
+}
+
 // NetAssetValues query for returning net asset values for a marker
 func (k Keeper) NetAssetValues(c context.Context, req *types.QueryNetAssetValuesRequest) (*types.QueryNetAssetValuesResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	priceDenoms := make(map[string]bool, len(req.PriceDenoms))
+	for _, denom := range req.PriceDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid price denom: %v", err)
+		}
+		priceDenoms[denom] = true
+	}
+	ctx, cancel := k.queryContext(c)
+	defer cancel()
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// The keys in this store are the price denoms, so the results are ordered by price denom.
+	navs := make([]types.NetAssetValue, 0)
+	navStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.NetAssetValueKeyPrefix(marker.GetAddress()))
+	pageRes, err := query.FilteredPaginate(navStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if err := checkQueryDeadline(ctx); err != nil {
+			return false, err
+		}
+		if len(priceDenoms) > 0 && !priceDenoms[string(key)] {
+			return false, nil
+		}
+		if accumulate {
+			var nav types.NetAssetValue
+			if err := k.cdc.Unmarshal(value, &nav); err != nil {
+				return false, err
+			}
+			navs = append(navs, nav)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryNetAssetValuesResponse{NetAssetValues: navs, Pagination: pageRes}, nil
+}
+
+// CanSetNetAssetValue checks whether a net asset value would be allowed by the marker's net asset value bounds.
+func (k Keeper) CanSetNetAssetValue(c context.Context, req *types.QueryCanSetNetAssetValueRequest) (*types.QueryCanSetNetAssetValueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.NetAssetValue.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid net asset value: %v", err)
+	}
+	ctx, cancel := k.queryContext(c)
+	defer cancel()
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := k.getNetAssetValueChange(ctx, marker, req.NetAssetValue)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	maxChange, err := k.GetNetAssetValueBounds(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rv := &types.QueryCanSetNetAssetValueResponse{
+		Allowed:              true,
+		PercentChange:        types.FormatPercentChange(change),
+		MaxChangeBasisPoints: maxChange,
+	}
+	if err = types.CheckNetAssetValueChange(change, maxChange); err != nil {
+		rv.Allowed = false
+		rv.Reason = err.Error()
+	}
+	return rv, nil
+}
+
+// LastAdminCheck checks whether revoking an address's access would remove the last ADMIN access grant from a marker.
+func (k Keeper) LastAdminCheck(c context.Context, req *types.QueryLastAdminCheckRequest) (*types.QueryLastAdminCheckResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := &types.QueryLastAdminCheckResponse{}
+	if err = types.CheckLastAdminRemoval(marker, addr); err != nil {
+		rv.IsLastAdmin = true
+		rv.Reason = err.Error()
+	}
+	return rv, nil
+}
+
+// RecommendedGrants returns the permissions typically needed to operate a marker that no address currently has.
+func (k Keeper) RecommendedGrants(c context.Context, req *types.QueryRecommendedGrantsRequest) (*types.QueryRecommendedGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRecommendedGrantsResponse{Recommendations: types.RecommendedGrants(marker)}, nil
+}
+
+// DenomMetadataProblems returns the markers whose bank denom metadata is missing or inconsistent.
+func (k Keeper) DenomMetadataProblems(c context.Context, req *types.QueryDenomMetadataProblemsRequest) (*types.QueryDenomMetadataProblemsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx, cancel := k.queryContext(c)
+	defer cancel()
+	var problems []types.DenomMetadataProblem
+	store := ctx.KVStore(k.storeKey)
+	markerStore := prefix.NewStore(store, types.MarkerStoreKeyPrefix)
+	pageRes, err := query.Paginate(markerStore, req.Pagination, func(_ []byte, value []byte) error {
+		if err := checkQueryDeadline(ctx); err != nil {
+			return err
+		}
+		marker, err := k.GetMarker(ctx, sdk.AccAddress(value))
+		if err != nil {
+			return err
+		}
+		if marker == nil {
+			return nil
+		}
+		denom := marker.GetDenom()
+		md, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+		problems = append(problems, types.CheckDenomMetadata(denom, md, found)...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDenomMetadataProblemsResponse{Problems: problems, Pagination: pageRes}, nil
+}
+
+// ConvertValue converts an amount into a target denom by chaining together recorded net asset values.
+func (k Keeper) ConvertValue(c context.Context, req *types.QueryConvertValueRequest) (*types.QueryConvertValueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	maxHops := int(req.MaxHops)
+	if maxHops == 0 {
+		maxHops = types.DefaultConvertValueMaxHops
+	}
+	if maxHops > types.MaxConvertValueHops {
+		return nil, status.Errorf(codes.InvalidArgument, "max hops %d cannot be more than %d", maxHops, types.MaxConvertValueHops)
+	}
+	ctx, cancel := k.queryContext(c)
+	defer cancel()
+
+	value, navs, err := k.ConvertValueThroughNAVs(ctx, req.Amount, req.TargetDenom, maxHops)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryConvertValueResponse{Value: value, NetAssetValues: navs}, nil
+}
+
+// MarkerValue returns the value of the full supply of a marker in a price denom.
+func (k Keeper) MarkerValue(c context.Context, req *types.QueryMarkerValueRequest) (*types.QueryMarkerValueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := sdk.ValidateDenom(req.PriceDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid price denom: %v", err)
+	}
+	ctx, cancel := k.queryContext(c)
+	defer cancel()
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	mv, err := k.GetMarkerValue(ctx, marker, req.PriceDenom)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if mv == nil {
+		return nil, status.Errorf(codes.NotFound, "marker %q does not have a net asset value in %q", marker.GetDenom(), req.PriceDenom)
+	}
+
+	return &types.QueryMarkerValueResponse{MarkerValue: *mv}, nil
+}
+
+// AllMarkersValue returns the total value, in a price denom, of the full supply of all markers with a net asset value in that denom.
+func (k Keeper) AllMarkersValue(c context.Context, req *types.QueryAllMarkersValueRequest) (*types.QueryAllMarkersValueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := sdk.ValidateDenom(req.PriceDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid price denom: %v", err)
+	}
+	ctx, cancel := k.queryContext(c)
+	defer cancel()
+
+	total, values, skipped, err := k.GetAllMarkersValue(ctx, req.PriceDenom)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryAllMarkersValueResponse{Total: total, MarkerValues: values, SkippedDenoms: skipped}, nil
+}
+
+// AccountDataHistoryAvailable reports whether previous account data values of a marker are retained.
+// The attribute module only keeps the current account data value, so they never are.
+func (k Keeper) AccountDataHistoryAvailable(_ context.Context, req *types.QueryAccountDataHistoryAvailableRequest) (*types.QueryAccountDataHistoryAvailableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := types.MarkerAddress(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryAccountDataHistoryAvailableResponse{Available: false}, nil
+}
+
+// MarkerTransferRules returns the settings of a marker that control how its funds can be transferred.
+func (k Keeper) MarkerTransferRules(c context.Context, req *types.QueryMarkerTransferRulesRequest) (*types.QueryMarkerTransferRulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	var addr sdk.AccAddress
+	if len(req.Address) > 0 {
+		var err error
+		addr, err = sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+		}
+	}
 	ctx := sdk.UnwrapSDKContext(c)
 
 	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
@@ -191,16 +844,111 @@ func (k Keeper) NetAssetValues(c context.Context, req *types.QueryNetAssetValues
 		return nil, err
 	}
 
-	var navs []types.NetAssetValue
-	err = k.IterateNetAssetValues(ctx, marker.GetAddress(), func(nav types.NetAssetValue) (stop bool) {
-		navs = append(navs, nav)
-		return false
+	rv := &types.QueryMarkerTransferRulesResponse{
+		Denom:               marker.GetDenom(),
+		MarkerType:          marker.GetMarkerType(),
+		AllowForcedTransfer: marker.AllowsForcedTransfer(),
+		RequiredAttributes:  marker.GetRequiredAttributes(),
+		SendEnabled:         k.bankKeeper.IsSendEnabledDenom(ctx, marker.GetDenom()),
+	}
+	if len(addr) > 0 {
+		rv.Address = addr.String()
+		rv.HasTransferAccess = marker.AddressHasAccess(addr, types.Access_Transfer)
+	}
+	return rv, nil
+}
+
+// RestrictionTrace returns the recorded send restriction decisions for a recent transaction.
+// Traces are only recorded (in memory, on this node) when restriction tracing is enabled in the app config.
+func (k Keeper) RestrictionTrace(_ context.Context, req *types.QueryRestrictionTraceRequest) (*types.QueryRestrictionTraceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	txHash := strings.ToUpper(req.TxHash)
+	if bz, err := hex.DecodeString(txHash); err != nil || len(bz) != tmhash.Size {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash %q: must be %d hex characters", req.TxHash, 2*tmhash.Size)
+	}
+	if !k.restrictionTraces.isEnabled() {
+		return nil, status.Errorf(codes.FailedPrecondition, "restriction tracing is not enabled on this node (see %s)", types.AppConfigKeyRestrictionTrace)
+	}
+	traces := k.restrictionTraces.find(txHash)
+	if len(traces) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no restriction traces found for tx %s", txHash)
+	}
+	return &types.QueryRestrictionTraceResponse{Traces: traces}, nil
+}
+
+// MarkersByRequiredAttribute returns the restricted markers with a required attribute that the requested attribute satisfies.
+func (k Keeper) MarkersByRequiredAttribute(c context.Context, req *types.QueryMarkersByRequiredAttributeRequest) (*types.QueryMarkersByRequiredAttributeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	attr := nametypes.NormalizeName(req.Attribute)
+	if len(attr) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "attribute name cannot be empty")
+	}
+	if strings.Contains(attr, "*") {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attribute name %q: wildcards are not allowed", req.Attribute)
+	}
+
+	ctx, cancel := k.queryContext(c)
+	defer cancel()
+	matches := make([]types.MarkerRequiredAttributeMatch, 0)
+	store := ctx.KVStore(k.storeKey)
+	markerStore := prefix.NewStore(store, types.MarkerStoreKeyPrefix)
+	pageRes, err := query.FilteredPaginate(markerStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		if err := checkQueryDeadline(ctx); err != nil {
+			return false, err
+		}
+		marker, err := k.GetMarker(ctx, sdk.AccAddress(value))
+		if err != nil || marker == nil {
+			return false, err
+		}
+		if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+			return false, nil
+		}
+		reqAttr, found := types.FindMatchingRequiredAttribute(marker.GetRequiredAttributes(), attr)
+		if !found {
+			return false, nil
+		}
+		if accumulate {
+			matches = append(matches, types.MarkerRequiredAttributeMatch{
+				Denom:             marker.GetDenom(),
+				MarkerAddress:     marker.GetAddress().String(),
+				RequiredAttribute: reqAttr,
+			})
+		}
+		return true, nil
 	})
 	if err != nil {
 		return nil, err
 	}
+	return &types.QueryMarkersByRequiredAttributeResponse{Markers: matches, Pagination: pageRes}, nil
+}
+
+// queryContext unwraps the provided context and, if there's a query timeout, gives it a deadline.
+// The returned cancel func should always be called once the query is done (e.g. with defer).
+func (k Keeper) queryContext(c context.Context) (sdk.Context, context.CancelFunc) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if k.queryTimeout <= 0 {
+		return ctx, func() {}
+	}
+	goCtx, cancel := context.WithTimeout(ctx.Context(), k.queryTimeout)
+	return ctx.WithContext(goCtx), cancel
+}
 
-	return &types.QueryNetAssetValuesResponse{NetAssetValues: navs}, nil
+// checkQueryDeadline returns a DeadlineExceeded error if the provided context's deadline has passed,
+// or a Canceled error if the context was otherwise canceled.
+func checkQueryDeadline(ctx sdk.Context) error {
+	err := ctx.Context().Err()
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, "marker query exceeded the configured query timeout")
+	default:
+		return status.Error(codes.Canceled, err.Error())
+	}
 }
 
 // accountForDenomOrAddress attempts to first get a marker by account address and then by denom.
@@ -215,7 +963,7 @@ func accountForDenomOrAddress(ctx sdk.Context, keeper Keeper, lookup string) (ty
 	} else {
 		account, err = keeper.GetMarker(ctx, addr)
 	}
-	if err != nil {
+	if err != nil || account == nil {
 		return nil, types.ErrMarkerNotFound.Wrap("invalid denom or address")
 	}
 	return account, nil
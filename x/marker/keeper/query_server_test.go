@@ -0,0 +1,2397 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestQueryResolvedID(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	denom := "resolvecoin"
+	marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+		*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint}),
+	})
+	marker.Supply = sdkmath.NewInt(100)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+
+	expected := &types.ResolvedMarkerID{Denom: denom, Address: marker.GetAddress().String()}
+
+	tests := []struct {
+		name string
+		id   string
+	}{
+		{name: "by denom", id: denom},
+		{name: "by address", id: marker.GetAddress().String()},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			markerResp, err := app.MarkerKeeper.Marker(ctx, &types.QueryMarkerRequest{Id: tc.id})
+			if assert.NoError(t, err, "Marker(%q)", tc.id) {
+				assert.Equal(t, expected, markerResp.ResolvedId, "Marker(%q) ResolvedId", tc.id)
+			}
+
+			supplyResp, err := app.MarkerKeeper.Supply(ctx, &types.QuerySupplyRequest{Id: tc.id})
+			if assert.NoError(t, err, "Supply(%q)", tc.id) {
+				assert.Equal(t, expected, supplyResp.ResolvedId, "Supply(%q) ResolvedId", tc.id)
+			}
+
+			escrowResp, err := app.MarkerKeeper.Escrow(ctx, &types.QueryEscrowRequest{Id: tc.id})
+			if assert.NoError(t, err, "Escrow(%q)", tc.id) {
+				assert.Equal(t, expected, escrowResp.ResolvedId, "Escrow(%q) ResolvedId", tc.id)
+			}
+		})
+	}
+
+	t.Run("unknown address", func(t *testing.T) {
+		id := sdk.AccAddress("unknown_marker______").String()
+		_, err := app.MarkerKeeper.Supply(ctx, &types.QuerySupplyRequest{Id: id})
+		assert.EqualError(t, err, "invalid denom or address: marker not found", "Supply(%q)", id)
+	})
+}
+
+func TestQueryDenomMetadataProblems(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	addMarker := func(denom string, md *banktypes.Metadata) {
+		marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+			*types.NewAccessGrant(admin, []types.Access{types.Access_Admin}),
+		})
+		marker.Supply = sdkmath.NewInt(100)
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker(%q)", denom)
+		if md != nil {
+			app.BankKeeper.SetDenomMetaData(ctx, *md)
+		}
+	}
+	newMetadata := func(base, display string, units ...*banktypes.DenomUnit) *banktypes.Metadata {
+		return &banktypes.Metadata{Base: base, Display: display, DenomUnits: units}
+	}
+	unit := func(denom string, exp uint32) *banktypes.DenomUnit {
+		return &banktypes.DenomUnit{Denom: denom, Exponent: exp}
+	}
+
+	addMarker("nanohealthy", newMetadata("nanohealthy", "healthy", unit("nanohealthy", 0), unit("healthy", 9)))
+	addMarker("nanomissing", nil)
+	addMarker("nanomismatch", nil)
+	// SetDenomMetaData stores metadata using its base, so write this directly to get a base that differs from the denom.
+	mismatched := newMetadata("nanoother", "mismatch", unit("nanoother", 0), unit("mismatch", 9))
+	require.NoError(t, app.BankKeeper.BaseViewKeeper.DenomMetadata.Set(ctx, "nanomismatch", *mismatched), "setting mismatched metadata")
+	addMarker("nanobroken", newMetadata("nanobroken", "broken", unit("nanobroken", 0), unit("broken", 9), unit("millibroken", 6)))
+	addMarker("nanoemptydisp", newMetadata("nanoemptydisp", "", unit("nanoemptydisp", 0), unit("emptydisp", 9)))
+
+	resp, err := app.MarkerKeeper.DenomMetadataProblems(ctx, &types.QueryDenomMetadataProblemsRequest{})
+	require.NoError(t, err, "DenomMetadataProblems")
+
+	actual := make(map[string][]types.DenomMetadataProblemType)
+	for _, problem := range resp.Problems {
+		actual[problem.Denom] = append(actual[problem.Denom], problem.Problem)
+	}
+
+	assert.NotContains(t, actual, "nanohealthy", "problems for healthy marker")
+	assert.Equal(t, []types.DenomMetadataProblemType{types.DenomMetadataProblemType_Missing}, actual["nanomissing"], "problems for missing metadata")
+	assert.Equal(t, []types.DenomMetadataProblemType{types.DenomMetadataProblemType_BaseMismatch}, actual["nanomismatch"], "problems for base mismatch")
+	assert.Equal(t, []types.DenomMetadataProblemType{types.DenomMetadataProblemType_BrokenExponentChain}, actual["nanobroken"], "problems for broken exponent chain")
+	assert.Equal(t, []types.DenomMetadataProblemType{types.DenomMetadataProblemType_EmptyDisplay}, actual["nanoemptydisp"], "problems for empty display")
+
+	t.Run("paginated", func(t *testing.T) {
+		var denoms []string
+		var nextKey []byte
+		for i := 0; i == 0 || len(nextKey) > 0; i++ {
+			require.Less(t, i, 100, "number of pages")
+			page, err := app.MarkerKeeper.DenomMetadataProblems(ctx, &types.QueryDenomMetadataProblemsRequest{
+				Pagination: &query.PageRequest{Key: nextKey, Limit: 1},
+			})
+			require.NoError(t, err, "DenomMetadataProblems page %d", i)
+			for _, problem := range page.Problems {
+				denoms = append(denoms, problem.Denom)
+			}
+			nextKey = page.Pagination.NextKey
+		}
+		var expDenoms []string
+		for _, problem := range resp.Problems {
+			expDenoms = append(expDenoms, problem.Denom)
+		}
+		assert.Equal(t, expDenoms, denoms, "denoms with problems from all pages")
+	})
+}
+
+func TestQueryAllMarkersFilters(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	newMarker := func(denom string, markerType types.MarkerType, markerStatus types.MarkerStatus) {
+		marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+			*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint}),
+		})
+		marker.MarkerType = markerType
+		marker.Status = markerStatus
+		app.MarkerKeeper.SetNewMarker(ctx, marker)
+	}
+	newMarker("filtercoinactive", types.MarkerType_Coin, types.StatusActive)
+	newMarker("filtercoinactive2", types.MarkerType_Coin, types.StatusActive)
+	newMarker("filtercoinproposed", types.MarkerType_Coin, types.StatusProposed)
+	newMarker("filterrestrictedactive", types.MarkerType_RestrictedCoin, types.StatusActive)
+	newMarker("filterrestrictedproposed", types.MarkerType_RestrictedCoin, types.StatusProposed)
+	newMarker("filterrestrictedfinalized", types.MarkerType_RestrictedCoin, types.StatusFinalized)
+
+	// getDenoms returns the sorted denoms of the provided markers that were created for this test.
+	getDenoms := func(t *testing.T, markers []*codectypes.Any) []string {
+		t.Helper()
+		var rv []string
+		for _, m := range markers {
+			var marker types.MarkerAccountI
+			require.NoError(t, app.InterfaceRegistry().UnpackAny(m, &marker), "UnpackAny")
+			if strings.HasPrefix(marker.GetDenom(), "filter") {
+				rv = append(rv, marker.GetDenom())
+			}
+		}
+		sort.Strings(rv)
+		return rv
+	}
+
+	tests := []struct {
+		name       string
+		req        *types.QueryAllMarkersRequest
+		expErr     string
+		expDenoms  []string
+		expTotal   uint64
+		expNextKey bool
+	}{
+		{
+			name: "no filters",
+			req:  &types.QueryAllMarkersRequest{},
+			expDenoms: []string{
+				"filtercoinactive", "filtercoinactive2", "filtercoinproposed",
+				"filterrestrictedactive", "filterrestrictedfinalized", "filterrestrictedproposed",
+			},
+		},
+		{
+			name:      "status active",
+			req:       &types.QueryAllMarkersRequest{Status: types.StatusActive},
+			expDenoms: []string{"filtercoinactive", "filtercoinactive2", "filterrestrictedactive"},
+		},
+		{
+			name:      "type restricted",
+			req:       &types.QueryAllMarkersRequest{MarkerType: types.MarkerType_RestrictedCoin},
+			expDenoms: []string{"filterrestrictedactive", "filterrestrictedfinalized", "filterrestrictedproposed"},
+		},
+		{
+			name:      "status proposed and type restricted",
+			req:       &types.QueryAllMarkersRequest{Status: types.StatusProposed, MarkerType: types.MarkerType_RestrictedCoin},
+			expDenoms: []string{"filterrestrictedproposed"},
+		},
+		{
+			name:      "status destroyed",
+			req:       &types.QueryAllMarkersRequest{Status: types.StatusDestroyed},
+			expDenoms: nil,
+		},
+		{
+			name: "pagination counts only matching markers",
+			req: &types.QueryAllMarkersRequest{
+				Status:     types.StatusFinalized,
+				MarkerType: types.MarkerType_RestrictedCoin,
+				Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+			},
+			expDenoms: []string{"filterrestrictedfinalized"},
+			expTotal:  1,
+		},
+		{
+			name:   "unknown status",
+			req:    &types.QueryAllMarkersRequest{Status: 99},
+			expErr: "rpc error: code = InvalidArgument desc = unknown marker status 99",
+		},
+		{
+			name:   "unknown marker type",
+			req:    &types.QueryAllMarkersRequest{MarkerType: 7},
+			expErr: "rpc error: code = InvalidArgument desc = unknown marker type 7",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := app.MarkerKeeper.AllMarkers(ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "AllMarkers error")
+				return
+			}
+			require.NoError(t, err, "AllMarkers")
+			assert.Equal(t, tc.expDenoms, getDenoms(t, resp.Markers), "AllMarkers denoms")
+			if tc.req.Pagination != nil && tc.req.Pagination.CountTotal {
+				assert.Equal(t, int(tc.expTotal), int(resp.Pagination.Total), "AllMarkers pagination total")
+				assert.Empty(t, resp.Pagination.NextKey, "AllMarkers pagination next key")
+			}
+		})
+	}
+}
+
+func TestQueryAllMarkersBadEntries(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	for _, denom := range []string{"goodcoin", "goodcoin2"} {
+		marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+			*types.NewAccessGrant(admin, []types.Access{types.Access_Admin}),
+		})
+		app.MarkerKeeper.SetNewMarker(ctx, marker)
+	}
+
+	// Add marker entries (that sort before all others) for an address without an account,
+	// and for an address with an account that isn't a marker account.
+	missingAddr := sdk.AccAddress(make([]byte, 20))
+	notMarkerAddr := sdk.AccAddress(append(make([]byte, 19), 1))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, notMarkerAddr))
+	store := app.MarkerKeeper.GetStore(ctx)
+	store.Set(types.MarkerStoreKey(missingAddr), missingAddr)
+	store.Set(types.MarkerStoreKey(notMarkerAddr), notMarkerAddr)
+
+	expErrors := []string{
+		missingAddr.String() + ": no marker account found",
+		notMarkerAddr.String() + ": account at " + notMarkerAddr.String() + " is not a marker account",
+	}
+
+	resp, err := app.MarkerKeeper.AllMarkers(ctx, &types.QueryAllMarkersRequest{})
+	require.NoError(t, err, "AllMarkers")
+	assert.Equal(t, expErrors, resp.Errors, "AllMarkers errors")
+	var denoms []string
+	for _, m := range resp.Markers {
+		var acct types.MarkerAccountI
+		require.NoError(t, app.InterfaceRegistry().UnpackAny(m, &acct), "UnpackAny")
+		denoms = append(denoms, acct.GetDenom())
+	}
+	sort.Strings(denoms)
+	assert.Equal(t, []string{"goodcoin", "goodcoin2"}, denoms, "AllMarkers denoms")
+
+	// Each bad entry should only be reported in the page it would have been in.
+	page1, err := app.MarkerKeeper.AllMarkers(ctx, &types.QueryAllMarkersRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err, "AllMarkers page 1")
+	assert.Equal(t, expErrors, page1.Errors, "AllMarkers page 1 errors")
+	require.NotEmpty(t, page1.Pagination.NextKey, "AllMarkers page 1 next key")
+	page2, err := app.MarkerKeeper.AllMarkers(ctx, &types.QueryAllMarkersRequest{Pagination: &query.PageRequest{Key: page1.Pagination.NextKey, Limit: 1}})
+	require.NoError(t, err, "AllMarkers page 2")
+	assert.Empty(t, page2.Errors, "AllMarkers page 2 errors")
+}
+
+func TestQueryResolveMetadata(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	scopeUUID := uuid.MustParse("91f2b84c-57b5-4a38-a3f3-6dd36a8b4ab4")
+	sessionUUID := uuid.MustParse("b47d4b5e-8b5c-4ec8-a4a2-bbbe26b5dd21")
+	scopeID := metadatatypes.ScopeMetadataAddress(scopeUUID)
+	sessionID := metadatatypes.SessionMetadataAddress(scopeUUID, sessionUUID)
+	badDenom := metadatatypes.DenomPrefix + "notanaddress"
+
+	denom := "resolvemetacoin"
+	marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+		*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint}),
+	})
+	marker.Supply = sdkmath.NewInt(100)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	escrowed := sdk.NewCoins(scopeID.Coin(), sessionID.Coin(), sdk.NewInt64Coin(badDenom, 1), sdk.NewInt64Coin("othercoin", 5))
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, marker.GetAddress(), escrowed), "FundAccount")
+
+	scopeResolved := types.ResolvedMetadataDenom{
+		Denom:       scopeID.Denom(),
+		Address:     scopeID.String(),
+		AddressType: metadatatypes.PrefixScope,
+		PrimaryUuid: scopeUUID.String(),
+	}
+	sessionResolved := types.ResolvedMetadataDenom{
+		Denom:       sessionID.Denom(),
+		Address:     sessionID.String(),
+		AddressType: metadatatypes.PrefixSession,
+		PrimaryUuid: scopeUUID.String(),
+	}
+	badResolved := types.ResolvedMetadataDenom{
+		Denom: badDenom,
+		Error: "invalid metadata address in denom \"" + badDenom + "\": decoding bech32 failed: invalid separator index -1",
+	}
+
+	t.Run("escrow without resolve", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.Escrow(ctx, &types.QueryEscrowRequest{Id: denom})
+		require.NoError(t, err, "Escrow")
+		assert.Equal(t, escrowed.Add(sdk.NewInt64Coin(denom, 100)).String(), resp.Escrow.String(), "Escrow escrow")
+		assert.Nil(t, resp.Metadata, "Escrow metadata")
+	})
+
+	t.Run("escrow with resolve", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.Escrow(ctx, &types.QueryEscrowRequest{Id: denom, ResolveMetadata: true})
+		require.NoError(t, err, "Escrow")
+		assert.Equal(t, escrowed.Add(sdk.NewInt64Coin(denom, 100)).String(), resp.Escrow.String(), "Escrow escrow")
+		// The escrow coins are sorted by denom, and the metadata entries are in the same order.
+		var expected []types.ResolvedMetadataDenom
+		for _, coin := range resp.Escrow {
+			switch coin.Denom {
+			case scopeID.Denom():
+				expected = append(expected, scopeResolved)
+			case sessionID.Denom():
+				expected = append(expected, sessionResolved)
+			case badDenom:
+				expected = append(expected, badResolved)
+			}
+		}
+		assert.Len(t, expected, 3, "expected metadata entries")
+		assert.Equal(t, expected, resp.Metadata, "Escrow metadata")
+	})
+
+	t.Run("holding of metadata denom", func(t *testing.T) {
+		scopeMarker := types.NewEmptyMarkerAccount(scopeID.Denom(), admin.String(), []types.AccessGrant{
+			*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint}),
+		})
+		scopeMarker.Supply = sdkmath.NewInt(1)
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, scopeMarker), "AddFinalizeAndActivateMarker(scope)")
+
+		resp, err := app.MarkerKeeper.Holding(ctx, &types.QueryHoldingRequest{Id: scopeID.Denom()})
+		require.NoError(t, err, "Holding without resolve")
+		assert.Nil(t, resp.Metadata, "Holding without resolve metadata")
+
+		resp, err = app.MarkerKeeper.Holding(ctx, &types.QueryHoldingRequest{Id: scopeID.Denom(), ResolveMetadata: true})
+		require.NoError(t, err, "Holding with resolve")
+		assert.Equal(t, []types.ResolvedMetadataDenom{scopeResolved}, resp.Metadata, "Holding with resolve metadata")
+
+		resp, err = app.MarkerKeeper.Holding(ctx, &types.QueryHoldingRequest{Id: scopeID.Denom(), ResolveMetadata: true, MinAmount: "1"})
+		require.NoError(t, err, "Holding with resolve and filter")
+		assert.Equal(t, []types.ResolvedMetadataDenom{scopeResolved}, resp.Metadata, "Holding with resolve and filter metadata")
+	})
+
+	t.Run("holding of regular coin with resolve", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.Holding(ctx, &types.QueryHoldingRequest{Id: denom, ResolveMetadata: true})
+		require.NoError(t, err, "Holding")
+		assert.Len(t, resp.Balances, 1, "Holding balances")
+		assert.Nil(t, resp.Metadata, "Holding metadata")
+	})
+}
+
+func TestQueryMarkerOwnedScopes(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	scopeUUID1 := uuid.MustParse("1e5bf1d0-3bc6-4c6a-9f4d-6a2a1c8f0e01")
+	scopeUUID2 := uuid.MustParse("2e5bf1d0-3bc6-4c6a-9f4d-6a2a1c8f0e02")
+	scopeID1 := metadatatypes.ScopeMetadataAddress(scopeUUID1)
+	scopeID2 := metadatatypes.ScopeMetadataAddress(scopeUUID2)
+	sessionID := metadatatypes.SessionMetadataAddress(scopeUUID1, uuid.MustParse("3e5bf1d0-3bc6-4c6a-9f4d-6a2a1c8f0e03"))
+
+	denom := "ownedscopescoin"
+	marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+		*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint}),
+	})
+	marker.Supply = sdkmath.NewInt(100)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	held := sdk.NewCoins(scopeID1.Coin(), scopeID2.Coin(), sessionID.Coin(),
+		sdk.NewInt64Coin("othercoin", 5), sdk.NewInt64Coin("zzzcoin", 3))
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, marker.GetAddress(), held), "FundAccount")
+
+	expected := []types.ResolvedMetadataDenom{
+		{Denom: scopeID1.Denom(), Address: scopeID1.String(), AddressType: metadatatypes.PrefixScope, PrimaryUuid: scopeUUID1.String()},
+		{Denom: scopeID2.Denom(), Address: scopeID2.String(), AddressType: metadatatypes.PrefixScope, PrimaryUuid: scopeUUID2.String()},
+	}
+	sort.Slice(expected, func(i, j int) bool { return expected[i].Denom < expected[j].Denom })
+
+	t.Run("not requested", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.Marker(ctx, &types.QueryMarkerRequest{Id: denom})
+		require.NoError(t, err, "Marker")
+		assert.Nil(t, resp.OwnedScopes, "Marker owned scopes")
+		assert.Nil(t, resp.OwnedScopesPagination, "Marker owned scopes pagination")
+	})
+
+	t.Run("both scopes listed", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.Marker(ctx, &types.QueryMarkerRequest{Id: denom, IncludeOwnedScopes: true})
+		require.NoError(t, err, "Marker")
+		assert.Equal(t, expected, resp.OwnedScopes, "Marker owned scopes")
+		require.NotNil(t, resp.OwnedScopesPagination, "Marker owned scopes pagination")
+		assert.Empty(t, resp.OwnedScopesPagination.NextKey, "Marker owned scopes next key")
+		assert.Equal(t, uint64(2), resp.OwnedScopesPagination.Total, "Marker owned scopes total")
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		var actual []types.ResolvedMetadataDenom
+		var nextKey []byte
+		for i := 0; i < 3; i++ {
+			resp, err := app.MarkerKeeper.Marker(ctx, &types.QueryMarkerRequest{
+				Id:                    denom,
+				IncludeOwnedScopes:    true,
+				OwnedScopesPagination: &query.PageRequest{Key: nextKey, Limit: 1},
+			})
+			require.NoError(t, err, "Marker page %d", i+1)
+			require.Len(t, resp.OwnedScopes, 1, "Marker page %d owned scopes", i+1)
+			actual = append(actual, resp.OwnedScopes...)
+			nextKey = resp.OwnedScopesPagination.NextKey
+			if len(nextKey) == 0 {
+				break
+			}
+		}
+		assert.Equal(t, expected, actual, "owned scopes from all pages")
+	})
+
+	t.Run("one scope per page, small balance pages", func(t *testing.T) {
+		defer markerkeeper.SetOwnedScopesPageSize(1)()
+		for _, reverse := range []bool{false, true} {
+			var actual []types.ResolvedMetadataDenom
+			var nextKey []byte
+			for i := 0; i < 3; i++ {
+				resp, err := app.MarkerKeeper.Marker(ctx, &types.QueryMarkerRequest{
+					Id:                    denom,
+					IncludeOwnedScopes:    true,
+					OwnedScopesPagination: &query.PageRequest{Key: nextKey, Limit: 1, Reverse: reverse},
+				})
+				require.NoError(t, err, "Marker page %d (reverse = %t)", i+1, reverse)
+				require.Len(t, resp.OwnedScopes, 1, "Marker page %d (reverse = %t) owned scopes", i+1, reverse)
+				actual = append(actual, resp.OwnedScopes...)
+				nextKey = resp.OwnedScopesPagination.NextKey
+				if len(nextKey) == 0 {
+					break
+				}
+			}
+			exp := slices.Clone(expected)
+			if reverse {
+				slices.Reverse(exp)
+			}
+			assert.Equal(t, exp, actual, "owned scopes from all pages (reverse = %t)", reverse)
+		}
+	})
+
+	t.Run("offset", func(t *testing.T) {
+		defer markerkeeper.SetOwnedScopesPageSize(2)()
+		resp, err := app.MarkerKeeper.Marker(ctx, &types.QueryMarkerRequest{
+			Id:                    denom,
+			IncludeOwnedScopes:    true,
+			OwnedScopesPagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+		})
+		require.NoError(t, err, "Marker")
+		assert.Equal(t, expected[1:], resp.OwnedScopes, "Marker owned scopes")
+		require.NotNil(t, resp.OwnedScopesPagination, "Marker owned scopes pagination")
+		assert.Empty(t, resp.OwnedScopesPagination.NextKey, "Marker owned scopes next key")
+		assert.Equal(t, uint64(2), resp.OwnedScopesPagination.Total, "Marker owned scopes total")
+	})
+
+	t.Run("limit is capped", func(t *testing.T) {
+		req := &types.QueryMarkerRequest{
+			Id:                    denom,
+			IncludeOwnedScopes:    true,
+			OwnedScopesPagination: &query.PageRequest{Limit: types.MaxOwnedScopesLimit + 1},
+		}
+		resp, err := app.MarkerKeeper.Marker(ctx, req)
+		require.NoError(t, err, "Marker")
+		assert.Equal(t, expected, resp.OwnedScopes, "Marker owned scopes")
+		assert.Equal(t, uint64(types.MaxOwnedScopesLimit+1), req.OwnedScopesPagination.Limit, "request limit after query")
+	})
+
+	t.Run("key and offset", func(t *testing.T) {
+		_, err := app.MarkerKeeper.Marker(ctx, &types.QueryMarkerRequest{
+			Id:                    denom,
+			IncludeOwnedScopes:    true,
+			OwnedScopesPagination: &query.PageRequest{Key: []byte("nft/"), Offset: 1},
+		})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = either offset or key is expected, got both", "Marker error")
+	})
+}
+
+func TestNewResolvedMetadataDenom(t *testing.T) {
+	scopeUUID := uuid.MustParse("91f2b84c-57b5-4a38-a3f3-6dd36a8b4ab4")
+	scopeID := metadatatypes.ScopeMetadataAddress(scopeUUID)
+	recordID := metadatatypes.RecordMetadataAddress(scopeUUID, "loan-doc")
+
+	tests := []struct {
+		name  string
+		denom string
+		exp   types.ResolvedMetadataDenom
+	}{
+		{
+			name:  "scope",
+			denom: scopeID.Denom(),
+			exp: types.ResolvedMetadataDenom{
+				Denom:       scopeID.Denom(),
+				Address:     scopeID.String(),
+				AddressType: metadatatypes.PrefixScope,
+				PrimaryUuid: scopeUUID.String(),
+			},
+		},
+		{
+			name:  "record",
+			denom: recordID.Denom(),
+			exp: types.ResolvedMetadataDenom{
+				Denom:       recordID.Denom(),
+				Address:     recordID.String(),
+				AddressType: metadatatypes.PrefixRecord,
+				PrimaryUuid: scopeUUID.String(),
+			},
+		},
+		{
+			name:  "not a metadata denom",
+			denom: "nhash",
+			exp: types.ResolvedMetadataDenom{
+				Denom: "nhash",
+				Error: "denom \"nhash\" is not a MetadataAddress denom",
+			},
+		},
+		{
+			name:  "account address",
+			denom: "nft/" + sdk.AccAddress("not_a_metadata_addr_").String(),
+			exp: types.ResolvedMetadataDenom{
+				Denom: "nft/" + sdk.AccAddress("not_a_metadata_addr_").String(),
+				Error: "invalid metadata address in denom \"nft/" + sdk.AccAddress("not_a_metadata_addr_").String() + "\": " +
+					"invalid metadata address type: 110",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := types.NewResolvedMetadataDenom(tc.denom)
+			assert.Equal(t, tc.exp, actual, "NewResolvedMetadataDenom(%q)", tc.denom)
+		})
+	}
+}
+
+func TestFilterMetadataCoins(t *testing.T) {
+	scopeUUID := uuid.MustParse("4e5bf1d0-3bc6-4c6a-9f4d-6a2a1c8f0e04")
+	scopeID := metadatatypes.ScopeMetadataAddress(scopeUUID)
+	sessionID := metadatatypes.SessionMetadataAddress(scopeUUID, uuid.MustParse("5e5bf1d0-3bc6-4c6a-9f4d-6a2a1c8f0e05"))
+	recordID := metadatatypes.RecordMetadataAddress(scopeUUID, "somerecord")
+	coins := sdk.NewCoins(scopeID.Coin(), sessionID.Coin(), recordID.Coin(), sdk.NewInt64Coin("nhash", 5))
+
+	tests := []struct {
+		name      string
+		coins     sdk.Coins
+		addrTypes []string
+		exp       sdk.Coins
+	}{
+		{name: "nil coins", coins: nil, exp: nil},
+		{name: "no metadata coins", coins: sdk.NewCoins(sdk.NewInt64Coin("nhash", 5)), exp: nil},
+		{name: "all metadata coins", coins: coins, exp: sdk.NewCoins(scopeID.Coin(), sessionID.Coin(), recordID.Coin())},
+		{name: "only scopes", coins: coins, addrTypes: []string{metadatatypes.PrefixScope}, exp: sdk.NewCoins(scopeID.Coin())},
+		{
+			name:      "scopes and records",
+			coins:     coins,
+			addrTypes: []string{metadatatypes.PrefixScope, metadatatypes.PrefixRecord},
+			exp:       sdk.NewCoins(scopeID.Coin(), recordID.Coin()),
+		},
+		{name: "scope specs", coins: coins, addrTypes: []string{metadatatypes.PrefixScopeSpecification}, exp: nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act := types.FilterMetadataCoins(tc.coins, tc.addrTypes...)
+			assert.Equal(t, tc.exp.String(), act.String(), "FilterMetadataCoins")
+		})
+	}
+}
+
+func TestQueryTimeout(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	// Seed enough markers that iterating over all of them takes much longer than the tiny timeout.
+	admin := sdk.AccAddress("admin_______________")
+	markerCount := 1000
+	for i := 0; i < markerCount; i++ {
+		denom := fmt.Sprintf("timeoutcoin%04d", i)
+		marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+			*types.NewAccessGrant(admin, []types.Access{types.Access_Admin}),
+		})
+		app.MarkerKeeper.SetNewMarker(ctx, marker)
+	}
+	allReq := &types.QueryAllMarkersRequest{Pagination: &query.PageRequest{Limit: uint64(markerCount)}}
+	problemsReq := &types.QueryDenomMetadataProblemsRequest{Pagination: &query.PageRequest{Limit: uint64(markerCount)}}
+
+	assertDeadlineExceeded := func(t *testing.T, err error, name string) {
+		t.Helper()
+		if assert.Error(t, err, name) {
+			assert.Equal(t, codes.DeadlineExceeded.String(), status.Code(err).String(), "%s error code", name)
+			assert.ErrorContains(t, err, "marker query exceeded the configured query timeout", "%s error", name)
+		}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		k := app.MarkerKeeper
+		k.SetQueryTimeout(0)
+		resp, err := k.AllMarkers(ctx, allReq)
+		if assert.NoError(t, err, "AllMarkers") {
+			assert.Len(t, resp.Markers, markerCount, "AllMarkers markers")
+		}
+	})
+
+	t.Run("generous timeout", func(t *testing.T) {
+		k := app.MarkerKeeper
+		k.SetQueryTimeout(time.Minute)
+		resp, err := k.AllMarkers(ctx, allReq)
+		if assert.NoError(t, err, "AllMarkers") {
+			assert.Len(t, resp.Markers, markerCount, "AllMarkers markers")
+		}
+	})
+
+	t.Run("tiny timeout: large queries", func(t *testing.T) {
+		k := app.MarkerKeeper
+		k.SetQueryTimeout(time.Nanosecond)
+		_, err := k.AllMarkers(ctx, allReq)
+		assertDeadlineExceeded(t, err, "AllMarkers")
+		_, err = k.DenomMetadataProblems(ctx, problemsReq)
+		assertDeadlineExceeded(t, err, "DenomMetadataProblems")
+	})
+
+	t.Run("tiny timeout: small queries", func(t *testing.T) {
+		k := app.MarkerKeeper
+		k.SetQueryTimeout(time.Nanosecond)
+		denom := "timeoutcoin0000"
+		_, err := k.Marker(ctx, &types.QueryMarkerRequest{Id: denom})
+		assert.NoError(t, err, "Marker(%q)", denom)
+		_, err = k.Access(ctx, &types.QueryAccessRequest{Id: denom})
+		assert.NoError(t, err, "Access(%q)", denom)
+		_, err = k.Params(ctx, &types.QueryParamsRequest{})
+		assert.NoError(t, err, "Params")
+	})
+
+	t.Run("context already canceled", func(t *testing.T) {
+		k := app.MarkerKeeper
+		k.SetQueryTimeout(time.Minute)
+		goCtx, cancel := context.WithCancel(ctx.Context())
+		cancel()
+		_, err := k.AllMarkers(ctx.WithContext(goCtx), allReq)
+		if assert.Error(t, err, "AllMarkers") {
+			assert.Equal(t, codes.Canceled.String(), status.Code(err).String(), "AllMarkers error code")
+		}
+	})
+}
+
+func TestQueryAccountDataHistoryAvailable(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	resp, err := app.MarkerKeeper.AccountDataHistoryAvailable(ctx, &types.QueryAccountDataHistoryAvailableRequest{Denom: "nhash"})
+	require.NoError(t, err, "AccountDataHistoryAvailable(nhash)")
+	assert.False(t, resp.Available, "AccountDataHistoryAvailable(nhash) Available")
+
+	_, err = app.MarkerKeeper.AccountDataHistoryAvailable(ctx, &types.QueryAccountDataHistoryAvailableRequest{Denom: "x"})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid denom: x", "AccountDataHistoryAvailable(x)")
+
+	_, err = app.MarkerKeeper.AccountDataHistoryAvailable(ctx, nil)
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid request", "AccountDataHistoryAvailable(nil)")
+}
+
+func TestQueryHoldingFilters(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	dust := sdk.AccAddress("dust________________")
+	escrow := sdk.AccAddress("ibc_escrow__________")
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	denom := "holdcoin"
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+
+	marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+		*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Withdraw}),
+	})
+	marker.Supply = sdkmath.NewInt(1000)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, addr1, denom, coins(200)), "WithdrawCoins to addr1")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, addr2, coins(50)), "SendCoins addr1 -> addr2")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, dust, coins(5)), "SendCoins addr1 -> dust")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, escrow, coins(30)), "SendCoins addr1 -> escrow")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, govAddr, coins(10)), "SendCoins addr1 -> gov")
+
+	allResp, err := app.MarkerKeeper.Holding(ctx, &types.QueryHoldingRequest{Id: denom})
+	require.NoError(t, err, "Holding without filters")
+	require.Len(t, allResp.Balances, 6, "Holding without filters balances")
+	// holders gets the balances of the provided addresses in the same order as the unfiltered results.
+	holders := func(addrs ...sdk.AccAddress) []types.Balance {
+		var rv []types.Balance
+		for _, bal := range allResp.Balances {
+			for _, addr := range addrs {
+				if bal.Address == addr.String() {
+					rv = append(rv, bal)
+				}
+			}
+		}
+		return rv
+	}
+	require.Equal(t, allResp.Balances, holders(marker.GetAddress(), addr1, addr2, dust, escrow, govAddr), "all holders")
+
+	tests := []struct {
+		name     string
+		req      *types.QueryHoldingRequest
+		expBals  []types.Balance
+		expTotal uint64
+		expErr   string
+	}{
+		{
+			name:    "exclude module accounts",
+			req:     &types.QueryHoldingRequest{ExcludeModuleAccounts: true},
+			expBals: holders(addr1, addr2, dust, escrow),
+		},
+		{
+			name:    "excluded addresses",
+			req:     &types.QueryHoldingRequest{ExcludedAddresses: []string{escrow.String(), addr2.String()}},
+			expBals: holders(marker.GetAddress(), addr1, dust, govAddr),
+		},
+		{
+			name:    "min amount",
+			req:     &types.QueryHoldingRequest{MinAmount: "10"},
+			expBals: holders(marker.GetAddress(), addr1, addr2, escrow, govAddr),
+		},
+		{
+			name: "all filters",
+			req: &types.QueryHoldingRequest{
+				ExcludeModuleAccounts: true,
+				ExcludedAddresses:     []string{escrow.String()},
+				MinAmount:             "6",
+			},
+			expBals: holders(addr1, addr2),
+		},
+		{
+			name: "offset and count total",
+			req: &types.QueryHoldingRequest{
+				MinAmount:  "10",
+				Pagination: &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true},
+			},
+			expBals:  holders(marker.GetAddress(), addr1, addr2, escrow, govAddr)[1:3],
+			expTotal: 5,
+		},
+		{
+			name:   "invalid excluded address",
+			req:    &types.QueryHoldingRequest{ExcludedAddresses: []string{"bad"}},
+			expErr: `rpc error: code = InvalidArgument desc = invalid excluded address "bad": decoding bech32 failed: invalid bech32 string length 3`,
+		},
+		{
+			name:   "invalid min amount",
+			req:    &types.QueryHoldingRequest{MinAmount: "-1"},
+			expErr: `rpc error: code = InvalidArgument desc = invalid min amount "-1": must be a non-negative integer`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.req.Id = denom
+			resp, err := app.MarkerKeeper.Holding(ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "Holding error")
+				return
+			}
+			require.NoError(t, err, "Holding error")
+			assert.Equal(t, tc.expBals, resp.Balances, "Holding balances")
+			if assert.NotNil(t, resp.Pagination, "Holding pagination") {
+				assert.Equal(t, tc.expTotal, resp.Pagination.Total, "Holding pagination total")
+			}
+		})
+	}
+
+	t.Run("paged one at a time", func(t *testing.T) {
+		expBals := holders(marker.GetAddress(), addr1, addr2, escrow, govAddr)
+		var actBals []types.Balance
+		pageReq := &query.PageRequest{Limit: 1}
+		for i := 0; i < len(expBals); i++ {
+			resp, err := app.MarkerKeeper.Holding(ctx, &types.QueryHoldingRequest{Id: denom, MinAmount: "10", Pagination: pageReq})
+			require.NoError(t, err, "Holding page %d", i)
+			require.Len(t, resp.Balances, 1, "Holding page %d balances", i)
+			actBals = append(actBals, resp.Balances...)
+			pageReq = &query.PageRequest{Limit: 1, Key: resp.Pagination.NextKey}
+			if i < len(expBals)-1 {
+				require.NotEmpty(t, resp.Pagination.NextKey, "Holding page %d next key", i)
+			}
+		}
+		assert.Equal(t, expBals, actBals, "balances from all pages")
+	})
+}
+
+func TestQueryHolderCount(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	denom := "countcoin"
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+
+	marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+		*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Withdraw}),
+	})
+	marker.Supply = sdkmath.NewInt(1000)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, addr1, denom, coins(200)), "WithdrawCoins to addr1")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, addr2, coins(50)), "SendCoins addr1 -> addr2")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, addr3, coins(5)), "SendCoins addr1 -> addr3")
+
+	t.Run("holder count", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.HolderCount(ctx, &types.QueryHolderCountRequest{Id: denom})
+		require.NoError(t, err, "HolderCount")
+		assert.Equal(t, uint64(4), resp.HolderCount, "HolderCount holder count")
+		assert.Equal(t, sdk.NewInt64Coin(denom, 200), resp.HeldOutsideEscrow, "HolderCount held outside escrow")
+	})
+
+	t.Run("holder count by marker address", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.HolderCount(ctx, &types.QueryHolderCountRequest{Id: marker.GetAddress().String()})
+		require.NoError(t, err, "HolderCount")
+		assert.Equal(t, uint64(4), resp.HolderCount, "HolderCount holder count")
+	})
+
+	t.Run("holder count unknown marker", func(t *testing.T) {
+		_, err := app.MarkerKeeper.HolderCount(ctx, &types.QueryHolderCountRequest{Id: "nosuchcoin"})
+		assert.EqualError(t, err, "invalid denom or address: marker not found", "HolderCount error")
+	})
+
+	t.Run("holder count nil request", func(t *testing.T) {
+		_, err := app.MarkerKeeper.HolderCount(ctx, nil)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid request", "HolderCount error")
+	})
+
+	tests := []struct {
+		name     string
+		req      *types.QueryHoldingRequest
+		expLen   int
+		expTotal uint64
+	}{
+		{
+			name:     "count total with small limit",
+			req:      &types.QueryHoldingRequest{CountTotal: true, Pagination: &query.PageRequest{Limit: 1}},
+			expLen:   1,
+			expTotal: 4,
+		},
+		{
+			name:     "pagination count total with small limit",
+			req:      &types.QueryHoldingRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}},
+			expLen:   2,
+			expTotal: 4,
+		},
+		{
+			name:     "count total with filter",
+			req:      &types.QueryHoldingRequest{CountTotal: true, MinAmount: "10", Pagination: &query.PageRequest{Limit: 1}},
+			expLen:   1,
+			expTotal: 3,
+		},
+		{
+			name:     "count total without pagination",
+			req:      &types.QueryHoldingRequest{CountTotal: true},
+			expLen:   4,
+			expTotal: 4,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.req.Id = denom
+			resp, err := app.MarkerKeeper.Holding(ctx, tc.req)
+			require.NoError(t, err, "Holding error")
+			assert.Len(t, resp.Balances, tc.expLen, "Holding balances")
+			if assert.NotNil(t, resp.Pagination, "Holding pagination") {
+				assert.Equal(t, tc.expTotal, resp.Pagination.Total, "Holding pagination total")
+			}
+		})
+	}
+}
+
+func TestQueryHoldingAttributeFilter(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	denom := "attrcoin"
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+	setAttr := func(addr sdk.AccAddress) {
+		require.NoError(t, app.AttributeKeeper.SetAttribute(ctx,
+			attrtypes.Attribute{
+				Name:          "kyc.provenance.io",
+				Value:         []byte("string value"),
+				Address:       addr.String(),
+				AttributeType: attrtypes.AttributeType_String,
+			},
+			admin,
+		), "SetAttribute(%s)", addr)
+	}
+
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, admin))
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "kyc.provenance.io", admin, false), "SetNameRecord kyc.provenance.io")
+	marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+		*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Withdraw}),
+	})
+	marker.Supply = sdkmath.NewInt(1000)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, addr1, denom, coins(300)), "WithdrawCoins to addr1")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, addr2, coins(100)), "SendCoins addr1 -> addr2")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, addr3, coins(100)), "SendCoins addr1 -> addr3")
+	setAttr(addr1)
+	setAttr(addr3)
+
+	// holderAddrs returns the addresses of the provided balances.
+	holderAddrs := func(bals []types.Balance) []string {
+		var rv []string
+		for _, bal := range bals {
+			rv = append(rv, bal.Address)
+		}
+		return rv
+	}
+	addrStrs := func(addrs ...sdk.AccAddress) []string {
+		var rv []string
+		for _, addr := range addrs {
+			rv = append(rv, addr.String())
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name     string
+		req      *types.QueryHoldingRequest
+		expAddrs []string
+		expErr   string
+	}{
+		{
+			name:     "has attribute, unspecified mode",
+			req:      &types.QueryHoldingRequest{Attribute: "kyc.provenance.io"},
+			expAddrs: addrStrs(addr1, addr3),
+		},
+		{
+			name:     "has attribute",
+			req:      &types.QueryHoldingRequest{Attribute: "kyc.provenance.io", AttributeMode: types.AttributeFilterMode_Has},
+			expAddrs: addrStrs(addr1, addr3),
+		},
+		{
+			name:     "missing attribute",
+			req:      &types.QueryHoldingRequest{Attribute: "kyc.provenance.io", AttributeMode: types.AttributeFilterMode_Missing},
+			expAddrs: addrStrs(marker.GetAddress(), addr2),
+		},
+		{
+			name:     "has wildcard attribute",
+			req:      &types.QueryHoldingRequest{Attribute: "*.provenance.io", AttributeMode: types.AttributeFilterMode_Has},
+			expAddrs: addrStrs(addr1, addr3),
+		},
+		{
+			name:     "has other attribute",
+			req:      &types.QueryHoldingRequest{Attribute: "other.provenance.io", AttributeMode: types.AttributeFilterMode_Has},
+			expAddrs: nil,
+		},
+		{
+			name:     "missing other attribute",
+			req:      &types.QueryHoldingRequest{Attribute: "other.provenance.io", AttributeMode: types.AttributeFilterMode_Missing},
+			expAddrs: addrStrs(marker.GetAddress(), addr1, addr2, addr3),
+		},
+		{
+			name: "missing attribute and exclude module accounts",
+			req: &types.QueryHoldingRequest{
+				Attribute:             "kyc.provenance.io",
+				AttributeMode:         types.AttributeFilterMode_Missing,
+				ExcludeModuleAccounts: true,
+			},
+			expAddrs: addrStrs(addr2),
+		},
+		{
+			name:   "invalid attribute",
+			req:    &types.QueryHoldingRequest{Attribute: "kyc.*.io"},
+			expErr: `rpc error: code = InvalidArgument desc = invalid attribute "kyc.*.io": invalid required attribute "kyc.*.io": a wildcard is only allowed as a leading "*." segment`,
+		},
+		{
+			name:   "invalid attribute mode",
+			req:    &types.QueryHoldingRequest{Attribute: "kyc.provenance.io", AttributeMode: 5},
+			expErr: "rpc error: code = InvalidArgument desc = invalid attribute mode 5",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.req.Id = denom
+			resp, err := app.MarkerKeeper.Holding(ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "Holding error")
+				return
+			}
+			require.NoError(t, err, "Holding error")
+			assert.ElementsMatch(t, tc.expAddrs, holderAddrs(resp.Balances), "Holding balance addresses")
+		})
+	}
+
+	t.Run("page limit is capped", func(t *testing.T) {
+		for i := 0; i < 60; i++ {
+			addr := sdk.AccAddress(fmt.Sprintf("attr_holder_%08d", i))
+			require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, addr, coins(1)), "SendCoins addr1 -> %s", addr)
+			setAttr(addr)
+		}
+		req := &types.QueryHoldingRequest{
+			Id:         denom,
+			Attribute:  "kyc.provenance.io",
+			Pagination: &query.PageRequest{Limit: 100, CountTotal: true},
+		}
+		resp, err := app.MarkerKeeper.Holding(ctx, req)
+		require.NoError(t, err, "Holding error")
+		assert.Len(t, resp.Balances, 50, "Holding balances")
+		if assert.NotNil(t, resp.Pagination, "Holding pagination") {
+			assert.NotEmpty(t, resp.Pagination.NextKey, "Holding pagination next key")
+			assert.Equal(t, uint64(62), resp.Pagination.Total, "Holding pagination total")
+		}
+	})
+}
+
+func TestQueryAllMarkersCost(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	seeded := 0
+	// seed creates the provided number of new markers.
+	seed := func(count int) {
+		for i := 0; i < count; i++ {
+			seeded++
+			marker := types.NewEmptyMarkerAccount(fmt.Sprintf("costcoin%d", seeded), admin.String(), []types.AccessGrant{
+				*types.NewAccessGrant(admin, []types.Access{types.Access_Admin}),
+			})
+			app.MarkerKeeper.SetNewMarker(ctx, marker)
+		}
+	}
+	// getKeysVisited runs the query with costs and makes sure the cost is in the response.
+	getKeysVisited := func(t *testing.T, req *types.QueryAllMarkersRequest) uint64 {
+		t.Helper()
+		req.IncludeCost = true
+		resp, err := app.MarkerKeeper.AllMarkers(ctx, req)
+		require.NoError(t, err, "AllMarkers")
+		require.NotNil(t, resp.Cost, "AllMarkers cost")
+		assert.GreaterOrEqual(t, int(resp.Cost.KeysVisited), len(resp.Markers), "AllMarkers cost keys visited")
+		return resp.Cost.KeysVisited
+	}
+
+	allReq := func() *types.QueryAllMarkersRequest {
+		return &types.QueryAllMarkersRequest{Pagination: &query.PageRequest{Limit: 1000}}
+	}
+	base := getKeysVisited(t, allReq())
+
+	seed(5)
+	assert.Equal(t, int(base)+5, int(getKeysVisited(t, allReq())), "keys visited after adding 5 markers")
+	seed(20)
+	assert.Equal(t, int(base)+25, int(getKeysVisited(t, allReq())), "keys visited after adding 20 more markers")
+
+	// Filtered out markers are still visited.
+	filteredReq := allReq()
+	filteredReq.Status = types.StatusDestroyed
+	assert.Equal(t, int(base)+25, int(getKeysVisited(t, filteredReq)), "keys visited when filtering by status")
+
+	// A limited page doesn't visit the whole store.
+	pageReq := &types.QueryAllMarkersRequest{Pagination: &query.PageRequest{Limit: 3}}
+	assert.Less(t, int(getKeysVisited(t, pageReq)), int(base)+25, "keys visited with a limit of 3")
+
+	t.Run("without include cost", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.AllMarkers(ctx, allReq())
+		require.NoError(t, err, "AllMarkers")
+		assert.NotEmpty(t, resp.Markers, "AllMarkers markers")
+		assert.Nil(t, resp.Cost, "AllMarkers cost")
+	})
+}
+
+func TestQueryHoldingCost(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	denom := "costholdcoin"
+	marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+		*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Withdraw}),
+	})
+	marker.Supply = sdkmath.NewInt(1_000_000)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+
+	seeded := 0
+	// seed gives some of the denom to the provided number of new accounts.
+	seed := func(count int) {
+		for i := 0; i < count; i++ {
+			seeded++
+			addr := sdk.AccAddress(fmt.Sprintf("cost_holder_%08d", seeded))
+			require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, addr, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, int64(seeded)))), "WithdrawCoins to holder %d", seeded)
+		}
+	}
+	// getKeysVisited runs the query with costs and makes sure the cost is in the response.
+	getKeysVisited := func(t *testing.T, req *types.QueryHoldingRequest) uint64 {
+		t.Helper()
+		req.IncludeCost = true
+		resp, err := app.MarkerKeeper.Holding(ctx, req)
+		require.NoError(t, err, "Holding")
+		require.NotNil(t, resp.Cost, "Holding cost")
+		assert.GreaterOrEqual(t, int(resp.Cost.KeysVisited), len(resp.Balances), "Holding cost keys visited")
+		return resp.Cost.KeysVisited
+	}
+
+	// The marker's escrow holds the rest of the supply, so it's one of the holders.
+	seed(4)
+	assert.Equal(t, 5, int(getKeysVisited(t, &types.QueryHoldingRequest{Id: denom})), "keys visited with 4 holders")
+	seed(10)
+	assert.Equal(t, 15, int(getKeysVisited(t, &types.QueryHoldingRequest{Id: denom})), "keys visited with 14 holders")
+
+	// Filtered out holders are still visited.
+	filteredReq := &types.QueryHoldingRequest{Id: denom, MinAmount: "1000000"}
+	assert.Equal(t, 15, int(getKeysVisited(t, filteredReq)), "keys visited when filtering by min amount")
+
+	t.Run("without include cost", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.Holding(ctx, &types.QueryHoldingRequest{Id: denom})
+		require.NoError(t, err, "Holding")
+		assert.Len(t, resp.Balances, 15, "Holding balances")
+		assert.Nil(t, resp.Cost, "Holding cost")
+	})
+	t.Run("filtered without include cost", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.Holding(ctx, &types.QueryHoldingRequest{Id: denom, MinAmount: "10"})
+		require.NoError(t, err, "Holding")
+		assert.NotEmpty(t, resp.Balances, "Holding balances")
+		assert.Nil(t, resp.Cost, "Holding cost")
+	})
+}
+
+func TestQueryHoldingDiff(t *testing.T) {
+	app := simapp.Setup(t)
+
+	admin := sdk.AccAddress("admin_______________")
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	addr4 := sdk.AccAddress("addr4_______________")
+	denom := "diffcoin"
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewInt64Coin(denom, amount)
+	}
+	// commit writes the changes made in the provided context and commits them as a new block.
+	commit := func(ctx sdk.Context) int64 {
+		ctx.MultiStore().(storetypes.CacheMultiStore).Write()
+		_, err := app.Commit()
+		require.NoError(t, err, "Commit()")
+		return app.LastBlockHeight()
+	}
+
+	// Height A: addr1 has 100, addr2 has 50, and addr4 has 20.
+	ctx := app.BaseApp.NewContext(false)
+	marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+		*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Withdraw}),
+	})
+	// All of the supply is withdrawn, so the marker account doesn't hold any at either height.
+	marker.Supply = sdkmath.NewInt(170)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, addr1, denom, sdk.NewCoins(coin(100))), "WithdrawCoins to addr1")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, addr2, denom, sdk.NewCoins(coin(50))), "WithdrawCoins to addr2")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, addr4, denom, sdk.NewCoins(coin(20))), "WithdrawCoins to addr4")
+	heightA := commit(ctx)
+
+	// Height B: addr1 sends 30 to addr2 and 10 to addr3, and addr4 sends all of its funds to addr1.
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: heightA + 1})
+	require.NoError(t, err, "FinalizeBlock(%d)", heightA+1)
+	ctx = app.BaseApp.NewContext(false)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(coin(30))), "SendCoins addr1 -> addr2")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, addr3, sdk.NewCoins(coin(10))), "SendCoins addr1 -> addr3")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr4, addr1, sdk.NewCoins(coin(20))), "SendCoins addr4 -> addr1")
+	heightB := commit(ctx)
+
+	queryCtx := app.BaseApp.NewContext(true)
+	change := func(addr sdk.AccAddress, before, after int64) types.HoldingChange {
+		return types.HoldingChange{Address: addr.String(), Before: coin(before), After: coin(after)}
+	}
+
+	t.Run("forward", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.HoldingDiff(queryCtx, &types.QueryHoldingDiffRequest{Id: denom, HeightA: heightA, HeightB: heightB})
+		require.NoError(t, err, "HoldingDiff(%d, %d)", heightA, heightB)
+		assert.Equal(t, []types.HoldingChange{change(addr3, 0, 10)}, resp.Added, "Added")
+		assert.Equal(t, []types.HoldingChange{change(addr4, 20, 0)}, resp.Removed, "Removed")
+		assert.Equal(t, []types.HoldingChange{change(addr1, 100, 80), change(addr2, 50, 80)}, resp.Changed, "Changed")
+		if assert.NotNil(t, resp.Pagination, "Pagination") {
+			assert.Equal(t, 4, int(resp.Pagination.Total), "Pagination.Total")
+			assert.Empty(t, resp.Pagination.NextKey, "Pagination.NextKey")
+		}
+	})
+
+	t.Run("backward by address", func(t *testing.T) {
+		id := marker.GetAddress().String()
+		resp, err := app.MarkerKeeper.HoldingDiff(queryCtx, &types.QueryHoldingDiffRequest{Id: id, HeightA: heightB, HeightB: heightA})
+		require.NoError(t, err, "HoldingDiff(%d, %d)", heightB, heightA)
+		assert.Equal(t, []types.HoldingChange{change(addr4, 0, 20)}, resp.Added, "Added")
+		assert.Equal(t, []types.HoldingChange{change(addr3, 10, 0)}, resp.Removed, "Removed")
+		assert.Equal(t, []types.HoldingChange{change(addr1, 80, 100), change(addr2, 80, 50)}, resp.Changed, "Changed")
+	})
+
+	t.Run("same height", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.HoldingDiff(queryCtx, &types.QueryHoldingDiffRequest{Id: denom, HeightA: heightB, HeightB: heightB})
+		require.NoError(t, err, "HoldingDiff(%d, %d)", heightB, heightB)
+		assert.Empty(t, resp.Added, "Added")
+		assert.Empty(t, resp.Removed, "Removed")
+		assert.Empty(t, resp.Changed, "Changed")
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		var added, removed, changed []types.HoldingChange
+		pageReq := &query.PageRequest{Limit: 3}
+		for pages := 1; ; pages++ {
+			require.LessOrEqual(t, pages, 2, "number of pages")
+			resp, err := app.MarkerKeeper.HoldingDiff(queryCtx, &types.QueryHoldingDiffRequest{Id: denom, HeightA: heightA, HeightB: heightB, Pagination: pageReq})
+			require.NoError(t, err, "HoldingDiff page %d", pages)
+			added = append(added, resp.Added...)
+			removed = append(removed, resp.Removed...)
+			changed = append(changed, resp.Changed...)
+			if len(resp.Pagination.NextKey) == 0 {
+				break
+			}
+			assert.Equal(t, addr4, sdk.AccAddress(resp.Pagination.NextKey), "NextKey of page %d", pages)
+			pageReq = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 3}
+		}
+		assert.Equal(t, []types.HoldingChange{change(addr3, 0, 10)}, added, "Added")
+		assert.Equal(t, []types.HoldingChange{change(addr4, 20, 0)}, removed, "Removed")
+		assert.Equal(t, []types.HoldingChange{change(addr1, 100, 80), change(addr2, 50, 80)}, changed, "Changed")
+	})
+
+	t.Run("one entry per page, small holder pages", func(t *testing.T) {
+		// With a holder page size of 1, the holders at each height are read one at a time, and each
+		// request has to start reading from its page key. The results should be the same either way.
+		defer markerkeeper.SetHolderStreamPageSize(1)()
+		for _, reverse := range []bool{false, true} {
+			var added, removed, changed []types.HoldingChange
+			var total uint64
+			pageReq := &query.PageRequest{Limit: 1, CountTotal: true, Reverse: reverse}
+			for pages := 1; ; pages++ {
+				require.LessOrEqual(t, pages, 4, "number of pages (reverse = %t)", reverse)
+				resp, err := app.MarkerKeeper.HoldingDiff(queryCtx, &types.QueryHoldingDiffRequest{Id: denom, HeightA: heightA, HeightB: heightB, Pagination: pageReq})
+				require.NoError(t, err, "HoldingDiff page %d (reverse = %t)", pages, reverse)
+				assert.Equal(t, 1, len(resp.Added)+len(resp.Removed)+len(resp.Changed), "number of entries on page %d (reverse = %t)", pages, reverse)
+				added = append(added, resp.Added...)
+				removed = append(removed, resp.Removed...)
+				changed = append(changed, resp.Changed...)
+				if pages == 1 {
+					total = resp.Pagination.Total
+				}
+				if len(resp.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1, Reverse: reverse}
+			}
+			expChanged := []types.HoldingChange{change(addr1, 100, 80), change(addr2, 50, 80)}
+			if reverse {
+				expChanged = []types.HoldingChange{expChanged[1], expChanged[0]}
+			}
+			assert.Equal(t, 4, int(total), "Pagination.Total (reverse = %t)", reverse)
+			assert.Equal(t, []types.HoldingChange{change(addr3, 0, 10)}, added, "Added (reverse = %t)", reverse)
+			assert.Equal(t, []types.HoldingChange{change(addr4, 20, 0)}, removed, "Removed (reverse = %t)", reverse)
+			assert.Equal(t, expChanged, changed, "Changed (reverse = %t)", reverse)
+		}
+	})
+
+	t.Run("offset", func(t *testing.T) {
+		defer markerkeeper.SetHolderStreamPageSize(2)()
+		pageReq := &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true}
+		resp, err := app.MarkerKeeper.HoldingDiff(queryCtx, &types.QueryHoldingDiffRequest{Id: denom, HeightA: heightA, HeightB: heightB, Pagination: pageReq})
+		require.NoError(t, err, "HoldingDiff")
+		assert.Equal(t, []types.HoldingChange{change(addr3, 0, 10)}, resp.Added, "Added")
+		assert.Empty(t, resp.Removed, "Removed")
+		assert.Equal(t, []types.HoldingChange{change(addr2, 50, 80)}, resp.Changed, "Changed")
+		assert.Equal(t, addr4, sdk.AccAddress(resp.Pagination.NextKey), "NextKey")
+		assert.Equal(t, 4, int(resp.Pagination.Total), "Pagination.Total")
+	})
+
+	t.Run("pruned height", func(t *testing.T) {
+		k := app.MarkerKeeper
+		k.SetHistoricalContextFn(func(height int64) (sdk.Context, error) {
+			if height == heightA {
+				return sdk.Context{}, fmt.Errorf("failed to load state at height %d", height)
+			}
+			return app.BaseApp.CreateQueryContext(height, false)
+		})
+		_, err := k.HoldingDiff(queryCtx, &types.QueryHoldingDiffRequest{Id: denom, HeightA: heightA, HeightB: heightB})
+		if assert.Error(t, err, "HoldingDiff(%d, %d)", heightA, heightB) {
+			assert.Equal(t, codes.FailedPrecondition.String(), status.Code(err).String(), "HoldingDiff error code")
+			assert.ErrorContains(t, err, fmt.Sprintf("state at height %d is not available", heightA), "HoldingDiff error")
+		}
+	})
+
+	t.Run("future height", func(t *testing.T) {
+		_, err := app.MarkerKeeper.HoldingDiff(queryCtx, &types.QueryHoldingDiffRequest{Id: denom, HeightA: heightA, HeightB: heightB + 10})
+		if assert.Error(t, err, "HoldingDiff(%d, %d)", heightA, heightB+10) {
+			assert.Equal(t, codes.InvalidArgument.String(), status.Code(err).String(), "HoldingDiff error code")
+			assert.ErrorContains(t, err, fmt.Sprintf("invalid height %d", heightB+10), "HoldingDiff error")
+		}
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err := app.MarkerKeeper.HoldingDiff(queryCtx, nil)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid request", "HoldingDiff(nil)")
+		_, err = app.MarkerKeeper.HoldingDiff(queryCtx, &types.QueryHoldingDiffRequest{Id: denom, HeightA: 0, HeightB: heightB})
+		assert.EqualError(t, err, fmt.Sprintf("rpc error: code = InvalidArgument desc = heights must be positive, got height_a=0 and height_b=%d", heightB), "HoldingDiff zero height_a")
+		_, err = app.MarkerKeeper.HoldingDiff(queryCtx, &types.QueryHoldingDiffRequest{Id: "unknowncoin", HeightA: heightA, HeightB: heightB})
+		assert.EqualError(t, err, "invalid denom or address: marker not found", "HoldingDiff unknown marker")
+	})
+}
+
+func TestQueryAccessGrantsByAddress(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	user := sdk.AccAddress("user________________")
+	other := sdk.AccAddress("other_______________")
+	newMarker := func(denom string, userAccess ...types.Access) *types.MarkerAccount {
+		grants := []types.AccessGrant{*types.NewAccessGrant(admin, []types.Access{types.Access_Admin})}
+		if len(userAccess) > 0 {
+			grants = append(grants, *types.NewAccessGrant(user, userAccess))
+		}
+		marker := types.NewEmptyMarkerAccount(denom, admin.String(), grants)
+		app.MarkerKeeper.SetNewMarker(ctx, marker)
+		return marker
+	}
+	mintCoin := newMarker("grantmintcoin", types.Access_Mint, types.Access_Burn)
+	withdrawCoin := newMarker("grantwithdrawcoin", types.Access_Withdraw)
+	bothCoin := newMarker("grantbothcoin", types.Access_Mint, types.Access_Withdraw)
+	newMarker("grantnonecoin")
+
+	expGrant := func(marker *types.MarkerAccount) types.MarkerAccessGrant {
+		return types.MarkerAccessGrant{
+			Denom:         marker.GetDenom(),
+			MarkerAddress: marker.GetAddress().String(),
+			Permissions:   types.GrantsForAddress(user, marker.GetAccessList()...).Permissions,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		req    *types.QueryAccessGrantsByAddressRequest
+		exp    []types.MarkerAccessGrant
+		expErr string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			expErr: "rpc error: code = InvalidArgument desc = invalid request",
+		},
+		{
+			name:   "invalid address",
+			req:    &types.QueryAccessGrantsByAddressRequest{Address: "notanaddress"},
+			expErr: `rpc error: code = InvalidArgument desc = invalid address "notanaddress": decoding bech32 failed: invalid separator index -1`,
+		},
+		{
+			name:   "unknown permission",
+			req:    &types.QueryAccessGrantsByAddressRequest{Address: user.String(), Permission: 99},
+			expErr: "rpc error: code = InvalidArgument desc = unknown permission 99",
+		},
+		{
+			name: "no grants",
+			req:  &types.QueryAccessGrantsByAddressRequest{Address: other.String()},
+			exp:  []types.MarkerAccessGrant{},
+		},
+		{
+			name: "all permissions",
+			req:  &types.QueryAccessGrantsByAddressRequest{Address: user.String()},
+			exp:  []types.MarkerAccessGrant{expGrant(mintCoin), expGrant(withdrawCoin), expGrant(bothCoin)},
+		},
+		{
+			name: "only mint",
+			req:  &types.QueryAccessGrantsByAddressRequest{Address: user.String(), Permission: types.Access_Mint},
+			exp:  []types.MarkerAccessGrant{expGrant(mintCoin), expGrant(bothCoin)},
+		},
+		{
+			name: "only withdraw",
+			req:  &types.QueryAccessGrantsByAddressRequest{Address: user.String(), Permission: types.Access_Withdraw},
+			exp:  []types.MarkerAccessGrant{expGrant(withdrawCoin), expGrant(bothCoin)},
+		},
+		{
+			name: "only transfer",
+			req:  &types.QueryAccessGrantsByAddressRequest{Address: user.String(), Permission: types.Access_Transfer},
+			exp:  []types.MarkerAccessGrant{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := app.MarkerKeeper.AccessGrantsByAddress(ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "AccessGrantsByAddress error")
+				return
+			}
+			require.NoError(t, err, "AccessGrantsByAddress error")
+			assert.ElementsMatch(t, tc.exp, resp.Grants, "AccessGrantsByAddress grants")
+		})
+	}
+
+	t.Run("paginated", func(t *testing.T) {
+		req := &types.QueryAccessGrantsByAddressRequest{
+			Address:    user.String(),
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		}
+		resp, err := app.MarkerKeeper.AccessGrantsByAddress(ctx, req)
+		require.NoError(t, err, "AccessGrantsByAddress page 1")
+		require.NotNil(t, resp.Pagination, "page 1 pagination")
+		assert.Len(t, resp.Grants, 2, "page 1 grants")
+		assert.Equal(t, 3, int(resp.Pagination.Total), "page 1 total")
+		require.NotEmpty(t, resp.Pagination.NextKey, "page 1 next key")
+		grants := resp.Grants
+
+		req.Pagination = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2}
+		resp, err = app.MarkerKeeper.AccessGrantsByAddress(ctx, req)
+		require.NoError(t, err, "AccessGrantsByAddress page 2")
+		assert.Len(t, resp.Grants, 1, "page 2 grants")
+		grants = append(grants, resp.Grants...)
+
+		exp := []types.MarkerAccessGrant{expGrant(mintCoin), expGrant(withdrawCoin), expGrant(bothCoin)}
+		assert.ElementsMatch(t, exp, grants, "grants from both pages")
+	})
+}
+
+func TestQueryMarkersByRequiredAttribute(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	newMarker := func(denom string, markerType types.MarkerType, reqAttrs ...string) *types.MarkerAccount {
+		marker := types.NewMarkerAccount(
+			authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+			sdk.NewInt64Coin(denom, 100), admin,
+			[]types.AccessGrant{*types.NewAccessGrant(admin, []types.Access{types.Access_Admin})},
+			types.StatusActive, markerType, true, false, false, reqAttrs,
+		)
+		app.MarkerKeeper.SetNewMarker(ctx, marker)
+		return marker
+	}
+	kycCoin := newMarker("rakyccoin", types.MarkerType_RestrictedCoin, "kyc.provenance.io")
+	wildCoin := newMarker("rawildcoin", types.MarkerType_RestrictedCoin, "other.attr", "*.provenance.io")
+	deepCoin := newMarker("radeepcoin", types.MarkerType_RestrictedCoin, "*.kyc.provenance.io")
+	newMarker("ranonecoin", types.MarkerType_RestrictedCoin)
+	newMarker("racoincoin", types.MarkerType_Coin, "kyc.provenance.io")
+
+	expMatch := func(marker *types.MarkerAccount, reqAttr string) types.MarkerRequiredAttributeMatch {
+		return types.MarkerRequiredAttributeMatch{
+			Denom:             marker.GetDenom(),
+			MarkerAddress:     marker.GetAddress().String(),
+			RequiredAttribute: reqAttr,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		req    *types.QueryMarkersByRequiredAttributeRequest
+		exp    []types.MarkerRequiredAttributeMatch
+		expErr string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			expErr: "rpc error: code = InvalidArgument desc = invalid request",
+		},
+		{
+			name:   "empty attribute",
+			req:    &types.QueryMarkersByRequiredAttributeRequest{Attribute: "  "},
+			expErr: "rpc error: code = InvalidArgument desc = attribute name cannot be empty",
+		},
+		{
+			name:   "wildcard attribute",
+			req:    &types.QueryMarkersByRequiredAttributeRequest{Attribute: "*.provenance.io"},
+			expErr: `rpc error: code = InvalidArgument desc = invalid attribute name "*.provenance.io": wildcards are not allowed`,
+		},
+		{
+			name: "no matches",
+			req:  &types.QueryMarkersByRequiredAttributeRequest{Attribute: "kyc.example"},
+			exp:  []types.MarkerRequiredAttributeMatch{},
+		},
+		{
+			name: "exact and wildcard matches",
+			req:  &types.QueryMarkersByRequiredAttributeRequest{Attribute: "kyc.provenance.io"},
+			exp:  []types.MarkerRequiredAttributeMatch{expMatch(kycCoin, "kyc.provenance.io"), expMatch(wildCoin, "*.provenance.io")},
+		},
+		{
+			name: "not normalized",
+			req:  &types.QueryMarkersByRequiredAttributeRequest{Attribute: " KYC . Provenance.io "},
+			exp:  []types.MarkerRequiredAttributeMatch{expMatch(kycCoin, "kyc.provenance.io"), expMatch(wildCoin, "*.provenance.io")},
+		},
+		{
+			name: "only wildcard matches",
+			req:  &types.QueryMarkersByRequiredAttributeRequest{Attribute: "us.kyc.provenance.io"},
+			exp:  []types.MarkerRequiredAttributeMatch{expMatch(wildCoin, "*.provenance.io"), expMatch(deepCoin, "*.kyc.provenance.io")},
+		},
+		{
+			name: "first of several required attributes",
+			req:  &types.QueryMarkersByRequiredAttributeRequest{Attribute: "other.attr"},
+			exp:  []types.MarkerRequiredAttributeMatch{expMatch(wildCoin, "other.attr")},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := app.MarkerKeeper.MarkersByRequiredAttribute(ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "MarkersByRequiredAttribute error")
+				return
+			}
+			require.NoError(t, err, "MarkersByRequiredAttribute error")
+			assert.ElementsMatch(t, tc.exp, resp.Markers, "MarkersByRequiredAttribute markers")
+		})
+	}
+
+	t.Run("paginated", func(t *testing.T) {
+		req := &types.QueryMarkersByRequiredAttributeRequest{
+			Attribute:  "us.kyc.provenance.io",
+			Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+		}
+		resp, err := app.MarkerKeeper.MarkersByRequiredAttribute(ctx, req)
+		require.NoError(t, err, "MarkersByRequiredAttribute page 1")
+		require.NotNil(t, resp.Pagination, "page 1 pagination")
+		assert.Len(t, resp.Markers, 1, "page 1 markers")
+		assert.Equal(t, 2, int(resp.Pagination.Total), "page 1 total")
+		require.NotEmpty(t, resp.Pagination.NextKey, "page 1 next key")
+		markers := resp.Markers
+
+		req.Pagination = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1}
+		resp, err = app.MarkerKeeper.MarkersByRequiredAttribute(ctx, req)
+		require.NoError(t, err, "MarkersByRequiredAttribute page 2")
+		assert.Len(t, resp.Markers, 1, "page 2 markers")
+		markers = append(markers, resp.Markers...)
+
+		exp := []types.MarkerRequiredAttributeMatch{expMatch(wildCoin, "*.provenance.io"), expMatch(deepCoin, "*.kyc.provenance.io")}
+		assert.ElementsMatch(t, exp, markers, "markers from both pages")
+	})
+}
+
+func TestQueryNetAssetValues(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockHeight(5)
+
+	admin := sdk.AccAddress("admin_______________")
+	marker := types.NewEmptyMarkerAccount("navcoin", admin.String(), []types.AccessGrant{
+		*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint}),
+	})
+	marker.Supply = sdkmath.NewInt(100)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	// Added out of order to make sure they come back ordered by price denom.
+	for _, price := range []string{"9usd", "7eur", "3nhash", "5cad"} {
+		coin, err := sdk.ParseCoinNormalized(price)
+		require.NoError(t, err, "ParseCoinNormalized(%q)", price)
+		require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, marker, types.NewNetAssetValue(coin, 1), "test"), "SetNetAssetValue(%s)", price)
+	}
+
+	prices := func(navs []types.NetAssetValue) []string {
+		rv := make([]string, len(navs))
+		for i, nav := range navs {
+			rv[i] = nav.Price.String()
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name      string
+		req       *types.QueryNetAssetValuesRequest
+		expPrices []string
+		expErr    string
+	}{
+		{
+			name:      "all",
+			req:       &types.QueryNetAssetValuesRequest{Id: "navcoin"},
+			expPrices: []string{"5cad", "7eur", "3nhash", "9usd"},
+		},
+		{
+			name:      "one price denom",
+			req:       &types.QueryNetAssetValuesRequest{Id: "navcoin", PriceDenoms: []string{"usd"}},
+			expPrices: []string{"9usd"},
+		},
+		{
+			name:      "several price denoms",
+			req:       &types.QueryNetAssetValuesRequest{Id: "navcoin", PriceDenoms: []string{"usd", "cad", "jpy"}},
+			expPrices: []string{"5cad", "9usd"},
+		},
+		{
+			name:      "no matching price denoms",
+			req:       &types.QueryNetAssetValuesRequest{Id: "navcoin", PriceDenoms: []string{"jpy"}},
+			expPrices: []string{},
+		},
+		{
+			name:   "invalid price denom",
+			req:    &types.QueryNetAssetValuesRequest{Id: "navcoin", PriceDenoms: []string{"x"}},
+			expErr: "rpc error: code = InvalidArgument desc = invalid price denom: invalid denom: x",
+		},
+		{
+			name:   "nil request",
+			expErr: "rpc error: code = InvalidArgument desc = invalid request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := app.MarkerKeeper.NetAssetValues(ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "NetAssetValues error")
+				return
+			}
+			require.NoError(t, err, "NetAssetValues error")
+			assert.Equal(t, tc.expPrices, prices(resp.NetAssetValues), "NetAssetValues prices")
+			for i, nav := range resp.NetAssetValues {
+				assert.Equal(t, uint64(5), nav.UpdatedBlockHeight, "NetAssetValues[%d].UpdatedBlockHeight", i)
+			}
+		})
+	}
+
+	t.Run("paginated", func(t *testing.T) {
+		req := &types.QueryNetAssetValuesRequest{
+			Id:          "navcoin",
+			PriceDenoms: []string{"cad", "nhash", "usd"},
+			Pagination:  &query.PageRequest{Limit: 2, CountTotal: true},
+		}
+		resp, err := app.MarkerKeeper.NetAssetValues(ctx, req)
+		require.NoError(t, err, "NetAssetValues page 1")
+		require.NotNil(t, resp.Pagination, "page 1 pagination")
+		assert.Equal(t, []string{"5cad", "3nhash"}, prices(resp.NetAssetValues), "page 1 prices")
+		assert.Equal(t, 3, int(resp.Pagination.Total), "page 1 total")
+		require.NotEmpty(t, resp.Pagination.NextKey, "page 1 next key")
+
+		req.Pagination = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2}
+		resp, err = app.MarkerKeeper.NetAssetValues(ctx, req)
+		require.NoError(t, err, "NetAssetValues page 2")
+		assert.Equal(t, []string{"9usd"}, prices(resp.NetAssetValues), "page 2 prices")
+		assert.Empty(t, resp.Pagination.NextKey, "page 2 next key")
+	})
+}
+
+func TestQueryAccountDataByAddresses(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	newMarker := func(denom string) *types.MarkerAccount {
+		marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+			*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint}),
+		})
+		marker.Supply = sdkmath.NewInt(100)
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker(%q)", denom)
+		return marker
+	}
+	dataCoin := newMarker("datacoin")
+	emptyCoin := newMarker("emptycoin")
+	require.NoError(t, app.AttributeKeeper.SetAccountData(ctx, dataCoin.GetAddress().String(), "some data"), "SetAccountData")
+	plainAddr := sdk.AccAddress("plain_______________")
+
+	tooMany := make([]string, types.MaxAccountDataAddresses+1)
+	for i := range tooMany {
+		tooMany[i] = dataCoin.GetAddress().String()
+	}
+
+	tests := []struct {
+		name       string
+		req        *types.QueryAccountDataByAddressesRequest
+		expEntries []types.AccountDataEntry
+		expErr     string
+	}{
+		{
+			name: "markers, non-marker, and malformed addresses",
+			req: &types.QueryAccountDataByAddressesRequest{Addresses: []string{
+				dataCoin.GetAddress().String(), plainAddr.String(), "bad", emptyCoin.GetAddress().String(),
+			}},
+			expEntries: []types.AccountDataEntry{
+				{Address: dataCoin.GetAddress().String(), Denom: "datacoin", Value: "some data"},
+				{Address: plainAddr.String(), Error: "not a marker account"},
+				{Address: "bad", Error: "invalid address: decoding bech32 failed: invalid bech32 string length 3"},
+				{Address: emptyCoin.GetAddress().String(), Denom: "emptycoin"},
+			},
+		},
+		{
+			name:       "no addresses",
+			req:        &types.QueryAccountDataByAddressesRequest{},
+			expEntries: []types.AccountDataEntry{},
+		},
+		{
+			name:   "too many addresses",
+			req:    &types.QueryAccountDataByAddressesRequest{Addresses: tooMany},
+			expErr: "rpc error: code = InvalidArgument desc = too many addresses 101: cannot have more than 100",
+		},
+		{
+			name:   "nil request",
+			expErr: "rpc error: code = InvalidArgument desc = invalid request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := app.MarkerKeeper.AccountDataByAddresses(ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "AccountDataByAddresses error")
+				return
+			}
+			require.NoError(t, err, "AccountDataByAddresses error")
+			assert.Equal(t, tc.expEntries, resp.Entries, "AccountDataByAddresses entries")
+		})
+	}
+}
+
+func TestQueryMarkersByDenom(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	for _, denom := range []string{"apples", "bananas"} {
+		marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+			*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint}),
+		})
+		marker.Supply = sdkmath.NewInt(100)
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker(%q)", denom)
+	}
+
+	tooMany := make([]string, types.MaxMarkersByDenom+1)
+	for i := range tooMany {
+		tooMany[i] = "apples"
+	}
+
+	tests := []struct {
+		name        string
+		req         *types.QueryMarkersByDenomRequest
+		expDenoms   []string
+		expNotFound []string
+		expErr      string
+	}{
+		{
+			name:        "found and not found in input order",
+			req:         &types.QueryMarkersByDenomRequest{Denoms: []string{"bananas", "cherries", "apples", "!bad"}},
+			expDenoms:   []string{"bananas", "apples"},
+			expNotFound: []string{"cherries", "!bad"},
+		},
+		{
+			name:      "repeated denom",
+			req:       &types.QueryMarkersByDenomRequest{Denoms: []string{"apples", "apples"}},
+			expDenoms: []string{"apples", "apples"},
+		},
+		{
+			name:      "no denoms",
+			req:       &types.QueryMarkersByDenomRequest{},
+			expDenoms: []string{},
+		},
+		{
+			name:      "max denoms",
+			req:       &types.QueryMarkersByDenomRequest{Denoms: tooMany[1:]},
+			expDenoms: tooMany[1:],
+		},
+		{
+			name:   "too many denoms",
+			req:    &types.QueryMarkersByDenomRequest{Denoms: tooMany},
+			expErr: "rpc error: code = InvalidArgument desc = too many denoms 101: cannot have more than 100",
+		},
+		{
+			name:   "nil request",
+			expErr: "rpc error: code = InvalidArgument desc = invalid request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := app.MarkerKeeper.MarkersByDenom(ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "MarkersByDenom error")
+				return
+			}
+			require.NoError(t, err, "MarkersByDenom error")
+
+			denoms := make([]string, len(resp.Markers))
+			for i, entry := range resp.Markers {
+				denoms[i] = entry.Denom
+				var marker types.MarkerAccountI
+				if assert.NoError(t, app.InterfaceRegistry().UnpackAny(entry.Marker, &marker), "[%d]: UnpackAny", i) {
+					assert.Equal(t, entry.Denom, marker.GetDenom(), "[%d]: marker denom", i)
+				}
+			}
+			assert.Equal(t, tc.expDenoms, denoms, "MarkersByDenom markers denoms")
+			assert.Equal(t, tc.expNotFound, resp.NotFound, "MarkersByDenom not found")
+		})
+	}
+}
+
+func TestQueryCanSetNetAssetValue(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	newMarker := func(denom string) *types.MarkerAccount {
+		marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+			*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint}),
+		})
+		marker.Supply = sdkmath.NewInt(100)
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker(%q)", denom)
+		nav := types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 100), 1)
+		require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, marker, nav, "test"), "SetNetAssetValue(%q)", denom)
+		return marker
+	}
+	bounded := newMarker("boundedcoin")
+	newMarker("unboundedcoin")
+	require.NoError(t, app.MarkerKeeper.SetNetAssetValueBounds(ctx, bounded.GetAddress(), 2500), "SetNetAssetValueBounds")
+
+	usd := func(amount int64) types.NetAssetValue {
+		return types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, amount), 1)
+	}
+
+	tests := []struct {
+		name    string
+		req     *types.QueryCanSetNetAssetValueRequest
+		expResp *types.QueryCanSetNetAssetValueResponse
+		expErr  string
+	}{
+		{
+			name:    "in bounds",
+			req:     &types.QueryCanSetNetAssetValueRequest{Id: "boundedcoin", NetAssetValue: usd(110)},
+			expResp: &types.QueryCanSetNetAssetValueResponse{Allowed: true, PercentChange: "10.00", MaxChangeBasisPoints: 2500},
+		},
+		{
+			name: "out of bounds",
+			req:  &types.QueryCanSetNetAssetValueRequest{Id: bounded.GetAddress().String(), NetAssetValue: usd(100_000_000)},
+			expResp: &types.QueryCanSetNetAssetValueResponse{
+				PercentChange:        "99999900.00",
+				MaxChangeBasisPoints: 2500,
+				Reason:               "net asset value change of 99999900.00% exceeds the max change of 25.00%",
+			},
+		},
+		{
+			name:    "no current value",
+			req:     &types.QueryCanSetNetAssetValueRequest{Id: "boundedcoin", NetAssetValue: types.NewNetAssetValue(sdk.NewInt64Coin("nhash", 5), 1)},
+			expResp: &types.QueryCanSetNetAssetValueResponse{Allowed: true, MaxChangeBasisPoints: 2500},
+		},
+		{
+			name:    "no bounds",
+			req:     &types.QueryCanSetNetAssetValueRequest{Id: "unboundedcoin", NetAssetValue: usd(100_000_000)},
+			expResp: &types.QueryCanSetNetAssetValueResponse{Allowed: true, PercentChange: "99999900.00"},
+		},
+		{
+			name:   "invalid net asset value",
+			req:    &types.QueryCanSetNetAssetValueRequest{Id: "boundedcoin", NetAssetValue: types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 5), 0)},
+			expErr: "rpc error: code = InvalidArgument desc = invalid net asset value: marker net asset value volume must be positive value",
+		},
+		{
+			name:   "nil request",
+			expErr: "rpc error: code = InvalidArgument desc = invalid request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := app.MarkerKeeper.CanSetNetAssetValue(ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "CanSetNetAssetValue error")
+				return
+			}
+			require.NoError(t, err, "CanSetNetAssetValue error")
+			assert.Equal(t, tc.expResp, resp, "CanSetNetAssetValue response")
+		})
+	}
+}
+
+func TestQueryLastAdminCheck(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	other := sdk.AccAddress("other_______________")
+	newMarker := func(denom string, govControl bool) *types.MarkerAccount {
+		marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+			*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint}),
+			*types.NewAccessGrant(other, []types.Access{types.Access_Mint}),
+		})
+		marker.AllowGovernanceControl = govControl
+		marker.Supply = sdkmath.NewInt(100)
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker(%q)", denom)
+		return marker
+	}
+	lonely := newMarker("lonelyadmincoin", false)
+	newMarker("govadmincoin", true)
+
+	tests := []struct {
+		name    string
+		req     *types.QueryLastAdminCheckRequest
+		expResp *types.QueryLastAdminCheckResponse
+		expErr  string
+	}{
+		{
+			name: "last admin",
+			req:  &types.QueryLastAdminCheckRequest{Id: "lonelyadmincoin", Address: admin.String()},
+			expResp: &types.QueryLastAdminCheckResponse{
+				IsLastAdmin: true,
+				Reason:      "cannot remove the last ACCESS_ADMIN access grant from active marker lonelyadmincoin that is not controlled by governance",
+			},
+		},
+		{
+			name:    "not an admin",
+			req:     &types.QueryLastAdminCheckRequest{Id: lonely.GetAddress().String(), Address: other.String()},
+			expResp: &types.QueryLastAdminCheckResponse{},
+		},
+		{
+			name:    "governance controlled",
+			req:     &types.QueryLastAdminCheckRequest{Id: "govadmincoin", Address: admin.String()},
+			expResp: &types.QueryLastAdminCheckResponse{},
+		},
+		{
+			name:   "invalid address",
+			req:    &types.QueryLastAdminCheckRequest{Id: "lonelyadmincoin", Address: "notanaddress"},
+			expErr: "rpc error: code = InvalidArgument desc = invalid address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "unknown marker",
+			req:    &types.QueryLastAdminCheckRequest{Id: "nosuchcoin", Address: admin.String()},
+			expErr: "invalid denom or address: marker not found",
+		},
+		{
+			name:   "nil request",
+			expErr: "rpc error: code = InvalidArgument desc = invalid request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := app.MarkerKeeper.LastAdminCheck(ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "LastAdminCheck error")
+				return
+			}
+			require.NoError(t, err, "LastAdminCheck error")
+			assert.Equal(t, tc.expResp, resp, "LastAdminCheck response")
+		})
+	}
+}
+
+func TestQueryMarkerTransferRules(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	other := sdk.AccAddress("other_______________")
+	restricted := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress("rulescoin")),
+		sdk.NewInt64Coin("rulescoin", 100), admin,
+		[]types.AccessGrant{*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Transfer})},
+		types.StatusProposed, types.MarkerType_RestrictedCoin, true, false, true,
+		[]string{"kyc.provenance.io", "*.example"},
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, restricted), "AddFinalizeAndActivateMarker(rulescoin)")
+	unrestricted := types.NewEmptyMarkerAccount("plainrulescoin", admin.String(), []types.AccessGrant{
+		*types.NewAccessGrant(admin, []types.Access{types.Access_Admin}),
+	})
+	unrestricted.Supply = sdkmath.NewInt(100)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, unrestricted), "AddFinalizeAndActivateMarker(plainrulescoin)")
+	app.BankKeeper.SetSendEnabled(ctx, "plainrulescoin", false)
+
+	tests := []struct {
+		name    string
+		req     *types.QueryMarkerTransferRulesRequest
+		expResp *types.QueryMarkerTransferRulesResponse
+		expErr  string
+	}{
+		{
+			name: "restricted marker by denom",
+			req:  &types.QueryMarkerTransferRulesRequest{Id: "rulescoin"},
+			expResp: &types.QueryMarkerTransferRulesResponse{
+				Denom:               "rulescoin",
+				MarkerType:          types.MarkerType_RestrictedCoin,
+				AllowForcedTransfer: true,
+				RequiredAttributes:  []string{"kyc.provenance.io", "*.example"},
+				SendEnabled:         true,
+			},
+		},
+		{
+			name: "restricted marker by address with transfer access",
+			req:  &types.QueryMarkerTransferRulesRequest{Id: restricted.GetAddress().String(), Address: admin.String()},
+			expResp: &types.QueryMarkerTransferRulesResponse{
+				Denom:               "rulescoin",
+				MarkerType:          types.MarkerType_RestrictedCoin,
+				AllowForcedTransfer: true,
+				RequiredAttributes:  []string{"kyc.provenance.io", "*.example"},
+				SendEnabled:         true,
+				Address:             admin.String(),
+				HasTransferAccess:   true,
+			},
+		},
+		{
+			name: "restricted marker without transfer access",
+			req:  &types.QueryMarkerTransferRulesRequest{Id: "rulescoin", Address: other.String()},
+			expResp: &types.QueryMarkerTransferRulesResponse{
+				Denom:               "rulescoin",
+				MarkerType:          types.MarkerType_RestrictedCoin,
+				AllowForcedTransfer: true,
+				RequiredAttributes:  []string{"kyc.provenance.io", "*.example"},
+				SendEnabled:         true,
+				Address:             other.String(),
+			},
+		},
+		{
+			name: "send disabled coin marker",
+			req:  &types.QueryMarkerTransferRulesRequest{Id: "plainrulescoin"},
+			expResp: &types.QueryMarkerTransferRulesResponse{
+				Denom:      "plainrulescoin",
+				MarkerType: types.MarkerType_Coin,
+			},
+		},
+		{
+			name:   "invalid address",
+			req:    &types.QueryMarkerTransferRulesRequest{Id: "rulescoin", Address: "notanaddress"},
+			expErr: "rpc error: code = InvalidArgument desc = invalid address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "unknown marker",
+			req:    &types.QueryMarkerTransferRulesRequest{Id: "nosuchcoin"},
+			expErr: "invalid denom or address: marker not found",
+		},
+		{
+			name:   "nil request",
+			expErr: "rpc error: code = InvalidArgument desc = invalid request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := app.MarkerKeeper.MarkerTransferRules(ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "MarkerTransferRules error")
+				return
+			}
+			require.NoError(t, err, "MarkerTransferRules error")
+			assert.Equal(t, tc.expResp, resp, "MarkerTransferRules response")
+		})
+	}
+}
+
+func TestQueryRestrictionTrace(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	sender := sdk.AccAddress("sender______________")
+	receiver := sdk.AccAddress("receiver____________")
+	marker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress("tracecoin")),
+		sdk.NewInt64Coin("tracecoin", 1000), admin,
+		[]types.AccessGrant{*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Withdraw})},
+		types.StatusProposed, types.MarkerType_RestrictedCoin, true, false, false,
+		[]string{"kyc.provenance.io"},
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker(tracecoin)")
+	amt := sdk.NewCoins(sdk.NewInt64Coin("tracecoin", 10))
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, sender, amt), "FundAccount")
+
+	txBytes := []byte("restriction trace tx")
+	txHash := fmt.Sprintf("%X", tmhash.Sum(txBytes))
+	txCtx := ctx.WithTxBytes(txBytes)
+	expSendErr := "address " + receiver.String() + ` does not contain the "tracecoin" required attribute: "kyc.provenance.io"`
+	sendGas := func() uint64 {
+		cacheCtx, _ := txCtx.WithGasMeter(storetypes.NewInfiniteGasMeter()).CacheContext()
+		err := app.BankKeeper.SendCoins(cacheCtx, sender, receiver, amt)
+		require.EqualError(t, err, expSendErr, "SendCoins error")
+		return cacheCtx.GasMeter().GasConsumed()
+	}
+	req := &types.QueryRestrictionTraceRequest{TxHash: strings.ToLower(txHash)}
+
+	gasDisabled := sendGas()
+	_, err := app.MarkerKeeper.RestrictionTrace(ctx, req)
+	assert.EqualError(t, err, "rpc error: code = FailedPrecondition desc = restriction tracing is not enabled "+
+		"on this node (see marker.restriction-trace)", "RestrictionTrace error while disabled")
+
+	app.MarkerKeeper.SetRestrictionTraceEnabled(true)
+	defer app.MarkerKeeper.SetRestrictionTraceEnabled(false)
+
+	_, err = app.MarkerKeeper.RestrictionTrace(ctx, req)
+	assert.EqualError(t, err, "rpc error: code = NotFound desc = no restriction traces found for tx "+txHash,
+		"RestrictionTrace error before the send")
+
+	gasEnabled := sendGas()
+	assert.Equal(t, gasDisabled, gasEnabled, "gas consumed by the send with tracing enabled")
+
+	expResp := &types.QueryRestrictionTraceResponse{
+		Traces: []types.RestrictionTrace{
+			{
+				TxHash:      txHash,
+				Height:      ctx.BlockHeight(),
+				FromAddress: sender.String(),
+				ToAddress:   receiver.String(),
+				Amount:      "10tracecoin",
+				Checks:      []types.RestrictionTraceCheck{{Name: "required-attributes", Detail: "tracecoin: missing kyc.provenance.io"}},
+				Error:       expSendErr,
+			},
+		},
+	}
+	resp, err := app.MarkerKeeper.RestrictionTrace(ctx, req)
+	require.NoError(t, err, "RestrictionTrace error")
+	assert.Equal(t, expResp, resp, "RestrictionTrace response")
+
+	// A successful send shouldn't be recorded.
+	require.NoError(t, app.BankKeeper.SendCoins(types.WithBypass(txCtx), sender, receiver, amt), "SendCoins with bypass")
+	resp, err = app.MarkerKeeper.RestrictionTrace(ctx, req)
+	require.NoError(t, err, "RestrictionTrace error after successful send")
+	assert.Len(t, resp.Traces, 1, "RestrictionTrace traces after successful send")
+
+	_, err = app.MarkerKeeper.RestrictionTrace(ctx, &types.QueryRestrictionTraceRequest{TxHash: "abc"})
+	assert.EqualError(t, err, `rpc error: code = InvalidArgument desc = invalid tx hash "abc": must be 64 hex characters`,
+		"RestrictionTrace error with invalid tx hash")
+	_, err = app.MarkerKeeper.RestrictionTrace(ctx, nil)
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid request", "RestrictionTrace error with nil request")
+}
+
+// walkPages gets all the entries of a listing, two at a time, by following the next keys.
+func walkPages(t *testing.T, reverse bool, getPage func(pageReq *query.PageRequest) ([]string, *query.PageResponse, error)) []string {
+	t.Helper()
+	var rv []string
+	var key []byte
+	for i := 0; i < 100; i++ {
+		entries, pageResp, err := getPage(&query.PageRequest{Key: key, Limit: 2, Reverse: reverse})
+		require.NoError(t, err, "page %d (reverse = %t)", i+1, reverse)
+		rv = append(rv, entries...)
+		if pageResp == nil || len(pageResp.NextKey) == 0 {
+			return rv
+		}
+		key = pageResp.NextKey
+	}
+	t.Fatalf("listing did not end after 100 pages (reverse = %t)", reverse)
+	return nil
+}
+
+// assertMirrored asserts that walking the listing forward gets the expected entries, and backward gets them reversed.
+func assertMirrored(t *testing.T, exp []string, getPage func(pageReq *query.PageRequest) ([]string, *query.PageResponse, error)) {
+	t.Helper()
+	forward := walkPages(t, false, getPage)
+	backward := walkPages(t, true, getPage)
+	assert.Equal(t, exp, forward, "entries walking forward")
+	mirrored := make([]string, len(backward))
+	for i, entry := range backward {
+		mirrored[len(backward)-1-i] = entry
+	}
+	assert.Equal(t, exp, mirrored, "entries walking backward (reversed)")
+}
+
+func TestQueryListsReverse(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	// sortAddrs sorts the provided bech32 addresses by their bytes, which is the order they're stored in.
+	sortAddrs := func(addrs []string) {
+		sort.Slice(addrs, func(i, j int) bool {
+			return string(sdk.MustAccAddressFromBech32(addrs[i])) < string(sdk.MustAccAddressFromBech32(addrs[j]))
+		})
+	}
+	var markerAddrs []string
+	for _, denom := range []string{"revcoina", "revcoinb", "revcoinc", "revcoind", "revcoine"} {
+		marker := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{
+			*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Mint}),
+		})
+		marker.Supply = sdkmath.NewInt(1000)
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker(%s)", denom)
+		markerAddrs = append(markerAddrs, marker.GetAddress().String())
+	}
+	sortAddrs(markerAddrs)
+
+	holders := []string{types.MustGetMarkerAddress("revcoina").String()}
+	for i := 0; i < 5; i++ {
+		addr := sdk.AccAddress(fmt.Sprintf("holder%d_____________", i))
+		require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, addr,
+			sdk.NewCoins(sdk.NewInt64Coin("revcoina", int64(10+i)))), "FundAccount(%s)", addr)
+		holders = append(holders, addr.String())
+	}
+	sortAddrs(holders)
+
+	marker, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "revcoina")
+	require.NoError(t, err, "GetMarkerByDenom(revcoina)")
+	expPrices := []string{"1cad", "2eur", "3jpy", "4nhash", "5usd"}
+	for _, price := range expPrices {
+		coin, err := sdk.ParseCoinNormalized(price)
+		require.NoError(t, err, "ParseCoinNormalized(%q)", price)
+		require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, marker, types.NewNetAssetValue(coin, 1), "test"), "SetNetAssetValue(%s)", price)
+	}
+
+	t.Run("AllMarkers", func(t *testing.T) {
+		assertMirrored(t, markerAddrs, func(pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+			resp, err := app.MarkerKeeper.AllMarkers(ctx, &types.QueryAllMarkersRequest{Pagination: pageReq})
+			if err != nil {
+				return nil, nil, err
+			}
+			rv := make([]string, len(resp.Markers))
+			for i, m := range resp.Markers {
+				var acct types.MarkerAccountI
+				require.NoError(t, app.InterfaceRegistry().UnpackAny(m, &acct), "UnpackAny")
+				rv[i] = acct.GetAddress().String()
+			}
+			return rv, resp.Pagination, nil
+		})
+	})
+
+	holdingPage := func(req types.QueryHoldingRequest) func(pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+		return func(pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+			req.Pagination = pageReq
+			resp, err := app.MarkerKeeper.Holding(ctx, &req)
+			if err != nil {
+				return nil, nil, err
+			}
+			rv := make([]string, len(resp.Balances))
+			for i, bal := range resp.Balances {
+				rv[i] = bal.Address
+			}
+			return rv, resp.Pagination, nil
+		}
+	}
+
+	t.Run("Holding", func(t *testing.T) {
+		assertMirrored(t, holders, holdingPage(types.QueryHoldingRequest{Id: "revcoina"}))
+	})
+
+	t.Run("Holding with filter", func(t *testing.T) {
+		assertMirrored(t, holders, holdingPage(types.QueryHoldingRequest{Id: "revcoina", MinAmount: "1"}))
+	})
+
+	t.Run("NetAssetValues", func(t *testing.T) {
+		assertMirrored(t, expPrices, func(pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+			resp, err := app.MarkerKeeper.NetAssetValues(ctx, &types.QueryNetAssetValuesRequest{Id: "revcoina", Pagination: pageReq})
+			if err != nil {
+				return nil, nil, err
+			}
+			rv := make([]string, len(resp.NetAssetValues))
+			for i, nav := range resp.NetAssetValues {
+				rv[i] = nav.Price.String()
+			}
+			return rv, resp.Pagination, nil
+		})
+	})
+}
+
+func TestQueryHoldingByAddress(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_______________")
+	user := sdk.AccAddress("user________________")
+	newMarker := func(denom string, userAccess ...types.Access) *types.MarkerAccount {
+		grants := []types.AccessGrant{*types.NewAccessGrant(admin, []types.Access{types.Access_Admin, types.Access_Withdraw})}
+		if len(userAccess) > 0 {
+			grants = append(grants, *types.NewAccessGrant(user, userAccess))
+		}
+		marker := types.NewEmptyMarkerAccount(denom, admin.String(), grants)
+		marker.Supply = sdkmath.NewInt(1000)
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker(%q)", denom)
+		coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+		require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, user, denom, coins), "WithdrawCoins(%q)", denom)
+		return marker
+	}
+	aCoin := newMarker("holdacoin", types.Access_Mint, types.Access_Burn)
+	bCoin := newMarker("holdbcoin")
+	cCoin := newMarker("holdccoin", types.Access_Withdraw)
+	plainCoins := sdk.NewCoins(sdk.NewInt64Coin("holdaplain", 5), sdk.NewInt64Coin("holdzplain", 7))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.CoinPoolName, plainCoins), "MintCoins")
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, user, plainCoins), "SendCoinsFromModuleToAccount")
+
+	expHolding := func(marker *types.MarkerAccount, includeAccess bool) types.MarkerHolding {
+		rv := types.MarkerHolding{
+			Balance:       sdk.NewInt64Coin(marker.GetDenom(), 100),
+			MarkerAddress: marker.GetAddress().String(),
+			Status:        types.StatusActive,
+			MarkerType:    types.MarkerType_Coin,
+			Permissions:   types.GrantsForAddress(user, marker.GetAccessList()...).Permissions,
+		}
+		if includeAccess {
+			rv.AccessControl = marker.GetAccessList()
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name     string
+		req      *types.QueryHoldingByAddressRequest
+		exp      []types.MarkerHolding
+		expTotal uint64
+		expErr   string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			expErr: "rpc error: code = InvalidArgument desc = invalid request",
+		},
+		{
+			name:   "invalid address",
+			req:    &types.QueryHoldingByAddressRequest{Address: "notanaddress"},
+			expErr: `rpc error: code = InvalidArgument desc = invalid address "notanaddress": decoding bech32 failed: invalid separator index -1`,
+		},
+		{
+			name: "no holdings",
+			req:  &types.QueryHoldingByAddressRequest{Address: sdk.AccAddress("nobody______________").String()},
+			exp:  []types.MarkerHolding{},
+		},
+		{
+			name: "without access",
+			req:  &types.QueryHoldingByAddressRequest{Address: user.String()},
+			exp:  []types.MarkerHolding{expHolding(aCoin, false), expHolding(bCoin, false), expHolding(cCoin, false)},
+		},
+		{
+			name: "with access",
+			req:  &types.QueryHoldingByAddressRequest{Address: user.String(), IncludeAccess: true},
+			exp:  []types.MarkerHolding{expHolding(aCoin, true), expHolding(bCoin, true), expHolding(cCoin, true)},
+		},
+		{
+			name:     "offset and limit",
+			req:      &types.QueryHoldingByAddressRequest{Address: user.String(), Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true}},
+			exp:      []types.MarkerHolding{expHolding(bCoin, false)},
+			expTotal: 3,
+		},
+		{
+			name:   "offset and key",
+			req:    &types.QueryHoldingByAddressRequest{Address: user.String(), Pagination: &query.PageRequest{Offset: 1, Key: []byte("holdbcoin")}},
+			expErr: "rpc error: code = InvalidArgument desc = paginate: invalid request, either offset or key is expected, got both",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := app.MarkerKeeper.HoldingByAddress(ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "HoldingByAddress error")
+				return
+			}
+			require.NoError(t, err, "HoldingByAddress error")
+			assert.Equal(t, tc.exp, resp.Holdings, "HoldingByAddress holdings")
+			if assert.NotNil(t, resp.Pagination, "HoldingByAddress pagination") {
+				assert.Equal(t, tc.expTotal, resp.Pagination.Total, "HoldingByAddress pagination total")
+			}
+		})
+	}
+
+	t.Run("paginated by key", func(t *testing.T) {
+		req := &types.QueryHoldingByAddressRequest{Address: user.String(), Pagination: &query.PageRequest{Limit: 2}}
+		resp, err := app.MarkerKeeper.HoldingByAddress(ctx, req)
+		require.NoError(t, err, "HoldingByAddress page 1")
+		assert.Equal(t, []types.MarkerHolding{expHolding(aCoin, false), expHolding(bCoin, false)}, resp.Holdings, "page 1 holdings")
+		require.NotEmpty(t, resp.Pagination.NextKey, "page 1 next key")
+
+		req.Pagination = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2}
+		resp, err = app.MarkerKeeper.HoldingByAddress(ctx, req)
+		require.NoError(t, err, "HoldingByAddress page 2")
+		assert.Equal(t, []types.MarkerHolding{expHolding(cCoin, false)}, resp.Holdings, "page 2 holdings")
+		assert.Empty(t, resp.Pagination.NextKey, "page 2 next key")
+	})
+}
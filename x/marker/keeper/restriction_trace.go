@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// restrictionTraceRingSize is the number of restriction traces kept when restriction tracing is enabled.
+const restrictionTraceRingSize = 256
+
+// maxRestrictionTraceValueLen is the most bytes of an attribute value that are kept in a restriction trace.
+const maxRestrictionTraceValueLen = 100
+
+// restrictionTraceRing is an in-memory record of the most recent restriction traces.
+// It is node-local: it's never written to state, and nothing in it is used by the send restriction.
+// It's shared by all copies of a Keeper, so it's safe to enable it after the send restriction is registered.
+type restrictionTraceRing struct {
+	mu      sync.Mutex
+	enabled bool
+	traces  []types.RestrictionTrace
+	next    int
+}
+
+// newRestrictionTraceRing creates a new (disabled) restrictionTraceRing.
+func newRestrictionTraceRing() *restrictionTraceRing {
+	return &restrictionTraceRing{}
+}
+
+// setEnabled turns recording on or off. Turning it off also forgets all recorded traces.
+func (r *restrictionTraceRing) setEnabled(enabled bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.enabled = enabled
+	if !enabled {
+		r.traces = nil
+		r.next = 0
+	}
+}
+
+// isEnabled returns true if traces are being recorded.
+func (r *restrictionTraceRing) isEnabled() bool {
+	if r == nil {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.enabled
+}
+
+// add records the provided trace, replacing the oldest one if the ring is full. Nothing happens if it's disabled.
+func (r *restrictionTraceRing) add(trace types.RestrictionTrace) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.enabled {
+		return
+	}
+	if len(r.traces) < restrictionTraceRingSize {
+		r.traces = append(r.traces, trace)
+		return
+	}
+	r.traces[r.next] = trace
+	r.next = (r.next + 1) % restrictionTraceRingSize
+}
+
+// find returns the recorded traces with the provided tx hash, oldest first.
+func (r *restrictionTraceRing) find(txHash string) []types.RestrictionTrace {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var rv []types.RestrictionTrace
+	for i := range r.traces {
+		trace := r.traces[(r.next+i)%len(r.traces)]
+		if trace.TxHash == txHash {
+			rv = append(rv, trace)
+		}
+	}
+	return rv
+}
+
+// restrictionTracer collects the checks made by the send restriction for a single transfer.
+// All of its methods are safe to call on a nil tracer (they do nothing), which is what's used when tracing is off.
+type restrictionTracer struct {
+	checks     []types.RestrictionTraceCheck
+	attributes []types.RestrictionTraceAttribute
+}
+
+// newRestrictionTracer returns a new tracer if restriction tracing is enabled and
+// the provided context is for a transaction, or nil otherwise.
+func (k Keeper) newRestrictionTracer(ctx sdk.Context) *restrictionTracer {
+	if len(ctx.TxBytes()) == 0 || !k.restrictionTraces.isEnabled() {
+		return nil
+	}
+	return &restrictionTracer{}
+}
+
+// pass records a check that passed.
+func (t *restrictionTracer) pass(name, detail string) {
+	if t != nil {
+		t.checks = append(t.checks, types.RestrictionTraceCheck{Name: name, Passed: true, Detail: detail})
+	}
+}
+
+// check records a check that passed if err is nil, or failed otherwise. The provided err is returned.
+func (t *restrictionTracer) check(name, detail string, err error) error {
+	if t != nil {
+		t.checks = append(t.checks, types.RestrictionTraceCheck{Name: name, Passed: err == nil, Detail: detail})
+	}
+	return err
+}
+
+// addAttributes records the provided attributes.
+func (t *restrictionTracer) addAttributes(attributes []attrtypes.Attribute) {
+	if t == nil {
+		return
+	}
+	for _, attr := range attributes {
+		value := attr.Value
+		if len(value) > maxRestrictionTraceValueLen {
+			value = value[:maxRestrictionTraceValueLen]
+		}
+		var valueStr string
+		if utf8.Valid(value) {
+			valueStr = string(value)
+		} else {
+			valueStr = base64.StdEncoding.EncodeToString(value)
+		}
+		t.attributes = append(t.attributes, types.RestrictionTraceAttribute{Name: attr.Name, Value: valueStr})
+	}
+}
+
+// recordRestrictionTrace records the checks collected by the provided tracer for a transfer rejected with the provided error.
+// Nothing is recorded if the tracer is nil (i.e. tracing is off) or there's no error.
+func (k Keeper) recordRestrictionTrace(ctx sdk.Context, t *restrictionTracer, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, err error) {
+	if t == nil || err == nil {
+		return
+	}
+	k.restrictionTraces.add(types.RestrictionTrace{
+		TxHash:      fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes())),
+		Height:      ctx.BlockHeight(),
+		CheckTx:     ctx.IsCheckTx(),
+		FromAddress: fromAddr.String(),
+		ToAddress:   toAddr.String(),
+		Amount:      amt.String(),
+		Checks:      t.checks,
+		Attributes:  t.attributes,
+		Error:       err.Error(),
+	})
+}
@@ -17,9 +17,19 @@ var _ banktypes.SendRestrictionFn = Keeper{}.SendRestrictionFn
 
 func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	tr := k.newRestrictionTracer(ctx)
+	rv, err := k.sendRestriction(ctx, tr, fromAddr, toAddr, amt)
+	k.recordRestrictionTrace(ctx, tr, fromAddr, toAddr, amt, err)
+	return rv, err
+}
+
+// sendRestriction applies the marker module's restrictions to a send, recording the checks made in the provided tracer.
+// The tracer can be nil, in which case nothing is recorded.
+func (k Keeper) sendRestriction(ctx sdk.Context, tr *restrictionTracer, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
 	// In some cases, it might not be possible to add a bypass to the context.
 	// If it's from either the Marker or IBC Transfer module accounts, assume proper validation has been done elsewhere.
 	if types.HasBypass(ctx) || fromAddr.Equals(k.markerModuleAddr) || fromAddr.Equals(k.ibcTransferModuleAddr) {
+		tr.pass("bypass", "send has a bypass or is from the marker or ibc transfer module account")
 		// But still don't let restricted denoms get sent to the fee collector.
 		if toAddr.Equals(k.feeCollectorAddr) {
 			for _, coin := range amt {
@@ -29,10 +39,12 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 					return nil, err
 				}
 				if marker != nil && marker.GetMarkerType() == types.MarkerType_RestrictedCoin {
-					return nil, fmt.Errorf("cannot send restricted denom %s to the fee collector", coin.Denom)
+					return nil, tr.check("fee-collector", coin.Denom,
+						fmt.Errorf("cannot send restricted denom %s to the fee collector", coin.Denom))
 				}
 			}
 		}
+		k.emitSendHoldingThresholdEvents(ctx, fromAddr, toAddr, amt)
 		return toAddr, nil
 	}
 
@@ -48,12 +60,13 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 		// true when collecting fees.
 		if !internalsdk.HasFeeGrantInUse(ctx) {
 			if len(admins) == 0 {
-				return nil, fmt.Errorf("cannot withdraw from marker account %s (%s)",
-					fromAddr.String(), fromMarker.GetDenom())
+				return nil, tr.check("withdraw-access", fromMarker.GetDenom(),
+					fmt.Errorf("cannot withdraw from marker account %s (%s)", fromAddr.String(), fromMarker.GetDenom()))
 			}
 
 			// Need at least one admin that can make withdrawals.
-			if err := types.ValidateAtLeastOneAddrHasAccess(fromMarker, admins, types.Access_Withdraw); err != nil {
+			err := types.ValidateAtLeastOneAddrHasAccess(fromMarker, admins, types.Access_Withdraw)
+			if err = tr.check("withdraw-access", fromMarker.GetDenom(), err); err != nil {
 				return nil, err
 			}
 		}
@@ -63,8 +76,8 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 		if fromMarker.GetStatus() != types.StatusActive {
 			hasFromCoin, fromAmt := amt.Find(fromMarker.GetDenom())
 			if hasFromCoin && !fromAmt.IsZero() {
-				return nil, fmt.Errorf("cannot withdraw %s from %s marker (%s): marker status (%s) is not %s",
-					fromAmt, fromMarker.GetDenom(), fromAddr, fromMarker.GetStatus(), types.StatusActive)
+				return nil, tr.check("withdraw-marker-active", fromMarker.GetDenom(), fmt.Errorf("cannot withdraw %s from %s marker (%s): marker status (%s) is not %s",
+					fromAmt, fromMarker.GetDenom(), fromAddr, fromMarker.GetStatus(), types.StatusActive))
 			}
 		}
 	}
@@ -73,30 +86,33 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 	// fromAddr (if there isn't an admin) must have deposit access on that marker.
 	toMarker, _ := k.GetMarker(ctx, toAddr)
 	if toMarker != nil && toMarker.GetMarkerType() == types.MarkerType_RestrictedCoin {
+		var err error
 		if len(admins) > 0 {
-			if err := types.ValidateAtLeastOneAddrHasAccess(toMarker, admins, types.Access_Deposit); err != nil {
-				return nil, err
-			}
+			err = types.ValidateAtLeastOneAddrHasAccess(toMarker, admins, types.Access_Deposit)
 		} else {
-			if err := toMarker.ValidateAddressHasAccess(fromAddr, types.Access_Deposit); err != nil {
-				return nil, err
-			}
+			err = toMarker.ValidateAddressHasAccess(fromAddr, types.Access_Deposit)
+		}
+		if err = tr.check("deposit-access", toMarker.GetDenom(), err); err != nil {
+			return nil, err
 		}
 	}
 
 	// Check the ability to send each denom involved.
 	for _, coin := range amt {
-		if err := k.validateSendDenom(ctx, fromAddr, toAddr, admins, coin.Denom, toMarker); err != nil {
+		if err := k.validateSendDenom(ctx, tr, fromAddr, toAddr, admins, coin.Denom, toMarker); err != nil {
 			return nil, err
 		}
 	}
 
+	k.emitSendHoldingThresholdEvents(ctx, fromAddr, toAddr, amt)
+
 	return toAddr, nil
 }
 
 // validateSendDenom makes sure a send of the given denom is allowed for the given addresses.
 // This is NOT the validation that is needed for the marker Transfer endpoint.
-func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, admins []sdk.AccAddress, denom string, toMarker types.MarkerAccountI) error {
+// The tracer can be nil, in which case the checks made aren't recorded.
+func (k Keeper) validateSendDenom(ctx sdk.Context, tr *restrictionTracer, fromAddr, toAddr sdk.AccAddress, admins []sdk.AccAddress, denom string, toMarker types.MarkerAccountI) error {
 	markerAddr := types.MustGetMarkerAddress(denom)
 	marker, err := k.GetMarker(ctx, markerAddr)
 	if err != nil {
@@ -105,21 +121,24 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 
 	// If there's a marker, it must be active.
 	if marker != nil && marker.GetStatus() != types.StatusActive {
-		return fmt.Errorf("cannot send %s coins: marker status (%s) is not %s", denom, marker.GetStatus(), types.StatusActive)
+		return tr.check("marker-active", denom,
+			fmt.Errorf("cannot send %s coins: marker status (%s) is not %s", denom, marker.GetStatus(), types.StatusActive))
 	}
 
 	// If there's no marker for the denom, or it's not a restricted marker, there's nothing more to do here.
 	if marker == nil || marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		tr.pass("unrestricted", denom)
 		return nil
 	}
 
 	// We can't allow restricted coins to end up with the fee collector.
 	if toAddr.Equals(k.feeCollectorAddr) {
-		return fmt.Errorf("restricted denom %s cannot be sent to the fee collector", denom)
+		return tr.check("fee-collector", denom, fmt.Errorf("restricted denom %s cannot be sent to the fee collector", denom))
 	}
 
 	// If there's an admin that has transfer access, it's not a normal bank send and there's nothing more to do here.
 	if len(admins) > 0 && types.AtLeastOneAddrHasAccess(marker, admins, types.Access_Transfer) {
+		tr.pass("transfer-agent", denom)
 		return nil
 	}
 
@@ -128,11 +147,12 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 	// They can either take themselves off the list and do the send again, or just use the transfer endpoint.
 	// But for normal sends (without a transfer agent), we want the send-deny list enforced first.
 	if k.IsSendDeny(ctx, markerAddr, fromAddr) {
-		return fmt.Errorf("%s is on deny list for sending restricted marker", fromAddr.String())
+		return tr.check("send-deny", denom, fmt.Errorf("%s is on deny list for sending restricted marker", fromAddr.String()))
 	}
 
 	// If the fromAddr has transfer access, there's nothing left to check.
 	if marker.AddressHasAccess(fromAddr, types.Access_Transfer) {
+		tr.pass("transfer-access", denom)
 		return nil
 	}
 
@@ -142,16 +162,16 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 	// It's assumed that a marker address cannot be in the bypass list.
 	if toMarker != nil {
 		if len(admins) == 0 {
-			return fmt.Errorf("%s does not have %s on %s marker (%s)",
-				fromAddr, types.Access_Transfer, denom, marker.GetAddress())
+			return tr.check("deposit-transfer-access", denom, fmt.Errorf("%s does not have %s on %s marker (%s)",
+				fromAddr, types.Access_Transfer, denom, marker.GetAddress()))
 		}
 		addrs := make([]string, 1+len(admins))
 		addrs[0] = fromAddr.String()
 		for i, admin := range admins {
 			addrs[i+1] = admin.String()
 		}
-		return fmt.Errorf("none of %q have %s on %s marker (%s)",
-			addrs, types.Access_Transfer, denom, marker.GetAddress())
+		return tr.check("deposit-transfer-access", denom, fmt.Errorf("none of %q have %s on %s marker (%s)",
+			addrs, types.Access_Transfer, denom, marker.GetAddress()))
 	}
 
 	// If there aren't any required attributes, transfer permission is required unless coming from a bypass account.
@@ -160,34 +180,49 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 	reqAttr := marker.GetRequiredAttributes()
 	if len(reqAttr) == 0 {
 		if k.IsReqAttrBypassAddr(fromAddr) {
+			tr.pass("from-bypass", denom)
 			return nil
 		}
-		return fmt.Errorf("%s does not have transfer permissions for %s", fromAddr.String(), denom)
+		return tr.check("transfer-access", denom, fmt.Errorf("%s does not have transfer permissions for %s", fromAddr.String(), denom))
 	}
 
 	// At this point, we know there are required attributes and that fromAddr does not have transfer permission.
 	// If the toAddress has a bypass, skip checking the attributes and allow the transfer.
 	// When these funds are then being moved out of the bypass account, attributes are checked on that destination.
 	if k.IsReqAttrBypassAddr(toAddr) {
+		tr.pass("to-bypass", denom)
 		return nil
 	}
 
 	attributes, err := k.attrKeeper.GetAllAttributesAddr(ctx, toAddr)
 	if err != nil {
-		return fmt.Errorf("could not get attributes for %s: %w", toAddr.String(), err)
+		return tr.check("required-attributes", denom, fmt.Errorf("could not get attributes for %s: %w", toAddr.String(), err))
 	}
+	tr.addAttributes(attributes)
 	missing := findMissingAttributes(reqAttr, attributes)
 	if len(missing) != 0 {
 		pl := ""
 		if len(missing) != 1 {
 			pl = "s"
 		}
-		return fmt.Errorf("address %s does not contain the %q required attribute%s: \"%s\"", toAddr.String(), denom, pl, strings.Join(missing, `", "`))
+		return tr.check("required-attributes", denom+": missing "+strings.Join(missing, ", "),
+			fmt.Errorf("address %s does not contain the %q required attribute%s: \"%s\"", toAddr.String(), denom, pl, strings.Join(missing, `", "`)))
 	}
 
+	tr.pass("required-attributes", denom)
 	return nil
 }
 
+// getMissingAttributes returns all entries in required that don't pass MatchAttribute
+// on at least one of the attributes of the provided address.
+func (k Keeper) getMissingAttributes(ctx sdk.Context, addr sdk.AccAddress, required []string) ([]string, error) {
+	attributes, err := k.attrKeeper.GetAllAttributesAddr(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("could not get attributes for %s: %w", addr.String(), err)
+	}
+	return findMissingAttributes(required, attributes), nil
+}
+
 // findMissingAttributes returns all entries in required that don't pass
 // MatchAttribute on at least one of the provided attribute names.
 func findMissingAttributes(required []string, attributes []attrTypes.Attribute) []string {
@@ -204,38 +239,29 @@ reqLoop:
 	return rv
 }
 
-// NormalizeRequiredAttributes normalizes the required attribute names using name module's Normalize method
+// NormalizeRequiredAttributes normalizes the required attribute names using types.NormalizeRequiredAttributes,
+// then makes sure each is within the attribute and name module length limits.
 func (k Keeper) NormalizeRequiredAttributes(ctx sdk.Context, requiredAttributes []string) ([]string, error) {
+	result, err := types.NormalizeRequiredAttributes(requiredAttributes)
+	if err != nil {
+		return nil, err
+	}
+
 	maxLength := int(k.attrKeeper.GetMaxValueLength(ctx))
-	result := make([]string, len(requiredAttributes))
-	for i, attr := range requiredAttributes {
+	for _, attr := range result {
 		if len(attr) > maxLength {
 			return nil, fmt.Errorf("required attribute %v length is too long %v : %v ", attr, len(attr), maxLength)
 		}
-
-		// for now just check if required attribute starts with a *.
-		var prefix string
-		if strings.HasPrefix(attr, "*.") {
-			prefix = attr[:2]
-			attr = attr[2:]
-		}
-		normalizedAttr, err := k.nameKeeper.Normalize(ctx, attr)
-		if err != nil {
-			return nil, err
+		// The name module's Normalize also checks the segment length and count limits.
+		if _, err = k.nameKeeper.Normalize(ctx, strings.TrimPrefix(attr, types.RequiredAttributeWildcardPrefix)); err != nil {
+			return nil, fmt.Errorf("invalid required attribute %q: %w", attr, err)
 		}
-		result[i] = fmt.Sprintf("%s%s", prefix, normalizedAttr)
 	}
 	return result, nil
 }
 
 // MatchAttribute returns true if the provided attr satisfies the reqAttr.
+// It's the same as types.MatchRequiredAttribute, which is also used by the MarkersByRequiredAttribute query.
 func MatchAttribute(reqAttr string, attr string) bool {
-	if len(reqAttr) < 1 {
-		return false
-	}
-	if strings.HasPrefix(reqAttr, "*.") {
-		// [1:] because we only want to ignore the '*'; the '.' needs to be part of the check.
-		return strings.HasSuffix(attr, reqAttr[1:])
-	}
-	return reqAttr == attr
+	return types.MatchRequiredAttribute(reqAttr, attr)
 }
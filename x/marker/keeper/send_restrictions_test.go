@@ -1069,7 +1069,12 @@ func TestNormalizeRequiredAttributes(t *testing.T) {
 			name:               "should fail - segment name too short",
 			requiredAttributes: []string{"."},
 			expectedNormalized: []string{},
-			expectedError:      "segment of name is too short",
+			expectedError:      `invalid required attribute ".": name segments cannot be empty`,
+		},
+		{
+			name:               "should fail - segment name too long",
+			requiredAttributes: []string{"*.thisnamesegmentiswaytoolongtobeallowedbythenamemodule.io"},
+			expectedError:      `invalid required attribute "*.thisnamesegmentiswaytoolongtobeallowedbythenamemodule.io": segment of name is too long`,
 		},
 		{
 			name:               "should fail - segment name too short2",
@@ -1081,7 +1086,17 @@ func TestNormalizeRequiredAttributes(t *testing.T) {
 			name:               "should fail - invalid wild card value",
 			requiredAttributes: []string{"*b.provenance.io"},
 			expectedNormalized: []string{},
-			expectedError:      "value provided for name is invalid",
+			expectedError:      `invalid required attribute "*b.provenance.io": a wildcard is only allowed as a leading "*." segment`,
+		},
+		{
+			name:               "should fail - duplicates once normalized",
+			requiredAttributes: []string{"*.provenance.io", " *.Provenance.IO"},
+			expectedError:      `required attribute list contains duplicate entries: "*.provenance.io"`,
+		},
+		{
+			name:               "should succeed - entries are normalized",
+			requiredAttributes: []string{" KYC.Provenance.io ", "*. Provenance .IO"},
+			expectedNormalized: []string{"kyc.provenance.io", "*.provenance.io"},
 		},
 		{
 			name:               "should succeed - valid wild card value",
@@ -1165,6 +1180,18 @@ func TestMatchAttribute(t *testing.T) {
 			attr:           "test.provenance.iox",
 			expectedResult: false,
 		},
+		{
+			name:           "should succeed - required attr not normalized",
+			reqAttr:        "*. Provenance.IO",
+			attr:           "test.provenance.io",
+			expectedResult: true,
+		},
+		{
+			name:           "should fail - wildcard not leading",
+			reqAttr:        "test.*.io",
+			attr:           "test.provenance.io",
+			expectedResult: false,
+		},
 		{
 			name:           "should fail - wildcard extra beginning",
 			reqAttr:        "*.provenance.io",
@@ -44,6 +44,8 @@ const (
 	OpWeightMsgSetAccountData = "op_weight_msg_set_account_data"
 	//nolint:gosec // not credentials
 	OpWeightMsgUpdateSendDenyList = "op_weight_msg_update_send_deny_list"
+	//nolint:gosec // not credentials
+	OpWeightMsgAddNetAssetValues = "op_weight_msg_add_net_asset_values"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -68,6 +70,7 @@ func WeightedOperations(
 		wMsgAddMarkerProposal  int
 		wMsgSetAccountData     int
 		wMsgUpdateSendDenyList int
+		wMsgAddNetAssetValues  int
 	)
 
 	simState.AppParams.GetOrGenerate(OpWeightMsgAddMarker, &wMsgAddMarker, nil,
@@ -84,6 +87,8 @@ func WeightedOperations(
 		func(_ *rand.Rand) { wMsgSetAccountData = simappparams.DefaultWeightMsgSetAccountData })
 	simState.AppParams.GetOrGenerate(OpWeightMsgUpdateSendDenyList, &wMsgUpdateSendDenyList, nil,
 		func(_ *rand.Rand) { wMsgUpdateSendDenyList = simappparams.DefaultWeightMsgUpdateDenySendList })
+	simState.AppParams.GetOrGenerate(OpWeightMsgAddNetAssetValues, &wMsgAddNetAssetValues, nil,
+		func(_ *rand.Rand) { wMsgAddNetAssetValues = simappparams.DefaultWeightMsgAddNetAssetValues })
 
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(wMsgAddMarker, SimulateMsgAddMarker(k, args)),
@@ -93,6 +98,7 @@ func WeightedOperations(
 		simulation.NewWeightedOperation(wMsgAddMarkerProposal, SimulateMsgAddMarkerProposal(k, args)),
 		simulation.NewWeightedOperation(wMsgSetAccountData, SimulateMsgSetAccountData(k, args)),
 		simulation.NewWeightedOperation(wMsgUpdateSendDenyList, SimulateMsgUpdateSendDenyList(k, args)),
+		simulation.NewWeightedOperation(wMsgAddNetAssetValues, SimulateMsgAddNetAssetValues(k, args)),
 	}
 }
 
@@ -363,6 +369,26 @@ func SimulateMsgUpdateSendDenyList(k keeper.Keeper, args *WeightedOpsArgs) simty
 	}
 }
 
+// SimulateMsgAddNetAssetValues will set a random usd net asset value on a random marker.
+func SimulateMsgAddNetAssetValues(k keeper.Keeper, args *WeightedOpsArgs) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgAddNetAssetValuesRequest{}
+
+		marker, signer := randomMarkerWithAccessSigner(r, ctx, k, accs, types.Access_Admin)
+		if marker == nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to find marker with an admin signer"), nil, nil
+		}
+
+		msg.Denom = marker.GetDenom()
+		msg.Administrator = signer.Address.String()
+		msg.NetAssetValues = []types.NetAssetValue{randomNetAssetValue(r)}
+
+		return Dispatch(r, app, ctx, args.SimState, args.AK, args.BK, signer, chainID, msg, nil)
+	}
+}
+
 // Dispatch sends an operation to the chain using a given account/funds on account for fees.  Failures on the server side
 // are handled as no-op msg operations with the error string as the status/response.
 func Dispatch(
@@ -439,6 +465,14 @@ func randomUnrestrictedDenom(r *rand.Rand, unrestrictedDenomExp string) string {
 	return simtypes.RandStringOfLength(r, int(randomInt63(r, maxLen-minLen)+minLen))
 }
 
+// randomNetAssetValue generates a usd net asset value with a price between 1 and 1,000,000 mills,
+// and a volume between 1 and 1,000,000.
+func randomNetAssetValue(r *rand.Rand) types.NetAssetValue {
+	price := sdk.NewInt64Coin(types.UsdDenom, r.Int63n(1_000_000)+1)
+	volume := uint64(r.Int63n(1_000_000) + 1) //nolint:gosec // G115: Always between 1 and 1,000,000, so it fits in a uint64.
+	return types.NewNetAssetValue(price, volume)
+}
+
 // randomAccessGrants generates random access grants for randomly selected accounts.
 // Each account has a 30% chance of being chosen with a max of limit.
 func randomAccessGrants(r *rand.Rand, accs []simtypes.Account, limit int, markerType types.MarkerType) (grants []types.AccessGrant) {
@@ -112,6 +112,7 @@ func (s *SimTestSuite) TestWeightedOperations() {
 		{weight: simappparams.DefaultWeightMsgAddMarkerProposal, opMsgRoute: "gov", opMsgName: sdk.MsgTypeURL(&govtypes.MsgSubmitProposal{})},
 		{weight: simappparams.DefaultWeightMsgSetAccountData, opMsgRoute: types.RouterKey, opMsgName: sdk.MsgTypeURL(&types.MsgSetAccountDataRequest{})},
 		{weight: simappparams.DefaultWeightMsgUpdateDenySendList, opMsgRoute: types.RouterKey, opMsgName: sdk.MsgTypeURL(&types.MsgUpdateSendDenyListRequest{})},
+		{weight: simappparams.DefaultWeightMsgAddNetAssetValues, opMsgRoute: types.RouterKey, opMsgName: sdk.MsgTypeURL(&types.MsgAddNetAssetValuesRequest{})},
 	}
 
 	expNames := make([]string, len(expected))
@@ -457,6 +458,100 @@ func (s *SimTestSuite) TestSimulateMsgUpdateSendDenyList() {
 	s.Assert().Len(futureOperations, 0, "futureOperations")
 }
 
+func (s *SimTestSuite) TestSimulateMsgAddNetAssetValues() {
+	// setup 3 accounts
+	src := rand.NewSource(1)
+	r := rand.New(src)
+	accounts := s.getTestingAccounts(r, 3)
+	s.addSimCoinMarker(accounts[1])
+
+	// execute operation
+	op := simulation.SimulateMsgAddNetAssetValues(s.app.MarkerKeeper, s.getWeightedOpsArgs())
+	operationMsg, futureOperations, err := op(r, s.app.BaseApp, s.ctx, accounts, "")
+	s.Require().NoError(err, "SimulateMsgAddNetAssetValues op(...) error")
+	s.LogOperationMsg(operationMsg)
+
+	var msg types.MsgAddNetAssetValuesRequest
+	s.Require().NoError(s.app.AppCodec().Unmarshal(operationMsg.Msg, &msg), "UnmarshalJSON(operationMsg.Msg)")
+
+	s.Assert().True(operationMsg.OK, "operationMsg.OK")
+	s.Assert().Equal(sdk.MsgTypeURL(&msg), operationMsg.Name, "operationMsg.Name")
+	s.Assert().Equal("simcoin", msg.Denom, "msg.Denom")
+	s.Assert().Equal(accounts[1].Address.String(), msg.Administrator, "msg.Administrator")
+	if s.Assert().Len(msg.NetAssetValues, 1, "msg.NetAssetValues") {
+		nav := msg.NetAssetValues[0]
+		s.Assert().Equal(types.UsdDenom, nav.Price.Denom, "nav.Price.Denom")
+		s.Assert().True(nav.Price.Amount.IsPositive(), "nav.Price.Amount.IsPositive(): %s", nav.Price.Amount)
+		s.Assert().LessOrEqual(nav.Price.Amount.Int64(), int64(1_000_000), "nav.Price.Amount")
+		s.Assert().GreaterOrEqual(nav.Volume, uint64(1), "nav.Volume")
+		s.Assert().LessOrEqual(nav.Volume, uint64(1_000_000), "nav.Volume")
+	}
+	s.Assert().Equal(types.RouterKey, operationMsg.Route, "operationMsg.Route")
+	s.Assert().Len(futureOperations, 0, "futureOperations")
+
+	stored, err := s.app.MarkerKeeper.GetNetAssetValue(s.ctx, "simcoin", types.UsdDenom)
+	s.Require().NoError(err, "GetNetAssetValue")
+	s.Require().NotNil(stored, "GetNetAssetValue result")
+	s.Assert().Equal(msg.NetAssetValues[0].Price, stored.Price, "stored.Price")
+	s.Assert().Equal(msg.NetAssetValues[0].Volume, stored.Volume, "stored.Volume")
+
+	msgStr, broken := keeper.AllInvariants(s.app.MarkerKeeper, s.app.BankKeeper)(s.ctx)
+	s.Assert().False(broken, "AllInvariants broken: %s", msgStr)
+}
+
+// TestSimulateMsgAddNetAssetValuesDeterminism runs the net asset value operation several times with
+// the same seed against the same state and makes sure the results are always the same.
+func (s *SimTestSuite) TestSimulateMsgAddNetAssetValuesDeterminism() {
+	const seed = 7
+	accounts := s.getTestingAccounts(rand.New(rand.NewSource(seed)), 3)
+	s.addSimCoinMarker(accounts[0])
+	s.addSimCoinMarker(accounts[2], "othercoin")
+
+	var expMsg []byte
+	for i := 0; i < 5; i++ {
+		ctx, _ := s.ctx.CacheContext()
+		r := rand.New(rand.NewSource(seed))
+		op := simulation.SimulateMsgAddNetAssetValues(s.app.MarkerKeeper, s.getWeightedOpsArgs())
+		operationMsg, _, err := op(r, s.app.BaseApp, ctx, accounts, "")
+		s.Require().NoError(err, "[%d]: SimulateMsgAddNetAssetValues op(...) error", i)
+		s.Require().True(operationMsg.OK, "[%d]: operationMsg.OK", i)
+		if i == 0 {
+			expMsg = operationMsg.Msg
+			continue
+		}
+		s.Assert().Equal(string(expMsg), string(operationMsg.Msg), "[%d]: operationMsg.Msg", i)
+	}
+}
+
+// addSimCoinMarker adds an active marker that the provided account has all permissions on.
+// The marker's denom is "simcoin" unless another one is provided.
+func (s *SimTestSuite) addSimCoinMarker(acct simtypes.Account, denom ...string) {
+	d := "simcoin"
+	if len(denom) > 0 {
+		d = denom[0]
+	}
+	newMarker := &types.MsgAddFinalizeActivateMarkerRequest{
+		Amount:      sdk.NewInt64Coin(d, 1000),
+		Manager:     acct.Address.String(),
+		FromAddress: acct.Address.String(),
+		MarkerType:  types.MarkerType_RestrictedCoin,
+		AccessList: []types.AccessGrant{
+			{
+				Address: acct.Address.String(),
+				Permissions: types.AccessList{
+					types.Access_Mint, types.Access_Burn, types.Access_Deposit, types.Access_Withdraw,
+					types.Access_Delete, types.Access_Admin, types.Access_Transfer,
+				},
+			},
+		},
+		SupplyFixed:            true,
+		AllowGovernanceControl: true,
+	}
+	markerMsgServer := keeper.NewMsgServerImpl(s.app.MarkerKeeper)
+	_, err := markerMsgServer.AddFinalizeActivateMarker(s.ctx, newMarker)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", d)
+}
+
 func (s *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
 	return testutil.GenerateTestingAccounts(s.T(), s.ctx, s.app, r, n)
 }
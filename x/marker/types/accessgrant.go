@@ -1,7 +1,9 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
+	"slices"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -81,6 +83,46 @@ func ValidateGrants(grants ...AccessGrant) error {
 	return nil
 }
 
+// NormalizeAccessGrants combines the grants for each address into one (with the union of their permissions)
+// and sorts the result by address bytes. The number of grants that were combined into another is also returned.
+func NormalizeAccessGrants(grants []AccessGrant) ([]AccessGrant, int) {
+	if len(grants) == 0 {
+		return grants, 0
+	}
+
+	rv := make([]AccessGrant, 0, len(grants))
+	indexes := make(map[string]int, len(grants))
+	merged := 0
+	for _, grant := range grants {
+		i, seen := indexes[grant.Address]
+		if !seen {
+			indexes[grant.Address] = len(rv)
+			rv = append(rv, AccessGrant{Address: grant.Address, Permissions: slices.Clone(grant.Permissions)})
+			continue
+		}
+		merged++
+		for _, perm := range grant.Permissions {
+			if !hasAccess(rv[i].Permissions, perm) {
+				rv[i].Permissions = append(rv[i].Permissions, perm)
+			}
+		}
+	}
+
+	slices.SortStableFunc(rv, func(a, b AccessGrant) int {
+		return bytes.Compare(accessGrantAddrBytes(a), accessGrantAddrBytes(b))
+	})
+	return rv, merged
+}
+
+// accessGrantAddrBytes returns the bytes of a grant's address, or of the address string if it isn't a valid bech32 address.
+func accessGrantAddrBytes(grant AccessGrant) []byte {
+	addr, err := sdk.AccAddressFromBech32(grant.Address)
+	if err != nil {
+		return []byte(grant.Address)
+	}
+	return addr
+}
+
 // GrantsForAddress return
 func GrantsForAddress(account sdk.AccAddress, grants ...AccessGrant) AccessGrant {
 	for _, grant := range grants {
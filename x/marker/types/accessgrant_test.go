@@ -234,3 +234,83 @@ func TestMergeAddRemovePermissions(t *testing.T) {
 	require.Error(t, roleGrant.MergeAdd(*NewAccessGrant(otherAddr, AccessList{Access_Mint, Access_Admin})))
 	require.Error(t, roleGrant.MergeRemove(*NewAccessGrant(otherAddr, AccessList{Access_Mint, Access_Admin})))
 }
+
+func TestNormalizeAccessGrants(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	grant := func(addr sdk.AccAddress, perms ...Access) AccessGrant {
+		return AccessGrant{Address: addr.String(), Permissions: perms}
+	}
+
+	tests := []struct {
+		name      string
+		grants    []AccessGrant
+		exp       []AccessGrant
+		expMerged int
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:   "one grant",
+			grants: []AccessGrant{grant(addr1, Access_Mint)},
+			exp:    []AccessGrant{grant(addr1, Access_Mint)},
+		},
+		{
+			name:   "out of order",
+			grants: []AccessGrant{grant(addr3, Access_Admin), grant(addr1, Access_Mint), grant(addr2, Access_Burn)},
+			exp:    []AccessGrant{grant(addr1, Access_Mint), grant(addr2, Access_Burn), grant(addr3, Access_Admin)},
+		},
+		{
+			name: "duplicates",
+			grants: []AccessGrant{
+				grant(addr2, Access_Mint, Access_Burn),
+				grant(addr1, Access_Admin),
+				grant(addr2, Access_Burn, Access_Withdraw),
+				grant(addr1, Access_Admin),
+			},
+			exp: []AccessGrant{
+				grant(addr1, Access_Admin),
+				grant(addr2, Access_Mint, Access_Burn, Access_Withdraw),
+			},
+			expMerged: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, merged := NormalizeAccessGrants(tc.grants)
+			assert.Equal(t, tc.exp, actual, "NormalizeAccessGrants result")
+			assert.Equal(t, tc.expMerged, merged, "NormalizeAccessGrants merged count")
+		})
+	}
+}
+
+func TestMarkerAccessControlOrder(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	grant := func(addr sdk.AccAddress, perms ...Access) AccessGrant {
+		return AccessGrant{Address: addr.String(), Permissions: perms}
+	}
+
+	marker := NewEmptyMarkerAccount("ordercoin", "", []AccessGrant{
+		grant(addr3, Access_Admin),
+		grant(addr2, Access_Mint),
+		grant(addr2, Access_Burn),
+	})
+
+	require.NoError(t, marker.GrantAccess(NewAccessGrant(addr2, AccessList{Access_Withdraw})), "GrantAccess(addr2)")
+	assert.Equal(t, []AccessGrant{
+		grant(addr2, Access_Withdraw, Access_Mint, Access_Burn),
+		grant(addr3, Access_Admin),
+	}, marker.GetAccessList(), "access list after merging grant")
+
+	require.NoError(t, marker.GrantAccess(NewAccessGrant(addr1, AccessList{Access_Deposit})), "GrantAccess(addr1)")
+	require.NoError(t, marker.RevokeAccess(addr2), "RevokeAccess(addr2)")
+	assert.Equal(t, []AccessGrant{
+		grant(addr1, Access_Deposit),
+		grant(addr3, Access_Admin),
+	}, marker.GetAccessList(), "access list after revoke")
+}
@@ -162,3 +162,60 @@ func validateDenom(denom string, rootCoinName string) (SIPrefix, error) {
 	}
 	return prefix, nil
 }
+
+// CheckDenomMetadata checks the denom metadata of the marker with the provided denom for problems that cause
+// display issues (e.g. in wallets). Provide found = false if there isn't any denom metadata for the denom.
+// Unlike ValidateDenomMetadataBasic, this only looks for the things needed to properly display amounts.
+func CheckDenomMetadata(denom string, md banktypes.Metadata, found bool) []DenomMetadataProblem {
+	if !found {
+		return []DenomMetadataProblem{newDenomMetadataProblem(denom, DenomMetadataProblemType_Missing, "no denom metadata found")}
+	}
+
+	var rv []DenomMetadataProblem
+	if md.Base != denom {
+		rv = append(rv, newDenomMetadataProblem(denom, DenomMetadataProblemType_BaseMismatch,
+			fmt.Sprintf("denom metadata base %q does not match the marker denom", md.Base)))
+	}
+	if len(md.Display) == 0 {
+		rv = append(rv, newDenomMetadataProblem(denom, DenomMetadataProblemType_EmptyDisplay, "denom metadata display is empty"))
+	}
+	if detail := checkDenomUnitExponentChain(md); len(detail) > 0 {
+		rv = append(rv, newDenomMetadataProblem(denom, DenomMetadataProblemType_BrokenExponentChain, detail))
+	}
+	return rv
+}
+
+// newDenomMetadataProblem creates a new DenomMetadataProblem.
+func newDenomMetadataProblem(denom string, problem DenomMetadataProblemType, detail string) DenomMetadataProblem {
+	return DenomMetadataProblem{Denom: denom, Problem: problem, Detail: detail}
+}
+
+// checkDenomUnitExponentChain makes sure the denom units start with the base (with exponent 0),
+// have increasing exponents, and contain the display denom (if there is one).
+// A description of the first problem found is returned, or "" if there aren't any problems.
+func checkDenomUnitExponentChain(md banktypes.Metadata) string {
+	if len(md.DenomUnits) == 0 {
+		return "denom metadata has no denom units"
+	}
+	first := md.DenomUnits[0]
+	if first == nil || first.Denom != md.Base || first.Exponent != 0 {
+		return fmt.Sprintf("first denom unit is not the base %q with exponent 0", md.Base)
+	}
+	hasDisplay := len(md.Display) == 0
+	for i, du := range md.DenomUnits {
+		if du == nil {
+			return fmt.Sprintf("denom unit %d is nil", i)
+		}
+		if i > 0 && du.Exponent <= md.DenomUnits[i-1].Exponent {
+			return fmt.Sprintf("denom unit %q exponent %d is not greater than the previous exponent %d",
+				du.Denom, du.Exponent, md.DenomUnits[i-1].Exponent)
+		}
+		if du.Denom == md.Display {
+			hasDisplay = true
+		}
+	}
+	if !hasDisplay {
+		return fmt.Sprintf("display denom %q is not one of the denom units", md.Display)
+	}
+	return ""
+}
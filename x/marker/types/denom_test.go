@@ -370,3 +370,132 @@ func (s *DenomTestSuite) TestGetRootCoinName() {
 		})
 	}
 }
+
+func (s *DenomTestSuite) TestCheckDenomMetadata() {
+	healthy := func() banktypes.Metadata {
+		return banktypes.Metadata{
+			Base:    "nanocoin",
+			Display: "coin",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "nanocoin", Exponent: 0},
+				{Denom: "coin", Exponent: 9},
+			},
+		}
+	}
+	problem := func(pt DenomMetadataProblemType, detail string) DenomMetadataProblem {
+		return DenomMetadataProblem{Denom: "nanocoin", Problem: pt, Detail: detail}
+	}
+
+	tests := []struct {
+		name  string
+		md    func() banktypes.Metadata
+		found bool
+		exp   []DenomMetadataProblem
+	}{
+		{
+			name:  "healthy",
+			md:    healthy,
+			found: true,
+			exp:   nil,
+		},
+		{
+			name:  "missing",
+			md:    func() banktypes.Metadata { return banktypes.Metadata{} },
+			found: false,
+			exp:   []DenomMetadataProblem{problem(DenomMetadataProblemType_Missing, "no denom metadata found")},
+		},
+		{
+			name: "base mismatch",
+			md: func() banktypes.Metadata {
+				md := healthy()
+				md.Base = "coin"
+				md.DenomUnits[0].Denom = "coin"
+				md.DenomUnits[1].Denom = "megacoin"
+				md.Display = "megacoin"
+				return md
+			},
+			found: true,
+			exp: []DenomMetadataProblem{
+				problem(DenomMetadataProblemType_BaseMismatch, `denom metadata base "coin" does not match the marker denom`),
+			},
+		},
+		{
+			name: "empty display",
+			md: func() banktypes.Metadata {
+				md := healthy()
+				md.Display = ""
+				return md
+			},
+			found: true,
+			exp:   []DenomMetadataProblem{problem(DenomMetadataProblemType_EmptyDisplay, "denom metadata display is empty")},
+		},
+		{
+			name: "no denom units",
+			md: func() banktypes.Metadata {
+				md := healthy()
+				md.DenomUnits = nil
+				return md
+			},
+			found: true,
+			exp:   []DenomMetadataProblem{problem(DenomMetadataProblemType_BrokenExponentChain, "denom metadata has no denom units")},
+		},
+		{
+			name: "base unit has non-zero exponent",
+			md: func() banktypes.Metadata {
+				md := healthy()
+				md.DenomUnits[0].Exponent = 1
+				return md
+			},
+			found: true,
+			exp: []DenomMetadataProblem{
+				problem(DenomMetadataProblemType_BrokenExponentChain, `first denom unit is not the base "nanocoin" with exponent 0`),
+			},
+		},
+		{
+			name: "exponents not increasing",
+			md: func() banktypes.Metadata {
+				md := healthy()
+				md.DenomUnits = append(md.DenomUnits, &banktypes.DenomUnit{Denom: "microcoin", Exponent: 3})
+				return md
+			},
+			found: true,
+			exp: []DenomMetadataProblem{
+				problem(DenomMetadataProblemType_BrokenExponentChain, `denom unit "microcoin" exponent 3 is not greater than the previous exponent 9`),
+			},
+		},
+		{
+			name: "display not a denom unit",
+			md: func() banktypes.Metadata {
+				md := healthy()
+				md.Display = "megacoin"
+				return md
+			},
+			found: true,
+			exp: []DenomMetadataProblem{
+				problem(DenomMetadataProblemType_BrokenExponentChain, `display denom "megacoin" is not one of the denom units`),
+			},
+		},
+		{
+			name: "base mismatch and empty display",
+			md: func() banktypes.Metadata {
+				md := healthy()
+				md.Base = "othercoin"
+				md.Display = ""
+				return md
+			},
+			found: true,
+			exp: []DenomMetadataProblem{
+				problem(DenomMetadataProblemType_BaseMismatch, `denom metadata base "othercoin" does not match the marker denom`),
+				problem(DenomMetadataProblemType_EmptyDisplay, "denom metadata display is empty"),
+				problem(DenomMetadataProblemType_BrokenExponentChain, `first denom unit is not the base "othercoin" with exponent 0`),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			actual := CheckDenomMetadata("nanocoin", tc.md(), tc.found)
+			s.Assert().Equal(tc.exp, actual, "CheckDenomMetadata result")
+		})
+	}
+}
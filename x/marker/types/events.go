@@ -55,22 +55,43 @@ func NewEventMarkerAdd(denom string, address string, amount string, status strin
 }
 
 func NewEventMarkerAddAccess(accessGrant AccessGrantI, denom string, administrator string) *EventMarkerAddAccess {
+	return &EventMarkerAddAccess{
+		Access:        newEventMarkerAccess(accessGrant),
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerAddAccessBulk creates a new EventMarkerAddAccessBulk for the provided access grants.
+func NewEventMarkerAddAccessBulk(grants []AccessGrant, denom string, administrator string) *EventMarkerAddAccessBulk {
+	return &EventMarkerAddAccessBulk{
+		Access:        newEventMarkerAccessList(grants),
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+// newEventMarkerAccess creates a new EventMarkerAccess for the provided access grant.
+func newEventMarkerAccess(accessGrant AccessGrantI) EventMarkerAccess {
 	accessList := accessGrant.GetAccessList()
 	permissions := make([]string, len(accessList))
 	for i, permission := range accessList {
 		permissions[i] = permission.String()
 	}
 
-	access := EventMarkerAccess{
+	return EventMarkerAccess{
 		Address:     accessGrant.GetAddress().String(),
 		Permissions: permissions,
 	}
+}
 
-	return &EventMarkerAddAccess{
-		Access:        access,
-		Denom:         denom,
-		Administrator: administrator,
+// newEventMarkerAccessList creates an EventMarkerAccess for each of the provided access grants.
+func newEventMarkerAccessList(grants []AccessGrant) []EventMarkerAccess {
+	rv := make([]EventMarkerAccess, len(grants))
+	for i := range grants {
+		rv[i] = newEventMarkerAccess(&grants[i])
 	}
+	return rv
 }
 
 func NewEventMarkerDeleteAccess(removeAddress string, denom string, administrator string) *EventMarkerDeleteAccess {
@@ -81,6 +102,15 @@ func NewEventMarkerDeleteAccess(removeAddress string, denom string, administrato
 	}
 }
 
+// NewEventMarkerDeleteAccessBulk creates a new EventMarkerDeleteAccessBulk for the provided revoked access.
+func NewEventMarkerDeleteAccessBulk(revoked []AccessGrant, denom string, administrator string) *EventMarkerDeleteAccessBulk {
+	return &EventMarkerDeleteAccessBulk{
+		Access:        newEventMarkerAccessList(revoked),
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
 func NewEventMarkerFinalize(denom string, administrator string) *EventMarkerFinalize {
 	return &EventMarkerFinalize{
 		Denom:         denom,
@@ -184,6 +214,24 @@ func NewEventSetNetAssetValue(denom string, price sdk.Coin, volume uint64, sourc
 	}
 }
 
+// NewEventMarkerAccountDataUpdated returns a new instance of EventMarkerAccountDataUpdated
+func NewEventMarkerAccountDataUpdated(denom string, oldLength, newLength int, setter string) *EventMarkerAccountDataUpdated {
+	return &EventMarkerAccountDataUpdated{
+		Denom:     denom,
+		OldLength: strconv.Itoa(oldLength),
+		NewLength: strconv.Itoa(newLength),
+		Setter:    setter,
+	}
+}
+
+// NewEventMarkerAccessListsNormalized returns a new instance of EventMarkerAccessListsNormalized
+func NewEventMarkerAccessListsNormalized(markers, mergedGrants int) *EventMarkerAccessListsNormalized {
+	return &EventMarkerAccessListsNormalized{
+		Markers:      strconv.Itoa(markers),
+		MergedGrants: strconv.Itoa(mergedGrants),
+	}
+}
+
 // NewEventMarkerParamsUpdated returns a new instance of EventMarkerParamsUpdated
 func NewEventMarkerParamsUpdated(allowGovControl bool, denomRegex string, maxSupply sdkmath.Int) *EventMarkerParamsUpdated {
 	return &EventMarkerParamsUpdated{
@@ -192,3 +240,23 @@ func NewEventMarkerParamsUpdated(allowGovControl bool, denomRegex string, maxSup
 		MaxSupply:              maxSupply.String(),
 	}
 }
+
+// NewEventMarkerHoldingThresholdCrossed returns a new instance of EventMarkerHoldingThresholdCrossed
+func NewEventMarkerHoldingThresholdCrossed(denom string, address string, crossing HoldingThresholdCrossing, balance, supply sdkmath.Int) *EventMarkerHoldingThresholdCrossed {
+	return &EventMarkerHoldingThresholdCrossed{
+		Denom:     denom,
+		Address:   address,
+		Threshold: strconv.FormatUint(uint64(crossing.BasisPoints), 10),
+		Direction: crossing.Direction,
+		Balance:   balance.String(),
+		Supply:    supply.String(),
+	}
+}
+
+// NewEventMarkersBulkUpdated returns a new instance of EventMarkersBulkUpdated
+func NewEventMarkersBulkUpdated(denoms []string, authority string) *EventMarkersBulkUpdated {
+	return &EventMarkersBulkUpdated{
+		Denoms:    denoms,
+		Authority: authority,
+	}
+}
@@ -37,6 +37,7 @@ type BankKeeper interface {
 	GetBalance(context context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetSupply(context context.Context, denom string) sdk.Coin
 	DenomOwners(context context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error)
+	AllBalances(context context.Context, req *banktypes.QueryAllBalancesRequest) (*banktypes.QueryAllBalancesResponse, error)
 
 	SendCoins(context context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(context context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
@@ -46,6 +47,7 @@ type BankKeeper interface {
 
 	AppendSendRestriction(restriction banktypes.SendRestrictionFn)
 	BlockedAddr(addr sdk.AccAddress) bool
+	IsSendEnabledDenom(ctx context.Context, denom string) bool
 
 	GetDenomMetaData(context context.Context, denom string) (banktypes.Metadata, bool)
 	SetDenomMetaData(context context.Context, denomMetaData banktypes.Metadata)
@@ -2,8 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
@@ -30,10 +32,48 @@ func (state GenesisState) Validate() error {
 			}
 		}
 	}
+	for _, mht := range state.HoldingThresholds {
+		if _, err := sdk.AccAddressFromBech32(mht.Address); err != nil {
+			return fmt.Errorf("invalid holding thresholds marker address %q: %w", mht.Address, err)
+		}
+		if err := ValidateHoldingThresholds(mht.BasisPoints); err != nil {
+			return fmt.Errorf("invalid holding thresholds for %s: %w", mht.Address, err)
+		}
+	}
+	for _, mnb := range state.NetAssetValueBounds {
+		if _, err := sdk.AccAddressFromBech32(mnb.Address); err != nil {
+			return fmt.Errorf("invalid net asset value bounds marker address %q: %w", mnb.Address, err)
+		}
+	}
 
 	return nil
 }
 
+// ConcatGenesisStates combines several genesis states (e.g. the chunks of a chunked export) into one.
+// The params are taken from the first state. The markers, net asset values, deny-send addresses,
+// holding thresholds, and net asset value bounds of each state are appended in the order provided.
+func ConcatGenesisStates(states ...*GenesisState) *GenesisState {
+	if len(states) == 0 {
+		return DefaultGenesisState()
+	}
+
+	rv := NewGenesisState(states[0].Params, nil, nil, nil)
+	for _, state := range states {
+		rv.AppendEntries(state)
+	}
+	return rv
+}
+
+// AppendEntries appends the markers, net asset values, deny-send addresses, holding thresholds,
+// and net asset value bounds of the provided state to this one. The params are not changed.
+func (state *GenesisState) AppendEntries(other *GenesisState) {
+	state.Markers = append(state.Markers, other.Markers...)
+	state.NetAssetValues = append(state.NetAssetValues, other.NetAssetValues...)
+	state.DenySendAddresses = append(state.DenySendAddresses, other.DenySendAddresses...)
+	state.HoldingThresholds = append(state.HoldingThresholds, other.HoldingThresholds...)
+	state.NetAssetValueBounds = append(state.NetAssetValueBounds, other.NetAssetValueBounds...)
+}
+
 // DefaultGenesisState returns the initial module genesis state.
 func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(DefaultParams(), []MarkerAccount{}, []DenySendAddress{}, []MarkerNetAssetValues{})
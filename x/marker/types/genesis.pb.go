@@ -33,6 +33,10 @@ type GenesisState struct {
 	NetAssetValues []MarkerNetAssetValues `protobuf:"bytes,3,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
 	// list of denom based denied send addresses
 	DenySendAddresses []DenySendAddress `protobuf:"bytes,4,rep,name=deny_send_addresses,json=denySendAddresses,proto3" json:"deny_send_addresses"`
+	// list of marker holding thresholds
+	HoldingThresholds []MarkerHoldingThresholds `protobuf:"bytes,5,rep,name=holding_thresholds,json=holdingThresholds,proto3" json:"holding_thresholds"`
+	// list of marker net asset value bounds
+	NetAssetValueBounds []MarkerNetAssetValueBounds `protobuf:"bytes,6,rep,name=net_asset_value_bounds,json=netAssetValueBounds,proto3" json:"net_asset_value_bounds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,10 +154,94 @@ func (m *MarkerNetAssetValues) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarkerNetAssetValues proto.InternalMessageInfo
 
+// MarkerHoldingThresholds defines the holding thresholds for a marker
+type MarkerHoldingThresholds struct {
+	// address defines the marker address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// basis_points are the thresholds (in basis points of the marker's supply) in ascending order.
+	BasisPoints []uint32 `protobuf:"varint,2,rep,packed,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+}
+
+func (m *MarkerHoldingThresholds) Reset()         { *m = MarkerHoldingThresholds{} }
+func (m *MarkerHoldingThresholds) String() string { return proto.CompactTextString(m) }
+func (*MarkerHoldingThresholds) ProtoMessage()    {}
+func (*MarkerHoldingThresholds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{3}
+}
+func (m *MarkerHoldingThresholds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkerHoldingThresholds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkerHoldingThresholds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarkerHoldingThresholds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkerHoldingThresholds.Merge(m, src)
+}
+func (m *MarkerHoldingThresholds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkerHoldingThresholds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkerHoldingThresholds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkerHoldingThresholds proto.InternalMessageInfo
+
+// MarkerNetAssetValueBounds defines the net asset value bounds for a marker
+type MarkerNetAssetValueBounds struct {
+	// address defines the marker address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// max_change_basis_points is the largest change (in basis points) allowed in a net asset value update.
+	MaxChangeBasisPoints uint32 `protobuf:"varint,2,opt,name=max_change_basis_points,json=maxChangeBasisPoints,proto3" json:"max_change_basis_points,omitempty"`
+}
+
+func (m *MarkerNetAssetValueBounds) Reset()         { *m = MarkerNetAssetValueBounds{} }
+func (m *MarkerNetAssetValueBounds) String() string { return proto.CompactTextString(m) }
+func (*MarkerNetAssetValueBounds) ProtoMessage()    {}
+func (*MarkerNetAssetValueBounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{4}
+}
+func (m *MarkerNetAssetValueBounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkerNetAssetValueBounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkerNetAssetValueBounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarkerNetAssetValueBounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkerNetAssetValueBounds.Merge(m, src)
+}
+func (m *MarkerNetAssetValueBounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkerNetAssetValueBounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkerNetAssetValueBounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkerNetAssetValueBounds proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.marker.v1.GenesisState")
 	proto.RegisterType((*DenySendAddress)(nil), "provenance.marker.v1.DenySendAddress")
 	proto.RegisterType((*MarkerNetAssetValues)(nil), "provenance.marker.v1.MarkerNetAssetValues")
+	proto.RegisterType((*MarkerHoldingThresholds)(nil), "provenance.marker.v1.MarkerHoldingThresholds")
+	proto.RegisterType((*MarkerNetAssetValueBounds)(nil), "provenance.marker.v1.MarkerNetAssetValueBounds")
 }
 
 func init() {
@@ -161,33 +249,41 @@ func init() {
 }
 
 var fileDescriptor_5dcc4ab7c9d2f78f = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0xaa, 0xda, 0x40,
-	0x18, 0x86, 0x13, 0x15, 0x6d, 0x47, 0x6b, 0xdb, 0xa9, 0xd0, 0x20, 0x25, 0xfe, 0x14, 0x41, 0x0a,
-	0x4d, 0xd0, 0xee, 0xdc, 0x69, 0x0b, 0x5d, 0xb5, 0x88, 0x42, 0x17, 0x76, 0x11, 0xc6, 0xe4, 0x23,
-	0x0d, 0xad, 0x33, 0x21, 0x33, 0x86, 0x7a, 0x07, 0xdd, 0xb5, 0x97, 0xe0, 0xe5, 0xb8, 0x94, 0xae,
-	0xce, 0xea, 0x70, 0xd0, 0xcd, 0xb9, 0x8c, 0x83, 0x93, 0x04, 0xcd, 0x61, 0x70, 0x37, 0xf3, 0xf1,
-	0xbc, 0xcf, 0x3b, 0x99, 0x0c, 0xea, 0x86, 0x11, 0x8b, 0x81, 0x12, 0xea, 0x82, 0xbd, 0x22, 0xd1,
-	0x4f, 0x88, 0xec, 0x78, 0x60, 0xfb, 0x40, 0x81, 0x07, 0xdc, 0x0a, 0x23, 0x26, 0x18, 0x6e, 0x9c,
-	0x19, 0x2b, 0x61, 0xac, 0x78, 0xd0, 0x6c, 0xf8, 0xcc, 0x67, 0x12, 0xb0, 0x4f, 0xab, 0x84, 0x6d,
-	0x76, 0x94, 0xbe, 0x34, 0x25, 0x91, 0xee, 0xff, 0x02, 0xaa, 0x7d, 0x4e, 0x0a, 0xe6, 0x82, 0x08,
-	0xc0, 0x23, 0x54, 0x0e, 0x49, 0x44, 0x56, 0xdc, 0xd0, 0xdb, 0x7a, 0xbf, 0x3a, 0x7c, 0x63, 0xa9,
-	0x0a, 0xad, 0xa9, 0x64, 0x26, 0xa5, 0xdd, 0x6d, 0x4b, 0x9b, 0xa5, 0x09, 0xfc, 0x11, 0x55, 0x12,
-	0x82, 0x1b, 0x85, 0x76, 0xb1, 0x5f, 0x1d, 0xbe, 0x55, 0x87, 0xbf, 0xc8, 0xd5, 0xd8, 0x75, 0xd9,
-	0x9a, 0x8a, 0xd4, 0x91, 0x25, 0xf1, 0x02, 0xbd, 0xa0, 0x20, 0x1c, 0xc2, 0x39, 0x08, 0x27, 0x26,
-	0xbf, 0xd6, 0xc0, 0x8d, 0xa2, 0xb4, 0xbd, 0xbb, 0x66, 0xfb, 0x0a, 0x62, 0x7c, 0x8a, 0x7c, 0x93,
-	0x89, 0x54, 0x5a, 0xa7, 0xb9, 0x29, 0xfe, 0x8e, 0x5e, 0x79, 0x40, 0x37, 0x0e, 0x07, 0xea, 0x39,
-	0xc4, 0xf3, 0x22, 0xe0, 0x1c, 0xb8, 0x51, 0x92, 0xfa, 0x9e, 0x5a, 0xff, 0x09, 0xe8, 0x66, 0x0e,
-	0xd4, 0x1b, 0x27, 0x78, 0x6a, 0x7e, 0xe9, 0xe5, 0xc7, 0xc0, 0x47, 0x4f, 0xfe, 0x6c, 0x5b, 0xda,
-	0xfd, 0xb6, 0xa5, 0x75, 0x01, 0x3d, 0x7f, 0x94, 0xc2, 0x3d, 0x54, 0x4f, 0x94, 0x59, 0xad, 0xbc,
-	0xde, 0xa7, 0xb3, 0x67, 0xc9, 0x34, 0xc3, 0x3a, 0xa8, 0x26, 0x0f, 0x98, 0x41, 0x05, 0x09, 0x55,
-	0x4f, 0xb3, 0x14, 0xb9, 0xa8, 0xf9, 0xab, 0xa3, 0x86, 0xea, 0xe3, 0xb1, 0x81, 0x2a, 0xf9, 0x96,
-	0x6c, 0x8b, 0xe7, 0x8a, 0xcb, 0xbd, 0xfa, 0xab, 0x72, 0x66, 0xf5, 0xad, 0x9e, 0x4f, 0x34, 0xf1,
-	0x77, 0x07, 0x53, 0xdf, 0x1f, 0x4c, 0xfd, 0xee, 0x60, 0xea, 0xff, 0x8e, 0xa6, 0xb6, 0x3f, 0x9a,
-	0xda, 0xcd, 0xd1, 0xd4, 0xd0, 0xeb, 0x80, 0x29, 0x0b, 0xa6, 0xfa, 0x62, 0xe8, 0x07, 0xe2, 0xc7,
-	0x7a, 0x69, 0xb9, 0x6c, 0x65, 0x9f, 0x91, 0xf7, 0x01, 0xbb, 0xd8, 0xd9, 0xbf, 0xb3, 0x07, 0x2c,
-	0x36, 0x21, 0xf0, 0x65, 0x59, 0xbe, 0xde, 0x0f, 0x0f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x2d,
-	0x05, 0xd5, 0x32, 0x03, 0x00, 0x00,
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x4f, 0xb6, 0xd1, 0x81, 0xdb, 0x0e, 0xf0, 0x2a, 0x1a, 0x26, 0x94, 0xae, 0x45, 0x93, 0x2a,
+	0xa4, 0x25, 0x5a, 0x11, 0x97, 0xdd, 0xda, 0x21, 0xc1, 0x05, 0x54, 0xb5, 0x88, 0xc3, 0x38, 0x04,
+	0x27, 0x79, 0x4a, 0x02, 0x8b, 0x1d, 0xc5, 0x6e, 0xd4, 0x7e, 0x03, 0x6e, 0xf0, 0x11, 0x26, 0x3e,
+	0xcd, 0x8e, 0x3b, 0x72, 0x42, 0xa8, 0xbd, 0xf0, 0x31, 0x50, 0x9d, 0x84, 0xb5, 0x25, 0x8b, 0xb8,
+	0xd9, 0xcf, 0xbf, 0x3f, 0x4f, 0xbf, 0xf7, 0x64, 0xd4, 0x89, 0x62, 0x96, 0x00, 0x25, 0xd4, 0x01,
+	0x33, 0x24, 0xf1, 0x67, 0x88, 0xcd, 0xe4, 0xc4, 0xf4, 0x80, 0x02, 0x0f, 0xb8, 0x11, 0xc5, 0x4c,
+	0x30, 0xdc, 0xb8, 0xc1, 0x18, 0x29, 0xc6, 0x48, 0x4e, 0x0e, 0x1a, 0x1e, 0xf3, 0x98, 0x04, 0x98,
+	0xcb, 0x53, 0x8a, 0x3d, 0x68, 0x17, 0xea, 0x65, 0x2c, 0x09, 0xe9, 0x7c, 0xdf, 0x41, 0xb5, 0x57,
+	0xa9, 0xc1, 0x58, 0x10, 0x01, 0xf8, 0x14, 0x55, 0x22, 0x12, 0x93, 0x90, 0x6b, 0xea, 0xa1, 0xda,
+	0xad, 0xf6, 0x9e, 0x18, 0x45, 0x86, 0xc6, 0x50, 0x62, 0x06, 0x3b, 0x57, 0x3f, 0x5b, 0xca, 0x28,
+	0x63, 0xe0, 0x33, 0xb4, 0x9b, 0x22, 0xb8, 0xb6, 0x75, 0xb8, 0xdd, 0xad, 0xf6, 0x9e, 0x16, 0x93,
+	0xdf, 0xc8, 0x53, 0xdf, 0x71, 0xd8, 0x84, 0x8a, 0x4c, 0x23, 0x67, 0xe2, 0x73, 0xf4, 0x80, 0x82,
+	0xb0, 0x08, 0xe7, 0x20, 0xac, 0x84, 0x5c, 0x4c, 0x80, 0x6b, 0xdb, 0x52, 0xed, 0x59, 0x99, 0xda,
+	0x5b, 0x10, 0xfd, 0x25, 0xe5, 0xbd, 0x64, 0x64, 0xa2, 0x7b, 0x74, 0xad, 0x8a, 0x3f, 0xa0, 0x7d,
+	0x17, 0xe8, 0xcc, 0xe2, 0x40, 0x5d, 0x8b, 0xb8, 0x6e, 0x0c, 0x9c, 0x03, 0xd7, 0x76, 0xa4, 0xfc,
+	0x51, 0xb1, 0xfc, 0x4b, 0xa0, 0xb3, 0x31, 0x50, 0xb7, 0x9f, 0xc2, 0x33, 0xe5, 0x87, 0xee, 0x7a,
+	0x19, 0x38, 0xb6, 0x11, 0xf6, 0xd9, 0x85, 0x1b, 0x50, 0xcf, 0x12, 0x7e, 0x0c, 0x7c, 0x79, 0xe1,
+	0xda, 0x1d, 0xa9, 0x7d, 0x5c, 0xd6, 0xfa, 0xeb, 0x94, 0xf5, 0xee, 0x2f, 0x29, 0xf7, 0xf0, 0x37,
+	0x1f, 0xf0, 0x27, 0xf4, 0x68, 0x23, 0x1c, 0xcb, 0x66, 0x13, 0xea, 0x72, 0xad, 0x22, 0x7d, 0xcc,
+	0xff, 0x8e, 0x68, 0x20, 0x69, 0x99, 0xd3, 0x3e, 0xfd, 0xf7, 0xe9, 0xf4, 0xee, 0x97, 0xcb, 0x96,
+	0xf2, 0xfb, 0xb2, 0xa5, 0x74, 0x00, 0xdd, 0xdf, 0x48, 0x01, 0x1f, 0xa1, 0xbd, 0x54, 0x3e, 0x8f,
+	0x51, 0xae, 0xcb, 0xbd, 0x51, 0x3d, 0xad, 0xe6, 0xb0, 0x36, 0xaa, 0xc9, 0xc0, 0x73, 0xd0, 0x96,
+	0x04, 0x55, 0x97, 0xb5, 0x0c, 0xb2, 0x62, 0xf3, 0x55, 0x45, 0x8d, 0xa2, 0x61, 0x62, 0x0d, 0xed,
+	0xae, 0xbb, 0xe4, 0x57, 0x3c, 0x2e, 0x58, 0x96, 0xd2, 0xd5, 0x5b, 0xcf, 0xa0, 0x70, 0x4b, 0x56,
+	0x3a, 0xfa, 0x88, 0x9a, 0xb7, 0x8c, 0xa8, 0xa4, 0xa7, 0x36, 0xaa, 0xd9, 0x84, 0x07, 0xdc, 0x8a,
+	0x58, 0x40, 0x45, 0xda, 0x4f, 0x7d, 0x54, 0x95, 0xb5, 0xa1, 0x2c, 0xad, 0x38, 0x24, 0xe8, 0xf1,
+	0xad, 0xc3, 0x29, 0xf1, 0x78, 0x81, 0x9a, 0x21, 0x99, 0x5a, 0x8e, 0x4f, 0xa8, 0x07, 0xd6, 0x86,
+	0x9d, 0xda, 0xad, 0x8f, 0x1a, 0x21, 0x99, 0x9e, 0xc9, 0xd7, 0x41, 0x91, 0xef, 0xc0, 0xbb, 0x9a,
+	0xeb, 0xea, 0xf5, 0x5c, 0x57, 0x7f, 0xcd, 0x75, 0xf5, 0xdb, 0x42, 0x57, 0xae, 0x17, 0xba, 0xf2,
+	0x63, 0xa1, 0x2b, 0xa8, 0x19, 0xb0, 0xc2, 0xe8, 0x86, 0xea, 0x79, 0xcf, 0x0b, 0x84, 0x3f, 0xb1,
+	0x0d, 0x87, 0x85, 0xe6, 0x0d, 0xe4, 0x38, 0x60, 0x2b, 0x37, 0x73, 0x9a, 0x7f, 0x35, 0x62, 0x16,
+	0x01, 0xb7, 0x2b, 0xf2, 0x9f, 0x79, 0xfe, 0x67, 0x00, 0x5c, 0xaf, 0x2b, 0x51, 0xdc, 0x04, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -210,6 +306,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NetAssetValueBounds) > 0 {
+		for iNdEx := len(m.NetAssetValueBounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValueBounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.HoldingThresholds) > 0 {
+		for iNdEx := len(m.HoldingThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HoldingThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.DenySendAddresses) > 0 {
 		for iNdEx := len(m.DenySendAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -346,6 +470,89 @@ func (m *MarkerNetAssetValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MarkerHoldingThresholds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerHoldingThresholds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerHoldingThresholds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BasisPoints) > 0 {
+		dAtA3 := make([]byte, len(m.BasisPoints)*10)
+		var j2 int
+		for _, num := range m.BasisPoints {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGenesis(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkerNetAssetValueBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerNetAssetValueBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerNetAssetValueBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxChangeBasisPoints != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxChangeBasisPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -383,6 +590,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.HoldingThresholds) > 0 {
+		for _, e := range m.HoldingThresholds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NetAssetValueBounds) > 0 {
+		for _, e := range m.NetAssetValueBounds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -422,6 +641,42 @@ func (m *MarkerNetAssetValues) Size() (n int) {
 	return n
 }
 
+func (m *MarkerHoldingThresholds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.BasisPoints) > 0 {
+		l = 0
+		for _, e := range m.BasisPoints {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MarkerNetAssetValueBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MaxChangeBasisPoints != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxChangeBasisPoints))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -592,6 +847,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldingThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HoldingThresholds = append(m.HoldingThresholds, MarkerHoldingThresholds{})
+			if err := m.HoldingThresholds[len(m.HoldingThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValueBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAssetValueBounds = append(m.NetAssetValueBounds, MarkerNetAssetValueBounds{})
+			if err := m.NetAssetValueBounds[len(m.NetAssetValueBounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -843,6 +1166,265 @@ func (m *MarkerNetAssetValues) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MarkerHoldingThresholds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkerHoldingThresholds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkerHoldingThresholds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BasisPoints = append(m.BasisPoints, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BasisPoints) == 0 {
+					m.BasisPoints = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BasisPoints = append(m.BasisPoints, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkerNetAssetValueBounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkerNetAssetValueBounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkerNetAssetValueBounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeBasisPoints", wireType)
+			}
+			m.MaxChangeBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChangeBasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+const (
+	// MaxHoldingThresholds is the maximum number of holding thresholds a marker can have.
+	MaxHoldingThresholds = 10
+	// BasisPointsPerWhole is the number of basis points that make up an entire supply.
+	BasisPointsPerWhole = 10_000
+
+	// HoldingThresholdDirectionUp is the direction of a crossing where a share went from below a threshold to at or above it.
+	HoldingThresholdDirectionUp = "up"
+	// HoldingThresholdDirectionDown is the direction of a crossing where a share went from at or above a threshold to below it.
+	HoldingThresholdDirectionDown = "down"
+)
+
+// HoldingThresholdCrossing identifies a holding threshold that was crossed, and in which direction.
+type HoldingThresholdCrossing struct {
+	BasisPoints uint32
+	Direction   string
+}
+
+// ValidateHoldingThresholds makes sure that there aren't too many thresholds, that each is
+// more than zero and less than the whole supply, and that they are in ascending order without duplicates.
+func ValidateHoldingThresholds(basisPoints []uint32) error {
+	if len(basisPoints) > MaxHoldingThresholds {
+		return fmt.Errorf("too many holding thresholds %d: cannot have more than %d", len(basisPoints), MaxHoldingThresholds)
+	}
+	for i, bp := range basisPoints {
+		if bp == 0 || bp >= BasisPointsPerWhole {
+			return fmt.Errorf("invalid holding threshold %d: must be between 1 and %d (inclusive)", bp, BasisPointsPerWhole-1)
+		}
+		if i > 0 && bp <= basisPoints[i-1] {
+			return errors.New("holding thresholds must be in ascending order without duplicates")
+		}
+	}
+	return nil
+}
+
+// CrossedHoldingThresholds returns the thresholds crossed when a balance and supply change from the pre values to the post values.
+// A share is at or above a threshold when balance * 10,000 >= threshold * supply. A zero supply is never at or above a threshold.
+// Only multiplications are used, so it's cheap and doesn't have any rounding issues.
+func CrossedHoldingThresholds(basisPoints []uint32, preBal, preSupply, postBal, postSupply sdkmath.Int) []HoldingThresholdCrossing {
+	var rv []HoldingThresholdCrossing
+	for _, bp := range basisPoints {
+		wasAtOrAbove := isAtOrAboveThreshold(bp, preBal, preSupply)
+		isAtOrAbove := isAtOrAboveThreshold(bp, postBal, postSupply)
+		switch {
+		case !wasAtOrAbove && isAtOrAbove:
+			rv = append(rv, HoldingThresholdCrossing{BasisPoints: bp, Direction: HoldingThresholdDirectionUp})
+		case wasAtOrAbove && !isAtOrAbove:
+			rv = append(rv, HoldingThresholdCrossing{BasisPoints: bp, Direction: HoldingThresholdDirectionDown})
+		}
+	}
+	return rv
+}
+
+// isAtOrAboveThreshold returns true if the balance is at least the provided basis points of the supply.
+func isAtOrAboveThreshold(basisPoints uint32, balance, supply sdkmath.Int) bool {
+	if supply.IsNil() || !supply.IsPositive() || balance.IsNil() {
+		return false
+	}
+	return balance.MulRaw(BasisPointsPerWhole).GTE(supply.MulRaw(int64(basisPoints)))
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestValidateHoldingThresholds(t *testing.T) {
+	tests := []struct {
+		name        string
+		basisPoints []uint32
+		expErr      string
+	}{
+		{name: "nil", basisPoints: nil},
+		{name: "one", basisPoints: []uint32{2500}},
+		{name: "min and max", basisPoints: []uint32{1, 9999}},
+		{name: "max count", basisPoints: []uint32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+		{
+			name:        "too many",
+			basisPoints: []uint32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+			expErr:      "too many holding thresholds 11: cannot have more than 10",
+		},
+		{
+			name:        "zero",
+			basisPoints: []uint32{0, 2500},
+			expErr:      "invalid holding threshold 0: must be between 1 and 9999 (inclusive)",
+		},
+		{
+			name:        "whole supply",
+			basisPoints: []uint32{2500, 10000},
+			expErr:      "invalid holding threshold 10000: must be between 1 and 9999 (inclusive)",
+		},
+		{
+			name:        "out of order",
+			basisPoints: []uint32{5000, 2500},
+			expErr:      "holding thresholds must be in ascending order without duplicates",
+		},
+		{
+			name:        "duplicate",
+			basisPoints: []uint32{2500, 2500},
+			expErr:      "holding thresholds must be in ascending order without duplicates",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateHoldingThresholds(tc.basisPoints)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateHoldingThresholds error")
+			} else {
+				assert.NoError(t, err, "ValidateHoldingThresholds error")
+			}
+		})
+	}
+}
+
+func TestCrossedHoldingThresholds(t *testing.T) {
+	up := func(bp uint32) HoldingThresholdCrossing {
+		return HoldingThresholdCrossing{BasisPoints: bp, Direction: HoldingThresholdDirectionUp}
+	}
+	down := func(bp uint32) HoldingThresholdCrossing {
+		return HoldingThresholdCrossing{BasisPoints: bp, Direction: HoldingThresholdDirectionDown}
+	}
+	i := sdkmath.NewInt
+	thresholds := []uint32{2500, 5000}
+
+	tests := []struct {
+		name       string
+		preBal     sdkmath.Int
+		preSupply  sdkmath.Int
+		postBal    sdkmath.Int
+		postSupply sdkmath.Int
+		exp        []HoldingThresholdCrossing
+	}{
+		{name: "no change", preBal: i(10), preSupply: i(100), postBal: i(10), postSupply: i(100)},
+		{name: "increase below first", preBal: i(10), preSupply: i(100), postBal: i(24), postSupply: i(100)},
+		{name: "increase to exactly first", preBal: i(10), preSupply: i(100), postBal: i(25), postSupply: i(100), exp: []HoldingThresholdCrossing{up(2500)}},
+		{name: "increase past both", preBal: i(0), preSupply: i(100), postBal: i(60), postSupply: i(100), exp: []HoldingThresholdCrossing{up(2500), up(5000)}},
+		{name: "decrease below first", preBal: i(25), preSupply: i(100), postBal: i(24), postSupply: i(100), exp: []HoldingThresholdCrossing{down(2500)}},
+		{name: "decrease past both", preBal: i(50), preSupply: i(100), postBal: i(0), postSupply: i(100), exp: []HoldingThresholdCrossing{down(2500), down(5000)}},
+		{name: "supply increase drops share", preBal: i(30), preSupply: i(100), postBal: i(30), postSupply: i(200), exp: []HoldingThresholdCrossing{down(2500)}},
+		{name: "supply decrease raises share", preBal: i(30), preSupply: i(100), postBal: i(30), postSupply: i(60), exp: []HoldingThresholdCrossing{up(5000)}},
+		{name: "zero pre supply", preBal: i(0), preSupply: i(0), postBal: i(30), postSupply: i(30), exp: []HoldingThresholdCrossing{up(2500), up(5000)}},
+		{name: "zero post supply", preBal: i(30), preSupply: i(30), postBal: i(0), postSupply: i(0), exp: []HoldingThresholdCrossing{down(2500), down(5000)}},
+		{
+			name:       "huge amounts",
+			preBal:     sdkmath.NewIntFromUint64(1).MulRaw(1_000_000_000_000_000_000),
+			preSupply:  sdkmath.NewIntFromUint64(4).MulRaw(1_000_000_000_000_000_000),
+			postBal:    sdkmath.NewIntFromUint64(1).MulRaw(1_000_000_000_000_000_000).SubRaw(1),
+			postSupply: sdkmath.NewIntFromUint64(4).MulRaw(1_000_000_000_000_000_000),
+			exp:        []HoldingThresholdCrossing{down(2500)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act := CrossedHoldingThresholds(thresholds, tc.preBal, tc.preSupply, tc.postBal, tc.postSupply)
+			assert.Equal(t, tc.exp, act, "CrossedHoldingThresholds(%s, %s, %s, %s)",
+				tc.preBal, tc.preSupply, tc.postBal, tc.postSupply)
+		})
+	}
+
+	t.Run("no thresholds", func(t *testing.T) {
+		act := CrossedHoldingThresholds(nil, i(0), i(100), i(100), i(100))
+		assert.Empty(t, act, "CrossedHoldingThresholds with no thresholds")
+	})
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MarkerHooks defines the functions that are called after marker lifecycle actions.
+// They are called synchronously, after the state changes of the action have been made.
+type MarkerHooks interface {
+	// AfterMarkerActivated is called after a marker has been activated.
+	AfterMarkerActivated(ctx sdk.Context, marker MarkerAccountI, caller sdk.Address)
+	// AfterMarkerMinted is called after coins have been minted for a marker.
+	AfterMarkerMinted(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
+	// AfterMarkerBurned is called after coins of a marker have been burned.
+	AfterMarkerBurned(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
+	// AfterMarkerAccessChanged is called after the access of an address has been granted or revoked on a marker.
+	// The provided marker has the updated access list.
+	AfterMarkerAccessChanged(ctx sdk.Context, marker MarkerAccountI, caller, addr sdk.AccAddress)
+}
+
+var _ MarkerHooks = NoOpMarkerHooks{}
+
+// NoOpMarkerHooks is a MarkerHooks that does nothing. It can be embedded in
+// an implementation that only cares about some of the hooks.
+type NoOpMarkerHooks struct{}
+
+// AfterMarkerActivated does nothing.
+func (NoOpMarkerHooks) AfterMarkerActivated(sdk.Context, MarkerAccountI, sdk.Address) {}
+
+// AfterMarkerMinted does nothing.
+func (NoOpMarkerHooks) AfterMarkerMinted(sdk.Context, sdk.AccAddress, sdk.Coin) {}
+
+// AfterMarkerBurned does nothing.
+func (NoOpMarkerHooks) AfterMarkerBurned(sdk.Context, sdk.AccAddress, sdk.Coin) {}
+
+// AfterMarkerAccessChanged does nothing.
+func (NoOpMarkerHooks) AfterMarkerAccessChanged(sdk.Context, MarkerAccountI, sdk.AccAddress, sdk.AccAddress) {
+}
+
+var _ MarkerHooks = MultiMarkerHooks{}
+
+// MultiMarkerHooks combines multiple MarkerHooks, calling each of them in order.
+type MultiMarkerHooks []MarkerHooks
+
+// NewMultiMarkerHooks creates a new MultiMarkerHooks with the provided hooks.
+func NewMultiMarkerHooks(hooks ...MarkerHooks) MultiMarkerHooks {
+	return hooks
+}
+
+// AfterMarkerActivated calls AfterMarkerActivated on each of the hooks.
+func (h MultiMarkerHooks) AfterMarkerActivated(ctx sdk.Context, marker MarkerAccountI, caller sdk.Address) {
+	for _, hook := range h {
+		hook.AfterMarkerActivated(ctx, marker, caller)
+	}
+}
+
+// AfterMarkerMinted calls AfterMarkerMinted on each of the hooks.
+func (h MultiMarkerHooks) AfterMarkerMinted(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin) {
+	for _, hook := range h {
+		hook.AfterMarkerMinted(ctx, caller, coin)
+	}
+}
+
+// AfterMarkerBurned calls AfterMarkerBurned on each of the hooks.
+func (h MultiMarkerHooks) AfterMarkerBurned(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin) {
+	for _, hook := range h {
+		hook.AfterMarkerBurned(ctx, caller, coin)
+	}
+}
+
+// AfterMarkerAccessChanged calls AfterMarkerAccessChanged on each of the hooks.
+func (h MultiMarkerHooks) AfterMarkerAccessChanged(ctx sdk.Context, marker MarkerAccountI, caller, addr sdk.AccAddress) {
+	for _, hook := range h {
+		hook.AfterMarkerAccessChanged(ctx, marker, caller, addr)
+	}
+}
@@ -35,6 +35,12 @@ var (
 
 	// MarkerParamStoreKey key for marker module's params
 	MarkerParamStoreKey = []byte{0x05}
+
+	// HoldingThresholdsPrefix prefix for holding thresholds of markers
+	HoldingThresholdsPrefix = []byte{0x06}
+
+	// NetAssetValueBoundsPrefix prefix for net asset value bounds of markers
+	NetAssetValueBoundsPrefix = []byte{0x07}
 )
 
 // MarkerAddress returns the module account address for the given denomination
@@ -103,3 +109,25 @@ func GetMarkerFromNetAssetValueKey(key []byte) sdk.AccAddress {
 	markerAddr := sdk.AccAddress(key[2 : markerKeyLen+2])
 	return markerAddr
 }
+
+// HoldingThresholdsKey returns key [prefix][marker address] for marker holding thresholds
+func HoldingThresholdsKey(markerAddr sdk.AccAddress) []byte {
+	return append(HoldingThresholdsPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// GetMarkerFromHoldingThresholdsKey returns the marker address in the HoldingThresholds key.
+func GetMarkerFromHoldingThresholdsKey(key []byte) sdk.AccAddress {
+	markerKeyLen := key[1]
+	return sdk.AccAddress(key[2 : markerKeyLen+2])
+}
+
+// NetAssetValueBoundsKey returns key [prefix][marker address] for marker net asset value bounds
+func NetAssetValueBoundsKey(markerAddr sdk.AccAddress) []byte {
+	return append(NetAssetValueBoundsPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// GetMarkerFromNetAssetValueBoundsKey returns the marker address in the NetAssetValueBounds key.
+func GetMarkerFromNetAssetValueBoundsKey(key []byte) sdk.AccAddress {
+	markerKeyLen := key[1]
+	return sdk.AccAddress(key[2 : markerKeyLen+2])
+}
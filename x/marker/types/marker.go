@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
@@ -11,6 +12,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	proto "github.com/cosmos/gogoproto/proto"
+
+	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
 
 var (
@@ -41,8 +44,11 @@ type MarkerAccountI interface {
 	HasFixedSupply() bool
 
 	GrantAccess(AccessGrantI) error
+	GrantAccessBulk([]AccessGrant) ([]AccessGrant, error)
 	RevokeAccess(sdk.AccAddress) error
+	RevokeAccessBulk([]AccessGrant) ([]AccessGrant, error)
 	GetAccessList() []AccessGrant
+	NormalizeAccessControl() int
 
 	HasAccess(string, Access) bool
 	ValidateHasAccess(string, Access) error
@@ -51,6 +57,7 @@ type MarkerAccountI interface {
 	AddressListForPermission(Access) []sdk.AccAddress
 
 	HasGovernanceEnabled() bool
+	SetAllowGovernanceControl(bool)
 
 	AllowsForcedTransfer() bool
 	SetAllowForcedTransfer(bool)
@@ -121,6 +128,11 @@ func (ma MarkerAccount) HasFixedSupply() bool { return ma.SupplyFixed }
 // HasGovernanceEnabled returns true if this marker allows governance proposals to control this marker
 func (ma MarkerAccount) HasGovernanceEnabled() bool { return ma.AllowGovernanceControl }
 
+// SetAllowGovernanceControl sets whether governance proposals can control this marker.
+func (ma *MarkerAccount) SetAllowGovernanceControl(allowGovernanceControl bool) {
+	ma.AllowGovernanceControl = allowGovernanceControl
+}
+
 // AllowsForcedTransfer returns true if force transfer is allowed for this marker.
 func (ma MarkerAccount) AllowsForcedTransfer() bool {
 	return ma.AllowForcedTransfer
@@ -261,27 +273,81 @@ func ValidateIbcDenom(ma MarkerAccount) error {
 	return nil
 }
 
+// AccessTypesForMarkerType returns the access permissions that can be granted on a marker of the given type.
+// An empty list is returned for unsupported marker types.
+func AccessTypesForMarkerType(markerType MarkerType) []Access {
+	switch markerType {
+	case MarkerType_Coin:
+		return []Access{Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw}
+	// Restricted Coins also support Transfer access
+	case MarkerType_RestrictedCoin:
+		return []Access{Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw, Access_Transfer, Access_ForceTransfer}
+	default:
+		return nil
+	}
+}
+
+// RecommendedGrants returns the permissions that are typically needed to operate the provided marker
+// (given its type, status, and flags) but that are not currently granted to any address.
+// The result is advisory only; a marker without these grants is still valid.
+func RecommendedGrants(marker MarkerAccountI) []GrantRecommendation {
+	status := marker.GetStatus()
+	if status == StatusDestroyed || status == StatusUndefined {
+		return nil
+	}
+
+	reasons := make(map[Access]string)
+	if status != StatusCancelled {
+		reasons[Access_Admin] = "no address can manage the access grants or denom metadata of the marker"
+		reasons[Access_Mint] = "no address can increase the supply of the marker"
+		reasons[Access_Burn] = "no address can decrease the supply of the marker"
+		reasons[Access_Withdraw] = "no address can withdraw coins held by the marker account"
+		reasons[Access_Delete] = "no address can cancel the marker"
+		reasons[Access_Transfer] = "no address can transfer restricted coins on behalf of holders"
+		if marker.AllowsForcedTransfer() {
+			reasons[Access_ForceTransfer] = "forced transfers are allowed but no address can perform them"
+		}
+	} else {
+		reasons[Access_Delete] = "no address can destroy the cancelled marker"
+	}
+
+	var rv []GrantRecommendation
+	for _, access := range AccessTypesForMarkerType(marker.GetMarkerType()) {
+		reason, ok := reasons[access]
+		if !ok || len(marker.AddressListForPermission(access)) > 0 {
+			continue
+		}
+		rv = append(rv, GrantRecommendation{Permission: access, Reason: reason})
+	}
+	return rv
+}
+
+// CheckLastAdminRemoval returns an error if revoking the address's access would remove the last ADMIN access grant
+// from an active marker. Markers that allow governance control are exempt since governance can still manage them.
+func CheckLastAdminRemoval(marker MarkerAccountI, addr sdk.AccAddress) error {
+	if marker.GetStatus() != StatusActive || marker.HasGovernanceEnabled() || !marker.AddressHasAccess(addr, Access_Admin) {
+		return nil
+	}
+	for _, admin := range marker.AddressListForPermission(Access_Admin) {
+		if !admin.Equals(addr) {
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot remove the last %s access grant from active marker %s that is not controlled by governance",
+		Access_Admin, marker.GetDenom())
+}
+
 // ValidateGrantsForMarkerType checks a collection of grants and returns any errors encountered or nil
 func ValidateGrantsForMarkerType(markerType MarkerType, grants ...AccessGrant) error {
+	allowed := AccessTypesForMarkerType(markerType)
 	for _, grant := range grants {
 		for _, access := range grant.Permissions {
-			switch markerType {
-			case MarkerType_Coin:
-				{
-					if !access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw) {
-						return fmt.Errorf("%v is not supported for marker type %v", access, markerType)
-					}
-				}
-			// Restricted Coins also support Transfer access
-			case MarkerType_RestrictedCoin:
-				{
-					if !access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw, Access_Transfer, Access_ForceTransfer) {
-						return fmt.Errorf("%v is not supported for marker type %v", access, markerType)
-					}
-				}
-			default:
+			if len(allowed) == 0 {
 				return fmt.Errorf("cannot validate access grants for unsupported marker type %s", markerType.String())
 			}
+			if !access.IsOneOf(allowed...) {
+				return fmt.Errorf("%v is not supported for marker type %v", access, markerType)
+			}
 		}
 	}
 	return ValidateGrants(grants...)
@@ -297,6 +363,101 @@ func ValidateRequiredAttributes(requiredAttributes []string) error {
 	return nil
 }
 
+// RequiredAttributeWildcardPrefix is the prefix a required attribute can have so that it is satisfied
+// by any attribute with a name that ends with the rest of the required attribute (including the dot).
+const RequiredAttributeWildcardPrefix = "*."
+
+// NormalizeRequiredAttribute returns the normalized form of the provided required attribute.
+// Each segment is lower-cased and has surrounding spaces removed. A wildcard is only allowed as
+// a leading "*." segment, and the rest must be a valid name (without consideration of length limits,
+// since those are defined in state).
+func NormalizeRequiredAttribute(attr string) (string, error) {
+	normalized := nametypes.NormalizeName(attr)
+	name := strings.TrimPrefix(normalized, RequiredAttributeWildcardPrefix)
+	if len(name) == 0 {
+		return "", fmt.Errorf("invalid required attribute %q: name cannot be empty", attr)
+	}
+	if strings.Contains(name, "*") {
+		return "", fmt.Errorf("invalid required attribute %q: a wildcard is only allowed as a leading %q segment",
+			attr, RequiredAttributeWildcardPrefix)
+	}
+	for _, segment := range strings.Split(name, ".") {
+		if len(segment) == 0 {
+			return "", fmt.Errorf("invalid required attribute %q: name segments cannot be empty", attr)
+		}
+		if err := nametypes.ValidateNameSegment(segment); err != nil {
+			return "", fmt.Errorf("invalid required attribute %q: %w", attr, err)
+		}
+	}
+	return normalized, nil
+}
+
+// NormalizeRequiredAttributes returns the normalized form of each of the provided required attributes.
+// An error is returned if any are invalid, or if two entries are the same once normalized.
+func NormalizeRequiredAttributes(requiredAttributes []string) ([]string, error) {
+	rv := make([]string, len(requiredAttributes))
+	seen := make(map[string]bool, len(requiredAttributes))
+	for i, attr := range requiredAttributes {
+		normalized, err := NormalizeRequiredAttribute(attr)
+		if err != nil {
+			return nil, err
+		}
+		if seen[normalized] {
+			return nil, fmt.Errorf("required attribute list contains duplicate entries: %q", normalized)
+		}
+		seen[normalized] = true
+		rv[i] = normalized
+	}
+	return rv, nil
+}
+
+// MatchRequiredAttribute returns true if the provided attr satisfies the reqAttr.
+// The reqAttr is normalized using NormalizeRequiredAttribute (so it's in the same form as stored
+// required attributes), and the attr is normalized the same way the name module does.
+// A reqAttr with the wildcard prefix is satisfied by any attr that ends with the rest of it (including the dot).
+func MatchRequiredAttribute(reqAttr string, attr string) bool {
+	reqAttr, err := NormalizeRequiredAttribute(reqAttr)
+	if err != nil {
+		return false
+	}
+	attr = nametypes.NormalizeName(attr)
+	if strings.HasPrefix(reqAttr, RequiredAttributeWildcardPrefix) {
+		// [1:] because we only want to ignore the '*'; the '.' needs to be part of the check.
+		return strings.HasSuffix(attr, reqAttr[1:])
+	}
+	return reqAttr == attr
+}
+
+// FindMatchingRequiredAttribute returns the first of the provided required attributes that the provided
+// attr satisfies (according to MatchRequiredAttribute), and true. If none match, "", false is returned.
+func FindMatchingRequiredAttribute(required []string, attr string) (string, bool) {
+	for _, reqAttr := range required {
+		if MatchRequiredAttribute(reqAttr, attr) {
+			return reqAttr, true
+		}
+	}
+	return "", false
+}
+
+// validateRequiredAttributeChanges makes sure the provided add and remove lists only contain valid
+// required attributes, and that there are no duplicates (once normalized) across both lists.
+func validateRequiredAttributeChanges(add, remove []string) error {
+	seen := make(map[string]bool, len(add)+len(remove))
+	for _, list := range [][]string{add, remove} {
+		for _, attr := range list {
+			normalized, err := NormalizeRequiredAttribute(attr)
+			if err != nil {
+				return err
+			}
+			if seen[normalized] {
+				return fmt.Errorf("required attribute lists contain duplicate entries: %q", normalized)
+			}
+			seen[normalized] = true
+		}
+	}
+	return nil
+}
+
 func (ma *MarkerAccount) GetRequiredAttributes() []string {
 	return ma.RequiredAttributes
 }
@@ -412,6 +573,7 @@ func (ma *MarkerAccount) GrantAccess(access AccessGrantI) error {
 	}
 	// Append the new record
 	ma.AccessControl = append(ma.AccessControl, *NewAccessGrant(access.GetAddress(), access.GetAccessList()))
+	ma.NormalizeAccessControl()
 	return nil
 }
 
@@ -437,6 +599,107 @@ func (ma *MarkerAccount) GetAccessList() []AccessGrant {
 	return ma.AccessControl
 }
 
+// NormalizeAccessControl combines any access grants for the same address and sorts the access list by address.
+// It returns the number of access grants that were combined into another.
+func (ma *MarkerAccount) NormalizeAccessControl() int {
+	var merged int
+	ma.AccessControl, merged = NormalizeAccessGrants(ma.AccessControl)
+	return merged
+}
+
+// GrantAccessBulk adds all of the provided access grants to this marker at once.
+// Grants for the same address (including one already on the marker) are combined.
+// The returned grants are the provided ones, combined by address and sorted by address.
+// If an error is returned, the access list is not changed.
+func (ma *MarkerAccount) GrantAccessBulk(grants []AccessGrant) ([]AccessGrant, error) {
+	toAdd, err := canonicalAccessGrants(grants)
+	if err != nil {
+		return nil, err
+	}
+	toAdd, _ = NormalizeAccessGrants(toAdd)
+	accessList, _ := NormalizeAccessGrants(append(slices.Clone(ma.AccessControl), toAdd...))
+	if err = validateUniqueAccessGrants(accessList); err != nil {
+		return nil, err
+	}
+	ma.AccessControl = accessList
+	return toAdd, nil
+}
+
+// RevokeAccessBulk removes the permissions in each of the provided access grants from this marker at once.
+// A grant without any permissions removes all of that address's permissions, and an address left without
+// any permissions is removed from the access list. Grants for the same address are combined.
+// The returned grants are the permissions that were actually removed from each address, sorted by address.
+// An error is returned (and the access list is not changed) if an address does not have a permission being removed.
+func (ma *MarkerAccount) RevokeAccessBulk(revokes []AccessGrant) ([]AccessGrant, error) {
+	toRevoke, err := canonicalAccessGrants(revokes)
+	if err != nil {
+		return nil, err
+	}
+	toRevoke, _ = NormalizeAccessGrants(toRevoke)
+
+	accessList := slices.Clone(ma.AccessControl)
+	removed := make([]AccessGrant, 0, len(toRevoke))
+	for _, revoke := range toRevoke {
+		i := slices.IndexFunc(accessList, func(ag AccessGrant) bool { return ag.Address == revoke.Address })
+		if i < 0 {
+			return nil, fmt.Errorf("%s does not have any access on %s marker (%s)", revoke.Address, ma.GetDenom(), ma.GetAddress())
+		}
+		if len(revoke.Permissions) == 0 {
+			revoke.Permissions = slices.Clone(accessList[i].Permissions)
+		}
+		for _, perm := range revoke.Permissions {
+			if !accessList[i].HasAccess(perm) {
+				return nil, fmt.Errorf("%s does not have %s on %s marker (%s)", revoke.Address, perm, ma.GetDenom(), ma.GetAddress())
+			}
+		}
+		remaining := AccessGrant{Address: accessList[i].Address, Permissions: slices.Clone(accessList[i].Permissions)}
+		if err = remaining.MergeRemove(revoke); err != nil {
+			return nil, err
+		}
+		accessList[i] = remaining
+		removed = append(removed, revoke)
+	}
+
+	accessList = slices.DeleteFunc(accessList, func(ag AccessGrant) bool { return len(ag.Permissions) == 0 })
+	if err = validateUniqueAccessGrants(accessList); err != nil {
+		return nil, err
+	}
+	if len(accessList) == 0 {
+		accessList = nil
+	}
+	ma.AccessControl = accessList
+	return removed, nil
+}
+
+// canonicalAccessGrants validates each of the provided grants and returns copies of them with their addresses
+// in canonical form (so that different encodings of the same address are treated as the same address).
+func canonicalAccessGrants(grants []AccessGrant) ([]AccessGrant, error) {
+	rv := make([]AccessGrant, len(grants))
+	for i, grant := range grants {
+		if err := grant.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid access grant [%d] for %q: %w", i, grant.Address, err)
+		}
+		rv[i] = AccessGrant{Address: grant.GetAddress().String(), Permissions: slices.Clone(grant.Permissions)}
+	}
+	return rv, nil
+}
+
+// validateUniqueAccessGrants returns an error if an address has more than one of the provided grants,
+// or if any grant has the same permission more than once.
+func validateUniqueAccessGrants(grants []AccessGrant) error {
+	seen := make(map[string]bool, len(grants))
+	for _, grant := range grants {
+		if seen[grant.Address] {
+			return fmt.Errorf("%w: address %s has more than one access grant", ErrDuplicateAccessEntry, grant.Address)
+		}
+		seen[grant.Address] = true
+		if err := validateAccess(grant.Permissions); err != nil {
+			return fmt.Errorf("invalid access grant for %s: %w", grant.Address, err)
+		}
+	}
+	return nil
+}
+
 // MarkerTypeFromString returns a MarkerType from a string. It returns an error
 // if the string is invalid.
 func MarkerTypeFromString(str string) (MarkerType, error) {
@@ -495,6 +758,13 @@ func RemoveFromRequiredAttributes(currentAttrs []string, removeAttrs []string) (
 	return reqAttrs, nil
 }
 
+const (
+	// DefaultConvertValueMaxHops is the max hops used by the ConvertValue query when none is provided.
+	DefaultConvertValueMaxHops = 3
+	// MaxConvertValueHops is the largest max hops allowed in a ConvertValue query.
+	MaxConvertValueHops = 5
+)
+
 // NewNetAssetValue returns a new instance of NetAssetValue
 func NewNetAssetValue(price sdk.Coin, volume uint64) NetAssetValue {
 	return NetAssetValue{
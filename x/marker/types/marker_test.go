@@ -403,6 +403,176 @@ func TestRemovesFromRequiredAttributes(t *testing.T) {
 	}
 }
 
+func TestNormalizeRequiredAttribute(t *testing.T) {
+	tests := []struct {
+		name   string
+		attr   string
+		exp    string
+		expErr string
+	}{
+		{name: "empty", attr: "", expErr: `invalid required attribute "": name cannot be empty`},
+		{name: "only spaces", attr: "   ", expErr: `invalid required attribute "   ": name cannot be empty`},
+		{name: "only wildcard prefix", attr: "*.", expErr: `invalid required attribute "*.": name cannot be empty`},
+		{
+			name:   "just a wildcard",
+			attr:   "*",
+			expErr: `invalid required attribute "*": a wildcard is only allowed as a leading "*." segment`,
+		},
+		{
+			name:   "wildcard without dot",
+			attr:   "*kyc.provenance.io",
+			expErr: `invalid required attribute "*kyc.provenance.io": a wildcard is only allowed as a leading "*." segment`,
+		},
+		{
+			name:   "wildcard in middle",
+			attr:   "kyc.*.io",
+			expErr: `invalid required attribute "kyc.*.io": a wildcard is only allowed as a leading "*." segment`,
+		},
+		{
+			name:   "wildcard at end",
+			attr:   "kyc.provenance.*",
+			expErr: `invalid required attribute "kyc.provenance.*": a wildcard is only allowed as a leading "*." segment`,
+		},
+		{
+			name:   "two wildcards",
+			attr:   "*.*.provenance.io",
+			expErr: `invalid required attribute "*.*.provenance.io": a wildcard is only allowed as a leading "*." segment`,
+		},
+		{
+			name:   "empty segment",
+			attr:   "kyc..io",
+			expErr: `invalid required attribute "kyc..io": name segments cannot be empty`,
+		},
+		{
+			name:   "trailing dot",
+			attr:   "*.provenance.io.",
+			expErr: `invalid required attribute "*.provenance.io.": name segments cannot be empty`,
+		},
+		{
+			name:   "illegal character",
+			attr:   "kyc.provenance_io",
+			expErr: `invalid required attribute "kyc.provenance_io": illegal character "_" in name segment "provenance_io"`,
+		},
+		{
+			name:   "too many dashes",
+			attr:   "*.a-b-c.io",
+			expErr: `invalid required attribute "*.a-b-c.io": segment "a-b-c" has too many dashes`,
+		},
+		{name: "already normalized", attr: "kyc.provenance.io", exp: "kyc.provenance.io"},
+		{name: "already normalized wildcard", attr: "*.provenance.io", exp: "*.provenance.io"},
+		{name: "upper case", attr: "KYC.Provenance.IO", exp: "kyc.provenance.io"},
+		{name: "spaces around segments", attr: " * . provenance . io ", exp: "*.provenance.io"},
+		{name: "single segment", attr: "Kyc", exp: "kyc"},
+		{
+			name: "uuid segment",
+			attr: "*.91978BA2-5F35-459A-86A7-FECA1B0512E0.io",
+			exp:  "*.91978ba2-5f35-459a-86a7-feca1b0512e0.io",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = NormalizeRequiredAttribute(tc.attr)
+			}
+			require.NotPanics(t, testFunc, "NormalizeRequiredAttribute(%q)", tc.attr)
+			assertions.AssertErrorValue(t, err, tc.expErr, "NormalizeRequiredAttribute(%q) error", tc.attr)
+			assert.Equal(t, tc.exp, act, "NormalizeRequiredAttribute(%q) result", tc.attr)
+		})
+	}
+}
+
+func TestNormalizeRequiredAttributes(t *testing.T) {
+	tests := []struct {
+		name   string
+		attrs  []string
+		exp    []string
+		expErr string
+	}{
+		{name: "nil", attrs: nil, exp: []string{}},
+		{name: "empty", attrs: []string{}, exp: []string{}},
+		{
+			name:   "invalid second entry",
+			attrs:  []string{"kyc.provenance.io", "kyc.*.io"},
+			expErr: `invalid required attribute "kyc.*.io": a wildcard is only allowed as a leading "*." segment`,
+		},
+		{
+			name:   "exact duplicates",
+			attrs:  []string{"kyc.provenance.io", "aml.provenance.io", "kyc.provenance.io"},
+			expErr: `required attribute list contains duplicate entries: "kyc.provenance.io"`,
+		},
+		{
+			name:   "duplicates once normalized",
+			attrs:  []string{"*.provenance.io", " * .Provenance.IO"},
+			expErr: `required attribute list contains duplicate entries: "*.provenance.io"`,
+		},
+		{
+			name:  "wildcard and non-wildcard of same name",
+			attrs: []string{"*.provenance.io", "provenance.io"},
+			exp:   []string{"*.provenance.io", "provenance.io"},
+		},
+		{
+			name:  "all normalized",
+			attrs: []string{"KYC.provenance.io", " *.Bank.io", "aml"},
+			exp:   []string{"kyc.provenance.io", "*.bank.io", "aml"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act []string
+			var err error
+			testFunc := func() {
+				act, err = NormalizeRequiredAttributes(tc.attrs)
+			}
+			require.NotPanics(t, testFunc, "NormalizeRequiredAttributes(%q)", tc.attrs)
+			assertions.AssertErrorValue(t, err, tc.expErr, "NormalizeRequiredAttributes(%q) error", tc.attrs)
+			assert.Equal(t, tc.exp, act, "NormalizeRequiredAttributes(%q) result", tc.attrs)
+		})
+	}
+}
+
+func TestFindMatchingRequiredAttribute(t *testing.T) {
+	tests := []struct {
+		name     string
+		required []string
+		attr     string
+		exp      string
+		expFound bool
+	}{
+		{name: "nil required", required: nil, attr: "kyc.provenance.io"},
+		{name: "empty attr", required: []string{"*.provenance.io"}, attr: ""},
+		{name: "exact", required: []string{"kyc.provenance.io"}, attr: "kyc.provenance.io", exp: "kyc.provenance.io", expFound: true},
+		{name: "attr not normalized", required: []string{"kyc.provenance.io"}, attr: " KYC.Provenance.io", exp: "kyc.provenance.io", expFound: true},
+		{name: "wildcard", required: []string{"*.provenance.io"}, attr: "kyc.provenance.io", exp: "*.provenance.io", expFound: true},
+		{name: "wildcard needs a segment", required: []string{"*.provenance.io"}, attr: "provenance.io"},
+		{name: "wildcard needs whole segment", required: []string{"*.provenance.io"}, attr: "kycprovenance.io"},
+		{name: "invalid required entry", required: []string{"kyc.*.io"}, attr: "kyc.provenance.io"},
+		{
+			name:     "first match returned",
+			required: []string{"aml.provenance.io", "*.provenance.io", "kyc.provenance.io"},
+			attr:     "kyc.provenance.io",
+			exp:      "*.provenance.io",
+			expFound: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act string
+			var found bool
+			testFunc := func() {
+				act, found = FindMatchingRequiredAttribute(tc.required, tc.attr)
+			}
+			require.NotPanics(t, testFunc, "FindMatchingRequiredAttribute(%q, %q)", tc.required, tc.attr)
+			assert.Equal(t, tc.exp, act, "FindMatchingRequiredAttribute(%q, %q) result", tc.required, tc.attr)
+			assert.Equal(t, tc.expFound, found, "FindMatchingRequiredAttribute(%q, %q) found", tc.required, tc.attr)
+		})
+	}
+}
+
 func TestNetAssetValueConstructor(t *testing.T) {
 	price := sdk.NewInt64Coin("jackthecat", 406)
 	volume := uint64(100)
@@ -683,3 +853,159 @@ func TestValidateAtLeastOneAddrHasAccess(t *testing.T) {
 		})
 	}
 }
+
+func TestRecommendedGrants(t *testing.T) {
+	admin := sdk.AccAddress("admin_addr__________")
+	other := sdk.AccAddress("other_addr__________")
+
+	newMarker := func(markerType MarkerType, status MarkerStatus, forcedTransfer bool, grants ...AccessGrant) *MarkerAccount {
+		denom := "recommendme"
+		return &MarkerAccount{
+			BaseAccount:         &authtypes.BaseAccount{Address: MustGetMarkerAddress(denom).String()},
+			Denom:               denom,
+			Status:              status,
+			MarkerType:          markerType,
+			AllowForcedTransfer: forcedTransfer,
+			AccessControl:       grants,
+		}
+	}
+	perms := func(recs []GrantRecommendation) []Access {
+		var rv []Access
+		for _, rec := range recs {
+			rv = append(rv, rec.Permission)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name   string
+		marker *MarkerAccount
+		exp    []Access
+	}{
+		{
+			name:   "bare coin marker",
+			marker: newMarker(MarkerType_Coin, StatusActive, false),
+			exp:    []Access{Access_Admin, Access_Burn, Access_Delete, Access_Mint, Access_Withdraw},
+		},
+		{
+			name:   "bare restricted marker",
+			marker: newMarker(MarkerType_RestrictedCoin, StatusActive, false),
+			exp:    []Access{Access_Admin, Access_Burn, Access_Delete, Access_Mint, Access_Withdraw, Access_Transfer},
+		},
+		{
+			name:   "bare restricted marker with forced transfer",
+			marker: newMarker(MarkerType_RestrictedCoin, StatusProposed, true),
+			exp:    []Access{Access_Admin, Access_Burn, Access_Delete, Access_Mint, Access_Withdraw, Access_Transfer, Access_ForceTransfer},
+		},
+		{
+			name: "fully configured restricted marker",
+			marker: newMarker(MarkerType_RestrictedCoin, StatusActive, true,
+				AccessGrant{Address: admin.String(), Permissions: AccessList{Access_Admin, Access_Mint, Access_Burn, Access_Withdraw}},
+				AccessGrant{Address: other.String(), Permissions: AccessList{Access_Delete, Access_Transfer, Access_ForceTransfer}},
+			),
+			exp: nil,
+		},
+		{
+			name: "partially configured coin marker",
+			marker: newMarker(MarkerType_Coin, StatusFinalized, false,
+				AccessGrant{Address: admin.String(), Permissions: AccessList{Access_Admin, Access_Mint, Access_Deposit}},
+			),
+			exp: []Access{Access_Burn, Access_Delete, Access_Withdraw},
+		},
+		{
+			name:   "cancelled marker without delete",
+			marker: newMarker(MarkerType_RestrictedCoin, StatusCancelled, true),
+			exp:    []Access{Access_Delete},
+		},
+		{
+			name: "cancelled marker with delete",
+			marker: newMarker(MarkerType_RestrictedCoin, StatusCancelled, true,
+				AccessGrant{Address: admin.String(), Permissions: AccessList{Access_Delete}},
+			),
+			exp: nil,
+		},
+		{
+			name:   "destroyed marker",
+			marker: newMarker(MarkerType_Coin, StatusDestroyed, false),
+			exp:    nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := RecommendedGrants(tc.marker)
+			assert.Equal(t, tc.exp, perms(actual), "RecommendedGrants permissions")
+			for i, rec := range actual {
+				assert.NotEmpty(t, rec.Reason, "[%d]: %s reason", i, rec.Permission)
+			}
+		})
+	}
+}
+
+func TestCheckLastAdminRemoval(t *testing.T) {
+	admin := sdk.AccAddress("admin_addr__________")
+	other := sdk.AccAddress("other_addr__________")
+	denom := "lastadmincoin"
+
+	newMarker := func(status MarkerStatus, govControl bool, grants ...AccessGrant) *MarkerAccount {
+		return &MarkerAccount{
+			BaseAccount:            &authtypes.BaseAccount{Address: MustGetMarkerAddress(denom).String()},
+			Denom:                  denom,
+			Status:                 status,
+			MarkerType:             MarkerType_Coin,
+			AllowGovernanceControl: govControl,
+			AccessControl:          grants,
+		}
+	}
+	adminGrant := AccessGrant{Address: admin.String(), Permissions: AccessList{Access_Admin, Access_Mint}}
+	otherAdminGrant := AccessGrant{Address: other.String(), Permissions: AccessList{Access_Admin}}
+	otherMintGrant := AccessGrant{Address: other.String(), Permissions: AccessList{Access_Mint}}
+	expErr := "cannot remove the last ACCESS_ADMIN access grant from active marker " + denom +
+		" that is not controlled by governance"
+
+	tests := []struct {
+		name   string
+		marker *MarkerAccount
+		addr   sdk.AccAddress
+		expErr string
+	}{
+		{
+			name:   "only admin of active marker",
+			marker: newMarker(StatusActive, false, adminGrant, otherMintGrant),
+			addr:   admin,
+			expErr: expErr,
+		},
+		{
+			name:   "another admin exists",
+			marker: newMarker(StatusActive, false, adminGrant, otherAdminGrant),
+			addr:   admin,
+		},
+		{
+			name:   "address is not an admin",
+			marker: newMarker(StatusActive, false, adminGrant, otherMintGrant),
+			addr:   other,
+		},
+		{
+			name:   "governance controlled marker",
+			marker: newMarker(StatusActive, true, adminGrant),
+			addr:   admin,
+		},
+		{
+			name:   "finalized marker",
+			marker: newMarker(StatusFinalized, false, adminGrant),
+			addr:   admin,
+		},
+		{
+			name:   "proposed marker",
+			marker: newMarker(StatusProposed, false, adminGrant),
+			addr:   admin,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckLastAdminRemoval(tc.marker, tc.addr)
+			assertions.AssertErrorValue(t, err, tc.expErr, "CheckLastAdminRemoval")
+		})
+	}
+}
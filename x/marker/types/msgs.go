@@ -46,8 +46,14 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgWithdrawEscrowProposalRequest)(nil),
 	(*MsgSetDenomMetadataProposalRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgSetHoldingThresholdsRequest)(nil),
+	(*MsgSetNetAssetValueBoundsRequest)(nil),
+	(*MsgUpdateMarkersBulkRequest)(nil),
 }
 
+// MaxMarkerBulkUpdates is the maximum number of markers that can be updated in a single MsgUpdateMarkersBulkRequest.
+const MaxMarkerBulkUpdates = 50
+
 func NewMsgFinalizeRequest(denom string, admin sdk.AccAddress) *MsgFinalizeRequest {
 	return &MsgFinalizeRequest{
 		Denom:         denom,
@@ -148,8 +154,19 @@ func (msg MsgDeleteAccessRequest) ValidateBasic() error {
 	if err := sdk.ValidateDenom(msg.Denom); err != nil {
 		return err
 	}
-	_, err := sdk.AccAddressFromBech32(msg.RemovedAddress)
-	return err
+	if len(msg.RevokeAccess) == 0 {
+		_, err := sdk.AccAddressFromBech32(msg.RemovedAddress)
+		return err
+	}
+	if len(msg.RemovedAddress) > 0 {
+		return fmt.Errorf("cannot provide both a removed address and revoke access entries")
+	}
+	for i, grant := range msg.RevokeAccess {
+		if err := grant.Validate(); err != nil {
+			return fmt.Errorf("invalid revoke access entry [%d]: %w", i, err)
+		}
+	}
+	return nil
 }
 
 func NewMsgWithdrawRequest(
@@ -231,14 +248,8 @@ func (msg MsgAddMarkerRequest) ValidateBasic() error {
 		return fmt.Errorf("required attributes are reserved for restricted markers")
 	}
 
-	if len(msg.RequiredAttributes) > 0 {
-		seen := make(map[string]bool)
-		for _, str := range msg.RequiredAttributes {
-			if seen[str] {
-				return fmt.Errorf("required attribute list contains duplicate entries")
-			}
-			seen[str] = true
-		}
+	if _, err := NormalizeRequiredAttributes(msg.RequiredAttributes); err != nil {
+		return err
 	}
 
 	return nil
@@ -436,6 +447,10 @@ func (msg MsgAddFinalizeActivateMarkerRequest) ValidateBasic() error {
 		return fmt.Errorf("required attributes are reserved for restricted markers")
 	}
 
+	if _, err := NormalizeRequiredAttributes(msg.RequiredAttributes); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -485,15 +500,8 @@ func (msg MsgUpdateRequiredAttributesRequest) ValidateBasic() error {
 		return fmt.Errorf("both add and remove lists cannot be empty")
 	}
 
-	combined := []string{}
-	combined = append(combined, msg.AddRequiredAttributes...)
-	combined = append(combined, msg.RemoveRequiredAttributes...)
-	seen := make(map[string]bool)
-	for _, str := range combined {
-		if seen[str] {
-			return fmt.Errorf("required attribute lists contain duplicate entries")
-		}
-		seen[str] = true
+	if err := validateRequiredAttributeChanges(msg.AddRequiredAttributes, msg.RemoveRequiredAttributes); err != nil {
+		return err
 	}
 
 	_, err := sdk.AccAddressFromBech32(msg.TransferAuthority)
@@ -747,3 +755,89 @@ func (msg MsgUpdateParamsRequest) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Authority)
 	return err
 }
+
+// NewMsgSetHoldingThresholdsRequest creates a new MsgSetHoldingThresholdsRequest.
+func NewMsgSetHoldingThresholdsRequest(denom string, basisPoints []uint32, administrator string) *MsgSetHoldingThresholdsRequest {
+	return &MsgSetHoldingThresholdsRequest{
+		Denom:         denom,
+		BasisPoints:   basisPoints,
+		Administrator: administrator,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetHoldingThresholdsRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateHoldingThresholds(msg.BasisPoints); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Administrator)
+	return err
+}
+
+// NewMsgSetNetAssetValueBoundsRequest creates a new MsgSetNetAssetValueBoundsRequest.
+func NewMsgSetNetAssetValueBoundsRequest(denom string, maxChangeBasisPoints uint32, administrator string) *MsgSetNetAssetValueBoundsRequest {
+	return &MsgSetNetAssetValueBoundsRequest{
+		Denom:                denom,
+		MaxChangeBasisPoints: maxChangeBasisPoints,
+		Administrator:        administrator,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetNetAssetValueBoundsRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Administrator)
+	return err
+}
+
+// NewMsgUpdateMarkersBulkRequest creates a new MsgUpdateMarkersBulkRequest.
+func NewMsgUpdateMarkersBulkRequest(updates []MarkerBulkUpdate, authority string) *MsgUpdateMarkersBulkRequest {
+	return &MsgUpdateMarkersBulkRequest{
+		Updates:   updates,
+		Authority: authority,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+// If any of the updates are invalid, the whole message is invalid.
+func (msg MsgUpdateMarkersBulkRequest) ValidateBasic() error {
+	if len(msg.Updates) == 0 {
+		return errors.New("at least one marker update is required")
+	}
+	if len(msg.Updates) > MaxMarkerBulkUpdates {
+		return fmt.Errorf("too many marker updates %d: cannot have more than %d", len(msg.Updates), MaxMarkerBulkUpdates)
+	}
+	seen := make(map[string]bool, len(msg.Updates))
+	for i, update := range msg.Updates {
+		if err := update.Validate(); err != nil {
+			return fmt.Errorf("invalid update[%d]: %w", i, err)
+		}
+		if seen[update.Denom] {
+			return fmt.Errorf("invalid update[%d]: duplicate denom %q", i, update.Denom)
+		}
+		seen[update.Denom] = true
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return nil
+}
+
+// Validate makes sure that the update has a valid denom, changes something,
+// and doesn't have duplicate required attribute entries.
+func (u MarkerBulkUpdate) Validate() error {
+	if err := sdk.ValidateDenom(u.Denom); err != nil {
+		return err
+	}
+	if len(u.AddRequiredAttributes) == 0 && len(u.RemoveRequiredAttributes) == 0 &&
+		!u.SetAllowForcedTransfer && !u.SetAllowGovernanceControl {
+		return fmt.Errorf("no changes provided for %s", u.Denom)
+	}
+
+	return validateRequiredAttributeChanges(u.AddRequiredAttributes, u.RemoveRequiredAttributes)
+}
@@ -52,6 +52,9 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgWithdrawEscrowProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgSetDenomMetadataProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetHoldingThresholdsRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgSetNetAssetValueBoundsRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateMarkersBulkRequest{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -293,7 +296,41 @@ func TestMsgAddMarkerRequestValidateBasic(t *testing.T) {
 				0,
 				0,
 			),
-			errorMsg: "required attribute list contains duplicate entries",
+			errorMsg: `required attribute list contains duplicate entries: "foo"`,
+		},
+		{
+			name: "should fail duplicate entries for req attrs once normalized",
+			msg: *NewMsgAddMarkerRequest(
+				"hotdog",
+				sdkmath.NewInt(100),
+				validAddress,
+				validAddress,
+				MarkerType_RestrictedCoin,
+				true,
+				true,
+				false,
+				[]string{"*.foo.io", "*. FOO.io"},
+				0,
+				0,
+			),
+			errorMsg: `required attribute list contains duplicate entries: "*.foo.io"`,
+		},
+		{
+			name: "should fail on invalid wildcard in req attrs",
+			msg: *NewMsgAddMarkerRequest(
+				"hotdog",
+				sdkmath.NewInt(100),
+				validAddress,
+				validAddress,
+				MarkerType_RestrictedCoin,
+				true,
+				true,
+				false,
+				[]string{"foo.io", "foo.*.io"},
+				0,
+				0,
+			),
+			errorMsg: `invalid required attribute "foo.*.io": a wildcard is only allowed as a leading "*." segment`,
 		},
 	}
 
@@ -421,6 +458,42 @@ func TestMsgAddFinalizeActivateMarkerRequestValidateBasic(t *testing.T) {
 			),
 			errorMsg: "",
 		},
+		{
+			name: "should fail for restricted coin with invalid required attribute",
+			msg: *NewMsgAddFinalizeActivateMarkerRequest(
+				"hotdog",
+				sdkmath.NewInt(100),
+				validAddress,
+				validAddress,
+				MarkerType_RestrictedCoin,
+				true,
+				true,
+				false,
+				[]string{"blah", "bl@h"},
+				[]AccessGrant{*NewAccessGrant(validAddress, []Access{Access_Mint, Access_Admin})},
+				0,
+				0,
+			),
+			errorMsg: `invalid required attribute "bl@h": illegal character "@" in name segment "bl@h"`,
+		},
+		{
+			name: "should fail for restricted coin with duplicate required attributes",
+			msg: *NewMsgAddFinalizeActivateMarkerRequest(
+				"hotdog",
+				sdkmath.NewInt(100),
+				validAddress,
+				validAddress,
+				MarkerType_RestrictedCoin,
+				true,
+				true,
+				false,
+				[]string{"blah", "BLAH"},
+				[]AccessGrant{*NewAccessGrant(validAddress, []Access{Access_Mint, Access_Admin})},
+				0,
+				0,
+			),
+			errorMsg: `required attribute list contains duplicate entries: "blah"`,
+		},
 		{
 			name: "should fail when forced tranfers allowed with coin type",
 			msg: *NewMsgAddFinalizeActivateMarkerRequest(
@@ -574,17 +647,32 @@ func TestMsgUpdateRequiredAttributesRequestValidateBasic(t *testing.T) {
 		{
 			name:          "should fail, combined list has duplicate entries",
 			msg:           *NewMsgUpdateRequiredAttributesRequest("jackthecat", sdk.AccAddress(authority), []string{"foo.provenance.io"}, []string{"foo.provenance.io"}),
-			expectedError: "required attribute lists contain duplicate entries",
+			expectedError: `required attribute lists contain duplicate entries: "foo.provenance.io"`,
 		},
 		{
 			name:          "should fail, add list has duplicate entries",
 			msg:           *NewMsgUpdateRequiredAttributesRequest("jackthecat", sdk.AccAddress(authority), []string{"foo.provenance.io", "foo.provenance.io"}, []string{"foo2.provenance.io"}),
-			expectedError: "required attribute lists contain duplicate entries",
+			expectedError: `required attribute lists contain duplicate entries: "foo.provenance.io"`,
 		},
 		{
 			name:          "should fail, remove list has duplicate entries",
 			msg:           *NewMsgUpdateRequiredAttributesRequest("jackthecat", sdk.AccAddress(authority), []string{"foo.provenance.io"}, []string{"foo2.provenance.io", "foo2.provenance.io"}),
-			expectedError: "required attribute lists contain duplicate entries",
+			expectedError: `required attribute lists contain duplicate entries: "foo2.provenance.io"`,
+		},
+		{
+			name:          "should fail, combined list has duplicate entries once normalized",
+			msg:           *NewMsgUpdateRequiredAttributesRequest("jackthecat", sdk.AccAddress(authority), []string{"*.foo.provenance.io"}, []string{"*.Foo.Provenance.io"}),
+			expectedError: `required attribute lists contain duplicate entries: "*.foo.provenance.io"`,
+		},
+		{
+			name:          "should fail, invalid add list entry",
+			msg:           *NewMsgUpdateRequiredAttributesRequest("jackthecat", sdk.AccAddress(authority), []string{"foo.provenance.io"}, []string{"*"}),
+			expectedError: `invalid required attribute "*": a wildcard is only allowed as a leading "*." segment`,
+		},
+		{
+			name:          "should fail, invalid remove list entry",
+			msg:           *NewMsgUpdateRequiredAttributesRequest("jackthecat", sdk.AccAddress(authority), []string{"foo..io"}, []string{"foo2.provenance.io"}),
+			expectedError: `invalid required attribute "foo..io": name segments cannot be empty`,
 		},
 		{
 			name: "should succeed",
@@ -774,6 +862,74 @@ func TestMsgUpdateSendDenyListRequestValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgDeleteAccessRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	denom := "somedenom"
+
+	tests := []struct {
+		name   string
+		msg    MsgDeleteAccessRequest
+		expErr string
+	}{
+		{
+			name: "removed address",
+			msg:  MsgDeleteAccessRequest{Denom: denom, Administrator: admin, RemovedAddress: addr1},
+		},
+		{
+			name:   "invalid removed address",
+			msg:    MsgDeleteAccessRequest{Denom: denom, Administrator: admin, RemovedAddress: "invalid-address"},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgDeleteAccessRequest{Denom: "1", Administrator: admin, RemovedAddress: addr1},
+			expErr: "invalid denom: 1",
+		},
+		{
+			name: "revoke access entries",
+			msg: MsgDeleteAccessRequest{Denom: denom, Administrator: admin, RevokeAccess: []AccessGrant{
+				{Address: addr1, Permissions: AccessList{Access_Mint, Access_Burn}},
+				{Address: addr2},
+			}},
+		},
+		{
+			name: "both removed address and revoke access entries",
+			msg: MsgDeleteAccessRequest{Denom: denom, Administrator: admin, RemovedAddress: addr1, RevokeAccess: []AccessGrant{
+				{Address: addr2},
+			}},
+			expErr: "cannot provide both a removed address and revoke access entries",
+		},
+		{
+			name: "revoke access entry with invalid address",
+			msg: MsgDeleteAccessRequest{Denom: denom, Administrator: admin, RevokeAccess: []AccessGrant{
+				{Address: addr1},
+				{Address: "invalid-address"},
+			}},
+			expErr: "invalid revoke access entry [1]: invalid address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "revoke access entry with duplicate permissions",
+			msg: MsgDeleteAccessRequest{Denom: denom, Administrator: admin, RevokeAccess: []AccessGrant{
+				{Address: addr1, Permissions: AccessList{Access_Mint, Access_Mint}},
+			}},
+			expErr: "invalid revoke access entry [0]: access list contains duplicate entry",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
 func TestMsgAddNetAssetValueValidateBasic(t *testing.T) {
 	addr := sdk.AccAddress("addr________________").String()
 	denom := "somedenom"
@@ -1283,3 +1439,175 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetHoldingThresholdsRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgSetHoldingThresholdsRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  MsgSetHoldingThresholdsRequest{Denom: "hotdog", BasisPoints: []uint32{2500, 5000}, Administrator: addr},
+		},
+		{
+			name: "valid: no thresholds",
+			msg:  MsgSetHoldingThresholdsRequest{Denom: "hotdog", Administrator: addr},
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgSetHoldingThresholdsRequest{Denom: "", BasisPoints: []uint32{2500}, Administrator: addr},
+			expErr: "invalid denom: ",
+		},
+		{
+			name:   "invalid threshold",
+			msg:    MsgSetHoldingThresholdsRequest{Denom: "hotdog", BasisPoints: []uint32{10000}, Administrator: addr},
+			expErr: "invalid holding threshold 10000: must be between 1 and 9999 (inclusive)",
+		},
+		{
+			name:   "invalid administrator",
+			msg:    MsgSetHoldingThresholdsRequest{Denom: "hotdog", BasisPoints: []uint32{2500}, Administrator: "bad"},
+			expErr: "decoding bech32 failed: invalid bech32 string length 3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetNetAssetValueBoundsRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgSetNetAssetValueBoundsRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  MsgSetNetAssetValueBoundsRequest{Denom: "hotdog", MaxChangeBasisPoints: 2500, Administrator: addr},
+		},
+		{
+			name: "valid: no bounds",
+			msg:  MsgSetNetAssetValueBoundsRequest{Denom: "hotdog", Administrator: addr},
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgSetNetAssetValueBoundsRequest{Denom: "", MaxChangeBasisPoints: 2500, Administrator: addr},
+			expErr: "invalid denom: ",
+		},
+		{
+			name:   "invalid administrator",
+			msg:    MsgSetNetAssetValueBoundsRequest{Denom: "hotdog", MaxChangeBasisPoints: 2500, Administrator: "bad"},
+			expErr: "decoding bech32 failed: invalid bech32 string length 3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgUpdateMarkersBulkRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+	forced := func(denom string) MarkerBulkUpdate {
+		return MarkerBulkUpdate{Denom: denom, SetAllowForcedTransfer: true, AllowForcedTransfer: true}
+	}
+	tooMany := make([]MarkerBulkUpdate, MaxMarkerBulkUpdates+1)
+	for i := range tooMany {
+		tooMany[i] = forced(fmt.Sprintf("bulkcoin%d", i))
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgUpdateMarkersBulkRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg: MsgUpdateMarkersBulkRequest{
+				Updates: []MarkerBulkUpdate{
+					{Denom: "bulkcoina", AddRequiredAttributes: []string{"kyc.bulk.io"}},
+					forced("bulkcoinb"),
+					{Denom: "bulkcoinc", SetAllowGovernanceControl: true},
+				},
+				Authority: authority,
+			},
+		},
+		{
+			name:   "valid: max updates",
+			msg:    MsgUpdateMarkersBulkRequest{Updates: tooMany[:MaxMarkerBulkUpdates], Authority: authority},
+			expErr: "",
+		},
+		{
+			name:   "no updates",
+			msg:    MsgUpdateMarkersBulkRequest{Authority: authority},
+			expErr: "at least one marker update is required",
+		},
+		{
+			name:   "too many updates",
+			msg:    MsgUpdateMarkersBulkRequest{Updates: tooMany, Authority: authority},
+			expErr: fmt.Sprintf("too many marker updates %d: cannot have more than %d", MaxMarkerBulkUpdates+1, MaxMarkerBulkUpdates),
+		},
+		{
+			name:   "one invalid denom",
+			msg:    MsgUpdateMarkersBulkRequest{Updates: []MarkerBulkUpdate{forced("bulkcoina"), forced("x")}, Authority: authority},
+			expErr: "invalid update[1]: invalid denom: x",
+		},
+		{
+			name:   "duplicate denom",
+			msg:    MsgUpdateMarkersBulkRequest{Updates: []MarkerBulkUpdate{forced("bulkcoina"), forced("bulkcoina")}, Authority: authority},
+			expErr: "invalid update[1]: duplicate denom \"bulkcoina\"",
+		},
+		{
+			name:   "no changes",
+			msg:    MsgUpdateMarkersBulkRequest{Updates: []MarkerBulkUpdate{{Denom: "bulkcoina"}}, Authority: authority},
+			expErr: "invalid update[0]: no changes provided for bulkcoina",
+		},
+		{
+			name: "duplicate required attributes",
+			msg: MsgUpdateMarkersBulkRequest{
+				Updates: []MarkerBulkUpdate{{
+					Denom:                    "bulkcoina",
+					AddRequiredAttributes:    []string{"kyc.bulk.io"},
+					RemoveRequiredAttributes: []string{"kyc.bulk.io"},
+				}},
+				Authority: authority,
+			},
+			expErr: `invalid update[0]: required attribute lists contain duplicate entries: "kyc.bulk.io"`,
+		},
+		{
+			name:   "invalid authority",
+			msg:    MsgUpdateMarkersBulkRequest{Updates: []MarkerBulkUpdate{forced("bulkcoina")}, Authority: "bad"},
+			expErr: "invalid authority: decoding bech32 failed: invalid bech32 string length 3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// NetAssetValueChange returns the change in the per-unit value from the old net asset value to the new one,
+// as a fraction (e.g. -0.125 for a 12.5% drop). The per-unit value of a net asset value is its price divided by its volume.
+// Returns false if the change cannot be determined, i.e. the old net asset value has a zero price or volume.
+// A new net asset value with a zero price or volume is a 100% drop.
+func NetAssetValueChange(oldNAV, newNAV NetAssetValue) (*big.Rat, bool) {
+	if oldNAV.Price.Amount.IsNil() || !oldNAV.Price.Amount.IsPositive() || oldNAV.Volume == 0 {
+		return nil, false
+	}
+	if newNAV.Price.Amount.IsNil() || !newNAV.Price.Amount.IsPositive() || newNAV.Volume == 0 {
+		return big.NewRat(-1, 1), true
+	}
+	// (newPrice / newVolume) / (oldPrice / oldVolume) - 1 = (newPrice * oldVolume) / (oldPrice * newVolume) - 1
+	num := new(big.Int).Mul(newNAV.Price.Amount.BigInt(), new(big.Int).SetUint64(oldNAV.Volume))
+	den := new(big.Int).Mul(oldNAV.Price.Amount.BigInt(), new(big.Int).SetUint64(newNAV.Volume))
+	change := new(big.Rat).SetFrac(num, den)
+	return change.Sub(change, big.NewRat(1, 1)), true
+}
+
+// FormatPercentChange returns the provided change (a fraction) as a percent with two decimal places, e.g. "-12.50".
+// Returns an empty string if the change is nil.
+func FormatPercentChange(change *big.Rat) string {
+	if change == nil {
+		return ""
+	}
+	return new(big.Rat).Mul(change, big.NewRat(100, 1)).FloatString(2)
+}
+
+// CheckNetAssetValueChange returns an error if the provided change (a fraction) is larger (in either direction)
+// than the provided max change in basis points. A zero max change or a nil change is always allowed.
+func CheckNetAssetValueChange(change *big.Rat, maxChangeBasisPoints uint32) error {
+	if maxChangeBasisPoints == 0 || change == nil {
+		return nil
+	}
+	bps := new(big.Rat).Mul(new(big.Rat).Abs(change), big.NewRat(BasisPointsPerWhole, 1))
+	if bps.Cmp(new(big.Rat).SetUint64(uint64(maxChangeBasisPoints))) > 0 {
+		return fmt.Errorf("net asset value change of %s%% exceeds the max change of %s%%",
+			FormatPercentChange(change), FormatPercentChange(big.NewRat(int64(maxChangeBasisPoints), BasisPointsPerWhole)))
+	}
+	return nil
+}
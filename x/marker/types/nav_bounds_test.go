@@ -0,0 +1,88 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNetAssetValueChange(t *testing.T) {
+	nav := func(price int64, volume uint64) NetAssetValue {
+		return NewNetAssetValue(sdk.NewInt64Coin(UsdDenom, price), volume)
+	}
+
+	tests := []struct {
+		name      string
+		oldNAV    NetAssetValue
+		newNAV    NetAssetValue
+		expChange string
+	}{
+		{name: "no change", oldNAV: nav(100, 1), newNAV: nav(100, 1), expChange: "0.00"},
+		{name: "same per-unit value", oldNAV: nav(100, 1), newNAV: nav(500, 5), expChange: "0.00"},
+		{name: "increase", oldNAV: nav(100, 1), newNAV: nav(110, 1), expChange: "10.00"},
+		{name: "decrease", oldNAV: nav(800, 10), newNAV: nav(70, 1), expChange: "-12.50"},
+		{name: "off by a million", oldNAV: nav(100, 1), newNAV: nav(100_000_000, 1), expChange: "99999900.00"},
+		{name: "new price zero", oldNAV: nav(100, 1), newNAV: nav(0, 0), expChange: "-100.00"},
+		{name: "old price zero", oldNAV: nav(0, 0), newNAV: nav(100, 1), expChange: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			change, ok := NetAssetValueChange(tc.oldNAV, tc.newNAV)
+			assert.Equal(t, len(tc.expChange) > 0, ok, "NetAssetValueChange ok")
+			assert.Equal(t, tc.expChange, FormatPercentChange(change), "NetAssetValueChange percent")
+		})
+	}
+}
+
+func TestCheckNetAssetValueChange(t *testing.T) {
+	nav := func(price int64) NetAssetValue {
+		return NewNetAssetValue(sdk.NewInt64Coin(UsdDenom, price), 1)
+	}
+
+	tests := []struct {
+		name      string
+		oldPrice  int64
+		newPrice  int64
+		maxChange uint32
+		expErr    string
+	}{
+		{name: "no bounds", oldPrice: 100, newPrice: 100_000_000, maxChange: 0},
+		{name: "within bounds", oldPrice: 100, newPrice: 120, maxChange: 2500},
+		{name: "at upper bound", oldPrice: 100, newPrice: 125, maxChange: 2500},
+		{name: "at lower bound", oldPrice: 100, newPrice: 75, maxChange: 2500},
+		{
+			name:      "above upper bound",
+			oldPrice:  100,
+			newPrice:  126,
+			maxChange: 2500,
+			expErr:    "net asset value change of 26.00% exceeds the max change of 25.00%",
+		},
+		{
+			name:      "below lower bound",
+			oldPrice:  100,
+			newPrice:  74,
+			maxChange: 2500,
+			expErr:    "net asset value change of -26.00% exceeds the max change of 25.00%",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			chg, ok := NetAssetValueChange(nav(tc.oldPrice), nav(tc.newPrice))
+			assert.True(t, ok, "NetAssetValueChange ok")
+			err := CheckNetAssetValueChange(chg, tc.maxChange)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "CheckNetAssetValueChange error")
+			} else {
+				assert.NoError(t, err, "CheckNetAssetValueChange error")
+			}
+		})
+	}
+
+	t.Run("nil change", func(t *testing.T) {
+		assert.NoError(t, CheckNetAssetValueChange(nil, 1), "CheckNetAssetValueChange error")
+	})
+}
@@ -1,5 +1,13 @@
 package types
 
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
 const (
 	QueryMarkers      = "all" // all instead of markers to prevent uri stuttering  in '/custom/marker/all'
 	QueryMarker       = "detail"
@@ -21,3 +29,90 @@ type QueryMarkersParams struct {
 func NewQueryMarkersParams(page, limit int, denom, status string) QueryMarkersParams {
 	return QueryMarkersParams{page, limit, denom, status}
 }
+
+// NewResolvedMarkerID creates a ResolvedMarkerID with the canonical denom and address of the provided marker.
+func NewResolvedMarkerID(marker MarkerAccountI) *ResolvedMarkerID {
+	return &ResolvedMarkerID{
+		Denom:   marker.GetDenom(),
+		Address: marker.GetAddress().String(),
+	}
+}
+
+// NewResolvedMetadataDenom creates a ResolvedMetadataDenom with the details of the metadata address of the provided denom.
+// If the denom can't be parsed as a metadata denom, the Error field has the reason.
+func NewResolvedMetadataDenom(denom string) ResolvedMetadataDenom {
+	rv := ResolvedMetadataDenom{Denom: denom}
+	addr, err := metadatatypes.MetadataAddressFromDenom(denom)
+	if err != nil {
+		rv.Error = err.Error()
+		return rv
+	}
+	addrType, err := addr.Prefix()
+	if err != nil {
+		rv.Error = err.Error()
+		return rv
+	}
+	primaryUUID, err := addr.PrimaryUUID()
+	if err != nil {
+		rv.Error = err.Error()
+		return rv
+	}
+	rv.Address = addr.String()
+	rv.AddressType = addrType
+	rv.PrimaryUuid = primaryUUID.String()
+	return rv
+}
+
+// ResolveMetadataDenoms creates a ResolvedMetadataDenom for each of the provided coins that has a metadata denom.
+// Coins with other denoms are skipped.
+func ResolveMetadataDenoms(coins sdk.Coins) []ResolvedMetadataDenom {
+	var rv []ResolvedMetadataDenom
+	for _, coin := range FilterMetadataCoins(coins) {
+		rv = append(rv, NewResolvedMetadataDenom(coin.Denom))
+	}
+	return rv
+}
+
+// FilterMetadataCoins returns the provided coins that have a metadata denom (in the same order).
+// If any addrTypes are provided (e.g. metadatatypes.PrefixScope), only metadata denoms of those types are returned.
+func FilterMetadataCoins(coins sdk.Coins, addrTypes ...string) sdk.Coins {
+	var rv sdk.Coins
+	for _, coin := range coins {
+		if !metadatatypes.IsMetadataDenom(coin.Denom) {
+			continue
+		}
+		if len(addrTypes) > 0 && !hasMetadataDenomType(coin.Denom, addrTypes) {
+			continue
+		}
+		rv = append(rv, coin)
+	}
+	return rv
+}
+
+// hasMetadataDenomType returns true if the provided metadata denom is for an address with one of the provided types.
+func hasMetadataDenomType(denom string, addrTypes []string) bool {
+	id, _ := metadatatypes.TrimMetadataDenomPrefix(denom)
+	for _, addrType := range addrTypes {
+		if strings.HasPrefix(id, addrType+"1") {
+			return true
+		}
+	}
+	return false
+}
+
+// MaxOwnedScopesLimit is the maximum number of owned scopes that can be returned in a single Marker query.
+const MaxOwnedScopesLimit = 100
+
+// AppConfigKeyQueryTimeout is the app config (app.toml) key for the maximum amount of time that an expensive
+// marker query is allowed to run. A duration of zero (the default) means there's no limit.
+const AppConfigKeyQueryTimeout = "marker.query-timeout"
+
+// AppConfigKeyRestrictionTrace is the app config (app.toml) key for whether to record the checks made by the
+// send restriction when it rejects a transfer. It is false by default.
+const AppConfigKeyRestrictionTrace = "marker.restriction-trace"
+
+// MaxAccountDataAddresses is the maximum number of addresses that can be provided to the AccountDataByAddresses query.
+const MaxAccountDataAddresses = 100
+
+// MaxMarkersByDenom is the maximum number of denoms that can be provided to the MarkersByDenom query.
+const MaxMarkersByDenom = 100
@@ -430,6 +430,13 @@ type MsgDeleteAccessRequest struct {
 	Denom          string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	Administrator  string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 	RemovedAddress string `protobuf:"bytes,3,opt,name=removed_address,json=removedAddress,proto3" json:"removed_address,omitempty"`
+	// allow_last_admin_removal allows the last ADMIN access grant to be removed from an active marker
+	// that is not controlled by governance. Without it, such a request is rejected.
+	AllowLastAdminRemoval bool `protobuf:"varint,4,opt,name=allow_last_admin_removal,json=allowLastAdminRemoval,proto3" json:"allow_last_admin_removal,omitempty"`
+	// revoke_access lists several access grants to revoke at once, instead of providing a removed_address.
+	// The permissions in each entry are removed from its address; an entry without any permissions removes
+	// all of that address's access. Either all of them are revoked, or none of them are.
+	RevokeAccess []AccessGrant `protobuf:"bytes,5,rep,name=revoke_access,json=revokeAccess,proto3" json:"revoke_access"`
 }
 
 func (m *MsgDeleteAccessRequest) Reset()         { *m = MsgDeleteAccessRequest{} }
@@ -486,6 +493,20 @@ func (m *MsgDeleteAccessRequest) GetRemovedAddress() string {
 	return ""
 }
 
+func (m *MsgDeleteAccessRequest) GetAllowLastAdminRemoval() bool {
+	if m != nil {
+		return m.AllowLastAdminRemoval
+	}
+	return false
+}
+
+func (m *MsgDeleteAccessRequest) GetRevokeAccess() []AccessGrant {
+	if m != nil {
+		return m.RevokeAccess
+	}
+	return nil
+}
+
 // MsgDeleteAccessResponse defines the Msg/DeleteAccess response type
 type MsgDeleteAccessResponse struct {
 }
@@ -2226,6 +2247,9 @@ type MsgAddNetAssetValuesRequest struct {
 	Denom          string          `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	Administrator  string          `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 	NetAssetValues []NetAssetValue `protobuf:"bytes,3,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+	// bypass_bounds, if true, skips the check of the marker's net asset value bounds.
+	// It can only be used when the administrator is the governance module account address.
+	BypassBounds bool `protobuf:"varint,4,opt,name=bypass_bounds,json=bypassBounds,proto3" json:"bypass_bounds,omitempty"`
 }
 
 func (m *MsgAddNetAssetValuesRequest) Reset()         { *m = MsgAddNetAssetValuesRequest{} }
@@ -2282,6 +2306,13 @@ func (m *MsgAddNetAssetValuesRequest) GetNetAssetValues() []NetAssetValue {
 	return nil
 }
 
+func (m *MsgAddNetAssetValuesRequest) GetBypassBounds() bool {
+	if m != nil {
+		return m.BypassBounds
+	}
+	return false
+}
+
 // MsgAddNetAssetValuesResponse defines the Msg/AddNetAssetValue response type
 type MsgAddNetAssetValuesResponse struct {
 }
@@ -2901,6 +2932,403 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetHoldingThresholdsRequest defines the Msg/SetHoldingThresholds request type
+type MsgSetHoldingThresholdsRequest struct {
+	// denom is the denomination of the marker to update.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// basis_points are the thresholds (in basis points of the marker's supply) in ascending order.
+	// An empty list removes all of the marker's holding thresholds.
+	BasisPoints []uint32 `protobuf:"varint,2,rep,packed,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+	// administrator is the signer of the message. Must have admin access on the marker.
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *MsgSetHoldingThresholdsRequest) Reset()         { *m = MsgSetHoldingThresholdsRequest{} }
+func (m *MsgSetHoldingThresholdsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHoldingThresholdsRequest) ProtoMessage()    {}
+func (*MsgSetHoldingThresholdsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{56}
+}
+func (m *MsgSetHoldingThresholdsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHoldingThresholdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHoldingThresholdsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHoldingThresholdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHoldingThresholdsRequest.Merge(m, src)
+}
+func (m *MsgSetHoldingThresholdsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHoldingThresholdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHoldingThresholdsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetHoldingThresholdsRequest proto.InternalMessageInfo
+
+func (m *MsgSetHoldingThresholdsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetHoldingThresholdsRequest) GetBasisPoints() []uint32 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return nil
+}
+
+func (m *MsgSetHoldingThresholdsRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// MsgSetHoldingThresholdsResponse defines the Msg/SetHoldingThresholds response type
+type MsgSetHoldingThresholdsResponse struct {
+}
+
+func (m *MsgSetHoldingThresholdsResponse) Reset()         { *m = MsgSetHoldingThresholdsResponse{} }
+func (m *MsgSetHoldingThresholdsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHoldingThresholdsResponse) ProtoMessage()    {}
+func (*MsgSetHoldingThresholdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{57}
+}
+func (m *MsgSetHoldingThresholdsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHoldingThresholdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHoldingThresholdsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHoldingThresholdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHoldingThresholdsResponse.Merge(m, src)
+}
+func (m *MsgSetHoldingThresholdsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHoldingThresholdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHoldingThresholdsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetHoldingThresholdsResponse proto.InternalMessageInfo
+
+// MsgSetNetAssetValueBoundsRequest defines the Msg/SetNetAssetValueBounds request type
+type MsgSetNetAssetValueBoundsRequest struct {
+	// denom is the denomination of the marker to update.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// max_change_basis_points is the largest change (in basis points, e.g. 2500 = 25%) allowed in the per-unit
+	// value of a net asset value when it is updated. Zero removes the marker's net asset value bounds.
+	MaxChangeBasisPoints uint32 `protobuf:"varint,2,opt,name=max_change_basis_points,json=maxChangeBasisPoints,proto3" json:"max_change_basis_points,omitempty"`
+	// administrator is the signer of the message. Must have admin access on the marker.
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *MsgSetNetAssetValueBoundsRequest) Reset()         { *m = MsgSetNetAssetValueBoundsRequest{} }
+func (m *MsgSetNetAssetValueBoundsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNetAssetValueBoundsRequest) ProtoMessage()    {}
+func (*MsgSetNetAssetValueBoundsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{58}
+}
+func (m *MsgSetNetAssetValueBoundsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNetAssetValueBoundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNetAssetValueBoundsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetNetAssetValueBoundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNetAssetValueBoundsRequest.Merge(m, src)
+}
+func (m *MsgSetNetAssetValueBoundsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNetAssetValueBoundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNetAssetValueBoundsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetNetAssetValueBoundsRequest proto.InternalMessageInfo
+
+func (m *MsgSetNetAssetValueBoundsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetNetAssetValueBoundsRequest) GetMaxChangeBasisPoints() uint32 {
+	if m != nil {
+		return m.MaxChangeBasisPoints
+	}
+	return 0
+}
+
+func (m *MsgSetNetAssetValueBoundsRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// MsgSetNetAssetValueBoundsResponse defines the Msg/SetNetAssetValueBounds response type
+type MsgSetNetAssetValueBoundsResponse struct {
+}
+
+func (m *MsgSetNetAssetValueBoundsResponse) Reset()         { *m = MsgSetNetAssetValueBoundsResponse{} }
+func (m *MsgSetNetAssetValueBoundsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNetAssetValueBoundsResponse) ProtoMessage()    {}
+func (*MsgSetNetAssetValueBoundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{59}
+}
+func (m *MsgSetNetAssetValueBoundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNetAssetValueBoundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNetAssetValueBoundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetNetAssetValueBoundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNetAssetValueBoundsResponse.Merge(m, src)
+}
+func (m *MsgSetNetAssetValueBoundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNetAssetValueBoundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNetAssetValueBoundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetNetAssetValueBoundsResponse proto.InternalMessageInfo
+
+// MsgUpdateMarkersBulkRequest defines a msg to update settings on several markers at once.
+// It is only usable via governance proposal. Either all of the updates are applied, or none of them are.
+type MsgUpdateMarkersBulkRequest struct {
+	// updates are the changes to make, one entry per marker.
+	Updates []MarkerBulkUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+	// The signer of this message. Must be the governance module account address.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgUpdateMarkersBulkRequest) Reset()         { *m = MsgUpdateMarkersBulkRequest{} }
+func (m *MsgUpdateMarkersBulkRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMarkersBulkRequest) ProtoMessage()    {}
+func (*MsgUpdateMarkersBulkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{60}
+}
+func (m *MsgUpdateMarkersBulkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMarkersBulkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMarkersBulkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMarkersBulkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMarkersBulkRequest.Merge(m, src)
+}
+func (m *MsgUpdateMarkersBulkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMarkersBulkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMarkersBulkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMarkersBulkRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateMarkersBulkRequest) GetUpdates() []MarkerBulkUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+func (m *MsgUpdateMarkersBulkRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MarkerBulkUpdate defines the changes to make to a single marker in a MsgUpdateMarkersBulkRequest.
+type MarkerBulkUpdate struct {
+	// The denomination of the marker to update.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// List of required attributes to remove from the marker.
+	RemoveRequiredAttributes []string `protobuf:"bytes,2,rep,name=remove_required_attributes,json=removeRequiredAttributes,proto3" json:"remove_required_attributes,omitempty"`
+	// List of required attributes to add to the marker.
+	AddRequiredAttributes []string `protobuf:"bytes,3,rep,name=add_required_attributes,json=addRequiredAttributes,proto3" json:"add_required_attributes,omitempty"`
+	// set_allow_forced_transfer indicates that the marker's allow_forced_transfer should be set to the value below.
+	SetAllowForcedTransfer bool `protobuf:"varint,4,opt,name=set_allow_forced_transfer,json=setAllowForcedTransfer,proto3" json:"set_allow_forced_transfer,omitempty"`
+	// The new value of the marker's allow_forced_transfer field. Ignored unless set_allow_forced_transfer is true.
+	AllowForcedTransfer bool `protobuf:"varint,5,opt,name=allow_forced_transfer,json=allowForcedTransfer,proto3" json:"allow_forced_transfer,omitempty"`
+	// set_allow_governance_control indicates that the marker's allow_governance_control should be set to the value below.
+	SetAllowGovernanceControl bool `protobuf:"varint,6,opt,name=set_allow_governance_control,json=setAllowGovernanceControl,proto3" json:"set_allow_governance_control,omitempty"`
+	// The new value of the marker's allow_governance_control field. Ignored unless set_allow_governance_control is true.
+	AllowGovernanceControl bool `protobuf:"varint,7,opt,name=allow_governance_control,json=allowGovernanceControl,proto3" json:"allow_governance_control,omitempty"`
+}
+
+func (m *MarkerBulkUpdate) Reset()         { *m = MarkerBulkUpdate{} }
+func (m *MarkerBulkUpdate) String() string { return proto.CompactTextString(m) }
+func (*MarkerBulkUpdate) ProtoMessage()    {}
+func (*MarkerBulkUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{61}
+}
+func (m *MarkerBulkUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkerBulkUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkerBulkUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarkerBulkUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkerBulkUpdate.Merge(m, src)
+}
+func (m *MarkerBulkUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkerBulkUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkerBulkUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkerBulkUpdate proto.InternalMessageInfo
+
+func (m *MarkerBulkUpdate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MarkerBulkUpdate) GetRemoveRequiredAttributes() []string {
+	if m != nil {
+		return m.RemoveRequiredAttributes
+	}
+	return nil
+}
+
+func (m *MarkerBulkUpdate) GetAddRequiredAttributes() []string {
+	if m != nil {
+		return m.AddRequiredAttributes
+	}
+	return nil
+}
+
+func (m *MarkerBulkUpdate) GetSetAllowForcedTransfer() bool {
+	if m != nil {
+		return m.SetAllowForcedTransfer
+	}
+	return false
+}
+
+func (m *MarkerBulkUpdate) GetAllowForcedTransfer() bool {
+	if m != nil {
+		return m.AllowForcedTransfer
+	}
+	return false
+}
+
+func (m *MarkerBulkUpdate) GetSetAllowGovernanceControl() bool {
+	if m != nil {
+		return m.SetAllowGovernanceControl
+	}
+	return false
+}
+
+func (m *MarkerBulkUpdate) GetAllowGovernanceControl() bool {
+	if m != nil {
+		return m.AllowGovernanceControl
+	}
+	return false
+}
+
+// MsgUpdateMarkersBulkResponse defines the Msg/UpdateMarkersBulk response type
+type MsgUpdateMarkersBulkResponse struct {
+}
+
+func (m *MsgUpdateMarkersBulkResponse) Reset()         { *m = MsgUpdateMarkersBulkResponse{} }
+func (m *MsgUpdateMarkersBulkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMarkersBulkResponse) ProtoMessage()    {}
+func (*MsgUpdateMarkersBulkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{62}
+}
+func (m *MsgUpdateMarkersBulkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMarkersBulkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMarkersBulkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMarkersBulkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMarkersBulkResponse.Merge(m, src)
+}
+func (m *MsgUpdateMarkersBulkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMarkersBulkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMarkersBulkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMarkersBulkResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrantAllowanceRequest)(nil), "provenance.marker.v1.MsgGrantAllowanceRequest")
 	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "provenance.marker.v1.MsgGrantAllowanceResponse")
@@ -2958,160 +3386,186 @@ func init() {
 	proto.RegisterType((*MsgSetDenomMetadataProposalResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataProposalResponse")
 	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.marker.v1.MsgUpdateParamsRequest")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.marker.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetHoldingThresholdsRequest)(nil), "provenance.marker.v1.MsgSetHoldingThresholdsRequest")
+	proto.RegisterType((*MsgSetHoldingThresholdsResponse)(nil), "provenance.marker.v1.MsgSetHoldingThresholdsResponse")
+	proto.RegisterType((*MsgSetNetAssetValueBoundsRequest)(nil), "provenance.marker.v1.MsgSetNetAssetValueBoundsRequest")
+	proto.RegisterType((*MsgSetNetAssetValueBoundsResponse)(nil), "provenance.marker.v1.MsgSetNetAssetValueBoundsResponse")
+	proto.RegisterType((*MsgUpdateMarkersBulkRequest)(nil), "provenance.marker.v1.MsgUpdateMarkersBulkRequest")
+	proto.RegisterType((*MarkerBulkUpdate)(nil), "provenance.marker.v1.MarkerBulkUpdate")
+	proto.RegisterType((*MsgUpdateMarkersBulkResponse)(nil), "provenance.marker.v1.MsgUpdateMarkersBulkResponse")
 }
 
 func init() { proto.RegisterFile("provenance/marker/v1/tx.proto", fileDescriptor_bcb203fb73175ed3) }
 
 var fileDescriptor_bcb203fb73175ed3 = []byte{
-	// 2359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x5f, 0x6f, 0x1b, 0x59,
-	0x15, 0xef, 0x38, 0x8e, 0x37, 0x3e, 0x6e, 0xd3, 0xe6, 0x36, 0x6d, 0x27, 0x93, 0xcd, 0xdf, 0x36,
-	0xad, 0xb7, 0x6c, 0xec, 0xc6, 0x0b, 0xdd, 0x6e, 0x58, 0x09, 0x39, 0xc9, 0xa6, 0x54, 0x60, 0x54,
-	0x39, 0x0b, 0x08, 0x5e, 0xac, 0xf1, 0xcc, 0xcd, 0x64, 0x14, 0x7b, 0xc6, 0x9d, 0x7b, 0xed, 0x34,
-	0x2b, 0x21, 0x21, 0xf6, 0x69, 0x9f, 0x58, 0xf6, 0x01, 0x21, 0xc4, 0x03, 0x4f, 0x08, 0xf1, 0x54,
-	0xa1, 0x15, 0x1f, 0x00, 0x09, 0xb1, 0x80, 0x40, 0xab, 0xe5, 0x05, 0xf1, 0xb0, 0xa0, 0x56, 0xa2,
-	0x08, 0xf1, 0x19, 0x00, 0xcd, 0xbd, 0x77, 0xc6, 0x1e, 0x7b, 0xe6, 0xda, 0x49, 0x5c, 0x2d, 0x2f,
-	0x6d, 0xe6, 0x9e, 0x73, 0xee, 0x39, 0xbf, 0x73, 0xcf, 0xb9, 0xf7, 0x9c, 0x93, 0xc0, 0x42, 0xcb,
-	0x73, 0x3b, 0xd8, 0xd1, 0x1d, 0x03, 0x17, 0x9b, 0xba, 0x77, 0x88, 0xbd, 0x62, 0x67, 0xa3, 0x48,
-	0x1f, 0x17, 0x5a, 0x9e, 0x4b, 0x5d, 0x34, 0xdb, 0x25, 0x17, 0x38, 0xb9, 0xd0, 0xd9, 0xd0, 0x66,
-	0xf4, 0xa6, 0xed, 0xb8, 0x45, 0xf6, 0x2f, 0x67, 0xd4, 0xe6, 0x2c, 0xd7, 0xb5, 0x1a, 0xb8, 0xc8,
-	0xbe, 0xea, 0xed, 0xfd, 0xa2, 0xee, 0x1c, 0x07, 0x24, 0xc3, 0x25, 0x4d, 0x97, 0xd4, 0xd8, 0x57,
-	0x91, 0x7f, 0x08, 0xd2, 0xac, 0xe5, 0x5a, 0x2e, 0x5f, 0xf7, 0x7f, 0x12, 0xab, 0x8b, 0x9c, 0xa7,
-	0x58, 0xd7, 0x09, 0x2e, 0x76, 0x36, 0xea, 0x98, 0xea, 0x1b, 0x45, 0xc3, 0xb5, 0x9d, 0x01, 0xba,
-	0x73, 0x18, 0xd2, 0xfd, 0x0f, 0x41, 0xbf, 0x26, 0xe8, 0x4d, 0x62, 0xf9, 0x60, 0x9a, 0xc4, 0x12,
-	0x84, 0x35, 0xbb, 0x6e, 0x14, 0xf5, 0x56, 0xab, 0x61, 0x1b, 0x3a, 0xb5, 0x5d, 0x87, 0x14, 0xa9,
-	0xa7, 0x3b, 0x64, 0x3f, 0x0a, 0x5a, 0x5b, 0x89, 0xf5, 0x89, 0x80, 0xcf, 0x59, 0x6e, 0xc6, 0xb2,
-	0xe8, 0x86, 0x81, 0x09, 0xb1, 0x3c, 0xdd, 0xa1, 0x9c, 0x6f, 0xf5, 0x0f, 0x0a, 0xa8, 0x15, 0x62,
-	0xdd, 0xf7, 0x97, 0xca, 0x8d, 0x86, 0x7b, 0xe4, 0x4b, 0x54, 0xf1, 0xa3, 0x36, 0x26, 0x14, 0xcd,
-	0xc2, 0xa4, 0x89, 0x1d, 0xb7, 0xa9, 0x2a, 0xcb, 0x4a, 0x3e, 0x5b, 0xe5, 0x1f, 0xe8, 0x06, 0x5c,
-	0xd0, 0xcd, 0xa6, 0xed, 0xd8, 0x84, 0x7a, 0x3a, 0x75, 0x3d, 0x35, 0xc5, 0xa8, 0xd1, 0x45, 0xa4,
-	0xc2, 0x4b, 0x4c, 0x0f, 0xc6, 0xea, 0x04, 0xa3, 0x07, 0x9f, 0xe8, 0x2d, 0xc8, 0xea, 0x81, 0x26,
-	0x35, 0xbd, 0xac, 0xe4, 0x73, 0xa5, 0xd9, 0x02, 0x3f, 0x9d, 0x42, 0x70, 0x3a, 0x85, 0xb2, 0x73,
-	0xbc, 0x35, 0xf3, 0xfb, 0x0f, 0xd7, 0x2f, 0xec, 0x62, 0x1c, 0xda, 0xf5, 0xa0, 0xda, 0x95, 0xdc,
-	0x44, 0xdf, 0x7b, 0xfe, 0xe4, 0x76, 0x54, 0xe9, 0xea, 0x3c, 0xcc, 0xc5, 0x80, 0x21, 0x2d, 0xd7,
-	0x21, 0x78, 0xf5, 0xbf, 0x69, 0xb8, 0x5c, 0x21, 0x56, 0xd9, 0x34, 0x2b, 0xcc, 0x21, 0x01, 0xca,
-	0xd7, 0x21, 0xa3, 0x37, 0xdd, 0xb6, 0x43, 0x19, 0xcc, 0x5c, 0x69, 0xae, 0x20, 0x42, 0xc0, 0x3f,
-	0xde, 0x82, 0x38, 0xbe, 0xc2, 0xb6, 0x6b, 0x3b, 0x5b, 0xe9, 0x8f, 0x3e, 0x5d, 0x3a, 0x57, 0x15,
-	0xec, 0x3e, 0xc4, 0xa6, 0xee, 0xe8, 0x16, 0xf6, 0x02, 0x88, 0xe2, 0x13, 0xad, 0xc0, 0xf9, 0x7d,
-	0xcf, 0x6d, 0xd6, 0x74, 0xd3, 0xf4, 0x30, 0x21, 0x0c, 0x65, 0xb6, 0x9a, 0xf3, 0xd7, 0xca, 0x7c,
-	0x09, 0x6d, 0x42, 0x86, 0x50, 0x9d, 0xb6, 0x89, 0x3a, 0xb9, 0xac, 0xe4, 0xa7, 0x4b, 0xab, 0x85,
-	0xb8, 0x48, 0x2e, 0x70, 0x53, 0xf7, 0x18, 0x67, 0x55, 0x48, 0xa0, 0x32, 0xe4, 0x38, 0x47, 0x8d,
-	0x1e, 0xb7, 0xb0, 0x9a, 0x61, 0x1b, 0x2c, 0xcb, 0x36, 0x78, 0xfb, 0xb8, 0x85, 0xab, 0xd0, 0x0c,
-	0x7f, 0x46, 0x5f, 0x86, 0x1c, 0x0f, 0x86, 0x5a, 0xc3, 0x26, 0x54, 0x7d, 0x69, 0x79, 0x22, 0x9f,
-	0x2b, 0xad, 0xc4, 0x6f, 0x51, 0x66, 0x8c, 0xcc, 0xab, 0xc2, 0x03, 0xc0, 0x65, 0xbf, 0x6a, 0x13,
-	0xea, 0x63, 0x25, 0xed, 0x56, 0xab, 0x71, 0x5c, 0xdb, 0xb7, 0x1f, 0x63, 0x53, 0x9d, 0x5a, 0x56,
-	0xf2, 0x53, 0xd5, 0x1c, 0x5f, 0xdb, 0xf5, 0x97, 0xd0, 0x3d, 0x50, 0xd9, 0xb9, 0xd5, 0x2c, 0xb7,
-	0x83, 0x3d, 0xb6, 0x7d, 0xcd, 0x70, 0x1d, 0xea, 0xb9, 0x0d, 0x35, 0xcb, 0xd8, 0xaf, 0x32, 0xfa,
-	0xfd, 0x90, 0xbc, 0xcd, 0xa9, 0xa8, 0x04, 0x57, 0xb8, 0xe4, 0xbe, 0xeb, 0x19, 0xd8, 0xac, 0x05,
-	0xe9, 0xa0, 0x02, 0x13, 0xbb, 0xcc, 0x88, 0xbb, 0x8c, 0xf6, 0xb6, 0x20, 0xa1, 0x22, 0x5c, 0xf6,
-	0xf0, 0xa3, 0xb6, 0xed, 0x61, 0xb3, 0xa6, 0x53, 0xea, 0xd9, 0xf5, 0x36, 0xc5, 0x44, 0xcd, 0x2d,
-	0x4f, 0xe4, 0xb3, 0x55, 0x14, 0x90, 0xca, 0x21, 0x05, 0x2d, 0x41, 0xb6, 0x4d, 0xcc, 0x9a, 0x81,
-	0x1d, 0x4a, 0xd4, 0xf3, 0xcb, 0x4a, 0x3e, 0xbd, 0x95, 0x52, 0x95, 0xea, 0x54, 0x9b, 0x98, 0xdb,
-	0xfe, 0x1a, 0xba, 0x0a, 0x99, 0x8e, 0xdb, 0x68, 0x37, 0xb1, 0x7a, 0xc1, 0xa7, 0x56, 0xc5, 0x17,
-	0x9a, 0xe7, 0x82, 0x4d, 0xbb, 0xd1, 0x20, 0xea, 0x34, 0x23, 0xf9, 0x42, 0x15, 0xff, 0x7b, 0x73,
-	0xc6, 0x8f, 0xcf, 0x48, 0x18, 0xac, 0x5e, 0x85, 0xd9, 0x68, 0x00, 0x8a, 0xc8, 0xfc, 0x99, 0x12,
-	0x44, 0x26, 0x77, 0xf5, 0x38, 0xf2, 0xef, 0x4b, 0x90, 0xe1, 0x87, 0xa4, 0x4e, 0x9c, 0xec, 0x6c,
-	0x85, 0x58, 0x6c, 0x7e, 0x85, 0x00, 0x02, 0x3b, 0x05, 0x80, 0x1f, 0x28, 0x70, 0xb5, 0x42, 0xac,
-	0x1d, 0xdc, 0xc0, 0x14, 0x8f, 0x0f, 0xc3, 0x2d, 0xb8, 0xe8, 0xe1, 0xa6, 0xdb, 0xf1, 0x0f, 0x52,
-	0x64, 0x12, 0x4f, 0xb4, 0x69, 0xb1, 0x2c, 0x92, 0x29, 0xd6, 0xd6, 0x39, 0xb8, 0x36, 0x60, 0x92,
-	0x30, 0xd7, 0x04, 0x54, 0x21, 0xd6, 0xae, 0xed, 0xe8, 0x0d, 0xfb, 0x9d, 0x71, 0xdc, 0x76, 0xb1,
-	0x06, 0x5c, 0x61, 0x87, 0xda, 0xd5, 0x12, 0x51, 0x5e, 0x36, 0xa8, 0xdd, 0xd1, 0xe9, 0x0b, 0x56,
-	0xde, 0xd5, 0x22, 0x94, 0xd7, 0xe1, 0x52, 0x85, 0x58, 0xdb, 0x7e, 0x10, 0x34, 0x5e, 0x94, 0xea,
-	0xcb, 0x30, 0xd3, 0xa3, 0x23, 0xa2, 0x98, 0x9f, 0xc6, 0x8b, 0x55, 0x1c, 0xe8, 0x10, 0x8a, 0xdf,
-	0x55, 0x60, 0xba, 0x42, 0xac, 0x8a, 0xed, 0xd0, 0x33, 0x5f, 0xf8, 0xa7, 0x37, 0x6d, 0x06, 0x2e,
-	0x86, 0x46, 0x44, 0x0d, 0xdb, 0x6a, 0x7b, 0xce, 0x67, 0x6e, 0x18, 0x37, 0x42, 0x18, 0xf6, 0x1f,
-	0x85, 0x45, 0xe8, 0x37, 0x6d, 0x7a, 0x60, 0x7a, 0xfa, 0xd1, 0x38, 0x12, 0x79, 0x01, 0x80, 0xba,
-	0x7d, 0x39, 0x9c, 0xa5, 0x6e, 0xf0, 0x16, 0x1e, 0x87, 0xb8, 0xd3, 0xec, 0xae, 0x92, 0xe0, 0xde,
-	0xf5, 0x71, 0xff, 0xe2, 0x6f, 0x4b, 0x79, 0xcb, 0xa6, 0x07, 0xed, 0x7a, 0xc1, 0x70, 0x9b, 0xa2,
-	0x62, 0x13, 0xff, 0xad, 0x13, 0xf3, 0xb0, 0xe8, 0x3f, 0x8b, 0x84, 0x09, 0x90, 0x1f, 0xfb, 0xb7,
-	0x70, 0x03, 0x5b, 0xba, 0x71, 0x5c, 0xf3, 0x4b, 0x34, 0xf2, 0xf3, 0xe7, 0x4f, 0x6e, 0x2b, 0x81,
-	0xe7, 0x24, 0xb9, 0xd3, 0xc5, 0x2f, 0xfc, 0xf2, 0x3b, 0xee, 0x97, 0xe0, 0x9d, 0x19, 0xff, 0xa1,
-	0x4d, 0xc4, 0xb9, 0x6e, 0x84, 0x52, 0x22, 0xea, 0xdd, 0xc9, 0x3e, 0xef, 0x4a, 0x20, 0x76, 0xa1,
-	0x08, 0x88, 0xff, 0x50, 0xe0, 0x4a, 0x85, 0x58, 0x0f, 0xea, 0x46, 0x3f, 0xca, 0x0f, 0x14, 0x98,
-	0x0a, 0x1f, 0x5f, 0x0e, 0xf4, 0x95, 0x82, 0x5d, 0x37, 0x0a, 0xbd, 0xd5, 0x6a, 0x21, 0xe0, 0x60,
-	0x85, 0x47, 0x77, 0xff, 0xad, 0xaf, 0xf8, 0xc0, 0xff, 0xfa, 0xe9, 0xd2, 0xf6, 0xe0, 0xa9, 0xd9,
-	0x75, 0x63, 0xdd, 0x72, 0x8b, 0x9d, 0x7b, 0xc5, 0xa6, 0x6b, 0xb6, 0x1b, 0x98, 0xf8, 0xf5, 0x6f,
-	0x4f, 0xdd, 0xcb, 0x8f, 0xb2, 0xd7, 0xd8, 0xd0, 0x8e, 0x33, 0x84, 0xbd, 0xca, 0xde, 0xab, 0x08,
-	0x4e, 0xe1, 0x82, 0x3f, 0x2a, 0xa0, 0x55, 0x88, 0xb5, 0x87, 0xe9, 0x8e, 0x1f, 0xe0, 0x15, 0x4c,
-	0x75, 0x53, 0xa7, 0x7a, 0xe0, 0x87, 0x36, 0x4c, 0x35, 0xc5, 0x92, 0x70, 0xc3, 0x42, 0xf7, 0xbc,
-	0x9d, 0xc3, 0xf0, 0xbc, 0x03, 0xb9, 0xad, 0x4d, 0x01, 0xbd, 0x24, 0x0d, 0xd8, 0xc7, 0xbc, 0x57,
-	0x10, 0x60, 0x03, 0x9d, 0xa1, 0xaa, 0x33, 0x20, 0x5d, 0x80, 0xf9, 0x58, 0x38, 0x02, 0xee, 0x9f,
-	0xd3, 0x70, 0x9d, 0x3f, 0xe9, 0xc1, 0x43, 0x15, 0xbc, 0x19, 0xff, 0x0f, 0x45, 0x72, 0x5f, 0xa1,
-	0x3b, 0x79, 0xf6, 0x42, 0x37, 0x33, 0xbe, 0x42, 0xf7, 0xa5, 0x93, 0x15, 0xba, 0x53, 0xa7, 0x2b,
-	0x74, 0xb3, 0x27, 0x2e, 0x74, 0x61, 0xb4, 0x42, 0x37, 0x27, 0x2d, 0x74, 0xcf, 0x27, 0x17, 0xba,
-	0x17, 0x86, 0x17, 0xba, 0x37, 0xe1, 0x86, 0x3c, 0xa8, 0x44, 0xf4, 0xfd, 0x49, 0x81, 0x65, 0x3f,
-	0x3a, 0x99, 0x0b, 0x1f, 0x38, 0x86, 0x87, 0x75, 0x82, 0x1f, 0x7a, 0x6e, 0xcb, 0x25, 0x7a, 0xe3,
-	0xcc, 0xa1, 0xb7, 0x06, 0xd3, 0x54, 0xf7, 0x2c, 0x4c, 0xc3, 0x10, 0x13, 0x59, 0xc3, 0x57, 0x83,
-	0x20, 0xbb, 0x0b, 0x59, 0xbd, 0x4d, 0x0f, 0x5c, 0xcf, 0xa6, 0xc7, 0x3c, 0x46, 0xb7, 0xd4, 0x4f,
-	0x3e, 0x5c, 0x9f, 0x15, 0x5a, 0x04, 0xdb, 0x1e, 0xf5, 0x6c, 0xc7, 0xaa, 0x76, 0x59, 0x37, 0xd1,
-	0x3f, 0x7f, 0xba, 0xa4, 0xf8, 0xd8, 0xbb, 0x6b, 0xab, 0xd7, 0x61, 0x45, 0x82, 0x47, 0xa0, 0xfe,
-	0xa4, 0x17, 0xf5, 0x0e, 0x8e, 0x47, 0x5d, 0x1f, 0x1d, 0x75, 0x51, 0x5c, 0x31, 0xb7, 0x46, 0x7c,
-	0x13, 0x43, 0x07, 0x45, 0x90, 0xa7, 0xc6, 0x87, 0x7c, 0x10, 0x93, 0x40, 0xfe, 0xc3, 0x14, 0xac,
-	0x56, 0x88, 0xf5, 0xf5, 0x96, 0x29, 0x4a, 0xdf, 0x68, 0x80, 0xca, 0x4b, 0x8d, 0x37, 0x41, 0xe3,
-	0x65, 0x7f, 0x2d, 0x2e, 0xea, 0x53, 0x2c, 0xea, 0x55, 0xce, 0x31, 0xb8, 0x35, 0xba, 0x0b, 0xd7,
-	0x74, 0xd3, 0x8c, 0x15, 0x9d, 0x60, 0xa2, 0x57, 0x74, 0xd3, 0x8c, 0x91, 0xbb, 0x0f, 0x28, 0xc8,
-	0xc5, 0x5a, 0xd7, 0x59, 0xe9, 0x21, 0xce, 0x9a, 0x09, 0x64, 0xca, 0xa1, 0xd3, 0xe6, 0x03, 0xa7,
-	0xc5, 0xec, 0xb7, 0xba, 0xc6, 0x6e, 0xe1, 0x64, 0xbf, 0x08, 0xff, 0xfd, 0x4a, 0x81, 0xc5, 0x90,
-	0x2f, 0x7a, 0x1b, 0xc8, 0x7d, 0x97, 0x78, 0xbd, 0xa4, 0x92, 0xaf, 0x97, 0x71, 0xe6, 0xc5, 0x0a,
-	0x2c, 0x25, 0xda, 0x2d, 0xb0, 0xbd, 0xc7, 0x27, 0x51, 0x7b, 0x98, 0x96, 0x0d, 0xc3, 0x0f, 0xcf,
-	0x9d, 0x9e, 0x67, 0x37, 0x1e, 0xd5, 0x2c, 0x4c, 0x76, 0xf4, 0x46, 0x1b, 0x8b, 0xbc, 0xe6, 0x1f,
-	0xe8, 0x0e, 0x64, 0x88, 0x6d, 0x39, 0xc1, 0x83, 0x23, 0x31, 0x5a, 0xf0, 0x6d, 0x5e, 0x0c, 0x2c,
-	0x16, 0x0b, 0x62, 0x8e, 0xd4, 0x6f, 0x8a, 0x30, 0xf4, 0x5f, 0x0a, 0xbc, 0x1c, 0x82, 0xd9, 0xc3,
-	0x8e, 0xb9, 0x83, 0x9d, 0x63, 0xff, 0x85, 0x90, 0x1b, 0x7b, 0x17, 0xae, 0x89, 0xf0, 0x35, 0xb1,
-	0x63, 0x77, 0x5b, 0xda, 0x30, 0x76, 0xaf, 0x70, 0xf2, 0x0e, 0xa3, 0x96, 0x03, 0x22, 0xba, 0x03,
-	0xb3, 0x7e, 0xe0, 0x0e, 0x08, 0xf1, 0xa8, 0x45, 0xba, 0x69, 0xf6, 0x4b, 0x44, 0x0e, 0x2e, 0x7d,
-	0xb6, 0x83, 0x5b, 0x82, 0x85, 0x04, 0xac, 0xc2, 0x1b, 0xbf, 0x56, 0x58, 0x81, 0x51, 0x36, 0xcd,
-	0xaf, 0x61, 0x5a, 0x26, 0x04, 0xd3, 0x6f, 0xf8, 0xa7, 0x30, 0x96, 0xfe, 0x7f, 0x0f, 0x2e, 0x39,
-	0xfe, 0xed, 0xed, 0xef, 0x5a, 0x63, 0x87, 0x1b, 0x4c, 0x33, 0xae, 0xc7, 0x3f, 0xe0, 0x11, 0x13,
-	0xc4, 0x6b, 0x30, 0xed, 0x44, 0xec, 0x8a, 0x2d, 0x92, 0x16, 0xd9, 0x89, 0xc6, 0x60, 0x10, 0x20,
-	0x7f, 0xab, 0xb0, 0x7b, 0xcb, 0x0f, 0x88, 0x5e, 0xb9, 0xfe, 0x3b, 0x3b, 0x1e, 0x6b, 0x77, 0x12,
-	0x93, 0x3a, 0xd5, 0x24, 0x66, 0xac, 0x89, 0xc8, 0x2f, 0x9a, 0x64, 0x20, 0x02, 0xf0, 0x2f, 0x15,
-	0x58, 0xab, 0x10, 0xab, 0xca, 0x22, 0xf2, 0x14, 0x98, 0x63, 0x26, 0x37, 0x3c, 0xc8, 0xfb, 0x26,
-	0x37, 0x63, 0xc5, 0x96, 0x87, 0x9b, 0xc3, 0x6c, 0x16, 0xf0, 0x7e, 0xc3, 0xef, 0xd1, 0xed, 0x03,
-	0xdd, 0xb1, 0x30, 0x1f, 0xae, 0x8e, 0x86, 0xab, 0x0c, 0xe0, 0xe0, 0xa3, 0x9a, 0x98, 0xdc, 0xa6,
-	0x46, 0x9e, 0xdc, 0x66, 0x1d, 0x7c, 0xc4, 0x7f, 0x7c, 0x01, 0xd7, 0x6a, 0x3c, 0x0c, 0x01, 0xf5,
-	0xfd, 0x14, 0x2b, 0x36, 0x82, 0x6e, 0xf6, 0x2d, 0x62, 0x78, 0xee, 0xd1, 0x68, 0x60, 0x8d, 0xb0,
-	0x04, 0x49, 0x0d, 0x6b, 0xcb, 0xef, 0x9c, 0xb4, 0x2d, 0x97, 0x14, 0x69, 0x13, 0x43, 0x8b, 0xb4,
-	0xf4, 0x38, 0x4a, 0x95, 0x24, 0x8f, 0x08, 0xbf, 0x3d, 0x0b, 0x53, 0x3e, 0xd2, 0x38, 0xf5, 0x7b,
-	0xee, 0x33, 0xea, 0x07, 0x4f, 0x5b, 0xb9, 0x4d, 0x27, 0x5d, 0x07, 0x09, 0x20, 0x85, 0x33, 0x7e,
-	0xc2, 0xe7, 0xbb, 0xfc, 0x19, 0x78, 0xa8, 0x7b, 0x7a, 0x33, 0xbc, 0xdf, 0x23, 0x96, 0x28, 0x23,
-	0x5b, 0x82, 0x36, 0x21, 0xd3, 0x62, 0x1b, 0x31, 0xf3, 0x73, 0xa5, 0x97, 0xe3, 0xb3, 0x88, 0x2b,
-	0x0b, 0x2e, 0x44, 0x2e, 0x31, 0x80, 0x82, 0x8f, 0x7a, 0xa3, 0xd6, 0x71, 0xcb, 0x4b, 0xff, 0x9e,
-	0x83, 0x89, 0x0a, 0xb1, 0x50, 0x0d, 0xa6, 0x82, 0x5e, 0x04, 0xe5, 0x13, 0x12, 0x76, 0x60, 0x24,
-	0xac, 0xbd, 0x32, 0x02, 0x27, 0x57, 0xe4, 0x2b, 0x08, 0x9a, 0x1c, 0x89, 0x82, 0xbe, 0xb1, 0xaf,
-	0x44, 0x41, 0xff, 0xe8, 0x16, 0x7d, 0x0b, 0x32, 0x7c, 0xa6, 0x8a, 0x6e, 0x26, 0x0a, 0x45, 0x06,
-	0xbb, 0xda, 0xad, 0xa1, 0x7c, 0xdd, 0xad, 0xf9, 0xd4, 0x54, 0xb2, 0x75, 0x64, 0x74, 0x2b, 0xd9,
-	0x3a, 0x3a, 0x7e, 0x45, 0x7b, 0x90, 0xae, 0xd8, 0x0e, 0x45, 0x37, 0x12, 0x05, 0x7a, 0x26, 0xb3,
-	0xda, 0xda, 0x10, 0xae, 0xee, 0xa6, 0x5b, 0x6d, 0xcf, 0x91, 0x6c, 0xda, 0x33, 0x55, 0x95, 0x6c,
-	0xda, 0x3b, 0xf6, 0x44, 0x75, 0xc8, 0x86, 0xbf, 0xd8, 0x40, 0x92, 0x73, 0xe9, 0xfb, 0x25, 0x8d,
-	0x76, 0x7b, 0x14, 0x56, 0xa1, 0xe3, 0x10, 0xce, 0xf7, 0xfe, 0x42, 0x02, 0xbd, 0x3a, 0xc4, 0x8d,
-	0x51, 0x4d, 0xeb, 0x23, 0x72, 0x77, 0x23, 0x32, 0xb8, 0xe3, 0x24, 0x11, 0xd9, 0x37, 0xe6, 0x95,
-	0x44, 0x64, 0xff, 0x40, 0x54, 0x78, 0x8c, 0xbf, 0x73, 0x72, 0x8f, 0x45, 0x66, 0x49, 0x72, 0x8f,
-	0x45, 0x27, 0x04, 0x3e, 0x88, 0xb0, 0x21, 0x49, 0x06, 0xd1, 0xd7, 0x04, 0x49, 0x40, 0xf4, 0xb7,
-	0x1d, 0xe8, 0x00, 0x72, 0x3d, 0x63, 0x40, 0xf4, 0xb9, 0x44, 0xc9, 0xc1, 0xa1, 0xa8, 0xf6, 0xea,
-	0x68, 0xcc, 0x42, 0xd3, 0x11, 0x5c, 0xea, 0xbf, 0x68, 0xd1, 0x9d, 0xc4, 0x1d, 0x12, 0x06, 0x90,
-	0xda, 0xc6, 0x09, 0x24, 0x84, 0xe2, 0x47, 0x30, 0x1d, 0xfd, 0x95, 0x38, 0x2a, 0x24, 0x6e, 0x12,
-	0xfb, 0x87, 0x00, 0x5a, 0x71, 0x64, 0x7e, 0xa1, 0xf2, 0x03, 0x05, 0xe6, 0x12, 0xc7, 0x3f, 0xe8,
-	0x0d, 0x59, 0x00, 0x48, 0xe7, 0x90, 0xda, 0xe6, 0x69, 0x44, 0x85, 0x51, 0xef, 0x29, 0x70, 0x35,
-	0x7e, 0x34, 0x83, 0xee, 0x26, 0x7b, 0x55, 0x36, 0x9b, 0xd2, 0x5e, 0x3f, 0xb1, 0xdc, 0x80, 0x2d,
-	0xfd, 0xc3, 0x92, 0xa1, 0xb6, 0x24, 0x4c, 0x8c, 0x86, 0xda, 0x92, 0x34, 0x95, 0x41, 0xdf, 0x57,
-	0x40, 0x4d, 0x1a, 0x3d, 0xa0, 0x7b, 0x89, 0xbb, 0x0e, 0x99, 0xe2, 0x68, 0x6f, 0x9c, 0x42, 0x52,
-	0x58, 0xf4, 0xae, 0x02, 0xb3, 0x71, 0xc3, 0x02, 0xf4, 0xf9, 0x21, 0x7b, 0xc6, 0xce, 0x44, 0xb4,
-	0x2f, 0x9c, 0x50, 0xaa, 0x9b, 0x37, 0xd1, 0x11, 0x80, 0x24, 0x6f, 0x62, 0xc7, 0x16, 0x92, 0xbc,
-	0x89, 0x9f, 0x2d, 0xa0, 0xef, 0x00, 0x1a, 0xec, 0xb5, 0x51, 0x69, 0x88, 0xfd, 0x31, 0x43, 0x08,
-	0xed, 0xb5, 0x13, 0xc9, 0x08, 0xf5, 0xef, 0xc0, 0xcc, 0x40, 0x13, 0x8c, 0x36, 0x64, 0x29, 0x17,
-	0xdb, 0xf4, 0x6b, 0xa5, 0x93, 0x88, 0xf4, 0x44, 0x61, 0x52, 0x5f, 0x2a, 0x89, 0xc2, 0x21, 0x3d,
-	0xb9, 0x24, 0x0a, 0x87, 0x35, 0xc1, 0xe8, 0x47, 0x0a, 0xcc, 0x4b, 0xba, 0x49, 0xf4, 0xc5, 0xc4,
-	0xad, 0x87, 0xf7, 0xcd, 0xda, 0x9b, 0xa7, 0x13, 0xee, 0x49, 0x90, 0xb8, 0xb6, 0x4f, 0x92, 0x20,
-	0x92, 0x66, 0x57, 0x92, 0x20, 0xb2, 0xde, 0x92, 0x5d, 0x62, 0xf1, 0x6d, 0x94, 0xe4, 0x12, 0x93,
-	0x76, 0xa2, 0x92, 0x4b, 0x4c, 0xde, 0xaf, 0x05, 0xe1, 0x13, 0xdb, 0xc7, 0xc8, 0xc3, 0x47, 0xd6,
-	0xdf, 0xc9, 0xc3, 0x47, 0xda, 0x34, 0xf9, 0xc5, 0x5e, 0x6f, 0x4b, 0x22, 0x29, 0xf6, 0x62, 0xfa,
-	0x2a, 0x49, 0xb1, 0x17, 0xd7, 0xe7, 0x68, 0x93, 0xdf, 0x7d, 0xfe, 0xe4, 0xb6, 0xb2, 0x65, 0x7d,
-	0xf4, 0x74, 0x51, 0xf9, 0xf8, 0xe9, 0xa2, 0xf2, 0xf7, 0xa7, 0x8b, 0xca, 0xfb, 0xcf, 0x16, 0xcf,
-	0x7d, 0xfc, 0x6c, 0xf1, 0xdc, 0x5f, 0x9e, 0x2d, 0x9e, 0x83, 0x6b, 0xb6, 0x1b, 0xbb, 0xe3, 0x43,
-	0xe5, 0xdb, 0xbd, 0xad, 0x68, 0x97, 0x65, 0xdd, 0x76, 0x7b, 0xbe, 0x8a, 0x8f, 0x83, 0x3f, 0x23,
-	0x64, 0x3d, 0x69, 0x3d, 0xc3, 0xfe, 0x52, 0xef, 0xb5, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x5c,
-	0x2e, 0xee, 0x73, 0x9f, 0x29, 0x00, 0x00,
+	// 2672 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xfb, 0x2b, 0xf6, 0x1b, 0xdb, 0x89, 0x2b, 0x8e, 0xdd, 0xee, 0x24, 0xfe, 0x4a, 0x9c,
+	0x38, 0x61, 0x3d, 0x13, 0x7b, 0xc9, 0x97, 0x59, 0xb1, 0x1a, 0xdb, 0xeb, 0x6c, 0x44, 0x06, 0x45,
+	0xe3, 0x00, 0x82, 0x4b, 0xab, 0xa6, 0xbb, 0xd2, 0x6e, 0xb9, 0xa7, 0x7b, 0xd2, 0xd5, 0x33, 0xb6,
+	0x57, 0x42, 0x42, 0xec, 0x69, 0x2f, 0xb0, 0xda, 0x03, 0x5a, 0x21, 0x0e, 0x9c, 0x10, 0x42, 0x42,
+	0x5a, 0xd0, 0x8a, 0x7f, 0x00, 0x21, 0x16, 0x10, 0x68, 0xb5, 0x5c, 0x10, 0x87, 0x05, 0x25, 0x12,
+	0x41, 0xfc, 0x09, 0x1c, 0x60, 0xd5, 0x55, 0xd5, 0x3d, 0xd3, 0x33, 0xdd, 0x3d, 0x1f, 0x9e, 0x68,
+	0xf7, 0x92, 0xb8, 0xab, 0xde, 0xab, 0xf7, 0x7e, 0xaf, 0x5e, 0x55, 0xbd, 0xfa, 0xd5, 0xc0, 0xa5,
+	0x8a, 0xeb, 0xd4, 0x88, 0x8d, 0x6d, 0x8d, 0xe4, 0xca, 0xd8, 0x3d, 0x20, 0x6e, 0xae, 0xb6, 0x9e,
+	0xf3, 0x8e, 0xb2, 0x15, 0xd7, 0xf1, 0x1c, 0x34, 0x5d, 0xef, 0xce, 0xf2, 0xee, 0x6c, 0x6d, 0x5d,
+	0x99, 0xc2, 0x65, 0xd3, 0x76, 0x72, 0xec, 0x5f, 0x2e, 0xa8, 0xcc, 0x19, 0x8e, 0x63, 0x58, 0x24,
+	0xc7, 0xbe, 0x4a, 0xd5, 0x27, 0x39, 0x6c, 0x1f, 0x07, 0x5d, 0x9a, 0x43, 0xcb, 0x0e, 0x55, 0xd9,
+	0x57, 0x8e, 0x7f, 0x88, 0xae, 0x69, 0xc3, 0x31, 0x1c, 0xde, 0xee, 0xff, 0x25, 0x5a, 0xe7, 0xb9,
+	0x4c, 0xae, 0x84, 0x29, 0xc9, 0xd5, 0xd6, 0x4b, 0xc4, 0xc3, 0xeb, 0x39, 0xcd, 0x31, 0xed, 0x96,
+	0x7e, 0xfb, 0x20, 0xec, 0xf7, 0x3f, 0x44, 0xff, 0xac, 0xe8, 0x2f, 0x53, 0xc3, 0x07, 0x53, 0xa6,
+	0x86, 0xe8, 0x58, 0x31, 0x4b, 0x5a, 0x0e, 0x57, 0x2a, 0x96, 0xa9, 0x61, 0xcf, 0x74, 0x6c, 0x9a,
+	0xf3, 0x5c, 0x6c, 0xd3, 0x27, 0x51, 0xd0, 0xca, 0x52, 0x6c, 0x4c, 0x04, 0x7c, 0x2e, 0x72, 0x35,
+	0x56, 0x04, 0x6b, 0x1a, 0xa1, 0xd4, 0x70, 0xb1, 0xed, 0x71, 0xb9, 0xe5, 0x3f, 0x49, 0x20, 0x17,
+	0xa8, 0x71, 0xdf, 0x6f, 0xca, 0x5b, 0x96, 0x73, 0xe8, 0x6b, 0x14, 0xc9, 0xd3, 0x2a, 0xa1, 0x1e,
+	0x9a, 0x86, 0x61, 0x9d, 0xd8, 0x4e, 0x59, 0x96, 0x16, 0xa5, 0xd5, 0xb1, 0x22, 0xff, 0x40, 0x57,
+	0x60, 0x02, 0xeb, 0x65, 0xd3, 0x36, 0xa9, 0xe7, 0x62, 0xcf, 0x71, 0xe5, 0x01, 0xd6, 0x1b, 0x6d,
+	0x44, 0x32, 0x9c, 0x66, 0x76, 0x08, 0x91, 0x07, 0x59, 0x7f, 0xf0, 0x89, 0xde, 0x80, 0x31, 0x1c,
+	0x58, 0x92, 0x87, 0x16, 0xa5, 0xd5, 0xcc, 0xc6, 0x74, 0x96, 0xcf, 0x4e, 0x36, 0x98, 0x9d, 0x6c,
+	0xde, 0x3e, 0xde, 0x9a, 0xfa, 0xe3, 0x87, 0x6b, 0x13, 0xbb, 0x84, 0x84, 0x7e, 0x3d, 0x28, 0xd6,
+	0x35, 0x37, 0xd1, 0xf7, 0x5f, 0x7c, 0x70, 0x23, 0x6a, 0x74, 0xf9, 0x02, 0xcc, 0xc5, 0x80, 0xa1,
+	0x15, 0xc7, 0xa6, 0x64, 0xf9, 0xff, 0x43, 0x70, 0xae, 0x40, 0x8d, 0xbc, 0xae, 0x17, 0x58, 0x40,
+	0x02, 0x94, 0x77, 0x60, 0x04, 0x97, 0x9d, 0xaa, 0xed, 0x31, 0x98, 0x99, 0x8d, 0xb9, 0xac, 0x48,
+	0x01, 0x7f, 0x7a, 0xb3, 0x62, 0xfa, 0xb2, 0xdb, 0x8e, 0x69, 0x6f, 0x0d, 0x7d, 0xf4, 0xe9, 0xc2,
+	0xa9, 0xa2, 0x10, 0xf7, 0x21, 0x96, 0xb1, 0x8d, 0x0d, 0xe2, 0x06, 0x10, 0xc5, 0x27, 0x5a, 0x82,
+	0xf1, 0x27, 0xae, 0x53, 0x56, 0xb1, 0xae, 0xbb, 0x84, 0x52, 0x86, 0x72, 0xac, 0x98, 0xf1, 0xdb,
+	0xf2, 0xbc, 0x09, 0x6d, 0xc2, 0x08, 0xf5, 0xb0, 0x57, 0xa5, 0xf2, 0xf0, 0xa2, 0xb4, 0x3a, 0xb9,
+	0xb1, 0x9c, 0x8d, 0xcb, 0xe4, 0x2c, 0x77, 0x75, 0x8f, 0x49, 0x16, 0x85, 0x06, 0xca, 0x43, 0x86,
+	0x4b, 0xa8, 0xde, 0x71, 0x85, 0xc8, 0x23, 0x6c, 0x80, 0xc5, 0xb4, 0x01, 0x1e, 0x1f, 0x57, 0x48,
+	0x11, 0xca, 0xe1, 0xdf, 0xe8, 0x4d, 0xc8, 0xf0, 0x64, 0x50, 0x2d, 0x93, 0x7a, 0xf2, 0xe9, 0xc5,
+	0xc1, 0xd5, 0xcc, 0xc6, 0x52, 0xfc, 0x10, 0x79, 0x26, 0xc8, 0xa2, 0x2a, 0x22, 0x00, 0x5c, 0xf7,
+	0xa1, 0x49, 0x3d, 0x1f, 0x2b, 0xad, 0x56, 0x2a, 0xd6, 0xb1, 0xfa, 0xc4, 0x3c, 0x22, 0xba, 0x3c,
+	0xba, 0x28, 0xad, 0x8e, 0x16, 0x33, 0xbc, 0x6d, 0xd7, 0x6f, 0x42, 0x77, 0x41, 0x66, 0xf3, 0xa6,
+	0x1a, 0x4e, 0x8d, 0xb8, 0x6c, 0x78, 0x55, 0x73, 0x6c, 0xcf, 0x75, 0x2c, 0x79, 0x8c, 0x89, 0xcf,
+	0xb0, 0xfe, 0xfb, 0x61, 0xf7, 0x36, 0xef, 0x45, 0x1b, 0x70, 0x9e, 0x6b, 0x3e, 0x71, 0x5c, 0x8d,
+	0xe8, 0x6a, 0xb0, 0x1c, 0x64, 0x60, 0x6a, 0xe7, 0x58, 0xe7, 0x2e, 0xeb, 0x7b, 0x2c, 0xba, 0x50,
+	0x0e, 0xce, 0xb9, 0xe4, 0x69, 0xd5, 0x74, 0x89, 0xae, 0x62, 0xcf, 0x73, 0xcd, 0x52, 0xd5, 0x23,
+	0x54, 0xce, 0x2c, 0x0e, 0xae, 0x8e, 0x15, 0x51, 0xd0, 0x95, 0x0f, 0x7b, 0xd0, 0x02, 0x8c, 0x55,
+	0xa9, 0xae, 0x6a, 0xc4, 0xf6, 0xa8, 0x3c, 0xbe, 0x28, 0xad, 0x0e, 0x6d, 0x0d, 0xc8, 0x52, 0x71,
+	0xb4, 0x4a, 0xf5, 0x6d, 0xbf, 0x0d, 0xcd, 0xc0, 0x48, 0xcd, 0xb1, 0xaa, 0x65, 0x22, 0x4f, 0xf8,
+	0xbd, 0x45, 0xf1, 0x85, 0x2e, 0x70, 0xc5, 0xb2, 0x69, 0x59, 0x54, 0x9e, 0x64, 0x5d, 0xbe, 0x52,
+	0xc1, 0xff, 0xde, 0x9c, 0xf2, 0xf3, 0x33, 0x92, 0x06, 0xcb, 0x33, 0x30, 0x1d, 0x4d, 0x40, 0x91,
+	0x99, 0x3f, 0x93, 0x82, 0xcc, 0xe4, 0xa1, 0xee, 0xc7, 0xfa, 0x7b, 0x1d, 0x46, 0xf8, 0x24, 0xc9,
+	0x83, 0xdd, 0xcd, 0xad, 0x50, 0x8b, 0x5d, 0x5f, 0x21, 0x80, 0xc0, 0x4f, 0x01, 0xe0, 0xfd, 0x01,
+	0x98, 0x29, 0x50, 0x63, 0x87, 0x58, 0xc4, 0x23, 0xfd, 0xc3, 0x70, 0x0d, 0xce, 0xb8, 0xa4, 0xec,
+	0xd4, 0x88, 0x1e, 0x84, 0x50, 0x2c, 0xb4, 0x49, 0xd1, 0x1c, 0x2c, 0xa6, 0x3b, 0x41, 0x82, 0x59,
+	0x98, 0x7a, 0x2a, 0x1b, 0x44, 0x65, 0x22, 0xd8, 0x62, 0x6b, 0x6f, 0xb4, 0xc8, 0xd3, 0xe8, 0x21,
+	0xa6, 0x5e, 0xde, 0xef, 0x2d, 0xf2, 0x4e, 0xf4, 0x10, 0x26, 0x5c, 0x52, 0x73, 0x0e, 0x88, 0x2a,
+	0x82, 0x35, 0xdc, 0x5d, 0xb0, 0xc6, 0xb9, 0x76, 0x3e, 0x39, 0x64, 0x73, 0x30, 0xdb, 0x12, 0x19,
+	0x11, 0x35, 0x1d, 0x50, 0x81, 0x1a, 0xbb, 0xa6, 0x8d, 0x2d, 0xf3, 0xad, 0x7e, 0x6c, 0xba, 0xb1,
+	0x0e, 0x9c, 0x87, 0x73, 0x11, 0x2b, 0x11, 0xe3, 0x79, 0xcd, 0x33, 0x6b, 0xd8, 0x7b, 0xc9, 0xc6,
+	0xeb, 0x56, 0x84, 0xf1, 0x12, 0x9c, 0x2d, 0x50, 0x63, 0xdb, 0x0f, 0xaf, 0xf5, 0xb2, 0x4c, 0x9f,
+	0x83, 0xa9, 0x06, 0x1b, 0x11, 0xc3, 0x7c, 0x36, 0x5e, 0xae, 0xe1, 0xc0, 0x86, 0x30, 0xfc, 0xb6,
+	0x04, 0x93, 0x05, 0x6a, 0x14, 0x4c, 0xdb, 0x3b, 0xf1, 0xb9, 0xd3, 0xbb, 0x6b, 0x53, 0x70, 0x26,
+	0x74, 0x22, 0xea, 0xd8, 0x56, 0xd5, 0xb5, 0x3f, 0x77, 0xc7, 0xb8, 0x13, 0xc2, 0xb1, 0xff, 0x49,
+	0x2c, 0x43, 0xbf, 0x65, 0x7a, 0xfb, 0xba, 0x8b, 0x0f, 0xfb, 0xb1, 0x9f, 0x5c, 0x02, 0xf0, 0x9c,
+	0xa6, 0xad, 0x64, 0xcc, 0x73, 0x82, 0x5d, 0xe4, 0x38, 0xc4, 0x3d, 0xb4, 0x38, 0x98, 0x8e, 0x7b,
+	0xd7, 0xc7, 0xfd, 0x8b, 0x7f, 0x2c, 0xac, 0x1a, 0xa6, 0xb7, 0x5f, 0x2d, 0x65, 0x35, 0xa7, 0x2c,
+	0x0a, 0x47, 0xf1, 0xdf, 0x1a, 0xd5, 0x0f, 0x72, 0xfe, 0xe9, 0x4c, 0x99, 0x02, 0xfd, 0xb1, 0x7f,
+	0x18, 0x58, 0xc4, 0xc0, 0xda, 0xb1, 0xea, 0x57, 0x8a, 0xf4, 0xe7, 0x2f, 0x3e, 0xb8, 0x21, 0x05,
+	0x91, 0x4b, 0x59, 0x3b, 0x75, 0xfc, 0x22, 0x2e, 0x7f, 0xe0, 0x71, 0x09, 0x8e, 0xbb, 0xfe, 0x4f,
+	0xda, 0x60, 0x5c, 0xe8, 0x3a, 0xa8, 0x68, 0xa2, 0xd1, 0x1d, 0x6e, 0x8a, 0x6e, 0x0a, 0xc4, 0x3a,
+	0x14, 0x01, 0xf1, 0x5f, 0x12, 0x9c, 0x2f, 0x50, 0xe3, 0x41, 0x49, 0x6b, 0x46, 0xf9, 0x9e, 0x04,
+	0xa3, 0x61, 0x0d, 0xc0, 0x81, 0x5e, 0xcf, 0x9a, 0x25, 0x2d, 0xdb, 0x58, 0x34, 0x67, 0x03, 0x09,
+	0x56, 0xff, 0xd4, 0xc7, 0xdf, 0xfa, 0x9a, 0x0f, 0xfc, 0xef, 0x9f, 0x2e, 0x6c, 0xb7, 0xce, 0x9a,
+	0x59, 0xd2, 0xd6, 0x0c, 0x27, 0x57, 0xbb, 0x9b, 0x2b, 0x3b, 0x7a, 0xd5, 0x22, 0xd4, 0x2f, 0xc3,
+	0x1b, 0xca, 0x6f, 0x3e, 0x95, 0x8d, 0xce, 0x86, 0x7e, 0x9c, 0x20, 0xed, 0x65, 0x98, 0x69, 0xc6,
+	0x29, 0x42, 0xf0, 0x67, 0x09, 0x94, 0x02, 0x35, 0xf6, 0x88, 0xb7, 0xe3, 0x27, 0x78, 0x81, 0x78,
+	0x58, 0xc7, 0x1e, 0x0e, 0xe2, 0x50, 0x85, 0xd1, 0xb2, 0x68, 0x12, 0x61, 0xb8, 0x54, 0x9f, 0x6f,
+	0xfb, 0x20, 0x9c, 0xef, 0x40, 0x6f, 0x6b, 0x53, 0x40, 0xdf, 0x48, 0x4d, 0xd8, 0x23, 0x7e, 0x65,
+	0x11, 0x60, 0x03, 0x9b, 0xa1, 0xa9, 0x13, 0x20, 0xbd, 0x04, 0x17, 0x62, 0xe1, 0x08, 0xb8, 0x7f,
+	0x1d, 0x82, 0xcb, 0xbc, 0xb2, 0x08, 0x0e, 0xaa, 0xe0, 0xcc, 0xf8, 0x22, 0xd4, 0xea, 0x4d, 0xf5,
+	0xf6, 0xf0, 0xc9, 0xeb, 0xed, 0x91, 0xfe, 0xd5, 0xdb, 0xa7, 0xbb, 0xab, 0xb7, 0x47, 0x7b, 0xab,
+	0xb7, 0xc7, 0xba, 0xae, 0xb7, 0xa1, 0xb3, 0x7a, 0x3b, 0x93, 0x5a, 0x6f, 0x8f, 0x27, 0xd7, 0xdb,
+	0x13, 0xed, 0xeb, 0xed, 0xab, 0x70, 0x25, 0x3d, 0xa9, 0x44, 0xf6, 0xfd, 0x45, 0x82, 0x45, 0x3f,
+	0x3b, 0x59, 0x08, 0x1f, 0xd8, 0x9a, 0x4b, 0x30, 0x25, 0x8f, 0x5c, 0xa7, 0xe2, 0x50, 0x6c, 0x9d,
+	0x38, 0xf5, 0x56, 0x60, 0xd2, 0xc3, 0xae, 0x41, 0xbc, 0x30, 0xc5, 0xc4, 0xaa, 0xe1, 0xad, 0x41,
+	0x92, 0xdd, 0x86, 0x31, 0x5c, 0xf5, 0xf6, 0x1d, 0xd7, 0xf4, 0x8e, 0x79, 0x8e, 0x6e, 0xc9, 0x9f,
+	0x7c, 0xb8, 0x36, 0x2d, 0xac, 0x08, 0xb1, 0x3d, 0xcf, 0x35, 0x6d, 0xa3, 0x58, 0x17, 0xdd, 0x44,
+	0xff, 0xfe, 0xe9, 0x82, 0xe4, 0x63, 0xaf, 0xb7, 0x2d, 0x5f, 0x86, 0xa5, 0x14, 0x3c, 0x02, 0xf5,
+	0x27, 0x8d, 0xa8, 0x77, 0x48, 0x3c, 0xea, 0x52, 0xe7, 0xa8, 0x73, 0x62, 0x8b, 0xb9, 0xd6, 0xe1,
+	0x99, 0x18, 0x06, 0x28, 0x82, 0x7c, 0xa0, 0x7f, 0xc8, 0x77, 0x48, 0x02, 0xf2, 0x1f, 0x0d, 0xc0,
+	0x72, 0x81, 0x1a, 0xdf, 0xa8, 0xe8, 0xa2, 0xf4, 0x8d, 0x26, 0x68, 0x7a, 0xa9, 0xf1, 0x1a, 0x28,
+	0xfc, 0xf6, 0xa1, 0xc6, 0x65, 0xfd, 0x00, 0xcb, 0x7a, 0x99, 0x4b, 0xb4, 0x0e, 0x8d, 0x6e, 0xc3,
+	0x2c, 0xd6, 0xf5, 0x58, 0xd5, 0x41, 0xa6, 0x7a, 0x1e, 0xeb, 0x7a, 0x8c, 0xde, 0x7d, 0x40, 0xc1,
+	0x5a, 0x54, 0xeb, 0xc1, 0x1a, 0x6a, 0x13, 0xac, 0xa9, 0x40, 0x27, 0x1f, 0x06, 0xed, 0x42, 0x10,
+	0xb4, 0x98, 0xf1, 0x96, 0x57, 0xe0, 0x72, 0x6a, 0x5c, 0x44, 0xfc, 0x7e, 0x23, 0xc1, 0x7c, 0x28,
+	0x17, 0xdd, 0x0d, 0xd2, 0x63, 0x97, 0xb8, 0xbd, 0x0c, 0x24, 0x6f, 0x2f, 0xfd, 0x5c, 0x17, 0x4b,
+	0xb0, 0x90, 0xe8, 0xb7, 0xc0, 0xf6, 0x0e, 0x27, 0xc4, 0xf6, 0x88, 0x97, 0xd7, 0x34, 0x3f, 0x3d,
+	0x77, 0x1a, 0x8e, 0xdd, 0x78, 0x54, 0xd3, 0x30, 0x5c, 0xc3, 0x56, 0x95, 0x88, 0x75, 0xcd, 0x3f,
+	0xd0, 0x4d, 0x18, 0xa1, 0xa6, 0x61, 0x13, 0xb7, 0xad, 0xd3, 0x42, 0x6e, 0xf3, 0x4c, 0xe0, 0xb1,
+	0x68, 0x10, 0x74, 0x56, 0xb3, 0x2b, 0xc2, 0xd1, 0xff, 0x48, 0x70, 0x31, 0x04, 0xb3, 0x47, 0x6c,
+	0x7d, 0x87, 0xd8, 0xc7, 0xfe, 0x09, 0x91, 0xee, 0xec, 0x6d, 0x98, 0x15, 0xe9, 0xab, 0x13, 0xdb,
+	0xac, 0xdf, 0xac, 0xc3, 0xdc, 0x3d, 0xcf, 0xbb, 0x77, 0x58, 0x6f, 0x3e, 0xe8, 0x44, 0x37, 0x61,
+	0xda, 0x4f, 0xdc, 0x16, 0x25, 0x9e, 0xb5, 0x08, 0xeb, 0x7a, 0xb3, 0x46, 0x64, 0xe2, 0x86, 0x4e,
+	0x36, 0x71, 0x0b, 0x70, 0x29, 0x01, 0xab, 0x88, 0xc6, 0x0b, 0x89, 0x15, 0x18, 0x79, 0x5d, 0xff,
+	0x3a, 0xf1, 0xf2, 0x94, 0x12, 0xef, 0x9b, 0xfe, 0x2c, 0xf4, 0x85, 0x86, 0xd8, 0x83, 0xb3, 0xb6,
+	0xbf, 0x7b, 0xfb, 0xa3, 0xaa, 0x6c, 0x72, 0x03, 0x52, 0xe5, 0x72, 0xfc, 0x01, 0x1e, 0x71, 0x41,
+	0x9c, 0x06, 0x93, 0x76, 0xc4, 0x2f, 0x74, 0x19, 0x26, 0x4a, 0xc7, 0x15, 0x4c, 0xa9, 0x5a, 0x72,
+	0xaa, 0xb6, 0x4e, 0x05, 0x4f, 0x31, 0xce, 0x1b, 0xb7, 0x58, 0x5b, 0x6c, 0x25, 0x35, 0x0f, 0x17,
+	0xe3, 0x81, 0x8a, 0x48, 0xfc, 0x5e, 0x82, 0x65, 0x91, 0x35, 0x8d, 0x7a, 0xcd, 0x1b, 0x7b, 0x7c,
+	0x40, 0xea, 0xac, 0xd1, 0x40, 0x4f, 0xac, 0x51, 0x5f, 0x57, 0x2b, 0xdf, 0x8d, 0x92, 0x81, 0x08,
+	0xc0, 0xbf, 0x96, 0x60, 0xa5, 0x40, 0x0d, 0x46, 0xe9, 0x90, 0x1e, 0x30, 0xc7, 0xb0, 0x4c, 0x7c,
+	0x25, 0x34, 0xb3, 0x4c, 0xfd, 0xc4, 0xb6, 0x0a, 0x57, 0xdb, 0xf9, 0x2c, 0xe0, 0xfd, 0x8e, 0x6f,
+	0xb6, 0xdb, 0xfb, 0xd8, 0x36, 0x08, 0x27, 0x82, 0x3b, 0xc3, 0x95, 0x07, 0xb0, 0xc9, 0xa1, 0x2a,
+	0x58, 0xe6, 0x81, 0x8e, 0x59, 0xe6, 0x31, 0x9b, 0x1c, 0xf2, 0x3f, 0x5f, 0xc2, 0xde, 0x1b, 0x0f,
+	0x43, 0x40, 0x7d, 0x77, 0x00, 0x16, 0x1b, 0xae, 0xbc, 0x6f, 0x50, 0xcd, 0x75, 0x0e, 0x3b, 0x03,
+	0xab, 0x85, 0x75, 0xca, 0x40, 0xbb, 0xbb, 0xfb, 0xcd, 0x6e, 0xef, 0xee, 0x29, 0x95, 0xdc, 0x60,
+	0xdb, 0x4a, 0x6e, 0xa8, 0x1f, 0xf5, 0x4c, 0x52, 0x44, 0x44, 0xdc, 0x9e, 0x87, 0x4b, 0x3e, 0x72,
+	0xbb, 0x6a, 0x8e, 0xdc, 0xe7, 0x74, 0x69, 0xec, 0xb5, 0xbc, 0x9b, 0x4c, 0xda, 0x0e, 0x12, 0x40,
+	0x8a, 0x60, 0xfc, 0x44, 0x82, 0x99, 0xf0, 0xac, 0x78, 0x84, 0x5d, 0x5c, 0x0e, 0x0f, 0x81, 0x88,
+	0x27, 0x52, 0xc7, 0x9e, 0xf8, 0x6f, 0x35, 0x15, 0x36, 0x10, 0x73, 0x3f, 0xb3, 0x71, 0x31, 0x7e,
+	0x15, 0x71, 0x63, 0xc1, 0x86, 0xc8, 0x35, 0x5a, 0x50, 0x70, 0x3e, 0x38, 0xea, 0x9d, 0xf0, 0xfc,
+	0x97, 0x7c, 0xa5, 0xef, 0x11, 0xef, 0x4d, 0xc7, 0xd2, 0x4d, 0xdb, 0x78, 0xbc, 0xef, 0x12, 0xba,
+	0xef, 0x58, 0x7a, 0x9b, 0x63, 0x6c, 0x09, 0xc6, 0x4b, 0x98, 0x9a, 0x54, 0xad, 0x38, 0xa6, 0x7f,
+	0xa7, 0xf2, 0x97, 0xc0, 0x44, 0x31, 0xc3, 0xda, 0x1e, 0xb1, 0x26, 0xf4, 0xd5, 0x58, 0x96, 0x27,
+	0x05, 0x7e, 0x07, 0x77, 0x7a, 0xbe, 0xa2, 0xe3, 0xdd, 0x15, 0x90, 0x7e, 0x2b, 0xee, 0x18, 0xc4,
+	0x8b, 0x9e, 0x89, 0xec, 0x78, 0x4b, 0x07, 0x75, 0x0b, 0x66, 0xcb, 0xf8, 0x48, 0xd5, 0xd8, 0x86,
+	0xa1, 0x36, 0xe1, 0x93, 0x56, 0x27, 0x8a, 0xd3, 0x65, 0x7c, 0xc4, 0xb7, 0x93, 0xad, 0x97, 0x0c,
+	0x54, 0x5c, 0x2a, 0x12, 0x40, 0x08, 0xa8, 0xbf, 0xe2, 0x15, 0x08, 0x9f, 0x59, 0xbe, 0xa1, 0xd2,
+	0xad, 0xaa, 0x75, 0x10, 0xa0, 0xdc, 0x85, 0xd3, 0x55, 0xd6, 0x47, 0x65, 0x89, 0x6d, 0x51, 0x57,
+	0xd3, 0xf6, 0x62, 0x5f, 0x93, 0x0f, 0x25, 0xf2, 0x29, 0x50, 0xee, 0xeb, 0x6d, 0xe9, 0x07, 0x83,
+	0x70, 0xb6, 0xd9, 0xde, 0x17, 0xea, 0xda, 0x73, 0x0f, 0xe6, 0xfc, 0xa2, 0x2b, 0xfe, 0xd2, 0xc0,
+	0x2b, 0xa6, 0x19, 0x4a, 0xf8, 0x63, 0x6f, 0xd3, 0xbd, 0x21, 0xf1, 0xae, 0x31, 0x9c, 0x7c, 0xd7,
+	0x78, 0x1d, 0x2e, 0xd6, 0xcd, 0xc5, 0x90, 0x27, 0x23, 0x4c, 0x75, 0x2e, 0xb0, 0xd8, 0xca, 0x9f,
+	0xa4, 0x31, 0x2f, 0xa7, 0xd3, 0x98, 0x97, 0xcd, 0x21, 0x7f, 0x7a, 0x44, 0x71, 0x17, 0x93, 0x43,
+	0x3c, 0xc9, 0x36, 0xfe, 0x7b, 0x11, 0x06, 0x0b, 0xd4, 0x40, 0x2a, 0x8c, 0x06, 0x9c, 0x06, 0x5a,
+	0x4d, 0xc8, 0xa3, 0x96, 0xa7, 0x25, 0xe5, 0x7a, 0x07, 0x92, 0xdc, 0x90, 0x6f, 0x20, 0x20, 0x4b,
+	0x52, 0x0c, 0x34, 0x3d, 0x1f, 0x29, 0xd7, 0x3b, 0x90, 0x14, 0x06, 0xbe, 0x0d, 0x23, 0xfc, 0x6d,
+	0x06, 0x5d, 0x4d, 0x54, 0x8a, 0x3c, 0x10, 0x29, 0xd7, 0xda, 0xca, 0xd5, 0x87, 0xe6, 0xaf, 0x2f,
+	0x29, 0x43, 0x47, 0x9e, 0x80, 0x94, 0x6b, 0x6d, 0xe5, 0xc4, 0xd0, 0x7b, 0x30, 0xe4, 0xbf, 0x9e,
+	0xa0, 0x2b, 0x89, 0x0a, 0x0d, 0x2f, 0x3c, 0xca, 0x4a, 0x1b, 0xa9, 0xfa, 0xa0, 0xfe, 0xcb, 0x47,
+	0xca, 0xa0, 0x0d, 0xaf, 0x33, 0xca, 0x4a, 0x1b, 0x29, 0x31, 0x68, 0x09, 0xc6, 0xc2, 0x77, 0x5a,
+	0x94, 0x32, 0x2f, 0x4d, 0x6f, 0xce, 0xca, 0x8d, 0x4e, 0x44, 0x85, 0x8d, 0x03, 0x18, 0x6f, 0x7c,
+	0xd8, 0x44, 0xaf, 0xb4, 0x09, 0x63, 0xd4, 0xd2, 0x5a, 0x87, 0xd2, 0xf5, 0x8c, 0x0c, 0xca, 0xa0,
+	0x94, 0x8c, 0x6c, 0x7a, 0x2e, 0x52, 0xae, 0x77, 0x20, 0x19, 0x89, 0x18, 0x5f, 0x75, 0xe9, 0x11,
+	0x8b, 0x70, 0xd2, 0xca, 0x8d, 0x4e, 0x44, 0xeb, 0x20, 0xc2, 0xcd, 0x26, 0x19, 0x44, 0x13, 0x99,
+	0xa2, 0x5c, 0xef, 0x40, 0x52, 0x18, 0xd8, 0x87, 0x4c, 0xc3, 0x73, 0x02, 0xfa, 0x52, 0xa2, 0x66,
+	0xeb, 0xe3, 0x8a, 0xf2, 0x4a, 0x67, 0xc2, 0xc2, 0xd2, 0x21, 0x9c, 0x6d, 0xae, 0xc5, 0xd0, 0xcd,
+	0xc4, 0x11, 0x12, 0x1e, 0x32, 0x94, 0xf5, 0x2e, 0x34, 0x84, 0xe1, 0xa7, 0x30, 0x19, 0xfd, 0x85,
+	0x0f, 0xca, 0x26, 0x0e, 0x12, 0xfb, 0xbb, 0x26, 0x25, 0xd7, 0xb1, 0xbc, 0x30, 0xf9, 0x9e, 0x04,
+	0x73, 0x89, 0x34, 0x32, 0xba, 0x97, 0x96, 0x00, 0xa9, 0xef, 0x19, 0xca, 0x66, 0x2f, 0xaa, 0xc2,
+	0xa9, 0x77, 0x24, 0x98, 0x89, 0xa7, 0x78, 0xd1, 0xed, 0xe4, 0xa8, 0xa6, 0x71, 0xdc, 0xca, 0x9d,
+	0xae, 0xf5, 0x5a, 0x7c, 0xd9, 0x21, 0x5d, 0xfa, 0xb2, 0x43, 0x7a, 0xf3, 0x25, 0x89, 0xdd, 0x45,
+	0x3f, 0x94, 0x40, 0x4e, 0xa2, 0x30, 0xd1, 0xdd, 0xc4, 0x51, 0xdb, 0xb0, 0xc1, 0xca, 0xbd, 0x1e,
+	0x34, 0x85, 0x47, 0x6f, 0x4b, 0x30, 0x1d, 0x47, 0x3a, 0xa2, 0x2f, 0xb7, 0x19, 0x33, 0x96, 0x5b,
+	0x55, 0x6e, 0x75, 0xa9, 0x55, 0x5f, 0x37, 0x51, 0x2a, 0x31, 0x65, 0xdd, 0xc4, 0xd2, 0x9f, 0x4a,
+	0xae, 0x63, 0x79, 0x61, 0xf2, 0xbb, 0x80, 0x5a, 0x39, 0x3b, 0xb4, 0xd1, 0xc6, 0xff, 0x18, 0x32,
+	0x53, 0x79, 0xb5, 0x2b, 0x1d, 0x61, 0xfe, 0x2d, 0x98, 0x6a, 0xe1, 0xc9, 0xd0, 0x7a, 0xda, 0x92,
+	0x8b, 0x25, 0x0f, 0x95, 0x8d, 0x6e, 0x54, 0x1a, 0xb2, 0x30, 0x89, 0xba, 0x4a, 0xc9, 0xc2, 0x36,
+	0xb4, 0x9d, 0x72, 0xaf, 0x07, 0x4d, 0xe1, 0xd1, 0xfb, 0x12, 0x5c, 0x48, 0x21, 0x9c, 0xd0, 0x57,
+	0x12, 0x87, 0x6e, 0x4f, 0xad, 0x29, 0xaf, 0xf5, 0xa6, 0xdc, 0xb0, 0x40, 0xe2, 0x98, 0xa1, 0x94,
+	0x05, 0x92, 0xc2, 0x87, 0x29, 0xb7, 0xba, 0xd4, 0x6a, 0xd8, 0xc4, 0xe2, 0x99, 0x96, 0x94, 0x4d,
+	0x2c, 0x95, 0xac, 0x52, 0xee, 0x74, 0xad, 0x17, 0x4d, 0x9f, 0x58, 0xaa, 0x23, 0x3d, 0x7d, 0xd2,
+	0x28, 0x20, 0xe5, 0x5e, 0x0f, 0x9a, 0xf5, 0x62, 0xaf, 0x91, 0xb5, 0x48, 0x29, 0xf6, 0x62, 0xa8,
+	0x17, 0x65, 0xad, 0x43, 0xe9, 0x86, 0x84, 0x88, 0x23, 0x16, 0x52, 0x12, 0x22, 0x85, 0x36, 0x51,
+	0x6e, 0x75, 0xa9, 0xd5, 0x78, 0xaa, 0xc5, 0xde, 0xfa, 0xd3, 0x4e, 0xb5, 0x34, 0xae, 0x43, 0xb9,
+	0xd3, 0xb5, 0x5e, 0x7d, 0x2f, 0x6b, 0xb9, 0x16, 0xa6, 0xec, 0x65, 0x49, 0x34, 0x84, 0xb2, 0xd1,
+	0x8d, 0x0a, 0xb7, 0xad, 0x0c, 0x7f, 0xcf, 0xff, 0xb1, 0xd2, 0x96, 0xf1, 0xd1, 0xb3, 0x79, 0xe9,
+	0xe3, 0x67, 0xf3, 0xd2, 0x3f, 0x9f, 0xcd, 0x4b, 0xef, 0x3e, 0x9f, 0x3f, 0xf5, 0xf1, 0xf3, 0xf9,
+	0x53, 0x7f, 0x7b, 0x3e, 0x7f, 0x0a, 0x66, 0x4d, 0x27, 0x76, 0xd8, 0x47, 0xd2, 0x77, 0x1a, 0xb9,
+	0xc3, 0xba, 0xc8, 0x9a, 0xe9, 0x34, 0x7c, 0xe5, 0x8e, 0x82, 0xdf, 0xa8, 0x33, 0x12, 0xb1, 0x34,
+	0xc2, 0x7e, 0x06, 0xfe, 0xea, 0x67, 0x03, 0x00, 0xb5, 0xe2, 0x44, 0x9d, 0xfc, 0x2f, 0x00, 0x00,
 }
 
 func (this *MsgSupplyIncreaseProposalRequest) Equal(that interface{}) bool {
@@ -3455,8 +3909,92 @@ func (this *MsgWithdrawEscrowProposalRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgUpdateMarkersBulkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
+	that1, ok := that.(*MsgUpdateMarkersBulkRequest)
+	if !ok {
+		that2, ok := that.(MsgUpdateMarkersBulkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Updates) != len(that1.Updates) {
+		return false
+	}
+	for i := range this.Updates {
+		if !this.Updates[i].Equal(&that1.Updates[i]) {
+			return false
+		}
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	return true
+}
+func (this *MarkerBulkUpdate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MarkerBulkUpdate)
+	if !ok {
+		that2, ok := that.(MarkerBulkUpdate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if len(this.RemoveRequiredAttributes) != len(that1.RemoveRequiredAttributes) {
+		return false
+	}
+	for i := range this.RemoveRequiredAttributes {
+		if this.RemoveRequiredAttributes[i] != that1.RemoveRequiredAttributes[i] {
+			return false
+		}
+	}
+	if len(this.AddRequiredAttributes) != len(that1.AddRequiredAttributes) {
+		return false
+	}
+	for i := range this.AddRequiredAttributes {
+		if this.AddRequiredAttributes[i] != that1.AddRequiredAttributes[i] {
+			return false
+		}
+	}
+	if this.SetAllowForcedTransfer != that1.SetAllowForcedTransfer {
+		return false
+	}
+	if this.AllowForcedTransfer != that1.AllowForcedTransfer {
+		return false
+	}
+	if this.SetAllowGovernanceControl != that1.SetAllowGovernanceControl {
+		return false
+	}
+	if this.AllowGovernanceControl != that1.AllowGovernanceControl {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
 
@@ -3526,6 +4064,12 @@ type MsgClient interface {
 	SetDenomMetadataProposal(ctx context.Context, in *MsgSetDenomMetadataProposalRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataProposalResponse, error)
 	// UpdateParams is a governance proposal endpoint for updating the marker module's params.
 	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetHoldingThresholds sets the holding concentration thresholds of a marker.
+	SetHoldingThresholds(ctx context.Context, in *MsgSetHoldingThresholdsRequest, opts ...grpc.CallOption) (*MsgSetHoldingThresholdsResponse, error)
+	// SetNetAssetValueBounds sets the limits on the net asset value updates of a marker.
+	SetNetAssetValueBounds(ctx context.Context, in *MsgSetNetAssetValueBoundsRequest, opts ...grpc.CallOption) (*MsgSetNetAssetValueBoundsResponse, error)
+	// UpdateMarkersBulk updates settings on several markers at once via governance proposal.
+	UpdateMarkersBulk(ctx context.Context, in *MsgUpdateMarkersBulkRequest, opts ...grpc.CallOption) (*MsgUpdateMarkersBulkResponse, error)
 }
 
 type msgClient struct {
@@ -3788,6 +4332,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest
 	return out, nil
 }
 
+func (c *msgClient) SetHoldingThresholds(ctx context.Context, in *MsgSetHoldingThresholdsRequest, opts ...grpc.CallOption) (*MsgSetHoldingThresholdsResponse, error) {
+	out := new(MsgSetHoldingThresholdsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetHoldingThresholds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetNetAssetValueBounds(ctx context.Context, in *MsgSetNetAssetValueBoundsRequest, opts ...grpc.CallOption) (*MsgSetNetAssetValueBoundsResponse, error) {
+	out := new(MsgSetNetAssetValueBoundsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetNetAssetValueBounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMarkersBulk(ctx context.Context, in *MsgUpdateMarkersBulkRequest, opts ...grpc.CallOption) (*MsgUpdateMarkersBulkResponse, error) {
+	out := new(MsgUpdateMarkersBulkResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateMarkersBulk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Finalize
@@ -3848,6 +4419,12 @@ type MsgServer interface {
 	SetDenomMetadataProposal(context.Context, *MsgSetDenomMetadataProposalRequest) (*MsgSetDenomMetadataProposalResponse, error)
 	// UpdateParams is a governance proposal endpoint for updating the marker module's params.
 	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+	// SetHoldingThresholds sets the holding concentration thresholds of a marker.
+	SetHoldingThresholds(context.Context, *MsgSetHoldingThresholdsRequest) (*MsgSetHoldingThresholdsResponse, error)
+	// SetNetAssetValueBounds sets the limits on the net asset value updates of a marker.
+	SetNetAssetValueBounds(context.Context, *MsgSetNetAssetValueBoundsRequest) (*MsgSetNetAssetValueBoundsResponse, error)
+	// UpdateMarkersBulk updates settings on several markers at once via governance proposal.
+	UpdateMarkersBulk(context.Context, *MsgUpdateMarkersBulkRequest) (*MsgUpdateMarkersBulkResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -3938,6 +4515,15 @@ func (*UnimplementedMsgServer) SetDenomMetadataProposal(ctx context.Context, req
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetHoldingThresholds(ctx context.Context, req *MsgSetHoldingThresholdsRequest) (*MsgSetHoldingThresholdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHoldingThresholds not implemented")
+}
+func (*UnimplementedMsgServer) SetNetAssetValueBounds(ctx context.Context, req *MsgSetNetAssetValueBoundsRequest) (*MsgSetNetAssetValueBoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNetAssetValueBounds not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMarkersBulk(ctx context.Context, req *MsgUpdateMarkersBulkRequest) (*MsgUpdateMarkersBulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarkersBulk not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -4447,6 +5033,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetHoldingThresholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHoldingThresholdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHoldingThresholds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetHoldingThresholds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHoldingThresholds(ctx, req.(*MsgSetHoldingThresholdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetNetAssetValueBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNetAssetValueBoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetNetAssetValueBounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetNetAssetValueBounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNetAssetValueBounds(ctx, req.(*MsgSetNetAssetValueBoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMarkersBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMarkersBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMarkersBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateMarkersBulk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMarkersBulk(ctx, req.(*MsgUpdateMarkersBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.marker.v1.Msg",
@@ -4564,6 +5204,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetHoldingThresholds",
+			Handler:    _Msg_SetHoldingThresholds_Handler,
+		},
+		{
+			MethodName: "SetNetAssetValueBounds",
+			Handler:    _Msg_SetNetAssetValueBounds_Handler,
+		},
+		{
+			MethodName: "UpdateMarkersBulk",
+			Handler:    _Msg_UpdateMarkersBulk_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/marker/v1/tx.proto",
@@ -4890,6 +5542,30 @@ func (m *MsgDeleteAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.RevokeAccess) > 0 {
+		for iNdEx := len(m.RevokeAccess) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RevokeAccess[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.AllowLastAdminRemoval {
+		i--
+		if m.AllowLastAdminRemoval {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.RemovedAddress) > 0 {
 		i -= len(m.RemovedAddress)
 		copy(dAtA[i:], m.RemovedAddress)
@@ -6176,6 +6852,16 @@ func (m *MsgAddNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.BypassBounds {
+		i--
+		if m.BypassBounds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.NetAssetValues) > 0 {
 		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6645,62 +7331,360 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetHoldingThresholdsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgGrantAllowanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetHoldingThresholdsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHoldingThresholdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BasisPoints) > 0 {
+		dAtA14 := make([]byte, len(m.BasisPoints)*10)
+		var j13 int
+		for _, num := range m.BasisPoints {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTx(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGrantAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetHoldingThresholdsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHoldingThresholdsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHoldingThresholdsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddMarkerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetNetAssetValueBoundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNetAssetValueBoundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNetAssetValueBoundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxChangeBasisPoints != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxChangeBasisPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNetAssetValueBoundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNetAssetValueBoundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNetAssetValueBoundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMarkersBulkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMarkersBulkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMarkersBulkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkerBulkUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerBulkUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerBulkUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SetAllowGovernanceControl {
+		i--
+		if m.SetAllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AllowForcedTransfer {
+		i--
+		if m.AllowForcedTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SetAllowForcedTransfer {
+		i--
+		if m.SetAllowForcedTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AddRequiredAttributes) > 0 {
+		for iNdEx := len(m.AddRequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddRequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.AddRequiredAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddRequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RemoveRequiredAttributes) > 0 {
+		for iNdEx := len(m.RemoveRequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveRequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RemoveRequiredAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveRequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMarkersBulkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMarkersBulkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMarkersBulkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgGrantAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddMarkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.FromAddress)
 	if l > 0 {
@@ -6804,6 +7788,15 @@ func (m *MsgDeleteAccessRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.AllowLastAdminRemoval {
+		n += 2
+	}
+	if len(m.RevokeAccess) > 0 {
+		for _, e := range m.RevokeAccess {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -7352,6 +8345,9 @@ func (m *MsgAddNetAssetValuesRequest) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.BypassBounds {
+		n += 2
+	}
 	return n
 }
 
@@ -7541,21 +8537,148 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+func (m *MsgSetHoldingThresholdsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.BasisPoints) > 0 {
+		l = 0
+		for _, e := range m.BasisPoints {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetHoldingThresholdsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetNetAssetValueBoundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxChangeBasisPoints != 0 {
+		n += 1 + sovTx(uint64(m.MaxChangeBasisPoints))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetNetAssetValueBoundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMarkersBulkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MarkerBulkUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RemoveRequiredAttributes) > 0 {
+		for _, s := range m.RemoveRequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AddRequiredAttributes) > 0 {
+		for _, s := range m.AddRequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SetAllowForcedTransfer {
+		n += 2
+	}
+	if m.AllowForcedTransfer {
+		n += 2
+	}
+	if m.SetAllowGovernanceControl {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateMarkersBulkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -8520,6 +9643,60 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.RemovedAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowLastAdminRemoval", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowLastAdminRemoval = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokeAccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokeAccess = append(m.RevokeAccess, AccessGrant{})
+			if err := m.RevokeAccess[len(m.RevokeAccess)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12090,6 +13267,26 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassBounds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BypassBounds = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13298,6 +14495,821 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetHoldingThresholdsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHoldingThresholdsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHoldingThresholdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BasisPoints = append(m.BasisPoints, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BasisPoints) == 0 {
+					m.BasisPoints = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BasisPoints = append(m.BasisPoints, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetHoldingThresholdsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHoldingThresholdsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHoldingThresholdsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetNetAssetValueBoundsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetNetAssetValueBoundsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetNetAssetValueBoundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeBasisPoints", wireType)
+			}
+			m.MaxChangeBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChangeBasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetNetAssetValueBoundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetNetAssetValueBoundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetNetAssetValueBoundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMarkersBulkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMarkersBulkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMarkersBulkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, MarkerBulkUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkerBulkUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkerBulkUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkerBulkUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveRequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveRequiredAttributes = append(m.RemoveRequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddRequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddRequiredAttributes = append(m.AddRequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetAllowForcedTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetAllowForcedTransfer = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowForcedTransfer = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetAllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetAllowGovernanceControl = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMarkersBulkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMarkersBulkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMarkersBulkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
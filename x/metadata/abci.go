@@ -0,0 +1,12 @@
+package metadata
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+)
+
+// EndBlocker prunes old entries from the modification index.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.PruneModificationIndex(ctx, keeper.MaxModificationIndexPrunePerBlock)
+}
@@ -2,6 +2,8 @@ package cli_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -29,6 +31,7 @@ import (
 
 	"github.com/provenance-io/provenance/internal/antewrapper"
 	"github.com/provenance-io/provenance/internal/pioconfig"
+	"github.com/provenance-io/provenance/internal/provcli"
 	"github.com/provenance-io/provenance/testutil"
 	testcli "github.com/provenance-io/provenance/testutil/cli"
 	attrcli "github.com/provenance-io/provenance/x/attribute/client/cli"
@@ -653,16 +656,21 @@ func runQueryCmdTestCases(s *IntegrationCLITestSuite, cmdGen func() *cobra.Comma
 func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 	cmd := func() *cobra.Command { return cli.GetMetadataParamsCmd() }
 
+	genFile := s.testnet.Validators[0].Ctx.Config.GenesisFile()
+	noMetadataGenFile := filepath.Join(s.T().TempDir(), "no-metadata.json")
+	s.Require().NoError(os.WriteFile(noMetadataGenFile, []byte(`{"chain_id":"no-metadata","app_state":{"bank":{}}}`), 0o600), "WriteFile no-metadata.json")
+	genesisFlag := "--" + provcli.FlagGenesis
+
 	testCases := []queryCmdTestCase{
 		{
 			name:   "get params as json output",
 			args:   []string{s.asJson},
-			expOut: []string{"\"params\":{}"},
+			expOut: []string{"\"params\":{\"enable_record_name_registry\":false,\"enable_modification_index\":false,\"modification_index_retention\":\"0\"}"},
 		},
 		{
 			name:   "get params as text output",
 			args:   []string{s.asText},
-			expOut: []string{"params: {}"},
+			expOut: []string{"params:\n  enable_modification_index: false\n  enable_record_name_registry: false\n  modification_index_retention: \"0\""},
 		},
 		{
 			name:   "get params - invalid args",
@@ -672,7 +680,7 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 		{
 			name:   "get params as json output including request",
 			args:   []string{s.asJson, s.includeRequest},
-			expOut: []string{"\"params\":{}", "\"request\":{\"include_request\":true}"},
+			expOut: []string{"\"params\":{\"enable_record_name_registry\":false,\"enable_modification_index\":false,\"modification_index_retention\":\"0\"}", "\"request\":{\"include_request\":true}"},
 		},
 		{
 			name:   "get locator params as json",
@@ -684,6 +692,32 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 			args:   []string{"locator", s.asText, s.includeRequest},
 			expOut: []string{"params:", "max_uri_length: 2048", "request:", "include_request: true"},
 		},
+		{
+			name: "get params from genesis",
+			args: []string{genesisFlag, genFile, s.asText},
+			expOut: []string{
+				"counts:\n" +
+					"  contract_specifications: 1\n" +
+					"  net_asset_values: 0\n" +
+					"  object_store_locators: 2\n" +
+					"  record_specifications: 1\n" +
+					"  records: 1\n" +
+					"  scope_specifications: 1\n" +
+					"  scopes: 1\n" +
+					"  sessions: 1\n",
+				"params:\n  enable_modification_index: false\n  enable_record_name_registry: false\n  modification_index_retention: \"0\"",
+			},
+		},
+		{
+			name:   "get params from genesis without a metadata section",
+			args:   []string{genesisFlag, noMetadataGenFile, s.asText},
+			expErr: "module section not found in genesis: genesis file " + noMetadataGenFile + " does not have a metadata section",
+		},
+		{
+			name:   "get params from genesis with locator arg",
+			args:   []string{"locator", genesisFlag, genFile},
+			expErr: "no arguments can be provided with --genesis",
+		},
 	}
 
 	runQueryCmdTestCases(s, cmd, testCases)
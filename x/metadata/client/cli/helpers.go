@@ -112,6 +112,20 @@ func parsePartyType(input string) (types.PartyType, error) {
 	return types.PartyType(rv), nil
 }
 
+// parseScopeAccessKind parses a string to a specified ScopeAccessKind.
+// Dashes can be used in place of underscores, and the SCOPE_ACCESS_KIND_ prefix is optional.
+func parseScopeAccessKind(input string) (types.ScopeAccessKind, error) {
+	name := strings.ReplaceAll(strings.ToUpper(strings.TrimSpace(input)), "-", "_")
+	rv := types.ScopeAccessKind_value["SCOPE_ACCESS_KIND_"+name]
+	if rv == 0 {
+		rv = types.ScopeAccessKind_value[name]
+	}
+	if rv == 0 {
+		return 0, fmt.Errorf("unknown access kind: %q", input)
+	}
+	return types.ScopeAccessKind(rv), nil
+}
+
 // parseOptional parse a string into an optional/required boolean where true == optional.
 func parseOptional(input string) (bool, error) {
 	switch strings.ToLower(input) {
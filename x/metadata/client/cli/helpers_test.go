@@ -936,6 +936,43 @@ func TestParseInputSpecification(t *testing.T) {
 	}
 }
 
+func TestParseScopeAccessKind(t *testing.T) {
+	// If this fails, add some unit tests for the new value(s), then update the expected length here.
+	assert.Len(t, types.ScopeAccessKind_name, 4, "types.ScopeAccessKind_name")
+
+	tests := []struct {
+		input  string
+		exp    types.ScopeAccessKind
+		expErr string
+	}{
+		{input: "owner", exp: types.ScopeAccessKind_Owner},
+		{input: "OWNER", exp: types.ScopeAccessKind_Owner},
+		{input: "SCOPE_ACCESS_KIND_OWNER", exp: types.ScopeAccessKind_Owner},
+		{input: "data-access", exp: types.ScopeAccessKind_DataAccess},
+		{input: "data_access", exp: types.ScopeAccessKind_DataAccess},
+		{input: "Data-Access", exp: types.ScopeAccessKind_DataAccess},
+		{input: "scope-access-kind-data-access", exp: types.ScopeAccessKind_DataAccess},
+		{input: "value-owner", exp: types.ScopeAccessKind_ValueOwner},
+		{input: " VALUE_OWNER ", exp: types.ScopeAccessKind_ValueOwner},
+		{input: "unspecified", expErr: `unknown access kind: "unspecified"`},
+		{input: "SCOPE_ACCESS_KIND_UNSPECIFIED", expErr: `unknown access kind: "SCOPE_ACCESS_KIND_UNSPECIFIED"`},
+		{input: "", expErr: `unknown access kind: ""`},
+		{input: "valueowner", expErr: `unknown access kind: "valueowner"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			actual, err := parseScopeAccessKind(tc.input)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "parseScopeAccessKind(%q) error", tc.input)
+			} else {
+				assert.NoError(t, err, "parseScopeAccessKind(%q) error", tc.input)
+			}
+			assert.Equal(t, tc.exp, actual, "parseScopeAccessKind(%q) result", tc.input)
+		})
+	}
+}
+
 func TestParsePartyTypes(t *testing.T) {
 	originator := types.PartyType_PARTY_TYPE_ORIGINATOR
 	servicer := types.PartyType_PARTY_TYPE_SERVICER
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -15,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	"github.com/provenance-io/provenance/internal/provcli"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
@@ -60,6 +62,14 @@ func GetQueryCmd() *cobra.Command {
 		GetOSLocatorCmd(),
 		GetAccountDataCmd(),
 		GetCmdNetAssetValuesQuery(),
+		GetRecordNameCmd(),
+		GetRecordLineageCmd(),
+		GetModifiedSinceCmd(),
+		GetMarkerMetadataHoldingsCmd(),
+		GetScopeDeletionBlockersCmd(),
+		GetScopePartiesCmd(),
+		GetHasScopeAccessCmd(),
+		GetAddressDetailsCmd(),
 	)
 	return queryCmd
 }
@@ -72,9 +82,18 @@ func GetMetadataParamsCmd() *cobra.Command {
 		Use:     "params [locator]",
 		Aliases: []string{"p"},
 		Short:   "Query the current metadata parameters",
-		Args:    cobra.MaximumNArgs(1),
-		Example: fmt.Sprintf("%s params", cmdStart),
+		Long: `Query the current metadata parameters.
+If --genesis is provided, the params (and the number of each type of entry) are read from that genesis file instead.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf(`%[1]s params
+%[1]s params --genesis exported-genesis.json`, cmdStart),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if genFile, _ := cmd.Flags().GetString(provcli.FlagGenesis); len(genFile) > 0 {
+				if len(args) > 0 {
+					return fmt.Errorf("no arguments can be provided with --%s", provcli.FlagGenesis)
+				}
+				return outputGenesisParams(cmd, genFile)
+			}
 			if len(args) == 0 {
 				return outputParams(cmd)
 			}
@@ -87,6 +106,7 @@ func GetMetadataParamsCmd() *cobra.Command {
 	}
 
 	addIncludeRequestFlag(cmd)
+	provcli.AddGenesisFlagToCmd(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -602,6 +622,381 @@ func GetAccountDataCmd() *cobra.Command {
 	return cmd
 }
 
+// GetRecordNameCmd returns the command handler for looking up record names in the record name registry.
+func GetRecordNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "record-name {record_id|name_hash}",
+		Short:   "Look up the name of a record or record specification",
+		Aliases: []string{"recordname", "rn"},
+		Long: `Look up the name of a record or record specification in the record name registry.
+
+The argument can be either a record (or record specification) address, or a name hash.
+A name hash can be hex or base64 encoded, and either the 16 bytes used in addresses or the full 32-byte sha256.
+
+Names are only available if they were written while the enable_record_name_registry param was true.`,
+		Example: fmt.Sprintf(`$ %[1]s record-name record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3
+$ %[1]s record-name 787ec76dcafd20c1908eb0936a12f91e
+$ %[1]s record-name eH7Hbcr9IMGQjrCTahL5Hg==`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			arg := strings.TrimSpace(args[0])
+
+			if _, addrErr := types.MetadataAddressFromBech32(arg); addrErr == nil {
+				req := &types.NameForRecordAddressRequest{RecordId: arg, IncludeRequest: includeRequest}
+				resp, qErr := queryClient.NameForRecordAddress(cmd.Context(), req)
+				if qErr != nil {
+					return fmt.Errorf("failed to query record name for %q: %w", arg, qErr)
+				}
+				return clientCtx.PrintProto(resp)
+			}
+
+			req := &types.RecordNameByHashRequest{Hash: arg, IncludeRequest: includeRequest}
+			resp, err := queryClient.RecordNameByHash(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query record name for hash %q: %w", arg, err)
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRecordLineageCmd returns the command handler for looking up the records that a record's inputs reference.
+func GetRecordLineageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "record-lineage {record_id}",
+		Short:   "Query the tree of records that a record's inputs reference",
+		Aliases: []string{"recordlineage", "lineage"},
+		Long: fmt.Sprintf(`Query the tree of records that a record's inputs reference.
+
+Inputs that reference other records are resolved recursively, up to --depth levels (default %[1]d, at most %[2]d).
+Inputs that reference an off-chain hash are listed with the record that has them.
+A record that is already one of its own ancestors is flagged as a cycle and not resolved any further.`,
+			types.DefaultRecordLineageDepth, types.MaxRecordLineageDepth),
+		Example: fmt.Sprintf(`$ %[1]s record-lineage record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3
+$ %[1]s record-lineage record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3 --depth 5`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			depth, err := cmd.Flags().GetUint32(FlagDepth)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.RecordLineageRequest{
+				RecordId:       strings.TrimSpace(args[0]),
+				Depth:          depth,
+				IncludeRequest: includeRequest,
+			}
+			resp, err := queryClient.RecordLineage(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query record lineage for %q: %w", req.RecordId, err)
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagDepth, 0, "the number of levels of record inputs to resolve")
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetModifiedSinceCmd returns the command handler for looking up the metadata changed since a block height.
+func GetModifiedSinceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "modified-since {height} [scope|session|record]",
+		Short:   "Query the scopes, sessions, and records changed at or after a block height",
+		Aliases: []string{"modifiedsince", "ms"},
+		Long: `Query the addresses of the scopes, sessions, and records that were last changed at or after a block height.
+
+The optional second argument limits the results to one type of metadata.
+Entries for deleted objects are included.
+
+Changes are only available if they were made while the enable_modification_index param was true,
+and if they haven't been pruned (see the modification_index_retention param).`,
+		Example: fmt.Sprintf(`$ %[1]s modified-since 1000
+$ %[1]s modified-since 1000 scope`, cmdStart),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(strings.TrimSpace(args[0]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %q: %w", args[0], err)
+			}
+			req := &types.ModifiedSinceRequest{Height: height, IncludeRequest: includeRequest}
+			if len(args) > 1 {
+				req.Type = strings.ToLower(strings.TrimSpace(args[1]))
+			}
+			req.Pagination, err = client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ModifiedSince(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query metadata modified since height %d: %w", height, err)
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "modifications")
+	return cmd
+}
+
+// GetMarkerMetadataHoldingsCmd returns the command handler for querying the scopes held by a marker.
+func GetMarkerMetadataHoldingsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "marker-holdings {denom|address}",
+		Aliases: []string{"markerholdings", "mh"},
+		Short:   "Query the scopes held by a marker",
+		Long: fmt.Sprintf(`%[1]s marker-holdings {denom|address} - gets the scopes held by a marker, and each scope's specification.
+
+Entries are flagged with scope_missing when the marker holds a scope's coin, but that scope no longer exists.`, cmdStart),
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s marker-holdings scopepool`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id := strings.TrimSpace(args[0])
+			if len(id) == 0 {
+				return fmt.Errorf("empty marker id")
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.MarkerMetadataHoldingsRequest{Id: id, IncludeRequest: includeRequest, Pagination: pageReq}
+			res, err := queryClient.MarkerMetadataHoldings(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "holdings")
+
+	return cmd
+}
+
+// GetScopeDeletionBlockersCmd returns the command handler for identifying everything that prevents the deletion of a scope.
+func GetScopeDeletionBlockersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scope-deletion-blockers {scope_id|scope_uuid}",
+		Aliases: []string{"scopedeletionblockers", "sdb"},
+		Short:   "Query everything that prevents the deletion of a scope",
+		Long: fmt.Sprintf(`%[1]s scope-deletion-blockers {scope_id|scope_uuid} - gets everything that prevents the deletion of a scope.
+
+This includes the number of sessions and records in the scope, the value owner if it is a module account or
+blocked address, and the marker that holds the scope's coin in escrow (if there is one).`, cmdStart),
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s scope-deletion-blockers scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			scopeID := strings.TrimSpace(args[0])
+			if len(scopeID) == 0 {
+				return fmt.Errorf("empty scope id")
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.ScopeDeletionBlockersRequest{ScopeId: scopeID, IncludeRequest: includeRequest}
+			res, err := queryClient.ScopeDeletionBlockers(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetScopePartiesCmd returns the command handler for querying the parties of a scope, grouped by role.
+func GetScopePartiesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scope-parties {scope_id|scope_uuid}",
+		Aliases: []string{"scopeparties", "sp"},
+		Short:   "Query the parties of a scope, grouped by role",
+		Long: fmt.Sprintf(`%[1]s scope-parties {scope_id|scope_uuid} - gets the parties of a scope, grouped by role.
+
+Each party also indicates whether its signature is optional and whether an account exists for its address.
+Use --include-sessions to also include the parties of the scope's sessions.`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s scope-parties scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s scope-parties 91978ba2-5f35-459a-86a7-feca1b0512e0 --include-sessions`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			scopeID := strings.TrimSpace(args[0])
+			if len(scopeID) == 0 {
+				return fmt.Errorf("empty scope id")
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.ScopePartiesRequest{
+				ScopeId:         scopeID,
+				IncludeSessions: includeSessions,
+				IncludeRequest:  includeRequest,
+			}
+			res, err := queryClient.ScopeParties(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().BoolVar(&includeSessions, "include-sessions", false, "include the parties of the scope's sessions")
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetHasScopeAccessCmd returns the command handler for querying whether an address has a kind of access to a scope.
+func GetHasScopeAccessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "has-scope-access {scope_id|scope_uuid} <address> {owner|data-access|value-owner}",
+		Aliases: []string{"hasscopeaccess", "scope-access", "hsa"},
+		Short:   "Query whether an address has a kind of access to a scope",
+		Long: fmt.Sprintf(`%[1]s has-scope-access {scope_id|scope_uuid} <address> {owner|data-access|value-owner}
+    - gets whether an address has a kind of access to a scope.
+
+The access kinds are:
+    owner: The address is one of the scope's owners (with any role).
+    data-access: The address is in the scope's data access list.
+    value-owner: The address holds the scope's coin.
+
+The result also has the role that the address matched, e.g. "SERVICER" for an owner with that role.`, cmdStart),
+		Args: cobra.ExactArgs(3),
+		Example: fmt.Sprintf(`%[1]s has-scope-access scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 owner
+%[1]s has-scope-access 91978ba2-5f35-459a-86a7-feca1b0512e0 pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 data-access`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			scopeID := strings.TrimSpace(args[0])
+			if len(scopeID) == 0 {
+				return fmt.Errorf("empty scope id")
+			}
+			address := strings.TrimSpace(args[1])
+			if len(address) == 0 {
+				return fmt.Errorf("empty address")
+			}
+			accessKind, err := parseScopeAccessKind(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.HasScopeAccessRequest{
+				ScopeId:        scopeID,
+				Address:        address,
+				AccessKind:     accessKind,
+				IncludeRequest: includeRequest,
+			}
+			res, err := queryClient.HasScopeAccess(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetAddressDetailsCmd returns the command handler for breaking a metadata address down into its components.
+func GetAddressDetailsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "decode <address>",
+		Aliases: []string{"address-details"},
+		Short:   "Break a metadata address down into its various components",
+		Long: fmt.Sprintf(`%[1]s decode <address>
+    - breaks a metadata address down into its various components.
+
+The address can be a bech32 address, a hex string, or an nft/ denom.
+Malformed addresses are not rejected. Instead, the result indicates that the address is not valid,
+has the reason, and has whatever components could be identified.
+The result also indicates whether there is an entry on chain for the address.`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s decode scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s decode 0091978ba25f35459a86a7feca1b0512e0
+%[1]s decode nft/scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address := strings.TrimSpace(args[0])
+			if len(address) == 0 {
+				return fmt.Errorf("empty address")
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AddressDetails(cmd.Context(), &types.AddressDetailsRequest{Address: address})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // ------------ private funcs for actually querying and outputting ------------
 
 // outputParams calls the Params query and outputs the response.
@@ -620,6 +1015,33 @@ func outputParams(cmd *cobra.Command) error {
 	return clientCtx.PrintProto(res)
 }
 
+// outputGenesisParams reads the metadata genesis state from the genesis file and outputs its params and entry counts.
+func outputGenesisParams(cmd *cobra.Command, genFile string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	var genState types.GenesisState
+	if err = provcli.ReadModuleGenesis(clientCtx.Codec, genFile, types.ModuleName, &genState); err != nil {
+		return err
+	}
+	if err = genState.Validate(); err != nil {
+		return fmt.Errorf("invalid %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return provcli.PrintGenesisParams(clientCtx, &genState.Params, map[string]int{
+		"scopes":                  len(genState.Scopes),
+		"sessions":                len(genState.Sessions),
+		"records":                 len(genState.Records),
+		"scope_specifications":    len(genState.ScopeSpecifications),
+		"contract_specifications": len(genState.ContractSpecifications),
+		"record_specifications":   len(genState.RecordSpecifications),
+		"object_store_locators":   len(genState.ObjectStoreLocators),
+		"net_asset_values":        len(genState.NetAssetValues),
+	})
+}
+
 // outputGetByAddr calls the GetByAddr query and outputs the response.
 func outputGetByAddr(cmd *cobra.Command, addrs []string) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -15,7 +15,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 
+	"github.com/provenance-io/provenance/internal/provcli"
 	attrcli "github.com/provenance-io/provenance/x/attribute/client/cli"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
@@ -26,6 +28,7 @@ const (
 	AddSwitch              = "add"
 	RemoveSwitch           = "remove"
 	FlagUsdMills           = "usd-mills"
+	FlagDepth              = "depth"
 )
 
 // NewTxCmd is the top-level command for Metadata CLI transactions.
@@ -71,6 +74,8 @@ func NewTxCmd() *cobra.Command {
 		SetAccountDataCmd(),
 
 		GetCmdAddNetAssetValues(),
+
+		ForceBurnScopeCoinCmd(),
 	)
 
 	return txCmd
@@ -1330,3 +1335,42 @@ func ParseNetAssetValueString(netAssetValuesString string) ([]types.NetAssetValu
 	}
 	return netAssetValues, nil
 }
+
+// ForceBurnScopeCoinCmd creates a command to burn a scope's coin via governance proposal.
+func ForceBurnScopeCoinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-burn-scope-coin <scope-id>",
+		Short: "Burn a scope's coin via governance proposal",
+		Long: `Submit a governance proposal to burn a scope's coin along with an initial deposit.
+
+A scope's coin cannot normally be burned while the scope still has sessions or records.
+This proposal burns it anyway, leaving the scope without a value owner.`,
+		Example: fmt.Sprintf(`$ %[1]s tx %[2]s force-burn-scope-coin scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn --deposit 50000nhash`,
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid metadata address %q: %w", args[0], err)
+			}
+			if !scopeID.IsScopeAddress() {
+				return fmt.Errorf("metadata address is not scope address: %v", scopeID.String())
+			}
+
+			flagSet := cmd.Flags()
+			msg := types.NewMsgForceBurnScopeCoinRequest(provcli.GetAuthority(flagSet), scopeID)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -17,6 +17,12 @@ import (
 // scopeDenomPrefix is the string that will start every scope denom.
 const scopeDenomPrefix = types.DenomPrefix + types.PrefixScope + "1"
 
+var (
+	_ BankKeeper               = (*MDBankKeeper)(nil)
+	_ types.MetadataBankKeeper = (*MDBankKeeper)(nil)
+)
+
+// NewMDBankKeeper wraps the provided bank keeper so that it can be used as the metadata module's BankKeeper.
 func NewMDBankKeeper(bk bankkeeper.BaseKeeper) *MDBankKeeper {
 	return &MDBankKeeper{BaseKeeper: bk}
 }
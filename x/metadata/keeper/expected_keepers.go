@@ -36,14 +36,15 @@ type MarkerKeeper interface {
 	IsMarkerAccount(ctx sdk.Context, addr sdk.AccAddress) bool
 }
 
+// BankKeeper defines the bank functionality needed by the metadata module.
+// The metadata denom lifecycle (mint, burn, send, owner lookup) is defined by types.MetadataBankKeeper.
 type BankKeeper interface {
+	types.MetadataBankKeeper
+
 	BlockedAddr(addr sdk.AccAddress) bool
-	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
-	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
-	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 
 	// These are methods not in the bank keeper, but that we add using our own MDBankKeeper.
 
-	DenomOwner(ctx context.Context, denom string) (sdk.AccAddress, error)
 	GetScopesForValueOwner(ctx context.Context, valueOwner sdk.AccAddress, pageReq *query.PageRequest) (types.AccMDLinks, *query.PageResponse, error)
 }
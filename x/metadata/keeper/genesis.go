@@ -10,6 +10,8 @@ import (
 
 // InitGenesis creates the initial genesis state for the metadata module.
 func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	// The params are set first so that the record name registry is populated as records are added.
+	k.SetParams(ctx, data.Params)
 	k.SetOSLocatorParams(ctx, data.OSLocatorParams)
 	if err := data.Validate(); err != nil {
 		panic(err)
@@ -84,6 +86,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 
 // ExportGenesis exports the current keeper state of the metadata module.ExportGenesis
 func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
+	params := k.GetParams(ctx)
 	oslocatorparams := k.GetOSLocatorParams(ctx)
 	scopes := make([]types.Scope, 0)
 	sessions := make([]types.Session, 0)
@@ -168,5 +171,5 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		markerNetAssetValues[i] = markerNavs
 	}
 
-	return types.NewGenesisState(types.Params{}, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, markerNetAssetValues)
+	return types.NewGenesisState(params, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, markerNetAssetValues)
 }
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// HealthCheckLimit is the maximum number of store entries read by any single health check.
+const HealthCheckLimit = 10
+
+// ModuleHealth runs a few shallow, bounded, read-only checks of the metadata module state.
+func (k Keeper) ModuleHealth(c context.Context, _ *types.ModuleHealthRequest) (*types.ModuleHealthResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	checks := k.RunHealthChecks(ctx)
+	rv := &types.ModuleHealthResponse{Healthy: true, Checks: checks}
+	for _, check := range checks {
+		if !check.Passed {
+			rv.Healthy = false
+		}
+	}
+	return rv, nil
+}
+
+// RunHealthChecks runs each of the metadata module health checks and returns their results.
+// Each check reads at most HealthCheckLimit entries, so the cost does not grow with the size of state.
+func (k Keeper) RunHealthChecks(ctx sdk.Context) []types.HealthCheck {
+	return []types.HealthCheck{
+		runHealthCheck("os_locator_params", func() error { return k.checkOSLocatorParamsHealth(ctx) }),
+		runHealthCheck("scopes", func() error { return k.checkScopesHealth(ctx) }),
+		runHealthCheck("scope_spec_index", func() error { return k.checkScopeSpecIndexHealth(ctx) }),
+	}
+}
+
+// runHealthCheck runs the provided check, timing it, and converting any error (or panic) into a failed result.
+func runHealthCheck(name string, check func() error) (rv types.HealthCheck) {
+	rv.Name = name
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			rv.Passed = false
+			rv.Error = fmt.Sprintf("panic: %v", r)
+		}
+		rv.DurationMicros = uint64(time.Since(start).Microseconds())
+	}()
+	if err := check(); err != nil {
+		rv.Error = err.Error()
+		return rv
+	}
+	rv.Passed = true
+	return rv
+}
+
+// checkOSLocatorParamsHealth makes sure the os locator params can be read and are usable.
+func (k Keeper) checkOSLocatorParamsHealth(ctx sdk.Context) error {
+	if k.GetOSLocatorParams(ctx).MaxUriLength == 0 {
+		return errors.New("os locator max uri length is zero")
+	}
+	return nil
+}
+
+// checkScopesHealth makes sure the first few scope entries can be read, are valid, and are stored under their own id.
+func (k Keeper) checkScopesHealth(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.ScopeKeyPrefix)
+	defer it.Close()
+	for i := 0; it.Valid() && i < HealthCheckLimit; it.Next() {
+		i++
+		key := types.MetadataAddress(it.Key())
+		scope, err := k.readScopeBz(it.Value())
+		if err != nil {
+			return fmt.Errorf("could not read scope entry %s: %w", key, err)
+		}
+		if !scope.ScopeId.Equals(key) {
+			return fmt.Errorf("scope entry %s contains scope %s", key, scope.ScopeId)
+		}
+		if err = scope.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid scope %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// checkScopeSpecIndexHealth makes sure the first scope-spec-to-scope index entry round-trips:
+// the scope it points to exists and uses that scope specification.
+func (k Keeper) checkScopeSpecIndexHealth(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.ScopeSpecScopeCacheKeyPrefix)
+	defer it.Close()
+	if !it.Valid() {
+		return nil
+	}
+
+	key := it.Key()[len(types.ScopeSpecScopeCacheKeyPrefix):]
+	var scopeSpecID, scopeID types.MetadataAddress
+	if err := scopeSpecID.Unmarshal(key[:len(key)/2]); err != nil {
+		return fmt.Errorf("invalid scope spec id in index entry %X: %w", it.Key(), err)
+	}
+	if err := scopeID.Unmarshal(key[len(key)/2:]); err != nil {
+		return fmt.Errorf("invalid scope id in index entry %X: %w", it.Key(), err)
+	}
+	if err := scopeSpecID.ValidateIsScopeSpecificationAddress(); err != nil {
+		return fmt.Errorf("invalid scope spec id in index entry %X: %w", it.Key(), err)
+	}
+	if err := scopeID.ValidateIsScopeAddress(); err != nil {
+		return fmt.Errorf("invalid scope id in index entry %X: %w", it.Key(), err)
+	}
+
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return fmt.Errorf("scope spec %s index entry points to unknown scope %s", scopeSpecID, scopeID)
+	}
+	if !scope.SpecificationId.Equals(scopeSpecID) {
+		return fmt.Errorf("scope spec %s index entry points to scope %s that uses scope spec %s",
+			scopeSpecID, scopeID, scope.SpecificationId)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"sort"
 	"testing"
 	"time"
@@ -14,9 +15,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	simapp "github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/internal/pioconfig"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
 	"github.com/provenance-io/provenance/x/metadata/types"
 	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
@@ -137,6 +141,18 @@ func (s *KeeperTestSuite) TestParams() {
 	})
 }
 
+func (s *KeeperTestSuite) TestAuthority() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.Assert().Equal(govAddr, s.app.MetadataKeeper.GetAuthority(), "app MetadataKeeper.GetAuthority()")
+
+	other := sdk.AccAddress("other_authority_____").String()
+	k := keeper.NewKeeper(s.app.AppCodec(), nil, nil, nil, nil, nil, s.app.BankKeeper, other)
+	s.Assert().Equal(other, k.GetAuthority(), "GetAuthority()")
+	s.Assert().NoError(k.ValidateAuthority(other), "ValidateAuthority(other)")
+	s.Assert().EqualError(k.ValidateAuthority(govAddr), fmt.Sprintf("expected %q got %q: expected gov account as only signer for proposal message", other, govAddr),
+		"ValidateAuthority(gov)")
+}
+
 func (s *KeeperTestSuite) TestGetOSLocator() {
 	s.Run("get os locator by owner address", func() {
 		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr)
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// Migrate4To5 will update the metadata store from version 4 to version 5.
+// It adds the contract spec to session index entries for all existing sessions.
+func (m Migrator) Migrate4To5(ctx sdk.Context) error {
+	logger := m.keeper.Logger(ctx)
+	logger.Info("Starting migration of x/metadata from 4 to 5.")
+	store := ctx.KVStore(m.keeper.storeKey)
+	count := 0
+	err := m.keeper.IterateSessions(ctx, types.MetadataAddress{}, func(session types.Session) (stop bool) {
+		m.keeper.indexSession(store, &session, nil)
+		count++
+		return false
+	})
+	if err != nil {
+		logger.Error("Error indexing sessions by contract spec.", "error", err)
+		return err
+	}
+	logger.Info("Done migrating x/metadata from 4 to 5.", "sessions", count)
+	return nil
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestMigrate4To5(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := FreshCtx(app)
+	kpr := app.MetadataKeeper
+	store := ctx.KVStore(kpr.GetStoreKey())
+
+	contractSpecID := types.ContractSpecMetadataAddress(uuid.New())
+	scopeUUID := uuid.New()
+	sessionIDs := make([]types.MetadataAddress, 3)
+	for i := range sessionIDs {
+		sessionIDs[i] = types.SessionMetadataAddress(scopeUUID, uuid.New())
+		session := types.NewSession("name", sessionIDs[i], contractSpecID, ownerPartyList("addr"), nil)
+		// Write the session directly to the store so that it doesn't get indexed (like it would have been in v4).
+		bz, err := app.AppCodec().Marshal(session)
+		require.NoError(t, err, "[%d]: Marshal(session)", i)
+		store.Set(sessionIDs[i], bz)
+	}
+
+	getSessionIDs := func() []types.MetadataAddress {
+		var rv []types.MetadataAddress
+		err := kpr.IterateSessionsForContractSpec(ctx, contractSpecID, func(sessionID types.MetadataAddress) (stop bool) {
+			rv = append(rv, sessionID)
+			return false
+		})
+		require.NoError(t, err, "IterateSessionsForContractSpec")
+		return rv
+	}
+
+	require.Empty(t, getSessionIDs(), "sessions for contract spec before the migration")
+	err := keeper.NewMigrator(kpr).Migrate4To5(ctx)
+	require.NoError(t, err, "Migrate4To5")
+	assert.ElementsMatch(t, sessionIDs, getSessionIDs(), "sessions for contract spec after the migration")
+}
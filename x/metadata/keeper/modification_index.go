@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// MaxModificationIndexPrunePerBlock is the maximum number of modification index entries pruned in a single block.
+const MaxModificationIndexPrunePerBlock = 1000
+
+// recordModification records the current block height as the last change to the provided scope, session, or
+// record address (if the modification index is enabled). Each address only has a single entry in the index.
+func (k Keeper) recordModification(ctx sdk.Context, id types.MetadataAddress) {
+	if len(id) == 0 || !k.IsModificationIndexEnabled(ctx) {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	lastKey := types.GetLastModifiedKey(id)
+	if bz := store.Get(lastKey); len(bz) == 8 {
+		store.Delete(types.GetModificationIndexKey(sdk.BigEndianToUint64(bz), id))
+	}
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115: Block heights are never negative.
+	store.Set(types.GetModificationIndexKey(height, id), []byte{0x01})
+	store.Set(lastKey, sdk.Uint64ToBigEndian(height))
+}
+
+// GetLastModifiedHeight gets the height of the last recorded change to the provided scope, session, or record address.
+func (k Keeper) GetLastModifiedHeight(ctx sdk.Context, id types.MetadataAddress) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLastModifiedKey(id))
+	if len(bz) != 8 {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// GetModificationIndexStart returns the earliest height still available in the modification index.
+// Entries from before this height have been (or are about to be) pruned. Zero means nothing is pruned.
+func (k Keeper) GetModificationIndexStart(ctx sdk.Context) int64 {
+	retention := k.GetParams(ctx).ModificationIndexRetention
+	height := ctx.BlockHeight()
+	if retention == 0 || height <= 0 || uint64(height) <= retention {
+		return 0
+	}
+	return height - int64(retention) + 1 //nolint:gosec // G115: Retention is less than height, so this is safe.
+}
+
+// PruneModificationIndex removes up to the provided number of modification index entries
+// that are older than the retention window. It returns the number of entries removed.
+func (k Keeper) PruneModificationIndex(ctx sdk.Context, limit int) int {
+	start := k.GetModificationIndexStart(ctx)
+	if start <= 0 || limit <= 0 {
+		return 0
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.ModificationIndexKeyPrefix)
+	iter := indexStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(start))) //nolint:gosec // G115: We know start is positive here.
+	var toDelete [][]byte
+	for ; iter.Valid() && len(toDelete) < limit; iter.Next() {
+		toDelete = append(toDelete, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range toDelete {
+		indexStore.Delete(key)
+		height, id, err := types.ParseModificationIndexKey(key)
+		if err != nil {
+			k.Logger(ctx).Error("invalid modification index key", "key", key, "err", err)
+			continue
+		}
+		lastKey := types.GetLastModifiedKey(id)
+		if bz := store.Get(lastKey); len(bz) == 8 && sdk.BigEndianToUint64(bz) == height {
+			store.Delete(lastKey)
+		}
+	}
+	return len(toDelete)
+}
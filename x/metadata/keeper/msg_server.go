@@ -99,6 +99,9 @@ func (k msgServer) AddScopeDataAccess(
 
 	existing, found := k.GetScope(ctx, msg.ScopeId)
 	if !found {
+		if err := invalidScopeIDError(msg.ScopeId); err != nil {
+			return nil, err
+		}
 		return nil, sdkerrors.ErrNotFound.Wrapf("scope not found with id %s", msg.ScopeId)
 	}
 
@@ -127,6 +130,9 @@ func (k msgServer) DeleteScopeDataAccess(
 
 	existing, found := k.GetScope(ctx, msg.ScopeId)
 	if !found {
+		if err := invalidScopeIDError(msg.ScopeId); err != nil {
+			return nil, err
+		}
 		return nil, sdkerrors.ErrNotFound.Wrapf("scope not found with id %s", msg.ScopeId)
 	}
 
@@ -159,6 +165,9 @@ func (k msgServer) AddScopeOwner(
 
 	existing, found := k.GetScope(ctx, msg.ScopeId)
 	if !found {
+		if err := invalidScopeIDError(msg.ScopeId); err != nil {
+			return nil, err
+		}
 		return nil, sdkerrors.ErrNotFound.Wrapf("scope not found with id %s", msg.ScopeId)
 	}
 
@@ -195,6 +204,9 @@ func (k msgServer) DeleteScopeOwner(
 
 	existing, found := k.GetScope(ctx, msg.ScopeId)
 	if !found {
+		if err := invalidScopeIDError(msg.ScopeId); err != nil {
+			return nil, err
+		}
 		return nil, sdkerrors.ErrNotFound.Wrapf("scope not found with id %s", msg.ScopeId)
 	}
 
@@ -773,6 +785,9 @@ func (k msgServer) AddNetAssetValues(goCtx context.Context, msg *types.MsgAddNet
 
 	scope, found := k.GetScope(ctx, scopeID)
 	if !found {
+		if err = invalidScopeIDError(scopeID); err != nil {
+			return nil, err
+		}
 		return nil, sdkerrors.ErrNotFound.Wrap(fmt.Sprintf("scope not found: %v", scopeID.String()))
 	}
 
@@ -788,3 +803,40 @@ func (k msgServer) AddNetAssetValues(goCtx context.Context, msg *types.MsgAddNet
 
 	return &types.MsgAddNetAssetValuesResponse{}, nil
 }
+
+// ForceBurnScopeCoin burns a scope's coin even if the scope still has sessions or records.
+func (k msgServer) ForceBurnScopeCoin(goCtx context.Context, msg *types.MsgForceBurnScopeCoinRequest) (*types.MsgForceBurnScopeCoinResponse, error) {
+	ctx := UnwrapMetadataContext(goCtx)
+
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	scopeID, err := types.MetadataAddressFromBech32(msg.ScopeId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = k.ForceScopeCoinBurn(ctx, scopeID); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgForceBurnScopeCoinResponse{}, nil
+}
+
+// invalidScopeIDError returns an error describing why the provided id is not a scope id, or nil if it is one.
+// If the id is for something that belongs to a scope (e.g. a session), the error includes that scope's id.
+func invalidScopeIDError(scopeID types.MetadataAddress) error {
+	class := types.ClassifyAddressForType(scopeID, types.PrefixScope)
+	switch {
+	case class.Valid:
+		return nil
+	case len(class.ActualHRP) == 0:
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid scope id %s: not a valid metadata address", scopeID.DebugString())
+	case len(class.SuggestedAddress) > 0:
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid scope id %s: it is a %s id, use its scope id %s instead",
+			scopeID, class.ActualHRP, class.SuggestedAddress)
+	default:
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid scope id %s: it is a %s id", scopeID, class.ActualHRP)
+	}
+}
@@ -330,7 +330,7 @@ func (s *MsgServerTestSuite) TestWriteScope() {
 				},
 				Signers: []string{scopeOwnerAddr.String()},
 			},
-			expErr: "invalid scope metadata address MetadataAddress{0x0, 0x31}: " +
+			expErr: "invalid scope metadata address invalid[prefix=0x00 len=2 hex=0031]: " +
 				"incorrect address length (expected: 17, actual: 2): invalid request",
 		},
 		{
@@ -1079,6 +1079,8 @@ func (s *MsgServerTestSuite) TestAddAndDeleteScopeDataAccess() {
 	scopeID := types.ScopeMetadataAddress(uuid.New())
 	scope := types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, "", false)
 	dneScopeID := types.ScopeMetadataAddress(uuid.New())
+	sessionID := scopeID.MustGetAsSessionAddress(uuid.New())
+	recSpecID := types.RecordSpecMetadataAddress(uuid.New(), "recspec")
 	user3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
 
 	scopeSpecMsg := types.NewMsgWriteScopeSpecificationRequest(*scopeSpec, []string{s.user1})
@@ -1109,6 +1111,24 @@ func (s *MsgServerTestSuite) TestAddAndDeleteScopeDataAccess() {
 			signers:  []string{s.user1},
 			errorMsg: fmt.Sprintf("scope not found with id %s: not found", dneScopeID),
 		},
+		{
+			name:     "should fail to ADD address to data access, session id instead of scope id",
+			addMsg:   types.NewMsgAddScopeDataAccessRequest(sessionID, []string{s.user1}, []string{s.user1}),
+			signers:  []string{s.user1},
+			errorMsg: fmt.Sprintf("invalid scope id %s: it is a session id, use its scope id %s instead: invalid request", sessionID, scopeID),
+		},
+		{
+			name:     "should fail to ADD address to data access, record spec id instead of scope id",
+			addMsg:   types.NewMsgAddScopeDataAccessRequest(recSpecID, []string{s.user1}, []string{s.user1}),
+			signers:  []string{s.user1},
+			errorMsg: fmt.Sprintf("invalid scope id %s: it is a recspec id: invalid request", recSpecID),
+		},
+		{
+			name:     "should fail to ADD address to data access, invalid scope id bytes",
+			addMsg:   types.NewMsgAddScopeDataAccessRequest(types.MetadataAddress{0x00, 0x01}, []string{s.user1}, []string{s.user1}),
+			signers:  []string{s.user1},
+			errorMsg: "invalid scope id " + types.MetadataAddress{0x00, 0x01}.DebugString() + ": not a valid metadata address: invalid request",
+		},
 		{
 			name:     "should fail to ADD address to data access, validate add failure",
 			addMsg:   types.NewMsgAddScopeDataAccessRequest(scopeID, []string{s.user1}, []string{s.user1}),
@@ -1471,7 +1491,7 @@ func (s *MsgServerTestSuite) TestUpdateValueOwners() {
 			},
 			scopeIDs: ids(scopeIDNotFound, scopeID1, scopeID2),
 			signers:  []string{valueOwner1, valueOwner2},
-			expErr:   "no account address associated with metadata address \"" + scopeIDNotFound.String() + "\": invalid request",
+			expErr:   "entry 0: no account address associated with metadata address \"" + scopeIDNotFound.String() + "\": invalid request",
 		},
 		{
 			name: "scope 2 of 3 not found",
@@ -1481,7 +1501,7 @@ func (s *MsgServerTestSuite) TestUpdateValueOwners() {
 			},
 			scopeIDs: ids(scopeID1, scopeIDNotFound, scopeID2),
 			signers:  []string{valueOwner1, valueOwner2},
-			expErr:   "no account address associated with metadata address \"" + scopeIDNotFound.String() + "\": invalid request",
+			expErr:   "entry 1: no account address associated with metadata address \"" + scopeIDNotFound.String() + "\": invalid request",
 		},
 		{
 			name: "scope 3 of 3 not found",
@@ -1491,7 +1511,7 @@ func (s *MsgServerTestSuite) TestUpdateValueOwners() {
 			},
 			scopeIDs: ids(scopeID1, scopeID2, scopeIDNotFound),
 			signers:  []string{valueOwner1, valueOwner2},
-			expErr:   "no account address associated with metadata address \"" + scopeIDNotFound.String() + "\": invalid request",
+			expErr:   "entry 2: no account address associated with metadata address \"" + scopeIDNotFound.String() + "\": invalid request",
 		},
 		{
 			name: "not properly signed",
@@ -1510,7 +1530,7 @@ func (s *MsgServerTestSuite) TestUpdateValueOwners() {
 			},
 			scopeIDs: ids(scopeID1),
 			signers:  []string{owner1},
-			expErr:   "no account address associated with metadata address \"" + scopeID1.String() + "\": invalid request",
+			expErr:   "entry 0: no account address associated with metadata address \"" + scopeID1.String() + "\": invalid request",
 		},
 		{
 			name: "1 scope updated",
@@ -2364,7 +2384,7 @@ func (s *MsgServerTestSuite) TestAddNetAssetValue() {
 					}},
 				Signers: []string{user1},
 			},
-			expErr: fmt.Sprintf("scope not found: %v: not found", scopeSpecIDNF.String()),
+			expErr: fmt.Sprintf("invalid scope id %v: it is a scopespec id: invalid request", scopeSpecIDNF.String()),
 		},
 		{
 			name: "value denom does not exist",
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetParams returns the metadata module params.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the metadata module params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+}
+
+// IsRecordNameRegistryEnabled returns true if record names should be recorded in the record name registry.
+func (k Keeper) IsRecordNameRegistryEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).EnableRecordNameRegistry
+}
+
+// IsModificationIndexEnabled returns true if changes to scopes, sessions, and records should be recorded in the modification index.
+func (k Keeper) IsModificationIndexEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).EnableModificationIndex
+}
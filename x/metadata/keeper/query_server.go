@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	b64 "encoding/base64"
 	"fmt"
@@ -8,6 +9,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/store/prefix"
 
@@ -24,9 +27,10 @@ const defaultLimit = 100
 var _ types.QueryServer = Keeper{}
 
 // Params queries params of metadata module.
-func (k Keeper) Params(_ context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "Params")
-	resp := &types.QueryParamsResponse{Params: types.Params{}}
+	ctx := sdk.UnwrapSDKContext(c)
+	resp := &types.QueryParamsResponse{Params: k.GetParams(ctx)}
 	if req != nil && req.IncludeRequest {
 		resp.Request = req
 	}
@@ -169,7 +173,7 @@ func (k Keeper) ScopesAll(c context.Context, req *types.ScopesAllRequest) (*type
 		var addr types.MetadataAddress
 		kErr := addr.Unmarshal(key)
 		if kErr == nil {
-			k.Logger(ctx).Error("failed to unmarshal scope", "address", addr, "error", vErr)
+			k.Logger(ctx).Error("failed to unmarshal scope", append(types.MDFields(addr), "error", vErr)...)
 			retval.Scopes = append(retval.Scopes, types.WrapScopeNotFound(addr))
 		} else {
 			k64 := b64.StdEncoding.EncodeToString(key)
@@ -310,7 +314,7 @@ func (k Keeper) Sessions(c context.Context, req *types.SessionsRequest) (*types.
 
 	if req.IncludeRecords {
 		// Get all the session ids
-		sessionAddrs := []types.MetadataAddress{}
+		var sessionAddrs types.MetadataAddresses
 		for _, s := range retval.Sessions {
 			if s.Session != nil {
 				sessionAddrs = append(sessionAddrs, s.Session.SessionId)
@@ -318,14 +322,7 @@ func (k Keeper) Sessions(c context.Context, req *types.SessionsRequest) (*types.
 		}
 		// Iterate the records for the whole scope, and just keep the ones for our sessions.
 		err := k.IterateRecords(ctx, scopeAddr, func(r types.Record) (stop bool) {
-			keep := false
-			for _, a := range sessionAddrs {
-				if r.SessionId.Equals(a) {
-					keep = true
-					break
-				}
-			}
-			if keep {
+			if sessionAddrs.Contains(r.SessionId) {
 				retval.Records = append(retval.Records, types.WrapRecord(&r, !req.ExcludeIdInfo))
 			}
 			return false
@@ -367,7 +364,7 @@ func (k Keeper) SessionsAll(c context.Context, req *types.SessionsAllRequest) (*
 		var addr types.MetadataAddress
 		kErr := addr.Unmarshal(key)
 		if kErr == nil {
-			k.Logger(ctx).Error("failed to unmarshal session", "address", addr, "error", vErr)
+			k.Logger(ctx).Error("failed to unmarshal session", append(types.MDFields(addr), "error", vErr)...)
 			retval.Sessions = append(retval.Sessions, types.WrapSessionNotFound(addr))
 		} else {
 			k64 := b64.StdEncoding.EncodeToString(key)
@@ -495,23 +492,14 @@ func (k Keeper) Records(c context.Context, req *types.RecordsRequest) (*types.Re
 
 	if req.IncludeSessions {
 		// Get a list of unique session addresses from the records.
-		sessionAddrs := []types.MetadataAddress{}
+		var sessionAddrs types.MetadataAddresses
 		for _, r := range retval.Records {
 			if r.Record != nil {
-				alreadyHave := false
-				for _, a := range sessionAddrs {
-					if r.Record.SessionId.Equals(a) {
-						alreadyHave = true
-						break
-					}
-				}
-				if !alreadyHave {
-					sessionAddrs = append(sessionAddrs, r.Record.SessionId)
-				}
+				sessionAddrs = append(sessionAddrs, r.Record.SessionId)
 			}
 		}
 		// Get each session.
-		for _, a := range sessionAddrs {
+		for _, a := range sessionAddrs.Deduplicated() {
 			session, found := k.GetSession(ctx, a)
 			if found {
 				retval.Sessions = append(retval.Sessions, types.WrapSession(&session, !req.ExcludeIdInfo))
@@ -553,7 +541,7 @@ func (k Keeper) RecordsAll(c context.Context, req *types.RecordsAllRequest) (*ty
 		var addr types.MetadataAddress
 		kErr := addr.Unmarshal(key)
 		if kErr == nil {
-			k.Logger(ctx).Error("failed to unmarshal record", "address", addr, "error", vErr)
+			k.Logger(ctx).Error("failed to unmarshal record", append(types.MDFields(addr), "error", vErr)...)
 			retval.Records = append(retval.Records, types.WrapRecordNotFound(addr))
 		} else {
 			k64 := b64.StdEncoding.EncodeToString(key)
@@ -570,6 +558,193 @@ func (k Keeper) RecordsAll(c context.Context, req *types.RecordsAllRequest) (*ty
 	return &retval, nil
 }
 
+// RecordNameByHash looks up a record name in the record name registry using its name hash.
+func (k Keeper) RecordNameByHash(c context.Context, req *types.RecordNameByHashRequest) (*types.RecordNameByHashResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "RecordNameByHash")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.RecordNameByHashResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	nameHash, err := types.ParseRecordNameHash(req.Hash)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retval.Name, err = k.lookupRecordName(ctx, nameHash)
+	if err != nil {
+		return &retval, err
+	}
+	return &retval, nil
+}
+
+// NameForRecordAddress looks up a record name in the record name registry using the record's address.
+func (k Keeper) NameForRecordAddress(c context.Context, req *types.NameForRecordAddressRequest) (*types.NameForRecordAddressResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "NameForRecordAddress")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.NameForRecordAddressResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.RecordId) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("record id cannot be empty")
+	}
+	addr, err := types.MetadataAddressFromBech32(req.RecordId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("invalid record id %q: %v", req.RecordId, err)
+	}
+	if !addr.IsRecordAddress() && !addr.IsRecordSpecificationAddress() {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("id %q is not a record or record specification address", req.RecordId)
+	}
+	nameHash, err := addr.NameHash()
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("invalid record id %q: %v", req.RecordId, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retval.Name, err = k.lookupRecordName(ctx, nameHash)
+	if err != nil {
+		return &retval, err
+	}
+	return &retval, nil
+}
+
+// RecordLineage returns the tree of records that a record's inputs reference, resolved to a requested depth.
+func (k Keeper) RecordLineage(c context.Context, req *types.RecordLineageRequest) (*types.RecordLineageResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "RecordLineage")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.RecordLineageResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.RecordId) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("record id cannot be empty")
+	}
+	recordAddr, err := types.MetadataAddressFromBech32(req.RecordId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("invalid record id %q: %v", req.RecordId, err)
+	}
+	if !recordAddr.IsRecordAddress() {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("id %q is not a record address", req.RecordId)
+	}
+	retval.Depth = req.Depth
+	switch {
+	case retval.Depth == 0:
+		retval.Depth = types.DefaultRecordLineageDepth
+	case retval.Depth > types.MaxRecordLineageDepth:
+		retval.Depth = types.MaxRecordLineageDepth
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	retval.Lineage = k.GetRecordLineage(ctx, recordAddr, retval.Depth)
+	return &retval, nil
+}
+
+// lookupRecordName gets a name from the record name registry, returning a not-found error that explains
+// the feature flag when the registry is disabled or doesn't have an entry for the hash.
+func (k Keeper) lookupRecordName(ctx sdk.Context, nameHash []byte) (string, error) {
+	name, found := k.GetRecordNameByHash(ctx, nameHash)
+	if found {
+		return name, nil
+	}
+	if !k.IsRecordNameRegistryEnabled(ctx) {
+		return "", status.Errorf(codes.NotFound, "no record name found for hash %x: "+
+			"the record name registry is disabled (see the enable_record_name_registry param)", nameHash)
+	}
+	return "", status.Errorf(codes.NotFound, "no record name found for hash %x: "+
+		"names are only registered for records and record specifications written while the "+
+		"enable_record_name_registry param is true", nameHash)
+}
+
+// ModifiedSince returns the scope, session, and record addresses that last changed at or after a given height.
+func (k Keeper) ModifiedSince(c context.Context, req *types.ModifiedSinceRequest) (*types.ModifiedSinceResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ModifiedSince")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ModifiedSinceResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if req.Height < 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("invalid height %d: cannot be negative", req.Height)
+	}
+	switch req.Type {
+	case "", types.PrefixScope, types.PrefixSession, types.PrefixRecord:
+	default:
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("invalid type %q: must be one of %q, %q, or %q",
+			req.Type, types.PrefixScope, types.PrefixSession, types.PrefixRecord)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.IsModificationIndexEnabled(ctx) {
+		return &retval, status.Error(codes.FailedPrecondition,
+			"the modification index is disabled (see the enable_modification_index param)")
+	}
+	if start := k.GetModificationIndexStart(ctx); req.Height < start {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("invalid height %d: changes before height %d have been pruned "+
+			"from the modification index (see the modification_index_retention param)", req.Height, start)
+	}
+
+	// The index is ordered by height, so we start iterating at the requested height (or the requested key if later).
+	pageReq := &query.PageRequest{}
+	if req.Pagination != nil {
+		*pageReq = *req.Pagination
+	}
+	if pageReq.Reverse {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("reverse pagination is not supported by this query")
+	}
+	if pageReq.Offset > 0 && len(pageReq.Key) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("offset pagination is not supported by this query, use the next key instead")
+	}
+	pageReq.Offset = 0
+	heightKey := sdk.Uint64ToBigEndian(uint64(req.Height)) //nolint:gosec // G115: We know the height is not negative here.
+	if bytes.Compare(pageReq.Key, heightKey) < 0 {
+		pageReq.Key = heightKey
+	}
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ModificationIndexKeyPrefix)
+	pageRes, err := query.FilteredPaginate(indexStore, pageReq, func(key, _ []byte, accumulate bool) (bool, error) {
+		height, id, pErr := types.ParseModificationIndexKey(key)
+		if pErr != nil {
+			return false, pErr
+		}
+		if len(req.Type) > 0 {
+			idType, tErr := id.Prefix()
+			if tErr != nil || idType != req.Type {
+				return false, nil
+			}
+		}
+		if accumulate {
+			retval.Modifications = append(retval.Modifications, types.MetadataModification{
+				Address: id.String(),
+				Height:  int64(height), //nolint:gosec // G115: Heights are written from non-negative block heights.
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+
+	return &retval, nil
+}
+
 // Ownership returns a list of scope identifiers that list the given address as a data or value owner.
 func (k Keeper) Ownership(c context.Context, req *types.OwnershipRequest) (*types.OwnershipResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "Ownership")
@@ -643,11 +818,170 @@ func (k Keeper) ValueOwnership(c context.Context, req *types.ValueOwnershipReque
 	if err != nil {
 		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("error collecting results: %v", err)
 	}
-	retval.ScopeUuids = links.GetPrimaryUUIDs()
+	retval.ScopeUuids, err = links.GetPrimaryUUIDsStrict()
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("error collecting results: %v", err)
+	}
+
+	return &retval, nil
+}
+
+// MarkerMetadataHoldings returns the scopes held by a marker along with each scope's specification.
+func (k Keeper) MarkerMetadataHoldings(c context.Context, req *types.MarkerMetadataHoldingsRequest) (*types.MarkerMetadataHoldingsResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "MarkerMetadataHoldings")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.MarkerMetadataHoldingsResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if req.Id == "" {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("marker id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	markerAddr, err := k.markerAddressForDenomOrAddress(ctx, req.Id)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	var links types.AccMDLinks
+	links, retval.Pagination, err = k.bankKeeper.GetScopesForValueOwner(ctx, markerAddr, req.Pagination)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("error collecting results: %v", err)
+	}
+
+	retval.Holdings = make([]types.MarkerMetadataHolding, 0, len(links))
+	for _, link := range links {
+		if link == nil || len(link.MDAddr) == 0 {
+			continue
+		}
+		holding := types.MarkerMetadataHolding{
+			ScopeId: link.MDAddr.String(),
+			Amount:  k.bankKeeper.GetBalance(ctx, markerAddr, link.MDAddr.Denom()).Amount,
+		}
+		scope, found := k.GetScope(ctx, link.MDAddr)
+		if found {
+			holding.ScopeSpecId = scope.SpecificationId.String()
+		} else {
+			holding.ScopeMissing = true
+		}
+		retval.Holdings = append(retval.Holdings, holding)
+	}
 
 	return &retval, nil
 }
 
+// ScopeDeletionBlockers returns everything that prevents (or complicates) the deletion of a scope.
+func (k Keeper) ScopeDeletionBlockers(c context.Context, req *types.ScopeDeletionBlockersRequest) (*types.ScopeDeletionBlockersResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopeDeletionBlockers")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if req.ScopeId == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("scope id cannot be empty")
+	}
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retval, err := k.GetScopeDeletionBlockers(ctx, scopeAddr)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	return retval, nil
+}
+
+// ScopeParties returns the parties of a scope, grouped by role.
+func (k Keeper) ScopeParties(c context.Context, req *types.ScopePartiesRequest) (*types.ScopePartiesResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopeParties")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if req.ScopeId == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("scope id cannot be empty")
+	}
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retval, err := k.GetScopeParties(ctx, scopeAddr, req.IncludeSessions)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	return retval, nil
+}
+
+// HasScopeAccess returns whether an address has a specific kind of access to a scope.
+func (k Keeper) HasScopeAccess(c context.Context, req *types.HasScopeAccessRequest) (*types.HasScopeAccessResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "HasScopeAccess")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := req.AccessKind.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.ScopeId == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("scope id cannot be empty")
+	}
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if req.Address == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("address cannot be empty")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address %q: %v", req.Address, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retval := &types.HasScopeAccessResponse{}
+	retval.HasAccess, retval.MatchedRole, err = k.CheckScopeAccess(ctx, scopeAddr, addr, req.AccessKind)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	return retval, nil
+}
+
+// markerAddressForDenomOrAddress gets the address of the marker with the provided denom or address.
+func (k Keeper) markerAddressForDenomOrAddress(ctx sdk.Context, id string) (sdk.AccAddress, error) {
+	if addr, err := sdk.AccAddressFromBech32(id); err == nil {
+		if !k.markerKeeper.IsMarkerAccount(ctx, addr) {
+			return nil, fmt.Errorf("address %s is not a marker account", id)
+		}
+		return addr, nil
+	}
+	marker, err := k.markerKeeper.GetMarkerByDenom(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("marker %q not found: %w", id, err)
+	}
+	return marker.GetAddress(), nil
+}
+
 // ScopeSpecification returns a specific scope specification by id.
 func (k Keeper) ScopeSpecification(c context.Context, req *types.ScopeSpecificationRequest) (*types.ScopeSpecificationResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopeSpecification")
@@ -739,7 +1073,7 @@ func (k Keeper) ScopeSpecificationsAll(c context.Context, req *types.ScopeSpecif
 		var addr types.MetadataAddress
 		kErr := addr.Unmarshal(key)
 		if kErr == nil {
-			k.Logger(ctx).Error("failed to unmarshal scope spec", "address", addr, "error", vErr)
+			k.Logger(ctx).Error("failed to unmarshal scope spec", append(types.MDFields(addr), "error", vErr)...)
 			retval.ScopeSpecifications = append(retval.ScopeSpecifications, types.WrapScopeSpecNotFound(addr))
 		} else {
 			k64 := b64.StdEncoding.EncodeToString(key)
@@ -826,7 +1160,7 @@ func (k Keeper) ContractSpecificationsAll(c context.Context, req *types.Contract
 		var addr types.MetadataAddress
 		kErr := addr.Unmarshal(key)
 		if kErr == nil {
-			k.Logger(ctx).Error("failed to unmarshal contract spec", "address", addr, "error", vErr)
+			k.Logger(ctx).Error("failed to unmarshal contract spec", append(types.MDFields(addr), "error", vErr)...)
 			retval.ContractSpecifications = append(retval.ContractSpecifications, types.WrapContractSpecNotFound(addr))
 		} else {
 			k64 := b64.StdEncoding.EncodeToString(key)
@@ -946,7 +1280,7 @@ func (k Keeper) RecordSpecificationsAll(c context.Context, req *types.RecordSpec
 		var addr types.MetadataAddress
 		kErr := addr.Unmarshal(key)
 		if kErr == nil {
-			k.Logger(ctx).Error("failed to unmarshal record spec", "address", addr, "error", vErr)
+			k.Logger(ctx).Error("failed to unmarshal record spec", append(types.MDFields(addr), "error", vErr)...)
 			retval.RecordSpecifications = append(retval.RecordSpecifications, types.WrapRecordSpecNotFound(addr))
 		} else {
 			k64 := b64.StdEncoding.EncodeToString(key)
@@ -1029,6 +1363,31 @@ func (k Keeper) GetByAddr(c context.Context, req *types.GetByAddrRequest) (*type
 	return retval, nil
 }
 
+// AddressDetails breaks a metadata address down into its various components.
+// Malformed addresses are reported in the response instead of causing an error.
+func (k Keeper) AddressDetails(c context.Context, req *types.AddressDetailsRequest) (*types.AddressDetailsResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "AddressDetails")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := &types.AddressDetailsResponse{}
+	addr, err := types.MetadataAddressFromAnyString(req.Address)
+	if err != nil {
+		retval.Error = err.Error()
+		addr = types.UnverifiedMetadataAddressFromString(req.Address)
+	} else {
+		retval.Valid = true
+		ctx := sdk.UnwrapSDKContext(c)
+		retval.Exists = ctx.KVStore(k.storeKey).Has(addr)
+	}
+	if len(addr) > 0 {
+		retval.Details = types.GetAddressDetails(addr)
+	}
+
+	return retval, nil
+}
+
 func (k Keeper) OSLocatorParams(c context.Context, request *types.OSLocatorParamsRequest) (*types.OSLocatorParamsResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "OSLocatorParams")
 	ctx := sdk.UnwrapSDKContext(c)
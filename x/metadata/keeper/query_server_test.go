@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"bytes"
 	gocontext "context"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"slices"
 	"strings"
@@ -13,6 +15,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -20,10 +24,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	simapp "github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/testutil/assertions"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/metadata/keeper"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
@@ -529,6 +537,36 @@ func wrapRecords(records []*types.Record, includeIDInfo bool) []*types.RecordWra
 	return rv
 }
 
+// assertPagesMirrored gets all the entries of a listing, two at a time, by following the next keys, first forward,
+// then in reverse. It asserts that the forward entries are the expected ones, and that the reverse entries are the
+// same, but in the opposite order.
+func (s *QueryServerTestSuite) assertPagesMirrored(exp []string, getPage func(pageReq *query.PageRequest) ([]string, *query.PageResponse, error)) {
+	walk := func(reverse bool) []string {
+		var rv []string
+		var key []byte
+		for i := 0; i < 100; i++ {
+			entries, pageResp, err := getPage(&query.PageRequest{Key: key, Limit: 2, Reverse: reverse})
+			s.Require().NoError(err, "page %d (reverse = %t)", i+1, reverse)
+			rv = append(rv, entries...)
+			if pageResp == nil || len(pageResp.NextKey) == 0 {
+				return rv
+			}
+			key = pageResp.NextKey
+		}
+		s.FailNow("listing did not end after 100 pages", "reverse = %t", reverse)
+		return nil
+	}
+
+	forward := walk(false)
+	backward := walk(true)
+	s.Assert().Equal(exp, forward, "entries walking forward")
+	mirrored := make([]string, len(backward))
+	for i, entry := range backward {
+		mirrored[len(backward)-1-i] = entry
+	}
+	s.Assert().Equal(exp, mirrored, "entries walking backward (reversed)")
+}
+
 // TODO: Params tests
 
 func (s *QueryServerTestSuite) TestScopeQuery() {
@@ -1718,7 +1756,25 @@ func (s *QueryServerTestSuite) TestSessionsQuery() {
 	})
 }
 
-// TODO: SessionsAll tests
+func (s *QueryServerTestSuite) TestSessionsAll() {
+	data := s.createData([][]int{{1}, {2}, {3}, {2, 1}, {1, 1, 1}, {1, 2}})
+	exp := make([]string, len(data.AllSessions))
+	for i, session := range data.AllSessions {
+		exp[i] = session.SessionId.String()
+	}
+
+	s.assertPagesMirrored(exp, func(pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+		resp, err := s.queryClient.SessionsAll(gocontext.Background(), &types.SessionsAllRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, nil, err
+		}
+		rv := make([]string, len(resp.Sessions))
+		for i, session := range resp.Sessions {
+			rv[i] = session.Session.SessionId.String()
+		}
+		return rv, resp.Pagination, nil
+	})
+}
 
 func (s *QueryServerTestSuite) TestRecordsQuery() {
 	app, ctx, queryClient, scopeUUID, scopeID, sessionID, recordName := s.app, s.ctx, s.queryClient, s.scopeUUID, s.scopeID, s.sessionID, s.recordName
@@ -1769,7 +1825,26 @@ func (s *QueryServerTestSuite) TestRecordsQuery() {
 	s.Equal(recordNames[0], rsID.Records[0].Record.Name)
 }
 
-// TODO: RecordsAll tests
+func (s *QueryServerTestSuite) TestRecordsAll() {
+	data := s.createData([][]int{{1}, {2}, {3}, {2, 1}, {1, 1, 1}, {1, 2}})
+	exp := make([]string, len(data.AllRecords))
+	for i, record := range data.AllRecords {
+		exp[i] = record.GetRecordAddress().String()
+	}
+
+	s.assertPagesMirrored(exp, func(pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+		resp, err := s.queryClient.RecordsAll(gocontext.Background(), &types.RecordsAllRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, nil, err
+		}
+		rv := make([]string, len(resp.Records))
+		for i, record := range resp.Records {
+			rv[i] = record.Record.GetRecordAddress().String()
+		}
+		return rv, resp.Pagination, nil
+	})
+}
+
 // TODO: Ownership tests
 // TODO: ValueOwnership tests
 
@@ -2281,6 +2356,1069 @@ func (s *QueryServerTestSuite) TestScopeNetAssetValuesQuery() {
 	}
 }
 
+func (s *QueryServerTestSuite) TestModuleHealthQuery() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1, false)
+	s.Require().NoError(app.MetadataKeeper.SetScope(ctx, *scope), "SetScope")
+
+	checkNames := func(checks []types.HealthCheck) []string {
+		rv := make([]string, len(checks))
+		for i, check := range checks {
+			rv[i] = check.Name
+		}
+		return rv
+	}
+	expNames := []string{"os_locator_params", "scopes", "scope_spec_index"}
+
+	resp, err := queryClient.ModuleHealth(gocontext.Background(), &types.ModuleHealthRequest{})
+	s.Require().NoError(err, "ModuleHealth before corruption")
+	s.Assert().True(resp.Healthy, "Healthy before corruption")
+	s.Assert().Equal(expNames, checkNames(resp.Checks), "check names before corruption")
+	for _, check := range resp.Checks {
+		s.Assert().True(check.Passed, "%s passed before corruption", check.Name)
+		s.Assert().Empty(check.Error, "%s error before corruption", check.Name)
+	}
+
+	// Add an index entry (that sorts before the real one) for a scope that doesn't exist.
+	badSpecID := types.ScopeSpecMetadataAddress(uuid.UUID{})
+	missingScopeID := types.ScopeMetadataAddress(uuid.New())
+	store := ctx.KVStore(app.MetadataKeeper.GetStoreKey())
+	store.Set(types.GetScopeSpecScopeCacheKey(badSpecID, missingScopeID), []byte{0x01})
+
+	resp, err = queryClient.ModuleHealth(gocontext.Background(), &types.ModuleHealthRequest{})
+	s.Require().NoError(err, "ModuleHealth after corruption")
+	s.Assert().False(resp.Healthy, "Healthy after corruption")
+	s.Require().Equal(expNames, checkNames(resp.Checks), "check names after corruption")
+	s.Assert().True(resp.Checks[0].Passed, "os_locator_params passed after corruption")
+	s.Assert().True(resp.Checks[1].Passed, "scopes passed after corruption")
+	s.Assert().False(resp.Checks[2].Passed, "scope_spec_index passed after corruption")
+	s.Assert().Equal("scope spec "+badSpecID.String()+" index entry points to unknown scope "+missingScopeID.String(),
+		resp.Checks[2].Error, "scope_spec_index error after corruption")
+}
+
+func (s *QueryServerTestSuite) TestRecordNameQueries() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	enabledName := "Registered_Name"
+	disabledName := "unregistered_name"
+	scopeUUID := uuid.New()
+	enabledRecordID := types.RecordMetadataAddress(scopeUUID, enabledName)
+	disabledRecordID := types.RecordMetadataAddress(scopeUUID, disabledName)
+	recSpecName := "spec_name"
+	recSpecID := types.RecordSpecMetadataAddress(uuid.New(), recSpecName)
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	newRecord := func(name string) types.Record {
+		return types.Record{Name: name, SessionId: sessionID, SpecificationId: recSpecID, Process: types.Process{Name: "process"}}
+	}
+
+	// Write one record while the registry is disabled, then enable it and write another record and a record spec.
+	s.Require().False(app.MetadataKeeper.IsRecordNameRegistryEnabled(ctx), "IsRecordNameRegistryEnabled by default")
+	app.MetadataKeeper.SetRecord(ctx, newRecord(disabledName))
+	app.MetadataKeeper.SetParams(ctx, types.NewParams(true, false, 0))
+	app.MetadataKeeper.SetRecord(ctx, newRecord(enabledName))
+	app.MetadataKeeper.SetRecordSpecification(ctx, types.RecordSpecification{SpecificationId: recSpecID, Name: recSpecName})
+
+	enabledHash := types.RecordNameHash(enabledName)
+	disabledHash := types.RecordNameHash(disabledName)
+	missingErr := func(hash []byte) string {
+		return fmt.Sprintf("no record name found for hash %x: names are only registered for records and record "+
+			"specifications written while the enable_record_name_registry param is true", hash)
+	}
+
+	tests := []struct {
+		name    string
+		hash    string
+		id      string
+		expName string
+		expErr  string
+	}{
+		{name: "hash: hex", hash: fmt.Sprintf("%x", enabledHash), expName: enabledName},
+		{name: "hash: base64", hash: base64.StdEncoding.EncodeToString(enabledHash), expName: enabledName},
+		{name: "hash: record spec name", hash: fmt.Sprintf("%x", types.RecordNameHash(recSpecName)), expName: recSpecName},
+		{name: "hash: written while disabled", hash: fmt.Sprintf("%x", disabledHash), expErr: missingErr(disabledHash)},
+		{name: "hash: invalid", hash: "nope", expErr: `invalid record name hash "nope": must be the hex or base64 encoding of 16 or 32 bytes: invalid request`},
+		{name: "id: record", id: enabledRecordID.String(), expName: enabledName},
+		{name: "id: record spec", id: recSpecID.String(), expName: recSpecName},
+		{name: "id: written while disabled", id: disabledRecordID.String(), expErr: missingErr(disabledHash)},
+		{name: "id: scope", id: s.scopeID.String(), expErr: `id "` + s.scopeID.String() + `" is not a record or record specification address: invalid request`},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var name string
+			var err error
+			if len(tc.hash) > 0 {
+				var resp *types.RecordNameByHashResponse
+				resp, err = queryClient.RecordNameByHash(gocontext.Background(), &types.RecordNameByHashRequest{Hash: tc.hash})
+				if resp != nil {
+					name = resp.Name
+				}
+			} else {
+				var resp *types.NameForRecordAddressResponse
+				resp, err = queryClient.NameForRecordAddress(gocontext.Background(), &types.NameForRecordAddressRequest{RecordId: tc.id})
+				if resp != nil {
+					name = resp.Name
+				}
+			}
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "query error")
+			} else {
+				s.Assert().NoError(err, "query error")
+			}
+			s.Assert().Equal(tc.expName, name, "name")
+		})
+	}
+
+	s.Run("registry disabled", func() {
+		app.MetadataKeeper.SetParams(ctx, types.DefaultParams())
+		defer app.MetadataKeeper.SetParams(ctx, types.NewParams(true, false, 0))
+
+		// Names registered while enabled are still available.
+		resp, err := queryClient.NameForRecordAddress(gocontext.Background(), &types.NameForRecordAddressRequest{RecordId: enabledRecordID.String()})
+		s.Require().NoError(err, "NameForRecordAddress of name registered while enabled")
+		s.Assert().Equal(enabledName, resp.Name, "NameForRecordAddress name of name registered while enabled")
+
+		_, err = queryClient.NameForRecordAddress(gocontext.Background(), &types.NameForRecordAddressRequest{RecordId: disabledRecordID.String()})
+		expErr := fmt.Sprintf("no record name found for hash %x: the record name registry is disabled "+
+			"(see the enable_record_name_registry param)", disabledHash)
+		s.Assert().ErrorContains(err, expErr, "NameForRecordAddress of name not registered")
+		s.Assert().Equal(codes.NotFound, status.Code(err), "NameForRecordAddress error code")
+	})
+}
+
+func (s *QueryServerTestSuite) TestRecordLineage() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	scopeUUIDA, scopeUUIDB := uuid.New(), uuid.New()
+	sessionA := types.SessionMetadataAddress(scopeUUIDA, uuid.New())
+	sessionB1 := types.SessionMetadataAddress(scopeUUIDB, uuid.New())
+	sessionB2 := types.SessionMetadataAddress(scopeUUIDB, uuid.New())
+	recSpecID := types.RecordSpecMetadataAddress(uuid.New(), "spec_name")
+	recordInput := func(name string, recordID types.MetadataAddress) types.RecordInput {
+		return types.RecordInput{Name: name, Source: &types.RecordInput_RecordId{RecordId: recordID}, TypeName: "record", Status: types.RecordInputStatus_Record}
+	}
+	hashInput := func(name, hash string) types.RecordInput {
+		return types.RecordInput{Name: name, Source: &types.RecordInput_Hash{Hash: hash}, TypeName: "doc", Status: types.RecordInputStatus_Proposed}
+	}
+	setRecord := func(name string, sessionID types.MetadataAddress, inputs ...types.RecordInput) types.MetadataAddress {
+		record := types.Record{Name: name, SessionId: sessionID, SpecificationId: recSpecID, Process: types.Process{Name: "process"}, Inputs: inputs}
+		app.MetadataKeeper.SetRecord(ctx, record)
+		return record.GetRecordAddress()
+	}
+
+	// top (in scope A) -> mid (in scope B) -> leaf (also in scope B, different session).
+	leafID := setRecord("leaf", sessionB1, hashInput("raw", "leafhash"))
+	midID := setRecord("mid", sessionB2, recordInput("leaf-in", leafID), hashInput("extra", "midhash"))
+	missingID := types.RecordMetadataAddress(scopeUUIDB, "missing")
+	topID := setRecord("top", sessionA, hashInput("doc", "tophash"), recordInput("mid-in", midID), recordInput("missing-in", missingID))
+	// cycA -> cycB -> cycA.
+	cycAID := types.RecordMetadataAddress(scopeUUIDA, "cycA")
+	cycBID := setRecord("cycB", sessionA, recordInput("a-in", cycAID))
+	setRecord("cycA", sessionA, recordInput("b-in", cycBID))
+
+	leafNode := types.RecordLineageNode{
+		RecordId:   leafID.String(),
+		SessionId:  sessionB1.String(),
+		InputName:  "leaf-in",
+		HashInputs: []types.RecordLineageHashInput{{Name: "raw", Hash: "leafhash", TypeName: "doc"}},
+	}
+	midNode := func(leaf *types.RecordLineageNode) types.RecordLineageNode {
+		rv := types.RecordLineageNode{
+			RecordId:   midID.String(),
+			SessionId:  sessionB2.String(),
+			InputName:  "mid-in",
+			HashInputs: []types.RecordLineageHashInput{{Name: "extra", Hash: "midhash", TypeName: "doc"}},
+		}
+		if leaf != nil {
+			rv.Inputs = []types.RecordLineageNode{*leaf}
+		} else {
+			rv.Truncated = true
+		}
+		return rv
+	}
+	missingNode := types.RecordLineageNode{RecordId: missingID.String(), InputName: "missing-in", NotFound: true}
+	topNode := func(inputs ...types.RecordLineageNode) *types.RecordLineageNode {
+		return &types.RecordLineageNode{
+			RecordId:   topID.String(),
+			SessionId:  sessionA.String(),
+			Inputs:     inputs,
+			HashInputs: []types.RecordLineageHashInput{{Name: "doc", Hash: "tophash", TypeName: "doc"}},
+			Truncated:  len(inputs) == 0,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		req      *types.RecordLineageRequest
+		expResp  *types.RecordLineageResponse
+		expInErr []string
+	}{
+		{
+			name:     "empty record id",
+			req:      &types.RecordLineageRequest{},
+			expInErr: []string{"record id cannot be empty"},
+		},
+		{
+			name:     "invalid record id",
+			req:      &types.RecordLineageRequest{RecordId: "record1bad"},
+			expInErr: []string{`invalid record id "record1bad"`},
+		},
+		{
+			name:     "not a record id",
+			req:      &types.RecordLineageRequest{RecordId: sessionA.String()},
+			expInErr: []string{fmt.Sprintf("id %q is not a record address", sessionA)},
+		},
+		{
+			name: "record not found",
+			req:  &types.RecordLineageRequest{RecordId: missingID.String()},
+			expResp: &types.RecordLineageResponse{
+				Lineage: &types.RecordLineageNode{RecordId: missingID.String(), NotFound: true},
+				Depth:   types.DefaultRecordLineageDepth,
+			},
+		},
+		{
+			name: "default depth",
+			req:  &types.RecordLineageRequest{RecordId: topID.String()},
+			expResp: &types.RecordLineageResponse{
+				Lineage: topNode(midNode(&leafNode), missingNode),
+				Depth:   types.DefaultRecordLineageDepth,
+			},
+		},
+		{
+			name: "depth 1",
+			req:  &types.RecordLineageRequest{RecordId: topID.String(), Depth: 1},
+			expResp: &types.RecordLineageResponse{
+				Lineage: topNode(midNode(nil), missingNode),
+				Depth:   1,
+			},
+		},
+		{
+			name: "depth over max",
+			req:  &types.RecordLineageRequest{RecordId: topID.String(), Depth: 1000, IncludeRequest: true},
+			expResp: &types.RecordLineageResponse{
+				Lineage: topNode(midNode(&leafNode), missingNode),
+				Depth:   types.MaxRecordLineageDepth,
+				Request: &types.RecordLineageRequest{RecordId: topID.String(), Depth: 1000, IncludeRequest: true},
+			},
+		},
+		{
+			name: "leaf record",
+			req:  &types.RecordLineageRequest{RecordId: leafID.String()},
+			expResp: &types.RecordLineageResponse{
+				Lineage: &types.RecordLineageNode{
+					RecordId:   leafID.String(),
+					SessionId:  sessionB1.String(),
+					HashInputs: []types.RecordLineageHashInput{{Name: "raw", Hash: "leafhash", TypeName: "doc"}},
+				},
+				Depth: types.DefaultRecordLineageDepth,
+			},
+		},
+		{
+			name: "cycle",
+			req:  &types.RecordLineageRequest{RecordId: cycAID.String(), Depth: types.MaxRecordLineageDepth},
+			expResp: &types.RecordLineageResponse{
+				Lineage: &types.RecordLineageNode{
+					RecordId:  cycAID.String(),
+					SessionId: sessionA.String(),
+					Inputs: []types.RecordLineageNode{{
+						RecordId:  cycBID.String(),
+						SessionId: sessionA.String(),
+						InputName: "b-in",
+						Inputs: []types.RecordLineageNode{{
+							RecordId:  cycAID.String(),
+							SessionId: sessionA.String(),
+							InputName: "a-in",
+							Cycle:     true,
+						}},
+					}},
+				},
+				Depth: types.MaxRecordLineageDepth,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := queryClient.RecordLineage(ctx, tc.req)
+			if len(tc.expInErr) > 0 {
+				s.Require().Error(err, "RecordLineage error")
+				for _, exp := range tc.expInErr {
+					s.Assert().ErrorContains(err, exp, "RecordLineage error")
+				}
+				return
+			}
+			s.Require().NoError(err, "RecordLineage error")
+			s.Assert().Equal(tc.expResp, resp, "RecordLineage response")
+		})
+	}
+}
+
+func (s *QueryServerTestSuite) TestModifiedSince() {
+	app := s.app
+	atHeight := func(height int64) sdk.Context {
+		return s.ctx.WithBlockHeight(height)
+	}
+	modifiedSince := func(height int64, req *types.ModifiedSinceRequest) (*types.ModifiedSinceResponse, error) {
+		return app.MetadataKeeper.ModifiedSince(atHeight(height), req)
+	}
+
+	scopeAID := types.ScopeMetadataAddress(uuid.New())
+	scopeBID := types.ScopeMetadataAddress(uuid.New())
+	scopeCID := types.ScopeMetadataAddress(uuid.New())
+	sessionID := scopeAID.MustGetAsSessionAddress(uuid.New())
+	recordID := sessionID.MustGetAsRecordAddress("rec1")
+	newScope := func(id types.MetadataAddress) types.Scope {
+		return types.Scope{ScopeId: id, SpecificationId: s.scopeSpecID, Owners: ownerPartyList(s.user1)}
+	}
+	mod := func(id types.MetadataAddress, height int64) types.MetadataModification {
+		return types.MetadataModification{Address: id.String(), Height: height}
+	}
+
+	// Nothing written while the index is disabled is recorded.
+	s.Require().False(app.MetadataKeeper.IsModificationIndexEnabled(s.ctx), "IsModificationIndexEnabled by default")
+	s.Require().NoError(app.MetadataKeeper.SetScope(atHeight(5), newScope(scopeCID)), "SetScope C at 5")
+	_, err := modifiedSince(5, &types.ModifiedSinceRequest{})
+	s.Assert().EqualError(err, "rpc error: code = FailedPrecondition desc = the modification index is disabled "+
+		"(see the enable_modification_index param)", "ModifiedSince while disabled")
+
+	app.MetadataKeeper.SetParams(s.ctx, types.NewParams(false, true, 0))
+	s.Require().NoError(app.MetadataKeeper.SetScope(atHeight(10), newScope(scopeAID)), "SetScope A at 10")
+	app.MetadataKeeper.SetSession(atHeight(10), types.Session{SessionId: sessionID, SpecificationId: s.cSpecID, Parties: ownerPartyList(s.user1)})
+	app.MetadataKeeper.SetRecord(atHeight(20), types.Record{Name: "rec1", SessionId: sessionID, SpecificationId: s.recSpecID, Process: types.Process{Name: "process"}})
+	s.Require().NoError(app.MetadataKeeper.SetScope(atHeight(20), newScope(scopeBID)), "SetScope B at 20")
+	s.Require().NoError(app.MetadataKeeper.SetScope(atHeight(30), newScope(scopeAID)), "SetScope A at 30")
+	s.Require().NoError(app.MetadataKeeper.RemoveScope(atHeight(40), scopeBID), "RemoveScope B at 40")
+
+	allMods := []types.MetadataModification{mod(sessionID, 10), mod(recordID, 20), mod(scopeAID, 30), mod(scopeBID, 40)}
+
+	tests := []struct {
+		name    string
+		req     *types.ModifiedSinceRequest
+		expMods []types.MetadataModification
+		expErr  string
+	}{
+		{name: "everything", req: &types.ModifiedSinceRequest{Height: 0}, expMods: allMods},
+		{name: "exact height", req: &types.ModifiedSinceRequest{Height: 20}, expMods: allMods[1:]},
+		{name: "between heights", req: &types.ModifiedSinceRequest{Height: 21}, expMods: allMods[2:]},
+		{name: "after last change", req: &types.ModifiedSinceRequest{Height: 41}, expMods: nil},
+		{name: "only scopes", req: &types.ModifiedSinceRequest{Height: 0, Type: "scope"}, expMods: allMods[2:]},
+		{name: "only sessions", req: &types.ModifiedSinceRequest{Height: 0, Type: "session"}, expMods: allMods[:1]},
+		{name: "only records", req: &types.ModifiedSinceRequest{Height: 11, Type: "record"}, expMods: allMods[1:2]},
+		{name: "negative height", req: &types.ModifiedSinceRequest{Height: -1}, expErr: "invalid height -1: cannot be negative: invalid request"},
+		{
+			name:   "invalid type",
+			req:    &types.ModifiedSinceRequest{Type: "scopespec"},
+			expErr: `invalid type "scopespec": must be one of "scope", "session", or "record": invalid request`,
+		},
+		{
+			name:   "reverse",
+			req:    &types.ModifiedSinceRequest{Pagination: &query.PageRequest{Reverse: true}},
+			expErr: "reverse pagination is not supported by this query: invalid request",
+		},
+		{
+			name:   "offset",
+			req:    &types.ModifiedSinceRequest{Pagination: &query.PageRequest{Offset: 1}},
+			expErr: "offset pagination is not supported by this query, use the next key instead: invalid request",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := modifiedSince(40, tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "ModifiedSince error")
+				return
+			}
+			s.Require().NoError(err, "ModifiedSince error")
+			s.Assert().Equal(tc.expMods, resp.Modifications, "ModifiedSince modifications")
+		})
+	}
+
+	s.Run("paginated", func() {
+		req := &types.ModifiedSinceRequest{Height: 10, Pagination: &query.PageRequest{Limit: 3}}
+		resp, err := modifiedSince(40, req)
+		s.Require().NoError(err, "ModifiedSince page 1")
+		s.Assert().Equal(allMods[:3], resp.Modifications, "page 1 modifications")
+		s.Require().NotEmpty(resp.Pagination.NextKey, "page 1 next key")
+
+		req.Pagination = &query.PageRequest{Limit: 3, Key: resp.Pagination.NextKey}
+		resp, err = modifiedSince(40, req)
+		s.Require().NoError(err, "ModifiedSince page 2")
+		s.Assert().Equal(allMods[3:], resp.Modifications, "page 2 modifications")
+		s.Assert().Empty(resp.Pagination.NextKey, "page 2 next key")
+	})
+
+	s.Run("pruned", func() {
+		app.MetadataKeeper.SetParams(s.ctx, types.NewParams(false, true, 15))
+		ctx := atHeight(40)
+		s.Assert().Equal(int64(26), app.MetadataKeeper.GetModificationIndexStart(ctx), "GetModificationIndexStart")
+
+		_, err = modifiedSince(40, &types.ModifiedSinceRequest{Height: 25})
+		s.Assert().EqualError(err, "invalid height 25: changes before height 26 have been pruned from the modification "+
+			"index (see the modification_index_retention param): invalid request", "ModifiedSince before retention window")
+
+		s.Assert().Equal(1, app.MetadataKeeper.PruneModificationIndex(ctx, 1), "PruneModificationIndex with limit 1")
+		s.Assert().Equal(1, app.MetadataKeeper.PruneModificationIndex(ctx, 10), "PruneModificationIndex with limit 10")
+		s.Assert().Equal(0, app.MetadataKeeper.PruneModificationIndex(ctx, 10), "PruneModificationIndex with nothing left")
+
+		for _, id := range []types.MetadataAddress{sessionID, recordID} {
+			_, found := app.MetadataKeeper.GetLastModifiedHeight(ctx, id)
+			s.Assert().False(found, "GetLastModifiedHeight(%s) found after pruning", id)
+		}
+		height, found := app.MetadataKeeper.GetLastModifiedHeight(ctx, scopeAID)
+		s.Assert().True(found, "GetLastModifiedHeight(scope A) found after pruning")
+		s.Assert().Equal(int64(30), height, "GetLastModifiedHeight(scope A) after pruning")
+
+		resp, err := modifiedSince(40, &types.ModifiedSinceRequest{Height: 26})
+		s.Require().NoError(err, "ModifiedSince after pruning")
+		s.Assert().Equal(allMods[2:], resp.Modifications, "ModifiedSince modifications after pruning")
+	})
+}
+
+func (s *QueryServerTestSuite) TestMarkerMetadataHoldings() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	denom := "scopepool"
+	marker := markertypes.NewEmptyMarkerAccount(denom, s.user1, []markertypes.AccessGrant{
+		*markertypes.NewAccessGrant(s.user1Addr, []markertypes.Access{markertypes.Access_Admin}),
+	})
+	marker.Supply = sdkmath.NewInt(1)
+	s.Require().NoError(app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	markerAddr := marker.GetAddress()
+
+	healthyID := types.ScopeMetadataAddress(uuid.MustParse("11111111-1111-1111-1111-111111111111"))
+	orphanID := types.ScopeMetadataAddress(uuid.MustParse("22222222-2222-2222-2222-222222222222"))
+	for _, scopeID := range []types.MetadataAddress{healthyID, orphanID} {
+		scope := types.Scope{
+			ScopeId:           scopeID,
+			SpecificationId:   s.scopeSpecID,
+			Owners:            ownerPartyList(s.user1),
+			ValueOwnerAddress: markerAddr.String(),
+		}
+		s.Require().NoError(app.MetadataKeeper.SetScope(ctx, scope), "SetScope(%s)", scopeID)
+	}
+	// Delete the orphan scope directly from state so that its coin is left behind.
+	ctx.KVStore(app.MetadataKeeper.GetStoreKey()).Delete(orphanID)
+
+	// Results are ordered by scope denom, which puts the orphan first.
+	expHoldings := []types.MarkerMetadataHolding{
+		{ScopeId: orphanID.String(), Amount: sdkmath.OneInt(), ScopeMissing: true},
+		{ScopeId: healthyID.String(), ScopeSpecId: s.scopeSpecID.String(), Amount: sdkmath.OneInt()},
+	}
+
+	tests := []struct {
+		name   string
+		req    *types.MarkerMetadataHoldingsRequest
+		exp    []types.MarkerMetadataHolding
+		expErr string
+	}{
+		{
+			name: "by denom",
+			req:  &types.MarkerMetadataHoldingsRequest{Id: denom},
+			exp:  expHoldings,
+		},
+		{
+			name: "by address",
+			req:  &types.MarkerMetadataHoldingsRequest{Id: markerAddr.String()},
+			exp:  expHoldings,
+		},
+		{
+			name: "paginated",
+			req:  &types.MarkerMetadataHoldingsRequest{Id: denom, Pagination: &query.PageRequest{Offset: 1, Limit: 1}},
+			exp:  expHoldings[1:],
+		},
+		{
+			name:   "empty id",
+			req:    &types.MarkerMetadataHoldingsRequest{},
+			expErr: "marker id cannot be empty: invalid request",
+		},
+		{
+			name:   "not a marker address",
+			req:    &types.MarkerMetadataHoldingsRequest{Id: s.user2},
+			expErr: "address " + s.user2 + " is not a marker account: invalid request",
+		},
+		{
+			name:   "unknown denom",
+			req:    &types.MarkerMetadataHoldingsRequest{Id: "nosuchdenom"},
+			expErr: `marker "nosuchdenom" not found`,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := queryClient.MarkerMetadataHoldings(gocontext.Background(), tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "MarkerMetadataHoldings error")
+				return
+			}
+			s.Require().NoError(err, "MarkerMetadataHoldings error")
+			s.Assert().Equal(tc.exp, resp.Holdings, "MarkerMetadataHoldings holdings")
+		})
+	}
+}
+
+func (s *QueryServerTestSuite) TestScopeDeletionBlockers() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	// Each scope has exactly one kind of blocker (except the "clean" one which doesn't have any).
+	newScope := func(name string) types.MetadataAddress {
+		scopeID := types.ScopeMetadataAddress(uuid.NewSHA1(uuid.NameSpaceOID, []byte(name)))
+		scope := types.NewScope(scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1, false)
+		s.Require().NoError(app.MetadataKeeper.SetScope(ctx, *scope), "SetScope(%s)", name)
+		return scopeID
+	}
+	newSession := func(scopeID types.MetadataAddress) types.MetadataAddress {
+		sessionID := scopeID.MustGetAsSessionAddress(uuid.New())
+		session := types.NewSession("name", sessionID, s.cSpecID, ownerPartyList(s.user1),
+			&types.AuditFields{CreatedBy: s.user1, CreatedDate: time.Now()})
+		app.MetadataKeeper.SetSession(ctx, *session)
+		return sessionID
+	}
+	sendScopeCoin := func(scopeID types.MetadataAddress, toAddr sdk.AccAddress) {
+		err := app.BankKeeper.SendCoins(ctx, s.user1Addr, toAddr, scopeID.Coins())
+		s.Require().NoError(err, "SendCoins(%s) to %s", scopeID.Coins(), toAddr)
+	}
+
+	cleanID := newScope("clean")
+	cleanUUID := uuid.NewSHA1(uuid.NameSpaceOID, []byte("clean")).String()
+
+	sessionsID := newScope("sessions")
+	newSession(sessionsID)
+	newSession(sessionsID)
+
+	recordsID := newScope("records")
+	recSessionID := newSession(recordsID)
+	record := types.NewRecord(s.recordName, recSessionID,
+		*types.NewProcess("procname", &types.Process_Hash{Hash: "PROC_HASH"}, "proc_method"),
+		[]types.RecordInput{}, []types.RecordOutput{}, s.recSpecID)
+	app.MetadataKeeper.SetRecord(ctx, *record)
+
+	blockedID := newScope("blocked")
+	blockedAddr := app.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+	sendScopeCoin(blockedID, blockedAddr)
+
+	moduleAcctID := newScope("module account")
+	moduleAcct := authtypes.NewEmptyModuleAccount("scopeholder")
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, moduleAcct))
+	sendScopeCoin(moduleAcctID, moduleAcct.GetAddress())
+
+	escrowID := newScope("escrow")
+	denom := "scopeescrow"
+	marker := markertypes.NewEmptyMarkerAccount(denom, s.user1, []markertypes.AccessGrant{
+		*markertypes.NewAccessGrant(s.user1Addr, []markertypes.Access{markertypes.Access_Admin}),
+	})
+	marker.Supply = sdkmath.NewInt(1)
+	s.Require().NoError(app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	sendScopeCoin(escrowID, marker.GetAddress())
+
+	unknownID := types.ScopeMetadataAddress(uuid.NewSHA1(uuid.NameSpaceOID, []byte("unknown")))
+
+	tests := []struct {
+		name   string
+		req    *types.ScopeDeletionBlockersRequest
+		exp    *types.ScopeDeletionBlockersResponse
+		expErr string
+	}{
+		{
+			name: "no blockers",
+			req:  &types.ScopeDeletionBlockersRequest{ScopeId: cleanID.String()},
+			exp:  &types.ScopeDeletionBlockersResponse{ScopeId: cleanID.String()},
+		},
+		{
+			name: "no blockers by uuid with request",
+			req:  &types.ScopeDeletionBlockersRequest{ScopeId: cleanUUID, IncludeRequest: true},
+			exp: &types.ScopeDeletionBlockersResponse{
+				ScopeId: cleanID.String(),
+				Request: &types.ScopeDeletionBlockersRequest{ScopeId: cleanUUID, IncludeRequest: true},
+			},
+		},
+		{
+			name: "sessions",
+			req:  &types.ScopeDeletionBlockersRequest{ScopeId: sessionsID.String()},
+			exp: &types.ScopeDeletionBlockersResponse{
+				ScopeId:      sessionsID.String(),
+				SessionCount: 2,
+				Blockers:     []string{"scope has 2 session(s)"},
+			},
+		},
+		{
+			name: "records",
+			req:  &types.ScopeDeletionBlockersRequest{ScopeId: recordsID.String()},
+			exp: &types.ScopeDeletionBlockersResponse{
+				ScopeId:      recordsID.String(),
+				SessionCount: 1,
+				RecordCount:  1,
+				Blockers:     []string{"scope has 1 session(s)", "scope has 1 record(s)"},
+			},
+		},
+		{
+			name: "blocked value owner",
+			req:  &types.ScopeDeletionBlockersRequest{ScopeId: blockedID.String()},
+			exp: &types.ScopeDeletionBlockersResponse{
+				ScopeId:              blockedID.String(),
+				RestrictedValueOwner: blockedAddr.String(),
+				Blockers:             []string{"scope coin is held by blocked address " + blockedAddr.String()},
+			},
+		},
+		{
+			name: "module account value owner",
+			req:  &types.ScopeDeletionBlockersRequest{ScopeId: moduleAcctID.String()},
+			exp: &types.ScopeDeletionBlockersResponse{
+				ScopeId:              moduleAcctID.String(),
+				RestrictedValueOwner: moduleAcct.GetAddress().String(),
+				Blockers:             []string{"scope coin is held by module account " + moduleAcct.GetAddress().String()},
+			},
+		},
+		{
+			name: "escrowed in marker",
+			req:  &types.ScopeDeletionBlockersRequest{ScopeId: escrowID.String()},
+			exp: &types.ScopeDeletionBlockersResponse{
+				ScopeId:           escrowID.String(),
+				EscrowMarkerDenom: denom,
+				Blockers:          []string{"scope coin is held in escrow by marker " + denom + " (" + marker.GetAddress().String() + ")"},
+			},
+		},
+		{
+			name:   "empty scope id",
+			req:    &types.ScopeDeletionBlockersRequest{},
+			expErr: "scope id cannot be empty: invalid request",
+		},
+		{
+			name:   "not a scope id",
+			req:    &types.ScopeDeletionBlockersRequest{ScopeId: s.sessionID.String()},
+			expErr: "address [" + s.sessionID.String() + "] is not a scope address: invalid request",
+		},
+		{
+			name:   "unknown scope",
+			req:    &types.ScopeDeletionBlockersRequest{ScopeId: unknownID.String()},
+			expErr: "scope not found with id " + unknownID.String() + ": invalid request",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := queryClient.ScopeDeletionBlockers(gocontext.Background(), tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "ScopeDeletionBlockers error")
+				return
+			}
+			s.Require().NoError(err, "ScopeDeletionBlockers error")
+			s.Assert().Equal(tc.exp, resp, "ScopeDeletionBlockers response")
+		})
+	}
+}
+
+func (s *QueryServerTestSuite) TestScopeParties() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	user3Addr := sdk.AccAddress("user3_______________")
+	user3 := user3Addr.String()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, user3Addr))
+
+	// user1 and user3 have accounts, user2 does not.
+	scopeUUID := uuid.NewSHA1(uuid.NameSpaceOID, []byte("scope parties"))
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	scope := types.NewScope(scopeID, s.scopeSpecID, []types.Party{
+		{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER},
+		{Address: user3, Role: types.PartyType_PARTY_TYPE_SERVICER, Optional: true},
+		{Address: s.user2, Role: types.PartyType_PARTY_TYPE_OWNER, Optional: true},
+	}, []string{s.user1}, s.user1, false)
+	s.Require().NoError(app.MetadataKeeper.SetScope(ctx, *scope), "SetScope")
+
+	newSession := func(sessionUUID string, parties ...types.Party) types.MetadataAddress {
+		sessionID := scopeID.MustGetAsSessionAddress(uuid.MustParse(sessionUUID))
+		session := types.NewSession("name", sessionID, s.cSpecID, parties,
+			&types.AuditFields{CreatedBy: s.user1, CreatedDate: time.Now()})
+		app.MetadataKeeper.SetSession(ctx, *session)
+		return sessionID
+	}
+	session1ID := newSession("00000000-0000-0000-0000-000000000001",
+		types.Party{Address: s.user2, Role: types.PartyType_PARTY_TYPE_OWNER},
+		types.Party{Address: user3, Role: types.PartyType_PARTY_TYPE_SERVICER, Optional: true},
+		types.Party{Address: user3, Role: types.PartyType_PARTY_TYPE_AFFILIATE},
+	)
+	session2ID := newSession("00000000-0000-0000-0000-000000000002",
+		types.Party{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER, Optional: true},
+		types.Party{Address: s.user2, Role: types.PartyType_PARTY_TYPE_ORIGINATOR},
+	)
+
+	unknownID := types.ScopeMetadataAddress(uuid.NewSHA1(uuid.NameSpaceOID, []byte("unknown")))
+
+	scopeOnlyRoles := []types.ScopeRoleParties{
+		{
+			Role: types.PartyType_PARTY_TYPE_SERVICER,
+			Parties: []types.ScopePartyDetails{
+				{Address: user3, Optional: true, AccountExists: true, Sources: []string{scopeID.String()}},
+			},
+		},
+		{
+			Role: types.PartyType_PARTY_TYPE_OWNER,
+			Parties: []types.ScopePartyDetails{
+				{Address: s.user1, Optional: false, AccountExists: true, Sources: []string{scopeID.String()}},
+				{Address: s.user2, Optional: true, AccountExists: false, Sources: []string{scopeID.String()}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		req    *types.ScopePartiesRequest
+		exp    *types.ScopePartiesResponse
+		expErr string
+	}{
+		{
+			name: "scope only",
+			req:  &types.ScopePartiesRequest{ScopeId: scopeID.String()},
+			exp:  &types.ScopePartiesResponse{ScopeId: scopeID.String(), Roles: scopeOnlyRoles},
+		},
+		{
+			name: "scope only by uuid with request",
+			req:  &types.ScopePartiesRequest{ScopeId: scopeUUID.String(), IncludeRequest: true},
+			exp: &types.ScopePartiesResponse{
+				ScopeId: scopeID.String(),
+				Roles:   scopeOnlyRoles,
+				Request: &types.ScopePartiesRequest{ScopeId: scopeUUID.String(), IncludeRequest: true},
+			},
+		},
+		{
+			name: "with sessions",
+			req:  &types.ScopePartiesRequest{ScopeId: scopeID.String(), IncludeSessions: true},
+			exp: &types.ScopePartiesResponse{
+				ScopeId: scopeID.String(),
+				Roles: []types.ScopeRoleParties{
+					{
+						Role: types.PartyType_PARTY_TYPE_ORIGINATOR,
+						Parties: []types.ScopePartyDetails{
+							{Address: s.user2, Optional: false, AccountExists: false, Sources: []string{session2ID.String()}},
+						},
+					},
+					{
+						Role: types.PartyType_PARTY_TYPE_SERVICER,
+						Parties: []types.ScopePartyDetails{
+							{
+								Address: user3, Optional: true, AccountExists: true,
+								Sources: []string{scopeID.String(), session1ID.String()},
+							},
+						},
+					},
+					{
+						Role: types.PartyType_PARTY_TYPE_OWNER,
+						Parties: []types.ScopePartyDetails{
+							{
+								Address: s.user1, Optional: false, AccountExists: true,
+								Sources: []string{scopeID.String(), session2ID.String()},
+							},
+							{
+								Address: s.user2, Optional: false, AccountExists: false,
+								Sources: []string{scopeID.String(), session1ID.String()},
+							},
+						},
+					},
+					{
+						Role: types.PartyType_PARTY_TYPE_AFFILIATE,
+						Parties: []types.ScopePartyDetails{
+							{Address: user3, Optional: false, AccountExists: true, Sources: []string{session1ID.String()}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "empty scope id",
+			req:    &types.ScopePartiesRequest{},
+			expErr: "scope id cannot be empty: invalid request",
+		},
+		{
+			name:   "not a scope id",
+			req:    &types.ScopePartiesRequest{ScopeId: s.sessionID.String()},
+			expErr: "address [" + s.sessionID.String() + "] is not a scope address: invalid request",
+		},
+		{
+			name:   "unknown scope",
+			req:    &types.ScopePartiesRequest{ScopeId: unknownID.String()},
+			expErr: "scope not found with id " + unknownID.String() + ": invalid request",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := queryClient.ScopeParties(gocontext.Background(), tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "ScopeParties error")
+				return
+			}
+			s.Require().NoError(err, "ScopeParties error")
+			s.Assert().Equal(tc.exp, resp, "ScopeParties response")
+		})
+	}
+}
+
+func (s *QueryServerTestSuite) TestHasScopeAccess() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	user3Addr := sdk.AccAddress("user3_______________")
+	user3 := user3Addr.String()
+	user4 := sdk.AccAddress("user4_______________").String()
+
+	scopeUUID := uuid.NewSHA1(uuid.NameSpaceOID, []byte("scope access"))
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	scope := types.NewScope(scopeID, s.scopeSpecID, []types.Party{
+		{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER},
+		{Address: user3, Role: types.PartyType_PARTY_TYPE_SERVICER, Optional: true},
+	}, []string{s.user2}, s.user1, false)
+	s.Require().NoError(app.MetadataKeeper.SetScope(ctx, *scope), "SetScope")
+	err := app.BankKeeper.SendCoins(ctx, s.user1Addr, user3Addr, scopeID.Coins())
+	s.Require().NoError(err, "SendCoins(%s) to user3", scopeID.Coins())
+
+	unknownID := types.ScopeMetadataAddress(uuid.NewSHA1(uuid.NameSpaceOID, []byte("unknown")))
+	validKinds := "SCOPE_ACCESS_KIND_OWNER, SCOPE_ACCESS_KIND_DATA_ACCESS, SCOPE_ACCESS_KIND_VALUE_OWNER"
+
+	newReq := func(addr string, kind types.ScopeAccessKind) *types.HasScopeAccessRequest {
+		return &types.HasScopeAccessRequest{ScopeId: scopeID.String(), Address: addr, AccessKind: kind}
+	}
+
+	tests := []struct {
+		name    string
+		req     *types.HasScopeAccessRequest
+		exp     *types.HasScopeAccessResponse
+		expErr  string
+		expCode codes.Code
+	}{
+		{
+			name: "owner: owner role",
+			req:  newReq(s.user1, types.ScopeAccessKind_Owner),
+			exp:  &types.HasScopeAccessResponse{HasAccess: true, MatchedRole: "OWNER"},
+		},
+		{
+			name: "owner: servicer role",
+			req:  newReq(user3, types.ScopeAccessKind_Owner),
+			exp:  &types.HasScopeAccessResponse{HasAccess: true, MatchedRole: "SERVICER"},
+		},
+		{
+			name: "owner: data access only",
+			req:  newReq(s.user2, types.ScopeAccessKind_Owner),
+			exp:  &types.HasScopeAccessResponse{},
+		},
+		{
+			name: "data access: in list",
+			req:  newReq(s.user2, types.ScopeAccessKind_DataAccess),
+			exp:  &types.HasScopeAccessResponse{HasAccess: true, MatchedRole: "DATA_ACCESS"},
+		},
+		{
+			name: "data access: owner not in list",
+			req:  newReq(s.user1, types.ScopeAccessKind_DataAccess),
+			exp:  &types.HasScopeAccessResponse{},
+		},
+		{
+			name: "value owner: coin holder",
+			req:  newReq(user3, types.ScopeAccessKind_ValueOwner),
+			exp:  &types.HasScopeAccessResponse{HasAccess: true, MatchedRole: "VALUE_OWNER"},
+		},
+		{
+			name: "value owner: previous coin holder",
+			req:  newReq(s.user1, types.ScopeAccessKind_ValueOwner),
+			exp:  &types.HasScopeAccessResponse{},
+		},
+		{
+			name: "unrelated address",
+			req:  newReq(user4, types.ScopeAccessKind_Owner),
+			exp:  &types.HasScopeAccessResponse{},
+		},
+		{
+			name: "by uuid with request",
+			req: &types.HasScopeAccessRequest{
+				ScopeId: scopeUUID.String(), Address: s.user2, AccessKind: types.ScopeAccessKind_DataAccess, IncludeRequest: true,
+			},
+			exp: &types.HasScopeAccessResponse{
+				HasAccess:   true,
+				MatchedRole: "DATA_ACCESS",
+				Request: &types.HasScopeAccessRequest{
+					ScopeId: scopeUUID.String(), Address: s.user2, AccessKind: types.ScopeAccessKind_DataAccess, IncludeRequest: true,
+				},
+			},
+		},
+		{
+			name:    "unspecified access kind",
+			req:     newReq(s.user1, types.ScopeAccessKind_Unspecified),
+			expErr:  "invalid access kind SCOPE_ACCESS_KIND_UNSPECIFIED: must be one of " + validKinds,
+			expCode: codes.InvalidArgument,
+		},
+		{
+			name:    "unknown access kind",
+			req:     newReq(s.user1, 8),
+			expErr:  "invalid access kind 8: must be one of " + validKinds,
+			expCode: codes.InvalidArgument,
+		},
+		{
+			name:   "empty scope id",
+			req:    &types.HasScopeAccessRequest{Address: s.user1, AccessKind: types.ScopeAccessKind_Owner},
+			expErr: "scope id cannot be empty: invalid request",
+		},
+		{
+			name: "not a scope id",
+			req: &types.HasScopeAccessRequest{
+				ScopeId: s.sessionID.String(), Address: s.user1, AccessKind: types.ScopeAccessKind_Owner,
+			},
+			expErr: "address [" + s.sessionID.String() + "] is not a scope address: invalid request",
+		},
+		{
+			name:   "empty address",
+			req:    newReq("", types.ScopeAccessKind_Owner),
+			expErr: "address cannot be empty: invalid request",
+		},
+		{
+			name:   "invalid address",
+			req:    newReq("notanaddress", types.ScopeAccessKind_Owner),
+			expErr: "invalid address \"notanaddress\"",
+		},
+		{
+			name: "unknown scope",
+			req: &types.HasScopeAccessRequest{
+				ScopeId: unknownID.String(), Address: s.user1, AccessKind: types.ScopeAccessKind_Owner,
+			},
+			expErr: "scope not found with id " + unknownID.String() + ": invalid request",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := queryClient.HasScopeAccess(gocontext.Background(), tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "HasScopeAccess error")
+				if tc.expCode != codes.OK {
+					s.Assert().Equal(tc.expCode, status.Code(err), "HasScopeAccess error code")
+				}
+				return
+			}
+			s.Require().NoError(err, "HasScopeAccess error")
+			s.Assert().Equal(tc.exp, resp, "HasScopeAccess response")
+		})
+	}
+}
+
+func (s *QueryServerTestSuite) TestAddressDetails() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	scopeUUID := uuid.MustParse("91978ba2-5f35-459a-86a7-feca1b0512e0")
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	scope := types.NewScope(scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1, false)
+	s.Require().NoError(app.MetadataKeeper.SetScope(ctx, *scope), "SetScope")
+
+	sessionUUID := uuid.MustParse("5803f8bc-6067-4eb5-951f-2121671c2ec0")
+	sessionID := types.SessionMetadataAddress(scopeUUID, sessionUUID)
+	recordID := types.RecordMetadataAddress(scopeUUID, "recordname")
+	recordHash := recordID[17:33]
+
+	excessID := append(types.MetadataAddress{}, scopeID...)
+	excessID = append(excessID, []byte("extra")...)
+	excessBech32, err := bech32.ConvertAndEncode(types.PrefixScope, excessID)
+	s.Require().NoError(err, "ConvertAndEncode excess address")
+
+	scopeDetails := &types.AddressDetails{
+		Address:     scopeID.String(),
+		AddressHex:  hex.EncodeToString(scopeID),
+		Prefix:      types.PrefixScope,
+		PrimaryUuid: scopeUUID.String(),
+	}
+
+	tests := []struct {
+		name     string
+		req      *types.AddressDetailsRequest
+		exp      *types.AddressDetailsResponse
+		expInErr string
+	}{
+		{
+			name: "existing scope by bech32",
+			req:  &types.AddressDetailsRequest{Address: scopeID.String()},
+			exp:  &types.AddressDetailsResponse{Valid: true, Exists: true, Details: scopeDetails},
+		},
+		{
+			name: "existing scope by denom",
+			req:  &types.AddressDetailsRequest{Address: scopeID.Denom()},
+			exp:  &types.AddressDetailsResponse{Valid: true, Exists: true, Details: scopeDetails},
+		},
+		{
+			name: "unknown session by hex",
+			req:  &types.AddressDetailsRequest{Address: hex.EncodeToString(sessionID)},
+			exp: &types.AddressDetailsResponse{
+				Valid: true,
+				Details: &types.AddressDetails{
+					Address:       sessionID.String(),
+					AddressHex:    hex.EncodeToString(sessionID),
+					Prefix:        types.PrefixSession,
+					PrimaryUuid:   scopeUUID.String(),
+					SecondaryUuid: sessionUUID.String(),
+					ParentAddress: scopeID.String(),
+				},
+			},
+		},
+		{
+			name: "unknown record",
+			req:  &types.AddressDetailsRequest{Address: recordID.String()},
+			exp: &types.AddressDetailsResponse{
+				Valid: true,
+				Details: &types.AddressDetails{
+					Address:        recordID.String(),
+					AddressHex:     hex.EncodeToString(recordID),
+					Prefix:         types.PrefixRecord,
+					PrimaryUuid:    scopeUUID.String(),
+					NameHashHex:    hex.EncodeToString(recordHash),
+					NameHashBase64: base64.StdEncoding.EncodeToString(recordHash),
+					ParentAddress:  scopeID.String(),
+				},
+			},
+		},
+		{
+			name: "scope with excess bytes",
+			req:  &types.AddressDetailsRequest{Address: excessBech32},
+			exp: &types.AddressDetailsResponse{
+				Details: &types.AddressDetails{
+					AddressHex:    hex.EncodeToString(excessID),
+					Prefix:        types.PrefixScope,
+					PrimaryUuid:   scopeUUID.String(),
+					ExcessHex:     hex.EncodeToString([]byte("extra")),
+					ExcessBase64:  base64.StdEncoding.EncodeToString([]byte("extra")),
+					ParentAddress: scopeID.String(),
+				},
+			},
+			expInErr: "as bech32: incorrect address length (expected: 17, actual: 22)",
+		},
+		{
+			name: "unknown type byte",
+			req:  &types.AddressDetailsRequest{Address: "ff0102"},
+			exp: &types.AddressDetailsResponse{
+				Details: &types.AddressDetails{AddressHex: "ff0102", Prefix: "ff"},
+			},
+			expInErr: "invalid metadata address type: 255",
+		},
+		{
+			name:     "not an address",
+			req:      &types.AddressDetailsRequest{Address: "not an address"},
+			exp:      &types.AddressDetailsResponse{},
+			expInErr: "could not parse \"not an address\" as a metadata address",
+		},
+		{
+			name:     "empty address",
+			req:      &types.AddressDetailsRequest{},
+			exp:      &types.AddressDetailsResponse{},
+			expInErr: "empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := queryClient.AddressDetails(gocontext.Background(), tc.req)
+			s.Require().NoError(err, "AddressDetails error")
+			if len(tc.expInErr) > 0 {
+				s.Assert().Contains(resp.Error, tc.expInErr, "AddressDetails response error")
+				resp.Error = ""
+			}
+			s.Assert().Equal(tc.exp, resp, "AddressDetails response")
+		})
+	}
+}
+
 // TODO: OSLocatorParams tests
 // TODO: OSLocator tests
 // TODO: OSLocatorsByURI tests
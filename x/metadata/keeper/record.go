@@ -69,6 +69,8 @@ func (k Keeper) SetRecord(ctx sdk.Context, record types.Record) {
 	}
 
 	store.Set(recordID, b)
+	k.registerRecordName(ctx, record.Name)
+	k.recordModification(ctx, recordID)
 	k.EmitEvent(ctx, event)
 }
 
@@ -83,6 +85,7 @@ func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(id)
+	k.recordModification(ctx, id)
 	k.EmitEvent(ctx, types.NewEventRecordDeleted(id))
 
 	// Remove the session too if there are no more records in it.
@@ -104,7 +107,7 @@ func (k Keeper) IterateRecords(ctx sdk.Context, scopeID types.MetadataAddress, h
 		var record types.Record
 		err = k.cdc.Unmarshal(it.Value(), &record)
 		if err != nil {
-			k.Logger(ctx).Error("could not unmarshal record", "address", it.Key(), "error", err)
+			k.Logger(ctx).Error("could not unmarshal record", append(types.MDFields(it.Key()), "error", err)...)
 		} else if handler(record) {
 			break
 		}
@@ -349,3 +352,66 @@ func (k Keeper) ValidateDeleteRecord(ctx sdk.Context, proposedID types.MetadataA
 
 	return nil
 }
+
+// GetRecordLineage gets the provided record, and resolves the records its inputs reference, up to the provided depth.
+// A depth of zero only gets the requested record (without resolving any of its inputs).
+// Records that are already one of their own ancestors are flagged as a cycle and not resolved any further.
+// At most types.MaxRecordLineageRecords records are loaded.
+func (k Keeper) GetRecordLineage(ctx sdk.Context, recordID types.MetadataAddress, depth uint32) *types.RecordLineageNode {
+	b := &recordLineageBuilder{
+		ctx:         ctx,
+		k:           k,
+		ancestors:   make(map[string]bool),
+		recordsLeft: types.MaxRecordLineageRecords,
+	}
+	node := b.build(recordID, "", depth)
+	return &node
+}
+
+// recordLineageBuilder holds the state needed while building a record lineage tree.
+type recordLineageBuilder struct {
+	ctx sdk.Context
+	k   Keeper
+	// ancestors has the records (as strings of their bytes) in the path from the root to the current node.
+	ancestors map[string]bool
+	// recordsLeft is the number of records that can still be loaded.
+	recordsLeft int
+}
+
+// build creates the lineage node for a record, resolving its record inputs (recursively) if depthLeft is positive.
+func (b *recordLineageBuilder) build(recordID types.MetadataAddress, inputName string, depthLeft uint32) types.RecordLineageNode {
+	node := types.RecordLineageNode{RecordId: recordID.String(), InputName: inputName}
+	record, found := b.k.GetRecord(b.ctx, recordID)
+	b.recordsLeft--
+	if !found {
+		node.NotFound = true
+		return node
+	}
+	node.SessionId = record.SessionId.String()
+
+	key := string(recordID)
+	if b.ancestors[key] {
+		node.Cycle = true
+		return node
+	}
+	b.ancestors[key] = true
+	defer delete(b.ancestors, key)
+
+	for _, input := range record.Inputs {
+		switch source := input.Source.(type) {
+		case *types.RecordInput_RecordId:
+			if depthLeft == 0 || b.recordsLeft <= 0 {
+				node.Truncated = true
+				continue
+			}
+			node.Inputs = append(node.Inputs, b.build(source.RecordId, input.Name, depthLeft-1))
+		case *types.RecordInput_Hash:
+			node.HashInputs = append(node.HashInputs, types.RecordLineageHashInput{
+				Name:     input.Name,
+				Hash:     source.Hash,
+				TypeName: input.TypeName,
+			})
+		}
+	}
+	return node
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// registerRecordName adds the provided name to the record name registry (if the registry is enabled).
+func (k Keeper) registerRecordName(ctx sdk.Context, name string) {
+	if len(name) == 0 || !k.IsRecordNameRegistryEnabled(ctx) {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRecordNameKey(types.RecordNameHash(name)), []byte(name))
+}
+
+// GetRecordNameByHash looks up a name in the record name registry using the 16-byte name hash.
+func (k Keeper) GetRecordNameByHash(ctx sdk.Context, nameHash []byte) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRecordNameKey(nameHash))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
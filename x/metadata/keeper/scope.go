@@ -3,6 +3,8 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/provenance-io/provenance/internal/provutils"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
@@ -51,8 +54,14 @@ func (k Keeper) IterateScopesForAddress(ctx sdk.Context, address sdk.AccAddress,
 func (k Keeper) IterateScopesForScopeSpec(ctx sdk.Context, scopeSpecID types.MetadataAddress,
 	handler func(scopeID types.MetadataAddress) (stop bool),
 ) error {
+	if scopeSpecID.Empty() {
+		return errors.New("scope spec id cannot be empty")
+	}
+	prefix, err := scopeSpecID.ScopeSpecScopeIteratorPrefix()
+	if err != nil {
+		return err
+	}
 	store := ctx.KVStore(k.storeKey)
-	prefix := types.GetScopeSpecScopeCacheIteratorPrefix(scopeSpecID)
 	it := storetypes.KVStorePrefixIterator(store, prefix)
 	defer it.Close()
 	for ; it.Valid(); it.Next() {
@@ -152,8 +161,8 @@ func (k Keeper) writeScopeToState(ctx sdk.Context, scope types.Scope) {
 		if oldScopeBytes := store.Get(scope.ScopeId); len(oldScopeBytes) > 0 {
 			os, err := k.readScopeBz(oldScopeBytes)
 			if err != nil {
-				k.Logger(ctx).Error("could not unmarshal old scope", "err", err,
-					"scopeId", scope.ScopeId.String(), "oldScopeBytes", oldScopeBytes)
+				k.Logger(ctx).Error("could not unmarshal old scope",
+					append(types.MDFields(scope.ScopeId), "err", err, "oldScopeBytes", oldScopeBytes)...)
 			} else {
 				oldScope = &os
 			}
@@ -162,6 +171,7 @@ func (k Keeper) writeScopeToState(ctx sdk.Context, scope types.Scope) {
 
 	store.Set(scope.ScopeId, b)
 	k.indexScope(store, &scope, oldScope)
+	k.recordModification(ctx, scope.ScopeId)
 	k.EmitEvent(ctx, event)
 }
 
@@ -177,8 +187,8 @@ func (k Keeper) RemoveScope(ctx sdk.Context, id types.MetadataAddress) error {
 		return nil
 	}
 
-	// Burn the scope's value owner coin.
-	if err := k.SetScopeValueOwner(ctx, id, ""); err != nil {
+	// Burn the scope's value owner coin. The records are all being removed below, so we force it.
+	if err := k.setScopeValueOwner(ctx, id, "", true); err != nil {
 		return fmt.Errorf("could not remove scope %s value owner: %w", id, err)
 	}
 
@@ -193,6 +203,7 @@ func (k Keeper) RemoveScope(ctx sdk.Context, id types.MetadataAddress) error {
 
 	k.indexScope(store, nil, &scope)
 	store.Delete(id)
+	k.recordModification(ctx, id)
 	k.EmitEvent(ctx, types.NewEventScopeDeleted(scope.ScopeId))
 	return nil
 }
@@ -223,8 +234,93 @@ func (k Keeper) GetScopeValueOwners(ctx sdk.Context, ids []types.MetadataAddress
 
 // SetScopeValueOwner updates the value owner of a scope.
 // If there's no current value owner, the coin will be minted for the scope.
-// If there's no new value owner, the coin will be burned for the scope.
+// If there's no new value owner, the coin will be burned for the scope, but only if the scope does not have any sessions or records.
 func (k Keeper) SetScopeValueOwner(ctx sdk.Context, scopeID types.MetadataAddress, newValueOwner string) error {
+	return k.setScopeValueOwner(ctx, scopeID, newValueOwner, false)
+}
+
+// ForceScopeCoinBurn burns the coin of a scope even if the scope still has sessions or records.
+// This should only be used as a result of a governance proposal.
+func (k Keeper) ForceScopeCoinBurn(ctx sdk.Context, scopeID types.MetadataAddress) error {
+	if err := scopeID.ValidateIsScopeAddress(); err != nil {
+		return err
+	}
+	owner, err := k.bankKeeper.DenomOwner(ctx, scopeID.Denom())
+	if err != nil {
+		return fmt.Errorf("could not get current value owner of %q: %w", scopeID, err)
+	}
+	if len(owner) == 0 {
+		return fmt.Errorf("scope coin %q does not exist", scopeID.Denom())
+	}
+	return k.setScopeValueOwner(ctx, scopeID, "", true)
+}
+
+// ValidateScopeCoinBurn returns an error if the scope has any sessions or records, which would
+// be left without a value owner if the scope's coin were burned.
+func (k Keeper) ValidateScopeCoinBurn(ctx sdk.Context, scopeID types.MetadataAddress) error {
+	sessPrefix, err := scopeID.ScopeSessionIteratorPrefix()
+	if err != nil {
+		return err
+	}
+	recPrefix, err := scopeID.ScopeRecordIteratorPrefix()
+	if err != nil {
+		return err
+	}
+
+	// Only check for a first entry of each (instead of counting them) so that this is cheap no matter how many there are.
+	store := ctx.KVStore(k.storeKey)
+	hasSessions, hasRecords := hasAnyWithPrefix(store, sessPrefix), hasAnyWithPrefix(store, recPrefix)
+	if !hasSessions && !hasRecords {
+		return nil
+	}
+
+	// It's being rejected, so count them for the error, but only up to a point so that this stays cheap.
+	var has []string
+	if hasSessions {
+		has = append(has, describeCount(countKeysWithPrefixUpTo(store, sessPrefix, burnBlockerCountLimit), "session", "sessions"))
+	}
+	if hasRecords {
+		has = append(has, describeCount(countKeysWithPrefixUpTo(store, recPrefix, burnBlockerCountLimit), "record", "records"))
+	}
+	return types.ErrScopeHasRecords.Wrapf("cannot burn scope coin for %s: scope has %s", scopeID, strings.Join(has, ", "))
+}
+
+// burnBlockerCountLimit is the most sessions or records that are counted when a scope coin burn is rejected.
+const burnBlockerCountLimit = 10
+
+// hasAnyWithPrefix returns true if there is at least one entry in the store with the provided prefix.
+func hasAnyWithPrefix(store storetypes.KVStore, prefix []byte) bool {
+	iter := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	return iter.Valid()
+}
+
+// countKeysWithPrefixUpTo counts the entries in the store with the provided prefix, stopping once it gets to limit.
+func countKeysWithPrefixUpTo(store storetypes.KVStore, prefix []byte, limit uint64) uint64 {
+	iter := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	var rv uint64
+	for ; iter.Valid() && rv < limit; iter.Next() {
+		rv++
+	}
+	return rv
+}
+
+// describeCount returns a string like "1 session", "3 sessions", or "10+ sessions" (if the count is at the limit).
+func describeCount(count uint64, singular, plural string) string {
+	switch {
+	case count >= burnBlockerCountLimit:
+		return fmt.Sprintf("%d+ %s", burnBlockerCountLimit, plural)
+	case count == 1:
+		return "1 " + singular
+	default:
+		return fmt.Sprintf("%d %s", count, plural)
+	}
+}
+
+// setScopeValueOwner updates the value owner of a scope.
+// If force is false, the coin is not burned while the scope still has sessions or records.
+func (k Keeper) setScopeValueOwner(ctx sdk.Context, scopeID types.MetadataAddress, newValueOwner string, force bool) error {
 	if err := scopeID.ValidateIsScopeAddress(); err != nil {
 		return err
 	}
@@ -257,7 +353,13 @@ func (k Keeper) SetScopeValueOwner(ctx sdk.Context, scopeID types.MetadataAddres
 		return nil
 	}
 
-	coins := sdk.Coins{coin}
+	if doBurn && !force {
+		if err = k.ValidateScopeCoinBurn(ctx, scopeID); err != nil {
+			return err
+		}
+	}
+
+	coins := scopeID.Coins()
 	if len(fromAddr) == 0 {
 		// If there's no current value owner, we'll mint it and send it from the module account.
 		fromAddr = k.moduleAddr
@@ -297,23 +399,27 @@ func (k Keeper) SetScopeValueOwners(ctx sdk.Context, links types.AccMDLinks, new
 		return sdkerrors.ErrUnauthorized.Wrapf("new value owner %s is not allowed to receive funds", newValueOwner)
 	}
 
-	// Identify the addresses and the amounts to send to each.
+	// Identify the addresses and the scopes to send from each.
 	var fromAddrs []sdk.AccAddress
-	fromAddrAmts := make(map[string]sdk.Coins)
+	fromAddrScopes := make(map[string][]types.MetadataAddress)
 	for _, link := range links {
-		coin := link.MDAddr.Coin()
-		cur, seen := fromAddrAmts[string(link.AccAddr)]
+		cur, seen := fromAddrScopes[string(link.AccAddr)]
 		if !seen {
 			fromAddrs = append(fromAddrs, link.AccAddr)
 		}
-		fromAddrAmts[string(link.AccAddr)] = cur.Add(coin)
+		fromAddrScopes[string(link.AccAddr)] = append(cur, link.MDAddr)
 	}
 
 	for _, fromAddr := range fromAddrs {
-		if !toAddr.Equals(fromAddr) {
-			if err = k.bankKeeper.SendCoins(ctx, fromAddr, toAddr, fromAddrAmts[string(fromAddr)]); err != nil {
-				return fmt.Errorf("could not send scope coins %q from %s to %s: %w", fromAddrAmts[string(fromAddr)], fromAddr, toAddr, err)
-			}
+		if toAddr.Equals(fromAddr) {
+			continue
+		}
+		coins, err := types.NewMetadataCoins(fromAddrScopes[string(fromAddr)]...)
+		if err != nil {
+			return fmt.Errorf("could not create scope coins to send from %s: %w", fromAddr, err)
+		}
+		if err = k.bankKeeper.SendCoins(ctx, fromAddr, toAddr, coins); err != nil {
+			return fmt.Errorf("could not send scope coins %q from %s to %s: %w", coins, fromAddr, toAddr, err)
 		}
 	}
 
@@ -585,6 +691,184 @@ func (k Keeper) ValidateDeleteScope(ctx sdk.Context, msg *types.MsgDeleteScopeRe
 	return transferAgents, nil
 }
 
+// GetScopeDeletionBlockers identifies everything that prevents (or complicates) the deletion of the provided scope.
+// Unlike ValidateDeleteScope, this does not stop at the first problem, and signers are not considered.
+func (k Keeper) GetScopeDeletionBlockers(ctx sdk.Context, scopeID types.MetadataAddress) (*types.ScopeDeletionBlockersResponse, error) {
+	if err := scopeID.ValidateIsScopeAddress(); err != nil {
+		return nil, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(scopeID) {
+		return nil, fmt.Errorf("scope not found with id %s", scopeID)
+	}
+
+	rv := &types.ScopeDeletionBlockersResponse{ScopeId: scopeID.String()}
+	// Neither of these can return an error because we know it's a valid scope id.
+	sessionPrefix, _ := scopeID.ScopeSessionIteratorPrefix()
+	recordPrefix, _ := scopeID.ScopeRecordIteratorPrefix()
+	rv.SessionCount = countKeysWithPrefix(store, sessionPrefix)
+	rv.RecordCount = countKeysWithPrefix(store, recordPrefix)
+	if rv.SessionCount > 0 {
+		rv.Blockers = append(rv.Blockers, fmt.Sprintf("scope has %d session(s)", rv.SessionCount))
+	}
+	if rv.RecordCount > 0 {
+		rv.Blockers = append(rv.Blockers, fmt.Sprintf("scope has %d record(s)", rv.RecordCount))
+	}
+
+	valueOwner, err := k.GetScopeValueOwner(ctx, scopeID)
+	if err != nil {
+		return nil, fmt.Errorf("error identifying current value owner of %q: %w", scopeID, err)
+	}
+	if len(valueOwner) == 0 {
+		return rv, nil
+	}
+
+	acct := k.authKeeper.GetAccount(ctx, valueOwner)
+	switch {
+	case k.markerKeeper.IsMarkerAccount(ctx, valueOwner):
+		if marker, ok := acct.(markertypes.MarkerAccountI); ok {
+			rv.EscrowMarkerDenom = marker.GetDenom()
+		}
+		rv.Blockers = append(rv.Blockers, fmt.Sprintf("scope coin is held in escrow by marker %s (%s)", rv.EscrowMarkerDenom, valueOwner))
+	case k.bankKeeper.BlockedAddr(valueOwner):
+		rv.RestrictedValueOwner = valueOwner.String()
+		rv.Blockers = append(rv.Blockers, fmt.Sprintf("scope coin is held by blocked address %s", valueOwner))
+	default:
+		if _, isModuleAcct := acct.(sdk.ModuleAccountI); isModuleAcct {
+			rv.RestrictedValueOwner = valueOwner.String()
+			rv.Blockers = append(rv.Blockers, fmt.Sprintf("scope coin is held by module account %s", valueOwner))
+		}
+	}
+
+	return rv, nil
+}
+
+// GetScopeParties gets the parties of the provided scope, grouped by role.
+// If includeSessions is true, the parties of each of the scope's sessions are also included.
+// A party is only optional if it is optional everywhere it was found with a given role.
+func (k Keeper) GetScopeParties(ctx sdk.Context, scopeID types.MetadataAddress, includeSessions bool) (*types.ScopePartiesResponse, error) {
+	if err := scopeID.ValidateIsScopeAddress(); err != nil {
+		return nil, err
+	}
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return nil, fmt.Errorf("scope not found with id %s", scopeID)
+	}
+
+	byRole := make(map[types.PartyType]*types.ScopeRoleParties)
+	addParties := func(source string, parties []types.Party) {
+		for _, party := range parties {
+			roleParties, ok := byRole[party.Role]
+			if !ok {
+				roleParties = &types.ScopeRoleParties{Role: party.Role}
+				byRole[party.Role] = roleParties
+			}
+
+			var details *types.ScopePartyDetails
+			for i := range roleParties.Parties {
+				if roleParties.Parties[i].Address == party.Address {
+					details = &roleParties.Parties[i]
+					break
+				}
+			}
+			if details == nil {
+				roleParties.Parties = append(roleParties.Parties, types.ScopePartyDetails{
+					Address:       party.Address,
+					Optional:      true,
+					AccountExists: k.accountExists(ctx, party.Address),
+				})
+				details = &roleParties.Parties[len(roleParties.Parties)-1]
+			}
+
+			details.Optional = details.Optional && party.Optional
+			if len(details.Sources) == 0 || details.Sources[len(details.Sources)-1] != source {
+				details.Sources = append(details.Sources, source)
+			}
+		}
+	}
+
+	addParties(scopeID.String(), scope.Owners)
+	if includeSessions {
+		err := k.IterateSessions(ctx, scopeID, func(session types.Session) (stop bool) {
+			addParties(session.SessionId.String(), session.Parties)
+			return false
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error iterating sessions of %s: %w", scopeID, err)
+		}
+	}
+
+	rv := &types.ScopePartiesResponse{ScopeId: scopeID.String()}
+	for _, roleParties := range byRole {
+		rv.Roles = append(rv.Roles, *roleParties)
+	}
+	sort.Slice(rv.Roles, func(i, j int) bool {
+		return rv.Roles[i].Role < rv.Roles[j].Role
+	})
+
+	return rv, nil
+}
+
+// CheckScopeAccess checks whether an address has the provided kind of access to a scope.
+// If it does, the matched role is also returned (see HasScopeAccessResponse.MatchedRole).
+func (k Keeper) CheckScopeAccess(ctx sdk.Context, scopeID types.MetadataAddress, addr sdk.AccAddress, kind types.ScopeAccessKind) (bool, string, error) {
+	if err := kind.Validate(); err != nil {
+		return false, "", err
+	}
+	if err := scopeID.ValidateIsScopeAddress(); err != nil {
+		return false, "", err
+	}
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return false, "", fmt.Errorf("scope not found with id %s", scopeID)
+	}
+
+	addrStr := addr.String()
+	switch kind {
+	case types.ScopeAccessKind_Owner:
+		for _, owner := range scope.Owners {
+			if owner.Address == addrStr {
+				return true, owner.Role.SimpleString(), nil
+			}
+		}
+	case types.ScopeAccessKind_DataAccess:
+		for _, da := range scope.DataAccess {
+			if da == addrStr {
+				return true, kind.SimpleString(), nil
+			}
+		}
+	case types.ScopeAccessKind_ValueOwner:
+		valueOwner, err := k.GetScopeValueOwner(ctx, scopeID)
+		if err != nil {
+			return false, "", err
+		}
+		if addr.Equals(valueOwner) {
+			return true, kind.SimpleString(), nil
+		}
+	}
+	return false, "", nil
+}
+
+// accountExists returns true if the provided bech32 address is valid and has an account.
+func (k Keeper) accountExists(ctx sdk.Context, bech32 string) bool {
+	addr, err := sdk.AccAddressFromBech32(bech32)
+	if err != nil {
+		return false
+	}
+	return k.authKeeper.GetAccount(ctx, addr) != nil
+}
+
+// countKeysWithPrefix counts the number of entries in the store with the provided key prefix.
+func countKeysWithPrefix(store storetypes.KVStore, prefix []byte) uint64 {
+	it := storetypes.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+	var rv uint64
+	for ; it.Valid(); it.Next() {
+		rv++
+	}
+	return rv
+}
+
 // ValidateSetScopeAccountData makes sure that the msg signers have proper authority to
 // set the account data of the provided metadata address.
 // Assumes that msg.MetadataAddr is a scope id.
@@ -25,6 +25,7 @@ import (
 	"github.com/provenance-io/provenance/testutil/testlog"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/testutil"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
@@ -383,7 +384,7 @@ func (s *ScopeKeeperTestSuite) TestGetScopeWithValueOwner() {
 
 	tests := []struct {
 		name     string
-		bk       *MockBankKeeper
+		bk       *testutil.MockBankKeeper
 		id       types.MetadataAddress
 		expScope types.Scope
 		expFound bool
@@ -396,7 +397,7 @@ func (s *ScopeKeeperTestSuite) TestGetScopeWithValueOwner() {
 		},
 		{
 			name: "no such scope but has a value owner on record",
-			bk:   NewMockBankKeeper().WithDenomOwnerResult(noScopeID, s.user3Addr),
+			bk:   testutil.NewMockBankKeeper().WithDenomOwnerResult(noScopeID, s.user3Addr),
 			id:   noScopeID,
 			// This is testing that the ValueOwnerAddress field is not populated in this case.
 			expScope: types.Scope{},
@@ -410,7 +411,7 @@ func (s *ScopeKeeperTestSuite) TestGetScopeWithValueOwner() {
 		},
 		{
 			name: "scope with value owner",
-			bk:   NewMockBankKeeper().WithDenomOwnerResult(okScopeID, s.user3Addr),
+			bk:   testutil.NewMockBankKeeper().WithDenomOwnerResult(okScopeID, s.user3Addr),
 			id:   okScopeID,
 			expScope: types.Scope{
 				ScopeId:            okScope.ScopeId,
@@ -427,7 +428,7 @@ func (s *ScopeKeeperTestSuite) TestGetScopeWithValueOwner() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			if tc.bk == nil {
-				tc.bk = NewMockBankKeeper()
+				tc.bk = testutil.NewMockBankKeeper()
 			}
 			defer s.SwapBankKeeper(tc.bk)()
 
@@ -447,13 +448,13 @@ func (s *ScopeKeeperTestSuite) TestGetScopeWithValueOwner() {
 func (s *ScopeKeeperTestSuite) TestPopulateScopeValueOwner() {
 	tests := []struct {
 		name  string
-		bk    *MockBankKeeper
+		bk    *testutil.MockBankKeeper
 		scope types.Scope
 		expVO string
 	}{
 		{
 			name:  "error getting value owner",
-			bk:    NewMockBankKeeper().WithDenomOwnerError(s.scopeID, "oops go boom"),
+			bk:    testutil.NewMockBankKeeper().WithDenomOwnerError(s.scopeID, "oops go boom"),
 			scope: types.Scope{ScopeId: s.scopeID, ValueOwnerAddress: "initialvo"},
 			expVO: "",
 		},
@@ -464,7 +465,7 @@ func (s *ScopeKeeperTestSuite) TestPopulateScopeValueOwner() {
 		},
 		{
 			name:  "has value owner",
-			bk:    NewMockBankKeeper().WithDenomOwnerResult(s.scopeID, s.user2Addr),
+			bk:    testutil.NewMockBankKeeper().WithDenomOwnerResult(s.scopeID, s.user2Addr),
 			scope: types.Scope{ScopeId: s.scopeID, ValueOwnerAddress: "initialvo"},
 			expVO: s.user2,
 		},
@@ -473,7 +474,7 @@ func (s *ScopeKeeperTestSuite) TestPopulateScopeValueOwner() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			if tc.bk == nil {
-				tc.bk = NewMockBankKeeper()
+				tc.bk = testutil.NewMockBankKeeper()
 			}
 			defer s.SwapBankKeeper(tc.bk)()
 
@@ -495,7 +496,7 @@ func (s *ScopeKeeperTestSuite) TestGetScopeValueOwner() {
 
 	tests := []struct {
 		name      string
-		bk        *MockBankKeeper
+		bk        *testutil.MockBankKeeper
 		id        types.MetadataAddress
 		expAddr   sdk.AccAddress
 		expErr    string
@@ -545,14 +546,14 @@ func (s *ScopeKeeperTestSuite) TestGetScopeValueOwner() {
 		},
 		{
 			name:      "scope id with lookup error",
-			bk:        NewMockBankKeeper().WithDenomOwnerError(s.scopeID, "this error was injected"),
+			bk:        testutil.NewMockBankKeeper().WithDenomOwnerError(s.scopeID, "this error was injected"),
 			id:        s.scopeID,
 			expErr:    "this error was injected",
 			expBKCall: true,
 		},
 		{
 			name:      "scope id with owner",
-			bk:        NewMockBankKeeper().WithDenomOwnerResult(s.scopeID, s.user1Addr),
+			bk:        testutil.NewMockBankKeeper().WithDenomOwnerResult(s.scopeID, s.user1Addr),
 			id:        s.scopeID,
 			expAddr:   s.user1Addr,
 			expBKCall: true,
@@ -562,11 +563,11 @@ func (s *ScopeKeeperTestSuite) TestGetScopeValueOwner() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			if tc.bk == nil {
-				tc.bk = NewMockBankKeeper()
+				tc.bk = testutil.NewMockBankKeeper()
 			}
 			defer s.SwapBankKeeper(tc.bk)()
 
-			var expBKCalls BankKeeperCalls
+			var expBKCalls testutil.BankKeeperCalls
 			if tc.expBKCall {
 				expBKCalls.DenomOwner = append(expBKCalls.DenomOwner, tc.id.Denom())
 			}
@@ -604,7 +605,7 @@ func (s *ScopeKeeperTestSuite) TestGetScopeValueOwners() {
 
 	tests := []struct {
 		name       string
-		bk         *MockBankKeeper
+		bk         *testutil.MockBankKeeper
 		ids        []types.MetadataAddress
 		expLinks   types.AccMDLinks
 		expErr     string
@@ -658,7 +659,7 @@ func (s *ScopeKeeperTestSuite) TestGetScopeValueOwners() {
 		},
 		{
 			name:       "one id: DenomOwner error",
-			bk:         NewMockBankKeeper().WithDenomOwnerError(scopeIDs[1], "something broke yo"),
+			bk:         testutil.NewMockBankKeeper().WithDenomOwnerError(scopeIDs[1], "something broke yo"),
 			ids:        []types.MetadataAddress{scopeIDs[1]},
 			expLinks:   types.AccMDLinks{},
 			expErr:     "something broke yo",
@@ -666,7 +667,7 @@ func (s *ScopeKeeperTestSuite) TestGetScopeValueOwners() {
 		},
 		{
 			name: "three ids: errors for all",
-			bk: NewMockBankKeeper().
+			bk: testutil.NewMockBankKeeper().
 				WithDenomOwnerError(scopeIDs[3], "its now on fire").
 				WithDenomOwnerError(scopeIDs[6], "small thing go big boom").
 				WithDenomOwnerError(scopeIDs[7], "something broke yo"),
@@ -677,7 +678,7 @@ func (s *ScopeKeeperTestSuite) TestGetScopeValueOwners() {
 		},
 		{
 			name: "three ids: same owner",
-			bk: NewMockBankKeeper().
+			bk: testutil.NewMockBankKeeper().
 				WithDenomOwnerResult(scopeIDs[4], s.user1Addr).
 				WithDenomOwnerResult(scopeIDs[5], s.user1Addr).
 				WithDenomOwnerResult(scopeIDs[6], s.user1Addr),
@@ -691,7 +692,7 @@ func (s *ScopeKeeperTestSuite) TestGetScopeValueOwners() {
 		},
 		{
 			name: "three ids: different owners",
-			bk: NewMockBankKeeper().
+			bk: testutil.NewMockBankKeeper().
 				WithDenomOwnerResult(scopeIDs[0], s.user1Addr).
 				WithDenomOwnerResult(scopeIDs[9], s.user2Addr).
 				WithDenomOwnerResult(scopeIDs[4], s.user3Addr),
@@ -705,7 +706,7 @@ func (s *ScopeKeeperTestSuite) TestGetScopeValueOwners() {
 		},
 		{
 			name: "four ids: one non-scope, error from one, one found, one not found",
-			bk: NewMockBankKeeper().
+			bk: testutil.NewMockBankKeeper().
 				WithDenomOwnerResult(scopeIDs[1], s.user3Addr).
 				WithDenomOwnerError(scopeIDs[2], "oopsie daisy: no worky"),
 			ids: []types.MetadataAddress{
@@ -727,11 +728,11 @@ func (s *ScopeKeeperTestSuite) TestGetScopeValueOwners() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			if tc.bk == nil {
-				tc.bk = NewMockBankKeeper()
+				tc.bk = testutil.NewMockBankKeeper()
 			}
 			defer s.SwapBankKeeper(tc.bk)()
 
-			expBKCalls := BankKeeperCalls{
+			expBKCalls := testutil.BankKeeperCalls{
 				DenomOwner: tc.expDOCalls,
 			}
 
@@ -763,7 +764,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwner() {
 
 	tests := []struct {
 		name          string
-		bk            *MockBankKeeper
+		bk            *testutil.MockBankKeeper
 		curOwner      sdk.AccAddress
 		scopeID       types.MetadataAddress
 		newValueOwner string
@@ -771,7 +772,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwner() {
 		expCallBA     sdk.AccAddress // BA = BlockedAddr
 		expCallDO     bool           // DO = Denom Owner
 		expCallMint   bool
-		expCallSend   *SendCoinsCall
+		expCallSend   *testutil.SendCoinsCall
 		expCallBurn   bool
 	}{
 		{
@@ -787,7 +788,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwner() {
 		{
 			name:    "invalid scope id",
 			scopeID: types.MetadataAddress{types.ScopeKeyPrefix[0], 0x1, 0x2},
-			expErr:  "invalid scope metadata address MetadataAddress{0x0, 0x1, 0x2}: incorrect address length (expected: 17, actual: 3)",
+			expErr:  "invalid scope metadata address invalid[prefix=0x00 len=3 hex=000102]: incorrect address length (expected: 17, actual: 3)",
 		},
 		{
 			name:    "session",
@@ -822,7 +823,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwner() {
 		},
 		{
 			name:          "blocked new value owner",
-			bk:            NewMockBankKeeper().WithBlockedAddr(addr1),
+			bk:            testutil.NewMockBankKeeper().WithBlockedAddr(addr1),
 			scopeID:       scopeID,
 			newValueOwner: addr1.String(),
 			expErr:        fmt.Sprintf("new value owner %q is not allowed to receive funds: unauthorized", addr1.String()),
@@ -830,7 +831,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwner() {
 		},
 		{
 			name:      "error getting current owner",
-			bk:        NewMockBankKeeper().WithDenomOwnerError(scopeID, "not now clark"),
+			bk:        testutil.NewMockBankKeeper().WithDenomOwnerError(scopeID, "not now clark"),
 			scopeID:   scopeID,
 			expErr:    fmt.Sprintf("could not get current value owner of %q: not now clark", scopeIDStr),
 			expCallDO: true,
@@ -844,7 +845,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwner() {
 		},
 		{
 			name:          "no current owner to new owner: error minting",
-			bk:            NewMockBankKeeper().WithMintCoinsErrors("not so fresh"),
+			bk:            testutil.NewMockBankKeeper().WithMintCoinsErrors("not so fresh"),
 			scopeID:       scopeID,
 			newValueOwner: addr1.String(),
 			expErr:        fmt.Sprintf("could not mint scope coin \"1nft/%s\": not so fresh", scopeIDStr),
@@ -854,7 +855,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwner() {
 		},
 		{
 			name:          "no current owner to new owner: error sending",
-			bk:            NewMockBankKeeper().WithSendCoinsError(moduleAddr, "it is mine now"),
+			bk:            testutil.NewMockBankKeeper().WithSendCoinsError(moduleAddr, "it is mine now"),
 			scopeID:       scopeID,
 			newValueOwner: addr1.String(),
 			expErr: fmt.Sprintf("could not send scope coin \"1nft/%s\" from %s to %s: it is mine now",
@@ -862,7 +863,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwner() {
 			expCallBA:   addr1,
 			expCallDO:   true,
 			expCallMint: true,
-			expCallSend: NewSendCoinsCall(moduleAddr, addr1, scopeID.Coins()),
+			expCallSend: testutil.NewSendCoinsCall(moduleAddr, addr1, scopeID.Coins()),
 		},
 		{
 			name:          "no current owner to new owner: okay",
@@ -871,7 +872,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwner() {
 			expCallBA:     addr1,
 			expCallDO:     true,
 			expCallMint:   true,
-			expCallSend:   NewSendCoinsCall(moduleAddr, addr1, scopeID.Coins()),
+			expCallSend:   testutil.NewSendCoinsCall(moduleAddr, addr1, scopeID.Coins()),
 		},
 		{
 			name:          "current owner to self",
@@ -884,7 +885,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwner() {
 		},
 		{
 			name:          "current owner to new owner: error sending",
-			bk:            NewMockBankKeeper().WithSendCoinsError(addr1, "gonna keep this one"),
+			bk:            testutil.NewMockBankKeeper().WithSendCoinsError(addr1, "gonna keep this one"),
 			curOwner:      addr1,
 			scopeID:       scopeID,
 			newValueOwner: addr2.String(),
@@ -892,7 +893,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwner() {
 				scopeIDStr, addr1.String(), addr2.String()),
 			expCallBA:   addr2,
 			expCallDO:   true,
-			expCallSend: NewSendCoinsCall(addr1, addr2, scopeID.Coins()),
+			expCallSend: testutil.NewSendCoinsCall(addr1, addr2, scopeID.Coins()),
 		},
 		{
 			name:          "current owner to new owner: okay",
@@ -901,28 +902,28 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwner() {
 			newValueOwner: addr2.String(),
 			expCallBA:     addr2,
 			expCallDO:     true,
-			expCallSend:   NewSendCoinsCall(addr1, addr2, scopeID.Coins()),
+			expCallSend:   testutil.NewSendCoinsCall(addr1, addr2, scopeID.Coins()),
 		},
 		{
 			name:          "current owner to empty new owner: error sending",
-			bk:            NewMockBankKeeper().WithSendCoinsError(addr1, "finders keepers"),
+			bk:            testutil.NewMockBankKeeper().WithSendCoinsError(addr1, "finders keepers"),
 			curOwner:      addr1,
 			scopeID:       scopeID,
 			newValueOwner: "",
 			expErr: fmt.Sprintf("could not send scope coin \"1nft/%s\" from %s to %s: finders keepers",
 				scopeIDStr, addr1.String(), moduleAddr.String()),
 			expCallDO:   true,
-			expCallSend: NewSendCoinsCall(addr1, moduleAddr, scopeID.Coins()),
+			expCallSend: testutil.NewSendCoinsCall(addr1, moduleAddr, scopeID.Coins()),
 		},
 		{
 			name:          "current owner to empty new owner: error burning",
-			bk:            NewMockBankKeeper().WithBurnCoinsErrors("too wet"),
+			bk:            testutil.NewMockBankKeeper().WithBurnCoinsErrors("too wet"),
 			curOwner:      addr1,
 			scopeID:       scopeID,
 			newValueOwner: "",
 			expErr:        fmt.Sprintf("could not burn scope coin \"1nft/%s\": too wet", scopeIDStr),
 			expCallDO:     true,
-			expCallSend:   NewSendCoinsCall(addr1, moduleAddr, scopeID.Coins()),
+			expCallSend:   testutil.NewSendCoinsCall(addr1, moduleAddr, scopeID.Coins()),
 			expCallBurn:   true,
 		},
 		{
@@ -931,7 +932,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwner() {
 			scopeID:       scopeID,
 			newValueOwner: "",
 			expCallDO:     true,
-			expCallSend:   NewSendCoinsCall(addr1, moduleAddr, scopeID.Coins()),
+			expCallSend:   testutil.NewSendCoinsCall(addr1, moduleAddr, scopeID.Coins()),
 			expCallBurn:   true,
 		},
 		{
@@ -948,15 +949,15 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwner() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			// Set up expected bank keeper calls.
-			expBKCalls := BankKeeperCalls{}
+			expBKCalls := testutil.BankKeeperCalls{}
 			if len(tc.expCallBA) > 0 {
 				expBKCalls.BlockedAddr = append(expBKCalls.BlockedAddr, tc.expCallBA)
 			}
 			if tc.expCallMint {
-				expBKCalls.MintCoins = append(expBKCalls.MintCoins, NewMintBurnCall(types.ModuleName, tc.scopeID.Coins()))
+				expBKCalls.MintCoins = append(expBKCalls.MintCoins, testutil.NewMintBurnCall(types.ModuleName, tc.scopeID.Coins()))
 			}
 			if tc.expCallBurn {
-				expBKCalls.BurnCoins = append(expBKCalls.BurnCoins, NewMintBurnCall(types.ModuleName, tc.scopeID.Coins()))
+				expBKCalls.BurnCoins = append(expBKCalls.BurnCoins, testutil.NewMintBurnCall(types.ModuleName, tc.scopeID.Coins()))
 			}
 			if tc.expCallSend != nil {
 				expBKCalls.SendCoins = append(expBKCalls.SendCoins, tc.expCallSend)
@@ -967,7 +968,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwner() {
 
 			// Set up the mock bank keeper.
 			if tc.bk == nil {
-				tc.bk = NewMockBankKeeper()
+				tc.bk = testutil.NewMockBankKeeper()
 			}
 			if len(tc.curOwner) > 0 {
 				tc.bk = tc.bk.WithDenomOwnerResult(tc.scopeID, tc.curOwner)
@@ -994,8 +995,8 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwners() {
 		}
 		return rv
 	}
-	sendCall := func(from, to sdk.AccAddress, scopeIDs ...types.MetadataAddress) *SendCoinsCall {
-		return &SendCoinsCall{
+	sendCall := func(from, to sdk.AccAddress, scopeIDs ...types.MetadataAddress) *testutil.SendCoinsCall {
+		return &testutil.SendCoinsCall{
 			FromAddr: from,
 			ToAddr:   to,
 			Amt:      scopeCoins(scopeIDs...),
@@ -1023,12 +1024,12 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwners() {
 
 	tests := []struct {
 		name           string
-		bankK          *MockBankKeeper
+		bankK          *testutil.MockBankKeeper
 		links          types.AccMDLinks
 		newVO          string
 		expErr         string
 		expBlockedCall bool
-		expSendCalls   []*SendCoinsCall
+		expSendCalls   []*testutil.SendCoinsCall
 	}{
 		{
 			name:   "nil links",
@@ -1046,25 +1047,25 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwners() {
 			name:   "link without acc address",
 			links:  types.AccMDLinks{types.NewAccMDLink(nil, scopeID1)},
 			newVO:  addr4.String(),
-			expErr: "no account address associated with metadata address \"" + scopeID1.String() + "\"",
+			expErr: "entry 0: no account address associated with metadata address \"" + scopeID1.String() + "\"",
 		},
 		{
 			name:   "link without md address",
 			links:  types.AccMDLinks{types.NewAccMDLink(addr1, nil)},
 			newVO:  addr4.String(),
-			expErr: "invalid scope metadata address MetadataAddress(nil): address is empty",
+			expErr: "entry 0: invalid scope metadata address MetadataAddress(nil): address is empty",
 		},
 		{
 			name:   "link with scope spec md address",
 			links:  types.AccMDLinks{types.NewAccMDLink(addr1, scopeSpecID)},
 			newVO:  addr4.String(),
-			expErr: "invalid scope id \"" + scopeSpecID.String() + "\": wrong type",
+			expErr: "entry 0: invalid scope id \"" + scopeSpecID.String() + "\": wrong type",
 		},
 		{
 			name:   "two links with same md address",
 			links:  types.AccMDLinks{types.NewAccMDLink(addr1, scopeID1), types.NewAccMDLink(addr2, scopeID1)},
 			newVO:  addr4.String(),
-			expErr: "duplicate metadata address \"" + scopeID1.String() + "\" not allowed",
+			expErr: "entry 1: duplicate metadata address \"" + scopeID1.String() + "\" not allowed",
 		},
 		{
 			name:   "empty new value owner",
@@ -1080,7 +1081,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwners() {
 		},
 		{
 			name:           "blocked address",
-			bankK:          NewMockBankKeeper().WithBlockedAddr(addr4),
+			bankK:          testutil.NewMockBankKeeper().WithBlockedAddr(addr4),
 			links:          types.AccMDLinks{types.NewAccMDLink(addr1, scopeID1)},
 			newVO:          addr4.String(),
 			expErr:         "new value owner " + addr4.String() + " is not allowed to receive funds: unauthorized",
@@ -1091,7 +1092,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwners() {
 			links:          types.AccMDLinks{types.NewAccMDLink(addr1, scopeID1)},
 			newVO:          addr4.String(),
 			expBlockedCall: true,
-			expSendCalls:   []*SendCoinsCall{sendCall(addr1, addr4, scopeID1)},
+			expSendCalls:   []*testutil.SendCoinsCall{sendCall(addr1, addr4, scopeID1)},
 		},
 		{
 			name:           "one link: new value owner is same",
@@ -1101,27 +1102,27 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwners() {
 		},
 		{
 			name:  "one link: error sending coins",
-			bankK: NewMockBankKeeper().WithSendCoinsError(addr1, "not a real error"),
+			bankK: testutil.NewMockBankKeeper().WithSendCoinsError(addr1, "not a real error"),
 			links: types.AccMDLinks{types.NewAccMDLink(addr1, scopeID1)},
 			newVO: addr4.String(),
 			expErr: "could not send scope coins \"" + scopeCoins(scopeID1).String() + "\" " +
 				"from " + addr1.String() + " to " + addr4.String() + ": not a real error",
 			expBlockedCall: true,
-			expSendCalls:   []*SendCoinsCall{sendCall(addr1, addr4, scopeID1)},
+			expSendCalls:   []*testutil.SendCoinsCall{sendCall(addr1, addr4, scopeID1)},
 		},
 		{
 			name:           "two links: same acc addresses",
 			links:          types.AccMDLinks{types.NewAccMDLink(addr1, scopeID1), types.NewAccMDLink(addr1, scopeID2)},
 			newVO:          addr4.String(),
 			expBlockedCall: true,
-			expSendCalls:   []*SendCoinsCall{sendCall(addr1, addr4, scopeID1, scopeID2)},
+			expSendCalls:   []*testutil.SendCoinsCall{sendCall(addr1, addr4, scopeID1, scopeID2)},
 		},
 		{
 			name:           "two links: different acc addresses",
 			links:          types.AccMDLinks{types.NewAccMDLink(addr1, scopeID1), types.NewAccMDLink(addr2, scopeID2)},
 			newVO:          addr4.String(),
 			expBlockedCall: true,
-			expSendCalls:   []*SendCoinsCall{sendCall(addr1, addr4, scopeID1), sendCall(addr2, addr4, scopeID2)},
+			expSendCalls:   []*testutil.SendCoinsCall{sendCall(addr1, addr4, scopeID1), sendCall(addr2, addr4, scopeID2)},
 		},
 		{
 			name: "mix of same and different acc addresses, one is new value owner",
@@ -1134,7 +1135,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwners() {
 			},
 			newVO:          addr4.String(),
 			expBlockedCall: true,
-			expSendCalls: []*SendCoinsCall{
+			expSendCalls: []*testutil.SendCoinsCall{
 				sendCall(addr1, addr4, scopeID1, scopeID3),
 				sendCall(addr2, addr4, scopeID2),
 				sendCall(addr3, addr4, scopeID5),
@@ -1142,7 +1143,7 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwners() {
 		},
 		{
 			name:  "three links: error sending from second",
-			bankK: NewMockBankKeeper().WithSendCoinsError(addr2, "fake error is fake"),
+			bankK: testutil.NewMockBankKeeper().WithSendCoinsError(addr2, "fake error is fake"),
 			links: types.AccMDLinks{
 				types.NewAccMDLink(addr1, scopeID1),
 				types.NewAccMDLink(addr2, scopeID2),
@@ -1152,21 +1153,40 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwners() {
 			expErr: "could not send scope coins \"" + scopeCoins(scopeID2).String() + "\" " +
 				"from " + addr2.String() + " to " + addr4.String() + ": fake error is fake",
 			expBlockedCall: true,
-			expSendCalls: []*SendCoinsCall{
+			expSendCalls: []*testutil.SendCoinsCall{
 				sendCall(addr1, addr4, scopeID1),
 				sendCall(addr2, addr4, scopeID2),
 			},
 		},
+		{
+			name:  "four links: error sending from last",
+			bankK: testutil.NewMockBankKeeper().WithSendCoinsError(addr3, "injected send failure"),
+			links: types.AccMDLinks{
+				types.NewAccMDLink(addr1, scopeID1),
+				types.NewAccMDLink(addr2, scopeID2),
+				types.NewAccMDLink(addr1, scopeID3),
+				types.NewAccMDLink(addr3, scopeID4),
+			},
+			newVO: addr4.String(),
+			expErr: "could not send scope coins \"" + scopeCoins(scopeID4).String() + "\" " +
+				"from " + addr3.String() + " to " + addr4.String() + ": injected send failure",
+			expBlockedCall: true,
+			expSendCalls: []*testutil.SendCoinsCall{
+				sendCall(addr1, addr4, scopeID1, scopeID3),
+				sendCall(addr2, addr4, scopeID2),
+				sendCall(addr3, addr4, scopeID4),
+			},
+		},
 	}
 
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			if tc.bankK == nil {
-				tc.bankK = NewMockBankKeeper()
+				tc.bankK = testutil.NewMockBankKeeper()
 			}
 			defer s.SwapBankKeeper(tc.bankK)()
 
-			expBKCalls := BankKeeperCalls{
+			expBKCalls := testutil.BankKeeperCalls{
 				SendCoins: tc.expSendCalls,
 			}
 			if tc.expBlockedCall {
@@ -1308,7 +1328,7 @@ func (s *ScopeKeeperTestSuite) TestValidateWriteScope() {
 		proposed types.Scope
 		signers  []string
 		authzK   *MockAuthzKeeper
-		bankK    *MockBankKeeper
+		bankK    *testutil.MockBankKeeper
 		errorMsg string
 		expAddrs []sdk.AccAddress
 	}{
@@ -1816,7 +1836,7 @@ func (s *ScopeKeeperTestSuite) TestValidateWriteScope() {
 			proposed: *ns(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{s.user2}, ""),
 			signers:  []string{s.user1},
 			authzK:   NewMockAuthzKeeper(), // So that there's no authz grants involved.
-			bankK:    NewMockBankKeeper().WithDenomOwnerError(scopeID, "this error should not be triggered"),
+			bankK:    testutil.NewMockBankKeeper().WithDenomOwnerError(scopeID, "this error should not be triggered"),
 			expAddrs: []sdk.AccAddress{s.user1Addr},
 		},
 		{
@@ -1825,7 +1845,7 @@ func (s *ScopeKeeperTestSuite) TestValidateWriteScope() {
 			proposed: *ns(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{s.user2}, s.user3),
 			signers:  []string{s.user1},
 			authzK:   NewMockAuthzKeeper(), // So that there's no authz grants involved.
-			bankK:    NewMockBankKeeper().WithDenomOwnerError(scopeID, "this is an injected error"),
+			bankK:    testutil.NewMockBankKeeper().WithDenomOwnerError(scopeID, "this is an injected error"),
 			errorMsg: "error identifying current value owner of \"" + scopeID.String() + "\": this is an injected error",
 		},
 		{
@@ -1856,7 +1876,7 @@ func (s *ScopeKeeperTestSuite) TestValidateWriteScope() {
 				defer s.SwapAuthzKeeper(tc.authzK)()
 			}
 			if tc.bankK == nil {
-				tc.bankK = NewMockBankKeeper()
+				tc.bankK = testutil.NewMockBankKeeper()
 			}
 			if tc.existing != nil && len(tc.existing.ValueOwnerAddress) > 0 {
 				// If there's supposed to be an existing value owner, and it hasn't been mocked yet,
@@ -2060,7 +2080,7 @@ func (s *ScopeKeeperTestSuite) TestValidateDeleteScope() {
 
 	tests := []struct {
 		name     string
-		bankK    *MockBankKeeper
+		bankK    *testutil.MockBankKeeper
 		scope    types.Scope
 		signers  []string
 		expAddrs []sdk.AccAddress
@@ -2218,7 +2238,7 @@ func (s *ScopeKeeperTestSuite) TestValidateDeleteScope() {
 		},
 		{
 			name:    "error getting current value owner",
-			bankK:   NewMockBankKeeper().WithDenomOwnerError(scopeUserValueOwner.ScopeId, "oopsies: no worky"),
+			bankK:   testutil.NewMockBankKeeper().WithDenomOwnerError(scopeUserValueOwner.ScopeId, "oopsies: no worky"),
 			scope:   scopeUserValueOwner,
 			signers: []string{s.user1, s.user2},
 			expErr:  "error identifying current value owner of \"" + scopeUserValueOwner.ScopeId.String() + "\": oopsies: no worky",
@@ -3206,22 +3226,22 @@ func (s *ScopeKeeperTestSuite) TestValidateUpdateValueOwners() {
 		{
 			name:   "nil entry in links",
 			links:  types.AccMDLinks{{AccAddr: addr1, MDAddr: scopeID1}, nil, {AccAddr: addr2, MDAddr: scopeID2}},
-			expErr: "nil entry not allowed",
+			expErr: "entry 1: nil entry not allowed",
 		},
 		{
 			name:   "link without acc addr",
 			links:  types.AccMDLinks{{AccAddr: nil, MDAddr: scopeID1}},
-			expErr: "no account address associated with metadata address \"" + scopeID1.String() + "\"",
+			expErr: "entry 0: no account address associated with metadata address \"" + scopeID1.String() + "\"",
 		},
 		{
 			name:   "link without md addr",
 			links:  types.AccMDLinks{{AccAddr: addr1, MDAddr: nil}},
-			expErr: "invalid scope metadata address MetadataAddress(nil): address is empty",
+			expErr: "entry 0: invalid scope metadata address MetadataAddress(nil): address is empty",
 		},
 		{
 			name:   "duplicate md addr in links",
 			links:  types.AccMDLinks{{AccAddr: addr1, MDAddr: scopeID1}, {AccAddr: addr1, MDAddr: scopeID1}},
-			expErr: "duplicate metadata address \"" + scopeID1.String() + "\" not allowed",
+			expErr: "entry 1: duplicate metadata address \"" + scopeID1.String() + "\" not allowed",
 		},
 		{
 			name:     "one of the links already has the proposed acc address",
@@ -3570,3 +3590,117 @@ func (s *ScopeKeeperTestSuite) TestRemoveNetAssetValues() {
 		})
 	}
 }
+
+func (s *ScopeKeeperTestSuite) TestScopeCoinBurnProtection() {
+	ctx := s.FreshCtx()
+	mdKeeper := s.app.MetadataKeeper
+	msgServer := keeper.NewMsgServerImpl(mdKeeper)
+	authority := mdKeeper.GetAuthority()
+
+	newScope := func() types.MetadataAddress {
+		scope := types.Scope{
+			ScopeId:           types.ScopeMetadataAddress(uuid.New()),
+			SpecificationId:   s.scopeSpecID,
+			Owners:            []types.Party{{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER}},
+			ValueOwnerAddress: s.user1,
+		}
+		s.Require().NoError(mdKeeper.SetScope(ctx, scope), "SetScope")
+		return scope.ScopeId
+	}
+	addRecords := func(scopeID types.MetadataAddress, names ...string) {
+		sessionID := scopeID.MustGetAsSessionAddress(uuid.New())
+		mdKeeper.SetSession(ctx, types.Session{SessionId: sessionID, Parties: []types.Party{{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER}}})
+		for _, name := range names {
+			mdKeeper.SetRecord(ctx, types.Record{Name: name, SessionId: sessionID})
+		}
+	}
+	assertOwner := func(scopeID types.MetadataAddress, expOwner sdk.AccAddress) {
+		owner, err := mdKeeper.GetScopeValueOwner(ctx, scopeID)
+		if s.Assert().NoError(err, "GetScopeValueOwner(%s)", scopeID) {
+			s.Assert().Equal(expOwner.String(), owner.String(), "GetScopeValueOwner(%s)", scopeID)
+		}
+	}
+
+	s.Run("no sessions or records", func() {
+		scopeID := newScope()
+		err := mdKeeper.SetScopeValueOwner(ctx, scopeID, "")
+		s.Require().NoError(err, "SetScopeValueOwner(%s, \"\")", scopeID)
+		assertOwner(scopeID, nil)
+	})
+
+	s.Run("with sessions and records", func() {
+		scopeID := newScope()
+		addRecords(scopeID, "recorda", "recordb")
+		addRecords(scopeID, "recordc")
+		expErr := fmt.Sprintf("cannot burn scope coin for %s: scope has 2 sessions, 3 records: "+
+			"scope still has sessions or records", scopeID)
+		err := mdKeeper.SetScopeValueOwner(ctx, scopeID, "")
+		s.Require().EqualError(err, expErr, "SetScopeValueOwner(%s, \"\")", scopeID)
+		s.Assert().ErrorIs(err, types.ErrScopeHasRecords, "SetScopeValueOwner(%s, \"\")", scopeID)
+		assertOwner(scopeID, s.user1Addr)
+
+		// Transferring it should still be okay.
+		err = mdKeeper.SetScopeValueOwner(ctx, scopeID, s.user2)
+		s.Require().NoError(err, "SetScopeValueOwner(%s, user2)", scopeID)
+		assertOwner(scopeID, s.user2Addr)
+	})
+
+	s.Run("with only a session", func() {
+		scopeID := newScope()
+		addRecords(scopeID)
+		expErr := fmt.Sprintf("cannot burn scope coin for %s: scope has 1 session: "+
+			"scope still has sessions or records", scopeID)
+		err := mdKeeper.SetScopeValueOwner(ctx, scopeID, "")
+		s.Require().EqualError(err, expErr, "SetScopeValueOwner(%s, \"\")", scopeID)
+		assertOwner(scopeID, s.user1Addr)
+	})
+
+	s.Run("with lots of records", func() {
+		scopeID := newScope()
+		addRecords(scopeID, "recorda", "recordb", "recordc", "recordd", "recorde", "recordf")
+		addRecords(scopeID, "recordg", "recordh", "recordi", "recordj", "recordk", "recordl")
+		addRecords(scopeID)
+		expErr := fmt.Sprintf("cannot burn scope coin for %s: scope has 3 sessions, 10+ records: "+
+			"scope still has sessions or records", scopeID)
+		err := mdKeeper.SetScopeValueOwner(ctx, scopeID, "")
+		s.Require().EqualError(err, expErr, "SetScopeValueOwner(%s, \"\")", scopeID)
+		assertOwner(scopeID, s.user1Addr)
+	})
+
+	s.Run("remove scope with records", func() {
+		scopeID := newScope()
+		addRecords(scopeID, "recorda")
+		err := mdKeeper.RemoveScope(ctx, scopeID)
+		s.Require().NoError(err, "RemoveScope(%s)", scopeID)
+		assertOwner(scopeID, nil)
+	})
+
+	s.Run("force burn: wrong authority", func() {
+		scopeID := newScope()
+		addRecords(scopeID, "recorda")
+		msg := types.NewMsgForceBurnScopeCoinRequest(s.user1, scopeID)
+		expErr := fmt.Sprintf("expected %q got %q: expected gov account as only signer for proposal message", authority, s.user1)
+		_, err := msgServer.ForceBurnScopeCoin(ctx, msg)
+		s.Require().EqualError(err, expErr, "ForceBurnScopeCoin")
+		assertOwner(scopeID, s.user1Addr)
+	})
+
+	s.Run("force burn: with records", func() {
+		scopeID := newScope()
+		addRecords(scopeID, "recorda")
+		msg := types.NewMsgForceBurnScopeCoinRequest(authority, scopeID)
+		_, err := msgServer.ForceBurnScopeCoin(ctx, msg)
+		s.Require().NoError(err, "ForceBurnScopeCoin")
+		assertOwner(scopeID, nil)
+		_, found := mdKeeper.GetScope(ctx, scopeID)
+		s.Assert().True(found, "GetScope(%s) found", scopeID)
+	})
+
+	s.Run("force burn: no coin", func() {
+		scopeID := types.ScopeMetadataAddress(uuid.New())
+		msg := types.NewMsgForceBurnScopeCoinRequest(authority, scopeID)
+		expErr := fmt.Sprintf("scope coin %q does not exist: invalid request", scopeID.Denom())
+		_, err := msgServer.ForceBurnScopeCoin(ctx, msg)
+		s.Require().EqualError(err, expErr, "ForceBurnScopeCoin")
+	})
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 
@@ -32,11 +33,15 @@ func (k Keeper) SetSession(ctx sdk.Context, session types.Session) {
 	b := k.cdc.MustMarshal(&session)
 
 	var event proto.Message = types.NewEventSessionCreated(session.SessionId)
-	if store.Has(session.SessionId) {
+	var oldSession *types.Session
+	if oldBz := store.Get(session.SessionId); oldBz != nil {
 		event = types.NewEventSessionUpdated(session.SessionId)
+		oldSession = k.readSessionForIndex(ctx, session.SessionId, oldBz)
 	}
 
 	store.Set(session.SessionId, b)
+	k.indexSession(store, &session, oldSession)
+	k.recordModification(ctx, session.SessionId)
 	k.EmitEvent(ctx, event)
 }
 
@@ -47,14 +52,56 @@ func (k Keeper) RemoveSession(ctx sdk.Context, id types.MetadataAddress) {
 	}
 	store := ctx.KVStore(k.storeKey)
 
-	if !store.Has(id) || k.sessionHasRecords(ctx, id) {
+	bz := store.Get(id)
+	if bz == nil || k.sessionHasRecords(ctx, id) {
 		return
 	}
 
+	k.indexSession(store, nil, k.readSessionForIndex(ctx, id, bz))
 	store.Delete(id)
+	k.recordModification(ctx, id)
 	k.EmitEvent(ctx, types.NewEventSessionDeleted(id))
 }
 
+// readSessionForIndex unmarshals the provided session bytes so that its index entries can be updated.
+// If the bytes cannot be unmarshalled, the error is logged and nil is returned.
+func (k Keeper) readSessionForIndex(ctx sdk.Context, id types.MetadataAddress, bz []byte) *types.Session {
+	var session types.Session
+	if err := k.cdc.Unmarshal(bz, &session); err != nil {
+		k.Logger(ctx).Error("could not unmarshal old session", append(types.MDFields(id), "error", err)...)
+		return nil
+	}
+	return &session
+}
+
+// indexSession updates the index entries for a session.
+//
+// When adding a new session:  indexSession(store, session, nil)
+//
+// When deleting a session:  indexSession(store, nil, session)
+//
+// When updating a session:  indexSession(store, newSession, oldSession)
+//
+// If both newSession and oldSession are not nil, it is assumed that they have the same SessionId.
+func (k Keeper) indexSession(store storetypes.KVStore, newSession, oldSession *types.Session) {
+	var newKey, oldKey []byte
+	if newSession != nil && !newSession.SpecificationId.Empty() {
+		newKey = types.GetContractSpecSessionCacheKey(newSession.SpecificationId, newSession.SessionId)
+	}
+	if oldSession != nil && !oldSession.SpecificationId.Empty() {
+		oldKey = types.GetContractSpecSessionCacheKey(oldSession.SpecificationId, oldSession.SessionId)
+	}
+	if bytes.Equal(newKey, oldKey) {
+		return
+	}
+	if len(oldKey) > 0 {
+		store.Delete(oldKey)
+	}
+	if len(newKey) > 0 {
+		store.Set(newKey, []byte{0x01})
+	}
+}
+
 func (k Keeper) sessionHasRecords(ctx sdk.Context, id types.MetadataAddress) bool {
 	if !id.IsSessionAddress() {
 		return false
@@ -92,7 +139,7 @@ func (k Keeper) IterateSessions(ctx sdk.Context, scopeID types.MetadataAddress,
 		var session types.Session
 		err = k.cdc.Unmarshal(it.Value(), &session)
 		if err != nil {
-			k.Logger(ctx).Error("could not unmarshal session", "address", it.Key(), "error", err)
+			k.Logger(ctx).Error("could not unmarshal session", append(types.MDFields(it.Key()), "error", err)...)
 		} else if handler(session) {
 			break
 		}
@@ -100,6 +147,32 @@ func (k Keeper) IterateSessions(ctx sdk.Context, scopeID types.MetadataAddress,
 	return nil
 }
 
+// IterateSessionsForContractSpec processes sessions associated with the provided contract specification id with the given handler.
+func (k Keeper) IterateSessionsForContractSpec(ctx sdk.Context, contractSpecID types.MetadataAddress,
+	handler func(sessionID types.MetadataAddress) (stop bool),
+) error {
+	if contractSpecID.Empty() {
+		return errors.New("contract spec id cannot be empty")
+	}
+	prefix, err := contractSpecID.ContractSpecSessionIteratorPrefix()
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var sessionID types.MetadataAddress
+		if err = sessionID.Unmarshal(it.Key()[len(prefix):]); err != nil {
+			return err
+		}
+		if handler(sessionID) {
+			break
+		}
+	}
+	return nil
+}
+
 // ValidateWriteSession checks the current session and the proposed session to determine if the proposed changes are valid
 // based on the existing state
 func (k Keeper) ValidateWriteSession(ctx sdk.Context, existing *types.Session, msg *types.MsgWriteSessionRequest) error {
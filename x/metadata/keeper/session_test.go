@@ -154,6 +154,59 @@ func (s *SessionKeeperTestSuite) TestSessionIterator() {
 	s.Equal(10, count, "iterator should return a full list of sessions")
 }
 
+func (s *SessionKeeperTestSuite) TestIterateSessionsForContractSpec() {
+	ctx := s.FreshCtx()
+	otherContractSpecID := types.ContractSpecMetadataAddress(uuid.New())
+	newSession := func(contractSpecID types.MetadataAddress) types.Session {
+		sessionID := types.SessionMetadataAddress(s.scopeUUID, uuid.New())
+		return *types.NewSession("name", sessionID, contractSpecID, ownerPartyList(s.user1), nil)
+	}
+	getSessionIDs := func(contractSpecID types.MetadataAddress) []types.MetadataAddress {
+		var rv []types.MetadataAddress
+		err := s.app.MetadataKeeper.IterateSessionsForContractSpec(ctx, contractSpecID, func(sessionID types.MetadataAddress) (stop bool) {
+			rv = append(rv, sessionID)
+			return false
+		})
+		s.Require().NoError(err, "IterateSessionsForContractSpec(%s)", contractSpecID)
+		return rv
+	}
+
+	session1 := newSession(s.contractSpecID)
+	session2 := newSession(s.contractSpecID)
+	session3 := newSession(otherContractSpecID)
+	for _, session := range []types.Session{session1, session2, session3} {
+		s.app.MetadataKeeper.SetSession(ctx, session)
+	}
+
+	s.Assert().ElementsMatch([]types.MetadataAddress{session1.SessionId, session2.SessionId}, getSessionIDs(s.contractSpecID), "sessions for contract spec")
+	s.Assert().ElementsMatch([]types.MetadataAddress{session3.SessionId}, getSessionIDs(otherContractSpecID), "sessions for other contract spec")
+	s.Assert().ElementsMatch([]types.MetadataAddress{session1.SessionId, session2.SessionId}, getSessionIDs(s.recordSpecID), "sessions for record spec")
+
+	session2.SpecificationId = otherContractSpecID
+	s.app.MetadataKeeper.SetSession(ctx, session2)
+	s.Assert().ElementsMatch([]types.MetadataAddress{session1.SessionId}, getSessionIDs(s.contractSpecID), "sessions for contract spec after update")
+	s.Assert().ElementsMatch([]types.MetadataAddress{session2.SessionId, session3.SessionId}, getSessionIDs(otherContractSpecID), "sessions for other contract spec after update")
+
+	s.app.MetadataKeeper.RemoveSession(ctx, session1.SessionId)
+	s.Assert().Empty(getSessionIDs(s.contractSpecID), "sessions for contract spec after removal")
+
+	stopped := 0
+	err := s.app.MetadataKeeper.IterateSessionsForContractSpec(ctx, otherContractSpecID, func(types.MetadataAddress) (stop bool) {
+		stopped++
+		return true
+	})
+	s.Assert().NoError(err, "IterateSessionsForContractSpec with stop")
+	s.Assert().Equal(1, stopped, "number of handler calls when stopping")
+
+	err = s.app.MetadataKeeper.RemoveContractSpecification(ctx, otherContractSpecID)
+	s.Assert().EqualError(err, "contract specification with id "+otherContractSpecID.String()+" still in use", "RemoveContractSpecification(other contract spec)")
+
+	err = s.app.MetadataKeeper.IterateSessionsForContractSpec(ctx, s.scopeID, func(types.MetadataAddress) (stop bool) { return false })
+	s.Assert().EqualError(err, "this metadata address does not contain a contract spec uuid", "IterateSessionsForContractSpec(scope id)")
+	err = s.app.MetadataKeeper.IterateSessionsForContractSpec(ctx, nil, func(types.MetadataAddress) (stop bool) { return false })
+	s.Assert().EqualError(err, "contract spec id cannot be empty", "IterateSessionsForContractSpec(nil)")
+}
+
 func (s *SessionKeeperTestSuite) TestValidateWriteSession() {
 	pt := func(addr string, role types.PartyType, opt bool) types.Party {
 		return types.Party{
@@ -82,7 +82,7 @@ func (k Keeper) GetRecordSpecificationsForContractSpecificationID(ctx sdk.Contex
 		if found {
 			retval = append(retval, &recordSpec)
 		} else {
-			k.Logger(ctx).Error(fmt.Sprintf("iterator found record spec id %s but no record spec was found with that id", recordSpecID))
+			k.Logger(ctx).Error("iterator found record spec id but no record spec was found with that id", types.MDFields(recordSpecID)...)
 		}
 		return false
 	})
@@ -114,6 +114,7 @@ func (k Keeper) SetRecordSpecification(ctx sdk.Context, spec types.RecordSpecifi
 	}
 
 	store.Set(spec.SpecificationId, b)
+	k.registerRecordName(ctx, spec.Name)
 	k.EmitEvent(ctx, event)
 }
 
@@ -338,7 +339,14 @@ func (k Keeper) isContractSpecUsed(ctx sdk.Context, contractSpecID types.Metadat
 		return true
 	}
 
-	// TODO: Look for sessions used by this contractSpecID.
+	// Look for sessions that use this contract spec.
+	itSessionErr := k.IterateSessionsForContractSpec(ctx, contractSpecID, func(types.MetadataAddress) (stop bool) {
+		contractSpecReferenceFound = true
+		return true
+	})
+	if itSessionErr != nil || contractSpecReferenceFound {
+		return true
+	}
 
 	// Look for a used record spec that is part of this contract spec
 	hasUsedRecordSpec := false
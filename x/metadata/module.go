@@ -32,7 +32,8 @@ var (
 	_ module.AppModuleBasic      = (*AppModule)(nil)
 	_ module.AppModuleSimulation = (*AppModule)(nil)
 
-	_ appmodule.AppModule = (*AppModule)(nil)
+	_ appmodule.AppModule     = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker = (*AppModule)(nil)
 )
 
 // AppModuleBasic contains non-dependent elements for the metadata module.
@@ -125,6 +126,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3To4); err != nil {
 		panic(fmt.Sprintf("failed to register x/metadata migration from version 3 to 4: %v", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4To5); err != nil {
+		panic(fmt.Sprintf("failed to register x/metadata migration from version 4 to 5: %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the metadata module. It returns no validator updates.
@@ -166,5 +170,11 @@ func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.Weig
 	return []simtypes.WeightedOperation{}
 }
 
+// EndBlock is the `EndBlocker` function run at the end of each block to prune the modification index.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	EndBlocker(sdk.UnwrapSDKContext(ctx), am.keeper)
+	return nil
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 4 }
+func (AppModule) ConsensusVersion() uint64 { return 5 }
@@ -0,0 +1,314 @@
+package testutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// This file houses a mock bank keeper for use in unit tests.
+
+// ensure that the MockBankKeeper implements types.MetadataBankKeeper.
+var _ types.MetadataBankKeeper = (*MockBankKeeper)(nil)
+
+// MockBankKeeper is a mocked bank keeper that records the calls made to it.
+// It has everything needed by the metadata keeper, and can be told to return errors from most of it.
+type MockBankKeeper struct {
+	BlockedAddrResults map[string]bool
+	MintCoinsResults   []string
+	BurnCoinsResults   []string
+	SendCoinsResults   map[string]string
+	DenomOwnerResults  map[string]DenomOwnerResult
+	GetSupplyResults   map[string]sdk.Coin
+
+	Calls BankKeeperCalls
+}
+
+// BankKeeperCalls contains records of calls made to the mock bank keeper.
+type BankKeeperCalls struct {
+	BlockedAddr []sdk.AccAddress
+	MintCoins   []*MintBurnCall
+	BurnCoins   []*MintBurnCall
+	SendCoins   []*SendCoinsCall
+	DenomOwner  []string
+	GetSupply   []string
+}
+
+// NewMockBankKeeper creates a new MockBankKeeper.
+// Usually followed by calls to WithBlockedAddr, WithMintCoinsErrors, WithBurnCoinsErrors,
+// SendCoinsErrors, WithDenomOwnerResult, WithDenomOwnerError, and/or WithSupply.
+func NewMockBankKeeper() *MockBankKeeper {
+	return &MockBankKeeper{
+		BlockedAddrResults: make(map[string]bool),
+		SendCoinsResults:   make(map[string]string),
+		DenomOwnerResults:  make(map[string]DenomOwnerResult),
+		GetSupplyResults:   make(map[string]sdk.Coin),
+	}
+}
+
+// WithBlockedAddr makes the provided addr report as blocked.
+func (k *MockBankKeeper) WithBlockedAddr(addr sdk.AccAddress) *MockBankKeeper {
+	k.BlockedAddrResults[string(addr)] = true
+	return k
+}
+
+// WithMintCoinsErrors queues up the provided strings as errors to return from MintCoins.
+// An entry of "" means no error will be returned for that entry.
+func (k *MockBankKeeper) WithMintCoinsErrors(errs ...string) *MockBankKeeper {
+	k.MintCoinsResults = append(k.MintCoinsResults, errs...)
+	return k
+}
+
+// WithBurnCoinsErrors queues up the provided strings as errors to return from BurnCoins.
+// An entry of "" means no error will be returned for that entry.
+func (k *MockBankKeeper) WithBurnCoinsErrors(errs ...string) *MockBankKeeper {
+	k.BurnCoinsResults = append(k.BurnCoinsResults, errs...)
+	return k
+}
+
+// WithSendCoinsError makes the SendCoins return the provided err for the given fromAddr.
+// An err of "" means no error will be returned for that fromAddr.
+func (k *MockBankKeeper) WithSendCoinsError(fromAddr sdk.AccAddress, err string) *MockBankKeeper {
+	k.SendCoinsResults[string(fromAddr)] = err
+	return k
+}
+
+// WithDenomOwnerResult makes DenomOwner return the given accAddr for the given scope (with nil error).
+func (k *MockBankKeeper) WithDenomOwnerResult(mdAddr types.MetadataAddress, accAddr sdk.AccAddress) *MockBankKeeper {
+	k.DenomOwnerResults[mdAddr.Denom()] = DenomOwnerResult{Owner: accAddr}
+	return k
+}
+
+// WithDenomOwnerError makes DenomOwner return the given err for the given scope (with nil AccAddress).
+func (k *MockBankKeeper) WithDenomOwnerError(mdAddr types.MetadataAddress, err string) *MockBankKeeper {
+	k.DenomOwnerResults[mdAddr.Denom()] = DenomOwnerResult{Err: err}
+	return k
+}
+
+// WithSupply makes GetSupply return the provided coin for its denom.
+func (k *MockBankKeeper) WithSupply(coin sdk.Coin) *MockBankKeeper {
+	k.GetSupplyResults[coin.Denom] = coin
+	return k
+}
+
+// AssertCalls asserts that all calls made using this bank keeper are equal to the provided expected calls.
+func (k *MockBankKeeper) AssertCalls(t *testing.T, exp BankKeeperCalls) bool {
+	t.Helper()
+	rv := k.AssertBlockedAddrCalls(t, exp.BlockedAddr)
+	rv = k.AssertMintCoinsCalls(t, exp.MintCoins) && rv
+	rv = k.AssertBurnCoinsCalls(t, exp.BurnCoins) && rv
+	rv = k.AssertSendCoinsCalls(t, exp.SendCoins) && rv
+	rv = k.AssertDenomOwnerCalls(t, exp.DenomOwner) && rv
+	rv = k.AssertGetSupplyCalls(t, exp.GetSupply) && rv
+	return rv
+}
+
+// AssertBlockedAddrCalls asserts that calls made to BlockedAddr are as expected.
+func (k *MockBankKeeper) AssertBlockedAddrCalls(t *testing.T, exp []sdk.AccAddress) bool {
+	t.Helper()
+	act := k.Calls.BlockedAddr
+	if assert.Equal(t, exp, act, "Addrs provided to BlockedAddr") {
+		return true
+	}
+	expStrs := addrsCastToStrings(exp)
+	actStrs := addrsCastToStrings(act)
+	assert.Equal(t, expStrs, actStrs, "Addrs (as strings) provided to BlockedAddr")
+	return false
+}
+
+// AssertMintCoinsCalls asserts that calls made to MintCoins are as expected.
+func (k *MockBankKeeper) AssertMintCoinsCalls(t *testing.T, exp []*MintBurnCall) bool {
+	t.Helper()
+	act := k.Calls.MintCoins
+	if assert.Equal(t, exp, act, "Calls made to MintCoins") {
+		return true
+	}
+	expStrs := mapToStrings(exp)
+	actStrs := mapToStrings(act)
+	assert.Equal(t, expStrs, actStrs, "Calls (as strings) made to MintCoins")
+	return false
+}
+
+// AssertBurnCoinsCalls asserts that calls made to BurnCoins are as expected.
+func (k *MockBankKeeper) AssertBurnCoinsCalls(t *testing.T, exp []*MintBurnCall) bool {
+	t.Helper()
+	act := k.Calls.BurnCoins
+	if assert.Equal(t, exp, act, "Calls made to BurnCoins") {
+		return true
+	}
+	expStrs := mapToStrings(exp)
+	actStrs := mapToStrings(act)
+	assert.Equal(t, expStrs, actStrs, "Calls (as strings) made to BurnCoins")
+	return false
+}
+
+// AssertSendCoinsCalls asserts that calls made to SendCoins are as expected.
+func (k *MockBankKeeper) AssertSendCoinsCalls(t *testing.T, exp []*SendCoinsCall) bool {
+	t.Helper()
+	act := k.Calls.SendCoins
+	if assert.Equal(t, exp, act, "Calls made to SendCoins") {
+		return true
+	}
+	expStrs := mapToStrings(exp)
+	actStrs := mapToStrings(act)
+	assert.Equal(t, expStrs, actStrs, "Calls (as strings) made to SendCoins")
+	return false
+}
+
+// AssertDenomOwnerCalls asserts that calls made to DenomOwner are as expected.
+func (k *MockBankKeeper) AssertDenomOwnerCalls(t *testing.T, exp []string) bool {
+	t.Helper()
+	return assert.Equal(t, exp, k.Calls.DenomOwner, "Calls made to DenomOwner")
+}
+
+// AssertGetSupplyCalls asserts that calls made to GetSupply are as expected.
+func (k *MockBankKeeper) AssertGetSupplyCalls(t *testing.T, exp []string) bool {
+	t.Helper()
+	return assert.Equal(t, exp, k.Calls.GetSupply, "Calls made to GetSupply")
+}
+
+// MintBurnCall is the args provided to either MintCoins or BurnCoins.
+type MintBurnCall struct {
+	ModuleName string
+	Coins      sdk.Coins
+}
+
+func (c MintBurnCall) String() string {
+	return c.ModuleName + "_" + c.Coins.String()
+}
+
+func NewMintBurnCall(moduleName string, coins sdk.Coins) *MintBurnCall {
+	return &MintBurnCall{
+		ModuleName: moduleName,
+		Coins:      coins,
+	}
+}
+
+// SendCoinsCall is the args provided to SendCoins.
+type SendCoinsCall struct {
+	FromAddr sdk.AccAddress
+	ToAddr   sdk.AccAddress
+	Amt      sdk.Coins
+}
+
+func (c SendCoinsCall) String() string {
+	return string(c.FromAddr) + "->" + string(c.ToAddr) + ":" + c.Amt.String()
+}
+
+func NewSendCoinsCall(fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) *SendCoinsCall {
+	return &SendCoinsCall{
+		FromAddr: fromAddr,
+		ToAddr:   toAddr,
+		Amt:      amt,
+	}
+}
+
+// DenomOwnerResult is the args returned by DenomOwner.
+type DenomOwnerResult struct {
+	Owner sdk.AccAddress
+	Err   string
+}
+
+func (k *MockBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	k.Calls.BlockedAddr = append(k.Calls.BlockedAddr, addr)
+	return k.BlockedAddrResults[string(addr)]
+}
+
+func (k *MockBankKeeper) MintCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	k.Calls.MintCoins = append(k.Calls.MintCoins, NewMintBurnCall(moduleName, amt))
+	if len(k.MintCoinsResults) > 0 {
+		err := k.MintCoinsResults[0]
+		k.MintCoinsResults = k.MintCoinsResults[1:]
+		if len(err) > 0 {
+			return errors.New(err)
+		}
+	}
+	return nil
+}
+
+func (k *MockBankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	k.Calls.BurnCoins = append(k.Calls.BurnCoins, NewMintBurnCall(moduleName, amt))
+	if len(k.BurnCoinsResults) > 0 {
+		err := k.BurnCoinsResults[0]
+		k.BurnCoinsResults = k.BurnCoinsResults[1:]
+		if len(err) > 0 {
+			return errors.New(err)
+		}
+	}
+	return nil
+}
+
+func (k *MockBankKeeper) SendCoins(_ context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	k.Calls.SendCoins = append(k.Calls.SendCoins, NewSendCoinsCall(fromAddr, toAddr, amt))
+	err := k.SendCoinsResults[string(fromAddr)]
+	if len(err) > 0 {
+		return errors.New(err)
+	}
+	return nil
+}
+
+func (k *MockBankKeeper) GetBalance(_ context.Context, _ sdk.AccAddress, _ string) sdk.Coin {
+	panic("not implemented")
+}
+
+func (k *MockBankKeeper) DenomOwner(_ context.Context, denom string) (sdk.AccAddress, error) {
+	k.Calls.DenomOwner = append(k.Calls.DenomOwner, denom)
+	result, found := k.DenomOwnerResults[denom]
+	if found {
+		if len(result.Err) > 0 {
+			return nil, errors.New(result.Err)
+		}
+		return result.Owner, nil
+	}
+	return nil, nil
+}
+
+func (k *MockBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	k.Calls.GetSupply = append(k.Calls.GetSupply, denom)
+	if coin, found := k.GetSupplyResults[denom]; found {
+		return coin
+	}
+	return sdk.Coin{Denom: denom, Amount: sdkmath.ZeroInt()}
+}
+
+func (k *MockBankKeeper) GetScopesForValueOwner(_ context.Context, _ sdk.AccAddress, _ *query.PageRequest) (types.AccMDLinks, *query.PageResponse, error) {
+	panic("not implemented")
+}
+
+// addrsCastToStrings casts each of the provided addrs to strings.
+// This does NOT create bech32 address strings.
+// It's handy when the bytes of the address are known, but not the bech32,
+// but you want to do a string comparison on the slices.
+//
+// To convert them to bech32 address strings, use mapToStrings.
+func addrsCastToStrings(addrs []sdk.AccAddress) []string {
+	if addrs == nil {
+		return nil
+	}
+	rv := make([]string, len(addrs))
+	for i, v := range addrs {
+		rv[i] = string(v)
+	}
+	return rv
+}
+
+func mapToStrings[S ~[]E, E fmt.Stringer](vals S) []string {
+	if vals == nil {
+		return nil
+	}
+	rv := make([]string, len(vals))
+	for i, v := range vals {
+		rv[i] = v.String()
+	}
+	return rv
+}
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/google/uuid"
@@ -33,7 +36,12 @@ const (
 	PrefixRecordSpecification = "recspec"
 
 	// DenomPrefix is the string prepended to a metadata address to create the denom for that metadata object.
+	// It is the canonical metadata denom prefix (see also MetadataDenomPrefixes).
 	DenomPrefix = "nft/"
+	// RichDenomRecordSegment is the segment of a rich denom that identifies a record (by name) within a scope.
+	RichDenomRecordSegment = "record"
+	// RichDenomSessionSegment is the segment of a rich denom that identifies a session (by uuid) within a scope.
+	RichDenomSessionSegment = "session"
 )
 
 var (
@@ -41,6 +49,119 @@ var (
 	_ sdk.Address = MetadataAddress{}
 )
 
+// metadataDenomPrefixes are the recognized metadata denom prefixes, longest first.
+// To recognize another prefix, add a RegisterMetadataDenomPrefix call to the init function below.
+var metadataDenomPrefixes []string
+
+func init() {
+	RegisterMetadataDenomPrefix(DenomPrefix)
+}
+
+// RegisterMetadataDenomPrefix adds the provided prefix to the recognized metadata denom prefixes.
+// A denom with a registered prefix is treated as a metadata denom when parsed, but Denom() always uses DenomPrefix.
+// When a denom starts with more than one registered prefix (e.g. "nft/" and "nft/v2/"), the longest one is used.
+// This is not safe for concurrent use, so it should only be called during initialization.
+func RegisterMetadataDenomPrefix(prefix string) {
+	if len(prefix) == 0 || slices.Contains(metadataDenomPrefixes, prefix) {
+		return
+	}
+	metadataDenomPrefixes = append(metadataDenomPrefixes, prefix)
+	sort.SliceStable(metadataDenomPrefixes, func(i, j int) bool {
+		return len(metadataDenomPrefixes[i]) > len(metadataDenomPrefixes[j])
+	})
+}
+
+// MetadataDenomPrefixes returns all of the recognized metadata denom prefixes, longest first.
+func MetadataDenomPrefixes() []string {
+	return slices.Clone(metadataDenomPrefixes)
+}
+
+// Sentinel errors that can be identified (using errors.Is) in the errors returned from the metadata address functions.
+// Wrapping these does not change the text of the resulting error.
+var (
+	// ErrAddressParse indicates that a metadata address could not be parsed or does not have a valid format.
+	ErrAddressParse = errors.New("invalid metadata address")
+	// ErrAddressWrongType indicates that a metadata address is not of the expected type.
+	ErrAddressWrongType = errors.New("wrong metadata address type")
+	// ErrEmptyAddress indicates that a metadata address does not have any bytes.
+	ErrEmptyAddress = errors.New("address is empty")
+)
+
+// InvalidAddressTypeError indicates that a metadata address has an unknown (or unexpected) type byte.
+type InvalidAddressTypeError struct {
+	// TypeByte is the first byte of the address.
+	TypeByte byte
+}
+
+// Error returns a description of this InvalidAddressTypeError, satisfying the error interface.
+func (e *InvalidAddressTypeError) Error() string {
+	return fmt.Sprintf("invalid metadata address type: %d", e.TypeByte)
+}
+
+// InvalidAddressLengthError indicates that a metadata address does not have the right number of bytes.
+type InvalidAddressLengthError struct {
+	// Expected is the required length. For functions that only need part of an address, it's the minimum length.
+	Expected int
+	// Actual is the length of the address.
+	Actual int
+}
+
+// Error returns a description of this InvalidAddressLengthError, satisfying the error interface.
+func (e *InvalidAddressLengthError) Error() string {
+	return fmt.Sprintf("incorrect address length (expected: %d, actual: %d)", e.Expected, e.Actual)
+}
+
+const (
+	// UUIDPositionPrimary is the InvalidUUIDBytesError position of the primary uuid of a metadata address.
+	UUIDPositionPrimary = "primary"
+	// UUIDPositionSecondary is the InvalidUUIDBytesError position of the secondary uuid of a metadata address.
+	UUIDPositionSecondary = "secondary"
+)
+
+// InvalidUUIDBytesError indicates that some of a metadata address's bytes are not a valid uuid.
+type InvalidUUIDBytesError struct {
+	// Position is which uuid is invalid, either UUIDPositionPrimary or UUIDPositionSecondary.
+	Position string
+	// Err is the error from parsing the uuid.
+	Err error
+}
+
+// Error returns a description of this InvalidUUIDBytesError, satisfying the error interface.
+func (e *InvalidUUIDBytesError) Error() string {
+	which := "uuid"
+	if e.Position != UUIDPositionPrimary {
+		which = e.Position + " uuid"
+	}
+	return fmt.Sprintf("invalid address bytes of %s, expected uuid compliant: %v", which, e.Err)
+}
+
+// Unwrap returns the error from parsing the uuid.
+func (e *InvalidUUIDBytesError) Unwrap() error {
+	return e.Err
+}
+
+// addressError is an error that identifies as one of the address sentinel errors, but has the text of another error.
+type addressError struct {
+	sentinel error
+	err      error
+}
+
+// newAddressError creates an error with the text of err that also identifies as the provided sentinel.
+// The sentinel can also be one of the address error types (e.g. InvalidAddressTypeError) for use with errors.As.
+func newAddressError(sentinel, err error) error {
+	return &addressError{sentinel: sentinel, err: err}
+}
+
+// Error returns the text of the underlying error, satisfying the error interface.
+func (e *addressError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns both the sentinel and the underlying error (for use with errors.Is and errors.As).
+func (e *addressError) Unwrap() []error {
+	return []error{e.sentinel, e.err}
+}
+
 // MetadataAddress is a blockchain compliant address based on UUIDs
 type MetadataAddress []byte
 
@@ -49,7 +170,7 @@ type MetadataAddress []byte
 func VerifyMetadataAddressFormat(bz []byte) (string, error) {
 	hrp := ""
 	if len(bz) == 0 {
-		return hrp, errors.New("address is empty")
+		return hrp, newAddressError(ErrAddressParse, ErrEmptyAddress)
 	}
 	var requiredLength int
 	checkSecondaryUUID := false
@@ -76,18 +197,18 @@ func VerifyMetadataAddressFormat(bz []byte) (string, error) {
 		requiredLength = 1 + 16 + 16 // type byte plus size of one uuid plus one-half sha256 hash
 
 	default:
-		return hrp, fmt.Errorf("invalid metadata address type: %d", bz[0])
+		return hrp, newAddressError(ErrAddressParse, &InvalidAddressTypeError{TypeByte: bz[0]})
 	}
 	if len(bz) != requiredLength {
-		return hrp, fmt.Errorf("incorrect address length (expected: %d, actual: %d)", requiredLength, len(bz))
+		return hrp, newAddressError(ErrAddressParse, &InvalidAddressLengthError{Expected: requiredLength, Actual: len(bz)})
 	}
 	// all valid metadata address have at least one uuid
 	if _, err := uuid.FromBytes(bz[1:17]); err != nil {
-		return hrp, fmt.Errorf("invalid address bytes of uuid, expected uuid compliant: %w", err)
+		return hrp, newAddressError(ErrAddressParse, &InvalidUUIDBytesError{Position: UUIDPositionPrimary, Err: err})
 	}
 	if checkSecondaryUUID {
 		if _, err := uuid.FromBytes(bz[17:33]); err != nil {
-			return hrp, fmt.Errorf("invalid address bytes of secondary uuid, expected uuid compliant: %w", err)
+			return hrp, newAddressError(ErrAddressParse, &InvalidUUIDBytesError{Position: UUIDPositionSecondary, Err: err})
 		}
 	}
 	return hrp, nil
@@ -112,18 +233,66 @@ func getNameForHRP(hrp string) string {
 func VerifyMetadataAddressHasType(ma MetadataAddress, expHRP string) error {
 	hrp, err := VerifyMetadataAddressFormat(ma)
 	if err != nil {
-		return fmt.Errorf("invalid %s metadata address %#v: %w", getNameForHRP(expHRP), ma, err)
+		return fmt.Errorf("invalid %s metadata address %s: %w", getNameForHRP(expHRP), ma.DebugString(), err)
 	}
 	if hrp != expHRP {
-		return fmt.Errorf("invalid %s id %q: wrong type", getNameForHRP(expHRP), ma)
+		return newAddressError(ErrAddressWrongType, fmt.Errorf("invalid %s id %q: wrong type", getNameForHRP(expHRP), ma))
 	}
 	return nil
 }
 
+// AddressClassification describes how a MetadataAddress compares to an expected type.
+type AddressClassification struct {
+	// Valid is true if the address is a valid MetadataAddress of the expected type.
+	Valid bool
+	// ActualHRP is the type (hrp) of the address. It is empty if the address is not a valid MetadataAddress.
+	ActualHRP string
+	// SuggestedAddress is the address of the expected type that can be derived from the address
+	// (e.g. the scope of a session). It is empty if there isn't one.
+	SuggestedAddress MetadataAddress
+}
+
+// ClassifyAddressForType checks the provided ma against the expected type (e.g. PrefixScope or PrefixContractSpecification, etc.).
+// If ma is a valid MetadataAddress of a different type, the result has its actual type and, if one can be
+// derived, the related address of the expected type (e.g. the scope of a session or record, or the contract
+// specification of a record specification).
+func ClassifyAddressForType(ma MetadataAddress, expHRP string) AddressClassification {
+	hrp, err := VerifyMetadataAddressFormat(ma)
+	if err != nil {
+		return AddressClassification{}
+	}
+	rv := AddressClassification{Valid: hrp == expHRP, ActualHRP: hrp}
+	if rv.Valid {
+		return rv
+	}
+	switch {
+	case expHRP == PrefixScope && (hrp == PrefixSession || hrp == PrefixRecord):
+		rv.SuggestedAddress, _ = ma.AsScopeAddress()
+	case expHRP == PrefixContractSpecification && hrp == PrefixRecordSpecification:
+		rv.SuggestedAddress, _ = ma.AsContractSpecAddress()
+	}
+	return rv
+}
+
 // ConvertHashToAddress constructs a MetadataAddress using the provided type code and the raw bytes of the
 // base64 decoded hash, limited appropriately by the desired typeCode.
 // The resulting Address is not guaranteed to contain valid UUIDS or name hashes.
 func ConvertHashToAddress(typeCode []byte, hash string) (MetadataAddress, error) {
+	return convertHashToAddress(typeCode, hash, false)
+}
+
+// ConvertHashToAddressStrict constructs a MetadataAddress using the provided type code and the raw bytes of the
+// decoded hash. The hash can be either hex or base64 encoded. If it is valid hex, it is decoded as hex.
+// Unlike ConvertHashToAddress, an error is returned if the decoded hash is longer than the typeCode requires.
+// The resulting Address is not guaranteed to contain valid UUIDS or name hashes.
+func ConvertHashToAddressStrict(typeCode []byte, hash string) (MetadataAddress, error) {
+	return convertHashToAddress(typeCode, hash, true)
+}
+
+// convertHashToAddress constructs a MetadataAddress using the provided type code and decoded hash.
+// If strict, the hash can be hex or base64 encoded and must have exactly the required length.
+// Otherwise, the hash must be base64 encoded and is truncated to the required length.
+func convertHashToAddress(typeCode []byte, hash string, strict bool) (MetadataAddress, error) {
 	var addr MetadataAddress
 	var err error
 	if len(typeCode) == 0 {
@@ -147,15 +316,33 @@ func ConvertHashToAddress(typeCode []byte, hash string) (MetadataAddress, error)
 		return addr, fmt.Errorf("invalid address type code 0x%X", typeCode)
 	}
 	var raw []byte
-	raw, err = base64.StdEncoding.DecodeString(hash)
-	if err != nil {
+	if !strict {
+		raw, err = base64.StdEncoding.DecodeString(hash)
+		if err != nil {
+			return addr, err
+		}
+		if len(raw) < reqLen {
+			return addr, fmt.Errorf("invalid hash \"%s\" byte length, expected at least %d bytes, found %d",
+				hash, reqLen, len(raw))
+		}
+		err = addr.Unmarshal(append([]byte{typeCode[0]}, raw[0:reqLen]...))
 		return addr, err
 	}
-	if len(raw) < reqLen {
-		return addr, fmt.Errorf("invalid hash \"%s\" byte length, expected at least %d bytes, found %d",
-			hash, reqLen, len(raw))
+
+	encoding := "hex"
+	raw, err = hex.DecodeString(hash)
+	if err != nil {
+		encoding = "base64"
+		raw, err = base64.StdEncoding.DecodeString(hash)
+		if err != nil {
+			return addr, fmt.Errorf("invalid hash \"%s\": not hex or base64 encoded: %w", hash, err)
+		}
 	}
-	err = addr.Unmarshal(append([]byte{typeCode[0]}, raw[0:reqLen]...))
+	if len(raw) != reqLen {
+		return addr, fmt.Errorf("invalid %s hash \"%s\" byte length, expected exactly %d bytes, found %d",
+			encoding, hash, reqLen, len(raw))
+	}
+	err = addr.Unmarshal(append([]byte{typeCode[0]}, raw...))
 	return addr, err
 }
 
@@ -163,10 +350,13 @@ func ConvertHashToAddress(typeCode []byte, hash string) (MetadataAddress, error)
 // only performs basic HEX decoding checks.  This method matches the sdk.AccAddress approach
 func MetadataAddressFromHex(address string) (MetadataAddress, error) {
 	if len(address) == 0 {
-		return MetadataAddress{}, errors.New("address decode failed: must provide an address")
+		return MetadataAddress{}, newAddressError(ErrAddressParse, errors.New("address decode failed: must provide an address"))
 	}
 	bz, err := hex.DecodeString(address)
-	return MetadataAddress(bz), err
+	if err != nil {
+		return MetadataAddress(bz), newAddressError(ErrAddressParse, err)
+	}
+	return MetadataAddress(bz), nil
 }
 
 // ParseMetadataAddressFromBech32 creates a MetadataAddress from a Bech32 string.
@@ -176,19 +366,22 @@ func MetadataAddressFromHex(address string) (MetadataAddress, error) {
 // If you don't need the HRP, use MetadataAddressFromBech32.
 func ParseMetadataAddressFromBech32(address string) (MetadataAddress, string, error) {
 	if len(strings.TrimSpace(address)) == 0 {
-		return MetadataAddress{}, "", errors.New("empty address string is not allowed")
+		return MetadataAddress{}, "", newAddressError(ErrAddressParse, errors.New("empty address string is not allowed"))
 	}
 
 	hrp, bz, err := bech32.DecodeAndConvert(address)
 	if err != nil {
-		return nil, "", err
+		if isBech32m(address) {
+			err = fmt.Errorf("address %q appears to be bech32m-encoded, but metadata addresses use classic bech32 encoding", address)
+		}
+		return nil, "", newAddressError(ErrAddressParse, err)
 	}
 	expectedHrp, err := VerifyMetadataAddressFormat(bz)
 	if err != nil {
 		return nil, "", err
 	}
 	if expectedHrp != hrp {
-		return MetadataAddress{}, "", fmt.Errorf("invalid bech32 prefix; expected %s, got %s", expectedHrp, hrp)
+		return MetadataAddress{}, "", newAddressError(ErrAddressWrongType, fmt.Errorf("invalid bech32 prefix; expected %s, got %s", expectedHrp, hrp))
 	}
 
 	return bz, hrp, nil
@@ -203,10 +396,85 @@ func MetadataAddressFromBech32(address string) (addr MetadataAddress, err error)
 	return bz, err
 }
 
+const (
+	// bech32Charset is the character set used for the data part of bech32 (and bech32m) strings.
+	bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	// bech32mChecksumConst is the checksum polymod result of a valid bech32m string (see BIP-350).
+	// A valid (classic) bech32 string has a polymod result of 1.
+	bech32mChecksumConst = 0x2bc830a3
+)
+
+// isBech32m returns true if the provided string has a valid bech32m checksum.
+// Metadata addresses are never bech32m-encoded; this is only used to provide a more helpful error.
+func isBech32m(str string) bool {
+	str = strings.ToLower(str)
+	one := strings.LastIndexByte(str, '1')
+	if one < 1 || one+7 > len(str) {
+		return false
+	}
+	values := bech32HRPExpand(str[:one])
+	for _, c := range str[one+1:] {
+		v := strings.IndexRune(bech32Charset, c)
+		if v < 0 {
+			return false
+		}
+		values = append(values, v)
+	}
+	return bech32Polymod(values) == bech32mChecksumConst
+}
+
+// bech32HRPExpand expands the human-readable part of a bech32 string into the values used in checksum calculation.
+func bech32HRPExpand(hrp string) []int {
+	rv := make([]int, 0, len(hrp)*2+1)
+	for _, c := range hrp {
+		rv = append(rv, int(c>>5))
+	}
+	rv = append(rv, 0)
+	for _, c := range hrp {
+		rv = append(rv, int(c&31))
+	}
+	return rv
+}
+
+// bech32Polymod computes the BCH checksum polymod of the provided bech32 values.
+func bech32Polymod(values []int) int {
+	gen := [5]int{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := 1
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ v
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
+
+// IsMetadataDenom returns true if the provided denom is for a MetadataAddress, i.e. it has a registered
+// metadata denom prefix (e.g. "nft/"). It does not check that the rest of the denom is a valid MetadataAddress.
+func IsMetadataDenom(denom string) bool {
+	_, ok := TrimMetadataDenomPrefix(denom)
+	return ok
+}
+
+// TrimMetadataDenomPrefix returns the provided denom without its metadata denom prefix, and whether it had one.
+// If the denom doesn't have a registered metadata denom prefix, it's returned unchanged along with false.
+func TrimMetadataDenomPrefix(denom string) (string, bool) {
+	for _, prefix := range metadataDenomPrefixes {
+		if id, found := strings.CutPrefix(denom, prefix); found {
+			return id, true
+		}
+	}
+	return denom, false
+}
+
 // MetadataAddressFromDenom gets the MetadataAddress that the provided denom is for.
+// The denom can have any of the registered metadata denom prefixes (see MetadataDenomPrefixes).
 func MetadataAddressFromDenom(denom string) (MetadataAddress, error) {
-	id := strings.TrimPrefix(denom, DenomPrefix)
-	if id == denom {
+	id, hasPrefix := TrimMetadataDenomPrefix(denom)
+	if !hasPrefix {
 		return nil, fmt.Errorf("denom %q is not a MetadataAddress denom", denom)
 	}
 	rv, err := MetadataAddressFromBech32(id)
@@ -216,6 +484,107 @@ func MetadataAddressFromDenom(denom string) (MetadataAddress, error) {
 	return rv, nil
 }
 
+// ParseRichDenom gets the MetadataAddress that the provided (possibly hierarchical) denom is for.
+// The denom can have any of these forms:
+//   - "nft/<bech32>": The same as MetadataAddressFromDenom.
+//   - "nft/<scope-bech32>/record/<name>": The address of the record with the provided name in the scope.
+//   - "nft/<scope-bech32>/session/<session-uuid>": The address of the session with the provided uuid in the scope.
+func ParseRichDenom(denom string) (MetadataAddress, error) {
+	id, hasPrefix := TrimMetadataDenomPrefix(denom)
+	if !hasPrefix {
+		return nil, fmt.Errorf("denom %q is not a MetadataAddress denom", denom)
+	}
+	base, rest, isRich := strings.Cut(id, "/")
+	if !isRich {
+		return MetadataAddressFromDenom(denom)
+	}
+
+	baseAddr, err := MetadataAddressFromBech32(base)
+	if err != nil {
+		return nil, fmt.Errorf("invalid metadata address in denom %q: %w", denom, err)
+	}
+	segment, value, _ := strings.Cut(rest, "/")
+	switch segment {
+	case RichDenomRecordSegment, RichDenomSessionSegment:
+	default:
+		return nil, fmt.Errorf("invalid segment %q in denom %q: expected %q or %q",
+			segment, denom, RichDenomRecordSegment, RichDenomSessionSegment)
+	}
+	if !baseAddr.IsScopeAddress() {
+		prefix, _ := baseAddr.Prefix()
+		return nil, fmt.Errorf("invalid base address in denom %q: expected a %s address with a /%s, got a %s address",
+			denom, PrefixScope, segment, prefix)
+	}
+
+	if segment == RichDenomRecordSegment {
+		if len(strings.TrimSpace(value)) == 0 {
+			return nil, fmt.Errorf("invalid denom %q: empty record name", denom)
+		}
+		return baseAddr.AsRecordAddress(value)
+	}
+
+	if len(value) == 0 {
+		return nil, fmt.Errorf("invalid denom %q: empty session uuid", denom)
+	}
+	sessionUUID, err := uuid.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session uuid %q in denom %q: %w", value, denom, err)
+	}
+	return baseAddr.AsSessionAddress(sessionUUID)
+}
+
+// MetadataAddressFromAnyString creates a MetadataAddress from a bech32 string, a hex string, or an nft/ denom.
+// Leading and trailing whitespace is ignored, and hex strings can have upper or lower case letters.
+// The formats are tried in that order, and the first one that yields a valid metadata address is returned.
+// If none of them work, the returned error has the reason each one failed.
+func MetadataAddressFromAnyString(str string) (MetadataAddress, error) {
+	str = strings.TrimSpace(str)
+	if len(str) == 0 {
+		return nil, newAddressError(ErrAddressParse, errors.New("empty address string is not allowed"))
+	}
+
+	rv, bech32Err := MetadataAddressFromBech32(str)
+	if bech32Err == nil {
+		return rv, nil
+	}
+
+	// MetadataAddressFromHex doesn't check the format, so we do that here.
+	rv, hexErr := MetadataAddressFromHex(str)
+	if hexErr == nil {
+		if _, hexErr = VerifyMetadataAddressFormat(rv); hexErr == nil {
+			return rv, nil
+		}
+	}
+
+	rv, denomErr := MetadataAddressFromDenom(str)
+	if denomErr == nil {
+		return rv, nil
+	}
+
+	return nil, newAddressError(ErrAddressParse, fmt.Errorf("could not parse %q as a metadata address: "+
+		"as bech32: %v; as hex: %v; as denom: %v", str, bech32Err, hexErr, denomErr))
+}
+
+// UnverifiedMetadataAddressFromString decodes a bech32 string (with any hrp), a hex string, or an nft/ denom into a
+// MetadataAddress without checking that the result is a properly formatted metadata address.
+// This is useful for breaking down malformed addresses. Nil is returned if the string cannot be decoded.
+func UnverifiedMetadataAddressFromString(str string) MetadataAddress {
+	str = strings.TrimSpace(str)
+	if id, hasPrefix := TrimMetadataDenomPrefix(str); hasPrefix {
+		str = id
+	}
+	if len(str) == 0 {
+		return nil
+	}
+	if _, bz, err := bech32.DecodeAndConvert(str); err == nil && len(bz) > 0 {
+		return bz
+	}
+	if bz, err := hex.DecodeString(str); err == nil && len(bz) > 0 {
+		return bz
+	}
+	return nil
+}
+
 // ScopeMetadataAddress creates a MetadataAddress instance for the given scope by its uuid
 func ScopeMetadataAddress(scopeUUID uuid.UUID) MetadataAddress {
 	bz, err := scopeUUID.MarshalBinary()
@@ -252,8 +621,7 @@ func RecordMetadataAddress(scopeUUID uuid.UUID, name string) MetadataAddress {
 	if len(name) < 1 {
 		panic("missing name value for record metadata address")
 	}
-	nameBytes := sha256.Sum256([]byte(name))
-	return append(addr, nameBytes[0:16]...)
+	return append(addr, RecordNameHash(name)...)
 }
 
 // ScopeSpecMetadataAddress creates a MetadataAddress instance for a scope specification
@@ -274,6 +642,70 @@ func ContractSpecMetadataAddress(specUUID uuid.UUID) MetadataAddress {
 	return append(ContractSpecificationKeyPrefix, bz...)
 }
 
+// ScopeSpecMetadataAddressFromName creates a MetadataAddress instance for a scope specification
+// whose uuid is deterministically derived from the provided name (see SpecUUIDFromName).
+func ScopeSpecMetadataAddressFromName(name string) (MetadataAddress, error) {
+	specUUID, err := SpecUUIDFromName(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scope spec name: %w", err)
+	}
+	return ScopeSpecMetadataAddress(specUUID), nil
+}
+
+// ContractSpecMetadataAddressFromName creates a MetadataAddress instance for a contract specification
+// whose uuid is deterministically derived from the provided name (see SpecUUIDFromName).
+func ContractSpecMetadataAddressFromName(name string) (MetadataAddress, error) {
+	specUUID, err := SpecUUIDFromName(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contract spec name: %w", err)
+	}
+	return ContractSpecMetadataAddress(specUUID), nil
+}
+
+// SpecUUIDFromName returns a uuid deterministically derived from the provided name.
+// The name is lower-cased and trimmed, then the first 16 bytes of its sha256 are used as the uuid.
+// This is the same way a record name hash is created (see RecordNameHash).
+func SpecUUIDFromName(name string) (uuid.UUID, error) {
+	if len(strings.TrimSpace(name)) == 0 {
+		return uuid.Nil, errors.New("name cannot be empty")
+	}
+	return uuid.FromBytes(RecordNameHash(name))
+}
+
+// SeedNamespace is the namespace uuid used to derive metadata uuids from seeds (see UUIDFromSeed).
+// It is the version 5 uuid of the url "https://provenance.io/metadata/seed" in the standard url namespace.
+var SeedNamespace = uuid.MustParse("d84c273e-1f80-5a58-91a1-2d1022d121a6")
+
+// UUIDFromSeed returns the version 5 (sha1) uuid of the provided seed in the provided namespace.
+// The same namespace and seed will always result in the same uuid.
+func UUIDFromSeed(namespace uuid.UUID, seed string) uuid.UUID {
+	return uuid.NewSHA1(namespace, []byte(seed))
+}
+
+// ScopeMetadataAddressFromSeed creates a MetadataAddress instance for a scope
+// whose uuid is deterministically derived from the provided seed (using SeedNamespace).
+func ScopeMetadataAddressFromSeed(seed string) MetadataAddress {
+	return ScopeMetadataAddress(UUIDFromSeed(SeedNamespace, seed))
+}
+
+// SessionMetadataAddressFromSeed creates a MetadataAddress instance for a session within a scope
+// whose session uuid is deterministically derived from the provided seed (using SeedNamespace).
+func SessionMetadataAddressFromSeed(scopeUUID uuid.UUID, seed string) MetadataAddress {
+	return SessionMetadataAddress(scopeUUID, UUIDFromSeed(SeedNamespace, seed))
+}
+
+// ScopeSpecMetadataAddressFromSeed creates a MetadataAddress instance for a scope specification
+// whose uuid is deterministically derived from the provided seed (using SeedNamespace).
+func ScopeSpecMetadataAddressFromSeed(seed string) MetadataAddress {
+	return ScopeSpecMetadataAddress(UUIDFromSeed(SeedNamespace, seed))
+}
+
+// ContractSpecMetadataAddressFromSeed creates a MetadataAddress instance for a contract specification
+// whose uuid is deterministically derived from the provided seed (using SeedNamespace).
+func ContractSpecMetadataAddressFromSeed(seed string) MetadataAddress {
+	return ContractSpecMetadataAddress(UUIDFromSeed(SeedNamespace, seed))
+}
+
 // RecordSpecMetadataAddress creates a MetadataAddress instance for a record specification
 func RecordSpecMetadataAddress(contractSpecUUID uuid.UUID, name string) MetadataAddress {
 	bz, err := contractSpecUUID.MarshalBinary()
@@ -286,8 +718,84 @@ func RecordSpecMetadataAddress(contractSpecUUID uuid.UUID, name string) Metadata
 	if len(name) < 1 {
 		panic("missing name value for record spec metadata address")
 	}
-	nameBytes := sha256.Sum256([]byte(name))
-	return append(addr, nameBytes[0:16]...)
+	return append(addr, RecordNameHash(name)...)
+}
+
+// UUIDSource is used to generate the uuids for NewScopeID, NewSessionID, and NewRandomRecordSpecID.
+// It can be replaced (e.g. in unit tests) to control the generated uuids.
+var UUIDSource = uuid.New
+
+// NewScopeID creates a MetadataAddress for a scope with a new uuid (from UUIDSource).
+// The scope uuid is also returned.
+func NewScopeID() (MetadataAddress, uuid.UUID) {
+	scopeUUID := UUIDSource()
+	return ScopeMetadataAddress(scopeUUID), scopeUUID
+}
+
+// NewSessionID creates a MetadataAddress for a session with a new uuid (from UUIDSource) in the scope
+// of the provided id, which can be a scope, session, or record id. The new session uuid is also returned.
+func NewSessionID(scopeID MetadataAddress) (MetadataAddress, uuid.UUID, error) {
+	if err := scopeID.Validate(); err != nil {
+		return nil, uuid.UUID{}, err
+	}
+	scopeUUID, err := scopeID.ScopeUUID()
+	if err != nil {
+		return nil, uuid.UUID{}, err
+	}
+	sessionUUID := UUIDSource()
+	return SessionMetadataAddress(scopeUUID, sessionUUID), sessionUUID, nil
+}
+
+// NewRandomRecordSpecID creates a MetadataAddress for a record specification with the provided name in the
+// contract specification of the provided id, which can be a contract specification or record specification id.
+// If the provided id is empty, a new contract specification uuid (from UUIDSource) is used.
+func NewRandomRecordSpecID(contractSpecID MetadataAddress, name string) (MetadataAddress, error) {
+	if len(strings.TrimSpace(name)) == 0 {
+		return nil, errors.New("missing name value for record spec metadata address")
+	}
+	if contractSpecID.Empty() {
+		return RecordSpecMetadataAddress(UUIDSource(), name), nil
+	}
+	if err := contractSpecID.Validate(); err != nil {
+		return nil, err
+	}
+	contractSpecUUID, err := contractSpecID.ContractSpecUUID()
+	if err != nil {
+		return nil, err
+	}
+	return RecordSpecMetadataAddress(contractSpecUUID, name), nil
+}
+
+// RecordNameHash returns the name hash used in record and record specification addresses for the provided name.
+// The name is lower-cased and trimmed before being hashed, and only the first 16 bytes of the sha256 are used.
+func RecordNameHash(name string) []byte {
+	nameBytes := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(name))))
+	return nameBytes[0:16]
+}
+
+// ParseRecordNameHash decodes a hex or base64 encoded record name hash.
+// Either the 16-byte hash used in metadata addresses or the full 32-byte sha256 hash can be provided;
+// the returned value is always the 16-byte version.
+func ParseRecordNameHash(hash string) ([]byte, error) {
+	hash = strings.TrimSpace(hash)
+	if len(hash) == 0 {
+		return nil, errors.New("record name hash cannot be empty")
+	}
+
+	decoders := []func(string) ([]byte, error){
+		hex.DecodeString,
+		base64.StdEncoding.DecodeString,
+		base64.RawStdEncoding.DecodeString,
+		base64.URLEncoding.DecodeString,
+		base64.RawURLEncoding.DecodeString,
+	}
+	for _, decode := range decoders {
+		bz, err := decode(hash)
+		if err == nil && (len(bz) == 16 || len(bz) == sha256.Size) {
+			return bz[:16], nil
+		}
+	}
+	return nil, fmt.Errorf("invalid record name hash %q: must be the hex or base64 encoding of 16 or 32 bytes", hash)
 }
 
 // Equals determines if the current MetadataAddress is equal to another sdk.Address
@@ -298,14 +806,16 @@ func (ma MetadataAddress) Equals(ma2 sdk.Address) bool {
 	return bytes.Equal(ma.Bytes(), ma2.Bytes())
 }
 
-// Empty returns true if the MetadataAddress is uninitialized
-func (ma MetadataAddress) Empty() bool {
-	if ma == nil {
-		return true
-	}
+// IdenticalTo returns true if this MetadataAddress has exactly the same bytes as the other one.
+// Unlike Equals, a nil MetadataAddress is not identical to an empty (non-nil) one.
+func (ma MetadataAddress) IdenticalTo(other MetadataAddress) bool {
+	return (ma == nil) == (other == nil) && bytes.Equal(ma, other)
+}
 
-	ma2 := MetadataAddress{}
-	return bytes.Equal(ma.Bytes(), ma2.Bytes())
+// Empty returns true if the MetadataAddress is uninitialized, i.e. it is nil or has no bytes.
+// Nil and empty are both considered Empty; use IdenticalTo to tell them apart.
+func (ma MetadataAddress) Empty() bool {
+	return len(ma) == 0
 }
 
 // Validate determines if the contained bytes form a valid MetadataAddress according to its type
@@ -319,9 +829,17 @@ func (ma MetadataAddress) Marshal() ([]byte, error) {
 	return ma, nil
 }
 
+// StrictMetadataAddressUnmarshal controls whether Unmarshal uses UnmarshalStrict.
+// It is false by default, but is enabled when the metadata module registers its interfaces.
+var StrictMetadataAddressUnmarshal = false
+
 // Unmarshal initializes a MetadataAddress instance using the given bytes.  An error will be returned if the
-// given bytes do not form a valid Address
+// given bytes do not form a valid Address. Unless StrictMetadataAddressUnmarshal is enabled, the
+// bytes are kept even if they are invalid.
 func (ma *MetadataAddress) Unmarshal(data []byte) error {
+	if StrictMetadataAddressUnmarshal {
+		return ma.UnmarshalStrict(data)
+	}
 	*ma = data
 	if len(data) == 0 {
 		return nil
@@ -330,6 +848,21 @@ func (ma *MetadataAddress) Unmarshal(data []byte) error {
 	return err
 }
 
+// UnmarshalStrict initializes a MetadataAddress instance using the given bytes. If the given bytes
+// do not form a valid Address, an error is returned and this MetadataAddress is left empty.
+func (ma *MetadataAddress) UnmarshalStrict(data []byte) error {
+	if len(data) == 0 {
+		*ma = MetadataAddress{}
+		return nil
+	}
+	if _, err := VerifyMetadataAddressFormat(data); err != nil {
+		*ma = MetadataAddress{}
+		return fmt.Errorf("could not unmarshal metadata address from %d bytes: %w", len(data), err)
+	}
+	*ma = data
+	return nil
+}
+
 // MarshalJSON returns a JSON representation for the current address using a bech32 encoded string
 func (ma MetadataAddress) MarshalJSON() ([]byte, error) {
 	return json.Marshal(ma.String())
@@ -355,7 +888,7 @@ func (ma *MetadataAddress) UnmarshalJSON(data []byte) error {
 
 	ma2, err := MetadataAddressFromBech32(s)
 	if err != nil {
-		return err
+		return newUnmarshalAddressError(s, err)
 	}
 
 	*ma = ma2
@@ -376,13 +909,22 @@ func (ma *MetadataAddress) UnmarshalYAML(data []byte) error {
 
 	ma2, err := MetadataAddressFromBech32(s)
 	if err != nil {
-		return err
+		return newUnmarshalAddressError(s, err)
 	}
 
 	*ma = ma2
 	return nil
 }
 
+// newUnmarshalAddressError wraps an error encountered while parsing the provided bech32 string.
+// If the string can be decoded, the address is identified using DebugString so that the problem bytes are visible.
+func newUnmarshalAddressError(str string, err error) error {
+	if _, bz, decErr := bech32.DecodeAndConvert(str); decErr == nil {
+		return fmt.Errorf("could not unmarshal metadata address %q (%s): %w", str, MetadataAddress(bz).DebugString(), err)
+	}
+	return fmt.Errorf("could not unmarshal metadata address %q: %w", str, err)
+}
+
 // Bytes implements Address interface, returns the raw bytes for this Address
 func (ma MetadataAddress) Bytes() []byte {
 	return ma
@@ -409,6 +951,40 @@ func (ma MetadataAddress) String() string {
 	return bech32Addr
 }
 
+// MaxRenderedExcessBytes is the maximum number of excess bytes (i.e. bytes beyond the expected length of the address)
+// that are rendered in the GetDetails hex and base64 strings and in the %#v format of a MetadataAddress.
+// Any more than that are left out and noted with a suffix, e.g. "…(+992 bytes)".
+const MaxRenderedExcessBytes = 32
+
+// debugStringMaxHexBytes is the maximum number of bytes that DebugString will include in the hex of an invalid address.
+const debugStringMaxHexBytes = 20
+
+// DebugString returns a string of this address that is safe for use in error and log messages.
+// A valid address is returned as its bech32 string (same as String()).
+// A nil address is "MetadataAddress(nil)" and an empty one is "MetadataAddress{}" so that they're still visible in a message.
+// An invalid address is returned as a single line describing its bytes, e.g. "invalid[prefix=0x05 len=20 hex=05deadbeef...]".
+// If there are more than 20 bytes, only the first 20 are included in the hex, followed by "...".
+func (ma MetadataAddress) DebugString() string {
+	if ma == nil {
+		return "MetadataAddress(nil)"
+	}
+	if len(ma) == 0 {
+		return "MetadataAddress{}"
+	}
+
+	if hrp, err := VerifyMetadataAddressFormat(ma); err == nil {
+		if bech32Addr, err := bech32.ConvertAndEncode(hrp, ma.Bytes()); err == nil {
+			return bech32Addr
+		}
+	}
+
+	hexStr := hex.EncodeToString(ma[:min(len(ma), debugStringMaxHexBytes)])
+	if len(ma) > debugStringMaxHexBytes {
+		hexStr += "..."
+	}
+	return fmt.Sprintf("invalid[prefix=0x%02x len=%d hex=%s]", ma[0], len(ma), hexStr)
+}
+
 // Size implements gogoproto custom type interface and returns the number of bytes in this instance
 func (ma MetadataAddress) Size() int {
 	return len(ma)
@@ -428,6 +1004,11 @@ func (ma MetadataAddress) Compare(other MetadataAddress) int {
 	return bytes.Compare(ma[0:], other[0:])
 }
 
+// Less returns true if this MetadataAddress comes before the other when sorted by raw bytes.
+func (ma MetadataAddress) Less(other MetadataAddress) bool {
+	return ma.Compare(other) < 0
+}
+
 // ScopeUUID returns the scope uuid component of a MetadataAddress (if appropriate)
 func (ma MetadataAddress) ScopeUUID() (uuid.UUID, error) {
 	if !ma.isTypeOneOf(ScopeKeyPrefix, SessionKeyPrefix, RecordKeyPrefix) {
@@ -477,51 +1058,94 @@ func (ma MetadataAddress) Prefix() (string, error) {
 // (since that's the first part of those metadata addresses).
 func (ma MetadataAddress) PrimaryUUID() (uuid.UUID, error) {
 	if len(ma) < 1 {
-		return uuid.UUID{}, fmt.Errorf("address empty")
+		return uuid.UUID{}, errAddressEmpty()
 	}
 	// if we don't know this type
 	if !ma.isTypeOneOf(ScopeKeyPrefix, SessionKeyPrefix, RecordKeyPrefix, ScopeSpecificationKeyPrefix, ContractSpecificationKeyPrefix, RecordSpecificationKeyPrefix) {
-		return uuid.UUID{}, fmt.Errorf("invalid address type out of valid range (got: %d)", ma[0])
+		return uuid.UUID{}, errAddressTypeOutOfRange(ma[0])
 	}
 	if len(ma) < 17 {
-		return uuid.UUID{}, fmt.Errorf("incorrect address length (must be at least 17, actual: %d)", len(ma))
+		return uuid.UUID{}, errAddressTooShort(17, len(ma))
 	}
 	return uuid.FromBytes(ma[1:17])
 }
 
+// errAddressEmpty returns an ErrEmptyAddress error with the text used by the MetadataAddress part getters.
+func errAddressEmpty() error {
+	return newAddressError(ErrEmptyAddress, errors.New("address empty"))
+}
+
+// errAddressTypeOutOfRange returns an InvalidAddressTypeError with the text used by the MetadataAddress part getters.
+func errAddressTypeOutOfRange(typeByte byte) error {
+	return newAddressError(&InvalidAddressTypeError{TypeByte: typeByte},
+		fmt.Errorf("invalid address type out of valid range (got: %d)", typeByte))
+}
+
+// errAddressTooShort returns an InvalidAddressLengthError with the text used by the MetadataAddress part getters.
+func errAddressTooShort(minLen, actual int) error {
+	return newAddressError(&InvalidAddressLengthError{Expected: minLen, Actual: actual},
+		fmt.Errorf("incorrect address length (must be at least %d, actual: %d)", minLen, actual))
+}
+
+// primaryUUIDBytes returns the primary UUID bytes (i.e. bytes 2 to 17) of this MetadataAddress without copying them.
+// An error is returned if this MetadataAddress is too short to have them. The type byte is not checked.
+func (ma MetadataAddress) primaryUUIDBytes() ([]byte, error) {
+	if len(ma) < 17 {
+		return nil, errAddressTooShort(17, len(ma))
+	}
+	return ma[1:17], nil
+}
+
 // SecondaryUUID returns the secondary UUID from this MetadataAddress (if applicable).
 // More accurately, this converts bytes 18 to 33 (inclusive) to a UUID.
 func (ma MetadataAddress) SecondaryUUID() (uuid.UUID, error) {
 	if len(ma) < 1 {
-		return uuid.UUID{}, fmt.Errorf("address empty")
+		return uuid.UUID{}, errAddressEmpty()
 	}
 	// if we don't know this type
 	if !ma.isTypeOneOf(SessionKeyPrefix) {
-		return uuid.UUID{}, fmt.Errorf("invalid address type out of valid range (got: %d)", ma[0])
+		return uuid.UUID{}, errAddressTypeOutOfRange(ma[0])
 	}
 	if len(ma) < 33 {
-		return uuid.UUID{}, fmt.Errorf("incorrect address length (must be at least 33, actual: %d)", len(ma))
+		return uuid.UUID{}, errAddressTooShort(33, len(ma))
 	}
 	return uuid.FromBytes(ma[17:33])
 }
 
+// HasNameHash returns true if this MetadataAddress has a name hash (i.e. it's a record or record spec address).
+// Unlike NameHash, this does not allocate anything.
+func (ma MetadataAddress) HasNameHash() bool {
+	return len(ma) >= 33 && ma.isTypeOneOf(RecordKeyPrefix, RecordSpecificationKeyPrefix)
+}
+
 // NameHash returns the hashed name bytes from this MetadataAddress (if applicable).
 // More accurately, this returns a copy of bytes 18 through 33 (inclusive).
 func (ma MetadataAddress) NameHash() ([]byte, error) {
 	namehash := make([]byte, 16)
 	if len(ma) < 1 {
-		return namehash, fmt.Errorf("address empty")
+		return namehash, errAddressEmpty()
 	}
 	if !ma.isTypeOneOf(RecordKeyPrefix, RecordSpecificationKeyPrefix) {
-		return namehash, fmt.Errorf("invalid address type out of valid range (got: %d)", ma[0])
+		return namehash, errAddressTypeOutOfRange(ma[0])
 	}
 	if len(ma) < 33 {
-		return namehash, fmt.Errorf("incorrect address length (must be at least 33, actual: %d)", len(ma))
+		return namehash, errAddressTooShort(33, len(ma))
 	}
 	copy(namehash, ma[17:])
 	return namehash, nil
 }
 
+// MatchesName returns true if this MetadataAddress's name hash is the hash of the provided name.
+// The name is normalized the same way it is when creating a record address (i.e. trimmed and lower-cased).
+// An error is returned if this MetadataAddress does not have a name hash (e.g. it's not a record or record spec address).
+func (ma MetadataAddress) MatchesName(name string) (bool, error) {
+	nameHash, err := ma.NameHash()
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(nameHash, RecordNameHash(name)), nil
+}
+
 // AsScopeAddress returns the MetadataAddress for a scope using the scope UUID within the current context
 func (ma MetadataAddress) AsScopeAddress() (MetadataAddress, error) {
 	scopeUUID, err := ma.ScopeUUID()
@@ -633,7 +1257,11 @@ func (ma MetadataAddress) ScopeSessionIteratorPrefix() ([]byte, error) {
 	if !ma.isTypeOneOf(ScopeKeyPrefix, SessionKeyPrefix, RecordKeyPrefix) {
 		return []byte{}, fmt.Errorf("this metadata address does not contain a scope uuid")
 	}
-	return append(SessionKeyPrefix, ma[1:17]...), nil
+	scopeUUID, err := ma.primaryUUIDBytes()
+	if err != nil {
+		return []byte{}, err
+	}
+	return append(SessionKeyPrefix, scopeUUID...), nil
 }
 
 // ScopeRecordIteratorPrefix returns an iterator prefix that finds all Records assigned to the scope designated in this MetadataAddress.
@@ -649,7 +1277,11 @@ func (ma MetadataAddress) ScopeRecordIteratorPrefix() ([]byte, error) {
 	if !ma.isTypeOneOf(ScopeKeyPrefix, SessionKeyPrefix, RecordKeyPrefix) {
 		return []byte{}, fmt.Errorf("this metadata address does not contain a scope uuid")
 	}
-	return append(RecordKeyPrefix, ma[1:17]...), nil
+	scopeUUID, err := ma.primaryUUIDBytes()
+	if err != nil {
+		return []byte{}, err
+	}
+	return append(RecordKeyPrefix, scopeUUID...), nil
 }
 
 // ContractSpecRecordSpecIteratorPrefix returns an iterator prefix that finds all record specifications
@@ -668,7 +1300,54 @@ func (ma MetadataAddress) ContractSpecRecordSpecIteratorPrefix() ([]byte, error)
 	if !ma.isTypeOneOf(ContractSpecificationKeyPrefix, RecordSpecificationKeyPrefix) {
 		return []byte{}, fmt.Errorf("this metadata address does not contain a contract spec uuid")
 	}
-	return append(RecordSpecificationKeyPrefix, ma[1:17]...), nil
+	specUUID, err := ma.primaryUUIDBytes()
+	if err != nil {
+		return []byte{}, err
+	}
+	return append(RecordSpecificationKeyPrefix, specUUID...), nil
+}
+
+// ScopeSpecScopeIteratorPrefix returns an iterator prefix that finds all scope spec to scope index entries
+// for the scope specification designated in this MetadataAddress.
+// If the current address is empty this returns a prefix to iterate through all scope spec to scope index entries.
+// If the current address is a scope specification, this returns a prefix to iterate through the index entries
+// for all scopes that use that scope specification.
+// If the current address is some other type, an error is returned.
+func (ma MetadataAddress) ScopeSpecScopeIteratorPrefix() ([]byte, error) {
+	if len(ma) < 1 {
+		return ScopeSpecScopeCacheKeyPrefix, nil
+	}
+	// if we don't know this type
+	if !ma.isTypeOneOf(ScopeSpecificationKeyPrefix) {
+		return []byte{}, fmt.Errorf("this metadata address does not contain a scope spec uuid")
+	}
+	if _, err := ma.primaryUUIDBytes(); err != nil {
+		return []byte{}, err
+	}
+	return GetScopeSpecScopeCacheIteratorPrefix(ma[0:17]), nil
+}
+
+// ContractSpecSessionIteratorPrefix returns an iterator prefix that finds all contract spec to session index entries
+// for the contract specification designated in this MetadataAddress.
+// If the current address is empty this returns a prefix to iterate through all contract spec to session index entries.
+// If the current address is a contract specification, this returns a prefix to iterate through the index entries
+// for all sessions that use that contract specification.
+// If the current address is a record specification, this returns a prefix to iterate through the index entries
+// for all sessions that use the contract specification that contains this record specification.
+// If the current address is some other type, an error is returned.
+func (ma MetadataAddress) ContractSpecSessionIteratorPrefix() ([]byte, error) {
+	if len(ma) < 1 {
+		return ContractSpecSessionCacheKeyPrefix, nil
+	}
+	// if we don't know this type
+	if !ma.isTypeOneOf(ContractSpecificationKeyPrefix, RecordSpecificationKeyPrefix) {
+		return []byte{}, fmt.Errorf("this metadata address does not contain a contract spec uuid")
+	}
+	specUUID, err := ma.primaryUUIDBytes()
+	if err != nil {
+		return []byte{}, err
+	}
+	return GetContractSpecSessionCacheIteratorPrefix(append(ContractSpecificationKeyPrefix, specUUID...)), nil
 }
 
 // Format implements fmt.Formatter interface for a MetadataAddress.
@@ -681,12 +1360,25 @@ func (ma MetadataAddress) Format(s fmt.State, verb rune) {
 		if s.Flag('#') {
 			// We can't provide the same MetadataAddress arg back to Sprintf here (infinite recursion).
 			// So we cast it as a byte slice, string that, then change the "[]byte" part to "MetadataAddress".
-			out = fmt.Sprintf(fmt.FormatString(s, verb), []byte(ma))
+			// Only the first MaxRenderedExcessBytes excess bytes are included; the rest are noted with a suffix.
+			end := len(ma)
+			if len(ma) > 0 {
+				end = min(len(ma), ma.excessStart()+MaxRenderedExcessBytes)
+			}
+			out = fmt.Sprintf(fmt.FormatString(s, verb), []byte(ma[:end]))
 			out = "MetadataAddress" + strings.TrimPrefix(out, "[]byte")
+			if end < len(ma) {
+				out += excessSuffix(len(ma) - end)
+			}
 		} else {
 			// The auto-generated gogoproto.stringer methods use "%v" for the MetadataAddress fields.
 			// So here, we return the bech32 for "%v" so that MetadataAddress fields look right in those strings.
-			out = fmt.Sprintf(fmt.FormatString(s, verb), ma.String())
+			// Invalid addresses use DebugString so that they're a single line (e.g. in event attributes).
+			str := ma.String()
+			if !ma.Empty() {
+				str = ma.DebugString()
+			}
+			out = fmt.Sprintf(fmt.FormatString(s, verb), str)
 		}
 	default:
 		// The other verbs (e.g. c b o O d x X U) should behave just like if this were a []byte.
@@ -784,9 +1476,14 @@ type MetadataAddressDetails struct {
 	NameHashHex string
 	// NameHashBase64 is the base64 string encoded version of NameHashBase64. E.g. "eH7Hbcr9IMGQjrCTahL5Hg=="
 	NameHashBase64 string
+	// MatchedName is the candidate name that hashes to AddressNameHash. E.g. "recordname"
+	// It is only populated by GetDetailsWithNames, and only if one of the candidates matches.
+	MatchedName string
 	// ExcessHex is the hex string encoded version of AddressExcess. E.g. "6578747261"
+	// Only the first MaxRenderedExcessBytes bytes are encoded, followed by a suffix if there are more, e.g. "…(+992 bytes)".
 	ExcessHex string
 	// ExcessBase64 is the base64 string encoded version of AddressExcess. E.g. "ZXh0cmE="
+	// Only the first MaxRenderedExcessBytes bytes are encoded, followed by a suffix if there are more, e.g. "…(+992 bytes)".
 	ExcessBase64 string
 	// ParentAddress is the MetadataAddress of the parent structure.
 	// I.e. for session and record addresses, this will be a scope address.
@@ -802,15 +1499,17 @@ func (ma MetadataAddress) GetDetails() MetadataAddressDetails {
 	copy(addr, ma)
 
 	retval := MetadataAddressDetails{Address: addr}
+	// The helpers that return errors (e.g. SecondaryUUID or AsScopeAddress) include the address string in
+	// those errors, which is relatively expensive. So the type byte and length are checked directly here instead.
+	hrp, hrpErr := VerifyMetadataAddressFormat(addr)
 	// Set the prefix info if we've got anything at all.
 	if len(addr) >= 1 {
 		retval.AddressPrefix = addr[0:1]
-		prefix, err := addr.Prefix()
-		if err != nil {
-			// If there's an error, convert the prefix bytes to hex
-			prefix = hex.EncodeToString(retval.AddressPrefix)
+		retval.Prefix = hrp
+		if len(hrp) == 0 {
+			// If the type is unknown, convert the prefix bytes to hex
+			retval.Prefix = hex.EncodeToString(retval.AddressPrefix)
 		}
-		retval.Prefix = prefix
 	}
 	// Every type has a primary uuid as the 16 bytes after the prefix.
 	// So if those exist, get set the primary uuid info.
@@ -823,37 +1522,75 @@ func (ma MetadataAddress) GetDetails() MetadataAddressDetails {
 		retval.PrimaryUUID = uid.String()
 	}
 	// Secondary UUIDs or only for some types. Check if we've got one and set it accordingly.
-	secondaryUUID, secondaryUUIDErr := addr.SecondaryUUID()
-	if secondaryUUIDErr == nil {
-		retval.AddressSecondaryUUID = secondaryUUID[:]
+	hasSecondaryUUID := len(addr) >= 33 && addr.isTypeOneOf(SessionKeyPrefix)
+	if hasSecondaryUUID {
+		retval.AddressSecondaryUUID = addr[17:33]
+		secondaryUUID, _ := uuid.FromBytes(retval.AddressSecondaryUUID)
 		retval.SecondaryUUID = secondaryUUID.String()
 	}
 	// Hashed names are only for some types. Check if we've got one and set it accordingly.
-	nameHash, nameHashErr := addr.NameHash()
-	if nameHashErr == nil {
-		retval.AddressNameHash = nameHash
+	hasNameHash := addr.HasNameHash()
+	if hasNameHash {
+		retval.AddressNameHash = addr[17:33]
 		retval.NameHashHex = hex.EncodeToString(retval.AddressNameHash)
 		retval.NameHashBase64 = base64.StdEncoding.EncodeToString(retval.AddressNameHash)
 	}
-	// Check for any excess bytes
-	expectedLength := 17 // 1 + 16 = prefix byte + primary UUID.
-	if secondaryUUIDErr == nil || nameHashErr == nil {
-		expectedLength += 16 // 16 = the secondary UUID length = the name hash length.
-	}
+	// Check for any excess bytes. The raw excess is always complete, but the strings are capped.
+	expectedLength := addr.excessStart()
 	if len(addr) > expectedLength {
 		retval.AddressExcess = addr[expectedLength:]
-		retval.ExcessHex = hex.EncodeToString(retval.AddressExcess)
-		retval.ExcessBase64 = base64.StdEncoding.EncodeToString(retval.AddressExcess)
+		rendered, suffix := truncateExcess(retval.AddressExcess)
+		retval.ExcessHex = hex.EncodeToString(rendered) + suffix
+		retval.ExcessBase64 = base64.StdEncoding.EncodeToString(rendered) + suffix
 	}
 	// And set the parent if we can.
-	if !addr.IsScopeAddress() {
-		if pAddr, err := addr.AsScopeAddress(); err == nil {
-			retval.ParentAddress = pAddr
+	if len(addr) >= 17 {
+		isValid := hrpErr == nil
+		if !(isValid && hrp == PrefixScope) && addr.isTypeOneOf(ScopeKeyPrefix, SessionKeyPrefix, RecordKeyPrefix) {
+			retval.ParentAddress = ScopeMetadataAddress(uuid.UUID(addr[1:17]))
+		}
+		if !(isValid && hrp == PrefixContractSpecification) && addr.isTypeOneOf(ContractSpecificationKeyPrefix, RecordSpecificationKeyPrefix) {
+			retval.ParentAddress = ContractSpecMetadataAddress(uuid.UUID(addr[1:17]))
 		}
 	}
-	if !addr.IsContractSpecificationAddress() {
-		if pAddr, err := addr.AsContractSpecAddress(); err == nil {
-			retval.ParentAddress = pAddr
+	return retval
+}
+
+// excessStart returns the index of the first byte in this MetadataAddress that isn't part of its expected components.
+// It's the prefix byte and primary UUID, plus a secondary UUID or name hash for types that have one (if there's room).
+func (ma MetadataAddress) excessStart() int {
+	rv := 17 // 1 + 16 = prefix byte + primary UUID.
+	if (len(ma) >= 33 && ma.isTypeOneOf(SessionKeyPrefix)) || ma.HasNameHash() {
+		rv += 16 // 16 = the secondary UUID length = the name hash length.
+	}
+	return rv
+}
+
+// truncateExcess returns the first MaxRenderedExcessBytes of the provided excess bytes to render, and
+// the suffix to append to the rendered string. The suffix is empty if nothing was left out.
+func truncateExcess(excess []byte) ([]byte, string) {
+	if len(excess) <= MaxRenderedExcessBytes {
+		return excess, ""
+	}
+	return excess[:MaxRenderedExcessBytes], excessSuffix(len(excess) - MaxRenderedExcessBytes)
+}
+
+// excessSuffix returns the string appended to rendered excess bytes to note how many were left out.
+func excessSuffix(omitted int) string {
+	return fmt.Sprintf("…(+%d bytes)", omitted)
+}
+
+// GetDetailsWithNames is the same as GetDetails, but also checks each of the provided candidate
+// names against the name hash. The first candidate that matches is set as the MatchedName.
+func (ma MetadataAddress) GetDetailsWithNames(candidates []string) MetadataAddressDetails {
+	retval := ma.GetDetails()
+	if len(retval.AddressNameHash) == 0 {
+		return retval
+	}
+	for _, name := range candidates {
+		if bytes.Equal(retval.AddressNameHash, RecordNameHash(name)) {
+			retval.MatchedName = name
+			break
 		}
 	}
 	return retval
@@ -864,6 +1601,29 @@ func (ma MetadataAddress) Denom() string {
 	return DenomPrefix + ma.String()
 }
 
+// RichDenom gets the hierarchical denom string for this MetadataAddress (see ParseRichDenom).
+// For a session, this is "nft/<scope-bech32>/session/<session-uuid>".
+// A record's name can't be recovered from its address, so for a record, this returns Denom() and true (lossy).
+// For all other types, this returns Denom() and false.
+func (ma MetadataAddress) RichDenom() (string, bool) {
+	switch {
+	case ma.IsSessionAddress():
+		scopeAddr, err := ma.AsScopeAddress()
+		if err != nil {
+			return ma.Denom(), true
+		}
+		sessionUUID, err := ma.SessionUUID()
+		if err != nil {
+			return ma.Denom(), true
+		}
+		return scopeAddr.Denom() + "/" + RichDenomSessionSegment + "/" + sessionUUID.String(), false
+	case ma.IsRecordAddress():
+		return ma.Denom(), true
+	default:
+		return ma.Denom(), false
+	}
+}
+
 // Coin creates the singular coin that represents this MetadataAddress.
 func (ma MetadataAddress) Coin() sdk.Coin {
 	return sdk.NewInt64Coin(ma.Denom(), 1)
@@ -874,6 +1634,96 @@ func (ma MetadataAddress) Coins() sdk.Coins {
 	return sdk.Coins{sdk.NewInt64Coin(ma.Denom(), 1)}
 }
 
+// NewMetadataCoins creates the Coins that represent the provided MetadataAddresses.
+//
+// An error is returned if any of the addresses are invalid. Duplicate addresses only result in a single coin.
+// The result is sorted by denom (as required by sdk.Coins), i.e. by the "nft/<bech32>" strings. That is
+// NOT necessarily the order of the address bytes: the human-readable part of each type sorts differently
+// than the type bytes (e.g. records come before scopes), and the bech32 character set isn't in alphabetical order.
+// So coins for multiple metadata addresses should be created using this instead of appending them manually.
+func NewMetadataCoins(addrs ...MetadataAddress) (sdk.Coins, error) {
+	rv := make(sdk.Coins, 0, len(addrs))
+	seen := make(map[string]bool, len(addrs))
+	for i, addr := range addrs {
+		if err := addr.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid metadata address [%d]: %w", i, err)
+		}
+		denom := addr.Denom()
+		if seen[denom] {
+			continue
+		}
+		seen[denom] = true
+		rv = append(rv, sdk.NewInt64Coin(denom, 1))
+	}
+	rv = rv.Sort()
+	if err := rv.Validate(); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// NamedRecordAddress is a record or record specification address along with the name it was made from.
+// The name can't be recovered from the address (it only has a hash of the name), so this is useful
+// when the name needs to be displayed later, e.g. when indexing.
+type NamedRecordAddress struct {
+	// Address is the record or record specification address.
+	Address MetadataAddress `json:"address"`
+	// Name is the normalized (trimmed and lower-cased) name that the Address was made from.
+	Name string `json:"name"`
+}
+
+// NewNamedRecordAddress creates a NamedRecordAddress for the record with the provided name in the scope of
+// the provided id, which can be a scope, session, or record id.
+func NewNamedRecordAddress(scopeID MetadataAddress, name string) (*NamedRecordAddress, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) == 0 {
+		return nil, errors.New("missing name value for record metadata address")
+	}
+	addr, err := scopeID.AsRecordAddress(name)
+	if err != nil {
+		return nil, err
+	}
+	return &NamedRecordAddress{Address: addr, Name: name}, nil
+}
+
+// NewNamedRecordSpecAddress creates a NamedRecordAddress for the record specification with the provided name in the
+// contract specification of the provided id, which can be a contract specification or record specification id.
+func NewNamedRecordSpecAddress(cSpecID MetadataAddress, name string) (*NamedRecordAddress, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) == 0 {
+		return nil, errors.New("missing name value for record spec metadata address")
+	}
+	addr, err := cSpecID.AsRecordSpecAddress(name)
+	if err != nil {
+		return nil, err
+	}
+	return &NamedRecordAddress{Address: addr, Name: name}, nil
+}
+
+// Validate returns an error if the Address isn't a valid record or record specification address,
+// or if the Name isn't the one that the Address was made from.
+func (n NamedRecordAddress) Validate() error {
+	if err := n.Address.Validate(); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if len(strings.TrimSpace(n.Name)) == 0 {
+		return errors.New("invalid name: cannot be empty")
+	}
+	matches, err := n.Address.MatchesName(n.Name)
+	if err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if !matches {
+		return fmt.Errorf("name %q does not match the name hash of %s", n.Name, n.Address)
+	}
+	return nil
+}
+
+// String returns a string representation of this NamedRecordAddress in the format <Address>(<Name>).
+func (n NamedRecordAddress) String() string {
+	return mdStr(n.Address) + "(" + n.Name + ")"
+}
+
 // AccMDLink associates an account address with a metadata address.
 type AccMDLink struct {
 	AccAddr sdk.AccAddress
@@ -943,48 +1793,322 @@ func (a AccMDLinks) String() string {
 	return emptyStr
 }
 
+// accMDLinkJSON is the JSON representation of an AccMDLink.
+type accMDLinkJSON struct {
+	Account         string `json:"account"`
+	MetadataAddress string `json:"metadata_address"`
+}
+
+// MarshalJSON returns a JSON object of this AccMDLink using bech32 strings,
+// e.g. {"account":"pb1...","metadata_address":"scope1..."}. Nil and empty addresses are "", and a nil AccMDLink is null.
+func (l *AccMDLink) MarshalJSON() ([]byte, error) {
+	if l == nil {
+		return []byte("null"), nil
+	}
+	var rv accMDLinkJSON
+	if len(l.AccAddr) > 0 {
+		rv.Account = l.AccAddr.String()
+	}
+	if len(l.MDAddr) > 0 {
+		rv.MetadataAddress = l.MDAddr.String()
+	}
+	return json.Marshal(rv)
+}
+
+// UnmarshalJSON sets this AccMDLink from a JSON object with bech32 "account" and "metadata_address" strings.
+// An empty string results in a nil address.
+func (l *AccMDLink) UnmarshalJSON(data []byte) error {
+	var raw accMDLinkJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	var accAddr sdk.AccAddress
+	if len(raw.Account) > 0 {
+		var err error
+		accAddr, err = sdk.AccAddressFromBech32(raw.Account)
+		if err != nil {
+			return fmt.Errorf("invalid account %q: %w", raw.Account, err)
+		}
+	}
+
+	var mdAddr MetadataAddress
+	if len(raw.MetadataAddress) > 0 {
+		var err error
+		mdAddr, err = MetadataAddressFromBech32(raw.MetadataAddress)
+		if err != nil {
+			return fmt.Errorf("invalid metadata_address %q: %w", raw.MetadataAddress, err)
+		}
+	}
+
+	l.AccAddr = accAddr
+	l.MDAddr = mdAddr
+	return nil
+}
+
+// MarshalJSON returns a JSON array of the entries in this AccMDLinks (see AccMDLink.MarshalJSON).
+// A nil AccMDLinks is null, and a nil entry is null.
+func (a AccMDLinks) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal([]*AccMDLink(a))
+}
+
+// UnmarshalJSON sets this AccMDLinks from a JSON array of AccMDLink objects (see AccMDLink.UnmarshalJSON).
+// A null entry results in a nil entry. Every problem found is included, each prefixed with the index
+// of the offending entry. If there are any problems, this AccMDLinks is not changed.
+func (a *AccMDLinks) UnmarshalJSON(data []byte) error {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return err
+	}
+	if raws == nil {
+		*a = nil
+		return nil
+	}
+
+	var errs []error
+	rv := make(AccMDLinks, len(raws))
+	for i, raw := range raws {
+		if string(raw) == "null" {
+			continue
+		}
+		link := &AccMDLink{}
+		if err := link.UnmarshalJSON(raw); err != nil {
+			errs = append(errs, fmt.Errorf("entry %d: %w", i, err))
+			continue
+		}
+		rv[i] = link
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	*a = rv
+	return nil
+}
+
+// Marshal returns a compact binary encoding of this AccMDLinks.
+//
+// The encoding is a uvarint entry count followed by each entry. Each entry is a single byte that is 0 for a nil
+// entry or 1 otherwise, followed (if not nil) by the AccAddr then the MDAddr. Each address is a uvarint length
+// followed by the address bytes. The lengths are stored plus one so that a length of 0 indicates a nil address
+// (and 1 is an empty one). A nil AccMDLinks is encoded as a count of 0 (and an empty one, a count of 1 with no entries).
+// Use Unmarshal to convert it back.
+func (a AccMDLinks) Marshal() ([]byte, error) {
+	size := binary.MaxVarintLen64
+	for _, link := range a {
+		size++
+		if link != nil {
+			size += 2*binary.MaxVarintLen64 + len(link.AccAddr) + len(link.MDAddr)
+		}
+	}
+
+	rv := make([]byte, 0, size)
+	rv = appendBinaryLen(rv, a == nil, len(a))
+	for _, link := range a {
+		if link == nil {
+			rv = append(rv, 0)
+			continue
+		}
+		rv = append(rv, 1)
+		rv = appendBinaryLen(rv, link.AccAddr == nil, len(link.AccAddr))
+		rv = append(rv, link.AccAddr...)
+		rv = appendBinaryLen(rv, link.MDAddr == nil, len(link.MDAddr))
+		rv = append(rv, link.MDAddr...)
+	}
+	return rv, nil
+}
+
+// Unmarshal sets this AccMDLinks from the binary encoding created by Marshal.
+// Nil entries and nil and empty addresses are restored as they were. If there's a problem, this AccMDLinks is not changed.
+func (a *AccMDLinks) Unmarshal(data []byte) error {
+	count, isNil, data, err := readBinaryLen(data)
+	if err != nil {
+		return fmt.Errorf("invalid entry count: %w", err)
+	}
+	if isNil {
+		if len(data) != 0 {
+			return fmt.Errorf("unexpected %d bytes after nil AccMDLinks", len(data))
+		}
+		*a = nil
+		return nil
+	}
+	// Every entry needs at least one byte, so this protects against allocating a giant slice for bad data.
+	if count > uint64(len(data)) {
+		return fmt.Errorf("entry count %d is more than the %d remaining bytes", count, len(data))
+	}
+
+	rv := make(AccMDLinks, count)
+	for i := range rv {
+		if len(data) == 0 {
+			return fmt.Errorf("entry %d: no data left", i)
+		}
+		flag := data[0]
+		data = data[1:]
+		switch flag {
+		case 0:
+			continue
+		case 1:
+		default:
+			return fmt.Errorf("entry %d: invalid entry flag %d", i, flag)
+		}
+
+		link := &AccMDLink{}
+		var addr []byte
+		addr, data, err = readBinaryBytes(data)
+		if err != nil {
+			return fmt.Errorf("entry %d: invalid AccAddr: %w", i, err)
+		}
+		link.AccAddr = addr
+		addr, data, err = readBinaryBytes(data)
+		if err != nil {
+			return fmt.Errorf("entry %d: invalid MDAddr: %w", i, err)
+		}
+		link.MDAddr = addr
+		rv[i] = link
+	}
+	if len(data) != 0 {
+		return fmt.Errorf("unexpected %d bytes after %d entries", len(data), count)
+	}
+
+	*a = rv
+	return nil
+}
+
+// appendBinaryLen appends the binary encoding of a length to the provided bytes.
+// The length is stored plus one so that zero can indicate nil.
+func appendBinaryLen(bz []byte, isNil bool, length int) []byte {
+	if isNil {
+		return binary.AppendUvarint(bz, 0)
+	}
+	return binary.AppendUvarint(bz, uint64(length)+1) //nolint:gosec // G115: A length is never negative, so it fits in a uint64.
+}
+
+// readBinaryLen reads a length (written using appendBinaryLen) from the start of the provided bytes.
+// It returns the length, whether it indicates nil, and the rest of the bytes.
+func readBinaryLen(data []byte) (uint64, bool, []byte, error) {
+	val, n := binary.Uvarint(data)
+	if n <= 0 {
+		return 0, false, data, errors.New("could not read length")
+	}
+	if val == 0 {
+		return 0, true, data[n:], nil
+	}
+	return val - 1, false, data[n:], nil
+}
+
+// readBinaryBytes reads a length-prefixed set of bytes from the start of the provided data.
+// It returns a copy of those bytes and the rest of the data.
+func readBinaryBytes(data []byte) ([]byte, []byte, error) {
+	length, isNil, data, err := readBinaryLen(data)
+	if err != nil || isNil {
+		return nil, data, err
+	}
+	if length > uint64(len(data)) {
+		return nil, data, fmt.Errorf("length %d is more than the %d remaining bytes", length, len(data))
+	}
+	rv := make([]byte, length)
+	copy(rv, data)
+	return rv, data[length:], nil
+}
+
 // ValidateForScopes returns an error in the following cases:
 //   - An entry is nil.
 //   - An entry does not have an AccAddr.
 //   - An entry does not have a MDAddr.
 //   - An MDAddr is not a valid scope id.
 //   - Any MDAddr appears more than once.
+//
+// Every problem found is included (joined using errors.Join), each prefixed with the index of the offending entry.
+// A duplicated MDAddr is only reported once, at the index of its second occurrence.
 func (a AccMDLinks) ValidateForScopes() error {
+	return a.ValidateForType(PrefixScope)
+}
+
+// ValidateForType is the same as ValidateForScopes except that each MDAddr must have the provided
+// type (e.g. PrefixScope or PrefixContractSpecification) instead of being a scope id.
+// An error is also returned if the provided type is not a known metadata address type.
+func (a AccMDLinks) ValidateForType(expHRP string) error {
+	switch expHRP {
+	case PrefixScope, PrefixSession, PrefixRecord, PrefixScopeSpecification, PrefixContractSpecification, PrefixRecordSpecification:
+	default:
+		return fmt.Errorf("unknown metadata address type %q", expHRP)
+	}
+	return a.validate(expHRP)
+}
+
+// ValidateUniformType is the same as ValidateForType except that, instead of requiring a specific type,
+// each MDAddr must have the same type as the first valid one. Returns that type (e.g. PrefixScope).
+// An empty string is returned if there are no entries or if there's an error.
+func (a AccMDLinks) ValidateUniformType() (string, error) {
+	if len(a) == 0 {
+		return "", nil
+	}
+
+	hrp := ""
+	for _, link := range a {
+		if link == nil {
+			continue
+		}
+		if prefix, err := VerifyMetadataAddressFormat(link.MDAddr); err == nil {
+			hrp = prefix
+			break
+		}
+	}
+
+	// If there isn't a valid MDAddr, there's nothing to compare the types to, so just check their format.
+	if err := a.validate(hrp); err != nil {
+		return "", err
+	}
+	return hrp, nil
+}
+
+// validate checks each entry of these links, returning every problem found (joined using errors.Join).
+// Each MDAddr must have the provided type, or if it's empty, just be a valid metadata address.
+func (a AccMDLinks) validate(expHRP string) error {
 	if len(a) == 0 {
 		return nil
 	}
 
+	var errs []error
 	seenMDAddrs := make(map[string]int8)
-	for _, link := range a {
+	for i, link := range a {
 		if link == nil {
-			return errors.New("nil entry not allowed")
+			errs = append(errs, fmt.Errorf("entry %d: nil entry not allowed", i))
+			continue
 		}
 
 		key := string(link.MDAddr)
 		switch seenMDAddrs[key] {
 		case 0:
 			seenMDAddrs[key] = 1
-			if err := link.MDAddr.ValidateIsScopeAddress(); err != nil {
-				return err
+			if len(expHRP) > 0 {
+				if err := VerifyMetadataAddressHasType(link.MDAddr, expHRP); err != nil {
+					errs = append(errs, fmt.Errorf("entry %d: %w", i, err))
+				}
+			} else if _, err := VerifyMetadataAddressFormat(link.MDAddr); err != nil {
+				errs = append(errs, fmt.Errorf("entry %d: invalid metadata address %s: %w", i, link.MDAddr.DebugString(), err))
 			}
 		case 1:
 			seenMDAddrs[key] = 2
-			return fmt.Errorf("duplicate metadata address %q not allowed", link.MDAddr)
+			errs = append(errs, fmt.Errorf("entry %d: duplicate metadata address %q not allowed", i, link.MDAddr))
 		}
 
 		if len(link.AccAddr) == 0 {
-			return fmt.Errorf("no account address associated with metadata address %q", link.MDAddr)
+			errs = append(errs, fmt.Errorf("entry %d: no account address associated with metadata address %q", i, link.MDAddr))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // GetAccAddrs returns a list of all AccAddr values from this AccMDLinks.
 // Each entry will appear only once and in the order it first appears in this list.
 // Empty and nil entries are ignored.
 func (a AccMDLinks) GetAccAddrs() []sdk.AccAddress {
-	seen := make(map[string]bool)
+	seen := make(map[string]bool, len(a))
 	var rv []sdk.AccAddress
 	for _, link := range a {
 		if link == nil || len(link.AccAddr) == 0 {
@@ -998,24 +2122,87 @@ func (a AccMDLinks) GetAccAddrs() []sdk.AccAddress {
 	return rv
 }
 
+// PrimaryUUIDsOption is an option that changes the behavior of AccMDLinks.GetPrimaryUUIDs.
+type PrimaryUUIDsOption int
+
+const (
+	// WithInvalidSentinels causes GetPrimaryUUIDs to use a sentinel string (instead of "") for entries without a primary UUID.
+	// A nil link is "<nil>", a nil or empty MDAddr is "<empty>", and an invalid MDAddr is "<invalid>".
+	WithInvalidSentinels PrimaryUUIDsOption = iota + 1
+)
+
+// invalidStr is a string indicating that something is invalid.
+const invalidStr = "<invalid>"
+
 // GetPrimaryUUIDs extracts the primary UUID out of each MDAddr and converts each to a string.
 // If the MDAddr is nil, empty, or invalid, it's corresponding result will be "".
-func (a AccMDLinks) GetPrimaryUUIDs() []string {
+// If the WithInvalidSentinels option is provided, those entries will instead be "<nil>", "<empty>", or "<invalid>".
+// See also: GetPrimaryUUIDsStrict.
+func (a AccMDLinks) GetPrimaryUUIDs(opts ...PrimaryUUIDsOption) []string {
 	if a == nil {
 		return nil
 	}
+	useSentinels := false
+	for _, opt := range opts {
+		if opt == WithInvalidSentinels {
+			useSentinels = true
+		}
+	}
 	rv := make([]string, len(a))
 	for i, link := range a {
-		if link != nil && len(link.MDAddr) > 0 {
+		switch {
+		case link == nil:
+			if useSentinels {
+				rv[i] = nilStr
+			}
+		case len(link.MDAddr) == 0:
+			if useSentinels {
+				rv[i] = emptyStr
+			}
+		default:
 			id, err := link.MDAddr.PrimaryUUID()
-			if err == nil {
+			switch {
+			case err == nil:
 				rv[i] = id.String()
+			case useSentinels:
+				rv[i] = invalidStr
 			}
 		}
 	}
 	return rv
 }
 
+// GetPrimaryUUIDsStrict extracts the primary UUID out of each MDAddr and converts each to a string.
+// An error is returned that identifies each entry that is nil, or has a nil, empty, or invalid MDAddr.
+func (a AccMDLinks) GetPrimaryUUIDsStrict() ([]string, error) {
+	if a == nil {
+		return nil, nil
+	}
+	rv := make([]string, len(a))
+	var errs []error
+	for i, link := range a {
+		switch {
+		case link == nil:
+			errs = append(errs, fmt.Errorf("[%d]: nil entry", i))
+		case link.MDAddr == nil:
+			errs = append(errs, fmt.Errorf("[%d]: nil metadata address", i))
+		case len(link.MDAddr) == 0:
+			errs = append(errs, fmt.Errorf("[%d]: empty metadata address", i))
+		default:
+			id, err := link.MDAddr.PrimaryUUID()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("[%d]: invalid metadata address %#X: %w", i, []byte(link.MDAddr), err))
+				continue
+			}
+			rv[i] = id.String()
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return rv, nil
+}
+
 // GetMDAddrsForAccAddr returns all of the MDAddrs associated with the provided AccAddr.
 func (a AccMDLinks) GetMDAddrsForAccAddr(addr string) []MetadataAddress {
 	var rv []MetadataAddress
@@ -1026,3 +2213,130 @@ func (a AccMDLinks) GetMDAddrsForAccAddr(addr string) []MetadataAddress {
 	}
 	return rv
 }
+
+// accMDLinkKey is a comparable representation of an AccMDLink's addresses (as raw bytes).
+type accMDLinkKey struct {
+	accAddr string
+	mdAddr  string
+}
+
+// key returns the accMDLinkKey of this AccMDLink.
+func (l *AccMDLink) key() accMDLinkKey {
+	return accMDLinkKey{accAddr: string(l.AccAddr), mdAddr: string(l.MDAddr)}
+}
+
+// Contains returns true if this AccMDLinks has an entry with the provided AccAddr and MDAddr.
+// Nil entries are ignored.
+func (a AccMDLinks) Contains(accAddr sdk.AccAddress, mdAddr MetadataAddress) bool {
+	for _, link := range a {
+		if link != nil && bytes.Equal(accAddr, link.AccAddr) && bytes.Equal(mdAddr, link.MDAddr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Deduplicated returns a new AccMDLinks with each AccAddr+MDAddr pair from this AccMDLinks only once.
+// Entries are kept in the order they first appear in this list. Nil entries are ignored.
+func (a AccMDLinks) Deduplicated() AccMDLinks {
+	return a.Union(nil)
+}
+
+// Union returns a new AccMDLinks with each AccAddr+MDAddr pair that is in either this or the other AccMDLinks.
+// Each pair appears only once and in the order it first appears (this list, then the other). Nil entries are ignored.
+func (a AccMDLinks) Union(other AccMDLinks) AccMDLinks {
+	seen := make(map[accMDLinkKey]bool)
+	var rv AccMDLinks
+	for _, links := range []AccMDLinks{a, other} {
+		for _, link := range links {
+			if link == nil {
+				continue
+			}
+			key := link.key()
+			if !seen[key] {
+				seen[key] = true
+				rv = append(rv, link)
+			}
+		}
+	}
+	return rv
+}
+
+// Difference returns a new AccMDLinks with each AccAddr+MDAddr pair in this AccMDLinks that is not in the other.
+// Each pair appears only once and in the order it first appears in this list. Nil entries are ignored.
+func (a AccMDLinks) Difference(other AccMDLinks) AccMDLinks {
+	seen := make(map[accMDLinkKey]bool)
+	for _, link := range other {
+		if link != nil {
+			seen[link.key()] = true
+		}
+	}
+	var rv AccMDLinks
+	for _, link := range a {
+		if link == nil {
+			continue
+		}
+		key := link.key()
+		if !seen[key] {
+			seen[key] = true
+			rv = append(rv, link)
+		}
+	}
+	return rv
+}
+
+// MetadataAddresses is a slice of MetadataAddress.
+type MetadataAddresses []MetadataAddress
+
+// String returns a string representation of these MetadataAddresses using the bech32 string of each entry.
+func (m MetadataAddresses) String() string {
+	if len(m) > 0 {
+		strs := make([]string, len(m))
+		for i, ma := range m {
+			strs[i] = mdStr(ma)
+		}
+		return "[" + strings.Join(strs, ", ") + "]"
+	}
+	if m == nil {
+		return nilStr
+	}
+	return emptyStr
+}
+
+// Sort sorts these MetadataAddresses (in place) by their raw bytes.
+// Since the first byte is the type, this groups entries by type (e.g. all scopes come before any sessions).
+// The sort is stable, so equal entries stay in the same order relative to each other.
+func (m MetadataAddresses) Sort() {
+	sort.SliceStable(m, func(i, j int) bool {
+		return m[i].Less(m[j])
+	})
+}
+
+// Contains returns true if one of these entries has the same bytes as the provided MetadataAddress.
+func (m MetadataAddresses) Contains(ma MetadataAddress) bool {
+	for _, entry := range m {
+		if bytes.Equal(entry, ma) {
+			return true
+		}
+	}
+	return false
+}
+
+// Deduplicated returns a new MetadataAddresses with each entry only appearing once (in the order it first appears).
+// Returns nil if there aren't any entries.
+func (m MetadataAddresses) Deduplicated() MetadataAddresses {
+	if len(m) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool, len(m))
+	rv := make(MetadataAddresses, 0, len(m))
+	for _, ma := range m {
+		key := string(ma)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		rv = append(rv, ma)
+	}
+	return rv
+}
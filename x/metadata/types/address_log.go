@@ -0,0 +1,81 @@
+package types
+
+import (
+	"sync"
+)
+
+// These are the keys used in the logging key/value pairs returned from MDFields.
+const (
+	// MDLogKeyType is the key for the type of metadata address, e.g. "scope".
+	MDLogKeyType = "md_type"
+	// MDLogKeyScopeUUID is the key for the scope uuid of a scope, session, or record address.
+	MDLogKeyScopeUUID = "md_scope_uuid"
+	// MDLogKeySecondary is the key for the session uuid of a session address, or the name hash of a record or record spec address.
+	MDLogKeySecondary = "md_secondary"
+	// MDLogKeyAddr is the key for the bech32 string of the metadata address.
+	MDLogKeyAddr = "md_addr"
+)
+
+// getMDLogDetails is the function used to get the details of a metadata address for logging.
+// It's a variable so that unit tests can tell if (and how often) it's called.
+var getMDLogDetails = MetadataAddress.GetDetails
+
+// MDFields returns key/value pairs describing the provided metadata address, suitable for the SDK logger. E.g.
+//
+//	k.Logger(ctx).Debug("scope stored", types.MDFields(scopeID)...)
+//
+// The keys returned depend only on the address type. The values are lazily evaluated: the address details
+// are only computed (once) if an entry is actually logged. So it's cheap to provide these to a Debug log
+// even when debug logging is disabled.
+//
+// Scope, session, and record addresses have an md_scope_uuid entry.
+// Session, record, and record spec addresses have an md_secondary entry (a session uuid or a name hash).
+// All addresses have md_type and md_addr entries.
+func MDFields(ma MetadataAddress) []interface{} {
+	details := &mdLogDetails{addr: ma}
+	rv := make([]interface{}, 0, 8)
+	rv = append(rv, MDLogKeyType, mdLogValue{details: details, field: func(d MetadataAddressDetails) string { return d.Prefix }})
+	if ma.isTypeOneOf(ScopeKeyPrefix, SessionKeyPrefix, RecordKeyPrefix) {
+		rv = append(rv, MDLogKeyScopeUUID, mdLogValue{details: details, field: func(d MetadataAddressDetails) string { return d.PrimaryUUID }})
+	}
+	switch {
+	case ma.isTypeOneOf(SessionKeyPrefix):
+		rv = append(rv, MDLogKeySecondary, mdLogValue{details: details, field: func(d MetadataAddressDetails) string { return d.SecondaryUUID }})
+	case ma.isTypeOneOf(RecordKeyPrefix, RecordSpecificationKeyPrefix):
+		rv = append(rv, MDLogKeySecondary, mdLogValue{details: details, field: func(d MetadataAddressDetails) string { return d.NameHashHex }})
+	}
+	rv = append(rv, MDLogKeyAddr, mdLogValue{details: details, field: func(d MetadataAddressDetails) string { return d.Address.String() }})
+	return rv
+}
+
+// mdLogDetails holds a metadata address and computes its details once, the first time they're needed.
+type mdLogDetails struct {
+	addr    MetadataAddress
+	once    sync.Once
+	details MetadataAddressDetails
+}
+
+// get returns the details of the metadata address, computing them if they haven't been yet.
+func (d *mdLogDetails) get() MetadataAddressDetails {
+	d.once.Do(func() {
+		d.details = getMDLogDetails(d.addr)
+	})
+	return d.details
+}
+
+// mdLogValue is a lazily-evaluated logging value for one of the details of a metadata address.
+type mdLogValue struct {
+	details *mdLogDetails
+	field   func(MetadataAddressDetails) string
+}
+
+// String returns the value of this field, satisfying the fmt.Stringer interface.
+func (v mdLogValue) String() string {
+	return v.field(v.details.get())
+}
+
+// MarshalText returns the value of this field, satisfying the encoding.TextMarshaler interface.
+// This is what a JSON logger ends up using for the value.
+func (v mdLogValue) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
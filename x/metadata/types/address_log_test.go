@@ -0,0 +1,171 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+)
+
+// countMDLogDetailsCalls replaces getMDLogDetails for the duration of a test so that calls to it can be counted.
+func countMDLogDetailsCalls(t *testing.T) *int {
+	calls := 0
+	orig := getMDLogDetails
+	getMDLogDetails = func(ma MetadataAddress) MetadataAddressDetails {
+		calls++
+		return orig(ma)
+	}
+	t.Cleanup(func() {
+		getMDLogDetails = orig
+	})
+	return &calls
+}
+
+// mdFieldsMap converts the key/value pairs from MDFields into a map of key to string value.
+func mdFieldsMap(t *testing.T, fields []interface{}) map[string]string {
+	require.Equal(t, 0, len(fields)%2, "number of entries in %v", fields)
+	rv := make(map[string]string, len(fields)/2)
+	for i := 0; i < len(fields); i += 2 {
+		key, ok := fields[i].(string)
+		require.True(t, ok, "fields[%d] = %#v is a string", i, fields[i])
+		val, ok := fields[i+1].(interface{ String() string })
+		require.True(t, ok, "fields[%d] = %#v is a Stringer", i+1, fields[i+1])
+		rv[key] = val.String()
+	}
+	return rv
+}
+
+func TestMDFields(t *testing.T) {
+	scopeUUID := uuid.MustParse("8d80b25a-c089-4446-956e-5d08cfe3e1a5")
+	sessionUUID := uuid.MustParse("c25c7bd4-c639-4367-a842-f64fa5fccc19")
+	specUUID := uuid.MustParse("6b0bdb2b-1e8e-4f6b-8d8d-1c6b1a2f8e71")
+
+	scopeID := ScopeMetadataAddress(scopeUUID)
+	sessionID := SessionMetadataAddress(scopeUUID, sessionUUID)
+	recordID := RecordMetadataAddress(scopeUUID, "recname")
+	scopeSpecID := ScopeSpecMetadataAddress(specUUID)
+	contractSpecID := ContractSpecMetadataAddress(specUUID)
+	recordSpecID := RecordSpecMetadataAddress(specUUID, "recspecname")
+
+	tests := []struct {
+		name string
+		addr MetadataAddress
+		exp  map[string]string
+	}{
+		{
+			name: "scope",
+			addr: scopeID,
+			exp: map[string]string{
+				MDLogKeyType:      PrefixScope,
+				MDLogKeyScopeUUID: scopeUUID.String(),
+				MDLogKeyAddr:      scopeID.String(),
+			},
+		},
+		{
+			name: "session",
+			addr: sessionID,
+			exp: map[string]string{
+				MDLogKeyType:      PrefixSession,
+				MDLogKeyScopeUUID: scopeUUID.String(),
+				MDLogKeySecondary: sessionUUID.String(),
+				MDLogKeyAddr:      sessionID.String(),
+			},
+		},
+		{
+			name: "record",
+			addr: recordID,
+			exp: map[string]string{
+				MDLogKeyType:      PrefixRecord,
+				MDLogKeyScopeUUID: scopeUUID.String(),
+				MDLogKeySecondary: recordID.GetDetails().NameHashHex,
+				MDLogKeyAddr:      recordID.String(),
+			},
+		},
+		{
+			name: "scope spec",
+			addr: scopeSpecID,
+			exp: map[string]string{
+				MDLogKeyType: PrefixScopeSpecification,
+				MDLogKeyAddr: scopeSpecID.String(),
+			},
+		},
+		{
+			name: "contract spec",
+			addr: contractSpecID,
+			exp: map[string]string{
+				MDLogKeyType: PrefixContractSpecification,
+				MDLogKeyAddr: contractSpecID.String(),
+			},
+		},
+		{
+			name: "record spec",
+			addr: recordSpecID,
+			exp: map[string]string{
+				MDLogKeyType:      PrefixRecordSpecification,
+				MDLogKeySecondary: recordSpecID.GetDetails().NameHashHex,
+				MDLogKeyAddr:      recordSpecID.String(),
+			},
+		},
+		{
+			name: "empty",
+			addr: MetadataAddress{},
+			exp: map[string]string{
+				MDLogKeyType: "",
+				MDLogKeyAddr: "",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := countMDLogDetailsCalls(t)
+			var fields []interface{}
+			testFunc := func() {
+				fields = MDFields(tc.addr)
+			}
+			require.NotPanics(t, testFunc, "MDFields")
+			assert.Equal(t, 0, *calls, "number of details calls made by MDFields")
+			act := mdFieldsMap(t, fields)
+			assert.Equal(t, tc.exp, act, "MDFields result")
+			assert.Equal(t, 1, *calls, "number of details calls after getting all the values")
+		})
+	}
+}
+
+func TestMDFieldsLazyLogging(t *testing.T) {
+	scopeUUID := uuid.MustParse("8d80b25a-c089-4446-956e-5d08cfe3e1a5")
+	sessionID := SessionMetadataAddress(scopeUUID, uuid.MustParse("c25c7bd4-c639-4367-a842-f64fa5fccc19"))
+
+	newLogger := func(level zerolog.Level) (log.Logger, *bytes.Buffer) {
+		var buffer bytes.Buffer
+		return log.NewCustomLogger(zerolog.New(&buffer).Level(level)), &buffer
+	}
+
+	t.Run("debug disabled", func(t *testing.T) {
+		calls := countMDLogDetailsCalls(t)
+		logger, buffer := newLogger(zerolog.InfoLevel)
+		logger.Debug("session stored", MDFields(sessionID)...)
+		assert.Empty(t, buffer.String(), "logged output")
+		assert.Equal(t, 0, *calls, "number of details calls")
+	})
+
+	t.Run("debug enabled", func(t *testing.T) {
+		calls := countMDLogDetailsCalls(t)
+		logger, buffer := newLogger(zerolog.DebugLevel)
+		logger.Debug("session stored", MDFields(sessionID)...)
+		assert.Equal(t, 1, *calls, "number of details calls")
+
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal(buffer.Bytes(), &entry), "unmarshaling logged output:\n%s", buffer.String())
+		assert.Equal(t, PrefixSession, entry[MDLogKeyType], MDLogKeyType)
+		assert.Equal(t, scopeUUID.String(), entry[MDLogKeyScopeUUID], MDLogKeyScopeUUID)
+		assert.Equal(t, "c25c7bd4-c639-4367-a842-f64fa5fccc19", entry[MDLogKeySecondary], MDLogKeySecondary)
+		assert.Equal(t, sessionID.String(), entry[MDLogKeyAddr], MDLogKeyAddr)
+	})
+}
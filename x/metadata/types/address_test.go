@@ -6,8 +6,10 @@ import (
 	"crypto/sha512"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 
@@ -242,6 +244,260 @@ func (s *AddressTestSuite) TestLegacySha512HashToAddress() {
 	}
 }
 
+func (s *AddressTestSuite) TestNewIDConstructors() {
+	uuids := []uuid.UUID{
+		uuid.MustParse("11111111-1111-4111-8111-111111111111"),
+		uuid.MustParse("22222222-2222-4222-8222-222222222222"),
+	}
+	defer func(orig func() uuid.UUID) { UUIDSource = orig }(UUIDSource)
+	var calls int
+	UUIDSource = func() uuid.UUID {
+		rv := uuids[calls%len(uuids)]
+		calls++
+		return rv
+	}
+
+	s.Run("NewScopeID", func() {
+		calls = 0
+		scopeID, scopeUUID := NewScopeID()
+		s.Assert().Equal(uuids[0], scopeUUID, "scope uuid")
+		s.Assert().Equal(ScopeMetadataAddress(uuids[0]), scopeID, "scope id")
+	})
+
+	scopeID := ScopeMetadataAddress(s.scopeUUID)
+	contractSpecID := ContractSpecMetadataAddress(uuids[1])
+	for _, tc := range []struct {
+		name   string
+		id     MetadataAddress
+		expErr string
+	}{
+		{name: "from scope", id: scopeID},
+		{name: "from session", id: scopeID.MustGetAsSessionAddress(s.sessionUUID)},
+		{name: "from record", id: scopeID.MustGetAsRecordAddress("recname")},
+		{
+			name:   "from contract spec",
+			id:     contractSpecID,
+			expErr: "this metadata address (" + contractSpecID.String() + ") does not contain a scope uuid",
+		},
+		{
+			name:   "invalid bytes",
+			id:     MetadataAddress{ScopeKeyPrefix[0], 0x01, 0x02},
+			expErr: "incorrect address length (expected: 17, actual: 3)",
+		},
+	} {
+		s.Run("NewSessionID "+tc.name, func() {
+			calls = 0
+			sessionID, sessionUUID, err := NewSessionID(tc.id)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "NewSessionID error")
+				s.Assert().Empty(sessionID, "session id")
+				return
+			}
+			s.Require().NoError(err, "NewSessionID error")
+			s.Assert().Equal(uuids[0], sessionUUID, "session uuid")
+			s.Assert().Equal(SessionMetadataAddress(s.scopeUUID, uuids[0]), sessionID, "session id")
+		})
+	}
+
+	for _, tc := range []struct {
+		name    string
+		id      MetadataAddress
+		recName string
+		expSpec uuid.UUID
+		expErr  string
+	}{
+		{name: "from contract spec", id: contractSpecID, recName: "recname", expSpec: uuids[1]},
+		{name: "from record spec", id: contractSpecID.MustGetAsRecordSpecAddress("other"), recName: "recname", expSpec: uuids[1]},
+		{name: "no contract spec", recName: "recname", expSpec: uuids[0]},
+		{
+			name:    "from scope",
+			id:      scopeID,
+			recName: "recname",
+			expErr:  "this metadata address (" + scopeID.String() + ") does not contain a contract specification uuid",
+		},
+		{
+			name:   "no name",
+			id:     contractSpecID,
+			expErr: "missing name value for record spec metadata address",
+		},
+	} {
+		s.Run("NewRandomRecordSpecID "+tc.name, func() {
+			calls = 0
+			recSpecID, err := NewRandomRecordSpecID(tc.id, tc.recName)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "NewRandomRecordSpecID error")
+				s.Assert().Empty(recSpecID, "record spec id")
+				return
+			}
+			s.Require().NoError(err, "NewRandomRecordSpecID error")
+			s.Assert().Equal(RecordSpecMetadataAddress(tc.expSpec, tc.recName), recSpecID, "record spec id")
+		})
+	}
+}
+
+func (s *AddressTestSuite) TestClassifyAddressForType() {
+	scopeID := ScopeMetadataAddress(s.scopeUUID)
+	contractSpecID := ContractSpecMetadataAddress(uuid.MustParse("c5d1f7a4-26b4-4d71-9e1b-2c1a0c7c3e52"))
+	recSpecID := contractSpecID.MustGetAsRecordSpecAddress("recname")
+
+	tests := []struct {
+		name   string
+		ma     MetadataAddress
+		expHRP string
+		exp    AddressClassification
+	}{
+		{
+			name:   "scope for scope",
+			ma:     scopeID,
+			expHRP: PrefixScope,
+			exp:    AddressClassification{Valid: true, ActualHRP: PrefixScope},
+		},
+		{
+			name:   "session for scope",
+			ma:     scopeID.MustGetAsSessionAddress(s.sessionUUID),
+			expHRP: PrefixScope,
+			exp:    AddressClassification{ActualHRP: PrefixSession, SuggestedAddress: scopeID},
+		},
+		{
+			name:   "record for scope",
+			ma:     scopeID.MustGetAsRecordAddress("recname"),
+			expHRP: PrefixScope,
+			exp:    AddressClassification{ActualHRP: PrefixRecord, SuggestedAddress: scopeID},
+		},
+		{
+			name:   "recspec for scope",
+			ma:     recSpecID,
+			expHRP: PrefixScope,
+			exp:    AddressClassification{ActualHRP: PrefixRecordSpecification},
+		},
+		{
+			name:   "recspec for contract spec",
+			ma:     recSpecID,
+			expHRP: PrefixContractSpecification,
+			exp:    AddressClassification{ActualHRP: PrefixRecordSpecification, SuggestedAddress: contractSpecID},
+		},
+		{
+			name:   "scope for session",
+			ma:     scopeID,
+			expHRP: PrefixSession,
+			exp:    AddressClassification{ActualHRP: PrefixScope},
+		},
+		{
+			name:   "invalid bytes",
+			ma:     MetadataAddress{ScopeKeyPrefix[0], 0x01, 0x02},
+			expHRP: PrefixScope,
+			exp:    AddressClassification{},
+		},
+		{
+			name:   "empty",
+			ma:     MetadataAddress{},
+			expHRP: PrefixScope,
+			exp:    AddressClassification{},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			actual := ClassifyAddressForType(tc.ma, tc.expHRP)
+			s.Assert().Equal(tc.exp, actual, "ClassifyAddressForType")
+		})
+	}
+}
+
+func (s *AddressTestSuite) TestConvertHashToAddressStrict() {
+	hashBytes := sha256.Sum256([]byte("test"))
+	hex16 := hex.EncodeToString(hashBytes[:16])
+	hex32 := hex.EncodeToString(hashBytes[:])
+	b64x16 := base64.StdEncoding.EncodeToString(hashBytes[:16])
+	b64x32 := base64.StdEncoding.EncodeToString(hashBytes[:])
+
+	tests := []struct {
+		name      string
+		typeBytes []byte
+		hash      string
+		expAddr   string
+		expErr    string
+	}{
+		{
+			name:      "empty typeBytes",
+			typeBytes: []byte{},
+			hash:      hex16,
+			expErr:    "empty typeCode bytes",
+		},
+		{
+			name:      "empty hash",
+			typeBytes: ScopeKeyPrefix,
+			expErr:    "empty hash string",
+		},
+		{
+			name:      "invalid type bytes",
+			typeBytes: []byte{0x07},
+			hash:      hex16,
+			expErr:    "invalid address type code 0x07",
+		},
+		{
+			name:      "scope from hex",
+			typeBytes: ScopeKeyPrefix,
+			hash:      hex16,
+			expAddr:   s.requireBech32String(ScopeKeyPrefix, hashBytes[:16]),
+		},
+		{
+			name:      "scope from base64",
+			typeBytes: ScopeKeyPrefix,
+			hash:      b64x16,
+			expAddr:   s.requireBech32String(ScopeKeyPrefix, hashBytes[:16]),
+		},
+		{
+			name:      "scope from too long hex",
+			typeBytes: ScopeKeyPrefix,
+			hash:      hex32,
+			expErr:    "invalid hex hash \"" + hex32 + "\" byte length, expected exactly 16 bytes, found 32",
+		},
+		{
+			name:      "scope from too long base64",
+			typeBytes: ScopeKeyPrefix,
+			hash:      b64x32,
+			expErr:    "invalid base64 hash \"" + b64x32 + "\" byte length, expected exactly 16 bytes, found 32",
+		},
+		{
+			name:      "record from hex",
+			typeBytes: RecordKeyPrefix,
+			hash:      hex32,
+			expAddr:   s.requireBech32String(RecordKeyPrefix, hashBytes[:]),
+		},
+		{
+			name:      "record from too short hex",
+			typeBytes: RecordKeyPrefix,
+			hash:      hex16,
+			expErr:    "invalid hex hash \"" + hex16 + "\" byte length, expected exactly 32 bytes, found 16",
+		},
+		{
+			name:      "session from base64",
+			typeBytes: SessionKeyPrefix,
+			hash:      b64x32,
+			expAddr:   s.requireBech32String(SessionKeyPrefix, hashBytes[:]),
+		},
+		{
+			name:      "not hex or base64",
+			typeBytes: ScopeKeyPrefix,
+			hash:      "invalid hash",
+			expErr:    "invalid hash \"invalid hash\": not hex or base64 encoded: " + base64.CorruptInputError(7).Error(),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			addr, err := ConvertHashToAddressStrict(tc.typeBytes, tc.hash)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "ConvertHashToAddressStrict error")
+				return
+			}
+			s.Require().NoError(err, "ConvertHashToAddressStrict error")
+			s.Assert().Equal(tc.expAddr, addr.String(), "ConvertHashToAddressStrict result")
+		})
+	}
+}
+
 func (s *AddressTestSuite) TestVerifyMetadataAddressFormat() {
 	uuid0 := uuid.Nil
 	uuid1 := uuid.MustParse("1D42DB43-FCF2-46F8-A4B6-974D73B6551E") // came from uuidgen
@@ -522,37 +778,37 @@ func (s *AddressTestSuite) TestVerifyMetadataAddressHasType() {
 			name:   "invalid scope id",
 			ma:     MetadataAddress{ScopeKeyPrefix[0], 0x1, 0x2},
 			hrp:    PrefixScope,
-			expErr: "invalid scope metadata address MetadataAddress{0x0, 0x1, 0x2}: incorrect address length (expected: 17, actual: 3)",
+			expErr: "invalid scope metadata address invalid[prefix=0x00 len=3 hex=000102]: incorrect address length (expected: 17, actual: 3)",
 		},
 		{
 			name:   "invalid session id",
 			ma:     MetadataAddress{SessionKeyPrefix[0], 0x3, 0x4},
 			hrp:    PrefixSession,
-			expErr: "invalid session metadata address MetadataAddress{0x1, 0x3, 0x4}: incorrect address length (expected: 33, actual: 3)",
+			expErr: "invalid session metadata address invalid[prefix=0x01 len=3 hex=010304]: incorrect address length (expected: 33, actual: 3)",
 		},
 		{
 			name:   "invalid record id",
 			ma:     MetadataAddress{RecordKeyPrefix[0], 0x5, 0x6},
 			hrp:    PrefixRecord,
-			expErr: "invalid record metadata address MetadataAddress{0x2, 0x5, 0x6}: incorrect address length (expected: 33, actual: 3)",
+			expErr: "invalid record metadata address invalid[prefix=0x02 len=3 hex=020506]: incorrect address length (expected: 33, actual: 3)",
 		},
 		{
 			name:   "invalid scope spec id",
 			ma:     MetadataAddress{ScopeSpecificationKeyPrefix[0], 0x7, 0x8},
 			hrp:    PrefixScopeSpecification,
-			expErr: "invalid scope specification metadata address MetadataAddress{0x4, 0x7, 0x8}: incorrect address length (expected: 17, actual: 3)",
+			expErr: "invalid scope specification metadata address invalid[prefix=0x04 len=3 hex=040708]: incorrect address length (expected: 17, actual: 3)",
 		},
 		{
 			name:   "invalid contract spec id",
 			ma:     MetadataAddress{ContractSpecificationKeyPrefix[0], 0x9, 0xa},
 			hrp:    PrefixContractSpecification,
-			expErr: "invalid contract specification metadata address MetadataAddress{0x3, 0x9, 0xa}: incorrect address length (expected: 17, actual: 3)",
+			expErr: "invalid contract specification metadata address invalid[prefix=0x03 len=3 hex=03090a]: incorrect address length (expected: 17, actual: 3)",
 		},
 		{
 			name:   "invalid record spec id",
 			ma:     MetadataAddress{RecordSpecificationKeyPrefix[0], 0xb, 0xc},
 			hrp:    PrefixRecordSpecification,
-			expErr: "invalid record specification metadata address MetadataAddress{0x5, 0xb, 0xc}: incorrect address length (expected: 33, actual: 3)",
+			expErr: "invalid record specification metadata address invalid[prefix=0x05 len=3 hex=050b0c]: incorrect address length (expected: 33, actual: 3)",
 		},
 	}
 
@@ -618,6 +874,20 @@ func (s *AddressTestSuite) TestMetadataAddressFromBech32() {
 	hrpMismatchAddr, err := bech32.ConvertAndEncode(PrefixScopeSpecification, scopeAddr)
 	s.Require().NoError(err, "ConvertAndEncode hrpMismatchAddr")
 
+	// Re-encode the scope with a bech32m checksum (same hrp and data, different checksum constant).
+	scopeAddrStr := scopeAddr.String()
+	scopeBech32mStr := scopeAddrStr[:len(scopeAddrStr)-6]
+	checksumValues := bech32HRPExpand(PrefixScope)
+	for _, c := range scopeBech32mStr[len(PrefixScope)+1:] {
+		checksumValues = append(checksumValues, strings.IndexRune(bech32Charset, c))
+	}
+	polymod := bech32Polymod(append(checksumValues, 0, 0, 0, 0, 0, 0)) ^ bech32mChecksumConst
+	for i := 0; i < 6; i++ {
+		scopeBech32mStr += string(bech32Charset[(polymod>>(5*(5-i)))&31])
+	}
+	s.Require().True(isBech32m(scopeBech32mStr), "isBech32m(%q)", scopeBech32mStr)
+	s.Require().False(isBech32m(scopeAddrStr), "isBech32m(%q)", scopeAddrStr)
+
 	tests := []struct {
 		name    string
 		input   string
@@ -660,6 +930,20 @@ func (s *AddressTestSuite) TestMetadataAddressFromBech32() {
 			expHRP:  "",
 			expErr:  "invalid bech32 prefix; expected scope, got scopespec",
 		},
+		{
+			name:    "bech32m encoded scope",
+			input:   scopeBech32mStr,
+			expAddr: nil,
+			expHRP:  "",
+			expErr:  "address \"" + scopeBech32mStr + "\" appears to be bech32m-encoded, but metadata addresses use classic bech32 encoding",
+		},
+		{
+			name:    "bad checksum that is not bech32m",
+			input:   scopeAddrStr[:len(scopeAddrStr)-1] + "q",
+			expAddr: nil,
+			expHRP:  "",
+			expErr:  "decoding bech32 failed: invalid checksum (expected " + scopeAddrStr[len(scopeAddrStr)-6:] + " got " + scopeAddrStr[len(scopeAddrStr)-6:len(scopeAddrStr)-1] + "q)",
+		},
 		{
 			name:    "scope",
 			input:   scopeAddr.String(),
@@ -817,6 +1101,325 @@ func (s *AddressTestSuite) TestMetadataAddressFromDenom() {
 	}
 }
 
+func (s *AddressTestSuite) TestIsMetadataDenom() {
+	tests := []struct {
+		denom string
+		exp   bool
+	}{
+		{denom: "", exp: false},
+		{denom: "nhash", exp: false},
+		{denom: "nft", exp: false},
+		{denom: "nft/", exp: true},
+		{denom: "nft/notanaddress", exp: true},
+		{denom: ScopeMetadataAddress(s.scopeUUID).Denom(), exp: true},
+		{denom: "NFT/" + ScopeMetadataAddress(s.scopeUUID).String(), exp: false},
+	}
+
+	for _, tc := range tests {
+		s.Run(fmt.Sprintf("%q", tc.denom), func() {
+			act := IsMetadataDenom(tc.denom)
+			s.Assert().Equal(tc.exp, act, "IsMetadataDenom(%q)", tc.denom)
+		})
+	}
+}
+
+// registerTestMetadataDenomPrefix registers the provided metadata denom prefix, returning a func that restores the original registry.
+func registerTestMetadataDenomPrefix(prefix string) func() {
+	orig := metadataDenomPrefixes
+	metadataDenomPrefixes = slices.Clone(orig)
+	RegisterMetadataDenomPrefix(prefix)
+	return func() {
+		metadataDenomPrefixes = orig
+	}
+}
+
+func (s *AddressTestSuite) TestMetadataDenomPrefixes() {
+	s.Run("production", func() {
+		s.Assert().Equal([]string{DenomPrefix}, MetadataDenomPrefixes(), "MetadataDenomPrefixes()")
+	})
+
+	s.Run("result is a copy", func() {
+		prefixes := MetadataDenomPrefixes()
+		prefixes[0] = "changed/"
+		s.Assert().Equal([]string{DenomPrefix}, MetadataDenomPrefixes(), "MetadataDenomPrefixes() after altering a result")
+	})
+
+	s.Run("register existing and empty", func() {
+		defer registerTestMetadataDenomPrefix(DenomPrefix)()
+		RegisterMetadataDenomPrefix("")
+		s.Assert().Equal([]string{DenomPrefix}, MetadataDenomPrefixes(), "MetadataDenomPrefixes()")
+	})
+
+	s.Run("register a second prefix", func() {
+		defer registerTestMetadataDenomPrefix("nft/v2/")()
+		s.Assert().Equal([]string{"nft/v2/", DenomPrefix}, MetadataDenomPrefixes(), "MetadataDenomPrefixes()")
+
+		scopeID := ScopeMetadataAddress(s.scopeUUID)
+		sessionUUID := uuid.MustParse("b47d4b5e-8b5c-4ec8-a4a2-bbbe26b5dd21")
+		sessionID := SessionMetadataAddress(s.scopeUUID, sessionUUID)
+
+		s.Assert().Equal("nft/"+scopeID.String(), scopeID.Denom(), "Denom()")
+		s.Assert().True(IsMetadataDenom("nft/v2/"+scopeID.String()), "IsMetadataDenom(nft/v2/...)")
+		s.Assert().True(IsMetadataDenom("nft/"+scopeID.String()), "IsMetadataDenom(nft/...)")
+		s.Assert().False(IsMetadataDenom("nft"+scopeID.String()), "IsMetadataDenom(nft...)")
+
+		id, ok := TrimMetadataDenomPrefix("nft/v2/" + scopeID.String())
+		s.Assert().True(ok, "TrimMetadataDenomPrefix(nft/v2/...) ok")
+		s.Assert().Equal(scopeID.String(), id, "TrimMetadataDenomPrefix(nft/v2/...) result")
+
+		addr, err := MetadataAddressFromDenom("nft/v2/" + scopeID.String())
+		s.Require().NoError(err, "MetadataAddressFromDenom(nft/v2/...)")
+		s.Assert().Equal(scopeID, addr, "MetadataAddressFromDenom(nft/v2/...)")
+		addr, err = MetadataAddressFromDenom(scopeID.Denom())
+		s.Require().NoError(err, "MetadataAddressFromDenom(nft/...)")
+		s.Assert().Equal(scopeID, addr, "MetadataAddressFromDenom(nft/...)")
+
+		addr, err = ParseRichDenom("nft/v2/" + scopeID.String() + "/session/" + sessionUUID.String())
+		s.Require().NoError(err, "ParseRichDenom(nft/v2/.../session/...)")
+		s.Assert().Equal(sessionID, addr, "ParseRichDenom(nft/v2/.../session/...)")
+
+		_, err = MetadataAddressFromDenom("nft/v3/" + scopeID.String())
+		s.Assert().ErrorContains(err, `invalid metadata address in denom "nft/v3/`, "MetadataAddressFromDenom(nft/v3/...)")
+	})
+
+	s.Run("registry restored", func() {
+		s.Assert().Equal([]string{DenomPrefix}, MetadataDenomPrefixes(), "MetadataDenomPrefixes()")
+		_, err := MetadataAddressFromDenom("nft/v2/" + ScopeMetadataAddress(s.scopeUUID).String())
+		s.Assert().Error(err, "MetadataAddressFromDenom(nft/v2/...)")
+	})
+}
+
+func (s *AddressTestSuite) TestParseRichDenom() {
+	scopeUUID := uuid.MustParse("91f2b84c-57b5-4a38-a3f3-6dd36a8b4ab4")
+	sessionUUID := uuid.MustParse("b47d4b5e-8b5c-4ec8-a4a2-bbbe26b5dd21")
+	scopeID := ScopeMetadataAddress(scopeUUID)
+	sessionID := SessionMetadataAddress(scopeUUID, sessionUUID)
+	recordID := RecordMetadataAddress(scopeUUID, "loan-doc")
+	contractSpecID := ContractSpecMetadataAddress(uuid.MustParse("2a9f4f28-1d4a-4bcf-9d2e-31fc8e4d0c64"))
+	scopeDenom := scopeID.Denom()
+
+	tests := []struct {
+		name    string
+		denom   string
+		expAddr MetadataAddress
+		expErr  string
+	}{
+		{
+			name:   "non-metadata denom",
+			denom:  "nhash",
+			expErr: "denom \"nhash\" is not a MetadataAddress denom",
+		},
+		{
+			name:    "plain scope denom",
+			denom:   scopeDenom,
+			expAddr: scopeID,
+		},
+		{
+			name:    "plain session denom",
+			denom:   sessionID.Denom(),
+			expAddr: sessionID,
+		},
+		{
+			name:    "plain record denom",
+			denom:   recordID.Denom(),
+			expAddr: recordID,
+		},
+		{
+			name:    "record in scope",
+			denom:   scopeDenom + "/record/loan-doc",
+			expAddr: recordID,
+		},
+		{
+			name:    "record in scope: name with different case and spaces",
+			denom:   scopeDenom + "/record/ Loan-Doc ",
+			expAddr: recordID,
+		},
+		{
+			name:    "session in scope",
+			denom:   scopeDenom + "/session/" + sessionUUID.String(),
+			expAddr: sessionID,
+		},
+		{
+			name:   "invalid base address",
+			denom:  "nft/scope1nope/record/loan-doc",
+			expErr: "invalid metadata address in denom \"nft/scope1nope/record/loan-doc\": decoding bech32 failed: invalid separator index 5",
+		},
+		{
+			name:   "unknown segment",
+			denom:  scopeDenom + "/recspec/loan-doc",
+			expErr: "invalid segment \"recspec\" in denom \"" + scopeDenom + "/recspec/loan-doc\": expected \"record\" or \"session\"",
+		},
+		{
+			name:   "empty segment",
+			denom:  scopeDenom + "/",
+			expErr: "invalid segment \"\" in denom \"" + scopeDenom + "/\": expected \"record\" or \"session\"",
+		},
+		{
+			name:   "contract spec base with record",
+			denom:  contractSpecID.Denom() + "/record/loan-doc",
+			expErr: "invalid base address in denom \"" + contractSpecID.Denom() + "/record/loan-doc\": expected a scope address with a /record, got a contractspec address",
+		},
+		{
+			name:   "session base with session",
+			denom:  sessionID.Denom() + "/session/" + sessionUUID.String(),
+			expErr: "invalid base address in denom \"" + sessionID.Denom() + "/session/" + sessionUUID.String() + "\": expected a scope address with a /session, got a session address",
+		},
+		{
+			name:   "record without name",
+			denom:  scopeDenom + "/record",
+			expErr: "invalid denom \"" + scopeDenom + "/record\": empty record name",
+		},
+		{
+			name:   "record with empty name",
+			denom:  scopeDenom + "/record/ ",
+			expErr: "invalid denom \"" + scopeDenom + "/record/ \": empty record name",
+		},
+		{
+			name:   "session without uuid",
+			denom:  scopeDenom + "/session/",
+			expErr: "invalid denom \"" + scopeDenom + "/session/\": empty session uuid",
+		},
+		{
+			name:   "session with invalid uuid",
+			denom:  scopeDenom + "/session/not-a-uuid",
+			expErr: "invalid session uuid \"not-a-uuid\" in denom \"" + scopeDenom + "/session/not-a-uuid\": invalid UUID length: 10",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var actAddr MetadataAddress
+			var actErr error
+			testFunc := func() {
+				actAddr, actErr = ParseRichDenom(tc.denom)
+			}
+			s.Require().NotPanics(testFunc, "ParseRichDenom(%q)", tc.denom)
+			assertions.AssertErrorValue(s.T(), actErr, tc.expErr, "error from ParseRichDenom(%q)", tc.denom)
+			s.Assert().Equal(tc.expAddr, actAddr, "address from ParseRichDenom(%q)", tc.denom)
+		})
+	}
+}
+
+func (s *AddressTestSuite) TestMetadataAddressFromAnyString() {
+	scopeID := ScopeMetadataAddress(s.scopeUUID)
+	sessionID := SessionMetadataAddress(s.scopeUUID, uuid.MustParse("b47d4b5e-8b5c-4ec8-a4a2-bbbe26b5dd21"))
+	recordID := RecordMetadataAddress(s.scopeUUID, "recname")
+	scopeHex := hex.EncodeToString(scopeID)
+	// A valid hex string that isn't a valid metadata address (it's one byte too short).
+	shortHex := scopeHex[:len(scopeHex)-2]
+	accAddr := sdk.AccAddress("nope_nope_nope_nope_").String()
+
+	tests := []struct {
+		name    string
+		str     string
+		expAddr MetadataAddress
+		expErr  []string
+	}{
+		{
+			name:   "empty",
+			str:    "",
+			expErr: []string{"empty address string is not allowed"},
+		},
+		{
+			name:   "only whitespace",
+			str:    " \t\n ",
+			expErr: []string{"empty address string is not allowed"},
+		},
+		{name: "bech32 scope", str: scopeID.String(), expAddr: scopeID},
+		{name: "bech32 session", str: sessionID.String(), expAddr: sessionID},
+		{name: "bech32 record with whitespace", str: "  " + recordID.String() + "\n", expAddr: recordID},
+		{name: "lowercase hex", str: scopeHex, expAddr: scopeID},
+		{name: "uppercase hex", str: strings.ToUpper(scopeHex), expAddr: scopeID},
+		{name: "hex with whitespace", str: "\t" + hex.EncodeToString(sessionID) + " ", expAddr: sessionID},
+		{name: "denom", str: scopeID.Denom(), expAddr: scopeID},
+		{name: "denom with whitespace", str: " " + recordID.Denom() + " ", expAddr: recordID},
+		{
+			name: "hex that decodes but is not a metadata address",
+			str:  shortHex,
+			expErr: []string{
+				"could not parse \"" + shortHex + "\" as a metadata address",
+				"as hex: incorrect address length (expected: 17, actual: 16)",
+			},
+		},
+		{
+			name: "account address",
+			str:  accAddr,
+			expErr: []string{
+				"could not parse \"" + accAddr + "\" as a metadata address",
+				"as bech32: invalid metadata address type: 110",
+				"as hex: encoding/hex: invalid byte",
+				"as denom: denom \"" + accAddr + "\" is not a MetadataAddress denom",
+			},
+		},
+		{
+			name: "denom with bad address",
+			str:  DenomPrefix + "nope",
+			expErr: []string{
+				"could not parse \"nft/nope\" as a metadata address",
+				"as denom: invalid metadata address in denom \"nft/nope\"",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var addr MetadataAddress
+			var err error
+			testFunc := func() {
+				addr, err = MetadataAddressFromAnyString(tc.str)
+			}
+			s.Require().NotPanics(testFunc, "MetadataAddressFromAnyString(%q)", tc.str)
+			if len(tc.expErr) > 0 {
+				for _, exp := range tc.expErr {
+					s.Assert().ErrorContains(err, exp, "MetadataAddressFromAnyString(%q) error", tc.str)
+				}
+				s.Assert().ErrorIs(err, ErrAddressParse, "MetadataAddressFromAnyString(%q) error", tc.str)
+			} else {
+				s.Assert().NoError(err, "MetadataAddressFromAnyString(%q) error", tc.str)
+			}
+			s.Assert().Equal(tc.expAddr, addr, "MetadataAddressFromAnyString(%q) address", tc.str)
+		})
+	}
+}
+
+func (s *AddressTestSuite) TestUnverifiedMetadataAddressFromString() {
+	scopeID := ScopeMetadataAddress(s.scopeUUID)
+	scopeHex := hex.EncodeToString(scopeID)
+	shortID := scopeID[:len(scopeID)-1]
+	shortBech32, err := bech32.ConvertAndEncode(PrefixScope, shortID)
+	s.Require().NoError(err, "ConvertAndEncode short scope")
+	accAddr := sdk.AccAddress("nope_nope_nope_nope_")
+
+	tests := []struct {
+		name string
+		str  string
+		exp  MetadataAddress
+	}{
+		{name: "empty", str: "", exp: nil},
+		{name: "only whitespace", str: " \t\n ", exp: nil},
+		{name: "bech32 scope", str: scopeID.String(), exp: scopeID},
+		{name: "bech32 too short", str: shortBech32, exp: shortID},
+		{name: "account address", str: accAddr.String(), exp: MetadataAddress(accAddr)},
+		{name: "hex with whitespace", str: " " + scopeHex + "\n", exp: scopeID},
+		{name: "hex too short", str: scopeHex[:len(scopeHex)-2], exp: shortID},
+		{name: "denom", str: scopeID.Denom(), exp: scopeID},
+		{name: "denom too short", str: DenomPrefix + shortBech32, exp: shortID},
+		{name: "denom prefix only", str: DenomPrefix, exp: nil},
+		{name: "not decodable", str: "not an address", exp: nil},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var addr MetadataAddress
+			testFunc := func() {
+				addr = UnverifiedMetadataAddressFromString(tc.str)
+			}
+			s.Require().NotPanics(testFunc, "UnverifiedMetadataAddressFromString(%q)", tc.str)
+			s.Assert().Equal(tc.exp, addr, "UnverifiedMetadataAddressFromString(%q)", tc.str)
+		})
+	}
+}
+
 func (s *AddressTestSuite) TestMetadataAddressWithInvalidData() {
 	t := s.T()
 
@@ -824,7 +1427,8 @@ func (s *AddressTestSuite) TestMetadataAddressWithInvalidData() {
 	require.NoError(t, addrErr, "address parsing error")
 
 	_, err := VerifyMetadataAddressFormat(addr)
-	require.EqualValues(t, fmt.Errorf("invalid metadata address type: %d", addr[0]), err)
+	require.EqualError(t, err, fmt.Sprintf("invalid metadata address type: %d", addr[0]))
+	require.ErrorIs(t, err, ErrAddressParse)
 
 	scopeID := ScopeMetadataAddress(s.scopeUUID)
 	padded := make([]byte, 20)
@@ -833,19 +1437,36 @@ func (s *AddressTestSuite) TestMetadataAddressWithInvalidData() {
 	require.EqualValues(t, 17, length)
 
 	_, err = VerifyMetadataAddressFormat(padded)
-	require.EqualValues(t, fmt.Errorf("incorrect address length (expected: %d, actual: %d)", 17, 20), err)
+	require.EqualError(t, err, fmt.Sprintf("incorrect address length (expected: %d, actual: %d)", 17, 20))
+	require.ErrorIs(t, err, ErrAddressParse)
 
 	_, err = MetadataAddressFromBech32("")
-	require.EqualValues(t, errors.New("empty address string is not allowed"), err)
+	require.EqualError(t, err, "empty address string is not allowed")
+	require.ErrorIs(t, err, ErrAddressParse)
 
 	_, err = MetadataAddressFromBech32("scope1qzxcpvj6czy5g354dews3nlruxjsahh")
 	require.EqualValues(t, "decoding bech32 failed: invalid checksum (expected 57e9fl got xjsahh)", err.Error())
+	require.ErrorIs(t, err, ErrAddressParse)
 
 	_, err = MetadataAddressFromHex("")
-	require.EqualValues(t, errors.New("address decode failed: must provide an address"), err)
+	require.EqualError(t, err, "address decode failed: must provide an address")
+	require.ErrorIs(t, err, ErrAddressParse)
 
 	_, err = MetadataAddressFromHex(s.scopeHex + "!!BAD")
-	require.EqualValues(t, hex.InvalidByteError(0x21), err)
+	require.ErrorIs(t, err, hex.InvalidByteError(0x21))
+	require.ErrorIs(t, err, ErrAddressParse)
+
+	wrongHRP, err := bech32.ConvertAndEncode(PrefixSession, scopeID)
+	require.NoError(t, err, "ConvertAndEncode")
+	_, err = MetadataAddressFromBech32(wrongHRP)
+	require.EqualError(t, err, "invalid bech32 prefix; expected scope, got session")
+	require.ErrorIs(t, err, ErrAddressWrongType)
+	require.NotErrorIs(t, err, ErrAddressParse)
+
+	err = VerifyMetadataAddressHasType(scopeID, PrefixRecord)
+	require.ErrorIs(t, err, ErrAddressWrongType)
+	err = VerifyMetadataAddressHasType(padded, PrefixScope)
+	require.ErrorIs(t, err, ErrAddressParse)
 
 	var testMarshal MetadataAddress
 	err = testMarshal.UnmarshalJSON([]byte(s.scopeBech32 + "{bad}{json}"))
@@ -860,6 +1481,179 @@ func (s *AddressTestSuite) TestMetadataAddressWithInvalidData() {
 	require.NoError(t, err)
 }
 
+func (s *AddressTestSuite) TestMetadataAddressTypedErrors() {
+	scopeID := ScopeMetadataAddress(s.scopeUUID)
+	sessionID := SessionMetadataAddress(s.scopeUUID, uuid.MustParse("5803f8bc-6067-4eb5-951f-2121671c2ec0"))
+	verify := func(ma MetadataAddress) error {
+		_, err := VerifyMetadataAddressFormat(ma)
+		return err
+	}
+	primary := func(ma MetadataAddress) error {
+		_, err := ma.PrimaryUUID()
+		return err
+	}
+	secondary := func(ma MetadataAddress) error {
+		_, err := ma.SecondaryUUID()
+		return err
+	}
+	nameHash := func(ma MetadataAddress) error {
+		_, err := ma.NameHash()
+		return err
+	}
+
+	tests := []struct {
+		name     string
+		f        func(ma MetadataAddress) error
+		ma       MetadataAddress
+		expErr   string
+		expEmpty bool
+		expType  *InvalidAddressTypeError
+		expLen   *InvalidAddressLengthError
+		expParse bool
+	}{
+		{
+			name:     "verify: empty",
+			f:        verify,
+			ma:       MetadataAddress{},
+			expErr:   "address is empty",
+			expEmpty: true,
+			expParse: true,
+		},
+		{
+			name:     "verify: unknown type",
+			f:        verify,
+			ma:       MetadataAddress{0x9},
+			expErr:   "invalid metadata address type: 9",
+			expType:  &InvalidAddressTypeError{TypeByte: 0x9},
+			expParse: true,
+		},
+		{
+			name:     "verify: wrong length",
+			f:        verify,
+			ma:       sessionID[:20],
+			expErr:   "incorrect address length (expected: 33, actual: 20)",
+			expLen:   &InvalidAddressLengthError{Expected: 33, Actual: 20},
+			expParse: true,
+		},
+		{
+			name:     "primary uuid: empty",
+			f:        primary,
+			ma:       nil,
+			expErr:   "address empty",
+			expEmpty: true,
+		},
+		{
+			name:    "primary uuid: unknown type",
+			f:       primary,
+			ma:      MetadataAddress{0x9, 0x1},
+			expErr:  "invalid address type out of valid range (got: 9)",
+			expType: &InvalidAddressTypeError{TypeByte: 0x9},
+		},
+		{
+			name:   "primary uuid: too short",
+			f:      primary,
+			ma:     scopeID[:10],
+			expErr: "incorrect address length (must be at least 17, actual: 10)",
+			expLen: &InvalidAddressLengthError{Expected: 17, Actual: 10},
+		},
+		{
+			name:     "secondary uuid: empty",
+			f:        secondary,
+			ma:       MetadataAddress{},
+			expErr:   "address empty",
+			expEmpty: true,
+		},
+		{
+			name:    "secondary uuid: wrong type",
+			f:       secondary,
+			ma:      scopeID,
+			expErr:  "invalid address type out of valid range (got: 0)",
+			expType: &InvalidAddressTypeError{TypeByte: ScopeKeyPrefix[0]},
+		},
+		{
+			name:   "secondary uuid: too short",
+			f:      secondary,
+			ma:     sessionID[:20],
+			expErr: "incorrect address length (must be at least 33, actual: 20)",
+			expLen: &InvalidAddressLengthError{Expected: 33, Actual: 20},
+		},
+		{
+			name:     "name hash: empty",
+			f:        nameHash,
+			ma:       nil,
+			expErr:   "address empty",
+			expEmpty: true,
+		},
+		{
+			name:    "name hash: wrong type",
+			f:       nameHash,
+			ma:      sessionID,
+			expErr:  "invalid address type out of valid range (got: 1)",
+			expType: &InvalidAddressTypeError{TypeByte: SessionKeyPrefix[0]},
+		},
+		{
+			name:   "name hash: too short",
+			f:      nameHash,
+			ma:     RecordMetadataAddress(s.scopeUUID, "recname")[:30],
+			expErr: "incorrect address length (must be at least 33, actual: 30)",
+			expLen: &InvalidAddressLengthError{Expected: 33, Actual: 30},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := tc.f(tc.ma)
+			s.Require().EqualError(err, tc.expErr, "error")
+
+			if tc.expEmpty {
+				s.Assert().ErrorIs(err, ErrEmptyAddress, "error")
+			} else {
+				s.Assert().NotErrorIs(err, ErrEmptyAddress, "error")
+			}
+			if tc.expParse {
+				s.Assert().ErrorIs(err, ErrAddressParse, "error")
+			} else {
+				s.Assert().NotErrorIs(err, ErrAddressParse, "error")
+			}
+
+			var typeErr *InvalidAddressTypeError
+			if s.Assert().Equal(tc.expType != nil, errors.As(err, &typeErr), "errors.As(err, *InvalidAddressTypeError)") && tc.expType != nil {
+				s.Assert().Equal(tc.expType, typeErr, "InvalidAddressTypeError")
+			}
+			var lenErr *InvalidAddressLengthError
+			if s.Assert().Equal(tc.expLen != nil, errors.As(err, &lenErr), "errors.As(err, *InvalidAddressLengthError)") && tc.expLen != nil {
+				s.Assert().Equal(tc.expLen, lenErr, "InvalidAddressLengthError")
+			}
+			var uuidErr *InvalidUUIDBytesError
+			s.Assert().False(errors.As(err, &uuidErr), "errors.As(err, *InvalidUUIDBytesError)")
+		})
+	}
+}
+
+func TestInvalidUUIDBytesError(t *testing.T) {
+	inner := errors.New("bad uuid")
+	tests := []struct {
+		position string
+		exp      string
+	}{
+		{position: UUIDPositionPrimary, exp: "invalid address bytes of uuid, expected uuid compliant: bad uuid"},
+		{position: UUIDPositionSecondary, exp: "invalid address bytes of secondary uuid, expected uuid compliant: bad uuid"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.position, func(t *testing.T) {
+			err := newAddressError(ErrAddressParse, &InvalidUUIDBytesError{Position: tc.position, Err: inner})
+			assert.EqualError(t, err, tc.exp, "Error()")
+			assert.ErrorIs(t, err, ErrAddressParse, "error")
+			assert.ErrorIs(t, err, inner, "error")
+			var uuidErr *InvalidUUIDBytesError
+			if assert.True(t, errors.As(err, &uuidErr), "errors.As(err, *InvalidUUIDBytesError)") {
+				assert.Equal(t, tc.position, uuidErr.Position, "Position")
+			}
+		})
+	}
+}
+
 func (s *AddressTestSuite) TestMetadataAddressMarshal() {
 	t := s.T()
 
@@ -881,6 +1675,117 @@ func (s *AddressTestSuite) TestMetadataAddressMarshal() {
 	require.EqualValues(t, scopeID, newInstance)
 }
 
+func (s *AddressTestSuite) TestMetadataAddressEmpty() {
+	tests := []struct {
+		name string
+		addr MetadataAddress
+		exp  bool
+	}{
+		{name: "nil", addr: nil, exp: true},
+		{name: "empty", addr: MetadataAddress{}, exp: true},
+		{name: "empty slice of a longer address", addr: ScopeMetadataAddress(s.scopeUUID)[:0], exp: true},
+		{name: "one byte", addr: MetadataAddress{0}, exp: false},
+		{name: "scope", addr: ScopeMetadataAddress(s.scopeUUID), exp: false},
+		{name: "invalid", addr: MetadataAddress("not a metadata address"), exp: false},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var act bool
+			testFunc := func() {
+				act = tc.addr.Empty()
+			}
+			s.Require().NotPanics(testFunc, "Empty()")
+			s.Assert().Equal(tc.exp, act, "Empty()")
+		})
+	}
+}
+
+func (s *AddressTestSuite) TestMetadataAddressIdenticalTo() {
+	scopeID := ScopeMetadataAddress(s.scopeUUID)
+	otherScopeID := ScopeMetadataAddress(s.sessionUUID)
+
+	tests := []struct {
+		name     string
+		addr     MetadataAddress
+		other    MetadataAddress
+		exp      bool
+		expEqual bool
+	}{
+		{name: "nil nil", addr: nil, other: nil, exp: true, expEqual: true},
+		{name: "empty empty", addr: MetadataAddress{}, other: MetadataAddress{}, exp: true, expEqual: true},
+		{name: "nil empty", addr: nil, other: MetadataAddress{}, exp: false, expEqual: true},
+		{name: "empty nil", addr: MetadataAddress{}, other: nil, exp: false, expEqual: true},
+		{name: "nil scope", addr: nil, other: scopeID, exp: false, expEqual: false},
+		{name: "scope nil", addr: scopeID, other: nil, exp: false, expEqual: false},
+		{name: "empty scope", addr: MetadataAddress{}, other: scopeID, exp: false, expEqual: false},
+		{name: "scope empty", addr: scopeID, other: MetadataAddress{}, exp: false, expEqual: false},
+		{name: "same scope", addr: scopeID, other: scopeID, exp: true, expEqual: true},
+		{name: "copy of scope", addr: scopeID, other: slices.Clone(scopeID), exp: true, expEqual: true},
+		{name: "different scopes", addr: scopeID, other: otherScopeID, exp: false, expEqual: false},
+		{name: "prefix of other", addr: scopeID[:16], other: scopeID, exp: false, expEqual: false},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var act bool
+			testFunc := func() {
+				act = tc.addr.IdenticalTo(tc.other)
+			}
+			s.Require().NotPanics(testFunc, "IdenticalTo")
+			s.Assert().Equal(tc.exp, act, "IdenticalTo")
+			s.Assert().Equal(tc.expEqual, tc.addr.Equals(tc.other), "Equals")
+		})
+	}
+}
+
+func (s *AddressTestSuite) TestMetadataAddressUnmarshalStrict() {
+	scopeID := ScopeMetadataAddress(s.scopeUUID)
+	truncated := scopeID[:10]
+
+	s.Run("valid", func() {
+		var ma MetadataAddress
+		err := ma.UnmarshalStrict(scopeID)
+		s.Require().NoError(err, "UnmarshalStrict")
+		s.Assert().Equal(scopeID, ma, "resulting address")
+	})
+
+	s.Run("empty", func() {
+		ma := MetadataAddress(scopeID)
+		err := ma.UnmarshalStrict(nil)
+		s.Require().NoError(err, "UnmarshalStrict")
+		s.Assert().Empty(ma, "resulting address")
+	})
+
+	s.Run("truncated", func() {
+		ma := MetadataAddress(scopeID)
+		err := ma.UnmarshalStrict(truncated)
+		s.Assert().EqualError(err, "could not unmarshal metadata address from 10 bytes: incorrect address length (expected: 17, actual: 10)", "UnmarshalStrict")
+		s.Assert().ErrorIs(err, ErrAddressParse, "UnmarshalStrict")
+		s.Assert().Empty(ma, "resulting address")
+	})
+
+	s.Run("non-strict unmarshal keeps invalid bytes", func() {
+		defer func(orig bool) { StrictMetadataAddressUnmarshal = orig }(StrictMetadataAddressUnmarshal)
+		StrictMetadataAddressUnmarshal = false
+		var ma MetadataAddress
+		err := ma.Unmarshal(truncated)
+		s.Assert().EqualError(err, "incorrect address length (expected: 17, actual: 10)", "Unmarshal")
+		s.Assert().Equal(truncated, ma, "resulting address")
+	})
+
+	s.Run("msg with truncated scope id", func() {
+		defer func(orig bool) { StrictMetadataAddressUnmarshal = orig }(StrictMetadataAddressUnmarshal)
+		StrictMetadataAddressUnmarshal = true
+		bz, err := (&MsgDeleteScopeRequest{ScopeId: truncated, Signers: []string{"signer"}}).Marshal()
+		s.Require().NoError(err, "Marshal")
+		var msg MsgDeleteScopeRequest
+		err = msg.Unmarshal(bz)
+		s.Assert().EqualError(err, "could not unmarshal metadata address from 10 bytes: incorrect address length (expected: 17, actual: 10)", "Unmarshal")
+		s.Assert().Empty(msg.ScopeId, "ScopeId")
+	})
+}
+
 func (s *AddressTestSuite) TestCompare() {
 	maEmpty := MetadataAddress{}
 	ma1 := MetadataAddress("1")
@@ -972,6 +1877,86 @@ func (s *AddressTestSuite) TestMetadataAddressIteratorPrefix() {
 	require.Equal(t, RecordSpecificationKeyPrefix[0], bz[0], "ContractSpecRecordSpecIteratorPrefix first byte")
 }
 
+func (s *AddressTestSuite) TestIteratorPrefixesShortAddress() {
+	tooShort := func(actual int) string {
+		return fmt.Sprintf("incorrect address length (must be at least 17, actual: %d)", actual)
+	}
+	tests := []struct {
+		name   string
+		getter func(ma MetadataAddress) ([]byte, error)
+		addr   MetadataAddress
+	}{
+		{name: "ScopeSessionIteratorPrefix scope", getter: MetadataAddress.ScopeSessionIteratorPrefix, addr: MetadataAddress{ScopeKeyPrefix[0], 1, 2}},
+		{name: "ScopeSessionIteratorPrefix session", getter: MetadataAddress.ScopeSessionIteratorPrefix, addr: MetadataAddress{SessionKeyPrefix[0]}},
+		{name: "ScopeRecordIteratorPrefix scope", getter: MetadataAddress.ScopeRecordIteratorPrefix, addr: MetadataAddress{ScopeKeyPrefix[0], 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}},
+		{name: "ScopeRecordIteratorPrefix record", getter: MetadataAddress.ScopeRecordIteratorPrefix, addr: MetadataAddress{RecordKeyPrefix[0], 1}},
+		{name: "ContractSpecRecordSpecIteratorPrefix contract spec", getter: MetadataAddress.ContractSpecRecordSpecIteratorPrefix, addr: MetadataAddress{ContractSpecificationKeyPrefix[0], 1, 2}},
+		{name: "ContractSpecRecordSpecIteratorPrefix record spec", getter: MetadataAddress.ContractSpecRecordSpecIteratorPrefix, addr: MetadataAddress{RecordSpecificationKeyPrefix[0]}},
+		{name: "ScopeSpecScopeIteratorPrefix", getter: MetadataAddress.ScopeSpecScopeIteratorPrefix, addr: MetadataAddress{ScopeSpecificationKeyPrefix[0], 1, 2, 3}},
+		{name: "ContractSpecSessionIteratorPrefix contract spec", getter: MetadataAddress.ContractSpecSessionIteratorPrefix, addr: MetadataAddress{ContractSpecificationKeyPrefix[0], 1}},
+		{name: "ContractSpecSessionIteratorPrefix record spec", getter: MetadataAddress.ContractSpecSessionIteratorPrefix, addr: MetadataAddress{RecordSpecificationKeyPrefix[0], 1, 2, 3, 4}},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var bz []byte
+			var err error
+			testFunc := func() {
+				bz, err = tc.getter(tc.addr)
+			}
+			s.Require().NotPanics(testFunc, "%s(%v)", tc.name, []byte(tc.addr))
+			s.Assert().EqualError(err, tooShort(len(tc.addr)), "%s(%v) error", tc.name, []byte(tc.addr))
+			var lenErr *InvalidAddressLengthError
+			s.Assert().ErrorAs(err, &lenErr, "%s(%v) error", tc.name, []byte(tc.addr))
+			s.Assert().Equal([]byte{}, bz, "%s(%v) result", tc.name, []byte(tc.addr))
+		})
+	}
+}
+
+func (s *AddressTestSuite) TestSpecIndexIteratorPrefixes() {
+	t := s.T()
+
+	var emptyID MetadataAddress
+	bz, err := emptyID.ScopeSpecScopeIteratorPrefix()
+	assert.NoError(t, err, "empty address ScopeSpecScopeIteratorPrefix error")
+	assert.Equal(t, ScopeSpecScopeCacheKeyPrefix, bz, "empty address ScopeSpecScopeIteratorPrefix value")
+	bz, err = emptyID.ContractSpecSessionIteratorPrefix()
+	assert.NoError(t, err, "empty address ContractSpecSessionIteratorPrefix error")
+	assert.Equal(t, ContractSpecSessionCacheKeyPrefix, bz, "empty address ContractSpecSessionIteratorPrefix value")
+
+	scopeID := ScopeMetadataAddress(s.scopeUUID)
+	bz, err = scopeID.ScopeSpecScopeIteratorPrefix()
+	assert.EqualError(t, err, "this metadata address does not contain a scope spec uuid", "scope id ScopeSpecScopeIteratorPrefix error message")
+	assert.Equal(t, []byte{}, bz, "scope id ScopeSpecScopeIteratorPrefix value")
+	bz, err = scopeID.ContractSpecSessionIteratorPrefix()
+	assert.EqualError(t, err, "this metadata address does not contain a contract spec uuid", "scope id ContractSpecSessionIteratorPrefix error message")
+	assert.Equal(t, []byte{}, bz, "scope id ContractSpecSessionIteratorPrefix value")
+
+	contractSpecID := ContractSpecMetadataAddress(s.scopeUUID)
+	bz, err = contractSpecID.ScopeSpecScopeIteratorPrefix()
+	assert.EqualError(t, err, "this metadata address does not contain a scope spec uuid", "contract spec id ScopeSpecScopeIteratorPrefix error message")
+	assert.Equal(t, []byte{}, bz, "contract spec id ScopeSpecScopeIteratorPrefix value")
+
+	scopeSpecID := ScopeSpecMetadataAddress(s.scopeUUID)
+	bz, err = scopeSpecID.ScopeSpecScopeIteratorPrefix()
+	require.NoError(t, err, "ScopeSpecScopeIteratorPrefix error")
+	assert.Equal(t, GetScopeSpecScopeCacheIteratorPrefix(scopeSpecID), bz, "ScopeSpecScopeIteratorPrefix value")
+	assert.Equal(t, ScopeSpecScopeCacheKeyPrefix, bz[0:1], "ScopeSpecScopeIteratorPrefix first byte")
+	assert.Equal(t, s.scopeUUID[:], bz[2:18], "ScopeSpecScopeIteratorPrefix uuid bytes")
+
+	expPrefix := GetContractSpecSessionCacheIteratorPrefix(contractSpecID)
+	bz, err = contractSpecID.ContractSpecSessionIteratorPrefix()
+	require.NoError(t, err, "ContractSpecSessionIteratorPrefix error")
+	assert.Equal(t, expPrefix, bz, "ContractSpecSessionIteratorPrefix value")
+	assert.Equal(t, ContractSpecSessionCacheKeyPrefix, bz[0:1], "ContractSpecSessionIteratorPrefix first byte")
+	assert.Equal(t, s.scopeUUID[:], bz[2:18], "ContractSpecSessionIteratorPrefix uuid bytes")
+
+	recordSpecID := RecordSpecMetadataAddress(s.scopeUUID, "recspec")
+	bz, err = recordSpecID.ContractSpecSessionIteratorPrefix()
+	require.NoError(t, err, "record spec id ContractSpecSessionIteratorPrefix error")
+	assert.Equal(t, expPrefix, bz, "record spec id ContractSpecSessionIteratorPrefix value")
+}
+
 func (s *AddressTestSuite) TestScopeMetadataAddress() {
 	t := s.T()
 
@@ -1114,6 +2099,147 @@ func (s *AddressTestSuite) TestContractSpecMetadataAddress() {
 	require.Equal(t, contractSpecUUID, contractSpecUUIDFromContractSpecId, "value from ContractSpecUUID")
 }
 
+func (s *AddressTestSuite) TestScopeSpecMetadataAddressFromName() {
+	t := s.T()
+
+	name := "my.company.loan.spec"
+	nameHash := sha256.Sum256([]byte(name))
+
+	scopeSpecID, err := ScopeSpecMetadataAddressFromName(name)
+	require.NoError(t, err, "ScopeSpecMetadataAddressFromName(%q)", name)
+	require.True(t, scopeSpecID.IsScopeSpecificationAddress(), "IsScopeSpecificationAddress")
+	require.Equal(t, ScopeSpecificationKeyPrefix, scopeSpecID[0:1].Bytes(), "bytes[0]: the type bit")
+	require.Equal(t, nameHash[0:16], scopeSpecID[1:17].Bytes(), "bytes[1:17]: the hashed name")
+	_, err = VerifyMetadataAddressFormat(scopeSpecID)
+	require.NoError(t, err, "VerifyMetadataAddressFormat")
+
+	scopeSpecIDFromBech32, err := MetadataAddressFromBech32(scopeSpecID.String())
+	require.NoError(t, err, "error from MetadataAddressFromBech32")
+	require.Equal(t, scopeSpecID, scopeSpecIDFromBech32, "value from MetadataAddressFromBech32")
+
+	for _, other := range []string{"My.Company.Loan.Spec", "MY.COMPANY.LOAN.SPEC", "  my.company.loan.spec\t"} {
+		otherID, otherErr := ScopeSpecMetadataAddressFromName(other)
+		if assert.NoError(t, otherErr, "ScopeSpecMetadataAddressFromName(%q)", other) {
+			assert.Equal(t, scopeSpecID, otherID, "ScopeSpecMetadataAddressFromName(%q)", other)
+		}
+	}
+
+	for _, empty := range []string{"", "   "} {
+		emptyID, emptyErr := ScopeSpecMetadataAddressFromName(empty)
+		assert.EqualError(t, emptyErr, "invalid scope spec name: name cannot be empty", "ScopeSpecMetadataAddressFromName(%q) error", empty)
+		assert.Nil(t, emptyID, "ScopeSpecMetadataAddressFromName(%q) result", empty)
+	}
+}
+
+func (s *AddressTestSuite) TestContractSpecMetadataAddressFromName() {
+	t := s.T()
+
+	name := "my.company.loan.contract"
+	nameHash := sha256.Sum256([]byte(name))
+
+	contractSpecID, err := ContractSpecMetadataAddressFromName(name)
+	require.NoError(t, err, "ContractSpecMetadataAddressFromName(%q)", name)
+	require.True(t, contractSpecID.IsContractSpecificationAddress(), "IsContractSpecificationAddress")
+	require.Equal(t, ContractSpecificationKeyPrefix, contractSpecID[0:1].Bytes(), "bytes[0]: the type bit")
+	require.Equal(t, nameHash[0:16], contractSpecID[1:17].Bytes(), "bytes[1:17]: the hashed name")
+	_, err = VerifyMetadataAddressFormat(contractSpecID)
+	require.NoError(t, err, "VerifyMetadataAddressFormat")
+
+	contractSpecIDFromBech32, err := MetadataAddressFromBech32(contractSpecID.String())
+	require.NoError(t, err, "error from MetadataAddressFromBech32")
+	require.Equal(t, contractSpecID, contractSpecIDFromBech32, "value from MetadataAddressFromBech32")
+
+	for _, other := range []string{"My.Company.Loan.Contract", "MY.COMPANY.LOAN.CONTRACT", "  my.company.loan.contract\t"} {
+		otherID, otherErr := ContractSpecMetadataAddressFromName(other)
+		if assert.NoError(t, otherErr, "ContractSpecMetadataAddressFromName(%q)", other) {
+			assert.Equal(t, contractSpecID, otherID, "ContractSpecMetadataAddressFromName(%q)", other)
+		}
+	}
+
+	for _, empty := range []string{"", "   "} {
+		emptyID, emptyErr := ContractSpecMetadataAddressFromName(empty)
+		assert.EqualError(t, emptyErr, "invalid contract spec name: name cannot be empty", "ContractSpecMetadataAddressFromName(%q) error", empty)
+		assert.Nil(t, emptyID, "ContractSpecMetadataAddressFromName(%q) result", empty)
+	}
+
+	// The same name should give the same uuid for both a scope spec and contract spec.
+	scopeSpecID, err := ScopeSpecMetadataAddressFromName(name)
+	require.NoError(t, err, "ScopeSpecMetadataAddressFromName(%q)", name)
+	require.Equal(t, scopeSpecID[1:], contractSpecID[1:], "scope spec and contract spec uuid bytes")
+}
+
+func (s *AddressTestSuite) TestMetadataAddressFromSeed() {
+	t := s.T()
+
+	expNamespace := uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://provenance.io/metadata/seed"))
+	require.Equal(t, expNamespace, SeedNamespace, "SeedNamespace")
+
+	seed := "loan-123"
+	expUUID := uuid.NewSHA1(SeedNamespace, []byte(seed))
+	require.Equal(t, expUUID, UUIDFromSeed(SeedNamespace, seed), "UUIDFromSeed(SeedNamespace, %q)", seed)
+	require.Equal(t, uint8(5), uint8(expUUID.Version()), "uuid version")
+
+	scopeUUID := uuid.MustParse("8d80b25a-c089-4446-956e-5d08cfe3e1a5")
+	tests := []struct {
+		name   string
+		fromFn func(seed string) MetadataAddress
+		exp    MetadataAddress
+		isType func(ma MetadataAddress) bool
+	}{
+		{
+			name:   "scope",
+			fromFn: ScopeMetadataAddressFromSeed,
+			exp:    ScopeMetadataAddress(expUUID),
+			isType: MetadataAddress.IsScopeAddress,
+		},
+		{
+			name: "session",
+			fromFn: func(seed string) MetadataAddress {
+				return SessionMetadataAddressFromSeed(scopeUUID, seed)
+			},
+			exp:    SessionMetadataAddress(scopeUUID, expUUID),
+			isType: MetadataAddress.IsSessionAddress,
+		},
+		{
+			name:   "scope spec",
+			fromFn: ScopeSpecMetadataAddressFromSeed,
+			exp:    ScopeSpecMetadataAddress(expUUID),
+			isType: MetadataAddress.IsScopeSpecificationAddress,
+		},
+		{
+			name:   "contract spec",
+			fromFn: ContractSpecMetadataAddressFromSeed,
+			exp:    ContractSpecMetadataAddress(expUUID),
+			isType: MetadataAddress.IsContractSpecificationAddress,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			addr := tc.fromFn(seed)
+			assert.Equal(t, tc.exp, addr, "address from seed %q", seed)
+			assert.True(t, tc.isType(addr), "address type check")
+			_, err := VerifyMetadataAddressFormat(addr)
+			assert.NoError(t, err, "VerifyMetadataAddressFormat")
+
+			again := tc.fromFn(seed)
+			assert.Equal(t, addr.String(), again.String(), "bech32 of second address from seed %q", seed)
+
+			other := tc.fromFn(seed + "x")
+			assert.NotEqual(t, addr.String(), other.String(), "bech32 of address from different seed")
+		})
+	}
+
+	t.Run("different namespaces", func(t *testing.T) {
+		otherNamespace := uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://example.com/other"))
+		uuid1 := UUIDFromSeed(SeedNamespace, seed)
+		uuid2 := UUIDFromSeed(otherNamespace, seed)
+		assert.NotEqual(t, uuid1, uuid2, "uuids from the same seed in different namespaces")
+		assert.NotEqual(t, ScopeMetadataAddress(uuid1).String(), ScopeMetadataAddress(uuid2).String(),
+			"scope ids from the same seed in different namespaces")
+	})
+}
+
 func (s *AddressTestSuite) TestRecordSpecMetadataAddress() {
 	t := s.T()
 
@@ -1560,6 +2686,183 @@ func (s *AddressTestSuite) TestNameHash() {
 	}
 }
 
+func (s *AddressTestSuite) TestMatchesName() {
+	recordID := RecordMetadataAddress(uuid.New(), "RecordName")
+	recordSpecID := RecordSpecMetadataAddress(uuid.New(), "recspecname")
+
+	tests := []struct {
+		name   string
+		id     MetadataAddress
+		input  string
+		exp    bool
+		expErr string
+	}{
+		{name: "record: same name", id: recordID, input: "recordname", exp: true},
+		{name: "record: original name", id: recordID, input: "RecordName", exp: true},
+		{name: "record: upper case with whitespace", id: recordID, input: "  RECORDNAME\t", exp: true},
+		{name: "record: different name", id: recordID, input: "recordname2", exp: false},
+		{name: "record: empty name", id: recordID, input: "", exp: false},
+		{name: "record spec: same name", id: recordSpecID, input: "recspecname", exp: true},
+		{name: "record spec: different name", id: recordSpecID, input: "recordname", exp: false},
+		{
+			name:   "scope",
+			id:     ScopeMetadataAddress(uuid.New()),
+			input:  "recordname",
+			expErr: "invalid address type out of valid range (got: 0)",
+		},
+		{
+			name:   "session",
+			id:     SessionMetadataAddress(uuid.New(), uuid.New()),
+			input:  "recordname",
+			expErr: "invalid address type out of valid range (got: 1)",
+		},
+		{
+			name:   "contract spec",
+			id:     ContractSpecMetadataAddress(uuid.New()),
+			input:  "recordname",
+			expErr: "invalid address type out of valid range (got: 3)",
+		},
+		{
+			name:   "empty",
+			id:     MetadataAddress{},
+			input:  "recordname",
+			expErr: "address empty",
+		},
+		{
+			name:   "too short",
+			id:     recordID[0:32],
+			input:  "recordname",
+			expErr: "incorrect address length (must be at least 33, actual: 32)",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			actual, err := tc.id.MatchesName(tc.input)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "MatchesName(%q) error", tc.input)
+			} else {
+				s.Assert().NoError(err, "MatchesName(%q) error", tc.input)
+			}
+			s.Assert().Equal(tc.exp, actual, "MatchesName(%q) result", tc.input)
+		})
+	}
+}
+
+func (s *AddressTestSuite) TestNamedRecordAddress() {
+	scopeUUID := uuid.New()
+	scopeID := ScopeMetadataAddress(scopeUUID)
+	cSpecUUID := uuid.New()
+	cSpecID := ContractSpecMetadataAddress(cSpecUUID)
+
+	s.Run("record from scope", func() {
+		named, err := NewNamedRecordAddress(scopeID, "  RecordName ")
+		s.Require().NoError(err, "NewNamedRecordAddress")
+		s.Assert().Equal(RecordMetadataAddress(scopeUUID, "recordname"), named.Address, "Address")
+		s.Assert().Equal("recordname", named.Name, "Name")
+		s.Assert().NoError(named.Validate(), "Validate")
+	})
+
+	s.Run("record from session", func() {
+		named, err := NewNamedRecordAddress(SessionMetadataAddress(scopeUUID, uuid.New()), "recordname")
+		s.Require().NoError(err, "NewNamedRecordAddress")
+		s.Assert().Equal(RecordMetadataAddress(scopeUUID, "recordname"), named.Address, "Address")
+	})
+
+	s.Run("record spec", func() {
+		named, err := NewNamedRecordSpecAddress(cSpecID, "SpecName")
+		s.Require().NoError(err, "NewNamedRecordSpecAddress")
+		s.Assert().Equal(RecordSpecMetadataAddress(cSpecUUID, "specname"), named.Address, "Address")
+		s.Assert().Equal("specname", named.Name, "Name")
+		s.Assert().NoError(named.Validate(), "Validate")
+	})
+
+	s.Run("constructor errors", func() {
+		_, err := NewNamedRecordAddress(scopeID, "  ")
+		s.Assert().EqualError(err, "missing name value for record metadata address", "NewNamedRecordAddress empty name")
+		_, err = NewNamedRecordAddress(cSpecID, "recordname")
+		s.Assert().EqualError(err, "this metadata address ("+cSpecID.String()+") does not contain a scope uuid",
+			"NewNamedRecordAddress with contract spec id")
+		_, err = NewNamedRecordSpecAddress(cSpecID, "")
+		s.Assert().EqualError(err, "missing name value for record spec metadata address", "NewNamedRecordSpecAddress empty name")
+		_, err = NewNamedRecordSpecAddress(scopeID, "specname")
+		s.Assert().Error(err, "NewNamedRecordSpecAddress with scope id")
+	})
+
+	s.Run("name edited after construction", func() {
+		named, err := NewNamedRecordAddress(scopeID, "recordname")
+		s.Require().NoError(err, "NewNamedRecordAddress")
+		named.Name = "othername"
+		s.Assert().EqualError(named.Validate(), `name "othername" does not match the name hash of `+named.Address.String(), "Validate")
+	})
+
+	s.Run("validate errors", func() {
+		recordID := RecordMetadataAddress(scopeUUID, "recordname")
+		s.Assert().EqualError(NamedRecordAddress{Address: recordID}.Validate(), "invalid name: cannot be empty", "Validate without name")
+		s.Assert().EqualError(NamedRecordAddress{Name: "recordname"}.Validate(), "invalid address: address is empty", "Validate without address")
+		s.Assert().EqualError(NamedRecordAddress{Address: scopeID, Name: "recordname"}.Validate(),
+			"invalid address: invalid address type out of valid range (got: 0)", "Validate with scope address")
+	})
+
+	s.Run("json", func() {
+		named, err := NewNamedRecordAddress(scopeID, "RecordName")
+		s.Require().NoError(err, "NewNamedRecordAddress")
+		bz, err := json.Marshal(named)
+		s.Require().NoError(err, "json.Marshal")
+		s.Assert().Equal(`{"address":"`+named.Address.String()+`","name":"recordname"}`, string(bz), "json.Marshal result")
+
+		var unmarshaled NamedRecordAddress
+		s.Require().NoError(json.Unmarshal(bz, &unmarshaled), "json.Unmarshal")
+		s.Assert().Equal(*named, unmarshaled, "json.Unmarshal result")
+		s.Assert().Equal(named.Address.String()+"(recordname)", unmarshaled.String(), "String")
+	})
+}
+
+func (s *AddressTestSuite) TestParseRecordNameHash() {
+	fullHash := sha256.Sum256([]byte("recordname"))
+	nameHash := fullHash[:16]
+	s.Require().Equal(nameHash, RecordNameHash("  RecordName "), "RecordNameHash")
+
+	tests := []struct {
+		name   string
+		input  string
+		exp    []byte
+		expErr string
+	}{
+		{name: "hex", input: hex.EncodeToString(nameHash), exp: nameHash},
+		{name: "upper case hex", input: strings.ToUpper(hex.EncodeToString(nameHash)), exp: nameHash},
+		{name: "full hash hex", input: hex.EncodeToString(fullHash[:]), exp: nameHash},
+		{name: "base64", input: base64.StdEncoding.EncodeToString(nameHash), exp: nameHash},
+		{name: "base64 no padding", input: base64.RawStdEncoding.EncodeToString(nameHash), exp: nameHash},
+		{name: "url base64", input: base64.URLEncoding.EncodeToString(nameHash), exp: nameHash},
+		{name: "full hash base64", input: base64.StdEncoding.EncodeToString(fullHash[:]), exp: nameHash},
+		{name: "surrounding whitespace", input: " " + hex.EncodeToString(nameHash) + " ", exp: nameHash},
+		{name: "empty", input: "  ", expErr: "record name hash cannot be empty"},
+		{
+			name:   "wrong length hex",
+			input:  hex.EncodeToString(nameHash[:8]),
+			expErr: `invalid record name hash "` + hex.EncodeToString(nameHash[:8]) + `": must be the hex or base64 encoding of 16 or 32 bytes`,
+		},
+		{
+			name:   "not an encoding",
+			input:  "not a hash!",
+			expErr: `invalid record name hash "not a hash!": must be the hex or base64 encoding of 16 or 32 bytes`,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			actual, err := ParseRecordNameHash(tc.input)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "ParseRecordNameHash error")
+			} else {
+				s.Assert().NoError(err, "ParseRecordNameHash error")
+			}
+			s.Assert().Equal(tc.exp, actual, "ParseRecordNameHash result")
+		})
+	}
+}
+
 func (s *AddressTestSuite) TestScopeAddressConverters() {
 	randomUUID := uuid.New()
 	scopeID := ScopeMetadataAddress(randomUUID)
@@ -2011,24 +3314,126 @@ func (s mockState) Flag(c int) bool {
 	return false
 }
 
-func (s *AddressTestSuite) TestFormat() {
-	someUUIDStr := "97263339-CFAA-41D9-809E-82CD78C84F02"
-	someUUID, err := uuid.Parse(someUUIDStr)
-	s.Require().NoError(err, "uuid.Parse(%q)", someUUIDStr)
-
-	type namedMetadataAddress struct {
-		name string
-		id   MetadataAddress
+func (s *AddressTestSuite) TestDebugString() {
+	scopeID := ScopeMetadataAddress(s.scopeUUID)
+	sessionID := SessionMetadataAddress(s.scopeUUID, s.sessionUUID)
+	// toBech32 encodes the provided bytes as bech32 using the provided hrp without verifying them.
+	toBech32 := func(hrp string, bz []byte) string {
+		rv, err := bech32.ConvertAndEncode(hrp, bz)
+		s.Require().NoError(err, "ConvertAndEncode(%q, %x)", hrp, bz)
+		return rv
 	}
 
-	scopeID := namedMetadataAddress{name: "scope", id: ScopeMetadataAddress(someUUID)}
-	contractSpecID := namedMetadataAddress{name: "contract spec", id: ContractSpecMetadataAddress(someUUID)}
-	emptyID := namedMetadataAddress{name: "empty", id: MetadataAddress{}}
-	nilID := namedMetadataAddress{name: "nil", id: nil}
-	invalidID := namedMetadataAddress{name: "invalid", id: MetadataAddress("do not create MetadataAddresses this way")}
-	expInvID := "MetadataAddress{0x64, 0x6f, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, " +
-		"0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x20, " +
-		"0x74, 0x68, 0x69, 0x73, 0x20, 0x77, 0x61, 0x79}"
+	tests := []struct {
+		name string
+		ma   MetadataAddress
+		exp  string
+		expV string
+	}{
+		{name: "nil", ma: nil, exp: "MetadataAddress(nil)", expV: ""},
+		{name: "empty", ma: MetadataAddress{}, exp: "MetadataAddress{}", expV: ""},
+		{name: "valid scope", ma: scopeID, exp: s.scopeBech32},
+		{name: "valid session", ma: sessionID, exp: sessionID.String()},
+		{
+			name: "truncated scope",
+			ma:   scopeID[:5],
+			exp:  "invalid[prefix=0x00 len=5 hex=" + hex.EncodeToString(scopeID[:5]) + "]",
+		},
+		{
+			name: "truncated session",
+			ma:   sessionID[:20],
+			exp:  "invalid[prefix=0x01 len=20 hex=" + hex.EncodeToString(sessionID[:20]) + "]",
+		},
+		{
+			name: "only a type byte",
+			ma:   MetadataAddress{RecordKeyPrefix[0]},
+			exp:  "invalid[prefix=0x02 len=1 hex=02]",
+		},
+		{
+			name: "unknown type byte",
+			ma:   MetadataAddress{0x09, 0xde, 0xad, 0xbe, 0xef},
+			exp:  "invalid[prefix=0x09 len=5 hex=09deadbeef]",
+		},
+		{
+			name: "excess bytes",
+			ma:   append(MetadataAddress{}, append(scopeID, 0xab, 0xcd)...),
+			exp:  "invalid[prefix=0x00 len=19 hex=" + hex.EncodeToString(scopeID) + "abcd]",
+		},
+		{
+			name: "excess bytes over hex limit",
+			ma:   append(MetadataAddress{}, append(sessionID, 0xab)...),
+			exp:  "invalid[prefix=0x01 len=34 hex=" + hex.EncodeToString(sessionID[:20]) + "...]",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var act string
+			testFunc := func() {
+				act = tc.ma.DebugString()
+			}
+			s.Require().NotPanics(testFunc, "DebugString()")
+			s.Assert().Equal(tc.exp, act, "DebugString()")
+			s.Assert().NotContains(act, "\n", "DebugString()")
+			expV := tc.exp
+			if len(tc.ma) == 0 {
+				expV = tc.expV
+			}
+			s.Assert().Equal(expV, fmt.Sprintf("%v", tc.ma), "%%v")
+		})
+	}
+
+	s.Run("unmarshal json with invalid bytes", func() {
+		bech := toBech32(PrefixScope, scopeID[:5])
+		var ma MetadataAddress
+		err := ma.UnmarshalJSON([]byte(`"` + bech + `"`))
+		exp := "could not unmarshal metadata address \"" + bech + "\" (invalid[prefix=0x00 len=5 hex=" +
+			hex.EncodeToString(scopeID[:5]) + "]): incorrect address length (expected: 17, actual: 5)"
+		s.Assert().EqualError(err, exp, "UnmarshalJSON")
+		s.Assert().ErrorIs(err, ErrAddressParse, "UnmarshalJSON")
+	})
+
+	s.Run("unmarshal yaml with invalid bytes", func() {
+		bech := toBech32(PrefixScope, MetadataAddress{0x09, 0xde, 0xad, 0xbe, 0xef})
+		var ma MetadataAddress
+		err := ma.UnmarshalYAML([]byte(bech))
+		exp := "could not unmarshal metadata address \"" + bech + "\" (invalid[prefix=0x09 len=5 hex=09deadbeef]): " +
+			"invalid metadata address type: 9"
+		s.Assert().EqualError(err, exp, "UnmarshalYAML")
+		s.Assert().ErrorIs(err, ErrAddressParse, "UnmarshalYAML")
+	})
+
+	s.Run("unmarshal json not bech32", func() {
+		var ma MetadataAddress
+		err := ma.UnmarshalJSON([]byte(`"notbech32"`))
+		s.Assert().ErrorContains(err, `could not unmarshal metadata address "notbech32": `, "UnmarshalJSON")
+	})
+
+	s.Run("verify has type", func() {
+		err := VerifyMetadataAddressHasType(MetadataAddress{0x09, 0xde, 0xad, 0xbe, 0xef}, PrefixScope)
+		exp := `invalid scope metadata address invalid[prefix=0x09 len=5 hex=09deadbeef]: invalid metadata address type: 9`
+		s.Assert().EqualError(err, exp, "VerifyMetadataAddressHasType")
+	})
+}
+
+func (s *AddressTestSuite) TestFormat() {
+	someUUIDStr := "97263339-CFAA-41D9-809E-82CD78C84F02"
+	someUUID, err := uuid.Parse(someUUIDStr)
+	s.Require().NoError(err, "uuid.Parse(%q)", someUUIDStr)
+
+	type namedMetadataAddress struct {
+		name string
+		id   MetadataAddress
+	}
+
+	scopeID := namedMetadataAddress{name: "scope", id: ScopeMetadataAddress(someUUID)}
+	contractSpecID := namedMetadataAddress{name: "contract spec", id: ContractSpecMetadataAddress(someUUID)}
+	emptyID := namedMetadataAddress{name: "empty", id: MetadataAddress{}}
+	nilID := namedMetadataAddress{name: "nil", id: nil}
+	invalidID := namedMetadataAddress{name: "invalid", id: MetadataAddress("do not create MetadataAddresses this way")}
+	expInvID := "MetadataAddress{0x64, 0x6f, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, " +
+		"0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x20, " +
+		"0x74, 0x68, 0x69, 0x73, 0x20, 0x77, 0x61, 0x79}"
 
 	tests := []struct {
 		id  namedMetadataAddress
@@ -2089,7 +3494,7 @@ func (s *AddressTestSuite) TestFormat() {
 		{id: nilID, fmt: "%x", exp: ""},
 		{id: invalidID, fmt: "%s", exp: expInvID},
 		{id: invalidID, fmt: "%q", exp: `"` + expInvID + `"`},
-		{id: invalidID, fmt: "%v", exp: expInvID},
+		{id: invalidID, fmt: "%v", exp: "invalid[prefix=0x64 len=40 hex=646f206e6f7420637265617465204d6574616461...]"},
 		{id: invalidID, fmt: "%#v", exp: expInvID},
 		{id: invalidID, fmt: "%T", exp: "types.MetadataAddress"},
 		{id: invalidID, fmt: "%x", exp: "646f206e6f7420637265617465204d65746164617461416464726573736573207468697320776179"},
@@ -2184,7 +3589,7 @@ func (s *AddressTestSuite) TestValidateIsTypeAddressFuncs() {
 					var expErr string
 					switch {
 					case len(addrDef.expInvalid) > 0:
-						expErr = fmt.Sprintf("invalid %s metadata address %#v: %s", typeName, addrDef.ma, addrDef.expInvalid)
+						expErr = fmt.Sprintf("invalid %s metadata address %s: %s", typeName, addrDef.ma.DebugString(), addrDef.expInvalid)
 					case funcDef.hrp != addrDef.name:
 						expErr = fmt.Sprintf("invalid %s id %q: wrong type", typeName, addrDef.ma)
 					}
@@ -2242,6 +3647,129 @@ func (s *AddressTestSuite) TestGenerateExamples() {
 
 // TODO: GetDetails tests.
 
+func (s *AddressTestSuite) TestGetDetailsExcess() {
+	// garbage returns count bytes that aren't all the same, so a bad truncation offset is noticed.
+	garbage := func(count int) []byte {
+		rv := make([]byte, count)
+		for i := range rv {
+			rv[i] = byte(i*7 + 3)
+		}
+		return rv
+	}
+	scopeID := ScopeMetadataAddress(s.scopeUUID)
+	recordID := RecordMetadataAddress(s.scopeUUID, "recordname")
+
+	tests := []struct {
+		name      string
+		addr      MetadataAddress
+		excess    []byte
+		expSuffix string
+	}{
+		{
+			name:   "scope with no excess",
+			addr:   scopeID,
+			excess: nil,
+		},
+		{
+			name:   "scope with a little excess",
+			addr:   scopeID,
+			excess: garbage(5),
+		},
+		{
+			name:   "scope with exactly max excess",
+			addr:   scopeID,
+			excess: garbage(MaxRenderedExcessBytes),
+		},
+		{
+			name:      "scope with one more than max excess",
+			addr:      scopeID,
+			excess:    garbage(MaxRenderedExcessBytes + 1),
+			expSuffix: "…(+1 bytes)",
+		},
+		{
+			name:      "1KB address with a scope prefix",
+			addr:      scopeID,
+			excess:    garbage(1024 - len(scopeID)),
+			expSuffix: fmt.Sprintf("…(+%d bytes)", 1024-len(scopeID)-MaxRenderedExcessBytes),
+		},
+		{
+			name:      "1KB address with a record prefix",
+			addr:      recordID,
+			excess:    garbage(1024 - len(recordID)),
+			expSuffix: fmt.Sprintf("…(+%d bytes)", 1024-len(recordID)-MaxRenderedExcessBytes),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			addr := MetadataAddress(slices.Concat(tc.addr, tc.excess))
+			rendered := tc.excess[:min(len(tc.excess), MaxRenderedExcessBytes)]
+			var expHex, expBase64 string
+			if len(tc.excess) > 0 {
+				expHex = hex.EncodeToString(rendered) + tc.expSuffix
+				expBase64 = base64.StdEncoding.EncodeToString(rendered) + tc.expSuffix
+			}
+
+			var details MetadataAddressDetails
+			testFunc := func() {
+				details = addr.GetDetails()
+			}
+			s.Require().NotPanics(testFunc, "GetDetails")
+			s.Assert().Equal(tc.excess, details.AddressExcess, "AddressExcess")
+			s.Assert().Equal(len(tc.excess), len(details.AddressExcess), "len(AddressExcess)")
+			s.Assert().Equal(expHex, details.ExcessHex, "ExcessHex")
+			s.Assert().Equal(expBase64, details.ExcessBase64, "ExcessBase64")
+			s.Assert().Equal(addr, details.Address, "Address")
+
+			expGoString := "MetadataAddress" + strings.TrimPrefix(fmt.Sprintf("%#v", []byte(slices.Concat(tc.addr, rendered))), "[]byte") + tc.expSuffix
+			s.Assert().Equal(expGoString, fmt.Sprintf("%#v", addr), "Sprintf(%q, addr)", "%#v")
+		})
+	}
+
+	s.Run("address details", func() {
+		addr := MetadataAddress(slices.Concat(scopeID, garbage(1024-len(scopeID))))
+		expSuffix := fmt.Sprintf("…(+%d bytes)", 1024-len(scopeID)-MaxRenderedExcessBytes)
+		var details *AddressDetails
+		testFunc := func() {
+			details = GetAddressDetails(addr)
+		}
+		s.Require().NotPanics(testFunc, "GetAddressDetails")
+		s.Assert().Equal(hex.EncodeToString(garbage(MaxRenderedExcessBytes))+expSuffix, details.ExcessHex, "ExcessHex")
+		s.Assert().Equal(base64.StdEncoding.EncodeToString(garbage(MaxRenderedExcessBytes))+expSuffix, details.ExcessBase64, "ExcessBase64")
+	})
+}
+
+func (s *AddressTestSuite) TestGetDetailsWithNames() {
+	recordID := RecordMetadataAddress(uuid.New(), "recordname")
+	recordSpecID := RecordSpecMetadataAddress(uuid.New(), "recspecname")
+	scopeID := ScopeMetadataAddress(uuid.New())
+
+	tests := []struct {
+		name       string
+		id         MetadataAddress
+		candidates []string
+		exp        string
+	}{
+		{name: "record: nil candidates", id: recordID, candidates: nil, exp: ""},
+		{name: "record: no match", id: recordID, candidates: []string{"one", "two"}, exp: ""},
+		{name: "record: only candidate", id: recordID, candidates: []string{"recordname"}, exp: "recordname"},
+		{name: "record: last candidate", id: recordID, candidates: []string{"one", "two", "recordname"}, exp: "recordname"},
+		{name: "record: not normalized", id: recordID, candidates: []string{"one", " RecordName "}, exp: " RecordName "},
+		{name: "record: two matches", id: recordID, candidates: []string{"RECORDNAME", "recordname"}, exp: "RECORDNAME"},
+		{name: "record spec: match", id: recordSpecID, candidates: []string{"recordname", "recspecname"}, exp: "recspecname"},
+		{name: "scope", id: scopeID, candidates: []string{"recordname", ""}, exp: ""},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			expDetails := tc.id.GetDetails()
+			expDetails.MatchedName = tc.exp
+			details := tc.id.GetDetailsWithNames(tc.candidates)
+			s.Assert().Equal(expDetails, details, "GetDetailsWithNames(%q)", tc.candidates)
+		})
+	}
+}
+
 func (s *AddressTestSuite) TestDenom() {
 	// As of writing this, the only metadata type that we should be making denoms for are scopes.
 	// However, I figured that restriction would be better left higher up which allows the Denom() method
@@ -2282,6 +3810,44 @@ func (s *AddressTestSuite) TestDenom() {
 	}
 }
 
+func (s *AddressTestSuite) TestRichDenom() {
+	scopeUUID := uuid.MustParse("91f2b84c-57b5-4a38-a3f3-6dd36a8b4ab4")
+	sessionUUID := uuid.MustParse("b47d4b5e-8b5c-4ec8-a4a2-bbbe26b5dd21")
+	scopeID := ScopeMetadataAddress(scopeUUID)
+	sessionID := SessionMetadataAddress(scopeUUID, sessionUUID)
+	recordID := RecordMetadataAddress(scopeUUID, "loan-doc")
+	scopeSpecID := ScopeSpecMetadataAddress(uuid.MustParse("0d7e3b1c-4f5e-4f3a-9c51-1c3f8e2d7a60"))
+
+	tests := []struct {
+		name     string
+		addr     MetadataAddress
+		expDenom string
+		expLossy bool
+	}{
+		{name: "scope", addr: scopeID, expDenom: scopeID.Denom()},
+		{name: "session", addr: sessionID, expDenom: scopeID.Denom() + "/session/" + sessionUUID.String()},
+		{name: "record", addr: recordID, expDenom: recordID.Denom(), expLossy: true},
+		{name: "scope spec", addr: scopeSpecID, expDenom: scopeSpecID.Denom()},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var denom string
+			var lossy bool
+			testFunc := func() {
+				denom, lossy = tc.addr.RichDenom()
+			}
+			s.Require().NotPanics(testFunc, "%s.RichDenom()", tc.addr)
+			s.Assert().Equal(tc.expDenom, denom, "%s.RichDenom() denom", tc.addr)
+			s.Assert().Equal(tc.expLossy, lossy, "%s.RichDenom() lossy", tc.addr)
+
+			addr, err := ParseRichDenom(denom)
+			s.Require().NoError(err, "ParseRichDenom(%q)", denom)
+			s.Assert().Equal(tc.addr, addr, "ParseRichDenom(%q)", denom)
+		})
+	}
+}
+
 func (s *AddressTestSuite) TestCoin() {
 	// Just like the Denom tests, I'm testing all the types even though only scopes should really only ever be used.
 
@@ -2327,6 +3893,79 @@ func (s *AddressTestSuite) TestCoin() {
 	}
 }
 
+func (s *AddressTestSuite) TestNewMetadataCoins() {
+	// The bech32 of scopeLow starts with "scope1qq" and scopeHigh with "scope1qp", so their
+	// denoms sort in the opposite order of their bytes. Records also sort before scopes and sessions.
+	scopeLow := ScopeMetadataAddress(uuid.MustParse("00000000-0000-0000-0000-000000000000"))
+	scopeHigh := ScopeMetadataAddress(uuid.MustParse("40000000-0000-0000-0000-000000000000"))
+	session := SessionMetadataAddress(uuid.MustParse("00000000-0000-0000-0000-000000000000"), uuid.New())
+	record := RecordMetadataAddress(uuid.MustParse("00000000-0000-0000-0000-000000000000"), "name")
+	s.Require().True(bytes.Compare(scopeLow, scopeHigh) < 0, "scopeLow bytes < scopeHigh bytes")
+	s.Require().True(scopeHigh.Denom() < scopeLow.Denom(), "scopeHigh denom < scopeLow denom")
+	s.Require().True(bytes.Compare(scopeLow, record) < 0, "scope bytes < record bytes")
+	s.Require().True(record.Denom() < scopeLow.Denom(), "record denom < scope denom")
+
+	coin := func(addr MetadataAddress) sdk.Coin {
+		return sdk.NewInt64Coin(addr.Denom(), 1)
+	}
+
+	tests := []struct {
+		name   string
+		addrs  []MetadataAddress
+		exp    sdk.Coins
+		expErr string
+	}{
+		{name: "nil", addrs: nil, exp: sdk.Coins{}},
+		{name: "one scope", addrs: []MetadataAddress{scopeLow}, exp: scopeLow.Coins()},
+		{
+			name:  "two scopes in byte order",
+			addrs: []MetadataAddress{scopeLow, scopeHigh},
+			exp:   sdk.Coins{coin(scopeHigh), coin(scopeLow)},
+		},
+		{
+			name:  "two scopes in denom order",
+			addrs: []MetadataAddress{scopeHigh, scopeLow},
+			exp:   sdk.Coins{coin(scopeHigh), coin(scopeLow)},
+		},
+		{
+			name:  "all types in byte order",
+			addrs: []MetadataAddress{scopeLow, scopeHigh, session, record},
+			exp:   sdk.Coins{coin(record), coin(scopeHigh), coin(scopeLow), coin(session)},
+		},
+		{
+			name:  "duplicates",
+			addrs: []MetadataAddress{scopeLow, scopeHigh, scopeLow, scopeHigh},
+			exp:   sdk.Coins{coin(scopeHigh), coin(scopeLow)},
+		},
+		{
+			name:   "invalid address",
+			addrs:  []MetadataAddress{scopeLow, MetadataAddress{0x00, 0x01}},
+			expErr: "invalid metadata address [1]: incorrect address length (expected: 17, actual: 2)",
+		},
+		{
+			name:   "empty address",
+			addrs:  []MetadataAddress{MetadataAddress{}},
+			expErr: "invalid metadata address [0]: address is empty",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var coins sdk.Coins
+			var err error
+			testFunc := func() {
+				coins, err = NewMetadataCoins(tc.addrs...)
+			}
+			s.Require().NotPanics(testFunc, "NewMetadataCoins")
+			assertions.AssertErrorValue(s.T(), err, tc.expErr, "NewMetadataCoins error")
+			s.Assert().Equal(tc.exp, coins, "NewMetadataCoins result")
+			if len(tc.expErr) == 0 {
+				s.Assert().NoError(coins.Validate(), "result.Validate()")
+			}
+		})
+	}
+}
+
 func (s *AddressTestSuite) TestAccMDLink_String() {
 	newUUID := func(b byte) uuid.UUID {
 		bz := bytes.Repeat([]byte{b}, 16)
@@ -2532,6 +4171,221 @@ func (s *AddressTestSuite) TestAccMDLinks_String() {
 	}
 }
 
+func (s *AddressTestSuite) TestAccMDLink_JSON() {
+	accAddr := sdk.AccAddress("accAddr1____________")
+	scopeAddr := ScopeMetadataAddress(uuid.MustParse("30303030-3030-3030-3030-303030303030"))
+	jsonFor := func(acc, md string) string {
+		return `{"account":"` + acc + `","metadata_address":"` + md + `"}`
+	}
+
+	tests := []struct {
+		name    string
+		link    *AccMDLink
+		expJSON string
+	}{
+		{name: "nil link", link: nil, expJSON: "null"},
+		{name: "nil addresses", link: NewAccMDLink(nil, nil), expJSON: jsonFor("", "")},
+		{name: "empty addresses", link: NewAccMDLink(sdk.AccAddress{}, MetadataAddress{}), expJSON: jsonFor("", "")},
+		{name: "only account", link: NewAccMDLink(accAddr, nil), expJSON: jsonFor(accAddr.String(), "")},
+		{name: "only metadata address", link: NewAccMDLink(nil, scopeAddr), expJSON: jsonFor("", scopeAddr.String())},
+		{name: "both addresses", link: NewAccMDLink(accAddr, scopeAddr), expJSON: jsonFor(accAddr.String(), scopeAddr.String())},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var act []byte
+			var err error
+			testFunc := func() {
+				act, err = json.Marshal(tc.link)
+			}
+			s.Require().NotPanics(testFunc, "json.Marshal(%s)", tc.link)
+			s.Require().NoError(err, "json.Marshal(%s)", tc.link)
+			s.Assert().Equal(tc.expJSON, string(act), "json.Marshal(%s)", tc.link)
+
+			if tc.link == nil {
+				return
+			}
+			var link AccMDLink
+			err = json.Unmarshal(act, &link)
+			s.Require().NoError(err, "json.Unmarshal(%s)", string(act))
+			s.Assert().Equal(tc.link.AccAddr.String(), link.AccAddr.String(), "unmarshaled AccAddr")
+			s.Assert().Equal(tc.link.MDAddr.String(), link.MDAddr.String(), "unmarshaled MDAddr")
+		})
+	}
+
+	s.Run("invalid account", func() {
+		var link AccMDLink
+		err := json.Unmarshal([]byte(jsonFor("notabech32", scopeAddr.String())), &link)
+		s.Assert().ErrorContains(err, `invalid account "notabech32": `, "json.Unmarshal error")
+	})
+
+	s.Run("invalid metadata_address", func() {
+		var link AccMDLink
+		err := json.Unmarshal([]byte(jsonFor(accAddr.String(), accAddr.String())), &link)
+		s.Assert().ErrorContains(err, `invalid metadata_address "`+accAddr.String()+`": `, "json.Unmarshal error")
+	})
+}
+
+func (s *AddressTestSuite) TestAccMDLinks_JSON() {
+	accAddr1 := sdk.AccAddress("accAddr1____________")
+	accAddr2 := sdk.AccAddress("accAddr2____________")
+	scopeAddr := ScopeMetadataAddress(uuid.MustParse("30303030-3030-3030-3030-303030303030"))
+	sessionAddr := SessionMetadataAddress(uuid.MustParse("31313131-3131-3131-3131-313131313131"), uuid.MustParse("31313131-3131-3131-3131-313131313131"))
+	jsonFor := func(acc, md string) string {
+		return `{"account":"` + acc + `","metadata_address":"` + md + `"}`
+	}
+
+	s.Run("round trips", func() {
+		tests := []struct {
+			name    string
+			links   AccMDLinks
+			expJSON string
+		}{
+			{name: "nil", links: nil, expJSON: "null"},
+			{name: "empty", links: AccMDLinks{}, expJSON: "[]"},
+			{name: "one nil entry", links: AccMDLinks{nil}, expJSON: "[null]"},
+			{
+				name: "many entries",
+				links: AccMDLinks{
+					NewAccMDLink(accAddr1, scopeAddr),
+					nil,
+					NewAccMDLink(accAddr2, sessionAddr),
+					NewAccMDLink(nil, nil),
+				},
+				expJSON: "[" + jsonFor(accAddr1.String(), scopeAddr.String()) + ",null," +
+					jsonFor(accAddr2.String(), sessionAddr.String()) + "," + jsonFor("", "") + "]",
+			},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				act, err := json.Marshal(tc.links)
+				s.Require().NoError(err, "json.Marshal(%s)", tc.links)
+				s.Assert().Equal(tc.expJSON, string(act), "json.Marshal(%s)", tc.links)
+
+				var links AccMDLinks
+				err = json.Unmarshal(act, &links)
+				s.Require().NoError(err, "json.Unmarshal(%s)", string(act))
+				s.Assert().Equal(tc.links.String(), links.String(), "unmarshaled links")
+				s.Assert().Equal(tc.links == nil, links == nil, "unmarshaled links is nil")
+			})
+		}
+	})
+
+	s.Run("mixed validity", func() {
+		data := "[" + jsonFor(accAddr1.String(), scopeAddr.String()) + "," +
+			jsonFor("bad1", scopeAddr.String()) + ",null," +
+			jsonFor(accAddr2.String(), "bad2") + "]"
+		orig := AccMDLinks{NewAccMDLink(accAddr1, nil)}
+		links := orig
+		err := json.Unmarshal([]byte(data), &links)
+		s.Assert().ErrorContains(err, `entry 1: invalid account "bad1": `, "json.Unmarshal error")
+		s.Assert().ErrorContains(err, `entry 3: invalid metadata_address "bad2": `, "json.Unmarshal error")
+		s.Assert().NotContains(err.Error(), "entry 0", "json.Unmarshal error")
+		s.Assert().NotContains(err.Error(), "entry 2", "json.Unmarshal error")
+		s.Assert().Equal(orig.String(), links.String(), "links after failed unmarshal")
+	})
+}
+
+func (s *AddressTestSuite) TestAccMDLinks_Binary() {
+	accAddr1 := sdk.AccAddress("accAddr1____________")
+	accAddr2 := sdk.AccAddress("accAddr2____________")
+	scopeAddr := ScopeMetadataAddress(uuid.MustParse("30303030-3030-3030-3030-303030303030"))
+	sessionAddr := SessionMetadataAddress(uuid.MustParse("31313131-3131-3131-3131-313131313131"), uuid.MustParse("31313131-3131-3131-3131-313131313131"))
+
+	s.Run("round trips", func() {
+		tests := []struct {
+			name   string
+			links  AccMDLinks
+			expLen int
+		}{
+			{name: "nil", links: nil, expLen: 1},
+			{name: "empty", links: AccMDLinks{}, expLen: 1},
+			{name: "one nil entry", links: AccMDLinks{nil}, expLen: 2},
+			{name: "nil addresses", links: AccMDLinks{NewAccMDLink(nil, nil)}, expLen: 4},
+			{name: "empty addresses", links: AccMDLinks{NewAccMDLink(sdk.AccAddress{}, MetadataAddress{})}, expLen: 4},
+			{
+				name: "many entries",
+				links: AccMDLinks{
+					NewAccMDLink(accAddr1, scopeAddr),
+					nil,
+					NewAccMDLink(accAddr2, sessionAddr),
+					NewAccMDLink(nil, scopeAddr),
+					NewAccMDLink(accAddr1, MetadataAddress{}),
+					NewAccMDLink(accAddr2, scopeAddr),
+				},
+				// count (1) + entry flags (6) + 4 acc addrs (21 each) + nil acc addr (1)
+				// + 3 scope addrs (18 each) + session addr (34) + empty md addr (1).
+				expLen: 1 + 6 + 4*21 + 1 + 3*18 + 34 + 1,
+			},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				bz, err := tc.links.Marshal()
+				s.Require().NoError(err, "Marshal()")
+				s.Assert().Len(bz, tc.expLen, "Marshal() result")
+
+				var links AccMDLinks
+				err = links.Unmarshal(bz)
+				s.Require().NoError(err, "Unmarshal(%v)", bz)
+				s.Assert().Equal(tc.links, links, "unmarshaled links")
+				s.Assert().Equal(tc.links == nil, links == nil, "unmarshaled links is nil")
+				for i := range tc.links {
+					if tc.links[i] == nil {
+						continue
+					}
+					s.Assert().Equal(tc.links[i].AccAddr == nil, links[i].AccAddr == nil, "[%d].AccAddr is nil", i)
+					s.Assert().Equal(tc.links[i].MDAddr == nil, links[i].MDAddr == nil, "[%d].MDAddr is nil", i)
+				}
+			})
+		}
+	})
+
+	s.Run("unmarshaled addresses are copies", func() {
+		bz, err := AccMDLinks{NewAccMDLink(accAddr1, scopeAddr)}.Marshal()
+		s.Require().NoError(err, "Marshal()")
+		var links AccMDLinks
+		s.Require().NoError(links.Unmarshal(bz), "Unmarshal")
+		for i := range bz {
+			bz[i] = 0
+		}
+		s.Assert().Equal(accAddr1, links[0].AccAddr, "AccAddr after changing the data")
+		s.Assert().Equal(scopeAddr, links[0].MDAddr, "MDAddr after changing the data")
+	})
+
+	s.Run("invalid data", func() {
+		good, err := AccMDLinks{NewAccMDLink(accAddr1, scopeAddr), nil}.Marshal()
+		s.Require().NoError(err, "Marshal()")
+
+		tests := []struct {
+			name   string
+			data   []byte
+			expErr string
+		}{
+			{name: "nil data", data: nil, expErr: "invalid entry count: could not read length"},
+			{name: "nil links with extra bytes", data: []byte{0, 1}, expErr: "unexpected 1 bytes after nil AccMDLinks"},
+			{name: "count too large", data: []byte{4, 0, 0}, expErr: "entry count 3 is more than the 2 remaining bytes"},
+			{name: "bad entry flag", data: []byte{2, 2}, expErr: "entry 0: invalid entry flag 2"},
+			{name: "missing acc addr", data: []byte{2, 1}, expErr: "entry 0: invalid AccAddr: could not read length"},
+			{name: "acc addr too long", data: []byte{2, 1, 5, 'a'}, expErr: "entry 0: invalid AccAddr: length 4 is more than the 1 remaining bytes"},
+			{name: "missing md addr", data: []byte{2, 1, 2, 'a'}, expErr: "entry 0: invalid MDAddr: could not read length"},
+			{name: "missing second entry", data: good[:len(good)-1], expErr: "entry 1: no data left"},
+			{name: "extra bytes", data: append(append([]byte{}, good...), 0), expErr: "unexpected 1 bytes after 2 entries"},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				orig := AccMDLinks{NewAccMDLink(accAddr2, sessionAddr)}
+				links := orig
+				err := links.Unmarshal(tc.data)
+				s.Assert().EqualError(err, tc.expErr, "Unmarshal(%v) error", tc.data)
+				s.Assert().Equal(orig, links, "links after failed Unmarshal")
+			})
+		}
+	})
+}
+
 func (s *AddressTestSuite) TestAccMDLinks_ValidateForScopes() {
 	newUUID := func(name string, i int) uuid.UUID {
 		bz := []byte(fmt.Sprintf("%s[%d]________________", name, i))[:16]
@@ -2566,22 +4420,23 @@ func (s *AddressTestSuite) TestAccMDLinks_ValidateForScopes() {
 		{
 			name:  "one link: nil",
 			links: AccMDLinks{nil},
-			exp:   "nil entry not allowed",
+			exp:   "entry 0: nil entry not allowed",
 		},
 		{
 			name:  "one link: empty",
 			links: AccMDLinks{{}},
-			exp:   "invalid scope metadata address MetadataAddress(nil): address is empty",
+			exp: "entry 0: invalid scope metadata address MetadataAddress(nil): address is empty\n" +
+				"entry 0: no account address associated with metadata address \"\"",
 		},
 		{
 			name:  "one link: nil md addr",
 			links: AccMDLinks{{MDAddr: nil, AccAddr: addrs[0]}},
-			exp:   "invalid scope metadata address MetadataAddress(nil): address is empty",
+			exp:   "entry 0: invalid scope metadata address MetadataAddress(nil): address is empty",
 		},
 		{
 			name:  "one link: empty md addr",
 			links: AccMDLinks{{MDAddr: MetadataAddress{}, AccAddr: addrs[0]}},
-			exp:   "invalid scope metadata address MetadataAddress{}: address is empty",
+			exp:   "entry 0: invalid scope metadata address MetadataAddress{}: address is empty",
 		},
 		{
 			name:  "one link: scope",
@@ -2591,67 +4446,69 @@ func (s *AddressTestSuite) TestAccMDLinks_ValidateForScopes() {
 		{
 			name:  "one link: nil acc addr",
 			links: AccMDLinks{{MDAddr: scopeIDs[0], AccAddr: nil}},
-			exp:   fmt.Sprintf("no account address associated with metadata address %q", scopeIDs[0]),
+			exp:   fmt.Sprintf("entry 0: no account address associated with metadata address %q", scopeIDs[0]),
 		},
 		{
 			name:  "one link: nil empty addr",
 			links: AccMDLinks{{MDAddr: scopeIDs[0], AccAddr: sdk.AccAddress{}}},
-			exp:   fmt.Sprintf("no account address associated with metadata address %q", scopeIDs[0]),
+			exp:   fmt.Sprintf("entry 0: no account address associated with metadata address %q", scopeIDs[0]),
 		},
 		{
 			name:  "one link: session",
 			links: AccMDLinks{{MDAddr: SessionMetadataAddress(newUUID("session", 0), newUUID("session", 1)), AccAddr: addrs[0]}},
-			exp:   fmt.Sprintf("invalid scope id %q: wrong type", SessionMetadataAddress(newUUID("session", 0), newUUID("session", 1))),
+			exp:   fmt.Sprintf("entry 0: invalid scope id %q: wrong type", SessionMetadataAddress(newUUID("session", 0), newUUID("session", 1))),
 		},
 		{
 			name:  "one link: record",
 			links: AccMDLinks{{MDAddr: RecordMetadataAddress(newUUID("record", 0), "recordname"), AccAddr: addrs[0]}},
-			exp:   fmt.Sprintf("invalid scope id %q: wrong type", RecordMetadataAddress(newUUID("record", 0), "recordname")),
+			exp:   fmt.Sprintf("entry 0: invalid scope id %q: wrong type", RecordMetadataAddress(newUUID("record", 0), "recordname")),
 		},
 		{
 			name:  "one link: scope spec",
 			links: AccMDLinks{{MDAddr: ScopeSpecMetadataAddress(newUUID("scopespec", 0)), AccAddr: addrs[0]}},
-			exp:   fmt.Sprintf("invalid scope id %q: wrong type", ScopeSpecMetadataAddress(newUUID("scopespec", 0))),
+			exp:   fmt.Sprintf("entry 0: invalid scope id %q: wrong type", ScopeSpecMetadataAddress(newUUID("scopespec", 0))),
 		},
 		{
 			name:  "one link: contract spec",
 			links: AccMDLinks{{MDAddr: ContractSpecMetadataAddress(newUUID("contractspec", 0)), AccAddr: addrs[0]}},
-			exp:   fmt.Sprintf("invalid scope id %q: wrong type", ContractSpecMetadataAddress(newUUID("contractspec", 0))),
+			exp:   fmt.Sprintf("entry 0: invalid scope id %q: wrong type", ContractSpecMetadataAddress(newUUID("contractspec", 0))),
 		},
 		{
 			name:  "one link: record spec",
 			links: AccMDLinks{{MDAddr: RecordSpecMetadataAddress(newUUID("contractspec", 0), "recordname"), AccAddr: addrs[0]}},
-			exp:   fmt.Sprintf("invalid scope id %q: wrong type", RecordSpecMetadataAddress(newUUID("contractspec", 0), "recordname")),
+			exp:   fmt.Sprintf("entry 0: invalid scope id %q: wrong type", RecordSpecMetadataAddress(newUUID("contractspec", 0), "recordname")),
 		},
 		{
 			name:  "one link: unknown mdaddr type",
 			links: AccMDLinks{{MDAddr: MetadataAddress{0xa0, 0x6e, 0x6f, 0x70, 0x65}, AccAddr: addrs[0]}},
-			exp:   "invalid scope metadata address MetadataAddress{0xa0, 0x6e, 0x6f, 0x70, 0x65}: invalid metadata address type: 160",
+			exp:   "entry 0: invalid scope metadata address invalid[prefix=0xa0 len=5 hex=a06e6f7065]: invalid metadata address type: 160",
 		},
 		{
 			name:  "one link: scope type byte but invalid",
 			links: AccMDLinks{{MDAddr: MetadataAddress{ScopeKeyPrefix[0], 0x6e, 0x6f, 0x70, 0x65}, AccAddr: addrs[0]}},
-			exp:   "invalid scope metadata address MetadataAddress{0x0, 0x6e, 0x6f, 0x70, 0x65}: incorrect address length (expected: 17, actual: 5)",
+			exp:   "entry 0: invalid scope metadata address invalid[prefix=0x00 len=5 hex=006e6f7065]: incorrect address length (expected: 17, actual: 5)",
 		},
 		{
 			name:  "two links: first nil",
 			links: AccMDLinks{nil, {MDAddr: scopeIDs[1], AccAddr: addrs[1]}},
-			exp:   "nil entry not allowed",
+			exp:   "entry 0: nil entry not allowed",
 		},
 		{
 			name:  "two links: first empty",
 			links: AccMDLinks{{}, {MDAddr: scopeIDs[1], AccAddr: addrs[1]}},
-			exp:   "invalid scope metadata address MetadataAddress(nil): address is empty",
+			exp: "entry 0: invalid scope metadata address MetadataAddress(nil): address is empty\n" +
+				"entry 0: no account address associated with metadata address \"\"",
 		},
 		{
 			name:  "two links: second nil",
 			links: AccMDLinks{{MDAddr: scopeIDs[0], AccAddr: addrs[0]}, nil},
-			exp:   "nil entry not allowed",
+			exp:   "entry 1: nil entry not allowed",
 		},
 		{
 			name:  "two links: second empty",
 			links: AccMDLinks{{MDAddr: scopeIDs[0], AccAddr: addrs[0]}, {}},
-			exp:   "invalid scope metadata address MetadataAddress(nil): address is empty",
+			exp: "entry 1: invalid scope metadata address MetadataAddress(nil): address is empty\n" +
+				"entry 1: no account address associated with metadata address \"\"",
 		},
 		{
 			name: "two links: fully different",
@@ -2667,7 +4524,7 @@ func (s *AddressTestSuite) TestAccMDLinks_ValidateForScopes() {
 				{MDAddr: scopeIDs[2], AccAddr: addrs[0]},
 				{MDAddr: scopeIDs[2], AccAddr: addrs[1]},
 			},
-			exp: fmt.Sprintf("duplicate metadata address %q not allowed", scopeIDs[2]),
+			exp: fmt.Sprintf("entry 1: duplicate metadata address %q not allowed", scopeIDs[2]),
 		},
 		{
 			name: "two links: same acc addrs different md addrs",
@@ -2683,7 +4540,7 @@ func (s *AddressTestSuite) TestAccMDLinks_ValidateForScopes() {
 				{MDAddr: ScopeSpecMetadataAddress(newUUID("scopespec", 1)), AccAddr: addrs[0]},
 				{MDAddr: scopeIDs[1], AccAddr: addrs[1]},
 			},
-			exp: fmt.Sprintf("invalid scope id %q: wrong type", ScopeSpecMetadataAddress(newUUID("scopespec", 1))),
+			exp: fmt.Sprintf("entry 0: invalid scope id %q: wrong type", ScopeSpecMetadataAddress(newUUID("scopespec", 1))),
 		},
 		{
 			name: "two links: invalid second md addr",
@@ -2691,7 +4548,7 @@ func (s *AddressTestSuite) TestAccMDLinks_ValidateForScopes() {
 				{MDAddr: scopeIDs[0], AccAddr: addrs[0]},
 				{MDAddr: MetadataAddress{0xa0, 0x6e, 0x6f, 0x70, 0x65}, AccAddr: addrs[1]},
 			},
-			exp: "invalid scope metadata address MetadataAddress{0xa0, 0x6e, 0x6f, 0x70, 0x65}: invalid metadata address type: 160",
+			exp: "entry 1: invalid scope metadata address invalid[prefix=0xa0 len=5 hex=a06e6f7065]: invalid metadata address type: 160",
 		},
 		{
 			name: "two links: first missing acc addr",
@@ -2699,7 +4556,7 @@ func (s *AddressTestSuite) TestAccMDLinks_ValidateForScopes() {
 				{MDAddr: scopeIDs[0], AccAddr: nil},
 				{MDAddr: scopeIDs[1], AccAddr: addrs[1]},
 			},
-			exp: fmt.Sprintf("no account address associated with metadata address %q", scopeIDs[0]),
+			exp: fmt.Sprintf("entry 0: no account address associated with metadata address %q", scopeIDs[0]),
 		},
 		{
 			name: "two links: second missing acc addr",
@@ -2707,7 +4564,7 @@ func (s *AddressTestSuite) TestAccMDLinks_ValidateForScopes() {
 				{MDAddr: scopeIDs[0], AccAddr: addrs[0]},
 				{MDAddr: scopeIDs[1], AccAddr: nil},
 			},
-			exp: fmt.Sprintf("no account address associated with metadata address %q", scopeIDs[1]),
+			exp: fmt.Sprintf("entry 1: no account address associated with metadata address %q", scopeIDs[1]),
 		},
 		{
 			name: "six links: all valid and fully different",
@@ -2719,87 +4576,320 @@ func (s *AddressTestSuite) TestAccMDLinks_ValidateForScopes() {
 			exp: "",
 		},
 		{
-			name: "six links: different scopes but same acc addrs",
-			links: AccMDLinks{
-				{MDAddr: scopeIDs[0], AccAddr: addrs[2]}, {MDAddr: scopeIDs[1], AccAddr: addrs[2]},
-				{MDAddr: scopeIDs[2], AccAddr: addrs[2]}, {MDAddr: scopeIDs[3], AccAddr: addrs[2]},
-				{MDAddr: scopeIDs[4], AccAddr: addrs[2]}, {MDAddr: scopeIDs[5], AccAddr: addrs[2]},
-			},
-			exp: "",
+			name: "six links: different scopes but same acc addrs",
+			links: AccMDLinks{
+				{MDAddr: scopeIDs[0], AccAddr: addrs[2]}, {MDAddr: scopeIDs[1], AccAddr: addrs[2]},
+				{MDAddr: scopeIDs[2], AccAddr: addrs[2]}, {MDAddr: scopeIDs[3], AccAddr: addrs[2]},
+				{MDAddr: scopeIDs[4], AccAddr: addrs[2]}, {MDAddr: scopeIDs[5], AccAddr: addrs[2]},
+			},
+			exp: "",
+		},
+		{
+			name: "six links: same scopes but different acc addrs",
+			links: AccMDLinks{
+				{MDAddr: scopeIDs[4], AccAddr: addrs[0]}, {MDAddr: scopeIDs[4], AccAddr: addrs[1]},
+				{MDAddr: scopeIDs[4], AccAddr: addrs[2]}, {MDAddr: scopeIDs[4], AccAddr: addrs[3]},
+				{MDAddr: scopeIDs[4], AccAddr: addrs[4]}, {MDAddr: scopeIDs[4], AccAddr: addrs[5]},
+			},
+			exp: fmt.Sprintf("entry 1: duplicate metadata address %q not allowed", scopeIDs[4]),
+		},
+		{
+			name: "six links: all same",
+			links: AccMDLinks{
+				{MDAddr: scopeIDs[4], AccAddr: addrs[4]}, {MDAddr: scopeIDs[4], AccAddr: addrs[4]},
+				{MDAddr: scopeIDs[4], AccAddr: addrs[4]}, {MDAddr: scopeIDs[4], AccAddr: addrs[4]},
+				{MDAddr: scopeIDs[4], AccAddr: addrs[4]}, {MDAddr: scopeIDs[4], AccAddr: addrs[4]},
+			},
+			exp: fmt.Sprintf("entry 1: duplicate metadata address %q not allowed", scopeIDs[4]),
+		},
+		{
+			name: "six links: last is invalid md addr",
+			links: AccMDLinks{
+				{MDAddr: scopeIDs[5], AccAddr: addrs[5]}, {MDAddr: scopeIDs[4], AccAddr: addrs[4]},
+				{MDAddr: scopeIDs[3], AccAddr: addrs[3]}, {MDAddr: scopeIDs[2], AccAddr: addrs[2]},
+				{MDAddr: scopeIDs[1], AccAddr: addrs[1]}, {MDAddr: MetadataAddress{0xa0, 0x6e, 0x6f, 0x70, 0x65}, AccAddr: addrs[0]},
+			},
+			exp: "entry 5: invalid scope metadata address invalid[prefix=0xa0 len=5 hex=a06e6f7065]: invalid metadata address type: 160",
+		},
+		{
+			name: "six links: last is missing acc addr",
+			links: AccMDLinks{
+				{MDAddr: scopeIDs[1], AccAddr: addrs[1]}, {MDAddr: scopeIDs[0], AccAddr: addrs[0]},
+				{MDAddr: scopeIDs[2], AccAddr: addrs[2]}, {MDAddr: scopeIDs[5], AccAddr: addrs[5]},
+				{MDAddr: scopeIDs[3], AccAddr: addrs[3]}, {MDAddr: scopeIDs[4], AccAddr: nil},
+			},
+			exp: fmt.Sprintf("entry 5: no account address associated with metadata address %q", scopeIDs[4]),
+		},
+		{
+			name: "six links: last is dup scope",
+			links: AccMDLinks{
+				{MDAddr: scopeIDs[0], AccAddr: addrs[0]}, {MDAddr: scopeIDs[1], AccAddr: addrs[0]},
+				{MDAddr: scopeIDs[2], AccAddr: addrs[2]}, {MDAddr: scopeIDs[3], AccAddr: addrs[3]},
+				{MDAddr: scopeIDs[4], AccAddr: addrs[4]}, {MDAddr: scopeIDs[3], AccAddr: addrs[5]},
+			},
+			exp: fmt.Sprintf("entry 5: duplicate metadata address %q not allowed", scopeIDs[3]),
+		},
+		{
+			name: "six links: last is nil",
+			links: AccMDLinks{
+				{MDAddr: scopeIDs[0], AccAddr: addrs[0]}, {MDAddr: scopeIDs[1], AccAddr: addrs[0]},
+				{MDAddr: scopeIDs[2], AccAddr: addrs[2]}, {MDAddr: scopeIDs[3], AccAddr: addrs[3]},
+				{MDAddr: scopeIDs[4], AccAddr: addrs[4]}, nil,
+			},
+			exp: "entry 5: nil entry not allowed",
+		},
+		{
+			name: "six links: last is empty",
+			links: AccMDLinks{
+				{MDAddr: scopeIDs[0], AccAddr: addrs[0]}, {MDAddr: scopeIDs[1], AccAddr: addrs[0]},
+				{MDAddr: scopeIDs[2], AccAddr: addrs[2]}, {MDAddr: scopeIDs[3], AccAddr: addrs[3]},
+				{MDAddr: scopeIDs[4], AccAddr: addrs[4]}, {},
+			},
+			exp: "entry 5: invalid scope metadata address MetadataAddress(nil): address is empty\n" +
+				"entry 5: no account address associated with metadata address \"\"",
+		},
+		{
+			name: "six links: every kind of problem",
+			links: AccMDLinks{
+				{MDAddr: scopeIDs[0], AccAddr: addrs[0]}, nil,
+				{MDAddr: ScopeSpecMetadataAddress(newUUID("scopespec", 2)), AccAddr: addrs[2]}, {MDAddr: scopeIDs[3], AccAddr: nil},
+				{MDAddr: scopeIDs[0], AccAddr: addrs[4]}, nil,
+			},
+			exp: "entry 1: nil entry not allowed\n" +
+				fmt.Sprintf("entry 2: invalid scope id %q: wrong type\n", ScopeSpecMetadataAddress(newUUID("scopespec", 2))) +
+				fmt.Sprintf("entry 3: no account address associated with metadata address %q\n", scopeIDs[3]) +
+				fmt.Sprintf("entry 4: duplicate metadata address %q not allowed\n", scopeIDs[0]) +
+				"entry 5: nil entry not allowed",
+		},
+		{
+			name: "six links: two different duplicates",
+			links: AccMDLinks{
+				{MDAddr: scopeIDs[0], AccAddr: addrs[0]}, {MDAddr: scopeIDs[1], AccAddr: addrs[1]},
+				{MDAddr: scopeIDs[1], AccAddr: addrs[2]}, {MDAddr: scopeIDs[0], AccAddr: addrs[3]},
+				{MDAddr: scopeIDs[1], AccAddr: addrs[4]}, {MDAddr: scopeIDs[0], AccAddr: addrs[5]},
+			},
+			exp: fmt.Sprintf("entry 2: duplicate metadata address %q not allowed\n", scopeIDs[1]) +
+				fmt.Sprintf("entry 3: duplicate metadata address %q not allowed", scopeIDs[0]),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var err error
+			testFunc := func() {
+				err = tc.links.ValidateForScopes()
+			}
+			s.Require().NotPanics(testFunc, "ValidateForScopes")
+			assertions.AssertErrorValue(s.T(), err, tc.exp, "ValidateForScopes")
+		})
+	}
+}
+
+func (s *AddressTestSuite) TestAccMDLinks_ValidateForType() {
+	newUUID := func(name string, i int) uuid.UUID {
+		bz := []byte(fmt.Sprintf("%s[%d]________________", name, i))[:16]
+		rv, err := uuid.FromBytes(bz)
+		s.Require().NoError(err, "%s[%d]: uuid.FromBytes(%v)", name, i, bz)
+		return rv
+	}
+	scopeID := ScopeMetadataAddress(newUUID("scope", 0))
+	cSpecIDs := []MetadataAddress{
+		ContractSpecMetadataAddress(newUUID("contractspec", 0)),
+		ContractSpecMetadataAddress(newUUID("contractspec", 1)),
+	}
+	sSpecID := ScopeSpecMetadataAddress(newUUID("scopespec", 0))
+	badAddr := MetadataAddress{0xa0, 0x6e, 0x6f, 0x70, 0x65}
+	addr0 := sdk.AccAddress("addr0_______________")
+	addr1 := sdk.AccAddress("addr1_______________")
+
+	tests := []struct {
+		name   string
+		links  AccMDLinks
+		expHRP string
+		exp    string
+	}{
+		{
+			name:   "nil links",
+			links:  nil,
+			expHRP: PrefixContractSpecification,
+			exp:    "",
+		},
+		{
+			name:   "empty links",
+			links:  AccMDLinks{},
+			expHRP: PrefixScopeSpecification,
+			exp:    "",
+		},
+		{
+			name:   "unknown type",
+			links:  AccMDLinks{{MDAddr: scopeID, AccAddr: addr0}},
+			expHRP: "nope",
+			exp:    "unknown metadata address type \"nope\"",
+		},
+		{
+			name:   "empty type",
+			links:  AccMDLinks{{MDAddr: scopeID, AccAddr: addr0}},
+			expHRP: "",
+			exp:    "unknown metadata address type \"\"",
+		},
+		{
+			name:   "one contract spec",
+			links:  AccMDLinks{{MDAddr: cSpecIDs[0], AccAddr: addr0}},
+			expHRP: PrefixContractSpecification,
+			exp:    "",
+		},
+		{
+			name:   "two contract specs",
+			links:  AccMDLinks{{MDAddr: cSpecIDs[0], AccAddr: addr0}, {MDAddr: cSpecIDs[1], AccAddr: addr0}},
+			expHRP: PrefixContractSpecification,
+			exp:    "",
+		},
+		{
+			name:   "one scope spec",
+			links:  AccMDLinks{{MDAddr: sSpecID, AccAddr: addr1}},
+			expHRP: PrefixScopeSpecification,
+			exp:    "",
+		},
+		{
+			name:   "only entry has invalid address",
+			links:  AccMDLinks{{MDAddr: badAddr, AccAddr: addr0}},
+			expHRP: PrefixContractSpecification,
+			exp:    "entry 0: invalid contract specification metadata address invalid[prefix=0xa0 len=5 hex=a06e6f7065]: invalid metadata address type: 160",
+		},
+		{
+			name:   "scope when contract spec expected",
+			links:  AccMDLinks{{MDAddr: scopeID, AccAddr: addr0}},
+			expHRP: PrefixContractSpecification,
+			exp:    fmt.Sprintf("entry 0: invalid contract specification id %q: wrong type", scopeID),
+		},
+		{
+			name: "mixed types",
+			links: AccMDLinks{
+				{MDAddr: cSpecIDs[0], AccAddr: addr0},
+				{MDAddr: sSpecID, AccAddr: addr0},
+				{MDAddr: scopeID, AccAddr: addr1},
+				{MDAddr: cSpecIDs[1], AccAddr: addr1},
+			},
+			expHRP: PrefixContractSpecification,
+			exp: fmt.Sprintf("entry 1: invalid contract specification id %q: wrong type\n", sSpecID) +
+				fmt.Sprintf("entry 2: invalid contract specification id %q: wrong type", scopeID),
+		},
+		{
+			name: "nil entry, missing acc addr, and duplicate",
+			links: AccMDLinks{
+				{MDAddr: sSpecID, AccAddr: addr0},
+				nil,
+				{MDAddr: sSpecID, AccAddr: nil},
+			},
+			expHRP: PrefixScopeSpecification,
+			exp: "entry 1: nil entry not allowed\n" +
+				fmt.Sprintf("entry 2: duplicate metadata address %q not allowed\n", sSpecID) +
+				fmt.Sprintf("entry 2: no account address associated with metadata address %q", sSpecID),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var err error
+			testFunc := func() {
+				err = tc.links.ValidateForType(tc.expHRP)
+			}
+			s.Require().NotPanics(testFunc, "ValidateForType(%q)", tc.expHRP)
+			assertions.AssertErrorValue(s.T(), err, tc.exp, "ValidateForType(%q)", tc.expHRP)
+		})
+	}
+}
+
+func (s *AddressTestSuite) TestAccMDLinks_ValidateUniformType() {
+	newUUID := func(name string, i int) uuid.UUID {
+		bz := []byte(fmt.Sprintf("%s[%d]________________", name, i))[:16]
+		rv, err := uuid.FromBytes(bz)
+		s.Require().NoError(err, "%s[%d]: uuid.FromBytes(%v)", name, i, bz)
+		return rv
+	}
+	scopeIDs := []MetadataAddress{
+		ScopeMetadataAddress(newUUID("scope", 0)),
+		ScopeMetadataAddress(newUUID("scope", 1)),
+	}
+	cSpecID := ContractSpecMetadataAddress(newUUID("contractspec", 0))
+	sSpecID := ScopeSpecMetadataAddress(newUUID("scopespec", 0))
+	badAddr := MetadataAddress{0xa0, 0x6e, 0x6f, 0x70, 0x65}
+	addr0 := sdk.AccAddress("addr0_______________")
+
+	tests := []struct {
+		name   string
+		links  AccMDLinks
+		expHRP string
+		expErr string
+	}{
+		{
+			name:   "nil links",
+			links:  nil,
+			expHRP: "",
+		},
+		{
+			name:   "empty links",
+			links:  AccMDLinks{},
+			expHRP: "",
 		},
 		{
-			name: "six links: same scopes but different acc addrs",
-			links: AccMDLinks{
-				{MDAddr: scopeIDs[4], AccAddr: addrs[0]}, {MDAddr: scopeIDs[4], AccAddr: addrs[1]},
-				{MDAddr: scopeIDs[4], AccAddr: addrs[2]}, {MDAddr: scopeIDs[4], AccAddr: addrs[3]},
-				{MDAddr: scopeIDs[4], AccAddr: addrs[4]}, {MDAddr: scopeIDs[4], AccAddr: addrs[5]},
-			},
-			exp: fmt.Sprintf("duplicate metadata address %q not allowed", scopeIDs[4]),
+			name:   "one scope",
+			links:  AccMDLinks{{MDAddr: scopeIDs[0], AccAddr: addr0}},
+			expHRP: PrefixScope,
 		},
 		{
-			name: "six links: all same",
-			links: AccMDLinks{
-				{MDAddr: scopeIDs[4], AccAddr: addrs[4]}, {MDAddr: scopeIDs[4], AccAddr: addrs[4]},
-				{MDAddr: scopeIDs[4], AccAddr: addrs[4]}, {MDAddr: scopeIDs[4], AccAddr: addrs[4]},
-				{MDAddr: scopeIDs[4], AccAddr: addrs[4]}, {MDAddr: scopeIDs[4], AccAddr: addrs[4]},
-			},
-			exp: fmt.Sprintf("duplicate metadata address %q not allowed", scopeIDs[4]),
+			name:   "two scopes",
+			links:  AccMDLinks{{MDAddr: scopeIDs[0], AccAddr: addr0}, {MDAddr: scopeIDs[1], AccAddr: addr0}},
+			expHRP: PrefixScope,
 		},
 		{
-			name: "six links: last is invalid md addr",
-			links: AccMDLinks{
-				{MDAddr: scopeIDs[5], AccAddr: addrs[5]}, {MDAddr: scopeIDs[4], AccAddr: addrs[4]},
-				{MDAddr: scopeIDs[3], AccAddr: addrs[3]}, {MDAddr: scopeIDs[2], AccAddr: addrs[2]},
-				{MDAddr: scopeIDs[1], AccAddr: addrs[1]}, {MDAddr: MetadataAddress{0xa0, 0x6e, 0x6f, 0x70, 0x65}, AccAddr: addrs[0]},
-			},
-			exp: "invalid scope metadata address MetadataAddress{0xa0, 0x6e, 0x6f, 0x70, 0x65}: invalid metadata address type: 160",
+			name:   "one contract spec",
+			links:  AccMDLinks{{MDAddr: cSpecID, AccAddr: addr0}},
+			expHRP: PrefixContractSpecification,
 		},
 		{
-			name: "six links: last is missing acc addr",
-			links: AccMDLinks{
-				{MDAddr: scopeIDs[1], AccAddr: addrs[1]}, {MDAddr: scopeIDs[0], AccAddr: addrs[0]},
-				{MDAddr: scopeIDs[2], AccAddr: addrs[2]}, {MDAddr: scopeIDs[5], AccAddr: addrs[5]},
-				{MDAddr: scopeIDs[3], AccAddr: addrs[3]}, {MDAddr: scopeIDs[4], AccAddr: nil},
-			},
-			exp: fmt.Sprintf("no account address associated with metadata address %q", scopeIDs[4]),
+			name:   "only entry has invalid address",
+			links:  AccMDLinks{{MDAddr: badAddr, AccAddr: addr0}},
+			expErr: "entry 0: invalid metadata address invalid[prefix=0xa0 len=5 hex=a06e6f7065]: invalid metadata address type: 160",
 		},
 		{
-			name: "six links: last is dup scope",
-			links: AccMDLinks{
-				{MDAddr: scopeIDs[0], AccAddr: addrs[0]}, {MDAddr: scopeIDs[1], AccAddr: addrs[0]},
-				{MDAddr: scopeIDs[2], AccAddr: addrs[2]}, {MDAddr: scopeIDs[3], AccAddr: addrs[3]},
-				{MDAddr: scopeIDs[4], AccAddr: addrs[4]}, {MDAddr: scopeIDs[3], AccAddr: addrs[5]},
-			},
-			exp: fmt.Sprintf("duplicate metadata address %q not allowed", scopeIDs[3]),
+			name:   "only entry is nil",
+			links:  AccMDLinks{nil},
+			expErr: "entry 0: nil entry not allowed",
 		},
 		{
-			name: "six links: last is nil",
-			links: AccMDLinks{
-				{MDAddr: scopeIDs[0], AccAddr: addrs[0]}, {MDAddr: scopeIDs[1], AccAddr: addrs[0]},
-				{MDAddr: scopeIDs[2], AccAddr: addrs[2]}, {MDAddr: scopeIDs[3], AccAddr: addrs[3]},
-				{MDAddr: scopeIDs[4], AccAddr: addrs[4]}, nil,
-			},
-			exp: "nil entry not allowed",
+			name:   "first entry invalid, rest scope specs",
+			links:  AccMDLinks{{MDAddr: badAddr, AccAddr: addr0}, {MDAddr: sSpecID, AccAddr: addr0}},
+			expErr: "entry 0: invalid scope specification metadata address invalid[prefix=0xa0 len=5 hex=a06e6f7065]: invalid metadata address type: 160",
 		},
 		{
-			name: "six links: last is empty",
+			name: "mixed types",
 			links: AccMDLinks{
-				{MDAddr: scopeIDs[0], AccAddr: addrs[0]}, {MDAddr: scopeIDs[1], AccAddr: addrs[0]},
-				{MDAddr: scopeIDs[2], AccAddr: addrs[2]}, {MDAddr: scopeIDs[3], AccAddr: addrs[3]},
-				{MDAddr: scopeIDs[4], AccAddr: addrs[4]}, {},
+				{MDAddr: scopeIDs[0], AccAddr: addr0},
+				{MDAddr: cSpecID, AccAddr: addr0},
+				{MDAddr: scopeIDs[1], AccAddr: addr0},
+				{MDAddr: sSpecID, AccAddr: addr0},
 			},
-			exp: "invalid scope metadata address MetadataAddress(nil): address is empty",
+			expErr: fmt.Sprintf("entry 1: invalid scope id %q: wrong type\n", cSpecID) +
+				fmt.Sprintf("entry 3: invalid scope id %q: wrong type", sSpecID),
+		},
+		{
+			name:   "uniform but missing acc addr",
+			links:  AccMDLinks{{MDAddr: cSpecID, AccAddr: nil}},
+			expErr: fmt.Sprintf("entry 0: no account address associated with metadata address %q", cSpecID),
 		},
 	}
 
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
+			var hrp string
 			var err error
 			testFunc := func() {
-				err = tc.links.ValidateForScopes()
+				hrp, err = tc.links.ValidateUniformType()
 			}
-			s.Require().NotPanics(testFunc, "ValidateForScopes")
-			assertions.AssertErrorValue(s.T(), err, tc.exp, "ValidateForScopes")
+			s.Require().NotPanics(testFunc, "ValidateUniformType()")
+			assertions.AssertErrorValue(s.T(), err, tc.expErr, "ValidateUniformType() error")
+			s.Assert().Equal(tc.expHRP, hrp, "ValidateUniformType() type")
 		})
 	}
 }
@@ -3097,6 +5187,153 @@ func (s *AddressTestSuite) TestAccMDLinks_GetPrimaryUUIDs() {
 	}
 }
 
+func (s *AddressTestSuite) TestAccMDLinks_GetPrimaryUUIDsWithInvalidSentinels() {
+	scopeID1 := ScopeMetadataAddress(uuid.MustParse("11111111-1111-1111-1111-111111111111"))
+	scopeID2 := ScopeMetadataAddress(uuid.MustParse("22222222-2222-2222-2222-222222222222"))
+
+	tests := []struct {
+		name  string
+		links AccMDLinks
+		exp   []string
+	}{
+		{
+			name:  "nil links",
+			links: nil,
+			exp:   nil,
+		},
+		{
+			name:  "one link: nil",
+			links: AccMDLinks{nil},
+			exp:   []string{"<nil>"},
+		},
+		{
+			name:  "one link: nil addr",
+			links: AccMDLinks{{MDAddr: nil}},
+			exp:   []string{"<empty>"},
+		},
+		{
+			name:  "one link: empty addr",
+			links: AccMDLinks{{MDAddr: MetadataAddress{}}},
+			exp:   []string{"<empty>"},
+		},
+		{
+			name:  "one link: invalid addr",
+			links: AccMDLinks{{MDAddr: MetadataAddress{0xA0, 0x1, 0x2}}},
+			exp:   []string{"<invalid>"},
+		},
+		{
+			name:  "one link: valid",
+			links: AccMDLinks{{MDAddr: scopeID1}},
+			exp:   []string{"11111111-1111-1111-1111-111111111111"},
+		},
+		{
+			name: "mix of valid and bad entries",
+			links: AccMDLinks{
+				{MDAddr: scopeID1},
+				nil,
+				{MDAddr: scopeID2[:16]},
+				{MDAddr: MetadataAddress{}},
+				{MDAddr: scopeID2},
+			},
+			exp: []string{
+				"11111111-1111-1111-1111-111111111111", "<nil>", "<invalid>",
+				"<empty>", "22222222-2222-2222-2222-222222222222",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var act []string
+			testFunc := func() {
+				act = tc.links.GetPrimaryUUIDs(WithInvalidSentinels)
+			}
+			s.Require().NotPanics(testFunc, "GetPrimaryUUIDs(WithInvalidSentinels)")
+			s.Assert().Equal(tc.exp, act, "result from GetPrimaryUUIDs(WithInvalidSentinels)")
+		})
+	}
+}
+
+func (s *AddressTestSuite) TestAccMDLinks_GetPrimaryUUIDsStrict() {
+	scopeID1 := ScopeMetadataAddress(uuid.MustParse("11111111-1111-1111-1111-111111111111"))
+	scopeID2 := ScopeMetadataAddress(uuid.MustParse("22222222-2222-2222-2222-222222222222"))
+
+	tests := []struct {
+		name   string
+		links  AccMDLinks
+		exp    []string
+		expErr []string
+	}{
+		{
+			name:  "nil links",
+			links: nil,
+			exp:   nil,
+		},
+		{
+			name:  "empty links",
+			links: AccMDLinks{},
+			exp:   []string{},
+		},
+		{
+			name:   "one link: nil",
+			links:  AccMDLinks{nil},
+			expErr: []string{"[0]: nil entry"},
+		},
+		{
+			name:   "one link: nil addr",
+			links:  AccMDLinks{{MDAddr: nil}},
+			expErr: []string{"[0]: nil metadata address"},
+		},
+		{
+			name:   "one link: empty addr",
+			links:  AccMDLinks{{MDAddr: MetadataAddress{}}},
+			expErr: []string{"[0]: empty metadata address"},
+		},
+		{
+			name:   "one link: invalid addr",
+			links:  AccMDLinks{{MDAddr: MetadataAddress{0xA0, 0x1, 0x2}}},
+			expErr: []string{"[0]: invalid metadata address 0XA00102: invalid address type out of valid range (got: 160)"},
+		},
+		{
+			name:  "two valid links",
+			links: AccMDLinks{{MDAddr: scopeID2}, {MDAddr: scopeID1}},
+			exp:   []string{"22222222-2222-2222-2222-222222222222", "11111111-1111-1111-1111-111111111111"},
+		},
+		{
+			name: "mix of valid and bad entries",
+			links: AccMDLinks{
+				{MDAddr: scopeID1},
+				nil,
+				{MDAddr: scopeID2},
+				{MDAddr: MetadataAddress{}},
+				{MDAddr: scopeID2[:16]},
+			},
+			expErr: []string{
+				"[1]: nil entry",
+				"[3]: empty metadata address",
+				fmt.Sprintf("[4]: invalid metadata address %#X: incorrect address length (must be at least 17, actual: 16)", []byte(scopeID2[:16])),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var act []string
+			var err error
+			testFunc := func() {
+				act, err = tc.links.GetPrimaryUUIDsStrict()
+			}
+			s.Require().NotPanics(testFunc, "GetPrimaryUUIDsStrict")
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, strings.Join(tc.expErr, "\n"), "GetPrimaryUUIDsStrict error")
+			} else {
+				s.Assert().NoError(err, "GetPrimaryUUIDsStrict error")
+			}
+			s.Assert().Equal(tc.exp, act, "result from GetPrimaryUUIDsStrict")
+		})
+	}
+}
+
 func (s *AddressTestSuite) TestAccMDLinks_GetMDAddrsForAccAddr() {
 	newUUID := func(name string) uuid.UUID {
 		s.Require().LessOrEqual(len(name), 16, "newUUID(%q): name too long")
@@ -3383,3 +5620,405 @@ func (s *AddressTestSuite) TestAccMDLinks_GetMDAddrsForAccAddr() {
 		})
 	}
 }
+
+func (s *AddressTestSuite) TestAccMDLinks_SetOperations() {
+	addr1 := sdk.AccAddress("1addr_______________")
+	addr2 := sdk.AccAddress("2addr_______________")
+	scope1 := ScopeMetadataAddress(uuid.MustParse("11111111-1111-1111-1111-111111111111"))
+	scope2 := ScopeMetadataAddress(uuid.MustParse("22222222-2222-2222-2222-222222222222"))
+
+	link11 := NewAccMDLink(addr1, scope1)
+	link12 := NewAccMDLink(addr1, scope2)
+	link21 := NewAccMDLink(addr2, scope1)
+	link22 := NewAccMDLink(addr2, scope2)
+	// link11Copy has the same addresses as link11, but is a different pointer with different underlying slices.
+	link11Copy := NewAccMDLink(sdk.AccAddress(string(addr1)), MetadataAddress(string(scope1)))
+
+	s.Run("Contains", func() {
+		tests := []struct {
+			name  string
+			links AccMDLinks
+			acc   sdk.AccAddress
+			md    MetadataAddress
+			exp   bool
+		}{
+			{name: "nil links", links: nil, acc: addr1, md: scope1, exp: false},
+			{name: "only nil entries", links: AccMDLinks{nil, nil}, acc: addr1, md: scope1, exp: false},
+			{name: "nil entry then match", links: AccMDLinks{nil, link11}, acc: addr1, md: scope1, exp: true},
+			{name: "match via equal bytes", links: AccMDLinks{link11Copy}, acc: addr1, md: scope1, exp: true},
+			{name: "acc matches, md does not", links: AccMDLinks{link11, link22}, acc: addr1, md: scope2, exp: false},
+			{name: "md matches, acc does not", links: AccMDLinks{link12, link21}, acc: addr2, md: scope2, exp: false},
+			{name: "nil addrs", links: AccMDLinks{{}}, acc: nil, md: nil, exp: true},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				var act bool
+				testFunc := func() {
+					act = tc.links.Contains(tc.acc, tc.md)
+				}
+				s.Require().NotPanics(testFunc, "Contains")
+				s.Assert().Equal(tc.exp, act, "Contains(%s, %s)", accStr(tc.acc), mdStr(tc.md))
+			})
+		}
+	})
+
+	s.Run("Deduplicated", func() {
+		tests := []struct {
+			name  string
+			links AccMDLinks
+			exp   AccMDLinks
+		}{
+			{name: "nil", links: nil, exp: nil},
+			{name: "empty", links: AccMDLinks{}, exp: nil},
+			{name: "only nil entries", links: AccMDLinks{nil, nil}, exp: nil},
+			{name: "no dups", links: AccMDLinks{link22, link11, link12}, exp: AccMDLinks{link22, link11, link12}},
+			{
+				name:  "dups and nils",
+				links: AccMDLinks{link12, nil, link11, link12, link11Copy, nil, link21},
+				exp:   AccMDLinks{link12, link11, link21},
+			},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				var act AccMDLinks
+				testFunc := func() {
+					act = tc.links.Deduplicated()
+				}
+				s.Require().NotPanics(testFunc, "Deduplicated")
+				s.Assert().Equal(tc.exp.String(), act.String(), "Deduplicated")
+			})
+		}
+	})
+
+	s.Run("Union", func() {
+		tests := []struct {
+			name  string
+			links AccMDLinks
+			other AccMDLinks
+			exp   AccMDLinks
+		}{
+			{name: "nil nil", links: nil, other: nil, exp: nil},
+			{name: "some nil", links: AccMDLinks{link11, link12}, other: nil, exp: AccMDLinks{link11, link12}},
+			{name: "nil some", links: nil, other: AccMDLinks{link21, link11}, exp: AccMDLinks{link21, link11}},
+			{
+				name:  "overlapping",
+				links: AccMDLinks{link22, nil, link11},
+				other: AccMDLinks{link11Copy, link12, nil, link22, link21},
+				exp:   AccMDLinks{link22, link11, link12, link21},
+			},
+			{
+				name:  "dups in other",
+				links: AccMDLinks{link11},
+				other: AccMDLinks{link12, link12},
+				exp:   AccMDLinks{link11, link12},
+			},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				var act AccMDLinks
+				testFunc := func() {
+					act = tc.links.Union(tc.other)
+				}
+				s.Require().NotPanics(testFunc, "Union")
+				s.Assert().Equal(tc.exp.String(), act.String(), "Union")
+			})
+		}
+	})
+
+	s.Run("Difference", func() {
+		tests := []struct {
+			name  string
+			links AccMDLinks
+			other AccMDLinks
+			exp   AccMDLinks
+		}{
+			{name: "nil nil", links: nil, other: nil, exp: nil},
+			{name: "nil some", links: nil, other: AccMDLinks{link11}, exp: nil},
+			{name: "some nil", links: AccMDLinks{link12, nil, link12}, other: nil, exp: AccMDLinks{link12}},
+			{
+				name:  "overlapping",
+				links: AccMDLinks{link22, link11, nil, link12, link21},
+				other: AccMDLinks{nil, link11Copy, link21},
+				exp:   AccMDLinks{link22, link12},
+			},
+			{
+				name:  "all removed",
+				links: AccMDLinks{link11, link12},
+				other: AccMDLinks{link12, link22, link11},
+				exp:   nil,
+			},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				var act AccMDLinks
+				testFunc := func() {
+					act = tc.links.Difference(tc.other)
+				}
+				s.Require().NotPanics(testFunc, "Difference")
+				s.Assert().Equal(tc.exp.String(), act.String(), "Difference")
+			})
+		}
+	})
+}
+
+func (s *AddressTestSuite) TestMetadataAddress_Less() {
+	scope1 := ScopeMetadataAddress(uuid.MustParse("11111111-1111-1111-1111-111111111111"))
+	scope2 := ScopeMetadataAddress(uuid.MustParse("22222222-2222-2222-2222-222222222222"))
+	session1 := SessionMetadataAddress(uuid.MustParse("11111111-1111-1111-1111-111111111111"), uuid.MustParse("33333333-3333-3333-3333-333333333333"))
+
+	tests := []struct {
+		name  string
+		ma    MetadataAddress
+		other MetadataAddress
+		exp   bool
+	}{
+		{name: "both nil", ma: nil, other: nil, exp: false},
+		{name: "nil and scope", ma: nil, other: scope1, exp: true},
+		{name: "scope and nil", ma: scope1, other: nil, exp: false},
+		{name: "same scope", ma: scope1, other: scope1, exp: false},
+		{name: "lower scope uuid", ma: scope1, other: scope2, exp: true},
+		{name: "higher scope uuid", ma: scope2, other: scope1, exp: false},
+		{name: "scope and session", ma: scope2, other: session1, exp: true},
+		{name: "session and scope", ma: session1, other: scope1, exp: false},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var act bool
+			testFunc := func() {
+				act = tc.ma.Less(tc.other)
+			}
+			s.Require().NotPanics(testFunc, "Less")
+			s.Assert().Equal(tc.exp, act, "%s.Less(%s)", mdStr(tc.ma), mdStr(tc.other))
+		})
+	}
+}
+
+func (s *AddressTestSuite) TestMetadataAddresses() {
+	scopeUUID1 := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	scopeUUID2 := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	scope1 := ScopeMetadataAddress(scopeUUID1)
+	scope2 := ScopeMetadataAddress(scopeUUID2)
+	session1 := SessionMetadataAddress(scopeUUID1, uuid.MustParse("33333333-3333-3333-3333-333333333333"))
+	session2 := SessionMetadataAddress(scopeUUID2, uuid.MustParse("33333333-3333-3333-3333-333333333333"))
+	record1 := RecordMetadataAddress(scopeUUID1, "record1")
+	// scope1Copy has the same bytes as scope1, but a different underlying slice.
+	scope1Copy := MetadataAddress(string(scope1))
+
+	s.Run("String", func() {
+		tests := []struct {
+			name string
+			mas  MetadataAddresses
+			exp  string
+		}{
+			{name: "nil", mas: nil, exp: "<nil>"},
+			{name: "empty", mas: MetadataAddresses{}, exp: "<empty>"},
+			{name: "one", mas: MetadataAddresses{scope1}, exp: "[" + scope1.String() + "]"},
+			{
+				name: "three with nil and empty",
+				mas:  MetadataAddresses{session1, nil, MetadataAddress{}},
+				exp:  "[" + session1.String() + ", <nil>, <empty>]",
+			},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				var act string
+				testFunc := func() {
+					act = tc.mas.String()
+				}
+				s.Require().NotPanics(testFunc, "String")
+				s.Assert().Equal(tc.exp, act, "String")
+			})
+		}
+	})
+
+	s.Run("Sort", func() {
+		tests := []struct {
+			name string
+			mas  MetadataAddresses
+			exp  MetadataAddresses
+		}{
+			{name: "nil", mas: nil, exp: nil},
+			{name: "empty", mas: MetadataAddresses{}, exp: MetadataAddresses{}},
+			{name: "one", mas: MetadataAddresses{scope1}, exp: MetadataAddresses{scope1}},
+			{
+				name: "grouped by type",
+				mas:  MetadataAddresses{record1, session2, scope2, session1, scope1},
+				exp:  MetadataAddresses{scope1, scope2, session1, session2, record1},
+			},
+			{
+				name: "nil first",
+				mas:  MetadataAddresses{scope2, nil, scope1},
+				exp:  MetadataAddresses{nil, scope1, scope2},
+			},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				testFunc := func() {
+					tc.mas.Sort()
+				}
+				s.Require().NotPanics(testFunc, "Sort")
+				s.Assert().Equal(tc.exp, tc.mas, "after Sort")
+			})
+		}
+
+		s.Run("stable", func() {
+			mas := MetadataAddresses{scope2, scope1Copy, session1, scope1}
+			mas.Sort()
+			s.Require().Equal(MetadataAddresses{scope1, scope1, scope2, session1}, mas, "after Sort")
+			s.Assert().Same(&scope1Copy[0], &mas[0][0], "first entry should still be scope1Copy")
+			s.Assert().Same(&scope1[0], &mas[1][0], "second entry should still be scope1")
+		})
+	})
+
+	s.Run("Contains", func() {
+		tests := []struct {
+			name string
+			mas  MetadataAddresses
+			ma   MetadataAddress
+			exp  bool
+		}{
+			{name: "nil list", mas: nil, ma: scope1, exp: false},
+			{name: "not in list", mas: MetadataAddresses{scope2, session1}, ma: scope1, exp: false},
+			{name: "in list", mas: MetadataAddresses{scope2, scope1}, ma: scope1, exp: true},
+			{name: "equal bytes", mas: MetadataAddresses{scope1Copy}, ma: scope1, exp: true},
+			{name: "nil in list", mas: MetadataAddresses{scope1, nil}, ma: nil, exp: true},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				var act bool
+				testFunc := func() {
+					act = tc.mas.Contains(tc.ma)
+				}
+				s.Require().NotPanics(testFunc, "Contains")
+				s.Assert().Equal(tc.exp, act, "Contains(%s)", mdStr(tc.ma))
+			})
+		}
+	})
+
+	s.Run("Deduplicated", func() {
+		tests := []struct {
+			name string
+			mas  MetadataAddresses
+			exp  MetadataAddresses
+		}{
+			{name: "nil", mas: nil, exp: nil},
+			{name: "empty", mas: MetadataAddresses{}, exp: nil},
+			{name: "no dups", mas: MetadataAddresses{scope2, scope1}, exp: MetadataAddresses{scope2, scope1}},
+			{
+				name: "dups keep first order",
+				mas:  MetadataAddresses{session1, scope1, session1, scope1Copy, scope2},
+				exp:  MetadataAddresses{session1, scope1, scope2},
+			},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				var orig MetadataAddresses
+				if tc.mas != nil {
+					orig = make(MetadataAddresses, len(tc.mas))
+					copy(orig, tc.mas)
+				}
+				var act MetadataAddresses
+				testFunc := func() {
+					act = tc.mas.Deduplicated()
+				}
+				s.Require().NotPanics(testFunc, "Deduplicated")
+				s.Assert().Equal(tc.exp, act, "Deduplicated")
+				s.Assert().Equal(orig, tc.mas, "original after Deduplicated")
+			})
+		}
+	})
+}
+
+// addFuzzSeeds adds some valid and almost-valid metadata addresses to the provided fuzz test's seed corpus.
+func addFuzzSeeds(f *testing.F) {
+	scopeUUID := uuid.MustParse("91978ba2-5f35-459a-86a7-feca1b0512e0")
+	sessionUUID := uuid.MustParse("5803f8bc-6067-4eb5-951f-2121671c2ec0")
+	addrs := []MetadataAddress{
+		ScopeMetadataAddress(scopeUUID),
+		SessionMetadataAddress(scopeUUID, sessionUUID),
+		RecordMetadataAddress(scopeUUID, "recordname"),
+		ScopeSpecMetadataAddress(scopeUUID),
+		ContractSpecMetadataAddress(scopeUUID),
+		RecordSpecMetadataAddress(scopeUUID, "recordname"),
+	}
+	f.Add([]byte{})
+	for _, addr := range addrs {
+		f.Add([]byte(addr))
+		f.Add([]byte(addr[:1]))
+		f.Add([]byte(addr[:16]))
+		f.Add(append([]byte(addr), 0x01, 0x02))
+	}
+	f.Add([]byte{0xff, 0x01, 0x02})
+}
+
+// fuzzMaxAddrLen is the maximum length of the addresses checked by the fuzz tests.
+const fuzzMaxAddrLen = 64
+
+func FuzzVerifyMetadataAddressFormat(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		if len(bz) > fuzzMaxAddrLen {
+			t.Skip()
+		}
+		hrp, err := VerifyMetadataAddressFormat(bz)
+		if err == nil && len(hrp) == 0 {
+			t.Errorf("VerifyMetadataAddressFormat(%v) returned no error and an empty hrp", bz)
+		}
+	})
+}
+
+func FuzzMetadataAddressIteratorPrefixes(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		if len(bz) > fuzzMaxAddrLen {
+			t.Skip()
+		}
+		ma := MetadataAddress(bz)
+		getters := []struct {
+			name   string
+			getter func() ([]byte, error)
+		}{
+			{name: "ScopeSessionIteratorPrefix", getter: ma.ScopeSessionIteratorPrefix},
+			{name: "ScopeRecordIteratorPrefix", getter: ma.ScopeRecordIteratorPrefix},
+			{name: "ContractSpecRecordSpecIteratorPrefix", getter: ma.ContractSpecRecordSpecIteratorPrefix},
+			{name: "ScopeSpecScopeIteratorPrefix", getter: ma.ScopeSpecScopeIteratorPrefix},
+			{name: "ContractSpecSessionIteratorPrefix", getter: ma.ContractSpecSessionIteratorPrefix},
+		}
+		for _, g := range getters {
+			prefix, err := g.getter()
+			if err == nil && len(prefix) == 0 {
+				t.Errorf("%s(%v) returned no error and an empty prefix", g.name, bz)
+			}
+			if err != nil && len(prefix) != 0 {
+				t.Errorf("%s(%v) returned an error and a prefix %v", g.name, bz, prefix)
+			}
+		}
+	})
+}
+
+func FuzzMetadataAddressGetDetails(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		if len(bz) > fuzzMaxAddrLen {
+			t.Skip()
+		}
+		details := MetadataAddress(bz).GetDetails()
+		if !bytes.Equal(details.Address, bz) {
+			t.Errorf("GetDetails(%v).Address = %v", bz, details.Address)
+		}
+		partsLen := len(details.AddressPrefix) + len(details.AddressPrimaryUUID) + len(details.AddressSecondaryUUID) +
+			len(details.AddressNameHash) + len(details.AddressExcess)
+		if partsLen != len(bz) && len(bz) >= 17 {
+			t.Errorf("GetDetails(%v) parts have a total length of %d, expected %d", bz, partsLen, len(bz))
+		}
+	})
+}
@@ -0,0 +1,337 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// These benchmarks and allocation budgets cover the MetadataAddress and AccMDLinks functions that get used
+// a lot during block processing. Run the benchmarks using:
+//   go test ./x/metadata/types -run '^$' -bench . -benchmem
+//
+// The allocation budgets are the maximum number of allocations each function is allowed to make.
+// If a change causes one of these budgets to be exceeded, it probably means that change introduced
+// a performance regression. If that's intentional (and can't be avoided), update the budget in the
+// same change so that it's clear the regression is known.
+//
+// Current budgets (per call):
+//   MetadataAddress.String:             2 (the bech32 conversion and encoding)
+//   MetadataAddress.Empty:              0
+//   MetadataAddress.IdenticalTo:        0
+//   MetadataAddress.Validate:           0
+//   MetadataAddress.Prefix:             0
+//   MetadataAddress.isTypeOneOf:        0
+//   MetadataAddress.HasNameHash:        0
+//   MetadataAddress.IsScopeAddress:     0
+//   MetadataAddress.GetDetails:         5 (the copy, uuid strings, name hash encodings, and parent)
+//   ParseMetadataAddressFromBech32:     2 (the bech32 decoding and conversion)
+//   AccMDLinks.GetAccAddrs:             86 for 100 links with 76 different account addresses
+//   AccMDLinks.GetMDAddrsForAccAddr:    5 for 100 links with 25 matches (just growing the result)
+//   AccMDLinks.Marshal:                 1 (the result)
+//
+// The AccMDLinks Marshal/Unmarshal benchmarks have MarshalJSON/UnmarshalJSON counterparts to compare the
+// binary encoding against the bech32 string one.
+
+// benchAddrs holds one valid MetadataAddress of each type.
+type benchAddrs struct {
+	scope        MetadataAddress
+	session      MetadataAddress
+	record       MetadataAddress
+	scopeSpec    MetadataAddress
+	contractSpec MetadataAddress
+	recordSpec   MetadataAddress
+}
+
+// newBenchAddrs creates a benchAddrs with a valid address of each type.
+func newBenchAddrs() benchAddrs {
+	scopeUUID := uuid.MustParse("91978ba2-5f35-459a-86a7-feca1b0512e0")
+	sessionUUID := uuid.MustParse("5803f8bc-6067-4eb5-951f-2121671c2ec0")
+	specUUID := uuid.MustParse("def6bc0a-c9dd-4874-948f-5206e6060a84")
+	return benchAddrs{
+		scope:        ScopeMetadataAddress(scopeUUID),
+		session:      SessionMetadataAddress(scopeUUID, sessionUUID),
+		record:       RecordMetadataAddress(scopeUUID, "recordname"),
+		scopeSpec:    ScopeSpecMetadataAddress(specUUID),
+		contractSpec: ContractSpecMetadataAddress(specUUID),
+		recordSpec:   RecordSpecMetadataAddress(specUUID, "recordname"),
+	}
+}
+
+// all returns all of the addresses in this benchAddrs along with their type names.
+func (a benchAddrs) all() []struct {
+	name string
+	addr MetadataAddress
+} {
+	return []struct {
+		name string
+		addr MetadataAddress
+	}{
+		{name: "scope", addr: a.scope},
+		{name: "session", addr: a.session},
+		{name: "record", addr: a.record},
+		{name: "scope spec", addr: a.scopeSpec},
+		{name: "contract spec", addr: a.contractSpec},
+		{name: "record spec", addr: a.recordSpec},
+	}
+}
+
+// benchLinkSizes are the AccMDLinks sizes used in the benchmarks.
+// Most txs have only a few, but some (e.g. a bulk value owner update) can have a thousand or more.
+var benchLinkSizes = []int{10, 100, 1000}
+
+// newBenchLinks creates an AccMDLinks with the provided number of entries.
+// Every 4th entry has the same account address, the rest all have different ones.
+func newBenchLinks(count int) (AccMDLinks, sdk.AccAddress) {
+	common := sdk.AccAddress("common_acc_addr_____")
+	rv := make(AccMDLinks, count)
+	for i := range rv {
+		acc := common
+		if i%4 != 0 {
+			acc = sdk.AccAddress(fmt.Sprintf("acc_addr_%011d", i))
+		}
+		rv[i] = NewAccMDLink(acc, ScopeMetadataAddress(UUIDFromSeed(uuid.Nil, fmt.Sprintf("scope %d", i))))
+	}
+	return rv, common
+}
+
+// Sinks for the benchmark results so that the compiler doesn't optimize away the calls.
+var (
+	benchStr      string
+	benchErr      error
+	benchBool     bool
+	benchAddr     MetadataAddress
+	benchDetails  MetadataAddressDetails
+	benchAccAddrs []sdk.AccAddress
+	benchMDAddrs  []MetadataAddress
+	benchBz       []byte
+	benchLinks    AccMDLinks
+)
+
+func BenchmarkMetadataAddress_String(b *testing.B) {
+	for _, tc := range newBenchAddrs().all() {
+		b.Run(tc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchStr = tc.addr.String()
+			}
+		})
+	}
+}
+
+// benchNilEmptyAddrs returns the nil and empty addresses (as well as a valid one) used in the Empty and IdenticalTo benchmarks.
+func benchNilEmptyAddrs() []struct {
+	name string
+	addr MetadataAddress
+} {
+	return []struct {
+		name string
+		addr MetadataAddress
+	}{
+		{name: "nil", addr: nil},
+		{name: "empty", addr: MetadataAddress{}},
+		{name: "scope", addr: newBenchAddrs().scope},
+	}
+}
+
+func BenchmarkMetadataAddress_Empty(b *testing.B) {
+	for _, tc := range benchNilEmptyAddrs() {
+		b.Run(tc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchBool = tc.addr.Empty()
+			}
+		})
+	}
+}
+
+func BenchmarkMetadataAddress_IdenticalTo(b *testing.B) {
+	for _, tc := range benchNilEmptyAddrs() {
+		// A clone so that the bytes are actually compared (and nil stays nil).
+		other := slices.Clone(tc.addr)
+		b.Run(tc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchBool = tc.addr.IdenticalTo(other)
+			}
+		})
+	}
+}
+
+func BenchmarkMetadataAddress_Validate(b *testing.B) {
+	for _, tc := range newBenchAddrs().all() {
+		b.Run(tc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchErr = tc.addr.Validate()
+			}
+		})
+	}
+}
+
+func BenchmarkMetadataAddress_GetDetails(b *testing.B) {
+	for _, tc := range newBenchAddrs().all() {
+		b.Run(tc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchDetails = tc.addr.GetDetails()
+			}
+		})
+	}
+}
+
+func BenchmarkParseMetadataAddressFromBech32(b *testing.B) {
+	for _, tc := range newBenchAddrs().all() {
+		str := tc.addr.String()
+		b.Run(tc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchAddr, benchStr, benchErr = ParseMetadataAddressFromBech32(str)
+			}
+		})
+	}
+}
+
+func BenchmarkAccMDLinks_GetAccAddrs(b *testing.B) {
+	for _, size := range benchLinkSizes {
+		links, _ := newBenchLinks(size)
+		b.Run(fmt.Sprintf("%d links", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchAccAddrs = links.GetAccAddrs()
+			}
+		})
+	}
+}
+
+func BenchmarkAccMDLinks_GetMDAddrsForAccAddr(b *testing.B) {
+	for _, size := range benchLinkSizes {
+		links, common := newBenchLinks(size)
+		addr := common.String()
+		b.Run(fmt.Sprintf("%d links", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchMDAddrs = links.GetMDAddrsForAccAddr(addr)
+			}
+		})
+	}
+}
+
+func BenchmarkAccMDLinks_Marshal(b *testing.B) {
+	for _, size := range benchLinkSizes {
+		links, _ := newBenchLinks(size)
+		b.Run(fmt.Sprintf("%d links", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchBz, benchErr = links.Marshal()
+			}
+		})
+	}
+}
+
+func BenchmarkAccMDLinks_MarshalJSON(b *testing.B) {
+	for _, size := range benchLinkSizes {
+		links, _ := newBenchLinks(size)
+		b.Run(fmt.Sprintf("%d links", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchBz, benchErr = links.MarshalJSON()
+			}
+		})
+	}
+}
+
+func BenchmarkAccMDLinks_Unmarshal(b *testing.B) {
+	for _, size := range benchLinkSizes {
+		links, _ := newBenchLinks(size)
+		bz, err := links.Marshal()
+		if err != nil {
+			b.Fatalf("Marshal() error: %v", err)
+		}
+		b.Run(fmt.Sprintf("%d links", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchErr = benchLinks.Unmarshal(bz)
+			}
+		})
+	}
+}
+
+func BenchmarkAccMDLinks_UnmarshalJSON(b *testing.B) {
+	for _, size := range benchLinkSizes {
+		links, _ := newBenchLinks(size)
+		bz, err := links.MarshalJSON()
+		if err != nil {
+			b.Fatalf("MarshalJSON() error: %v", err)
+		}
+		b.Run(fmt.Sprintf("%d links", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchErr = benchLinks.UnmarshalJSON(bz)
+			}
+		})
+	}
+}
+
+// assertAllocsAtMost asserts that the provided function makes at most the provided number of allocations per run.
+func assertAllocsAtMost(t *testing.T, budget float64, f func(), name string) bool {
+	t.Helper()
+	allocs := testing.AllocsPerRun(100, f)
+	return assert.LessOrEqual(t, allocs, budget, "number of allocations made by %s", name)
+}
+
+func TestAllocationBudgets(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping allocation budgets in short mode")
+	}
+
+	for _, tc := range newBenchAddrs().all() {
+		addr := tc.addr
+		str := addr.String()
+		t.Run(tc.name, func(t *testing.T) {
+			assertAllocsAtMost(t, 2, func() { benchStr = addr.String() }, "String")
+			assertAllocsAtMost(t, 0, func() { benchBool = addr.Empty() }, "Empty")
+			assertAllocsAtMost(t, 0, func() { benchBool = addr.IdenticalTo(addr) }, "IdenticalTo")
+			assertAllocsAtMost(t, 0, func() { benchErr = addr.Validate() }, "Validate")
+			assertAllocsAtMost(t, 0, func() { benchStr, benchErr = addr.Prefix() }, "Prefix")
+			assertAllocsAtMost(t, 0, func() {
+				benchBool = addr.isTypeOneOf(ScopeKeyPrefix, SessionKeyPrefix, RecordKeyPrefix)
+			}, "isTypeOneOf")
+			assertAllocsAtMost(t, 0, func() { benchBool = addr.HasNameHash() }, "HasNameHash")
+			assertAllocsAtMost(t, 0, func() { benchBool = addr.IsScopeAddress() }, "IsScopeAddress")
+			assertAllocsAtMost(t, 5, func() { benchDetails = addr.GetDetails() }, "GetDetails")
+			assertAllocsAtMost(t, 2, func() {
+				benchAddr, benchStr, benchErr = ParseMetadataAddressFromBech32(str)
+			}, "ParseMetadataAddressFromBech32")
+		})
+	}
+
+	for _, tc := range benchNilEmptyAddrs()[:2] {
+		addr := tc.addr
+		t.Run(tc.name, func(t *testing.T) {
+			assertAllocsAtMost(t, 0, func() { benchBool = addr.Empty() }, "Empty")
+			assertAllocsAtMost(t, 0, func() { benchBool = addr.IdenticalTo(nil) }, "IdenticalTo")
+		})
+	}
+
+	t.Run("GetAccAddrs", func(t *testing.T) {
+		links, _ := newBenchLinks(100)
+		assertAllocsAtMost(t, 86, func() { benchAccAddrs = links.GetAccAddrs() }, "GetAccAddrs")
+	})
+
+	t.Run("GetMDAddrsForAccAddr", func(t *testing.T) {
+		links, common := newBenchLinks(100)
+		addr := common.String()
+		assertAllocsAtMost(t, 5, func() { benchMDAddrs = links.GetMDAddrsForAccAddr(addr) }, "GetMDAddrsForAccAddr")
+	})
+
+	t.Run("Marshal", func(t *testing.T) {
+		links, _ := newBenchLinks(100)
+		assertAllocsAtMost(t, 1, func() { benchBz, benchErr = links.Marshal() }, "Marshal")
+	})
+}
@@ -8,7 +8,10 @@ import (
 )
 
 // RegisterInterfaces registers concrete implementations for this module.
+// It also enables StrictMetadataAddressUnmarshal so that malformed metadata addresses are rejected when decoded.
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	StrictMetadataAddressUnmarshal = true
+
 	messages := make([]proto.Message, len(AllRequestMsgs))
 	for i, msg := range AllRequestMsgs {
 		messages[i] = msg
@@ -14,4 +14,6 @@ var (
 	ErrOSLocatorURIToolong = cerrs.Register(ModuleName, 5, "uri length greater than allowed")
 	ErrNoRecordsFound      = cerrs.Register(ModuleName, 6, "No records found.")
 	ErrOSLocatorURIInvalid = cerrs.Register(ModuleName, 7, "uri is invalid")
+	// ErrScopeHasRecords occurs when trying to burn the coin of a scope that still has sessions or records.
+	ErrScopeHasRecords = cerrs.Register(ModuleName, 8, "scope still has sessions or records")
 )
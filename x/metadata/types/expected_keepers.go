@@ -0,0 +1,18 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MetadataBankKeeper defines the bank functionality needed to manage the lifecycle of metadata denoms (e.g. nft/scope1...).
+type MetadataBankKeeper interface {
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+
+	// DenomOwner is not in the bank keeper, but is added by the metadata keeper's MDBankKeeper.
+	DenomOwner(ctx context.Context, denom string) (sdk.AccAddress, error)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -51,7 +53,13 @@ const (
 //
 // - 0x14<contract_spec_id><scope_spec_id>: 0x01
 //
+// - 0x15<contract_spec_id><session_id>: 0x01
+//
 // - 0x20<owner_address><contract_spec_id>: 0x01
+//
+// - 0x26<height><metadata_id>: 0x01
+//
+// - 0x27<metadata_id>: <height>
 var (
 	// ScopeKeyPrefix is the key for scope records in metadata store
 	ScopeKeyPrefix = []byte{0x00}
@@ -75,6 +83,8 @@ var (
 	AddressScopeSpecCacheKeyPrefix = []byte{0x19}
 	// ContractSpecScopeSpecCacheKeyPrefix for scope spec lookup by contract spec
 	ContractSpecScopeSpecCacheKeyPrefix = []byte{0x14}
+	// ContractSpecSessionCacheKeyPrefix for session lookup by contract spec
+	ContractSpecSessionCacheKeyPrefix = []byte{0x15}
 	// AddressContractSpecCacheKeyPrefix for contract spec lookup by address
 	AddressContractSpecCacheKeyPrefix = []byte{0x20}
 
@@ -86,6 +96,17 @@ var (
 
 	// OSLocatorParamPrefix prefix for os locator params
 	OSLocatorParamPrefix = []byte{0x23}
+
+	// ParamsKey is the key for the metadata module params
+	ParamsKey = []byte{0x24}
+
+	// RecordNameKeyPrefix prefix for the record name registry (name hash to name)
+	RecordNameKeyPrefix = []byte{0x25}
+
+	// ModificationIndexKeyPrefix prefix for the modification index (height and metadata address)
+	ModificationIndexKeyPrefix = []byte{0x26}
+	// LastModifiedKeyPrefix prefix for the last modified height of each metadata address
+	LastModifiedKeyPrefix = []byte{0x27}
 )
 
 // GetAddressScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries assigned to a given address
@@ -128,6 +149,16 @@ func GetContractSpecScopeSpecCacheKey(contractSpecID MetadataAddress, scopeSpecI
 	return append(GetContractSpecScopeSpecCacheIteratorPrefix(contractSpecID), scopeSpecID.Bytes()...)
 }
 
+// GetContractSpecSessionCacheIteratorPrefix returns an iterator prefix for all session cache entries assigned to a given contract spec
+func GetContractSpecSessionCacheIteratorPrefix(contractSpecID MetadataAddress) []byte {
+	return append(ContractSpecSessionCacheKeyPrefix, contractSpecID.Bytes()...)
+}
+
+// GetContractSpecSessionCacheKey returns the store key for a contract spec + session cache entry
+func GetContractSpecSessionCacheKey(contractSpecID MetadataAddress, sessionID MetadataAddress) []byte {
+	return append(GetContractSpecSessionCacheIteratorPrefix(contractSpecID), sessionID.Bytes()...)
+}
+
 // GetAddressContractSpecCacheIteratorPrefix returns an iterator prefix for all contract spec cache entries assigned to a given address
 func GetAddressContractSpecCacheIteratorPrefix(addr sdk.AccAddress) []byte {
 	return append(AddressContractSpecCacheKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
@@ -152,3 +183,36 @@ func NetAssetValueKeyPrefix(scopeAddr MetadataAddress) []byte {
 func NetAssetValueKey(scopeAddr MetadataAddress, denom string) []byte {
 	return append(NetAssetValueKeyPrefix(scopeAddr), denom...)
 }
+
+// GetRecordNameKey returns the store key for a record name registry entry: [prefix][name hash].
+func GetRecordNameKey(nameHash []byte) []byte {
+	return append(RecordNameKeyPrefix, nameHash...)
+}
+
+// GetModificationIndexHeightPrefix returns the [prefix][height] part of a modification index key.
+func GetModificationIndexHeightPrefix(height uint64) []byte {
+	return append(ModificationIndexKeyPrefix, sdk.Uint64ToBigEndian(height)...)
+}
+
+// GetModificationIndexKey returns the store key for a modification index entry: [prefix][height][metadata address].
+func GetModificationIndexKey(height uint64, id MetadataAddress) []byte {
+	return append(GetModificationIndexHeightPrefix(height), id...)
+}
+
+// ParseModificationIndexKey extracts the height and metadata address from a modification index key
+// that does not have the prefix byte (e.g. as provided when iterating a prefix store): [height][metadata address].
+func ParseModificationIndexKey(key []byte) (uint64, MetadataAddress, error) {
+	if len(key) <= 8 {
+		return 0, nil, fmt.Errorf("invalid modification index key %x: too short", key)
+	}
+	id := MetadataAddress(key[8:])
+	if err := id.Validate(); err != nil {
+		return 0, nil, fmt.Errorf("invalid modification index key %x: %w", key, err)
+	}
+	return sdk.BigEndianToUint64(key[:8]), id, nil
+}
+
+// GetLastModifiedKey returns the store key for the last modified height of a metadata address: [prefix][metadata address].
+func GetLastModifiedKey(id MetadataAddress) []byte {
+	return append(LastModifiedKeyPrefix, id...)
+}
@@ -1,5 +1,7 @@
 package types
 
+import "encoding/hex"
+
 // GetScopeIDInfo creates a ScopeIdInfo populated with info about the provided MetadataAddress.
 func GetScopeIDInfo(scopeID MetadataAddress) *ScopeIdInfo {
 	info := scopeID.GetDetails()
@@ -75,3 +77,24 @@ func GetRecordSpecIDInfo(recordSpecID MetadataAddress) *RecordSpecIdInfo {
 		ContractSpecIdInfo:           GetContractSpecIDInfo(info.ParentAddress),
 	}
 }
+
+// GetAddressDetails creates an AddressDetails populated with info about the provided MetadataAddress.
+// The provided MetadataAddress does not need to be valid.
+func GetAddressDetails(addr MetadataAddress) *AddressDetails {
+	info := addr.GetDetails()
+	rv := &AddressDetails{
+		AddressHex:     hex.EncodeToString(info.Address),
+		Prefix:         info.Prefix,
+		PrimaryUuid:    info.PrimaryUUID,
+		SecondaryUuid:  info.SecondaryUUID,
+		NameHashHex:    info.NameHashHex,
+		NameHashBase64: info.NameHashBase64,
+		ExcessHex:      info.ExcessHex,
+		ExcessBase64:   info.ExcessBase64,
+		ParentAddress:  info.ParentAddress.String(),
+	}
+	if _, err := VerifyMetadataAddressFormat(info.Address); err == nil {
+		rv.Address = info.Address.String()
+	}
+	return rv
+}
@@ -25,6 +25,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the set of params for the metadata module.
 type Params struct {
+	// enable_record_name_registry is whether to record the names of records and record specifications by their name hash.
+	// When true, the names can be looked up using the RecordNameByHash and NameForRecordAddress queries.
+	// It is off by default because the registry grows state.
+	EnableRecordNameRegistry bool `protobuf:"varint,1,opt,name=enable_record_name_registry,json=enableRecordNameRegistry,proto3" json:"enable_record_name_registry,omitempty"`
+	// enable_modification_index is whether to record the block height of the last change to each scope, session, and
+	// record. When true, the recently changed metadata can be looked up using the ModifiedSince query.
+	// It is off by default because the index grows state.
+	EnableModificationIndex bool `protobuf:"varint,2,opt,name=enable_modification_index,json=enableModificationIndex,proto3" json:"enable_modification_index,omitempty"`
+	// modification_index_retention is the number of blocks that entries are kept in the modification index.
+	// Entries for objects that have not changed in this many blocks are pruned at the end of each block.
+	// Zero means entries are never pruned.
+	ModificationIndexRetention uint64 `protobuf:"varint,3,opt,name=modification_index_retention,json=modificationIndexRetention,proto3" json:"modification_index_retention,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -60,6 +72,27 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetEnableRecordNameRegistry() bool {
+	if m != nil {
+		return m.EnableRecordNameRegistry
+	}
+	return false
+}
+
+func (m *Params) GetEnableModificationIndex() bool {
+	if m != nil {
+		return m.EnableModificationIndex
+	}
+	return false
+}
+
+func (m *Params) GetModificationIndexRetention() uint64 {
+	if m != nil {
+		return m.ModificationIndexRetention
+	}
+	return 0
+}
+
 // ScopeIdInfo contains various info regarding a scope id.
 type ScopeIdInfo struct {
 	// scope_id is the raw bytes of the scope address.
@@ -561,53 +594,58 @@ func init() {
 }
 
 var fileDescriptor_786fb0ab3f663d79 = []byte{
-	// 728 bytes of a gzipped FileDescriptorProto
+	// 814 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x4e, 0xdb, 0x4a,
-	0x14, 0x87, 0xe3, 0xc0, 0x0d, 0xe4, 0xe4, 0x2f, 0x73, 0x03, 0xe4, 0xa2, 0x8b, 0x03, 0x41, 0xf7,
-	0x2a, 0x42, 0x25, 0x51, 0x28, 0xed, 0x82, 0xaa, 0xaa, 0xa0, 0x0b, 0x8a, 0xaa, 0x56, 0x28, 0xa8,
-	0x9b, 0x4a, 0x15, 0x32, 0xf6, 0x00, 0x56, 0x15, 0x8f, 0xe5, 0x71, 0x10, 0x7d, 0x0b, 0xd4, 0x27,
-	0xe8, 0x5b, 0x74, 0xd3, 0x07, 0x60, 0xc9, 0xaa, 0xaa, 0xba, 0x40, 0x15, 0x6c, 0xba, 0xe8, 0x43,
-	0x54, 0x1e, 0x8f, 0x3d, 0x67, 0x30, 0x48, 0x51, 0x77, 0x33, 0x67, 0x7e, 0xdf, 0x91, 0xe7, 0x8b,
-	0x8f, 0x01, 0xfe, 0xf3, 0x03, 0x76, 0x4a, 0x3d, 0xcb, 0xb3, 0x69, 0x6f, 0x48, 0x43, 0xcb, 0xb1,
-	0x42, 0xab, 0x77, 0xda, 0x4f, 0xd7, 0x5d, 0x3f, 0x60, 0x21, 0x23, 0x73, 0x2a, 0xd6, 0x4d, 0x8f,
-	0x4e, 0xfb, 0x0b, 0x8d, 0x63, 0x76, 0xcc, 0x44, 0xa4, 0x17, 0xad, 0xe2, 0x74, 0xbb, 0x0a, 0x85,
-	0x3d, 0x2b, 0xb0, 0x86, 0x7c, 0x73, 0xf2, 0xe7, 0xa7, 0x96, 0xd1, 0xfe, 0x6a, 0x40, 0x69, 0xdf,
-	0x66, 0x3e, 0xdd, 0x75, 0x76, 0xbd, 0x23, 0x46, 0xd6, 0x61, 0x9a, 0x47, 0xdb, 0x03, 0xd7, 0x69,
-	0x1a, 0x4b, 0x46, 0xa7, 0xbc, 0x3d, 0x7f, 0x71, 0xd5, 0xca, 0x7d, 0xbf, 0x6a, 0xd5, 0x5e, 0xc9,
-	0xe6, 0x5b, 0x8e, 0x13, 0x50, 0xce, 0x07, 0x53, 0x3c, 0xe6, 0xc8, 0xff, 0x50, 0x4b, 0x98, 0x03,
-	0x3f, 0xa0, 0x47, 0xee, 0x59, 0x33, 0x1f, 0xa1, 0x83, 0x8a, 0x4c, 0xec, 0x89, 0x22, 0x59, 0x83,
-	0xbf, 0xd3, 0x5c, 0xbc, 0x18, 0x8d, 0x5c, 0xa7, 0x39, 0x21, 0xb2, 0x75, 0x99, 0x15, 0x0f, 0xf3,
-	0x66, 0xe4, 0x3a, 0x64, 0x11, 0x20, 0x4e, 0x59, 0x8e, 0x13, 0x34, 0x27, 0x97, 0x8c, 0x4e, 0x71,
-	0x50, 0x14, 0x95, 0xe8, 0x09, 0xd4, 0xb1, 0x68, 0xf2, 0x17, 0x3a, 0x8e, 0xe8, 0xf6, 0xaf, 0x3c,
-	0x54, 0xf6, 0x29, 0xe7, 0x2e, 0xf3, 0xe4, 0xd5, 0x1e, 0x03, 0xf0, 0xb8, 0x30, 0xc6, 0xe5, 0x8a,
-	0x3c, 0x61, 0xc9, 0x2a, 0xcc, 0x28, 0x4e, 0xbf, 0x60, 0x2d, 0x4d, 0xc9, 0x2b, 0xf6, 0x61, 0x16,
-	0x65, 0x33, 0x97, 0x24, 0x69, 0x5e, 0x5d, 0xf3, 0x11, 0xcc, 0x63, 0x44, 0x2e, 0x05, 0x34, 0x29,
-	0xa0, 0x86, 0x82, 0xe2, 0x85, 0xc0, 0x96, 0xa1, 0x9c, 0x64, 0x85, 0x9f, 0x58, 0x40, 0x49, 0xd6,
-	0x84, 0x21, 0x14, 0x11, 0xed, 0x0a, 0x5a, 0x44, 0x74, 0xd9, 0x81, 0x4a, 0xfa, 0x93, 0xb8, 0xde,
-	0x11, 0x6b, 0x4e, 0x2d, 0x19, 0x9d, 0xd2, 0xfa, 0x4a, 0xf7, 0xee, 0x97, 0xaa, 0x8b, 0x5e, 0x95,
-	0x41, 0x89, 0xab, 0x4d, 0xfb, 0x4b, 0x1e, 0xca, 0x03, 0x6a, 0xb3, 0xc0, 0x91, 0xb6, 0x37, 0xa0,
-	0x18, 0x88, 0xfd, 0x18, 0xb2, 0xa7, 0x03, 0x49, 0x92, 0x0e, 0xd4, 0x53, 0x4a, 0x57, 0x5d, 0x4d,
-	0x32, 0xd2, 0x74, 0x0f, 0x1a, 0x2a, 0x99, 0x11, 0x3d, 0x93, 0xa4, 0x95, 0xe7, 0x3e, 0xcc, 0x2a,
-	0xe0, 0xc4, 0xe2, 0x27, 0xd4, 0x39, 0xf0, 0xac, 0x21, 0x95, 0x96, 0x49, 0x42, 0xbc, 0x10, 0x47,
-	0xaf, 0xad, 0x21, 0x25, 0x2d, 0x28, 0x49, 0x04, 0x29, 0x86, 0xb8, 0x24, 0x0c, 0x67, 0xf4, 0x15,
-	0xfe, 0x50, 0xdf, 0x79, 0x1e, 0x6a, 0xe2, 0x70, 0xdf, 0xa7, 0xb6, 0x34, 0xf8, 0x24, 0x69, 0xce,
-	0x7d, 0x6a, 0x8f, 0x61, 0x31, 0x6e, 0x18, 0x37, 0x88, 0xf4, 0x68, 0xb0, 0x2e, 0x73, 0x06, 0x45,
-	0xa5, 0xcf, 0x67, 0xb0, 0xa8, 0x03, 0x68, 0x87, 0xc4, 0x36, 0x11, 0x99, 0x3e, 0xb0, 0xf0, 0x9b,
-	0x7e, 0x05, 0x04, 0x82, 0x66, 0xb6, 0x92, 0x22, 0xc2, 0x99, 0x9e, 0x43, 0xc3, 0xab, 0x72, 0x62,
-	0x80, 0x3f, 0xe7, 0x81, 0x3c, 0x67, 0x5e, 0x18, 0x58, 0x76, 0x88, 0xac, 0x6c, 0x41, 0xdd, 0x96,
-	0xd5, 0x71, 0xc5, 0x54, 0x6d, 0xad, 0x4d, 0x34, 0x71, 0xb7, 0x5b, 0xe8, 0x7a, 0x1a, 0x3a, 0x20,
-	0x0d, 0xbd, 0x84, 0x95, 0x0c, 0xa6, 0x17, 0x90, 0x27, 0x53, 0x6f, 0x81, 0x2f, 0x22, 0x6c, 0x3d,
-	0x00, 0xa2, 0xb3, 0x48, 0x58, 0x1d, 0xb3, 0xc2, 0x59, 0x26, 0x8d, 0xb4, 0x69, 0x69, 0x61, 0xee,
-	0xe3, 0x04, 0xd4, 0xe3, 0x59, 0x44, 0xde, 0x9e, 0x82, 0x9c, 0xa0, 0x71, 0xad, 0x95, 0x03, 0xd4,
-	0x02, 0x4d, 0xcf, 0x9d, 0xc6, 0x08, 0x0e, 0x4b, 0x5f, 0x3b, 0xb0, 0x7c, 0x0b, 0xb9, 0xd7, 0xd6,
-	0xbf, 0x18, 0xcf, 0xb8, 0xda, 0x84, 0x85, 0x5b, 0x8d, 0xb2, 0xe3, 0x3b, 0x87, 0x3b, 0xa0, 0x11,
-	0x56, 0x1f, 0x14, 0x65, 0x39, 0xf6, 0x56, 0x55, 0x84, 0x70, 0xfc, 0x0e, 0x66, 0x33, 0x3f, 0x2f,
-	0x9a, 0xe9, 0xd5, 0xfb, 0x66, 0x3a, 0xfb, 0x8e, 0x0e, 0x88, 0x9d, 0xa9, 0x6d, 0xbf, 0xbf, 0xb8,
-	0x36, 0x8d, 0xcb, 0x6b, 0xd3, 0xf8, 0x71, 0x6d, 0x1a, 0xe7, 0x37, 0x66, 0xee, 0xf2, 0xc6, 0xcc,
-	0x7d, 0xbb, 0x31, 0x73, 0xf0, 0x8f, 0xcb, 0xee, 0xe9, 0xbd, 0x67, 0xbc, 0xdd, 0x38, 0x76, 0xc3,
-	0x93, 0xd1, 0x61, 0xd7, 0x66, 0xc3, 0x9e, 0x0a, 0xad, 0xb9, 0x0c, 0xed, 0x7a, 0x67, 0xea, 0xff,
-	0x83, 0xf0, 0x83, 0x4f, 0xf9, 0x61, 0x41, 0xfc, 0xb1, 0x7f, 0xf8, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0xea, 0x47, 0x7e, 0x34, 0x43, 0x08, 0x00, 0x00,
+	0x14, 0x87, 0xe3, 0x90, 0x1b, 0xc8, 0x49, 0x42, 0xc2, 0xdc, 0x00, 0x81, 0x0b, 0x49, 0x08, 0xba,
+	0x57, 0x11, 0xba, 0x24, 0x0a, 0xa5, 0x5d, 0x50, 0xa1, 0x16, 0xba, 0xa0, 0xa8, 0xa2, 0x42, 0x46,
+	0xdd, 0x54, 0xaa, 0x22, 0x63, 0x4f, 0x82, 0xd5, 0xc6, 0x13, 0x79, 0x1c, 0x04, 0x6f, 0x81, 0xfa,
+	0x04, 0x7d, 0x8b, 0x6e, 0xfa, 0x00, 0x48, 0xdd, 0xb0, 0xaa, 0xaa, 0x2e, 0x50, 0x05, 0x9b, 0x2e,
+	0xfa, 0x10, 0x95, 0x67, 0xc6, 0x9e, 0x71, 0x0c, 0x6a, 0xd4, 0xdd, 0xfc, 0xf9, 0x7d, 0x47, 0x33,
+	0x9f, 0x7d, 0x2c, 0xc3, 0xbf, 0x03, 0x97, 0x9c, 0x62, 0xc7, 0x70, 0x4c, 0xdc, 0xea, 0x63, 0xcf,
+	0xb0, 0x0c, 0xcf, 0x68, 0x9d, 0xb6, 0xc3, 0x71, 0x73, 0xe0, 0x12, 0x8f, 0xa0, 0x39, 0x19, 0x6b,
+	0x86, 0x5b, 0xa7, 0xed, 0xc5, 0x52, 0x8f, 0xf4, 0x08, 0x8b, 0xb4, 0xfc, 0x11, 0x4f, 0xd7, 0x3f,
+	0x6b, 0x90, 0x3e, 0x34, 0x5c, 0xa3, 0x4f, 0xd1, 0x36, 0xfc, 0x83, 0x1d, 0xe3, 0xf8, 0x1d, 0xee,
+	0xb8, 0xd8, 0x24, 0xae, 0xd5, 0x71, 0x8c, 0xbe, 0x3f, 0xee, 0xd9, 0xd4, 0x73, 0xcf, 0xcb, 0x5a,
+	0x4d, 0x6b, 0x4c, 0xe9, 0x65, 0x1e, 0xd1, 0x59, 0xe2, 0xa5, 0xd1, 0xc7, 0xba, 0xd8, 0x47, 0x5b,
+	0xb0, 0x20, 0xf0, 0x3e, 0xb1, 0xec, 0xae, 0x6d, 0x1a, 0x9e, 0x4d, 0x9c, 0x8e, 0xed, 0x58, 0xf8,
+	0xac, 0x9c, 0x64, 0xf0, 0x3c, 0x0f, 0x1c, 0x28, 0xfb, 0xfb, 0xfe, 0x36, 0x7a, 0x0a, 0x4b, 0x71,
+	0xa8, 0xe3, 0x62, 0x0f, 0x3b, 0xfe, 0xbc, 0x3c, 0x51, 0xd3, 0x1a, 0x29, 0x7d, 0xb1, 0x3f, 0x0a,
+	0xea, 0x41, 0x62, 0x2b, 0xf5, 0xe3, 0x43, 0x55, 0xab, 0x7f, 0xd1, 0x20, 0x7b, 0x64, 0x92, 0x01,
+	0xde, 0xb7, 0xf6, 0x9d, 0x2e, 0x41, 0x1b, 0x30, 0x45, 0xfd, 0x69, 0xc7, 0xb6, 0xd8, 0xf9, 0x73,
+	0xbb, 0xf3, 0x97, 0xd7, 0xd5, 0xc4, 0xb7, 0xeb, 0x6a, 0xe1, 0x40, 0xa8, 0xd9, 0xb1, 0x2c, 0x17,
+	0x53, 0xaa, 0x4f, 0x52, 0xce, 0xa1, 0xff, 0xa0, 0x10, 0x30, 0x9d, 0x81, 0x8b, 0xbb, 0x36, 0x3f,
+	0x7d, 0x4e, 0xcf, 0x8b, 0xc4, 0x21, 0x5b, 0x44, 0xeb, 0xf0, 0x77, 0x98, 0xe3, 0x83, 0xe1, 0xd0,
+	0xb6, 0xd8, 0x51, 0x73, 0x7a, 0x51, 0x64, 0xd9, 0x61, 0x5e, 0x0d, 0x6d, 0x0b, 0x2d, 0x03, 0xf0,
+	0x94, 0x61, 0x59, 0x6e, 0x39, 0x55, 0xd3, 0x1a, 0x19, 0x3d, 0xc3, 0x56, 0xfc, 0x13, 0xc8, 0x6d,
+	0x56, 0xe4, 0x2f, 0x65, 0xdb, 0xa7, 0xeb, 0x3f, 0x93, 0x90, 0x3f, 0xc2, 0x94, 0xfa, 0x17, 0xe7,
+	0x57, 0x7b, 0x04, 0x40, 0xf9, 0xc2, 0x18, 0x97, 0xcb, 0xd0, 0x80, 0x45, 0x6b, 0x30, 0x23, 0xb9,
+	0xe8, 0x05, 0x0b, 0x61, 0x4a, 0x5c, 0xb1, 0x0d, 0xb3, 0x4a, 0x36, 0x76, 0x49, 0x14, 0xe6, 0xe5,
+	0x35, 0x1f, 0xc2, 0xbc, 0x8a, 0x88, 0x21, 0x83, 0x52, 0x0c, 0x2a, 0x49, 0x88, 0x0f, 0x18, 0xb6,
+	0x02, 0xb9, 0x20, 0xcb, 0xfc, 0x70, 0x01, 0x59, 0xb1, 0xc6, 0x0c, 0x29, 0x11, 0x56, 0x2e, 0x1d,
+	0x89, 0xb0, 0x2a, 0x7b, 0x90, 0x0f, 0x1f, 0x89, 0xed, 0x74, 0x49, 0x79, 0xb2, 0xa6, 0x35, 0xb2,
+	0x1b, 0xab, 0xcd, 0xbb, 0x5b, 0xa2, 0xa9, 0xbc, 0x2a, 0x7a, 0x96, 0xca, 0x49, 0xfd, 0x53, 0x12,
+	0x72, 0xfc, 0x15, 0x17, 0xb6, 0x37, 0x21, 0x23, 0x9a, 0xe2, 0xf7, 0xb2, 0xa7, 0x5c, 0x41, 0xa2,
+	0x06, 0x14, 0x43, 0x2a, 0xaa, 0x7a, 0x3a, 0xc8, 0x08, 0xd3, 0x2d, 0x28, 0xc9, 0x64, 0x4c, 0xf4,
+	0x4c, 0x90, 0x96, 0x9e, 0xdb, 0x30, 0x2b, 0x81, 0x13, 0x83, 0x9e, 0x60, 0xde, 0xaf, 0xc2, 0x32,
+	0x0a, 0x88, 0xe7, 0x6c, 0xcb, 0x6f, 0x54, 0x54, 0x85, 0xac, 0x40, 0x14, 0xc5, 0xc0, 0x97, 0x98,
+	0xe1, 0x98, 0xbe, 0xf4, 0x1f, 0xea, 0xbb, 0x48, 0x42, 0x81, 0x6d, 0x1e, 0x0d, 0xb0, 0x29, 0x0c,
+	0x3e, 0x0e, 0x8a, 0xd3, 0x01, 0x36, 0xc7, 0xb0, 0x98, 0xa5, 0xb2, 0x80, 0xaf, 0x27, 0x02, 0x47,
+	0x65, 0xce, 0x28, 0x51, 0xe1, 0xf3, 0x09, 0x2c, 0x47, 0x01, 0x65, 0xa6, 0x88, 0x2d, 0x2b, 0x64,
+	0x78, 0x60, 0xe6, 0x37, 0xfc, 0x0a, 0x30, 0x44, 0xe9, 0xd9, 0x7c, 0x88, 0x30, 0x67, 0xd1, 0x9c,
+	0xd2, 0xbc, 0x79, 0xaa, 0xd6, 0xab, 0x7f, 0x4c, 0x02, 0x7a, 0x46, 0x1c, 0xcf, 0x35, 0x4c, 0x4f,
+	0xb1, 0xb2, 0x03, 0x45, 0x53, 0xac, 0x8e, 0x2b, 0x66, 0xda, 0x8c, 0x94, 0xf1, 0x3b, 0x6e, 0xb4,
+	0x44, 0x54, 0x4f, 0x29, 0x0a, 0x08, 0x43, 0x2f, 0x60, 0x35, 0x86, 0x45, 0x17, 0x14, 0x4f, 0x95,
+	0x68, 0x09, 0xf5, 0x22, 0xcc, 0xd6, 0xff, 0x80, 0xa2, 0xac, 0x22, 0xac, 0xa8, 0xb2, 0xcc, 0x59,
+	0x2c, 0xad, 0x68, 0x2b, 0x9a, 0x23, 0xb5, 0xeb, 0xef, 0x27, 0xa0, 0xc8, 0x7b, 0x51, 0xf1, 0xb6,
+	0x0d, 0xa2, 0x83, 0xc6, 0xb5, 0x96, 0x73, 0x95, 0x12, 0x4a, 0xf7, 0xdc, 0x69, 0x0c, 0xa9, 0x61,
+	0xe1, 0x6b, 0x0f, 0x56, 0x46, 0x90, 0x7b, 0x6d, 0x2d, 0xa9, 0x78, 0xcc, 0xd5, 0x16, 0x2c, 0x8e,
+	0x14, 0x8a, 0xb7, 0xef, 0x9c, 0x5a, 0x41, 0x69, 0x61, 0xf9, 0x41, 0x91, 0x96, 0xb9, 0xb7, 0x69,
+	0x49, 0x30, 0xc7, 0x6f, 0x60, 0x36, 0xf6, 0x78, 0x95, 0x9e, 0x5e, 0xbb, 0xaf, 0xa7, 0xe3, 0xef,
+	0xa8, 0x8e, 0xcc, 0xd8, 0xda, 0xee, 0xdb, 0xcb, 0x9b, 0x8a, 0x76, 0x75, 0x53, 0xd1, 0xbe, 0xdf,
+	0x54, 0xb4, 0x8b, 0xdb, 0x4a, 0xe2, 0xea, 0xb6, 0x92, 0xf8, 0x7a, 0x5b, 0x49, 0xc0, 0x82, 0x4d,
+	0xee, 0xa9, 0x7d, 0xa8, 0xbd, 0xde, 0xec, 0xd9, 0xde, 0xc9, 0xf0, 0xb8, 0x69, 0x92, 0x7e, 0x4b,
+	0x86, 0xd6, 0x6d, 0xa2, 0xcc, 0x5a, 0x67, 0xf2, 0xef, 0xc6, 0x3b, 0x1f, 0x60, 0x7a, 0x9c, 0x66,
+	0xbf, 0x2a, 0x0f, 0x7e, 0x0d, 0x00, 0x9c, 0x05, 0xd6, 0xa0, 0x01, 0x09, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -629,6 +667,15 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.EnableRecordNameRegistry != that1.EnableRecordNameRegistry {
+		return false
+	}
+	if this.EnableModificationIndex != that1.EnableModificationIndex {
+		return false
+	}
+	if this.ModificationIndexRetention != that1.ModificationIndexRetention {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -651,6 +698,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ModificationIndexRetention != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.ModificationIndexRetention))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EnableModificationIndex {
+		i--
+		if m.EnableModificationIndex {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EnableRecordNameRegistry {
+		i--
+		if m.EnableRecordNameRegistry {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1080,6 +1152,15 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.EnableRecordNameRegistry {
+		n += 2
+	}
+	if m.EnableModificationIndex {
+		n += 2
+	}
+	if m.ModificationIndexRetention != 0 {
+		n += 1 + sovMetadata(uint64(m.ModificationIndexRetention))
+	}
 	return n
 }
 
@@ -1296,6 +1377,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableRecordNameRegistry", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableRecordNameRegistry = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableModificationIndex", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableModificationIndex = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModificationIndexRetention", wireType)
+			}
+			m.ModificationIndexRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModificationIndexRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
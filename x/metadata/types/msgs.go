@@ -80,6 +80,8 @@ var AllRequestMsgs = []MetadataMsg{
 	(*MsgSetAccountDataRequest)(nil),
 
 	(*MsgAddNetAssetValuesRequest)(nil),
+
+	(*MsgForceBurnScopeCoinRequest)(nil),
 }
 
 // We still need these deprecated messages to be sdk.Msg for the codec.
@@ -960,6 +962,38 @@ func (msg MsgAddNetAssetValuesRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgForceBurnScopeCoinRequest  ------------------
+
+// NewMsgForceBurnScopeCoinRequest creates a new msg instance
+func NewMsgForceBurnScopeCoinRequest(authority string, scopeID MetadataAddress) *MsgForceBurnScopeCoinRequest {
+	return &MsgForceBurnScopeCoinRequest{
+		Authority: authority,
+		ScopeId:   scopeID.String(),
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgForceBurnScopeCoinRequest) GetSignerStrs() []string {
+	return []string{msg.Authority}
+}
+
+// ValidateBasic runs stateless validation on the msg. Implements MetadataMsg interface.
+func (msg MsgForceBurnScopeCoinRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+
+	scopeID, err := MetadataAddressFromBech32(msg.ScopeId)
+	if err != nil {
+		return fmt.Errorf("invalid metadata address %q: %w", msg.ScopeId, err)
+	}
+	if !scopeID.IsScopeAddress() {
+		return fmt.Errorf("metadata address is not scope address: %v", scopeID.String())
+	}
+
+	return nil
+}
+
 // ------------------  SessionIdComponents  ------------------
 
 func (msg *SessionIdComponents) GetSessionAddr() (MetadataAddress, error) {
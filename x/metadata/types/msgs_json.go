@@ -0,0 +1,228 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/google/uuid"
+)
+
+// The msgs with scope id fields allow those fields to be provided as scope uuids in json (e.g. in a gov proposal).
+// When unmarshaling, each uuid is converted to the bech32 string of its scope id before the standard unmarshaling is
+// done, so the resulting msg is identical to one provided with the bech32 scope ids.
+//
+// The standard unmarshaling is done using a type with the same fields as the msg, but without the UnmarshalJSONPB
+// method (so that it doesn't just call itself).
+
+var (
+	_ jsonpb.JSONPBUnmarshaler = (*MsgDeleteScopeRequest)(nil)
+	_ jsonpb.JSONPBUnmarshaler = (*MsgAddScopeDataAccessRequest)(nil)
+	_ jsonpb.JSONPBUnmarshaler = (*MsgDeleteScopeDataAccessRequest)(nil)
+	_ jsonpb.JSONPBUnmarshaler = (*MsgAddScopeOwnerRequest)(nil)
+	_ jsonpb.JSONPBUnmarshaler = (*MsgDeleteScopeOwnerRequest)(nil)
+	_ jsonpb.JSONPBUnmarshaler = (*MsgUpdateValueOwnersRequest)(nil)
+	_ jsonpb.JSONPBUnmarshaler = (*MsgSetAccountDataRequest)(nil)
+)
+
+// UnmarshalJSONPB unmarshals this msg from json, allowing the scope_id to be a scope uuid.
+func (msg *MsgDeleteScopeRequest) UnmarshalJSONPB(u *jsonpb.Unmarshaler, bz []byte) error {
+	return unmarshalJSONPBWithScopeUUIDs(u, bz, (*msgDeleteScopeRequestJSON)(msg), "scope_id")
+}
+
+// UnmarshalJSONPB unmarshals this msg from json, allowing the scope_id to be a scope uuid.
+func (msg *MsgAddScopeDataAccessRequest) UnmarshalJSONPB(u *jsonpb.Unmarshaler, bz []byte) error {
+	return unmarshalJSONPBWithScopeUUIDs(u, bz, (*msgAddScopeDataAccessRequestJSON)(msg), "scope_id")
+}
+
+// UnmarshalJSONPB unmarshals this msg from json, allowing the scope_id to be a scope uuid.
+func (msg *MsgDeleteScopeDataAccessRequest) UnmarshalJSONPB(u *jsonpb.Unmarshaler, bz []byte) error {
+	return unmarshalJSONPBWithScopeUUIDs(u, bz, (*msgDeleteScopeDataAccessRequestJSON)(msg), "scope_id")
+}
+
+// UnmarshalJSONPB unmarshals this msg from json, allowing the scope_id to be a scope uuid.
+func (msg *MsgAddScopeOwnerRequest) UnmarshalJSONPB(u *jsonpb.Unmarshaler, bz []byte) error {
+	return unmarshalJSONPBWithScopeUUIDs(u, bz, (*msgAddScopeOwnerRequestJSON)(msg), "scope_id")
+}
+
+// UnmarshalJSONPB unmarshals this msg from json, allowing the scope_id to be a scope uuid.
+func (msg *MsgDeleteScopeOwnerRequest) UnmarshalJSONPB(u *jsonpb.Unmarshaler, bz []byte) error {
+	return unmarshalJSONPBWithScopeUUIDs(u, bz, (*msgDeleteScopeOwnerRequestJSON)(msg), "scope_id")
+}
+
+// UnmarshalJSONPB unmarshals this msg from json, allowing the scope_ids to be scope uuids.
+// The scope_ids must either all be scope uuids or all be bech32 scope ids.
+func (msg *MsgUpdateValueOwnersRequest) UnmarshalJSONPB(u *jsonpb.Unmarshaler, bz []byte) error {
+	return unmarshalJSONPBWithScopeUUIDs(u, bz, (*msgUpdateValueOwnersRequestJSON)(msg), "scope_ids")
+}
+
+// UnmarshalJSONPB unmarshals this msg from json, allowing the metadata_addr to be a scope uuid.
+func (msg *MsgSetAccountDataRequest) UnmarshalJSONPB(u *jsonpb.Unmarshaler, bz []byte) error {
+	return unmarshalJSONPBWithScopeUUIDs(u, bz, (*msgSetAccountDataRequestJSON)(msg), "metadata_addr")
+}
+
+// unmarshalJSONPBWithScopeUUIDs converts the scope uuids in the provided fields of the json into bech32 scope ids,
+// then unmarshals the result into the provided target.
+// The fields are identified by their proto names. Their lowerCamelCase json names are also checked.
+func unmarshalJSONPBWithScopeUUIDs(u *jsonpb.Unmarshaler, bz []byte, target jsonpbTarget, fields ...string) error {
+	normalized, err := normalizeScopeUUIDsJSON(bz, fields...)
+	if err != nil {
+		return err
+	}
+	return u.Unmarshal(bytes.NewReader(normalized), target)
+}
+
+// normalizeScopeUUIDsJSON converts the scope uuids in the provided fields of the provided json object into
+// bech32 scope id strings. If nothing needs converting, the provided json is returned unchanged.
+// An error is returned if a field is provided using both its proto and json names,
+// or if a list field has both uuids and non-uuid strings.
+func normalizeScopeUUIDsJSON(bz []byte, fields ...string) ([]byte, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &obj); err != nil {
+		// Let the standard unmarshaling provide the error for this.
+		return bz, nil //nolint:nilerr // The error is intentionally left to the standard unmarshaling.
+	}
+
+	changed := false
+	for _, field := range fields {
+		name := field
+		val, found := obj[name]
+		if jsonName := lowerCamelCase(field); jsonName != field {
+			if jsonVal, jsonFound := obj[jsonName]; jsonFound {
+				if found {
+					return nil, fmt.Errorf("ambiguous %s: both %q and %q were provided", field, field, jsonName)
+				}
+				name, val, found = jsonName, jsonVal, true
+			}
+		}
+		if !found {
+			continue
+		}
+
+		newVal, err := normalizeScopeUUIDsJSONValue(val)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", field, err)
+		}
+		if newVal != nil {
+			obj[name] = newVal
+			changed = true
+		}
+	}
+
+	if !changed {
+		return bz, nil
+	}
+	return json.Marshal(obj)
+}
+
+// normalizeScopeUUIDsJSONValue converts the provided json value (a string or list of strings) from scope uuids
+// into bech32 scope ids. If there's nothing to convert, nil is returned.
+func normalizeScopeUUIDsJSONValue(val json.RawMessage) (json.RawMessage, error) {
+	var str string
+	if err := json.Unmarshal(val, &str); err == nil {
+		scopeID, isUUID := scopeIDFromUUIDString(str)
+		if !isUUID {
+			return nil, nil
+		}
+		return json.Marshal(scopeID.String())
+	}
+
+	var strs []string
+	if err := json.Unmarshal(val, &strs); err != nil {
+		// Let the standard unmarshaling provide the error for this.
+		return nil, nil //nolint:nilerr // The error is intentionally left to the standard unmarshaling.
+	}
+	uuids := 0
+	for i, s := range strs {
+		if scopeID, isUUID := scopeIDFromUUIDString(s); isUUID {
+			strs[i] = scopeID.String()
+			uuids++
+		}
+	}
+	if uuids == 0 {
+		return nil, nil
+	}
+	if uuids != len(strs) {
+		return nil, fmt.Errorf("cannot mix scope uuids and scope ids: %d of %d entries are uuids", uuids, len(strs))
+	}
+	return json.Marshal(strs)
+}
+
+// scopeIDFromUUIDString returns the scope id for the provided string, and true, if the string is a uuid.
+// If the string is not a uuid, nil, false is returned.
+func scopeIDFromUUIDString(str string) (MetadataAddress, bool) {
+	uid, err := uuid.Parse(strings.TrimSpace(str))
+	if err != nil {
+		return nil, false
+	}
+	return ScopeMetadataAddress(uid), true
+}
+
+// lowerCamelCase converts the provided snake_case proto field name into its lowerCamelCase json name.
+func lowerCamelCase(name string) string {
+	parts := strings.Split(name, "_")
+	for i := 1; i < len(parts); i++ {
+		if len(parts[i]) > 0 {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// jsonpbTarget is a proto message that is only used as the target of the standard jsonpb unmarshaling.
+type jsonpbTarget interface {
+	Reset()
+	String() string
+	ProtoMessage()
+}
+
+// These have the same fields as their msg counterparts, but don't have an UnmarshalJSONPB method.
+type (
+	msgDeleteScopeRequestJSON           MsgDeleteScopeRequest
+	msgAddScopeDataAccessRequestJSON    MsgAddScopeDataAccessRequest
+	msgDeleteScopeDataAccessRequestJSON MsgDeleteScopeDataAccessRequest
+	msgAddScopeOwnerRequestJSON         MsgAddScopeOwnerRequest
+	msgDeleteScopeOwnerRequestJSON      MsgDeleteScopeOwnerRequest
+	msgUpdateValueOwnersRequestJSON     MsgUpdateValueOwnersRequest
+	msgSetAccountDataRequestJSON        MsgSetAccountDataRequest
+)
+
+func (m *msgDeleteScopeRequestJSON) Reset()         { *m = msgDeleteScopeRequestJSON{} }
+func (m *msgDeleteScopeRequestJSON) String() string { return (*MsgDeleteScopeRequest)(m).String() }
+func (*msgDeleteScopeRequestJSON) ProtoMessage()    {}
+
+func (m *msgAddScopeDataAccessRequestJSON) Reset() { *m = msgAddScopeDataAccessRequestJSON{} }
+func (m *msgAddScopeDataAccessRequestJSON) String() string {
+	return (*MsgAddScopeDataAccessRequest)(m).String()
+}
+func (*msgAddScopeDataAccessRequestJSON) ProtoMessage() {}
+
+func (m *msgDeleteScopeDataAccessRequestJSON) Reset() { *m = msgDeleteScopeDataAccessRequestJSON{} }
+func (m *msgDeleteScopeDataAccessRequestJSON) String() string {
+	return (*MsgDeleteScopeDataAccessRequest)(m).String()
+}
+func (*msgDeleteScopeDataAccessRequestJSON) ProtoMessage() {}
+
+func (m *msgAddScopeOwnerRequestJSON) Reset()         { *m = msgAddScopeOwnerRequestJSON{} }
+func (m *msgAddScopeOwnerRequestJSON) String() string { return (*MsgAddScopeOwnerRequest)(m).String() }
+func (*msgAddScopeOwnerRequestJSON) ProtoMessage()    {}
+
+func (m *msgDeleteScopeOwnerRequestJSON) Reset() { *m = msgDeleteScopeOwnerRequestJSON{} }
+func (m *msgDeleteScopeOwnerRequestJSON) String() string {
+	return (*MsgDeleteScopeOwnerRequest)(m).String()
+}
+func (*msgDeleteScopeOwnerRequestJSON) ProtoMessage() {}
+
+func (m *msgUpdateValueOwnersRequestJSON) Reset() { *m = msgUpdateValueOwnersRequestJSON{} }
+func (m *msgUpdateValueOwnersRequestJSON) String() string {
+	return (*MsgUpdateValueOwnersRequest)(m).String()
+}
+func (*msgUpdateValueOwnersRequestJSON) ProtoMessage() {}
+
+func (m *msgSetAccountDataRequestJSON) Reset() { *m = msgSetAccountDataRequestJSON{} }
+func (m *msgSetAccountDataRequestJSON) String() string {
+	return (*MsgSetAccountDataRequest)(m).String()
+}
+func (*msgSetAccountDataRequestJSON) ProtoMessage() {}
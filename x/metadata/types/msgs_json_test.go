@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeScopeUUIDsJSON(t *testing.T) {
+	uuid1 := uuid.MustParse("D0FE5658-1A5A-4428-BBEC-7034476C990B")
+	scopeID1 := ScopeMetadataAddress(uuid1)
+
+	tests := []struct {
+		name   string
+		json   string
+		fields []string
+		exp    string
+		expErr string
+	}{
+		{name: "not an object", json: `["a"]`, fields: []string{"scope_id"}, exp: `["a"]`},
+		{name: "field not present", json: `{"other":"` + uuid1.String() + `"}`, fields: []string{"scope_id"}, exp: `{"other":"` + uuid1.String() + `"}`},
+		{name: "already a scope id", json: `{"scope_id":"` + scopeID1.String() + `"}`, fields: []string{"scope_id"}, exp: `{"scope_id":"` + scopeID1.String() + `"}`},
+		{name: "uuid", json: `{"scope_id":"` + uuid1.String() + `"}`, fields: []string{"scope_id"}, exp: `{"scope_id":"` + scopeID1.String() + `"}`},
+		{name: "uuid with spaces", json: `{"scope_id":" ` + uuid1.String() + ` "}`, fields: []string{"scope_id"}, exp: `{"scope_id":"` + scopeID1.String() + `"}`},
+		{name: "number", json: `{"scope_id":3}`, fields: []string{"scope_id"}, exp: `{"scope_id":3}`},
+		{name: "empty list", json: `{"scope_ids":[]}`, fields: []string{"scope_ids"}, exp: `{"scope_ids":[]}`},
+		{
+			name:   "list of uuids",
+			json:   `{"scope_ids":["` + uuid1.String() + `","` + uuid1.String() + `"]}`,
+			fields: []string{"scope_ids"},
+			exp:    `{"scope_ids":["` + scopeID1.String() + `","` + scopeID1.String() + `"]}`,
+		},
+		{
+			name:   "mixed list",
+			json:   `{"scope_ids":["` + scopeID1.String() + `","` + uuid1.String() + `","` + scopeID1.String() + `"]}`,
+			fields: []string{"scope_ids"},
+			expErr: "invalid scope_ids: cannot mix scope uuids and scope ids: 1 of 3 entries are uuids",
+		},
+		{
+			name:   "both names",
+			json:   `{"scope_id":"` + uuid1.String() + `","scopeId":"` + uuid1.String() + `"}`,
+			fields: []string{"scope_id"},
+			expErr: `ambiguous scope_id: both "scope_id" and "scopeId" were provided`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act []byte
+			var err error
+			testFunc := func() {
+				act, err = normalizeScopeUUIDsJSON([]byte(tc.json), tc.fields...)
+			}
+			require.NotPanics(t, testFunc, "normalizeScopeUUIDsJSON")
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "normalizeScopeUUIDsJSON error")
+				return
+			}
+			require.NoError(t, err, "normalizeScopeUUIDsJSON error")
+			assert.Equal(t, tc.exp, string(act), "normalizeScopeUUIDsJSON result")
+		})
+	}
+}
+
+func TestLowerCamelCase(t *testing.T) {
+	tests := []struct {
+		name string
+		exp  string
+	}{
+		{name: "scope_id", exp: "scopeId"},
+		{name: "scope_ids", exp: "scopeIds"},
+		{name: "metadata_addr", exp: "metadataAddr"},
+		{name: "value_owner_address", exp: "valueOwnerAddress"},
+		{name: "signers", exp: "signers"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%q", tc.name), func(t *testing.T) {
+			act := lowerCamelCase(tc.name)
+			assert.Equal(t, tc.exp, act, "lowerCamelCase(%q)", tc.name)
+		})
+	}
+}
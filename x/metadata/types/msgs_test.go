@@ -54,6 +54,7 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg {
 			return &MsgModifyOSLocatorRequest{Locator: ObjectStoreLocator{Owner: signer}}
 		},
+		func(signer string) sdk.Msg { return &MsgForceBurnScopeCoinRequest{Authority: signer} },
 	}
 
 	multiSignerMsgMakers := []testutil.MsgMakerMulti{
@@ -872,6 +873,139 @@ func TestMsgSetAccountDataRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgsUnmarshalJSONWithScopeUUIDs(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	uuid1 := uuid.MustParse("D0FE5658-1A5A-4428-BBEC-7034476C990B")
+	uuid2 := uuid.MustParse("C132796F-B2C1-4804-A77E-DD34F46E22F4")
+	scopeID1 := ScopeMetadataAddress(uuid1)
+	scopeID2 := ScopeMetadataAddress(uuid2)
+
+	tests := []struct {
+		name    string
+		json    string
+		expMsg  sdk.Msg
+		expErr  string
+		jsonAlt string // If provided, this json is also unmarshaled and must result in the same msg.
+	}{
+		{
+			name:    "delete scope: uuid",
+			json:    `{"@type":"/provenance.metadata.v1.MsgDeleteScopeRequest","scope_id":"` + uuid1.String() + `","signers":["` + addr + `"]}`,
+			jsonAlt: `{"@type":"/provenance.metadata.v1.MsgDeleteScopeRequest","scope_id":"` + scopeID1.String() + `","signers":["` + addr + `"]}`,
+			expMsg:  &MsgDeleteScopeRequest{ScopeId: scopeID1, Signers: []string{addr}},
+		},
+		{
+			name:    "delete scope: uuid with json name",
+			json:    `{"@type":"/provenance.metadata.v1.MsgDeleteScopeRequest","scopeId":"` + uuid1.String() + `","signers":["` + addr + `"]}`,
+			jsonAlt: `{"@type":"/provenance.metadata.v1.MsgDeleteScopeRequest","scopeId":"` + scopeID1.String() + `","signers":["` + addr + `"]}`,
+			expMsg:  &MsgDeleteScopeRequest{ScopeId: scopeID1, Signers: []string{addr}},
+		},
+		{
+			name:   "delete scope: both names",
+			json:   `{"@type":"/provenance.metadata.v1.MsgDeleteScopeRequest","scope_id":"` + uuid1.String() + `","scopeId":"` + scopeID1.String() + `","signers":["` + addr + `"]}`,
+			expErr: `ambiguous scope_id: both "scope_id" and "scopeId" were provided`,
+		},
+		{
+			name:   "delete scope: neither uuid nor bech32",
+			json:   `{"@type":"/provenance.metadata.v1.MsgDeleteScopeRequest","scope_id":"not-a-scope","signers":["` + addr + `"]}`,
+			expErr: `could not unmarshal metadata address "not-a-scope"`,
+		},
+		{
+			name:    "add scope data access: uuid",
+			json:    `{"@type":"/provenance.metadata.v1.MsgAddScopeDataAccessRequest","scope_id":"` + uuid2.String() + `","data_access":["` + addr + `"],"signers":["` + addr + `"]}`,
+			jsonAlt: `{"@type":"/provenance.metadata.v1.MsgAddScopeDataAccessRequest","scope_id":"` + scopeID2.String() + `","data_access":["` + addr + `"],"signers":["` + addr + `"]}`,
+			expMsg:  &MsgAddScopeDataAccessRequest{ScopeId: scopeID2, DataAccess: []string{addr}, Signers: []string{addr}},
+		},
+		{
+			name:    "delete scope data access: uuid",
+			json:    `{"@type":"/provenance.metadata.v1.MsgDeleteScopeDataAccessRequest","scope_id":"` + uuid2.String() + `","data_access":["` + addr + `"],"signers":["` + addr + `"]}`,
+			jsonAlt: `{"@type":"/provenance.metadata.v1.MsgDeleteScopeDataAccessRequest","scope_id":"` + scopeID2.String() + `","data_access":["` + addr + `"],"signers":["` + addr + `"]}`,
+			expMsg:  &MsgDeleteScopeDataAccessRequest{ScopeId: scopeID2, DataAccess: []string{addr}, Signers: []string{addr}},
+		},
+		{
+			name:    "add scope owner: uuid",
+			json:    `{"@type":"/provenance.metadata.v1.MsgAddScopeOwnerRequest","scope_id":"` + uuid1.String() + `","owners":[{"address":"` + addr + `","role":"PARTY_TYPE_OWNER"}],"signers":["` + addr + `"]}`,
+			jsonAlt: `{"@type":"/provenance.metadata.v1.MsgAddScopeOwnerRequest","scope_id":"` + scopeID1.String() + `","owners":[{"address":"` + addr + `","role":"PARTY_TYPE_OWNER"}],"signers":["` + addr + `"]}`,
+			expMsg: &MsgAddScopeOwnerRequest{
+				ScopeId: scopeID1,
+				Owners:  []Party{{Address: addr, Role: PartyType_PARTY_TYPE_OWNER}},
+				Signers: []string{addr},
+			},
+		},
+		{
+			name:    "delete scope owner: uuid",
+			json:    `{"@type":"/provenance.metadata.v1.MsgDeleteScopeOwnerRequest","scope_id":"` + uuid1.String() + `","owners":["` + addr + `"],"signers":["` + addr + `"]}`,
+			jsonAlt: `{"@type":"/provenance.metadata.v1.MsgDeleteScopeOwnerRequest","scope_id":"` + scopeID1.String() + `","owners":["` + addr + `"],"signers":["` + addr + `"]}`,
+			expMsg:  &MsgDeleteScopeOwnerRequest{ScopeId: scopeID1, Owners: []string{addr}, Signers: []string{addr}},
+		},
+		{
+			name:    "update value owners: uuids",
+			json:    `{"@type":"/provenance.metadata.v1.MsgUpdateValueOwnersRequest","scope_ids":["` + uuid1.String() + `","` + uuid2.String() + `"],"value_owner_address":"` + addr + `","signers":["` + addr + `"]}`,
+			jsonAlt: `{"@type":"/provenance.metadata.v1.MsgUpdateValueOwnersRequest","scope_ids":["` + scopeID1.String() + `","` + scopeID2.String() + `"],"value_owner_address":"` + addr + `","signers":["` + addr + `"]}`,
+			expMsg: &MsgUpdateValueOwnersRequest{
+				ScopeIds:          []MetadataAddress{scopeID1, scopeID2},
+				ValueOwnerAddress: addr,
+				Signers:           []string{addr},
+			},
+		},
+		{
+			name:    "update value owners: uuids with json name",
+			json:    `{"@type":"/provenance.metadata.v1.MsgUpdateValueOwnersRequest","scopeIds":["` + uuid1.String() + `","` + uuid2.String() + `"],"valueOwnerAddress":"` + addr + `","signers":["` + addr + `"]}`,
+			jsonAlt: `{"@type":"/provenance.metadata.v1.MsgUpdateValueOwnersRequest","scopeIds":["` + scopeID1.String() + `","` + scopeID2.String() + `"],"valueOwnerAddress":"` + addr + `","signers":["` + addr + `"]}`,
+			expMsg: &MsgUpdateValueOwnersRequest{
+				ScopeIds:          []MetadataAddress{scopeID1, scopeID2},
+				ValueOwnerAddress: addr,
+				Signers:           []string{addr},
+			},
+		},
+		{
+			name:   "update value owners: uuid and scope id",
+			json:   `{"@type":"/provenance.metadata.v1.MsgUpdateValueOwnersRequest","scope_ids":["` + uuid1.String() + `","` + scopeID2.String() + `"],"value_owner_address":"` + addr + `","signers":["` + addr + `"]}`,
+			expErr: "invalid scope_ids: cannot mix scope uuids and scope ids: 1 of 2 entries are uuids",
+		},
+		{
+			name:   "update value owners: both names",
+			json:   `{"@type":"/provenance.metadata.v1.MsgUpdateValueOwnersRequest","scope_ids":["` + uuid1.String() + `"],"scopeIds":["` + uuid2.String() + `"],"value_owner_address":"` + addr + `","signers":["` + addr + `"]}`,
+			expErr: `ambiguous scope_ids: both "scope_ids" and "scopeIds" were provided`,
+		},
+		{
+			name:    "set account data: uuid",
+			json:    `{"@type":"/provenance.metadata.v1.MsgSetAccountDataRequest","metadata_addr":"` + uuid2.String() + `","value":"some value","signers":["` + addr + `"]}`,
+			jsonAlt: `{"@type":"/provenance.metadata.v1.MsgSetAccountDataRequest","metadata_addr":"` + scopeID2.String() + `","value":"some value","signers":["` + addr + `"]}`,
+			expMsg:  &MsgSetAccountDataRequest{MetadataAddr: scopeID2, Value: "some value", Signers: []string{addr}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cdc := GetCdc(t)
+
+			var msg sdk.Msg
+			err := cdc.UnmarshalInterfaceJSON([]byte(tc.json), &msg)
+			if len(tc.expErr) > 0 {
+				assert.ErrorContains(t, err, tc.expErr, "UnmarshalInterfaceJSON error")
+				return
+			}
+			require.NoError(t, err, "UnmarshalInterfaceJSON error")
+			assert.Equal(t, tc.expMsg, msg, "UnmarshalInterfaceJSON result")
+
+			if len(tc.jsonAlt) > 0 {
+				var msgAlt sdk.Msg
+				err = cdc.UnmarshalInterfaceJSON([]byte(tc.jsonAlt), &msgAlt)
+				require.NoError(t, err, "UnmarshalInterfaceJSON error for the alternate json")
+				assert.Equal(t, msg, msgAlt, "result of the alternate json")
+			}
+
+			// Make sure the result can be marshaled and unmarshaled again, ending up the same.
+			bz, err := cdc.MarshalInterfaceJSON(msg)
+			require.NoError(t, err, "MarshalInterfaceJSON")
+			var msgRT sdk.Msg
+			err = cdc.UnmarshalInterfaceJSON(bz, &msgRT)
+			require.NoError(t, err, "UnmarshalInterfaceJSON error for round trip json: %s", string(bz))
+			assert.Equal(t, msg, msgRT, "round trip result")
+		})
+	}
+}
+
 // TestPrintMessageTypeStrings just prints out all the MsgTypeURLs.
 // The output can be copy/pasted into the const area in msgs.go
 func TestPrintMessageTypeStrings(t *testing.T) {
@@ -989,3 +1123,51 @@ func TestMsgAddNetAssetValueValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgForceBurnScopeCoinValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+	scopeID := "scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel"
+	sessionID := "session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr"
+
+	tests := []struct {
+		name   string
+		msg    MsgForceBurnScopeCoinRequest
+		expErr string
+	}{
+		{
+			name: "should succeed",
+			msg:  MsgForceBurnScopeCoinRequest{Authority: authority, ScopeId: scopeID},
+		},
+		{
+			name:   "no authority",
+			msg:    MsgForceBurnScopeCoinRequest{Authority: "", ScopeId: scopeID},
+			expErr: "invalid authority: empty address string is not allowed",
+		},
+		{
+			name:   "invalid authority",
+			msg:    MsgForceBurnScopeCoinRequest{Authority: "invalid", ScopeId: scopeID},
+			expErr: "invalid authority: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			name:   "no scope id",
+			msg:    MsgForceBurnScopeCoinRequest{Authority: authority, ScopeId: ""},
+			expErr: `invalid metadata address "": empty address string is not allowed`,
+		},
+		{
+			name:   "not scope meta address",
+			msg:    MsgForceBurnScopeCoinRequest{Authority: authority, ScopeId: sessionID},
+			expErr: "metadata address is not scope address: " + sessionID,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
@@ -1,11 +1,15 @@
 package types
 
 // NewParams creates a new parameter object
-func NewParams() Params {
-	return Params{}
+func NewParams(enableRecordNameRegistry, enableModificationIndex bool, modificationIndexRetention uint64) Params {
+	return Params{
+		EnableRecordNameRegistry:   enableRecordNameRegistry,
+		EnableModificationIndex:    enableModificationIndex,
+		ModificationIndexRetention: modificationIndexRetention,
+	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(false, false, 0)
 }
@@ -1,5 +1,10 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+)
+
 // -------------- ScopeWrapper --------------
 
 // WrapScope wraps a scope in a ScopeWrapper and populates the _addr and _uuid fields.
@@ -136,3 +141,39 @@ func WrapRecordSpecNotFound(ma MetadataAddress) *RecordSpecificationWrapper {
 		RecordSpecIdInfo: GetRecordSpecIDInfo(ma),
 	}
 }
+
+// -------------- ScopeAccessKind --------------
+
+// Validate returns an error if this is not one of the defined scope access kinds (other than unspecified).
+func (x ScopeAccessKind) Validate() error {
+	if _, known := ScopeAccessKind_name[int32(x)]; known && x != ScopeAccessKind_Unspecified {
+		return nil
+	}
+	return fmt.Errorf("invalid access kind %s: must be one of %s", x, strings.Join(ValidScopeAccessKinds(), ", "))
+}
+
+// SimpleString returns this access kind's name without the SCOPE_ACCESS_KIND_ prefix, e.g. "DATA_ACCESS".
+func (x ScopeAccessKind) SimpleString() string {
+	return strings.TrimPrefix(x.String(), "SCOPE_ACCESS_KIND_")
+}
+
+// ValidScopeAccessKinds returns the names of all the valid scope access kinds, in order.
+func ValidScopeAccessKinds() []string {
+	rv := make([]string, 0, len(ScopeAccessKind_name)-1)
+	for i := int32(1); i < int32(len(ScopeAccessKind_name)); i++ {
+		rv = append(rv, ScopeAccessKind_name[i])
+	}
+	return rv
+}
+
+// -------------- RecordLineage --------------
+
+const (
+	// DefaultRecordLineageDepth is the depth used by the RecordLineage query when none is provided.
+	DefaultRecordLineageDepth = 3
+	// MaxRecordLineageDepth is the largest depth allowed in the RecordLineage query.
+	MaxRecordLineageDepth = 10
+	// MaxRecordLineageRecords is the most records that the RecordLineage query will load.
+	// Once reached, any remaining inputs are not resolved and their records are flagged as truncated.
+	MaxRecordLineageRecords = 500
+)
@@ -651,3 +651,36 @@ func TestWrapRecordSpecNotFound(t *testing.T) {
 		})
 	}
 }
+
+func TestScopeAccessKindValidate(t *testing.T) {
+	validOpts := "SCOPE_ACCESS_KIND_OWNER, SCOPE_ACCESS_KIND_DATA_ACCESS, SCOPE_ACCESS_KIND_VALUE_OWNER"
+	tests := []struct {
+		kind   ScopeAccessKind
+		expErr string
+	}{
+		{kind: ScopeAccessKind_Unspecified, expErr: "invalid access kind SCOPE_ACCESS_KIND_UNSPECIFIED: must be one of " + validOpts},
+		{kind: ScopeAccessKind_Owner},
+		{kind: ScopeAccessKind_DataAccess},
+		{kind: ScopeAccessKind_ValueOwner},
+		{kind: 4, expErr: "invalid access kind 4: must be one of " + validOpts},
+		{kind: -1, expErr: "invalid access kind -1: must be one of " + validOpts},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.kind.String(), func(t *testing.T) {
+			err := tc.kind.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate()")
+			} else {
+				assert.NoError(t, err, "Validate()")
+			}
+		})
+	}
+}
+
+func TestScopeAccessKindSimpleString(t *testing.T) {
+	assert.Equal(t, "UNSPECIFIED", ScopeAccessKind_Unspecified.SimpleString(), "Unspecified")
+	assert.Equal(t, "OWNER", ScopeAccessKind_Owner.SimpleString(), "Owner")
+	assert.Equal(t, "DATA_ACCESS", ScopeAccessKind_DataAccess.SimpleString(), "DataAccess")
+	assert.Equal(t, "VALUE_OWNER", ScopeAccessKind_ValueOwner.SimpleString(), "ValueOwner")
+}
@@ -89,7 +89,7 @@ func (s *ScopeTestSuite) TestScopeValidateBasic() {
 		{
 			name:   "invalid scope id",
 			scope:  ns(MetadataAddress{0xa0, 0x1, 0x2}, ScopeSpecMetadataAddress(uuid.New()), []Party{}, []string{}, ""),
-			expErr: "invalid scope metadata address MetadataAddress{0xa0, 0x1, 0x2}: invalid metadata address type: 160",
+			expErr: "invalid scope metadata address invalid[prefix=0xa0 len=3 hex=a00102]: invalid metadata address type: 160",
 		},
 		{
 			name:   "invalid scope id - wrong address type",
@@ -104,7 +104,7 @@ func (s *ScopeTestSuite) TestScopeValidateBasic() {
 		{
 			name:   "invalid spec id",
 			scope:  ns(ScopeMetadataAddress(uuid.New()), MetadataAddress{0xa0, 0x1, 0x2}, []Party{}, []string{}, ""),
-			expErr: "invalid scope specification metadata address MetadataAddress{0xa0, 0x1, 0x2}: invalid metadata address type: 160",
+			expErr: "invalid scope specification metadata address invalid[prefix=0xa0 len=3 hex=a00102]: invalid metadata address type: 160",
 		},
 		{
 			name:   "invalid spec id - wrong address type",
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -2332,6 +2333,98 @@ func (m *MsgAddNetAssetValuesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddNetAssetValuesResponse proto.InternalMessageInfo
 
+// MsgForceBurnScopeCoinRequest defines the Msg/ForceBurnScopeCoin request type
+type MsgForceBurnScopeCoinRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// scope_id is the bech32 id of the scope whose coin should be burned.
+	ScopeId string `protobuf:"bytes,2,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+}
+
+func (m *MsgForceBurnScopeCoinRequest) Reset()         { *m = MsgForceBurnScopeCoinRequest{} }
+func (m *MsgForceBurnScopeCoinRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgForceBurnScopeCoinRequest) ProtoMessage()    {}
+func (*MsgForceBurnScopeCoinRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{53}
+}
+func (m *MsgForceBurnScopeCoinRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceBurnScopeCoinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceBurnScopeCoinRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceBurnScopeCoinRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceBurnScopeCoinRequest.Merge(m, src)
+}
+func (m *MsgForceBurnScopeCoinRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceBurnScopeCoinRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceBurnScopeCoinRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceBurnScopeCoinRequest proto.InternalMessageInfo
+
+func (m *MsgForceBurnScopeCoinRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgForceBurnScopeCoinRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
+	}
+	return ""
+}
+
+// MsgForceBurnScopeCoinResponse defines the Msg/ForceBurnScopeCoin response type
+type MsgForceBurnScopeCoinResponse struct {
+}
+
+func (m *MsgForceBurnScopeCoinResponse) Reset()         { *m = MsgForceBurnScopeCoinResponse{} }
+func (m *MsgForceBurnScopeCoinResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceBurnScopeCoinResponse) ProtoMessage()    {}
+func (*MsgForceBurnScopeCoinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{54}
+}
+func (m *MsgForceBurnScopeCoinResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceBurnScopeCoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceBurnScopeCoinResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceBurnScopeCoinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceBurnScopeCoinResponse.Merge(m, src)
+}
+func (m *MsgForceBurnScopeCoinResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceBurnScopeCoinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceBurnScopeCoinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceBurnScopeCoinResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgWriteScopeRequest)(nil), "provenance.metadata.v1.MsgWriteScopeRequest")
 	proto.RegisterType((*MsgWriteScopeResponse)(nil), "provenance.metadata.v1.MsgWriteScopeResponse")
@@ -2386,146 +2479,153 @@ func init() {
 	proto.RegisterType((*MsgP8EMemorializeContractResponse)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractResponse")
 	proto.RegisterType((*MsgAddNetAssetValuesRequest)(nil), "provenance.metadata.v1.MsgAddNetAssetValuesRequest")
 	proto.RegisterType((*MsgAddNetAssetValuesResponse)(nil), "provenance.metadata.v1.MsgAddNetAssetValuesResponse")
+	proto.RegisterType((*MsgForceBurnScopeCoinRequest)(nil), "provenance.metadata.v1.MsgForceBurnScopeCoinRequest")
+	proto.RegisterType((*MsgForceBurnScopeCoinResponse)(nil), "provenance.metadata.v1.MsgForceBurnScopeCoinResponse")
 }
 
 func init() { proto.RegisterFile("provenance/metadata/v1/tx.proto", fileDescriptor_3a3a0892f91e3036) }
 
 var fileDescriptor_3a3a0892f91e3036 = []byte{
-	// 2139 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5f, 0x6c, 0x1c, 0x47,
-	0x19, 0xf7, 0xda, 0x89, 0xed, 0xfb, 0x6c, 0xc7, 0xce, 0xc4, 0x89, 0xcf, 0x1b, 0x72, 0xe7, 0x5e,
-	0x93, 0xd6, 0xb8, 0xc9, 0x1d, 0x71, 0x8d, 0x70, 0xf3, 0x07, 0xb0, 0x1b, 0x41, 0x5d, 0xf5, 0x48,
-	0x74, 0xd7, 0x34, 0x2a, 0x12, 0x3a, 0x36, 0xbb, 0xe3, 0xcb, 0x52, 0xdf, 0xce, 0xb1, 0xb3, 0xe7,
-	0x26, 0x8d, 0x88, 0x00, 0x89, 0x3f, 0xe2, 0x01, 0x15, 0x21, 0x55, 0x54, 0x20, 0x54, 0x09, 0x09,
-	0xf1, 0x58, 0x89, 0x37, 0x5e, 0x78, 0xcd, 0x13, 0xaa, 0xc4, 0x0b, 0x2a, 0x52, 0x85, 0x92, 0x87,
-	0xf2, 0xc8, 0x33, 0x0f, 0x80, 0x76, 0x76, 0xf6, 0xcf, 0xdc, 0xed, 0xcc, 0xee, 0x9d, 0x4b, 0x5c,
-	0xa9, 0x0f, 0x96, 0x3c, 0xb3, 0xdf, 0xbf, 0xdf, 0x37, 0xdf, 0x7c, 0xf3, 0xcd, 0x37, 0x07, 0xe5,
-	0xae, 0x4b, 0xf6, 0xb1, 0x63, 0x38, 0x26, 0xae, 0x75, 0xb0, 0x67, 0x58, 0x86, 0x67, 0xd4, 0xf6,
-	0x2f, 0xd6, 0xbc, 0xbb, 0xd5, 0xae, 0x4b, 0x3c, 0x82, 0x4e, 0xc5, 0x04, 0xd5, 0x90, 0xa0, 0xba,
-	0x7f, 0x51, 0x5f, 0x32, 0x09, 0xed, 0x10, 0x5a, 0xeb, 0xd0, 0xb6, 0x4f, 0xdf, 0xa1, 0xed, 0x80,
-	0x41, 0x5f, 0x6c, 0x93, 0x36, 0x61, 0xff, 0xd6, 0xfc, 0xff, 0xf8, 0xec, 0x39, 0x89, 0x9e, 0x48,
-	0x64, 0x40, 0xb6, 0x2a, 0x21, 0x23, 0xb7, 0xbf, 0x83, 0x4d, 0x8f, 0x7a, 0xc4, 0xc5, 0x9c, 0xf2,
-	0xac, 0x84, 0xb2, 0xbb, 0x89, 0xfd, 0x3f, 0x4e, 0x55, 0x91, 0x50, 0x51, 0x93, 0x74, 0x43, 0x9a,
-	0x35, 0x19, 0x4d, 0x17, 0x9b, 0xf6, 0xae, 0x6d, 0x1a, 0x9e, 0x4d, 0x9c, 0x80, 0xb6, 0xf2, 0xa1,
-	0x06, 0x8b, 0x75, 0xda, 0xbe, 0xe5, 0xda, 0x1e, 0x6e, 0xfa, 0x32, 0x1a, 0xf8, 0xbb, 0x3d, 0x4c,
-	0x3d, 0xf4, 0x02, 0x1c, 0x65, 0x32, 0x8b, 0xda, 0x8a, 0xb6, 0x3a, 0xb3, 0x7e, 0xa6, 0x9a, 0xee,
-	0xb6, 0x2a, 0x63, 0xda, 0x3e, 0xf2, 0xf0, 0xa3, 0xf2, 0x58, 0x23, 0xe0, 0x40, 0x45, 0x98, 0xa2,
-	0x76, 0xdb, 0xc1, 0x2e, 0x2d, 0x8e, 0xaf, 0x4c, 0xac, 0x16, 0x1a, 0xe1, 0x10, 0x9d, 0x01, 0x60,
-	0x24, 0xad, 0x5e, 0xcf, 0xb6, 0x8a, 0x13, 0x2b, 0xda, 0x6a, 0xa1, 0x51, 0x60, 0x33, 0x37, 0x7b,
-	0xb6, 0x85, 0x4e, 0x43, 0xc1, 0xb7, 0x31, 0xf8, 0x7a, 0x84, 0x7d, 0x9d, 0xf6, 0x27, 0xc2, 0x8f,
-	0x3d, 0x6a, 0xb5, 0x3a, 0xf6, 0xde, 0x1e, 0x2d, 0x1e, 0x5d, 0xd1, 0x56, 0x8f, 0x34, 0xa6, 0x7b,
-	0xd4, 0xaa, 0xfb, 0xe3, 0x4b, 0x8b, 0x3f, 0x7d, 0xaf, 0x3c, 0xf6, 0xcf, 0xf7, 0xca, 0x63, 0x3f,
-	0xfc, 0xf8, 0xfd, 0xb5, 0x50, 0x5d, 0xe5, 0xdb, 0x70, 0xb2, 0x0f, 0x1b, 0xed, 0x12, 0x87, 0x62,
-	0xf4, 0x75, 0x98, 0x0b, 0xec, 0xb0, 0xad, 0x96, 0xed, 0xec, 0x12, 0x0e, 0xf2, 0x69, 0x25, 0xc8,
-	0x1d, 0x6b, 0xc7, 0xd9, 0x25, 0x8d, 0x19, 0x1a, 0x0f, 0x2a, 0xf7, 0x99, 0x86, 0x6b, 0x78, 0x0f,
-	0xf7, 0xb9, 0x6f, 0x1d, 0xa6, 0x43, 0x0d, 0x4c, 0xf8, 0xec, 0xf6, 0x92, 0xef, 0xa2, 0x0f, 0x3f,
-	0x2a, 0xcf, 0xd7, 0xb9, 0xe0, 0x2d, 0xcb, 0x72, 0x31, 0xa5, 0x8d, 0x29, 0x2e, 0x50, 0xee, 0x37,
-	0x09, 0xbc, 0x22, 0x9c, 0xea, 0x57, 0x1e, 0xe0, 0xab, 0xfc, 0x4e, 0x83, 0xcf, 0xd5, 0x69, 0x7b,
-	0xcb, 0xb2, 0xd8, 0xfc, 0x35, 0x5f, 0x9b, 0x69, 0xfa, 0xca, 0x0e, 0x60, 0x5e, 0x19, 0x66, 0xfc,
-	0xf9, 0x96, 0xc1, 0x24, 0x71, 0x13, 0xc1, 0x8a, 0x64, 0x27, 0xed, 0x9f, 0xc8, 0x63, 0x7f, 0x19,
-	0xce, 0x48, 0x8c, 0xe4, 0x30, 0x7e, 0xaf, 0x41, 0x59, 0x44, 0xf8, 0x29, 0x45, 0x52, 0x81, 0x15,
-	0xb9, 0x9d, 0x1c, 0xcc, 0x9f, 0x34, 0x58, 0x4a, 0xc0, 0xbd, 0xfe, 0xa6, 0x83, 0xdd, 0x83, 0x80,
-	0xb8, 0x0c, 0x93, 0xe4, 0xcd, 0x28, 0x58, 0x14, 0x3b, 0xf4, 0x86, 0xe1, 0x7a, 0xf7, 0xf8, 0x0e,
-	0xe5, 0x2c, 0x43, 0x03, 0xd4, 0xa1, 0x38, 0x68, 0x3b, 0x07, 0xf6, 0x2b, 0x0d, 0x74, 0x11, 0xfd,
-	0x81, 0xb1, 0x9d, 0x12, 0xb0, 0x15, 0x46, 0x36, 0xfb, 0x0c, 0x9c, 0x4e, 0xb5, 0x8c, 0x5b, 0xfe,
-	0x47, 0x8d, 0x7d, 0xbf, 0xd9, 0xb5, 0x0c, 0x0f, 0xbf, 0x66, 0xec, 0xf5, 0x82, 0xef, 0x51, 0x6c,
-	0x6d, 0x40, 0x21, 0x34, 0x9d, 0x16, 0xb5, 0x95, 0x09, 0x95, 0xed, 0xd3, 0xdc, 0x76, 0x8a, 0xaa,
-	0x70, 0x62, 0xdf, 0x97, 0xd5, 0x62, 0x46, 0xb7, 0x8c, 0x80, 0xa0, 0x38, 0xce, 0xf2, 0xd9, 0xf1,
-	0xfd, 0x48, 0x0d, 0xe7, 0x1c, 0x1a, 0x54, 0x89, 0xed, 0xed, 0x14, 0xa3, 0x39, 0xaa, 0x1f, 0x05,
-	0xa8, 0xea, 0x76, 0xdb, 0x15, 0x28, 0x42, 0x54, 0x3a, 0x4c, 0xe3, 0xbb, 0x36, 0xf5, 0x6c, 0xa7,
-	0xcd, 0x16, 0xa4, 0xd0, 0x88, 0xc6, 0xfe, 0xb7, 0xae, 0x4b, 0xba, 0x84, 0x62, 0x8b, 0x1b, 0x1c,
-	0x8d, 0x47, 0xb4, 0x33, 0xc5, 0x0c, 0x6e, 0xe7, 0x4f, 0xc6, 0x59, 0xfe, 0x0a, 0xd2, 0x33, 0xa6,
-	0xd4, 0x26, 0x4e, 0x68, 0xe2, 0x57, 0x60, 0x8a, 0x06, 0x33, 0x3c, 0x33, 0x97, 0xa5, 0x99, 0x39,
-	0x20, 0xe3, 0xe1, 0x1d, 0x72, 0x29, 0x8e, 0xa0, 0x16, 0x9c, 0xe4, 0x44, 0x7e, 0xf2, 0x37, 0x49,
-	0xa7, 0x4b, 0x1c, 0xec, 0x78, 0x94, 0x9d, 0x46, 0x33, 0xeb, 0xcf, 0x65, 0x28, 0xda, 0xb1, 0x5e,
-	0x8c, 0x58, 0x1a, 0x27, 0xe8, 0xe0, 0xa4, 0xf2, 0x10, 0x93, 0x78, 0xea, 0xe7, 0x1a, 0x9c, 0x48,
-	0x91, 0x8f, 0xca, 0xc2, 0x71, 0xc9, 0xd6, 0xea, 0xa5, 0xb1, 0xe4, 0x81, 0x19, 0x11, 0xf8, 0x41,
-	0x16, 0x2c, 0x58, 0x44, 0xe0, 0x87, 0x17, 0x7a, 0x0a, 0x66, 0x43, 0xb4, 0x89, 0x23, 0x77, 0x86,
-	0xcf, 0xf9, 0x32, 0xb6, 0x11, 0x2c, 0x84, 0x41, 0x8e, 0x1d, 0xcf, 0xde, 0xb5, 0xb1, 0x5b, 0xb9,
-	0xc3, 0x52, 0x95, 0xb8, 0x32, 0xfc, 0xe8, 0xac, 0xc3, 0x7c, 0xc2, 0x7f, 0x89, 0xc3, 0xf3, 0x5c,
-	0xa6, 0xe7, 0xd8, 0xf1, 0x39, 0x47, 0x93, 0xc3, 0xca, 0x5f, 0xc7, 0xe3, 0x33, 0xba, 0x81, 0x4d,
-	0xe2, 0x5a, 0x61, 0x0c, 0x5c, 0x81, 0x49, 0x97, 0x4d, 0x70, 0xf9, 0x25, 0x99, 0xfc, 0x80, 0x2d,
-	0x4c, 0x70, 0x01, 0xcf, 0x61, 0x06, 0xc0, 0x79, 0x40, 0x26, 0x71, 0x3c, 0xd7, 0x30, 0xbd, 0x56,
-	0x7f, 0x24, 0x2c, 0x84, 0x5f, 0x9a, 0x61, 0x59, 0x73, 0x15, 0xa6, 0xba, 0x86, 0xeb, 0xd9, 0xd8,
-	0x2f, 0x6a, 0x72, 0xe7, 0xf1, 0x90, 0x47, 0x12, 0x50, 0x56, 0xbc, 0xb3, 0x42, 0xa7, 0xf2, 0xe5,
-	0x7b, 0x19, 0x8e, 0x05, 0x1e, 0xea, 0x5b, 0xbd, 0xb3, 0x6a, 0xef, 0xf2, 0xc5, 0x9b, 0x75, 0x13,
-	0xa3, 0xca, 0x83, 0x44, 0xfd, 0x21, 0xae, 0xdd, 0x06, 0x14, 0x22, 0x2d, 0x59, 0x49, 0x7f, 0x3a,
-	0x94, 0x39, 0x74, 0xfd, 0xb3, 0xcc, 0xa2, 0x54, 0xd4, 0xcf, 0x73, 0xcb, 0x43, 0x0d, 0x9e, 0x12,
-	0x4a, 0xbf, 0x66, 0xb2, 0xf6, 0x0d, 0xcd, 0x7c, 0x0d, 0xe6, 0x84, 0x9a, 0x98, 0xfb, 0x62, 0x4d,
-	0x59, 0x06, 0x0a, 0x92, 0xf8, 0x72, 0x88, 0x62, 0x14, 0xc1, 0x27, 0x24, 0x87, 0x89, 0x5c, 0xc9,
-	0xe1, 0x2d, 0xa8, 0xa8, 0x90, 0xf0, 0x75, 0x7d, 0x15, 0x50, 0xb0, 0x8b, 0x99, 0x78, 0x71, 0x6d,
-	0x9f, 0xcd, 0xc4, 0xc3, 0x97, 0x77, 0x9e, 0x8a, 0x13, 0xfe, 0xd1, 0x5e, 0x11, 0x0f, 0xd0, 0x54,
-	0x3f, 0x6e, 0xc3, 0x82, 0xe0, 0x80, 0x1c, 0xab, 0x3e, 0x2f, 0x30, 0x8c, 0xb0, 0xf8, 0xe7, 0xe0,
-	0x69, 0xa5, 0x65, 0x3c, 0x10, 0xfe, 0xa2, 0xc1, 0xd9, 0xd0, 0x7d, 0x2f, 0x26, 0xf6, 0xde, 0x00,
-	0x86, 0xd7, 0xd3, 0x63, 0xe1, 0x82, 0xcc, 0x77, 0xa9, 0xc2, 0x9e, 0x40, 0x38, 0xfc, 0x58, 0x83,
-	0x73, 0x19, 0x80, 0x78, 0x48, 0x7c, 0x0b, 0x4e, 0x8a, 0x79, 0x48, 0x8c, 0x8a, 0xb5, 0x3c, 0xc8,
-	0x78, 0x60, 0x20, 0x73, 0x60, 0xae, 0xf2, 0xef, 0xc0, 0xb3, 0x5b, 0x96, 0x95, 0x64, 0x78, 0x95,
-	0x44, 0x8b, 0x11, 0x7a, 0xb6, 0x09, 0xcb, 0x82, 0x1d, 0xc3, 0x84, 0xc9, 0x92, 0x99, 0x06, 0x71,
-	0xc7, 0x42, 0x75, 0x38, 0x15, 0xc7, 0xbb, 0x20, 0x71, 0x5c, 0x2d, 0x71, 0x91, 0x0e, 0x04, 0xcb,
-	0xce, 0xf0, 0xb5, 0xcd, 0xb3, 0x6c, 0x11, 0x54, 0xd8, 0x79, 0xfc, 0xfd, 0x57, 0x83, 0xcf, 0x47,
-	0x71, 0x9a, 0x24, 0xfe, 0x9a, 0x4b, 0x3a, 0x9f, 0x09, 0x57, 0x9d, 0x87, 0xb5, 0x3c, 0x0e, 0xe0,
-	0xfe, 0xfa, 0x75, 0x10, 0xde, 0x83, 0xe4, 0x9f, 0x8a, 0xa4, 0xb3, 0x0a, 0xcf, 0x64, 0x19, 0xc7,
-	0x71, 0xfc, 0x5d, 0x8b, 0xd3, 0x76, 0x70, 0x36, 0xa5, 0x82, 0xb8, 0x95, 0x9e, 0x75, 0x9e, 0x53,
-	0x9f, 0xc6, 0x07, 0xca, 0x39, 0xe9, 0xe5, 0xc9, 0x44, 0x7a, 0x79, 0x22, 0xf1, 0xc3, 0x03, 0x96,
-	0x7c, 0xe5, 0xe0, 0x78, 0x06, 0xba, 0x05, 0x27, 0x78, 0x19, 0x90, 0x92, 0x7f, 0x56, 0xb3, 0x31,
-	0xf2, 0xec, 0xb3, 0xe0, 0xf6, 0xcd, 0x54, 0xde, 0xd5, 0x12, 0xd9, 0x5f, 0xe1, 0xde, 0xc3, 0x88,
-	0x91, 0x67, 0x58, 0x5a, 0x54, 0x98, 0xc6, 0x23, 0xe4, 0x3e, 0xab, 0x5e, 0xb6, 0x6d, 0xc7, 0xba,
-	0xde, 0x7c, 0x85, 0x98, 0x86, 0x47, 0xa2, 0x1b, 0xda, 0xcb, 0x30, 0xb5, 0x17, 0xcc, 0x64, 0xe5,
-	0xea, 0xeb, 0xac, 0x8d, 0xd8, 0xf4, 0x88, 0x8b, 0xb9, 0x8c, 0xb0, 0x40, 0xe4, 0x02, 0xfa, 0x8c,
-	0xe4, 0xb3, 0x95, 0x5d, 0x76, 0x9f, 0xef, 0x53, 0x1e, 0x95, 0x88, 0x9f, 0x98, 0xf6, 0xca, 0xf7,
-	0x60, 0x39, 0x72, 0xc6, 0x21, 0xc0, 0xbc, 0x93, 0xe8, 0x4c, 0x3c, 0x09, 0xa0, 0x75, 0x62, 0xd9,
-	0xbb, 0xf7, 0x0e, 0x0d, 0xe8, 0x80, 0xfa, 0xff, 0x03, 0xd0, 0xdf, 0x6a, 0x2c, 0x74, 0x9a, 0xd8,
-	0xdb, 0x32, 0x4d, 0xd2, 0x73, 0xbc, 0x6b, 0x86, 0x67, 0xc4, 0x77, 0xb6, 0xb9, 0x50, 0x5a, 0x70,
-	0x25, 0xcd, 0xd8, 0x6c, 0xb3, 0x9d, 0xc4, 0x04, 0x5a, 0x84, 0xa3, 0xac, 0x3b, 0xc2, 0x3b, 0x0f,
-	0xc1, 0x60, 0xe8, 0xf3, 0xe6, 0x34, 0x5b, 0x89, 0x7e, 0xfb, 0xf8, 0xa6, 0x7b, 0x47, 0x83, 0x52,
-	0x98, 0xb9, 0x6e, 0x6c, 0x0a, 0x39, 0x3c, 0xc4, 0xd0, 0x80, 0xd9, 0x30, 0x0b, 0xfa, 0xa9, 0x20,
-	0x2b, 0x5b, 0x75, 0x37, 0xb1, 0x50, 0x31, 0x71, 0x7f, 0x09, 0x32, 0x14, 0x39, 0x64, 0xd2, 0xc7,
-	0x50, 0xd4, 0x2a, 0x8f, 0x83, 0x56, 0x67, 0xba, 0x61, 0x4f, 0xa4, 0xa0, 0x43, 0xaf, 0xc3, 0x62,
-	0x4a, 0xb6, 0x0e, 0xdb, 0x8b, 0xf9, 0xd3, 0xf5, 0xf1, 0xfe, 0x74, 0x1d, 0xa3, 0xfc, 0xcf, 0x38,
-	0x6b, 0x94, 0xde, 0xd8, 0xc4, 0x75, 0xdc, 0x21, 0xae, 0x6d, 0xec, 0xd9, 0x6f, 0x45, 0x58, 0xc3,
-	0x05, 0x58, 0xee, 0x6b, 0x18, 0x16, 0xe2, 0xbe, 0xe0, 0x32, 0x4c, 0xb7, 0x5d, 0xd2, 0xeb, 0x86,
-	0xc5, 0x4b, 0xa1, 0x31, 0xc5, 0xc6, 0x3b, 0x16, 0xda, 0x90, 0x56, 0x39, 0xc1, 0xd1, 0x96, 0x5e,
-	0xcc, 0x7c, 0x15, 0xfc, 0xeb, 0xa7, 0xed, 0x19, 0x7b, 0x94, 0xdd, 0xd0, 0x15, 0x17, 0x61, 0x7f,
-	0xa1, 0x1b, 0x9c, 0xb6, 0x11, 0x71, 0xf9, 0x12, 0x42, 0x5f, 0xb2, 0x57, 0x89, 0x0c, 0x09, 0x11,
-	0xd8, 0x88, 0x0b, 0xbd, 0x04, 0xe0, 0x47, 0x83, 0xe1, 0xf5, 0x5c, 0x4c, 0x8b, 0x93, 0xd9, 0xe1,
-	0xd6, 0x0c, 0xa9, 0x9b, 0xd8, 0x6b, 0x24, 0x78, 0xfd, 0x30, 0xb3, 0x9d, 0x7d, 0xf2, 0x06, 0x76,
-	0x8b, 0x53, 0x81, 0x77, 0xf8, 0x30, 0x5a, 0x80, 0x5f, 0x8c, 0xb3, 0x7b, 0xb1, 0x6c, 0x01, 0x3e,
-	0xe1, 0xe7, 0x91, 0xb4, 0x66, 0xd1, 0xf8, 0xe8, 0xcd, 0x22, 0xf4, 0x0a, 0xcc, 0x8b, 0xcd, 0x8b,
-	0x20, 0x25, 0xe4, 0xed, 0x5e, 0xcc, 0x25, 0xbb, 0x17, 0x71, 0x50, 0xfe, 0x39, 0xe8, 0x97, 0x6e,
-	0x59, 0xd6, 0x37, 0xb0, 0xb7, 0x45, 0x29, 0xf6, 0x58, 0xb3, 0x92, 0xe6, 0x88, 0x47, 0x79, 0x95,
-	0x75, 0x13, 0x16, 0x1c, 0xec, 0xb5, 0x0c, 0x5f, 0x5c, 0x8b, 0x25, 0xb2, 0xd0, 0x56, 0x29, 0x74,
-	0x41, 0x3b, 0x4f, 0x23, 0xc7, 0x1c, 0xc1, 0x24, 0x65, 0xa7, 0x35, 0x05, 0x40, 0xb0, 0x9e, 0xeb,
-	0xff, 0x2a, 0xc2, 0x44, 0x9d, 0xb6, 0x91, 0x0d, 0x10, 0xf7, 0x11, 0xd0, 0x79, 0x99, 0x21, 0x69,
-	0xef, 0x81, 0xfa, 0x85, 0x9c, 0xd4, 0x3c, 0x84, 0xf6, 0x60, 0x26, 0x71, 0x37, 0x47, 0x2a, 0xee,
-	0xc1, 0xd7, 0x33, 0xbd, 0x9a, 0x97, 0x9c, 0x6b, 0xfb, 0x81, 0x06, 0x68, 0xf0, 0x1d, 0x09, 0x6d,
-	0x28, 0xc4, 0x48, 0xdf, 0xc6, 0xf4, 0x2f, 0x0e, 0xc9, 0xc5, 0x6d, 0xf8, 0x99, 0x06, 0x27, 0x53,
-	0x5f, 0x80, 0xd0, 0x97, 0xf2, 0xa1, 0x19, 0xb4, 0x64, 0x73, 0x78, 0x46, 0x6e, 0x8c, 0x0b, 0x73,
-	0xc2, 0x63, 0x0d, 0xaa, 0xe5, 0x00, 0x95, 0x7c, 0x25, 0xd0, 0xbf, 0x90, 0x9f, 0x81, 0xeb, 0xbc,
-	0x0f, 0x0b, 0xfd, 0x2f, 0x2d, 0x68, 0x3d, 0x1f, 0x02, 0x41, 0xf3, 0xf3, 0x43, 0xf1, 0x70, 0xe5,
-	0x0f, 0xe0, 0xf8, 0xc0, 0x8b, 0x08, 0x52, 0x49, 0x92, 0x3d, 0xfa, 0xe8, 0x1b, 0xc3, 0x31, 0xc5,
-	0xfa, 0x07, 0x5e, 0x3a, 0x94, 0xfa, 0x65, 0xcf, 0x33, 0x4a, 0xfd, 0xd2, 0xc7, 0x14, 0x44, 0x60,
-	0x36, 0xd9, 0xae, 0x47, 0xd5, 0xcc, 0xed, 0x2a, 0xbc, 0xb8, 0xe8, 0xb5, 0xdc, 0xf4, 0xf1, 0x06,
-	0x4f, 0xdc, 0xff, 0x50, 0x66, 0x7a, 0x10, 0x1a, 0xc4, 0x7a, 0x35, 0x2f, 0x79, 0x0c, 0x2f, 0x79,
-	0xa3, 0x42, 0xd9, 0x09, 0x42, 0xd4, 0x57, 0xcb, 0x4d, 0xcf, 0x15, 0xbe, 0xad, 0xc1, 0x92, 0xa4,
-	0xe7, 0x8a, 0x5e, 0xc8, 0x95, 0x0a, 0xd3, 0x2e, 0xa4, 0xfa, 0xa5, 0x51, 0x58, 0xb9, 0x49, 0xbf,
-	0xd4, 0xa0, 0x28, 0xeb, 0x77, 0xa2, 0x4b, 0xf9, 0x36, 0x4d, 0xaa, 0x51, 0x97, 0x47, 0xe2, 0xe5,
-	0x56, 0xbd, 0xab, 0x81, 0x2e, 0x6f, 0x46, 0xa2, 0x2b, 0x59, 0x80, 0x55, 0x3d, 0x1e, 0xfd, 0xea,
-	0x88, 0xdc, 0xdc, 0xb6, 0xdf, 0x68, 0x70, 0x5a, 0xd1, 0xac, 0x41, 0x57, 0x33, 0x81, 0x2b, 0xad,
-	0xfb, 0xf2, 0xa8, 0xec, 0x09, 0xd7, 0xc9, 0x5b, 0x88, 0x4a, 0xd7, 0x65, 0x76, 0x5d, 0x95, 0xae,
-	0xcb, 0xee, 0x5b, 0xa2, 0x3f, 0x68, 0x50, 0xce, 0xe8, 0xd9, 0xa1, 0xad, 0xa1, 0xf0, 0xa7, 0x35,
-	0x3c, 0xf5, 0xed, 0x83, 0x88, 0x48, 0xec, 0x0b, 0x59, 0x2b, 0x0a, 0x5d, 0xca, 0x97, 0x68, 0x86,
-	0xde, 0x17, 0x99, 0xbd, 0xaf, 0x77, 0x34, 0x58, 0x96, 0x36, 0x81, 0xd0, 0xe5, 0x9c, 0xf9, 0x28,
-	0xd5, 0xae, 0x2b, 0xa3, 0x31, 0xc7, 0xa5, 0x81, 0xd0, 0xf7, 0x51, 0x96, 0x06, 0x69, 0xed, 0x29,
-	0x65, 0x69, 0x90, 0xde, 0x52, 0xba, 0x0b, 0xf3, 0x7d, 0x4d, 0x18, 0x74, 0x31, 0x13, 0xc4, 0x80,
-	0xde, 0xf5, 0x61, 0x58, 0x62, 0xcd, 0x7d, 0x5d, 0x11, 0xa5, 0xe6, 0xf4, 0x06, 0x8e, 0x52, 0xb3,
-	0xac, 0xe9, 0xd2, 0x83, 0x63, 0x62, 0x13, 0x02, 0xa9, 0xfc, 0x96, 0xda, 0x4f, 0xd1, 0x2f, 0x0e,
-	0xc1, 0x11, 0x17, 0x22, 0x03, 0x17, 0x01, 0x65, 0x21, 0x22, 0xbb, 0xf7, 0xe8, 0x1b, 0xc3, 0x31,
-	0x05, 0xfa, 0xf5, 0xa3, 0xdf, 0xff, 0xf8, 0xfd, 0x35, 0x6d, 0xfb, 0x8d, 0x87, 0x8f, 0x4a, 0xda,
-	0x07, 0x8f, 0x4a, 0xda, 0x3f, 0x1e, 0x95, 0xb4, 0xb7, 0x1f, 0x97, 0xc6, 0x3e, 0x78, 0x5c, 0x1a,
-	0xfb, 0xdb, 0xe3, 0xd2, 0x18, 0x2c, 0xdb, 0x44, 0x22, 0xf8, 0x86, 0xf6, 0xcd, 0x8d, 0xb6, 0xed,
-	0xdd, 0xe9, 0xdd, 0xae, 0x9a, 0xa4, 0x53, 0x8b, 0x89, 0x2e, 0xd8, 0x24, 0x31, 0xaa, 0xdd, 0x8d,
-	0x7f, 0xd5, 0xe8, 0xdd, 0xeb, 0x62, 0x7a, 0x7b, 0x92, 0xfd, 0x96, 0xf1, 0xf9, 0xff, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x0e, 0x34, 0xe1, 0xb8, 0xfc, 0x29, 0x00, 0x00,
+	// 2219 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xf7, 0xac, 0xe3, 0x8f, 0x3d, 0xb6, 0x63, 0xe7, 0xc6, 0xb1, 0x67, 0x27, 0xcd, 0xae, 0xb3,
+	0x89, 0x5b, 0xe3, 0x26, 0xbb, 0x8d, 0xeb, 0x42, 0xea, 0x24, 0x80, 0x37, 0x55, 0xa9, 0xab, 0x2e,
+	0x89, 0x76, 0x9b, 0x46, 0x45, 0x42, 0xcb, 0x64, 0xe6, 0x7a, 0x33, 0xd4, 0x3b, 0x77, 0x99, 0x3b,
+	0xeb, 0x26, 0x8d, 0x88, 0x28, 0x12, 0x1f, 0xe2, 0x01, 0x15, 0x21, 0x55, 0x54, 0x20, 0x54, 0x09,
+	0x09, 0xf1, 0x58, 0x09, 0x9e, 0x78, 0xe1, 0x35, 0x4f, 0xa8, 0x82, 0x17, 0x54, 0xa4, 0x0a, 0x25,
+	0x0f, 0xe5, 0x0f, 0xe0, 0x89, 0x07, 0x40, 0x33, 0x73, 0xe7, 0xe3, 0xee, 0x7c, 0xaf, 0x4b, 0x5c,
+	0xa9, 0x0f, 0x96, 0x7c, 0xef, 0x9c, 0xaf, 0xdf, 0xb9, 0xe7, 0x9e, 0x7b, 0xee, 0xb9, 0x0b, 0x95,
+	0xbe, 0x41, 0xf6, 0xb1, 0x2e, 0xeb, 0x0a, 0xae, 0xf7, 0xb0, 0x29, 0xab, 0xb2, 0x29, 0xd7, 0xf7,
+	0x2f, 0xd4, 0xcd, 0x3b, 0xb5, 0xbe, 0x41, 0x4c, 0x82, 0x96, 0x7c, 0x82, 0x9a, 0x4b, 0x50, 0xdb,
+	0xbf, 0x20, 0x2d, 0x2b, 0x84, 0xf6, 0x08, 0xad, 0xf7, 0x68, 0xd7, 0xa2, 0xef, 0xd1, 0xae, 0xc3,
+	0x20, 0x95, 0x9c, 0x0f, 0x1d, 0x7b, 0x54, 0x77, 0x06, 0xec, 0xd3, 0x62, 0x97, 0x74, 0x89, 0x33,
+	0x6f, 0xfd, 0xc7, 0x66, 0x57, 0x63, 0x4c, 0xf0, 0xb4, 0x39, 0x64, 0x6b, 0x31, 0x64, 0xe4, 0xd6,
+	0xb7, 0xb1, 0x62, 0x52, 0x93, 0x18, 0x98, 0x51, 0x9e, 0x8d, 0xa1, 0xec, 0x5f, 0xc4, 0xd6, 0x1f,
+	0xa3, 0xaa, 0xc6, 0x50, 0x51, 0x85, 0xf4, 0x5d, 0x9a, 0xf5, 0x38, 0x9a, 0x3e, 0x56, 0xb4, 0x5d,
+	0x4d, 0x91, 0x4d, 0x8d, 0xe8, 0x0e, 0x6d, 0xf5, 0x23, 0x01, 0x16, 0x9b, 0xb4, 0x7b, 0xd3, 0xd0,
+	0x4c, 0xdc, 0xb6, 0x64, 0xb4, 0xf0, 0x77, 0x06, 0x98, 0x9a, 0xe8, 0x79, 0x98, 0xb0, 0x65, 0x8a,
+	0xc2, 0x8a, 0xb0, 0x36, 0xb3, 0x71, 0xaa, 0x16, 0xed, 0xd1, 0x9a, 0xcd, 0xd4, 0x38, 0xf2, 0xe0,
+	0xe3, 0xca, 0x58, 0xcb, 0xe1, 0x40, 0x22, 0x4c, 0x51, 0xad, 0xab, 0x63, 0x83, 0x8a, 0x85, 0x95,
+	0xf1, 0xb5, 0x62, 0xcb, 0x1d, 0xa2, 0x53, 0x00, 0x36, 0x49, 0x67, 0x30, 0xd0, 0x54, 0x71, 0x7c,
+	0x45, 0x58, 0x2b, 0xb6, 0x8a, 0xf6, 0xcc, 0x8d, 0x81, 0xa6, 0xa2, 0x93, 0x50, 0xb4, 0x6c, 0x74,
+	0xbe, 0x1e, 0xb1, 0xbf, 0x4e, 0x5b, 0x13, 0xee, 0xc7, 0x01, 0x55, 0x3b, 0x3d, 0x6d, 0x6f, 0x8f,
+	0x8a, 0x13, 0x2b, 0xc2, 0xda, 0x91, 0xd6, 0xf4, 0x80, 0xaa, 0x4d, 0x6b, 0xbc, 0xb5, 0xf8, 0xe3,
+	0xf7, 0x2b, 0x63, 0xff, 0x7c, 0xbf, 0x32, 0xf6, 0xfd, 0x4f, 0x3e, 0x58, 0x77, 0xd5, 0x55, 0xbf,
+	0x05, 0x27, 0x86, 0xb0, 0xd1, 0x3e, 0xd1, 0x29, 0x46, 0x5f, 0x83, 0x39, 0xc7, 0x0e, 0x4d, 0xed,
+	0x68, 0xfa, 0x2e, 0x61, 0x20, 0xcf, 0x24, 0x82, 0xdc, 0x51, 0x77, 0xf4, 0x5d, 0xd2, 0x9a, 0xa1,
+	0xfe, 0xa0, 0x7a, 0xcf, 0xd6, 0xf0, 0x02, 0xde, 0xc3, 0x43, 0xee, 0xdb, 0x80, 0x69, 0x57, 0x83,
+	0x2d, 0x7c, 0xb6, 0xb1, 0x6c, 0xb9, 0xe8, 0xa3, 0x8f, 0x2b, 0xf3, 0x4d, 0x26, 0x78, 0x5b, 0x55,
+	0x0d, 0x4c, 0x69, 0x6b, 0x8a, 0x09, 0x8c, 0xf7, 0x5b, 0x0c, 0x3c, 0x11, 0x96, 0x86, 0x95, 0x3b,
+	0xf8, 0xaa, 0xbf, 0x11, 0xe0, 0x89, 0x26, 0xed, 0x6e, 0xab, 0xaa, 0x3d, 0xff, 0x82, 0xa5, 0x4d,
+	0x51, 0x2c, 0x65, 0x07, 0x30, 0xaf, 0x02, 0x33, 0xd6, 0x7c, 0x47, 0xb6, 0x25, 0x31, 0x13, 0x41,
+	0xf5, 0x64, 0x07, 0xed, 0x1f, 0xcf, 0x62, 0x7f, 0x05, 0x4e, 0xc5, 0x18, 0xc9, 0x60, 0xfc, 0x56,
+	0x80, 0x0a, 0x8f, 0xf0, 0x33, 0x8a, 0xa4, 0x0a, 0x2b, 0xf1, 0x76, 0x32, 0x30, 0x7f, 0x14, 0x60,
+	0x39, 0x00, 0xf7, 0xda, 0x9b, 0x3a, 0x36, 0x0e, 0x02, 0xe2, 0x12, 0x4c, 0x92, 0x37, 0xbd, 0x60,
+	0x49, 0xd8, 0xa1, 0xd7, 0x65, 0xc3, 0xbc, 0xcb, 0x76, 0x28, 0x63, 0xc9, 0x0d, 0x50, 0x02, 0x31,
+	0x6c, 0x3b, 0x03, 0xf6, 0x0b, 0x01, 0x24, 0x1e, 0xfd, 0x81, 0xb1, 0x2d, 0x71, 0xd8, 0x8a, 0x23,
+	0x9b, 0x7d, 0x0a, 0x4e, 0x46, 0x5a, 0xc6, 0x2c, 0xff, 0xbd, 0x60, 0x7f, 0xbf, 0xd1, 0x57, 0x65,
+	0x13, 0xbf, 0x26, 0xef, 0x0d, 0x9c, 0xef, 0x5e, 0x6c, 0x6d, 0x42, 0xd1, 0x35, 0x9d, 0x8a, 0xc2,
+	0xca, 0x78, 0x92, 0xed, 0xd3, 0xcc, 0x76, 0x8a, 0x6a, 0x70, 0x7c, 0xdf, 0x92, 0xd5, 0xb1, 0x8d,
+	0xee, 0xc8, 0x0e, 0x81, 0x58, 0xb0, 0xf3, 0xd9, 0xb1, 0x7d, 0x4f, 0x0d, 0xe3, 0xcc, 0x0d, 0xaa,
+	0x0c, 0x4f, 0x44, 0x1b, 0xcd, 0x50, 0xfd, 0xc0, 0x41, 0xd5, 0xd4, 0xba, 0x06, 0x47, 0xe1, 0xa2,
+	0x92, 0x60, 0x1a, 0xdf, 0xd1, 0xa8, 0xa9, 0xe9, 0x5d, 0x7b, 0x41, 0x8a, 0x2d, 0x6f, 0x6c, 0x7d,
+	0xeb, 0x1b, 0xa4, 0x4f, 0x28, 0x56, 0x99, 0xc1, 0xde, 0x78, 0x44, 0x3b, 0x23, 0xcc, 0x60, 0x76,
+	0xfe, 0xa8, 0x00, 0x4b, 0x5e, 0x7a, 0xc6, 0x94, 0x6a, 0x44, 0x77, 0x4d, 0xfc, 0x0a, 0x4c, 0x51,
+	0x67, 0x86, 0x65, 0xe6, 0x4a, 0x6c, 0x66, 0x76, 0xc8, 0x58, 0x78, 0xbb, 0x5c, 0x09, 0x47, 0x50,
+	0x07, 0x4e, 0x30, 0x22, 0x2b, 0xf9, 0x2b, 0xa4, 0xd7, 0x27, 0x3a, 0xd6, 0x4d, 0x6a, 0x9f, 0x46,
+	0x33, 0x1b, 0x4f, 0xa7, 0x28, 0xda, 0x51, 0xaf, 0x7a, 0x2c, 0xad, 0xe3, 0x34, 0x3c, 0x99, 0x78,
+	0x88, 0xc5, 0x78, 0xea, 0xa7, 0x02, 0x1c, 0x8f, 0x90, 0x8f, 0x2a, 0xdc, 0x71, 0x69, 0xaf, 0xd5,
+	0x4b, 0x63, 0xc1, 0x03, 0xd3, 0x23, 0xb0, 0x82, 0x4c, 0x2c, 0x70, 0x04, 0x56, 0x78, 0xa1, 0xd3,
+	0x30, 0xeb, 0xa2, 0x0d, 0x1c, 0xb9, 0x33, 0x6c, 0xce, 0x92, 0xd1, 0x40, 0xb0, 0xe0, 0x06, 0x39,
+	0xd6, 0x4d, 0x6d, 0x57, 0xc3, 0x46, 0xf5, 0x36, 0x2c, 0x87, 0x56, 0x86, 0x1d, 0x9d, 0x4d, 0x98,
+	0x0f, 0xf8, 0x2f, 0x70, 0x78, 0xae, 0xa6, 0x7a, 0xce, 0x3e, 0x3e, 0xe7, 0x68, 0x70, 0x58, 0xfd,
+	0x6b, 0xc1, 0x3f, 0xa3, 0x5b, 0x58, 0x21, 0x86, 0xea, 0xc6, 0xc0, 0x65, 0x98, 0x34, 0xec, 0x09,
+	0x26, 0xbf, 0x1c, 0x27, 0xdf, 0x61, 0x73, 0x13, 0x9c, 0xc3, 0x73, 0x98, 0x01, 0x70, 0x0e, 0x90,
+	0x42, 0x74, 0xd3, 0x90, 0x15, 0xb3, 0x33, 0x1c, 0x09, 0x0b, 0xee, 0x97, 0xb6, 0x5b, 0xd6, 0x5c,
+	0x81, 0xa9, 0xbe, 0x6c, 0x98, 0x1a, 0xb6, 0x8a, 0x9a, 0xcc, 0x79, 0xdc, 0xe5, 0x89, 0x09, 0x28,
+	0xd5, 0xdf, 0x59, 0xae, 0x53, 0xd9, 0xf2, 0xbd, 0x0c, 0x47, 0x1d, 0x0f, 0x0d, 0xad, 0xde, 0xd9,
+	0x64, 0xef, 0xb2, 0xc5, 0x9b, 0x35, 0x02, 0xa3, 0xea, 0xfd, 0x40, 0xfd, 0xc1, 0xaf, 0xdd, 0x26,
+	0x14, 0x3d, 0x2d, 0x69, 0x49, 0x7f, 0xda, 0x95, 0x99, 0xbb, 0xfe, 0x29, 0xc1, 0x72, 0x48, 0x3f,
+	0xcb, 0x2d, 0x0f, 0x04, 0x38, 0xcd, 0x95, 0x7e, 0xed, 0x60, 0xed, 0xeb, 0x9a, 0xf9, 0x1a, 0xcc,
+	0x71, 0x35, 0x31, 0xf3, 0xc5, 0x7a, 0x62, 0x19, 0xc8, 0x49, 0x62, 0xcb, 0xc1, 0x8b, 0x49, 0x08,
+	0x3e, 0x2e, 0x39, 0x8c, 0x67, 0x4a, 0x0e, 0x6f, 0x41, 0x35, 0x09, 0x09, 0x5b, 0xd7, 0x57, 0x01,
+	0x39, 0xbb, 0xd8, 0x16, 0xcf, 0xaf, 0xed, 0x53, 0xa9, 0x78, 0xd8, 0xf2, 0xce, 0x53, 0x7e, 0xc2,
+	0x3a, 0xda, 0xab, 0xfc, 0x01, 0x1a, 0xe9, 0xc7, 0x06, 0x2c, 0x70, 0x0e, 0xc8, 0xb0, 0xea, 0xf3,
+	0x1c, 0xc3, 0x08, 0x8b, 0xbf, 0x0a, 0x67, 0x12, 0x2d, 0x63, 0x81, 0xf0, 0x67, 0x01, 0xce, 0xba,
+	0xee, 0xbb, 0x1a, 0xd8, 0x7b, 0x21, 0x0c, 0xaf, 0x47, 0xc7, 0xc2, 0xf9, 0x38, 0xdf, 0x45, 0x0a,
+	0x7b, 0x0c, 0xe1, 0xf0, 0x43, 0x01, 0x56, 0x53, 0x00, 0xb1, 0x90, 0xf8, 0x26, 0x9c, 0xe0, 0xf3,
+	0x10, 0x1f, 0x15, 0xeb, 0x59, 0x90, 0xb1, 0xc0, 0x40, 0x4a, 0x68, 0xae, 0xfa, 0x6f, 0xc7, 0xb3,
+	0xdb, 0xaa, 0x1a, 0x64, 0x78, 0x95, 0x78, 0x8b, 0xe1, 0x7a, 0xb6, 0x0d, 0x25, 0xce, 0x8e, 0x3c,
+	0x61, 0xb2, 0xac, 0x44, 0x41, 0xdc, 0x51, 0x51, 0x13, 0x96, 0xfc, 0x78, 0xe7, 0x24, 0x16, 0x92,
+	0x25, 0x2e, 0xd2, 0x50, 0xb0, 0xec, 0xe4, 0xaf, 0x6d, 0x9e, 0x82, 0xd5, 0x14, 0xec, 0x2c, 0xfe,
+	0xfe, 0x2b, 0xc0, 0x17, 0xbc, 0x38, 0x0d, 0x12, 0xbf, 0x68, 0x90, 0xde, 0xe7, 0xc2, 0x55, 0xe7,
+	0x60, 0x3d, 0x8b, 0x03, 0x98, 0xbf, 0x7e, 0xe9, 0x84, 0x77, 0x98, 0xfc, 0x33, 0x91, 0x74, 0xd6,
+	0xe0, 0xc9, 0x34, 0xe3, 0x18, 0x8e, 0xbf, 0x0b, 0x7e, 0xda, 0x76, 0xce, 0xa6, 0x48, 0x10, 0x37,
+	0xa3, 0xb3, 0xce, 0xd3, 0xc9, 0xa7, 0xf1, 0x81, 0x72, 0x4e, 0x74, 0x79, 0x32, 0x1e, 0x5d, 0x9e,
+	0xc4, 0xf8, 0xe1, 0x3e, 0x9c, 0x49, 0x04, 0xc7, 0x32, 0xd0, 0x4d, 0x38, 0xce, 0xca, 0x80, 0x88,
+	0xfc, 0xb3, 0x96, 0x8e, 0x91, 0x65, 0x9f, 0x05, 0x63, 0x68, 0xa6, 0xfa, 0x9e, 0x10, 0xc8, 0xfe,
+	0x09, 0xee, 0x3d, 0x8c, 0x18, 0x79, 0x12, 0xce, 0x26, 0x9b, 0xc6, 0x22, 0xe4, 0x9e, 0x5d, 0xbd,
+	0x34, 0x34, 0x5d, 0xbd, 0xd6, 0x7e, 0x85, 0x28, 0xb2, 0x49, 0xbc, 0x1b, 0xda, 0xcb, 0x30, 0xb5,
+	0xe7, 0xcc, 0xa4, 0xe5, 0xea, 0x6b, 0x76, 0x1b, 0xb1, 0x6d, 0x12, 0x03, 0x33, 0x19, 0x6e, 0x81,
+	0xc8, 0x04, 0x0c, 0x19, 0xc9, 0x66, 0xab, 0xbb, 0x20, 0x86, 0x95, 0x7b, 0x25, 0xe2, 0xa7, 0xa6,
+	0xbd, 0xfa, 0x5d, 0x28, 0x79, 0xce, 0x38, 0x04, 0x98, 0xb7, 0x03, 0x9d, 0x89, 0xc7, 0x01, 0xb4,
+	0x49, 0x54, 0x6d, 0xf7, 0xee, 0xa1, 0x01, 0x0d, 0xa9, 0xff, 0x3f, 0x00, 0xfd, 0xb5, 0x60, 0x87,
+	0x4e, 0x1b, 0x9b, 0xdb, 0x8a, 0x42, 0x06, 0xba, 0x69, 0xb5, 0xba, 0xfc, 0x3b, 0xdb, 0x9c, 0x2b,
+	0xcd, 0xb9, 0x92, 0xa6, 0x6c, 0xb6, 0xd9, 0x5e, 0x60, 0x02, 0x2d, 0xc2, 0x84, 0xdd, 0x1d, 0x61,
+	0x9d, 0x07, 0x67, 0x90, 0xfb, 0xbc, 0x39, 0x09, 0xa5, 0x08, 0xfb, 0xd8, 0xa6, 0x7b, 0x57, 0x80,
+	0xb2, 0x9b, 0xb9, 0xae, 0x5f, 0xe4, 0x72, 0xb8, 0x8b, 0xa1, 0x05, 0xb3, 0x6e, 0x16, 0xa4, 0x7d,
+	0xac, 0xa4, 0x65, 0x2b, 0xab, 0x35, 0x1f, 0x14, 0xc3, 0xfc, 0xc5, 0xc9, 0x48, 0xc8, 0x21, 0x93,
+	0x16, 0x06, 0x51, 0xa8, 0x3e, 0x72, 0x5a, 0x9d, 0xd1, 0x86, 0x3d, 0x96, 0x82, 0x0e, 0xbd, 0x0e,
+	0x8b, 0x11, 0xd9, 0xda, 0x6d, 0x2f, 0x66, 0x4f, 0xd7, 0xc7, 0x86, 0xd3, 0xb5, 0x8f, 0xf2, 0x3f,
+	0x05, 0xbb, 0x51, 0x7a, 0xfd, 0x22, 0x6e, 0xe2, 0x1e, 0x31, 0x34, 0x79, 0x4f, 0x7b, 0xcb, 0xc3,
+	0xea, 0x2e, 0x40, 0x69, 0xa8, 0x61, 0x58, 0xf4, 0xfb, 0x82, 0x25, 0x98, 0xee, 0x1a, 0x64, 0xd0,
+	0x77, 0x8b, 0x97, 0x62, 0x6b, 0xca, 0x1e, 0xef, 0xa8, 0x68, 0x33, 0xb6, 0xca, 0x71, 0x8e, 0xb6,
+	0xe8, 0x62, 0xe6, 0xab, 0x60, 0x5d, 0x3f, 0x35, 0x53, 0xde, 0xa3, 0xe2, 0x91, 0xe4, 0x8b, 0xb0,
+	0xb5, 0xd0, 0x2d, 0x46, 0xdb, 0xf2, 0xb8, 0x2c, 0x09, 0xae, 0x2f, 0xc5, 0x89, 0x74, 0x09, 0x1e,
+	0x58, 0x8f, 0x0b, 0xbd, 0x04, 0x60, 0x45, 0x83, 0x6c, 0x0e, 0x0c, 0x4c, 0xc5, 0xc9, 0xf4, 0x70,
+	0x6b, 0xbb, 0xd4, 0x6d, 0x6c, 0xb6, 0x02, 0xbc, 0x56, 0x98, 0x69, 0xfa, 0x3e, 0x79, 0x03, 0x1b,
+	0xe2, 0x94, 0xe3, 0x1d, 0x36, 0xf4, 0x16, 0xe0, 0x67, 0x05, 0x38, 0x9d, 0xb0, 0x00, 0x9f, 0xf2,
+	0xf3, 0x48, 0x54, 0xb3, 0xa8, 0x30, 0x7a, 0xb3, 0x08, 0xbd, 0x02, 0xf3, 0x7c, 0xf3, 0xc2, 0x49,
+	0x09, 0x59, 0xbb, 0x17, 0x73, 0xc1, 0xee, 0x85, 0x1f, 0x94, 0x7f, 0x72, 0xfa, 0xa5, 0xdb, 0xaa,
+	0xfa, 0x75, 0x6c, 0x6e, 0x53, 0x8a, 0x4d, 0xbb, 0x59, 0x49, 0x33, 0xc4, 0x63, 0x7c, 0x95, 0x75,
+	0x03, 0x16, 0x74, 0x6c, 0x76, 0x64, 0x4b, 0x5c, 0xc7, 0x4e, 0x64, 0xae, 0xad, 0xb1, 0xd0, 0x39,
+	0xed, 0x2c, 0x8d, 0x1c, 0xd5, 0x39, 0x93, 0x12, 0x3b, 0xad, 0x11, 0x00, 0x58, 0xd6, 0x7b, 0xdb,
+	0x79, 0x0e, 0x7a, 0x91, 0x18, 0x0a, 0x6e, 0x0c, 0x0c, 0xdd, 0x5e, 0xaf, 0xab, 0x44, 0xf3, 0xea,
+	0xa4, 0x2f, 0x42, 0x51, 0x1e, 0x98, 0xb7, 0x89, 0xa1, 0x99, 0x77, 0x1d, 0x8c, 0x0d, 0xf1, 0x2f,
+	0x7f, 0x38, 0xbf, 0xc8, 0xde, 0x41, 0x59, 0xba, 0x6e, 0x9b, 0x86, 0xa6, 0x77, 0x5b, 0x3e, 0x29,
+	0xe7, 0x9a, 0x02, 0xe7, 0x9a, 0xad, 0xa3, 0x96, 0x85, 0x3e, 0x29, 0x7b, 0xec, 0x89, 0x32, 0xc1,
+	0x31, 0x72, 0xe3, 0x5f, 0x25, 0x18, 0x6f, 0xd2, 0x2e, 0xd2, 0x00, 0xfc, 0x66, 0x07, 0x3a, 0x17,
+	0xe7, 0xad, 0xa8, 0x47, 0x4b, 0xe9, 0x7c, 0x46, 0x6a, 0x16, 0xe7, 0x7b, 0x30, 0x13, 0x68, 0x20,
+	0xa0, 0x24, 0xee, 0xf0, 0x13, 0x9f, 0x54, 0xcb, 0x4a, 0xce, 0xb4, 0xbd, 0x2d, 0x00, 0x0a, 0x3f,
+	0x76, 0xa1, 0xcd, 0x04, 0x31, 0xb1, 0x0f, 0x78, 0xd2, 0x73, 0x39, 0xb9, 0x98, 0x0d, 0x3f, 0x11,
+	0xe0, 0x44, 0xe4, 0x33, 0x15, 0xfa, 0x52, 0x36, 0x34, 0x61, 0x4b, 0x2e, 0xe6, 0x67, 0x64, 0xc6,
+	0x18, 0x30, 0xc7, 0xbd, 0x28, 0xa1, 0x7a, 0x06, 0x50, 0xc1, 0xa7, 0x0c, 0xe9, 0x99, 0xec, 0x0c,
+	0x4c, 0xe7, 0x3d, 0x58, 0x18, 0x7e, 0x0e, 0x42, 0x1b, 0xd9, 0x10, 0x70, 0x9a, 0x9f, 0xcd, 0xc5,
+	0xc3, 0x94, 0xdf, 0x87, 0x63, 0xa1, 0x67, 0x1b, 0x94, 0x24, 0x29, 0xee, 0x65, 0x4a, 0xda, 0xcc,
+	0xc7, 0xe4, 0xeb, 0x0f, 0x3d, 0xc7, 0x24, 0xea, 0x8f, 0x7b, 0x43, 0x92, 0x36, 0xf3, 0x31, 0x31,
+	0xfd, 0x04, 0x66, 0x83, 0x6f, 0x0a, 0xa8, 0x96, 0xba, 0x5d, 0xb9, 0x67, 0x21, 0xa9, 0x9e, 0x99,
+	0xde, 0xdf, 0xe0, 0x81, 0x4b, 0x2a, 0x4a, 0x4d, 0x0f, 0x5c, 0x17, 0x5b, 0xaa, 0x65, 0x25, 0xf7,
+	0xe1, 0x05, 0xaf, 0x7d, 0x28, 0x3d, 0x41, 0xf0, 0xfa, 0xea, 0x99, 0xe9, 0x99, 0xc2, 0x77, 0x04,
+	0x58, 0x8e, 0x69, 0x0c, 0xa3, 0xe7, 0x33, 0xa5, 0xc2, 0xa8, 0x5b, 0xb3, 0xb4, 0x35, 0x0a, 0x2b,
+	0x33, 0xe9, 0xe7, 0x02, 0x88, 0x71, 0x4d, 0x59, 0xb4, 0x95, 0x6d, 0xd3, 0x44, 0x1a, 0x75, 0x69,
+	0x24, 0x5e, 0x66, 0xd5, 0x7b, 0x02, 0x48, 0xf1, 0x1d, 0x53, 0x74, 0x39, 0x0d, 0x70, 0x52, 0x23,
+	0x4a, 0xba, 0x32, 0x22, 0x37, 0xb3, 0xed, 0x57, 0x02, 0x9c, 0x4c, 0xe8, 0x28, 0xa1, 0x2b, 0xa9,
+	0xc0, 0x13, 0xad, 0xfb, 0xf2, 0xa8, 0xec, 0x01, 0xd7, 0xc5, 0xf7, 0x39, 0x13, 0x5d, 0x97, 0xda,
+	0x1a, 0x96, 0xae, 0x8c, 0xc8, 0xcd, 0x6c, 0xfb, 0x9d, 0x00, 0x95, 0x94, 0xc6, 0x22, 0xda, 0xce,
+	0x85, 0x3f, 0xaa, 0x2b, 0x2b, 0x35, 0x0e, 0x22, 0x22, 0xb0, 0x2f, 0xe2, 0xfa, 0x65, 0x68, 0x2b,
+	0x5b, 0xa2, 0xc9, 0xbd, 0x2f, 0x52, 0x1b, 0x74, 0xef, 0x0a, 0x50, 0x8a, 0xed, 0x54, 0xa1, 0x4b,
+	0x19, 0xf3, 0x51, 0xa4, 0x5d, 0x97, 0x47, 0x63, 0xf6, 0x4b, 0x03, 0xae, 0x39, 0x95, 0x58, 0x1a,
+	0x44, 0xf5, 0xd0, 0xa4, 0x67, 0xb2, 0x33, 0x30, 0x9d, 0x77, 0x60, 0x7e, 0xa8, 0x53, 0x84, 0x2e,
+	0xa4, 0x82, 0x08, 0xe9, 0xdd, 0xc8, 0xc3, 0xe2, 0x6b, 0x1e, 0x6a, 0xdd, 0x24, 0x6a, 0x8e, 0xee,
+	0x32, 0x49, 0x1b, 0x79, 0x58, 0x98, 0xe6, 0x01, 0x1c, 0xe5, 0x3b, 0x25, 0x28, 0xc9, 0x6f, 0x91,
+	0x4d, 0x1f, 0xe9, 0x42, 0x0e, 0x0e, 0xbf, 0x10, 0x09, 0xdd, 0x56, 0x12, 0x0b, 0x91, 0xb8, 0xcb,
+	0x99, 0xb4, 0x99, 0x8f, 0x29, 0x50, 0x8a, 0x87, 0xaf, 0x22, 0x89, 0xa5, 0x78, 0xec, 0xe5, 0x49,
+	0x7a, 0x2e, 0x27, 0x97, 0x63, 0x83, 0x34, 0xf1, 0xbd, 0x4f, 0x3e, 0x58, 0x17, 0x1a, 0x6f, 0x3c,
+	0x78, 0x58, 0x16, 0x3e, 0x7c, 0x58, 0x16, 0xfe, 0xf1, 0xb0, 0x2c, 0xbc, 0xf3, 0xa8, 0x3c, 0xf6,
+	0xe1, 0xa3, 0xf2, 0xd8, 0xdf, 0x1e, 0x95, 0xc7, 0xa0, 0xa4, 0x91, 0x18, 0xc9, 0xd7, 0x85, 0x6f,
+	0x6c, 0x76, 0x35, 0xf3, 0xf6, 0xe0, 0x56, 0x4d, 0x21, 0xbd, 0xba, 0x4f, 0x74, 0x5e, 0x23, 0x81,
+	0x51, 0xfd, 0x8e, 0xff, 0xf3, 0x4f, 0xf3, 0x6e, 0x1f, 0xd3, 0x5b, 0x93, 0xf6, 0x8f, 0x3e, 0x9f,
+	0xfd, 0xdf, 0x00, 0x85, 0x83, 0x3a, 0x28, 0x40, 0x2b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2589,6 +2689,9 @@ type MsgClient interface {
 	SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error)
 	// AddNetAssetValues set the net asset value for a scope
 	AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error)
+	// ForceBurnScopeCoin burns the coin of a scope even if the scope still has sessions or records.
+	// This is a governance-only action.
+	ForceBurnScopeCoin(ctx context.Context, in *MsgForceBurnScopeCoinRequest, opts ...grpc.CallOption) (*MsgForceBurnScopeCoinResponse, error)
 }
 
 type msgClient struct {
@@ -2815,6 +2918,15 @@ func (c *msgClient) AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetVal
 	return out, nil
 }
 
+func (c *msgClient) ForceBurnScopeCoin(ctx context.Context, in *MsgForceBurnScopeCoinRequest, opts ...grpc.CallOption) (*MsgForceBurnScopeCoinResponse, error) {
+	out := new(MsgForceBurnScopeCoinResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ForceBurnScopeCoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// WriteScope adds or updates a scope.
@@ -2866,6 +2978,9 @@ type MsgServer interface {
 	SetAccountData(context.Context, *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error)
 	// AddNetAssetValues set the net asset value for a scope
 	AddNetAssetValues(context.Context, *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error)
+	// ForceBurnScopeCoin burns the coin of a scope even if the scope still has sessions or records.
+	// This is a governance-only action.
+	ForceBurnScopeCoin(context.Context, *MsgForceBurnScopeCoinRequest) (*MsgForceBurnScopeCoinResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2944,6 +3059,9 @@ func (*UnimplementedMsgServer) SetAccountData(ctx context.Context, req *MsgSetAc
 func (*UnimplementedMsgServer) AddNetAssetValues(ctx context.Context, req *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddNetAssetValues not implemented")
 }
+func (*UnimplementedMsgServer) ForceBurnScopeCoin(ctx context.Context, req *MsgForceBurnScopeCoinRequest) (*MsgForceBurnScopeCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceBurnScopeCoin not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -3381,6 +3499,24 @@ func _Msg_AddNetAssetValues_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ForceBurnScopeCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceBurnScopeCoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceBurnScopeCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/ForceBurnScopeCoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceBurnScopeCoin(ctx, req.(*MsgForceBurnScopeCoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.metadata.v1.Msg",
@@ -3482,6 +3618,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddNetAssetValues",
 			Handler:    _Msg_AddNetAssetValues_Handler,
 		},
+		{
+			MethodName: "ForceBurnScopeCoin",
+			Handler:    _Msg_ForceBurnScopeCoin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/metadata/v1/tx.proto",
@@ -5614,6 +5754,66 @@ func (m *MsgAddNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgForceBurnScopeCoinRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceBurnScopeCoinRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceBurnScopeCoinRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceBurnScopeCoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceBurnScopeCoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceBurnScopeCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -6492,6 +6692,32 @@ func (m *MsgAddNetAssetValuesResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgForceBurnScopeCoinRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgForceBurnScopeCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -12175,6 +12401,170 @@ func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgForceBurnScopeCoinRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceBurnScopeCoinRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceBurnScopeCoinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceBurnScopeCoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceBurnScopeCoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceBurnScopeCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0